@@ -22,6 +22,7 @@ const (
 	OperationStatusRunning    OperationStatus = "running"
 	OperationStatusSuccessful OperationStatus = "successful"
 	OperationStatusFailed     OperationStatus = "failed"
+	OperationStatusWarning    OperationStatus = "warning"
 	OperationStatusAborted    OperationStatus = "aborted"
 	OperationStatusUnknown    OperationStatus = "unknown"
 )
@@ -35,6 +35,8 @@ const (
 	ActionDoing   Status = "doing"
 	ActionDone    Status = "done" // means success
 	ActionFailed  Status = "failed"
+	// ActionSkipped means the action is not executed since the failure threshold of its task was reached
+	ActionSkipped Status = "skipped"
 )
 
 // ItemStatus represents the status of an action item
@@ -45,6 +47,8 @@ const (
 	ItemDoing   ItemStatus = "doing"
 	ItemDone    ItemStatus = "done" // means success
 	ItemFailed  ItemStatus = "failed"
+	// ItemWarning means the item failed but it doesn't fail the action
+	ItemWarning ItemStatus = "warning"
 )
 
 // Action repsents the definition of executable command(s) in a node,
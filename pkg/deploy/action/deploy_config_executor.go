@@ -70,6 +70,13 @@ func (e *deployConfigExecutor) Execute(act Action) *pb.Error {
 		}
 	}
 
+	// the config is only recorded if it's all applied, otherwise UpdateNodeConfig reports the missing ones as drifts
+	if len(errs) == 0 {
+		if err := e.recordConfig(configAction, masterMachine, logger); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if len(errs) != 0 {
 		pbErr := &pb.Error{
 			Reason:     "failed to do deploy config",
@@ -148,3 +155,25 @@ func (e *deployConfigExecutor) appendTaint(act *DeployConfigAction, machine mach
 	logger.Info("Finish to append taint")
 	return nil
 }
+
+func (e *deployConfigExecutor) recordConfig(act *DeployConfigAction, machine machine.IMachine, logger *logrus.Entry) *protos.Error {
+	logger.Debug("Start to record config")
+
+	operation := config.NewRecordNodeConfig(
+		&config.RecordNodeConfigConfig{
+			MasterMachine:    machine,
+			Logger:           logger,
+			Node:             act.NodeConfig,
+			Cluster:          act.ClusterConfig,
+			ExecuteLogWriter: act.GetExecuteLogBuffer(),
+		},
+	)
+
+	if err := operation.Execute(); err != nil {
+		logger.WithField("error", err).Error("record config error")
+		return err
+	}
+
+	logger.Debug("Finish to record config")
+	return nil
+}
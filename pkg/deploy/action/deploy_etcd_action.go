@@ -33,15 +33,17 @@ type DeployEtcdActionConfig struct {
 	CaKey           crypto.Signer
 	Node            *pb.Node
 	ClusterNodes    []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
 	LogFileBasePath string
 }
 
 type DeployEtcdAction struct {
 	Base
 
-	CACrt        *x509.Certificate
-	CAKey        crypto.Signer
-	ClusterNodes []*pb.Node
+	CACrt         *x509.Certificate
+	CAKey         crypto.Signer
+	ClusterNodes  []*pb.Node
+	ClusterConfig *pb.ClusterConfig
 }
 
 // NewDeployEtcdAction returns a deploy etcd action based on the config.
@@ -69,8 +71,9 @@ func NewDeployEtcdAction(cfg *DeployEtcdActionConfig) (Action, error) {
 			CreationTimestamp: time.Now(),
 			Node:              cfg.Node,
 		},
-		CACrt:        cfg.CaCrt,
-		CAKey:        cfg.CaKey,
-		ClusterNodes: cfg.ClusterNodes,
+		CACrt:         cfg.CaCrt,
+		CAKey:         cfg.CaKey,
+		ClusterNodes:  cfg.ClusterNodes,
+		ClusterConfig: cfg.ClusterConfig,
 	}, nil
 }
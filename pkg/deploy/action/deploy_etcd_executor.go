@@ -17,6 +17,7 @@ package action
 import (
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -42,12 +43,14 @@ func (a *deployEtcdExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Start to execute deploy etcd action")
 
 	config := &etcd.DeployEtcdOperationConfig{
-		Logger:       logger,
-		Node:         etcdAction.Node,
-		CACrt:        etcdAction.CACrt,
-		CAKey:        etcdAction.CAKey,
-		ClusterNodes: etcdAction.ClusterNodes,
-		LogWriter:    etcdAction.GetExecuteLogBuffer(),
+		Logger:           logger,
+		Node:             etcdAction.Node,
+		CACrt:            etcdAction.CACrt,
+		CAKey:            etcdAction.CAKey,
+		ClusterNodes:     etcdAction.ClusterNodes,
+		ContainerRuntime: deploy.GetContainerRuntime(etcdAction.ClusterConfig),
+		ImageRepository:  deploy.GetImageRepository(etcdAction.ClusterConfig),
+		LogWriter:        etcdAction.GetExecuteLogBuffer(),
 	}
 	op, err := etcd.NewDeployEtcdOperation(config)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	certutil "k8s.io/client-go/util/cert"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -51,6 +52,22 @@ func TestDeployEtcd(t *testing.T) {
 	pbErr := executor.Execute(normalAction)
 	assert.Nil(t, pbErr)
 
+	containerdAction, err := NewDeployEtcdAction(&DeployEtcdActionConfig{
+		CaCrt: cacert,
+		CaKey: signer,
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			ContainerRuntime: string(consts.ContainerRuntimeContainerd),
+		},
+	})
+	assert.NoError(t, err)
+
+	pbErr = executor.Execute(containerdAction)
+	assert.Nil(t, pbErr)
+
 	errorAction, err := NewDeployEtcdAction(&DeployEtcdActionConfig{
 		CaCrt: cacert,
 		CaKey: signer,
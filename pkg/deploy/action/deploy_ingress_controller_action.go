@@ -22,20 +22,20 @@ import (
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
-const ActionTypeDeployContour Type = "DeployContour"
+const ActionTypeDeployIngressController Type = "DeployIngressController"
 
-type DeployContourActionConfig struct {
+type DeployIngressControllerActionConfig struct {
 	ClusterConfig   *pb.ClusterConfig
 	MasterNodes     []*pb.Node
 	LogFileBasePath string
 }
 
-type DeployContourAction struct {
+type DeployIngressControllerAction struct {
 	Base
-	config *DeployContourActionConfig
+	config *DeployIngressControllerActionConfig
 }
 
-func NewDeployContourAction(config *DeployContourActionConfig) (Action, error) {
+func NewDeployIngressControllerAction(config *DeployIngressControllerActionConfig) (Action, error) {
 
 	if config == nil {
 		return nil, fmt.Errorf("action config is nil")
@@ -44,13 +44,13 @@ func NewDeployContourAction(config *DeployContourActionConfig) (Action, error) {
 		return nil, errors.New("master node is empty")
 	}
 
-	actionName := GenActionName(ActionTypeDeployContour)
-	return &DeployContourAction{
+	actionName := GenActionName(ActionTypeDeployIngressController)
+	return &DeployIngressControllerAction{
 		Base: Base{
 			Name:              actionName,
-			ActionType:        ActionTypeDeployContour,
+			ActionType:        ActionTypeDeployIngressController,
 			Status:            ActionPending,
-			LogFilePath:       GenActionLogFilePath(config.LogFileBasePath, actionName, config.ClusterConfig.ClusterName), // /app/deploy/logs/unknown/deploy-ingress/{clusterName}-DeployContour-{randomUint64}.log
+			LogFilePath:       GenActionLogFilePath(config.LogFileBasePath, actionName, config.ClusterConfig.ClusterName), // /app/deploy/logs/unknown/deploy-ingress/{clusterName}-DeployIngressController-{randomUint64}.log
 			CreationTimestamp: time.Now(),
 			Node:              config.MasterNodes[0],
 		},
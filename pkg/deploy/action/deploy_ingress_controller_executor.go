@@ -0,0 +1,173 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	deployMachine "github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ingress"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/manifest"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeDeployIngressController, new(deployIngressControllerExecutor))
+}
+
+type deployIngressControllerExecutor struct {
+	logger           *logrus.Entry
+	masterMachine    deployMachine.IMachine
+	action           *DeployIngressControllerAction
+	executeLogWriter io.Writer
+	manifest         string
+}
+
+func (executor *deployIngressControllerExecutor) Execute(act Action) *protos.Error {
+
+	action, ok := act.(*DeployIngressControllerAction)
+	if !ok {
+		return errOfTypeMismatched(new(DeployIngressControllerAction), act)
+	}
+
+	executor.action = action
+
+	executor.initLogger()
+	executor.initExecuteLogWriter()
+
+	executor.logger.Info("start to execute deploy ingress controller executor")
+
+	if err := executor.connectMasterNode(); err != nil {
+		return err
+	}
+	defer executor.disconnectMasterNode()
+
+	operations := []func() *protos.Error{
+		executor.renderYAML,
+		executor.writeYAML,
+		executor.applyYAML,
+	}
+
+	for _, operation := range operations {
+		err := operation()
+		if err != nil {
+			return err
+		}
+	}
+
+	executor.logger.Info("deploy ingress controller finished")
+
+	return nil
+}
+
+func (executor *deployIngressControllerExecutor) initLogger() {
+	executor.logger = logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: executor.action.GetName(),
+		"clusterName":         executor.action.config.ClusterConfig.GetClusterName(),
+	})
+}
+
+func (executor *deployIngressControllerExecutor) initExecuteLogWriter() {
+
+	executor.executeLogWriter = executor.action.GetExecuteLogBuffer()
+}
+
+func (executor *deployIngressControllerExecutor) connectMasterNode() *protos.Error {
+	var err error
+	executor.masterMachine, err = deployMachine.NewMachine(executor.action.config.MasterNodes[0])
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"error": err}).Error("failed to connect master node")
+		return &protos.Error{
+			Reason:     "connecting failed",
+			Detail:     fmt.Sprintf("failed to connect master node, err: %s", err),
+			FixMethods: "please check deploy node config to ensure master node can be connected successfully",
+		}
+	}
+	return nil
+}
+
+func (executor *deployIngressControllerExecutor) disconnectMasterNode() {
+	if executor.masterMachine != nil {
+		executor.masterMachine.Close()
+	}
+}
+
+func (executor *deployIngressControllerExecutor) renderYAML() *protos.Error {
+
+	executor.logger.Debug("Start to render ingress controller yaml")
+
+	var err error
+	executor.manifest, err = ingress.RenderManifest(executor.action.config.ClusterConfig)
+	if err != nil {
+		executor.logger.WithField("error", err).Error("render ingress controller yaml error")
+		return &protos.Error{
+			Reason:     "failed to render ingress controller manifest",
+			Detail:     err.Error(),
+			FixMethods: "please check the ingress options",
+		}
+	}
+
+	executor.logger.Info("Finish to render ingress controller yaml")
+	return nil
+}
+
+func (executor *deployIngressControllerExecutor) writeYAML() *protos.Error {
+
+	executor.logger.Debug("Start to write ingress controller yaml")
+
+	operation := manifest.NewWriteFile(
+		&manifest.WriteFileConfig{
+			Node:             executor.masterMachine,
+			Logger:           executor.logger,
+			ExecuteLogWriter: executor.executeLogWriter,
+			FilePath:         ingress.ManifestPath,
+			FileContent:      executor.manifest,
+		},
+	)
+
+	if err := operation.Execute(); err != nil {
+		executor.logger.WithField("error", err).Error("write ingress controller yaml error")
+		return err
+	}
+
+	executor.logger.Info("Finish to write ingress controller yaml action")
+	return nil
+}
+
+func (executor *deployIngressControllerExecutor) applyYAML() *protos.Error {
+
+	executor.logger.Debug("Start to apply yaml")
+
+	operation := manifest.NewApplyYAML(
+		&manifest.ApplyYAMLConfig{
+			Node:             executor.masterMachine,
+			Logger:           executor.logger,
+			ExecuteLogWriter: executor.executeLogWriter,
+			FilePath:         ingress.ManifestPath,
+		},
+	)
+
+	if err := operation.Execute(); err != nil {
+		executor.logger.WithField("error", err).Error("apply yaml error")
+		return err
+	}
+
+	executor.logger.Info("Finish to apply yaml action")
+	return nil
+}
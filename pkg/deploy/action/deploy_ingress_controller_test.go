@@ -0,0 +1,55 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestDeployIngressControllerExecute(t *testing.T) {
+	executor := new(deployIngressControllerExecutor)
+
+	newAction := func(masterName string, options *pb.IngressOptions) Action {
+		act, err := NewDeployIngressControllerAction(&DeployIngressControllerActionConfig{
+			ClusterConfig: &pb.ClusterConfig{ClusterName: "cluster", IngressOptions: options},
+			MasterNodes:   []*pb.Node{{Name: masterName, Ip: "10.10.10.10"}},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, act)
+		act.SetExecuteLogBuffer(&bytes.Buffer{})
+		return act
+	}
+
+	contourAction := newAction("normal", nil)
+	assert.Nil(t, executor.Execute(contourAction))
+	assert.Contains(t, contourAction.GetExecuteLogBuffer().(*bytes.Buffer).String(), "name: contour")
+
+	nginxAction := newAction("normal", &pb.IngressOptions{IngressType: "nginx", ExposeMode: "nodePort"})
+	assert.Nil(t, executor.Execute(nginxAction))
+	assert.Contains(t, nginxAction.GetExecuteLogBuffer().(*bytes.Buffer).String(), "name: nginx-ingress-controller")
+
+	assert.NotNil(t, executor.Execute(newAction("normal", &pb.IngressOptions{IngressType: "unknown"})))
+	assert.NotNil(t, executor.Execute(newAction("error", nil)))
+}
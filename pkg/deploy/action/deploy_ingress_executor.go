@@ -15,6 +15,7 @@
 package action
 
 import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ingress"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -31,14 +32,14 @@ func (executor *deployIngressExecutor) Execute(act Action) *protos.Error {
 		return errOfTypeMismatched(new(DeployIngressAction), act)
 	}
 
-	executor.addIngressMarks(action.config.NodeCfg)
+	executor.addIngressMarks(action.config.NodeCfg, action.config.ClusterConfig)
 
 	return new(deployNodeExecutor).Deploy(act, action.config)
 }
 
-func (executor *deployIngressExecutor) addIngressMarks(node *protos.NodeDeployConfig) {
+func (executor *deployIngressExecutor) addIngressMarks(node *protos.NodeDeployConfig, clusterConfig *protos.ClusterConfig) {
 	if node.Labels == nil {
 		node.Labels = map[string]string{}
 	}
-	node.Labels["node-role.kubernetes.io/ingress"] = "envoy"
+	node.Labels[ingress.NodeRoleLabel] = ingress.NodeRoleLabelValue(clusterConfig.GetIngressOptions())
 }
@@ -0,0 +1,72 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeDeployNetwork Type = "DeployNetwork"
+
+// DeployNetworkActionConfig represents the config for a deploy network action
+type DeployNetworkActionConfig struct {
+	Node            *pb.Node
+	MasterNode      *pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	Install         bool
+	LogFileBasePath string
+}
+
+// DeployNetworkAction waits for the network of a node to be ready, kubectl commands
+// are run on the master node. The action with Install set applies the network manifest firstly.
+type DeployNetworkAction struct {
+	Base
+
+	MasterNode    *pb.Node
+	ClusterConfig *pb.ClusterConfig
+	Install       bool
+}
+
+// NewDeployNetworkAction returns a deploy network action based on the config.
+// User should use this function to create a deploy network action.
+func NewDeployNetworkAction(cfg *DeployNetworkActionConfig) (Action, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("action config is nil")
+	}
+	if cfg.Node == nil {
+		return nil, fmt.Errorf("invalid action config: node is nil")
+	}
+	if cfg.MasterNode == nil {
+		return nil, fmt.Errorf("invalid action config: master node is nil")
+	}
+
+	actionName := GenActionName(ActionTypeDeployNetwork)
+	return &DeployNetworkAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeDeployNetwork,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.Node,
+		},
+		MasterNode:    cfg.MasterNode,
+		ClusterConfig: cfg.ClusterConfig,
+		Install:       cfg.Install,
+	}, nil
+}
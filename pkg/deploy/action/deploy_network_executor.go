@@ -0,0 +1,111 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/manifest"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/network"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeDeployNetwork, new(deployNetworkExecutor))
+}
+
+type deployNetworkExecutor struct {
+}
+
+func (e *deployNetworkExecutor) Execute(act Action) *pb.Error {
+	networkAction, ok := act.(*DeployNetworkAction)
+	if !ok {
+		return errOfTypeMismatched(new(DeployNetworkAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+		consts.LogFieldNode:   act.GetNode().GetName(),
+	})
+	logger.Debug("Start to execute deploy network action")
+
+	masterMachine, err := machine.NewMachine(networkAction.MasterNode)
+	if err != nil {
+		return &pb.Error{
+			Reason: "failed to connect to master node",
+			Detail: err.Error(),
+		}
+	}
+	defer masterMachine.Close()
+
+	if networkAction.Install {
+		if pbErr := e.install(networkAction, masterMachine, logger); pbErr != nil {
+			return pbErr
+		}
+	}
+
+	logger.Debug("Start to wait for network to be ready")
+	if err := network.WaitNodeNetworkReady(masterMachine, networkAction.ClusterConfig, networkAction.Node.GetName(),
+		network.DefaultNetworkReadyTimeout, act.GetExecuteLogBuffer()); err != nil {
+		return &pb.Error{
+			Reason:     "network of node is not ready",
+			Detail:     err.Error(),
+			FixMethods: "please check the network pod and kubelet on the node",
+		}
+	}
+
+	logger.Debug("Finish to execute deploy network action")
+	return nil
+}
+
+// install renders the network manifest and applies it on master
+func (e *deployNetworkExecutor) install(act *DeployNetworkAction, masterMachine machine.IMachine, logger *logrus.Entry) *pb.Error {
+	logger.Debug("Start to install network")
+
+	content, err := network.RenderManifest(act.ClusterConfig)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to render network manifest",
+			Detail:     err.Error(),
+			FixMethods: "please check the network options",
+		}
+	}
+
+	writeFile := manifest.NewWriteFile(&manifest.WriteFileConfig{
+		Node:             masterMachine,
+		Logger:           logger,
+		ExecuteLogWriter: act.GetExecuteLogBuffer(),
+		FilePath:         network.ManifestPath,
+		FileContent:      content,
+	})
+	if pbErr := writeFile.Execute(); pbErr != nil {
+		return pbErr
+	}
+
+	applyYAML := manifest.NewApplyYAML(&manifest.ApplyYAMLConfig{
+		Node:             masterMachine,
+		Logger:           logger,
+		ExecuteLogWriter: act.GetExecuteLogBuffer(),
+		FilePath:         network.ManifestPath,
+	})
+	if pbErr := applyYAML.Execute(); pbErr != nil {
+		return pbErr
+	}
+
+	logger.Debug("Finish to install network")
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestDeployNetworkExecute(t *testing.T) {
+	executor := new(deployNetworkExecutor)
+
+	newAction := func(masterName string) Action {
+		act, err := NewDeployNetworkAction(&DeployNetworkActionConfig{
+			Node:          &pb.Node{Name: masterName, Ip: "10.10.10.10"},
+			MasterNode:    &pb.Node{Name: masterName, Ip: "10.10.10.10"},
+			ClusterConfig: &pb.ClusterConfig{PodSubnet: "172.30.0.0/16"},
+			Install:       true,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, act)
+		act.SetExecuteLogBuffer(&bytes.Buffer{})
+		return act
+	}
+
+	normalAction := newAction("normal")
+	pbErr := executor.Execute(normalAction)
+	assert.Nil(t, pbErr)
+	assert.Contains(t, normalAction.GetExecuteLogBuffer().(*bytes.Buffer).String(), "apply -f")
+
+	pbErr = executor.Execute(newAction("error"))
+	assert.NotNil(t, pbErr)
+
+	_, err := NewDeployNetworkAction(&DeployNetworkActionConfig{Node: &pb.Node{}})
+	assert.Error(t, err)
+}
@@ -70,6 +70,11 @@ func (a *initMasterExecutor) Execute(act Action) *pb.Error {
 	logger.Debugf("Start to init master on nodes: %s", action.Node.Name)
 
 	if err := op.Do(); err != nil {
+		// a misconfigured load balancer makes the control plane look like never ready
+		if pbErr := master.DiagnoseLoadBalancer(action.ClusterConfig, action.MasterNodes); pbErr != nil {
+			logger.Debugf("load balancer check failed: %v", pbErr.Detail)
+			return pbErr
+		}
 		return &pb.Error{
 			Reason:     "failed to do init master operation",
 			Detail:     err.Error(),
@@ -77,6 +82,10 @@ func (a *initMasterExecutor) Execute(act Action) *pb.Error {
 		}
 	}
 
+	if pbErr := master.CheckLoadBalancer(action.ClusterConfig, action.MasterNodes); pbErr != nil {
+		return pbErr
+	}
+
 	logger.Debug("Finish to execute init master action")
 	return nil
 }
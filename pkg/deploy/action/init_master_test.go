@@ -73,3 +73,41 @@ func TestInitMaster(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 }
+
+func TestInitMasterFailedWithMasterDown(t *testing.T) {
+	executor := new(initMasterExecutor)
+
+	action, err := NewInitMasterAction(&InitMasterActionConfig{
+		Node: &pb.Node{
+			Name: "master",
+			Ip:   "127.0.0.1",
+		},
+		MasterNodes: []*pb.Node{
+			{
+				Name: "master",
+				Ip:   "127.0.0.1",
+			},
+		},
+		EtcdNodes: []*pb.Node{
+			{
+				Name: "error",
+				Ip:   "127.0.0.1",
+			},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type: "loadbalancer",
+				Loadbalancer: &pb.Loadbalancer{
+					Ip:   "127.0.0.1",
+					Port: 1,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	// the kubeadm init error is reported instead of an unreachable kube-apiserver
+	pbErr := executor.Execute(action)
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "failed to do init master operation", pbErr.Reason)
+}
@@ -16,11 +16,13 @@ package action
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -28,16 +30,28 @@ const ActionTypeNodeCheck Type = "NodeCheck"
 
 // NodeCheckActionConfig represents the config for a node check action
 type NodeCheckActionConfig struct {
-	NodeCheckConfig *pb.NodeCheckConfig
-	LogFileBasePath string
+	NodeCheckConfig      *pb.NodeCheckConfig
+	KubeAPIServerConnect *pb.KubeAPIServerConnect
+	PodSubnets           []string
+	ServiceSubnets       []string
+	CustomCheckItems     []*pb.CustomCheckItem
+	ContainerRuntime     string
+	LogFileBasePath      string
 }
 
 type NodeCheckAction struct {
 	Base
 	sync.RWMutex
 
-	NodeCheckConfig *pb.NodeCheckConfig
-	CheckItems      []*NodeCheckItem
+	NodeCheckConfig      *pb.NodeCheckConfig
+	KubeAPIServerConnect *pb.KubeAPIServerConnect
+	PodSubnets           []string
+	ServiceSubnets       []string
+	CustomCheckItems     []*pb.CustomCheckItem
+	ContainerRuntime     string
+	CheckItems           []*NodeCheckItem
+	// identities of node collected for finding duplicates among nodes
+	identity *check.NodeIdentity
 }
 
 type NodeCheckItem struct {
@@ -74,6 +88,51 @@ func NewNodeCheckAction(cfg *NodeCheckActionConfig) (Action, error) {
 			CreationTimestamp: time.Now(),
 			Node:              cfg.NodeCheckConfig.Node,
 		},
-		NodeCheckConfig: cfg.NodeCheckConfig,
+		NodeCheckConfig:      cfg.NodeCheckConfig,
+		KubeAPIServerConnect: cfg.KubeAPIServerConnect,
+		PodSubnets:           cfg.PodSubnets,
+		ServiceSubnets:       cfg.ServiceSubnets,
+		CustomCheckItems:     cfg.CustomCheckItems,
+		ContainerRuntime:     cfg.ContainerRuntime,
 	}, nil
 }
+
+func (a *NodeCheckAction) setIdentity(identity *check.NodeIdentity) {
+	a.Lock()
+	defer a.Unlock()
+	a.identity = identity
+}
+
+// GetIdentity returns the identities of node, it's nil if they are not collected
+func (a *NodeCheckAction) GetIdentity() *check.NodeIdentity {
+	a.RLock()
+	defer a.RUnlock()
+	return a.identity
+}
+
+// MarkDuplicateIdentity fails the duplicate identity check item and the action with the duplicates
+// found among nodes, it's called after the action is executed.
+func (a *NodeCheckAction) MarkDuplicateIdentity(duplicates []string) {
+	a.Lock()
+	defer a.Unlock()
+
+	itemName := newNodeCheckItem(check.DuplicateIdentity).Name
+	for _, item := range a.CheckItems {
+		if item.Name != itemName {
+			continue
+		}
+		item.Status = ItemFailed
+		item.Err = &pb.Error{
+			Reason:     "identities of node are duplicated with other nodes",
+			Detail:     strings.Join(duplicates, "; "),
+			FixMethods: "please make sure product_uuid and MAC addresses are unique among nodes, regenerate them if nodes are cloned from the same image",
+		}
+	}
+
+	failedItems := getFailedCheckItems(a)
+	a.Status = ActionFailed
+	a.Err = &pb.Error{
+		Reason: fmt.Sprintf("%d check item(s) failed", len(failedItems)),
+		Detail: fmt.Sprintf("failed check item list: %v", failedItems),
+	}
+}
@@ -18,9 +18,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
-	"math"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -34,9 +34,10 @@ import (
 
 // constant value for check
 const (
-	desiredDockerVersion = "18.09.0"
-	desiredKernelVersion = "4.19.46"
-	desiredSystemManager = "systemd"
+	desiredDockerVersion     = "18.09.0"
+	desiredContainerdVersion = "1.3.0"
+	desiredKernelVersion     = "4.19.46"
+	desiredSystemManager     = "systemd"
 
 	// CPU factor
 	desiredEtcdCPUCore    float64 = 4
@@ -68,9 +69,20 @@ const (
 
 	CheckPassed = "check passed"
 	CheckFailed = "check failed"
+
+	// clock skew between node and controller
+	maxClockSkew         = 30 * time.Second
+	recommendedClockSkew = time.Second
+
+	// percent of used inodes
+	maxInodeUsage         float64 = 95
+	recommendedInodeUsage float64 = 80
+
+	// average latency of synchronized write on etcd node
+	maxDiskSyncLatency         = 100 * time.Millisecond
+	recommendedDiskSyncLatency = 10 * time.Millisecond
 )
 
-var systemDistributions = [3]string{check.DistributionCentos, check.DistributionUbuntu, check.DistributionRHEL}
 var wg sync.WaitGroup
 
 func init() {
@@ -140,14 +152,17 @@ func CheckDockerExecutor(ncAction *NodeCheckAction, checkChan chan<- *NodeCheckI
 		checkItemReport.Status = ItemFailed
 	}
 
-	err = check.CheckDockerVersion(comparedDockerVersion, desiredDockerVersion, ">")
+	minimum, recommended := getCheckThresholds(ncAction.NodeCheckConfig)
+	status, threshold, err := checkWithThresholds(func(threshold checkThreshold) error {
+		return check.CheckDockerVersion(comparedDockerVersion, threshold.dockerVersion, ">")
+	}, minimum, recommended)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
-		checkItemReport.Status = ItemFailed
+		checkItemReport.Status = status
 		checkItemReport.Err = new(pb.Error)
-		checkItemReport.Err.Reason = "docker version too low"
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "docker version too low", "docker version is lower than recommended")
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please upgrade docker version to %v+", desiredDockerVersion)
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please upgrade docker version to %v+", threshold.dockerVersion)
 	} else {
 		logger.Debug(CheckPassed)
 		checkItemReport.Status = ItemDone
@@ -156,6 +171,67 @@ func CheckDockerExecutor(ncAction *NodeCheckAction, checkChan chan<- *NodeCheckI
 	checkChan <- checkItemReport
 }
 
+// goroutine as executor for checking containerd and crictl, they are installed by node init if absent
+func CheckContainerdExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "containerd",
+	})
+
+	logger.Debug("Start to execute check containerd")
+
+	checkItemReport := newNodeCheckItem(check.Containerd)
+
+	result, checkItemReport, err := ExecuteCheckScript(check.Containerd, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check containerd failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+	}
+
+	var versions map[string]string
+	if err == nil {
+		versions, err = check.ParseContainerdTools(result)
+	}
+
+	status := ItemFailed
+	reason := "failed to get containerd version"
+	fixMethods := ItemHelperOperation
+	if err == nil {
+		if versions[check.ContainerdTool] == "" {
+			status = ItemWarning
+			reason = "containerd is not installed"
+			err = fmt.Errorf("containerd is not found on node")
+			fixMethods = fmt.Sprintf("containerd %v+ will be installed by node init", desiredContainerdVersion)
+		} else if err = check.CheckContainerdVersion(versions[check.ContainerdTool], desiredContainerdVersion); err != nil {
+			reason = "containerd version too low"
+			fixMethods = fmt.Sprintf("please upgrade containerd version to %v+", desiredContainerdVersion)
+		} else if versions[check.CrictlTool] == "" {
+			status = ItemWarning
+			reason = "crictl is not installed"
+			err = fmt.Errorf("crictl is not found on node")
+			fixMethods = "crictl will be installed with kubeadm by node init"
+		}
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = status
+		checkItemReport.Err = &pb.Error{
+			Reason:     reason,
+			Detail:     err.Error(),
+			FixMethods: fixMethods,
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
 // goroutine as executor for check CPU
 func CheckCPUExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
@@ -176,31 +252,17 @@ func CheckCPUExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logCh
 		checkItemReport.Status = ItemFailed
 	}
 
-	var desiredCPUCore float64
-	for _, role := range ncAction.NodeCheckConfig.Roles {
-		switch role {
-		case string(constant.MachineRoleMaster):
-			desiredCPUCore = math.Max(desiredCPUCore, desiredMasterCPUCore)
-		case string(constant.MachineRoleWorker):
-			desiredCPUCore = math.Max(desiredCPUCore, desiredWorkerCPUCore)
-		case string(constant.MachineRoleEtcd):
-			desiredCPUCore = math.Max(desiredCPUCore, desiredEtcdCPUCore)
-		case string(constant.MachineRoleIngress):
-			desiredCPUCore = math.Max(desiredCPUCore, desiredIngressCPUCore)
-		}
-	}
-
-	// compare with lowest standard
-	desiredCPUCore = math.Max(desiredCPUCore, lowestCPUCore)
-
-	err = check.CheckCPUNums(cpuCore, desiredCPUCore)
+	minimum, recommended := getCheckThresholds(ncAction.NodeCheckConfig)
+	status, threshold, err := checkWithThresholds(func(threshold checkThreshold) error {
+		return check.CheckCPUNums(cpuCore, threshold.cpuCore)
+	}, minimum, recommended)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
-		checkItemReport.Status = ItemFailed
+		checkItemReport.Status = status
 		checkItemReport.Err = new(pb.Error)
-		checkItemReport.Err.Reason = "cpu cores not enough"
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "cpu cores not enough", "cpu cores are less than recommended")
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize cpu cores to %v", desiredCPUCore)
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize cpu cores to %v", threshold.cpuCore)
 	} else {
 		logger.Debug(CheckPassed)
 		checkItemReport.Status = ItemDone
@@ -229,14 +291,17 @@ func CheckKernelExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 		checkItemReport.Status = ItemFailed
 	}
 
-	err = check.CheckKernelVersion(kernelVersion, desiredKernelVersion, ">")
+	minimum, recommended := getCheckThresholds(ncAction.NodeCheckConfig)
+	status, threshold, err := checkWithThresholds(func(threshold checkThreshold) error {
+		return check.CheckKernelVersion(kernelVersion, threshold.kernelVersion, ">")
+	}, minimum, recommended)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
-		checkItemReport.Status = ItemFailed
+		checkItemReport.Status = status
 		checkItemReport.Err = new(pb.Error)
-		checkItemReport.Err.Reason = "kernel version too low"
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "kernel version too low", "kernel version is lower than recommended")
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize kernel version to %v", desiredKernelVersion)
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize kernel version to %v", threshold.kernelVersion)
 	} else {
 		logger.Debug(CheckPassed)
 		checkItemReport.Status = ItemDone
@@ -265,32 +330,17 @@ func CheckMemoryExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 		checkItemReport.Status = ItemFailed
 	}
 
-	var desiredMemory float64
-	for _, role := range ncAction.NodeCheckConfig.Roles {
-		switch role {
-		case string(constant.MachineRoleMaster):
-			desiredMemory = math.Max(desiredMemory, desiredMasterMemoryByteBase)
-		case string(constant.MachineRoleWorker):
-			desiredMemory = math.Max(desiredMemory, desiredWorkerMemoryByteBase)
-		case string(constant.MachineRoleEtcd):
-			desiredMemory = math.Max(desiredMemory, desiredEtcdMemoryByteBase)
-		case string(constant.MachineRoleIngress):
-			desiredMemory = math.Max(desiredMemory, desiredIngressMemoryByteBase)
-		}
-	}
-
-	// compare with lowest standard
-	desiredMemory = math.Max(desiredMemory, lowestMemoryByteBase)
-	desiredMemory = desiredMemory * operation.GiByteUnits
-
-	err = check.CheckMemoryCapacity(memoryCap, desiredMemory)
+	minimum, recommended := getCheckThresholds(ncAction.NodeCheckConfig)
+	status, threshold, err := checkWithThresholds(func(threshold checkThreshold) error {
+		return check.CheckMemoryCapacity(memoryCap, threshold.memoryGiB*operation.GiByteUnits)
+	}, minimum, recommended)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
-		checkItemReport.Status = ItemFailed
+		checkItemReport.Status = status
 		checkItemReport.Err = new(pb.Error)
-		checkItemReport.Err.Reason = "memory capacity not enough"
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "memory capacity not enough", "memory capacity is less than recommended")
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize memory capacity to %v", deploy.ReturnWithUnit(desiredMemory))
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize memory capacity to %v", deploy.ReturnWithUnit(threshold.memoryGiB*operation.GiByteUnits))
 	} else {
 		logger.Debug(CheckPassed)
 		logrus.Debug("memory check passed")
@@ -320,32 +370,17 @@ func CheckRootDiskExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem,
 		checkItemReport.Status = ItemFailed
 	}
 
-	var desiredRootDiskVolume float64
-	for _, role := range ncAction.NodeCheckConfig.Roles {
-		switch role {
-		case string(constant.MachineRoleMaster):
-			desiredRootDiskVolume = math.Max(desiredRootDiskVolume, desiredMasterDiskVolumeByteBase)
-		case string(constant.MachineRoleWorker):
-			desiredRootDiskVolume = math.Max(desiredRootDiskVolume, desiredWorkerDiskVolumeByteBase)
-		case string(constant.MachineRoleEtcd):
-			desiredRootDiskVolume = math.Max(desiredRootDiskVolume, desiredEtcdDiskVolumeByteBase)
-		case string(constant.MachineRoleIngress):
-			desiredRootDiskVolume = math.Max(desiredRootDiskVolume, desiredIngressDiskVolumeByteBase)
-		}
-	}
-
-	// compare with lowest standard
-	desiredRootDiskVolume = math.Max(desiredRootDiskVolume, lowestDiskVolumeByteBase)
-	desiredRootDiskVolume = desiredRootDiskVolume * operation.GiByteUnits
-
-	err = check.CheckRootDiskVolume(rootDiskVolume, desiredRootDiskVolume)
+	minimum, recommended := getCheckThresholds(ncAction.NodeCheckConfig)
+	status, threshold, err := checkWithThresholds(func(threshold checkThreshold) error {
+		return check.CheckRootDiskVolume(rootDiskVolume, threshold.rootDiskGiB*operation.GiByteUnits)
+	}, minimum, recommended)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
-		checkItemReport.Status = ItemFailed
+		checkItemReport.Status = status
 		checkItemReport.Err = new(pb.Error)
-		checkItemReport.Err.Reason = "root disk volume is not enough"
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "root disk volume is not enough", "root disk volume is less than recommended")
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize root disk volume to %s", deploy.ReturnWithUnit(desiredRootDiskVolume))
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please optimize root disk volume to %s", deploy.ReturnWithUnit(threshold.rootDiskGiB*operation.GiByteUnits))
 	} else {
 		logger.Debug(CheckPassed)
 		checkItemReport.Status = ItemDone
@@ -368,21 +403,21 @@ func CheckDistributionExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 
 	checkItemReport := newNodeCheckItem(check.Distribution)
 
-	disName, checkItemReport, err := ExecuteCheckScript(check.Distribution, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	osRelease, checkItemReport, err := ExecuteCheckScript(check.Distribution, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check distro failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
 	}
 
-	disName = strings.Trim(disName, "\"")
-	err = check.CheckSystemDistribution(disName)
+	disName, version := check.ParseOSRelease(osRelease)
+	err = check.CheckSystemDistribution(disName, version)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
 		checkItemReport.Status = ItemFailed
 		checkItemReport.Err = new(pb.Error)
 		checkItemReport.Err.Reason = "system distribution is not supported"
 		checkItemReport.Err.Detail = err.Error()
-		checkItemReport.Err.FixMethods = fmt.Sprintf("please change suitable distribution to %v", systemDistributions)
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please change suitable distribution to %v", check.SupportedDistributionNames())
 	} else {
 		logger.Debug(CheckPassed)
 		checkItemReport.Status = ItemDone
@@ -496,6 +531,541 @@ func CheckPortOccupiedExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 	ch <- checkItemReport
 }
 
+// goroutine as executor for kube-vip network interface and virtual ip check
+func CheckKubeVIPExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "kube-vip",
+	})
+
+	logrus.Debug("Start to execute check kube-vip")
+
+	checkItemReport := newNodeCheckItem(check.KubeVIP)
+	kubeVIP := ncAction.KubeAPIServerConnect.GetKubeVIP()
+
+	checkOperation := &check.CheckKubeVIPOperation{KubeVIP: kubeVIP}
+	addresses, stdErr, err := checkOperation.RunCommands(ncAction.NodeCheckConfig, logChan)
+	if err != nil {
+		logger.Errorf("check kube-vip failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = &pb.Error{
+			Reason:     "network interface of kube-vip is unavailable",
+			Detail:     fmt.Sprintf("stdErr: %s, err: %v", stdErr, err),
+			FixMethods: fmt.Sprintf("please make sure network interface %v exists on every master node", kubeVIP.GetNetInterfaceName()),
+		}
+		ch <- checkItemReport
+		return
+	}
+
+	err = check.CheckKubeVIP(string(addresses), kubeVIP.GetVip(), kubeVIP.GetMode())
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = new(pb.Error)
+		checkItemReport.Err.Reason = "kube-vip virtual ip is not suitable"
+		checkItemReport.Err.Detail = err.Error()
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please choose an unused ip in subnet of network interface %v, or use bgp mode", kubeVIP.GetNetInterfaceName())
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for external load balancer reachability check
+func CheckLoadBalancerExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "load balancer",
+	})
+
+	logrus.Debug("Start to execute check load balancer")
+
+	checkItemReport := newNodeCheckItem(check.LoadBalancer)
+	loadbalancer := ncAction.KubeAPIServerConnect.GetLoadbalancer()
+	address := deploy.JoinHostPort(loadbalancer.GetIp(), loadbalancer.GetPort())
+
+	checkOperation := &check.CheckLoadBalancerOperation{Loadbalancer: loadbalancer}
+	result, stdErr, err := checkOperation.RunCommands(ncAction.NodeCheckConfig, logChan)
+	if err == nil {
+		err = check.CheckLoadBalancerReachable(string(result), address)
+	} else {
+		err = fmt.Errorf("stdErr: %s, err: %v", stdErr, err)
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = new(pb.Error)
+		checkItemReport.Err.Reason = "load balancer is unreachable"
+		checkItemReport.Err.Detail = err.Error()
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please make sure load balancer listens on %v, "+
+			"and firewall or security group allows TCP traffic from node %v to it", address, ncAction.Node.Ip)
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking the network plan of cluster against node routes
+func CheckNetworkPlanExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "network plan",
+	})
+
+	logrus.Debug("Start to execute check network plan")
+
+	checkItemReport := newNodeCheckItem(check.NetworkPlan)
+
+	checkOperation := &check.CheckNetworkPlanOperation{}
+	result, stdErr, err := checkOperation.RunCommands(ncAction.NodeCheckConfig, logChan)
+	if err != nil {
+		logger.Errorf("check network plan failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = &pb.Error{
+			Reason:     "failed to get routes of node",
+			Detail:     fmt.Sprintf("stdErr: %s, err: %v", stdErr, err),
+			FixMethods: "please make sure iproute2 is installed on node",
+		}
+		ch <- checkItemReport
+		return
+	}
+
+	var reason, fixMethods string
+	routes, err := check.ParseNodeRoutes(string(result))
+	if err != nil {
+		reason = "failed to parse routes of node"
+		fixMethods = ItemHelperOperation
+	}
+
+	if err == nil {
+		subnets := append(append([]string{}, ncAction.PodSubnets...), ncAction.ServiceSubnets...)
+		if err = check.CheckSubnetsConflict(routes, subnets); err != nil {
+			reason = "cluster subnets conflict with node subnets"
+			fixMethods = "please choose pod and service subnets which do not overlap with the networks of nodes"
+		}
+	}
+
+	// kube-vip in arp mode is covered by the kube-vip check
+	if err == nil && ncAction.KubeAPIServerConnect.GetType() == "keepalived" && checkingMaster(ncAction) {
+		vip := ncAction.KubeAPIServerConnect.GetKeepalived().GetVip()
+		if err = check.CheckVIPInNodeSubnets(routes, vip); err != nil {
+			reason = "virtual ip is not in node subnets"
+			fixMethods = "please choose an unused ip in the subnet of master nodes as virtual ip"
+		}
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = &pb.Error{
+			Reason:     reason,
+			Detail:     err.Error(),
+			FixMethods: fixMethods,
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking clock skew and time sync service
+func CheckTimeSyncExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "time sync",
+	})
+
+	logrus.Debug("Start to execute check time sync")
+
+	checkItemReport := newNodeCheckItem(check.TimeSync)
+
+	checkOperation := &check.CheckTimeSyncOperation{}
+	result, stdErr, err := checkOperation.RunCommands(ncAction.NodeCheckConfig, logChan)
+	if err != nil {
+		err = fmt.Errorf("stdErr: %s, err: %v", stdErr, err)
+	}
+
+	var nodeTime time.Time
+	var activeServices int
+	if err == nil {
+		nodeTime, activeServices, err = check.ParseTimeSync(string(result))
+	}
+
+	status := ItemFailed
+	reason := "failed to get clock of node"
+	fixMethods := ItemHelperOperation
+	if err == nil {
+		skew := check.ClockSkew(nodeTime, checkOperation.StartTime, checkOperation.EndTime)
+		if err = check.CheckClockSkew(skew, maxClockSkew); err != nil {
+			reason = "clock skew is too large"
+			fixMethods = "please synchronize the clock of node with NTP server"
+		} else if err = check.CheckClockSkew(skew, recommendedClockSkew); err != nil {
+			status = ItemWarning
+			reason = "clock skew is larger than recommended"
+			fixMethods = "please synchronize the clock of node with NTP server"
+		} else if err = check.CheckTimeSyncService(activeServices); err != nil {
+			status = ItemWarning
+			reason = "time sync service is not running"
+			fixMethods = "please enable and start chronyd or ntpd"
+		}
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = status
+		checkItemReport.Err = &pb.Error{
+			Reason:     reason,
+			Detail:     err.Error(),
+			FixMethods: fixMethods,
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking the nameservers in resolv.conf are reachable
+func CheckNameserverExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "nameserver",
+	})
+
+	logrus.Debug("Start to execute check nameserver")
+
+	checkItemReport := newNodeCheckItem(check.Nameserver)
+
+	result, checkItemReport, err := ExecuteCheckScript(check.Nameserver, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check nameserver failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		ch <- checkItemReport
+		return
+	}
+
+	unreachable, err := check.CheckNameservers(result)
+	if err == nil && len(unreachable) > 0 {
+		checkItemReport.Status = ItemWarning
+		checkItemReport.Err = &pb.Error{
+			Reason:     "some nameservers are unreachable",
+			Detail:     fmt.Sprintf("nameservers %v are unreachable on port 53", unreachable),
+			FixMethods: "please remove the unreachable nameservers from /etc/resolv.conf, or allow DNS traffic from node to them",
+		}
+	} else if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = &pb.Error{
+			Reason:     "nameserver is unavailable",
+			Detail:     err.Error(),
+			FixMethods: "please configure reachable nameservers in /etc/resolv.conf, or allow DNS traffic from node to them",
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking kernel modules required by kubernetes
+func CheckKernelModuleExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "kernel module",
+	})
+
+	logrus.Debug("Start to execute check kernel module")
+
+	checkItemReport := newNodeCheckItem(check.KernelModule)
+
+	result, checkItemReport, err := ExecuteCheckScript(check.KernelModule, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check kernel module failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		ch <- checkItemReport
+		return
+	}
+
+	// ip_vs is required by kube-proxy in dual-stack cluster only
+	requiredModules := []string{check.KernelModuleBrNetfilter, check.KernelModuleOverlay}
+	optionalModules := []string{check.KernelModuleIPVS}
+	if len(ncAction.PodSubnets) > 1 {
+		requiredModules = append(requiredModules, optionalModules...)
+		optionalModules = nil
+	}
+
+	status := ItemFailed
+	err = check.CheckKernelModules(result, requiredModules)
+	if err == nil {
+		status = ItemWarning
+		err = check.CheckKernelModules(result, optionalModules)
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = status
+		checkItemReport.Err = &pb.Error{
+			Reason:     thresholdCheckReason(status, "required kernel modules are missing", "optional kernel modules are missing"),
+			Detail:     err.Error(),
+			FixMethods: "please install the kernel modules, or load them by modprobe",
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking cgroup driver of container runtime
+func CheckCgroupDriverExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "cgroup driver",
+	})
+
+	logrus.Debug("Start to execute check cgroup driver")
+
+	checkItemReport := newNodeCheckItem(check.CgroupDriver)
+
+	cgroupDriver, checkItemReport, err := ExecuteCheckScript(check.CgroupDriver, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check cgroup driver failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+	}
+
+	err = check.CheckCgroupDriver(cgroupDriver, check.KubeletCgroupDriver)
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = new(pb.Error)
+		checkItemReport.Err.Reason = "cgroup driver does not match kubelet"
+		checkItemReport.Err.Detail = err.Error()
+		checkItemReport.Err.FixMethods = fmt.Sprintf("please set \"exec-opts\": [\"native.cgroupdriver=%v\"] in /etc/docker/daemon.json and restart docker",
+			check.KubeletCgroupDriver)
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for checking inode usage
+func CheckInodeExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "inode",
+	})
+
+	logrus.Debug("Start to execute check inode")
+
+	checkItemReport := newNodeCheckItem(check.Inode)
+
+	inodeUsage, checkItemReport, err := ExecuteCheckScript(check.Inode, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check inode failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+	}
+
+	status := ItemFailed
+	err = check.CheckInodeUsage(inodeUsage, maxInodeUsage)
+	if err == nil {
+		status = ItemWarning
+		err = check.CheckInodeUsage(inodeUsage, recommendedInodeUsage)
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = status
+		checkItemReport.Err = new(pb.Error)
+		checkItemReport.Err.Reason = thresholdCheckReason(status, "inodes are not enough", "inode usage is higher than recommended")
+		checkItemReport.Err.Detail = err.Error()
+		checkItemReport.Err.FixMethods = "please remove unused files, images and containers to free inodes"
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for benchmarking latency of synchronized write on etcd node
+func CheckDiskSyncExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "disk sync",
+	})
+
+	logrus.Debug("Start to execute check disk sync")
+
+	checkItemReport := newNodeCheckItem(check.DiskSync)
+
+	result, checkItemReport, err := ExecuteCheckScript(check.DiskSync, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check disk sync failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		ch <- checkItemReport
+		return
+	}
+
+	status := ItemFailed
+	latency, err := check.ParseDiskSyncLatency(result, check.DiskSyncBenchmarkWrites)
+	if err == nil {
+		if err = check.CheckDiskSyncLatency(latency, maxDiskSyncLatency); err == nil {
+			status = ItemWarning
+			err = check.CheckDiskSyncLatency(latency, recommendedDiskSyncLatency)
+		}
+	}
+
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = status
+		checkItemReport.Err = &pb.Error{
+			Reason:     thresholdCheckReason(status, "disk is too slow for etcd", "disk is slower than recommended for etcd"),
+			Detail:     err.Error(),
+			FixMethods: fmt.Sprintf("please use SSD for /var/lib/etcd, latency of synchronized write is recommended to be under %v", recommendedDiskSyncLatency),
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// goroutine as executor for collecting product_uuid and MAC addresses, the item is updated
+// by node check task if they are duplicated with other nodes.
+func CheckDuplicateIdentityExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "duplicate identity",
+	})
+
+	logrus.Debug("Start to execute check duplicate identity")
+
+	checkItemReport := newNodeCheckItem(check.DuplicateIdentity)
+
+	result, checkItemReport, err := ExecuteCheckScript(check.DuplicateIdentity, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check duplicate identity failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+		ch <- checkItemReport
+		return
+	}
+
+	identity, err := check.ParseNodeIdentity(result)
+	if err != nil {
+		// node without product_uuid or physical network interface can't be checked
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemWarning
+		checkItemReport.Err = &pb.Error{
+			Reason:     "failed to get identities of node",
+			Detail:     err.Error(),
+			FixMethods: "please make sure product_uuid and MAC addresses are unique among nodes",
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		ncAction.setIdentity(identity)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+// newCustomCheckExecutor returns a goroutine as executor for the user defined check
+func newCustomCheckExecutor(item *pb.CustomCheckItem) func(*NodeCheckAction, chan<- *NodeCheckItem, chan<- *bytes.Buffer) {
+
+	return func(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+		defer wg.Done()
+
+		logger := logrus.WithFields(logrus.Fields{
+			"node":       ncAction.Node.Name,
+			"check_item": item.GetName(),
+		})
+
+		logger.Debug("Start to execute custom check")
+
+		checkItemReport := &NodeCheckItem{
+			Status:      ItemDoing,
+			Name:        fmt.Sprintf("check %v", item.GetName()),
+			Description: item.GetDescription(),
+		}
+		if checkItemReport.Description == "" {
+			checkItemReport.Description = fmt.Sprintf("自定义检查 %v", item.GetName())
+		}
+
+		checkOperation := &check.CheckCustomOperation{Item: item}
+		result, stdErr, err := checkOperation.RunCommands(ncAction.NodeCheckConfig, logChan)
+		if err != nil {
+			err = fmt.Errorf("stdErr: %s, err: %v", stdErr, err)
+		} else {
+			err = check.CheckCustomOutput(string(result), item.GetExpectedOutput())
+		}
+
+		if err != nil {
+			logger.Debugf("%v: %v", CheckFailed, err)
+			checkItemReport.Status = ItemFailed
+			if item.GetSeverity() == check.CustomCheckSeverityWarning {
+				checkItemReport.Status = ItemWarning
+			}
+			checkItemReport.Err = &pb.Error{
+				Reason:     fmt.Sprintf("custom check %v failed", item.GetName()),
+				Detail:     err.Error(),
+				FixMethods: item.GetFixMethods(),
+			}
+			if checkItemReport.Err.FixMethods == "" {
+				checkItemReport.Err.FixMethods = ItemHelperOperation
+			}
+		} else {
+			logger.Debug(CheckPassed)
+			checkItemReport.Status = ItemDone
+		}
+
+		ch <- checkItemReport
+	}
+}
+
 func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 	nodeCheckAction, ok := act.(*NodeCheckAction)
 	if !ok {
@@ -513,7 +1083,6 @@ func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 
 	// build items function
 	checkItemFunctions := []func(*NodeCheckAction, chan<- *NodeCheckItem, chan<- *bytes.Buffer){
-		CheckDockerExecutor,
 		CheckCPUExecutor,
 		CheckKernelExecutor,
 		CheckMemoryExecutor,
@@ -522,13 +1091,49 @@ func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 		CheckSysPrefExecutor,
 		CheckSysManagerExecutor,
 		CheckPortOccupiedExecutor,
+		CheckTimeSyncExecutor,
+		CheckNameserverExecutor,
+		CheckKernelModuleExecutor,
+		CheckInodeExecutor,
+		CheckDuplicateIdentityExecutor,
+	}
+
+	switch consts.ContainerRuntime(nodeCheckAction.ContainerRuntime) {
+	case consts.ContainerRuntimeContainerd:
+		// node init configures both containerd and kubelet with the systemd cgroup driver
+		checkItemFunctions = append(checkItemFunctions, CheckContainerdExecutor)
+	default:
+		checkItemFunctions = append(checkItemFunctions, CheckDockerExecutor, CheckCgroupDriverExecutor)
+	}
+
+	if checkingRole(nodeCheckAction, constant.MachineRoleEtcd) {
+		checkItemFunctions = append(checkItemFunctions, CheckDiskSyncExecutor)
+	}
+
+	switch nodeCheckAction.KubeAPIServerConnect.GetType() {
+	case "kubevip":
+		if checkingMaster(nodeCheckAction) {
+			checkItemFunctions = append(checkItemFunctions, CheckKubeVIPExecutor)
+		}
+	case "loadbalancer":
+		checkItemFunctions = append(checkItemFunctions, CheckLoadBalancerExecutor)
+	}
+
+	if len(nodeCheckAction.PodSubnets) > 0 || len(nodeCheckAction.ServiceSubnets) > 0 {
+		checkItemFunctions = append(checkItemFunctions, CheckNetworkPlanExecutor)
+	}
+
+	for _, item := range nodeCheckAction.CustomCheckItems {
+		if check.CustomCheckApplies(item, nodeCheckAction.NodeCheckConfig.Roles) {
+			checkItemFunctions = append(checkItemFunctions, newCustomCheckExecutor(item))
+		}
 	}
 
 	// make enough length of check items
 	nodeCheckch := make(chan *NodeCheckItem, len(checkItemFunctions))
 	nodeLogch := make(chan *bytes.Buffer, len(checkItemFunctions))
 
-	// check docker, CPU, kernel, memory, disk, distribution, system preference, system manager, port occupied
+	// run all check items concurrently
 	for _, function := range checkItemFunctions {
 		wg.Add(1)
 		go function(nodeCheckAction, nodeCheckch, nodeLogch)
@@ -570,10 +1175,23 @@ func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 	return nil
 }
 
+func checkingMaster(checkAction *NodeCheckAction) bool {
+	return checkingRole(checkAction, constant.MachineRoleMaster)
+}
+
+func checkingRole(checkAction *NodeCheckAction, machineRole constant.MachineRole) bool {
+	for _, role := range checkAction.NodeCheckConfig.Roles {
+		if role == string(machineRole) {
+			return true
+		}
+	}
+	return false
+}
+
 func getFailedCheckItems(checkAction *NodeCheckAction) []string {
 	var failedItemName []string
 	for _, item := range checkAction.CheckItems {
-		if item.Status != ItemDone {
+		if item.Status != ItemDone && item.Status != ItemWarning {
 			failedItemName = append(failedItemName, item.Name)
 		}
 	}
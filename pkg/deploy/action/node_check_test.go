@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
@@ -59,3 +60,343 @@ func TestNodeCheck(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 }
+
+func TestNodeCheckKubeVIP(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(roles []string, vip string) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles: roles,
+			},
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type: "kubevip",
+				KubeVIP: &pb.KubeVIP{
+					Vip:              vip,
+					NetInterfaceName: "eth0",
+					Mode:             "arp",
+				},
+			},
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	masterAction := newAction([]string{"master"}, "10.10.10.100")
+	assert.Nil(t, executor.Execute(masterAction))
+	assert.Contains(t, getCheckItemNames(masterAction), "check kube-vip")
+
+	workerAction := newAction([]string{"worker"}, "10.10.10.100")
+	assert.Nil(t, executor.Execute(workerAction))
+	assert.NotContains(t, getCheckItemNames(workerAction), "check kube-vip")
+
+	wrongVIPAction := newAction([]string{"master"}, "10.10.20.100")
+	assert.NotNil(t, executor.Execute(wrongVIPAction))
+}
+
+func TestNodeCheckLoadBalancer(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+		NodeCheckConfig: &pb.NodeCheckConfig{
+			Node: &pb.Node{
+				Name: "normal",
+				Ip:   "10.10.10.10",
+			},
+			Roles: []string{"worker"},
+		},
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type: "loadbalancer",
+			Loadbalancer: &pb.Loadbalancer{
+				Ip:   "10.10.10.100",
+				Port: 6443,
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	checkAction := act.(*NodeCheckAction)
+	assert.Nil(t, executor.Execute(checkAction))
+	assert.Contains(t, getCheckItemNames(checkAction), "check load-balancer")
+}
+
+func TestNodeCheckNetworkPlan(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(podSubnet string, vip string) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles: []string{"master"},
+			},
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type: "keepalived",
+				Keepalived: &pb.Keepalived{
+					Vip:              vip,
+					NetInterfaceName: "eth0",
+				},
+			},
+			PodSubnets:     []string{podSubnet},
+			ServiceSubnets: []string{"10.96.0.0/12"},
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	normalAction := newAction("172.20.0.0/16", "10.10.10.100")
+	assert.Nil(t, executor.Execute(normalAction))
+	assert.Contains(t, getCheckItemNames(normalAction), "check network-plan")
+
+	conflictAction := newAction("10.10.0.0/16", "10.10.10.100")
+	assert.NotNil(t, executor.Execute(conflictAction))
+
+	wrongVIPAction := newAction("172.20.0.0/16", "10.10.20.100")
+	assert.NotNil(t, executor.Execute(wrongVIPAction))
+}
+
+func getCheckItemNames(checkAction *NodeCheckAction) []string {
+	names := make([]string, 0, len(checkAction.CheckItems))
+	for _, item := range checkAction.CheckItems {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestNodeCheckCustomItems(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(items ...*pb.CustomCheckItem) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles: []string{"master"},
+			},
+			CustomCheckItems: items,
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	getItem := func(checkAction *NodeCheckAction, name string) *NodeCheckItem {
+		for _, item := range checkAction.CheckItems {
+			if item.Name == name {
+				return item
+			}
+		}
+		return nil
+	}
+
+	// the output of script is empty in mock machine
+	passedAction := newAction(
+		&pb.CustomCheckItem{Name: "exit-code", Script: "true"},
+		&pb.CustomCheckItem{Name: "worker-only", Script: "true", ExpectedOutput: "^ok$", Roles: []string{"worker"}},
+	)
+	assert.Nil(t, executor.Execute(passedAction))
+	assert.Equal(t, ItemDone, getItem(passedAction, "check exit-code").Status)
+	assert.Nil(t, getItem(passedAction, "check worker-only"))
+
+	warningAction := newAction(&pb.CustomCheckItem{Name: "auditd", Script: "systemctl is-active auditd",
+		ExpectedOutput: "^active$", Severity: "warning", FixMethods: "please start auditd"})
+	assert.Nil(t, executor.Execute(warningAction))
+	warningItem := getItem(warningAction, "check auditd")
+	assert.Equal(t, ItemWarning, warningItem.Status)
+	assert.Equal(t, "please start auditd", warningItem.Err.FixMethods)
+
+	failedAction := newAction(&pb.CustomCheckItem{Name: "ntp", Script: "timedatectl show -p NTPSynchronized --value",
+		ExpectedOutput: "^yes$"})
+	assert.NotNil(t, executor.Execute(failedAction))
+	assert.Equal(t, ItemFailed, getItem(failedAction, "check ntp").Status)
+}
+
+func TestNodeCheckThresholds(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(roles []string, thresholds map[string]*pb.NodeCheckThresholds) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles:      roles,
+				Thresholds: thresholds,
+			},
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	getStatus := func(checkAction *NodeCheckAction, name string) ItemStatus {
+		for _, item := range checkAction.CheckItems {
+			if item.Name == name {
+				return item.Status
+			}
+		}
+		return ""
+	}
+
+	// mock machine has 8 cpu cores and docker 18.09.0
+	warningAction := newAction([]string{"worker"}, map[string]*pb.NodeCheckThresholds{
+		"worker": {
+			Minimum:     &pb.CheckThreshold{CpuCore: 2},
+			Recommended: &pb.CheckThreshold{CpuCore: 16, DockerVersion: "19.03.5"},
+		},
+	})
+	assert.Nil(t, executor.Execute(warningAction))
+	assert.Equal(t, ItemWarning, getStatus(warningAction, "check cpu"))
+	assert.Equal(t, ItemWarning, getStatus(warningAction, "check docker"))
+	assert.Equal(t, ItemDone, getStatus(warningAction, "check memory"))
+
+	// the strictest threshold of node roles is used
+	failedAction := newAction([]string{"worker", "etcd"}, map[string]*pb.NodeCheckThresholds{
+		"worker": {Minimum: &pb.CheckThreshold{CpuCore: 2}},
+		"etcd":   {Minimum: &pb.CheckThreshold{CpuCore: 16}},
+	})
+	assert.NotNil(t, executor.Execute(failedAction))
+	assert.Equal(t, ItemFailed, getStatus(failedAction, "check cpu"))
+}
+
+func TestGetCheckThresholds(t *testing.T) {
+	minimum, recommended := getCheckThresholds(&pb.NodeCheckConfig{})
+	assert.Equal(t, defaultCheckThreshold(""), minimum)
+	assert.Equal(t, minimum, recommended)
+
+	minimum, recommended = getCheckThresholds(&pb.NodeCheckConfig{
+		Roles: []string{"worker"},
+		Thresholds: map[string]*pb.NodeCheckThresholds{
+			"worker": {
+				Minimum:     &pb.CheckThreshold{CpuCore: 2, MemoryGiB: 4, KernelVersion: "3.10.0"},
+				Recommended: &pb.CheckThreshold{MemoryGiB: 2},
+			},
+		},
+	})
+	assert.Equal(t, float64(2), minimum.cpuCore)
+	assert.Equal(t, "3.10.0", minimum.kernelVersion)
+	assert.Equal(t, desiredDockerVersion, minimum.dockerVersion)
+	assert.Equal(t, lowestCPUCore, recommended.cpuCore)
+	assert.Equal(t, desiredKernelVersion, recommended.kernelVersion)
+	// recommended is never lower than minimum
+	assert.Equal(t, float64(4), recommended.memoryGiB)
+}
+
+func TestNodeCheckBuiltinItems(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(roles ...string) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles: roles,
+			},
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	workerAction := newAction("worker")
+	assert.Nil(t, executor.Execute(workerAction))
+	names := getCheckItemNames(workerAction)
+	for _, name := range []string{"check time-sync", "check nameserver", "check kernel-module", "check cgroup-driver",
+		"check inode", "check duplicate-identity"} {
+		assert.Contains(t, names, name)
+	}
+	assert.NotContains(t, names, "check disk-sync")
+	for _, item := range workerAction.CheckItems {
+		assert.Equal(t, ItemDone, item.Status, item.Name)
+	}
+	assert.NotNil(t, workerAction.GetIdentity())
+
+	etcdAction := newAction("etcd")
+	assert.Nil(t, executor.Execute(etcdAction))
+	assert.Contains(t, getCheckItemNames(etcdAction), "check disk-sync")
+
+	etcdAction.MarkDuplicateIdentity([]string{"product_uuid uuid-1 is the same as nodes [node2]"})
+	assert.Equal(t, ActionFailed, etcdAction.GetStatus())
+	for _, item := range etcdAction.CheckItems {
+		if item.Name == "check duplicate-identity" {
+			assert.Equal(t, ItemFailed, item.Status)
+			assert.Contains(t, item.Err.Detail, "uuid-1")
+		}
+	}
+}
+
+func TestNodeCheckContainerRuntime(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	newAction := func(containerRuntime string) *NodeCheckAction {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: "normal",
+					Ip:   "10.10.10.10",
+				},
+				Roles: []string{"worker"},
+			},
+			ContainerRuntime: containerRuntime,
+		})
+		assert.NoError(t, err)
+		return act.(*NodeCheckAction)
+	}
+
+	dockerAction := newAction("")
+	assert.Nil(t, executor.Execute(dockerAction))
+	names := getCheckItemNames(dockerAction)
+	assert.Contains(t, names, "check docker")
+	assert.Contains(t, names, "check cgroup-driver")
+	assert.NotContains(t, names, "check containerd")
+
+	containerdAction := newAction(string(consts.ContainerRuntimeContainerd))
+	assert.Nil(t, executor.Execute(containerdAction))
+	names = getCheckItemNames(containerdAction)
+	assert.Contains(t, names, "check containerd")
+	assert.NotContains(t, names, "check docker")
+	assert.NotContains(t, names, "check cgroup-driver")
+	for _, item := range containerdAction.CheckItems {
+		assert.Equal(t, ItemDone, item.Status, item.Name)
+	}
+}
+
+func TestNodeCheckDistribution(t *testing.T) {
+	executor := new(nodeCheckExecutor)
+
+	distributionStatus := func(nodeName string) ItemStatus {
+		act, err := NewNodeCheckAction(&NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node: &pb.Node{
+					Name: nodeName,
+					Ip:   "10.10.10.10",
+				},
+				Roles: []string{"worker"},
+			},
+		})
+		assert.NoError(t, err)
+		executor.Execute(act)
+
+		for _, item := range act.(*NodeCheckAction).CheckItems {
+			if item.Name == "check distribution" {
+				return item.Status
+			}
+		}
+		return ""
+	}
+
+	for _, nodeName := range []string{"centos-7", "rhel-8", "ubuntu-18.04", "debian-10", "debian-11", "rocky-8", "almalinux-8", "openeuler-20.03"} {
+		assert.Equal(t, ItemDone, distributionStatus(nodeName), nodeName)
+	}
+	for _, nodeName := range []string{"ubuntu-22.04", "debian-9", "almalinux-9"} {
+		assert.Equal(t, ItemFailed, distributionStatus(nodeName), nodeName)
+	}
+}
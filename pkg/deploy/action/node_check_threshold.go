@@ -0,0 +1,145 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"math"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// checkThreshold contains the values which a node is checked against
+type checkThreshold struct {
+	dockerVersion string
+	kernelVersion string
+	cpuCore       float64
+	memoryGiB     float64
+	rootDiskGiB   float64
+}
+
+// defaultCheckThreshold returns the built-in threshold of the role, it's the lowest standard if role is empty
+func defaultCheckThreshold(role string) checkThreshold {
+	threshold := checkThreshold{
+		dockerVersion: desiredDockerVersion,
+		kernelVersion: desiredKernelVersion,
+		cpuCore:       lowestCPUCore,
+		memoryGiB:     lowestMemoryByteBase,
+		rootDiskGiB:   lowestDiskVolumeByteBase,
+	}
+
+	switch role {
+	case string(constant.MachineRoleMaster):
+		threshold.cpuCore = math.Max(threshold.cpuCore, desiredMasterCPUCore)
+		threshold.memoryGiB = math.Max(threshold.memoryGiB, desiredMasterMemoryByteBase)
+		threshold.rootDiskGiB = math.Max(threshold.rootDiskGiB, desiredMasterDiskVolumeByteBase)
+	case string(constant.MachineRoleWorker):
+		threshold.cpuCore = math.Max(threshold.cpuCore, desiredWorkerCPUCore)
+		threshold.memoryGiB = math.Max(threshold.memoryGiB, desiredWorkerMemoryByteBase)
+		threshold.rootDiskGiB = math.Max(threshold.rootDiskGiB, desiredWorkerDiskVolumeByteBase)
+	case string(constant.MachineRoleEtcd):
+		threshold.cpuCore = math.Max(threshold.cpuCore, desiredEtcdCPUCore)
+		threshold.memoryGiB = math.Max(threshold.memoryGiB, desiredEtcdMemoryByteBase)
+		threshold.rootDiskGiB = math.Max(threshold.rootDiskGiB, desiredEtcdDiskVolumeByteBase)
+	case string(constant.MachineRoleIngress):
+		threshold.cpuCore = math.Max(threshold.cpuCore, desiredIngressCPUCore)
+		threshold.memoryGiB = math.Max(threshold.memoryGiB, desiredIngressMemoryByteBase)
+		threshold.rootDiskGiB = math.Max(threshold.rootDiskGiB, desiredIngressDiskVolumeByteBase)
+	}
+
+	return threshold
+}
+
+// override returns a copy of the threshold with the values set by user
+func (t checkThreshold) override(threshold *pb.CheckThreshold) checkThreshold {
+	if threshold.GetDockerVersion() != "" {
+		t.dockerVersion = threshold.GetDockerVersion()
+	}
+	if threshold.GetKernelVersion() != "" {
+		t.kernelVersion = threshold.GetKernelVersion()
+	}
+	if threshold.GetCpuCore() > 0 {
+		t.cpuCore = threshold.GetCpuCore()
+	}
+	if threshold.GetMemoryGiB() > 0 {
+		t.memoryGiB = threshold.GetMemoryGiB()
+	}
+	if threshold.GetRootDiskGiB() > 0 {
+		t.rootDiskGiB = threshold.GetRootDiskGiB()
+	}
+	return t
+}
+
+// stricter returns the higher values of the two thresholds
+func (t checkThreshold) stricter(other checkThreshold) checkThreshold {
+	if operation.CompareVersion(other.dockerVersion, t.dockerVersion) > 0 {
+		t.dockerVersion = other.dockerVersion
+	}
+	if operation.CompareVersion(other.kernelVersion, t.kernelVersion) > 0 {
+		t.kernelVersion = other.kernelVersion
+	}
+	t.cpuCore = math.Max(t.cpuCore, other.cpuCore)
+	t.memoryGiB = math.Max(t.memoryGiB, other.memoryGiB)
+	t.rootDiskGiB = math.Max(t.rootDiskGiB, other.rootDiskGiB)
+	return t
+}
+
+// getCheckThresholds returns the minimum and recommended thresholds of the node, the strictest
+// of the node roles is used. Built-in thresholds are used for the roles without thresholds,
+// and the recommended one is never lower than the minimum.
+func getCheckThresholds(config *pb.NodeCheckConfig) (minimum, recommended checkThreshold) {
+	roles := config.GetRoles()
+	if len(roles) == 0 {
+		roles = []string{""}
+	}
+
+	for i, role := range roles {
+		roleDefault := defaultCheckThreshold(role)
+		roleThresholds := config.GetThresholds()[role]
+		roleMinimum := roleDefault.override(roleThresholds.GetMinimum())
+		roleRecommended := roleDefault.override(roleThresholds.GetRecommended()).stricter(roleMinimum)
+
+		if i == 0 {
+			minimum, recommended = roleMinimum, roleRecommended
+			continue
+		}
+		minimum = minimum.stricter(roleMinimum)
+		recommended = recommended.stricter(roleRecommended)
+	}
+
+	return
+}
+
+// checkWithThresholds checks the node against the minimum threshold and then the recommended one,
+// the item fails if the node is under the minimum, and gets a warning if it's under the recommended.
+// The threshold which the node doesn't meet is returned along with the error.
+func checkWithThresholds(checkFunc func(threshold checkThreshold) error, minimum, recommended checkThreshold) (ItemStatus, checkThreshold, error) {
+	if err := checkFunc(minimum); err != nil {
+		return ItemFailed, minimum, err
+	}
+	if err := checkFunc(recommended); err != nil {
+		return ItemWarning, recommended, err
+	}
+	return ItemDone, recommended, nil
+}
+
+// thresholdCheckReason returns the failed reason of the threshold check by the status
+func thresholdCheckReason(status ItemStatus, failedReason, warningReason string) string {
+	if status == ItemWarning {
+		return warningReason
+	}
+	return failedReason
+}
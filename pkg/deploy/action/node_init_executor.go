@@ -216,10 +216,13 @@ func constructInitGroup(nodeInitAction *NodeInitAction) map[it.ItemEnum]bool {
 	workerItemEnums := make([]it.ItemEnum, 0)
 	ingressItemEnums := make([]it.ItemEnum, 0)
 
-	baseItemEnums := []it.ItemEnum{it.HostName, it.Swap, it.Route, it.Network, it.FireWall, it.TimeZone, it.HostName, it.HostAlias, it.KubeTool}
+	baseItemEnums := []it.ItemEnum{it.HostName, it.Swap, it.Route, it.Network, it.FireWall, it.TimeZone, it.TimeSync, it.HostAlias, it.KubeTool}
 
-	if nodeInitAction.ClusterConfig.GetKubeAPIServerConnect().GetType() == "keepalived" {
+	switch nodeInitAction.ClusterConfig.GetKubeAPIServerConnect().GetType() {
+	case "keepalived":
 		masterItemEnums = []it.ItemEnum{it.Haproxy, it.Keepalived}
+	case "kubevip":
+		masterItemEnums = []it.ItemEnum{it.KubeVIP}
 	}
 
 	if containsRole(nodeInitAction, constant.MachineRoleEtcd) {
@@ -240,7 +243,12 @@ func constructInitGroup(nodeInitAction *NodeInitAction) map[it.ItemEnum]bool {
 
 	logrus.Debugf("node: %v, init group: %v", nodeInitAction.Node.Name, initGroup)
 
+	// items could be disabled by the node init profile
+	profile := nodeInitAction.ClusterConfig.GetNodeInitProfile()
 	for _, item := range baseItemEnums {
+		if !it.ItemEnabled(profile, item) {
+			continue
+		}
 		if _, ok := initGroup[item]; !ok {
 			initGroup[item] = true
 		}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -61,3 +62,53 @@ func TestNodeInitExecute(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 }
+
+func TestConstructInitGroupProfile(t *testing.T) {
+	newAction := func(profile *pb.NodeInitProfile) *NodeInitAction {
+		action, err := NewNodeInitAction(&NodeInitActionConfig{
+			NodeInitConfig: &pb.NodeDeployConfig{
+				Node:  &pb.Node{Name: "normal", Ip: "10.10.10.10"},
+				Roles: []string{"worker"},
+			},
+			ClusterConfig: &pb.ClusterConfig{NodeInitProfile: profile},
+		})
+		assert.NoError(t, err)
+		return action.(*NodeInitAction)
+	}
+
+	initGroup := constructInitGroup(newAction(nil))
+	assert.True(t, initGroup[it.Swap])
+	assert.True(t, initGroup[it.HostAlias])
+	assert.False(t, initGroup[it.TimeSync])
+
+	initGroup = constructInitGroup(newAction(&pb.NodeInitProfile{
+		NtpServers: []string{"ntp.aliyun.com"},
+		SwapPolicy: "keep",
+		Items:      map[string]bool{string(it.HostAlias): false},
+	}))
+	assert.False(t, initGroup[it.Swap])
+	assert.False(t, initGroup[it.HostAlias])
+	assert.True(t, initGroup[it.TimeSync])
+	assert.True(t, initGroup[it.KubeTool])
+
+	// haproxy and keepalived of masters could be disabled if they are provisioned in advance
+	masterAction := newAction(&pb.NodeInitProfile{
+		Items: map[string]bool{string(it.Keepalived): false, string(it.KubeTool): false},
+	})
+	masterAction.NodeInitConfig.Roles = []string{"master"}
+	masterAction.ClusterConfig.KubeAPIServerConnect = &pb.KubeAPIServerConnect{Type: "keepalived"}
+	initGroup = constructInitGroup(masterAction)
+	assert.True(t, initGroup[it.Haproxy])
+	assert.False(t, initGroup[it.Keepalived])
+	assert.False(t, initGroup[it.KubeTool])
+
+	pbErr := new(nodeInitExecutor).Execute(newAction(&pb.NodeInitProfile{
+		Timezone:       "UTC",
+		NtpServers:     []string{"ntp.aliyun.com"},
+		Sysctl:         map[string]string{"vm.max_map_count": "262144"},
+		FirewallMode:   "open-ports",
+		SwapPolicy:     "keep",
+		HostnamePolicy: "keep",
+	}))
+	assert.Nil(t, pbErr)
+}
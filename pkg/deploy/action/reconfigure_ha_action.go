@@ -0,0 +1,76 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeReconfigureHA Type = "ReconfigureHA"
+
+// ReconfigureHAActionConfig represents the config for a reconfigure-ha action
+type ReconfigureHAActionConfig struct {
+	NodeConfig      *pb.NodeDeployConfig
+	NodesConfig     []*pb.NodeDeployConfig
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+}
+
+// ReconfigureHAAction regenerates haproxy and keepalived config of a master node and reloads them.
+type ReconfigureHAAction struct {
+	Base
+
+	NodeConfig    *pb.NodeDeployConfig
+	NodesConfig   []*pb.NodeDeployConfig
+	ClusterConfig *pb.ClusterConfig
+}
+
+// NewReconfigureHAAction returns a reconfigure-ha action based on the config.
+// User should use this function to create a reconfigure-ha action.
+func NewReconfigureHAAction(cfg *ReconfigureHAActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.NodeConfig == nil || cfg.NodeConfig.Node == nil {
+		err = fmt.Errorf("invalid action config: node is nil")
+	} else if cfg.ClusterConfig == nil {
+		err = fmt.Errorf("invalid action config: cluster config is nil")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := GenActionName(ActionTypeReconfigureHA)
+	return &ReconfigureHAAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeReconfigureHA,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.NodeConfig.Node.Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.NodeConfig.Node,
+		},
+		NodeConfig:    cfg.NodeConfig,
+		NodesConfig:   cfg.NodesConfig,
+		ClusterConfig: cfg.ClusterConfig,
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeReconfigureHA, new(reconfigureHAExecutor))
+}
+
+type reconfigureHAExecutor struct{}
+
+func (a *reconfigureHAExecutor) Execute(act Action) *pb.Error {
+	haAction, ok := act.(*ReconfigureHAAction)
+	if !ok {
+		return errOfTypeMismatched(new(ReconfigureHAAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+	logger.Debug("Start to execute reconfigure ha action")
+
+	initAction := &operation.NodeInitAction{
+		NodeInitConfig: haAction.NodeConfig,
+		NodesConfig:    haAction.NodesConfig,
+		ClusterConfig:  haAction.ClusterConfig,
+	}
+
+	// haproxy goes first, so keepalived checks the new haproxy port
+	ops := []struct {
+		item it.ItemEnum
+		op   it.InitOperation
+	}{
+		{item: it.Haproxy, op: &it.InitHaproxyOperation{ScriptAction: it.HAScriptActionReconfigure}},
+		{item: it.Keepalived, op: &it.InitKeepalivedOperation{ScriptAction: it.HAScriptActionReconfigure}},
+	}
+
+	for _, each := range ops {
+		logChan := make(chan *bytes.Buffer, 1)
+		_, stdErr, err := each.op.RunCommands(haAction.Node, initAction, logChan)
+
+		if executeLogBuf := act.GetExecuteLogBuffer(); executeLogBuf != nil {
+			io.Copy(executeLogBuf, <-logChan)
+		}
+
+		if err != nil {
+			logger.Errorf("failed to reconfigure %v, err: %v", each.item, err)
+			return &pb.Error{
+				Reason:     fmt.Sprintf("failed to reconfigure %v", each.item),
+				Detail:     fmt.Sprintf("stdErr: %s, err: %v", stdErr, err),
+				FixMethods: ItemHelperOperation,
+			}
+		}
+	}
+
+	logger.Debug("Finish to execute reconfigure ha action")
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestNewReconfigureHAAction(t *testing.T) {
+	// test invalid paramters
+	tests := []*ReconfigureHAActionConfig{
+		nil,
+		&ReconfigureHAActionConfig{},
+		&ReconfigureHAActionConfig{NodeConfig: &pb.NodeDeployConfig{Node: &pb.Node{}}},
+	}
+	for _, test := range tests {
+		_, err := NewReconfigureHAAction(test)
+		assert.Error(t, err)
+	}
+}
+
+func TestReconfigureHAExecute(t *testing.T) {
+	executor := new(reconfigureHAExecutor)
+
+	clusterConfig := &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type: "keepalived",
+			Keepalived: &pb.Keepalived{
+				Vip:              "10.10.10.100",
+				NetInterfaceName: "eth0",
+				HaproxyPort:      8443,
+			},
+		},
+	}
+
+	newAction := func(name string) Action {
+		nodeConfig := &pb.NodeDeployConfig{
+			Node: &pb.Node{
+				Name: name,
+				Ip:   "10.10.10.10",
+			},
+			Roles: []string{"master"},
+		}
+		act, err := NewReconfigureHAAction(&ReconfigureHAActionConfig{
+			NodeConfig: nodeConfig,
+			NodesConfig: []*pb.NodeDeployConfig{
+				nodeConfig,
+				{Node: &pb.Node{Name: "master2", Ip: "10.10.10.11"}, Roles: []string{"master"}},
+				{Node: &pb.Node{Name: "master3", Ip: "10.10.10.12"}, Roles: []string{"master"}},
+			},
+			ClusterConfig: clusterConfig,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, act)
+		act.SetExecuteLogBuffer(&bytes.Buffer{})
+		return act
+	}
+
+	normalAction := newAction("normal")
+	pbErr := executor.Execute(normalAction)
+	assert.Nil(t, pbErr)
+	assert.Contains(t, normalAction.GetExecuteLogBuffer().(*bytes.Buffer).String(), "keepalived reconfigure")
+
+	pbErr = executor.Execute(newAction("error"))
+	assert.NotNil(t, pbErr)
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeRotateEncryptionKey Type = "RotateEncryptionKey"
+
+// RotateEncryptionKeyActionConfig represents the config for a rotate-encryption-key action
+type RotateEncryptionKeyActionConfig struct {
+	MasterNodes     []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+}
+
+// RotateEncryptionKeyAction replaces the encryption key of secrets on all masters and rewrites secrets by the new key,
+// masters are updated in turn, so it runs on the first master.
+type RotateEncryptionKeyAction struct {
+	Base
+
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+}
+
+// NewRotateEncryptionKeyAction returns a rotate-encryption-key action based on the config.
+// User should use this function to create a rotate-encryption-key action.
+func NewRotateEncryptionKeyAction(cfg *RotateEncryptionKeyActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if len(cfg.MasterNodes) == 0 {
+		err = fmt.Errorf("invalid action config: master nodes is empty")
+	} else if cfg.ClusterConfig == nil {
+		err = fmt.Errorf("invalid action config: cluster config is nil")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := GenActionName(ActionTypeRotateEncryptionKey)
+	return &RotateEncryptionKeyAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeRotateEncryptionKey,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.MasterNodes[0].Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.MasterNodes[0],
+		},
+		MasterNodes:   cfg.MasterNodes,
+		ClusterConfig: cfg.ClusterConfig,
+	}, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/master"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeRotateEncryptionKey, new(rotateEncryptionKeyExecutor))
+}
+
+type rotateEncryptionKeyExecutor struct{}
+
+func (a *rotateEncryptionKeyExecutor) Execute(act Action) *pb.Error {
+	rotateAction, ok := act.(*RotateEncryptionKeyAction)
+	if !ok {
+		return errOfTypeMismatched(new(RotateEncryptionKeyAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+	logger.Debug("Start to execute rotate encryption key action")
+
+	op, err := master.NewRotateEncryptionKeyOperation(&master.RotateEncryptionKeyOperationConfig{
+		Logger:        logger,
+		MasterNodes:   rotateAction.MasterNodes,
+		ClusterConfig: rotateAction.ClusterConfig,
+		LogWriter:     act.GetExecuteLogBuffer(),
+	})
+	if err != nil {
+		return &pb.Error{
+			Reason: "failed to get rotate encryption key operation",
+			Detail: err.Error(),
+		}
+	}
+
+	if err := op.Do(); err != nil {
+		return &pb.Error{
+			Reason:     "failed to rotate encryption key",
+			Detail:     err.Error(),
+			FixMethods: "please check kube-apiserver on masters, the old keys are kept until all secrets are rewritten",
+		}
+	}
+
+	logger.Debug("Finish to execute rotate encryption key action")
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeUpdateNodeConfig Type = "UpdateNodeConfig"
+
+// UpdateNodeConfigActionConfig represents the config for a update-node-config action
+type UpdateNodeConfigActionConfig struct {
+	NodeConfig      *pb.NodeDeployConfig
+	MasterNodes     []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	DryRun          bool
+	LogFileBasePath string
+}
+
+// UpdateNodeConfigAction updates labels, annotations and taints of a live node, Diff is set after it's executed.
+type UpdateNodeConfigAction struct {
+	Base
+
+	NodeConfig    *pb.NodeDeployConfig
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	DryRun        bool
+	Diff          *pb.NodeConfigDiff
+}
+
+// NewUpdateNodeConfigAction returns a update-node-config action based on the config.
+// User should use this function to create a update-node-config action.
+func NewUpdateNodeConfigAction(cfg *UpdateNodeConfigActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.NodeConfig == nil || cfg.NodeConfig.Node == nil {
+		err = fmt.Errorf("invalid action config: node is nil")
+	} else if len(cfg.MasterNodes) == 0 {
+		err = fmt.Errorf("invalid action config: master nodes is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := GenActionName(ActionTypeUpdateNodeConfig)
+	return &UpdateNodeConfigAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeUpdateNodeConfig,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.NodeConfig.Node.Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.NodeConfig.Node,
+		},
+		NodeConfig:    cfg.NodeConfig,
+		MasterNodes:   cfg.MasterNodes,
+		ClusterConfig: cfg.ClusterConfig,
+		DryRun:        cfg.DryRun,
+	}, nil
+}
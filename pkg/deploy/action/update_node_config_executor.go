@@ -0,0 +1,72 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/config"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeUpdateNodeConfig, new(updateNodeConfigExecutor))
+}
+
+type updateNodeConfigExecutor struct{}
+
+func (e *updateNodeConfigExecutor) Execute(act Action) *pb.Error {
+	updateAction, ok := act.(*UpdateNodeConfigAction)
+	if !ok {
+		return errOfTypeMismatched(new(UpdateNodeConfigAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+		consts.LogFieldNode:   act.GetNode().GetName(),
+	})
+	logger.Debug("Start to execute update node config action")
+
+	updateAction.Diff = &pb.NodeConfigDiff{NodeName: act.GetNode().GetName()}
+
+	client, err := operation.GetKubeClient(updateAction.MasterNodes[0])
+	if err != nil {
+		updateAction.Diff.Err = &pb.Error{
+			Reason:     "failed to connect to cluster",
+			Detail:     err.Error(),
+			FixMethods: consts.FixMethodSelfAnalyseIt,
+		}
+		return updateAction.Diff.Err
+	}
+
+	updateAction.Diff = config.NewUpdateNodeConfig(
+		&config.UpdateNodeConfigConfig{
+			Client:  client,
+			Logger:  logger,
+			Node:    updateAction.NodeConfig,
+			Cluster: updateAction.ClusterConfig,
+			DryRun:  updateAction.DryRun,
+		},
+	).Execute()
+
+	if updateAction.Diff.Err != nil {
+		return updateAction.Diff.Err
+	}
+
+	logger.Debug("Finish to execute update node config action")
+	return nil
+}
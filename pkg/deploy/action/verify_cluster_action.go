@@ -0,0 +1,99 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeVerifyCluster Type = "VerifyCluster"
+
+// VerifyClusterActionConfig represents the config for a verify cluster action
+type VerifyClusterActionConfig struct {
+	MasterNode      *pb.Node
+	Nodes           []*pb.Node
+	IngressNodes    []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+}
+
+// VerifyClusterAction runs a smoke test against the deployed cluster, kubectl commands are run on the master node.
+// Nodes are the kubernetes nodes, etcd only nodes are excluded.
+type VerifyClusterAction struct {
+	Base
+	sync.RWMutex
+
+	Nodes         []*pb.Node
+	IngressNodes  []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	VerifyItems   []*VerifyClusterItem
+}
+
+// VerifyClusterItem is the result of one check in the smoke test
+type VerifyClusterItem struct {
+	Name        string
+	Description string
+	Status      ItemStatus
+	Err         *pb.Error
+}
+
+// NewVerifyClusterAction returns a verify cluster action based on the config.
+// User should use this function to create a verify cluster action.
+func NewVerifyClusterAction(cfg *VerifyClusterActionConfig) (Action, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("action config is nil")
+	}
+	if cfg.MasterNode == nil {
+		return nil, fmt.Errorf("invalid action config: master node is nil")
+	}
+	if len(cfg.Nodes) == 0 {
+		return nil, fmt.Errorf("invalid action config: nodes is empty")
+	}
+
+	actionName := GenActionName(ActionTypeVerifyCluster)
+	return &VerifyClusterAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeVerifyCluster,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.MasterNode.Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.MasterNode,
+		},
+		Nodes:         cfg.Nodes,
+		IngressNodes:  cfg.IngressNodes,
+		ClusterConfig: cfg.ClusterConfig,
+	}, nil
+}
+
+// addItem records the result of a check
+func (a *VerifyClusterAction) addItem(item *VerifyClusterItem) {
+	a.Lock()
+	defer a.Unlock()
+	a.VerifyItems = append(a.VerifyItems, item)
+}
+
+// GetVerifyItems returns a copy of the check results recorded so far
+func (a *VerifyClusterAction) GetVerifyItems() []*VerifyClusterItem {
+	a.RLock()
+	defer a.RUnlock()
+	items := make([]*VerifyClusterItem, len(a.VerifyItems))
+	copy(items, a.VerifyItems)
+	return items
+}
@@ -0,0 +1,218 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/manifest"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/verify"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	VerifyItemNodesReady         = "nodes-ready"
+	VerifyItemSystemPodsRunning  = "system-pods-running"
+	VerifyItemDNS                = "dns"
+	VerifyItemPodNetwork         = "pod-network"
+	VerifyItemServiceClusterIP   = "service-cluster-ip"
+	VerifyItemServiceNodePort    = "service-node-port"
+	VerifyItemIngressReachable   = "ingress"
+	verifyFixMethodsCheckCluster = "please check the status of pods and nodes with kubectl on master, and download the log for details"
+)
+
+// verifyReadyTimeout is the timeout to wait for system pods and the verify workload to be running
+var verifyReadyTimeout = verify.DefaultReadyTimeout
+
+func init() {
+	RegisterExecutor(ActionTypeVerifyCluster, new(verifyClusterExecutor))
+}
+
+type verifyClusterExecutor struct {
+}
+
+func (e *verifyClusterExecutor) Execute(act Action) *pb.Error {
+	verifyAction, ok := act.(*VerifyClusterAction)
+	if !ok {
+		return errOfTypeMismatched(new(VerifyClusterAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+		consts.LogFieldNode:   act.GetNode().GetName(),
+	})
+	logger.Debug("Start to execute verify cluster action")
+
+	masterMachine, err := machine.NewMachine(verifyAction.Node)
+	if err != nil {
+		return &pb.Error{
+			Reason: "failed to connect to master node",
+			Detail: err.Error(),
+		}
+	}
+	defer masterMachine.Close()
+
+	logWriter := act.GetExecuteLogBuffer()
+	nodeNames := make([]string, 0, len(verifyAction.Nodes))
+	for _, node := range verifyAction.Nodes {
+		nodeNames = append(nodeNames, node.GetName())
+	}
+
+	e.check(verifyAction, VerifyItemNodesReady, "all nodes are ready", func() error {
+		return verify.NodesReady(masterMachine, nodeNames, logWriter)
+	})
+	e.check(verifyAction, VerifyItemSystemPodsRunning, "all pods in kube-system are running", func() error {
+		return verify.WaitSystemPodsRunning(masterMachine, verifyReadyTimeout, logWriter)
+	})
+
+	// the following checks share a workload running on every node
+	var pods []verify.Pod
+	workloadErr := e.deployWorkload(verifyAction, masterMachine, logger)
+	if workloadErr == nil {
+		pods, err = verify.WaitWorkloadReady(masterMachine, nodeNames, verifyReadyTimeout, logWriter)
+		if err != nil {
+			workloadErr = &pb.Error{
+				Reason:     "verify pods are not running",
+				Detail:     err.Error(),
+				FixMethods: fmt.Sprintf("please check the pods in namespace %v and make sure image %v can be pulled", verify.Namespace, verify.GetImage(verifyAction.ClusterConfig)),
+			}
+		}
+	}
+	defer verify.Cleanup(masterMachine, logWriter)
+
+	e.checkWithWorkload(verifyAction, workloadErr, VerifyItemDNS, "pods can resolve the domain of kubernetes service", func() error {
+		return verify.CheckDNS(masterMachine, pods[0], logWriter)
+	})
+	e.checkWithWorkload(verifyAction, workloadErr, VerifyItemPodNetwork, "pods can reach each other across nodes", func() error {
+		return verify.CheckPodNetwork(masterMachine, pods, logWriter)
+	})
+
+	var clusterIP string
+	var nodePort int
+	if workloadErr == nil {
+		clusterIP, nodePort, err = verify.GetWorkloadServiceAddress(masterMachine, logWriter)
+		if err != nil {
+			workloadErr = &pb.Error{
+				Reason:     "failed to get address of verify service",
+				Detail:     err.Error(),
+				FixMethods: verifyFixMethodsCheckCluster,
+			}
+		}
+	}
+	e.checkWithWorkload(verifyAction, workloadErr, VerifyItemServiceClusterIP, "pods can reach the cluster ip of service", func() error {
+		return verify.CheckServiceClusterIP(masterMachine, pods, clusterIP, logWriter)
+	})
+	e.checkWithWorkload(verifyAction, workloadErr, VerifyItemServiceNodePort, "the node port of service is reachable on every node", func() error {
+		for _, node := range verifyAction.Nodes {
+			if err := verify.TCPReachable(masterMachine, node.GetIp(), nodePort, logWriter); err != nil {
+				return fmt.Errorf("node %v: %v", node.GetName(), err)
+			}
+		}
+		return nil
+	})
+
+	if len(verifyAction.IngressNodes) > 0 {
+		e.check(verifyAction, VerifyItemIngressReachable, "the ingress controller is reachable on ingress nodes", func() error {
+			return verify.CheckIngress(masterMachine, verifyAction.ClusterConfig.GetIngressOptions(), verifyAction.IngressNodes, logWriter)
+		})
+	}
+
+	// If any of verify item was failed, we should return an error
+	var failedItems []string
+	for _, item := range verifyAction.GetVerifyItems() {
+		if item.Status != ItemDone {
+			failedItems = append(failedItems, item.Name)
+		}
+	}
+	if len(failedItems) > 0 {
+		return &pb.Error{
+			Reason:     fmt.Sprintf("%d verify item(s) failed", len(failedItems)),
+			Detail:     fmt.Sprintf("failed verify item list: %v", failedItems),
+			FixMethods: verifyFixMethodsCheckCluster,
+		}
+	}
+
+	logger.Debug("Finish to execute verify cluster action")
+	return nil
+}
+
+// check runs the check function and records the result as a verify item
+func (e *verifyClusterExecutor) check(act *VerifyClusterAction, name, description string, checkFunc func() error) {
+	item := &VerifyClusterItem{
+		Name:        name,
+		Description: description,
+		Status:      ItemDone,
+	}
+	if err := checkFunc(); err != nil {
+		item.Status = ItemFailed
+		item.Err = &pb.Error{
+			Reason:     fmt.Sprintf("verify %v failed", name),
+			Detail:     err.Error(),
+			FixMethods: verifyFixMethodsCheckCluster,
+		}
+	}
+	act.addItem(item)
+}
+
+// checkWithWorkload runs the check depending on the verify workload, the item fails with the
+// workload error if the workload is not ready.
+func (e *verifyClusterExecutor) checkWithWorkload(act *VerifyClusterAction, workloadErr *pb.Error, name, description string, checkFunc func() error) {
+	if workloadErr == nil {
+		e.check(act, name, description, checkFunc)
+		return
+	}
+
+	act.addItem(&VerifyClusterItem{
+		Name:        name,
+		Description: description,
+		Status:      ItemFailed,
+		Err:         workloadErr,
+	})
+}
+
+// deployWorkload renders the manifest of verify workload and applies it on master
+func (e *verifyClusterExecutor) deployWorkload(act *VerifyClusterAction, masterMachine machine.IMachine, logger *logrus.Entry) *pb.Error {
+	content, err := verify.RenderManifest(act.ClusterConfig)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to render verify manifest",
+			Detail:     err.Error(),
+			FixMethods: consts.MsgFixMethodsPleaseContactUs,
+		}
+	}
+
+	writeFile := manifest.NewWriteFile(&manifest.WriteFileConfig{
+		Node:             masterMachine,
+		Logger:           logger,
+		ExecuteLogWriter: act.GetExecuteLogBuffer(),
+		FilePath:         verify.ManifestPath,
+		FileContent:      content,
+	})
+	if pbErr := writeFile.Execute(); pbErr != nil {
+		return pbErr
+	}
+
+	applyYAML := manifest.NewApplyYAML(&manifest.ApplyYAMLConfig{
+		Node:             masterMachine,
+		Logger:           logger,
+		ExecuteLogWriter: act.GetExecuteLogBuffer(),
+		FilePath:         verify.ManifestPath,
+	})
+	return applyYAML.Execute()
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestVerifyClusterExecute(t *testing.T) {
+	executor := new(verifyClusterExecutor)
+
+	master := &pb.Node{Name: "master1", Ip: "10.10.10.10"}
+	act, err := NewVerifyClusterAction(&VerifyClusterActionConfig{
+		MasterNode:    master,
+		Nodes:         []*pb.Node{master},
+		IngressNodes:  []*pb.Node{master},
+		ClusterConfig: &pb.ClusterConfig{},
+	})
+	assert.NoError(t, err)
+	act.SetExecuteLogBuffer(&bytes.Buffer{})
+
+	assert.Nil(t, executor.Execute(act))
+	items := act.(*VerifyClusterAction).GetVerifyItems()
+	var names []string
+	for _, item := range items {
+		assert.Equal(t, ItemDone, item.Status)
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{VerifyItemNodesReady, VerifyItemSystemPodsRunning, VerifyItemDNS, VerifyItemPodNetwork,
+		VerifyItemServiceClusterIP, VerifyItemServiceNodePort, VerifyItemIngressReachable}, names)
+
+	// node2 is not registered
+	verifyReadyTimeout = 10 * time.Millisecond
+	act, err = NewVerifyClusterAction(&VerifyClusterActionConfig{
+		MasterNode: master,
+		Nodes:      []*pb.Node{master, {Name: "node2", Ip: "10.10.10.11"}},
+	})
+	assert.NoError(t, err)
+	act.SetExecuteLogBuffer(&bytes.Buffer{})
+	pbErr := executor.Execute(act)
+	assert.NotNil(t, pbErr)
+	assert.Contains(t, pbErr.Detail, VerifyItemNodesReady)
+
+	act, err = NewVerifyClusterAction(&VerifyClusterActionConfig{
+		MasterNode: &pb.Node{Name: "error"},
+		Nodes:      []*pb.Node{master},
+	})
+	assert.NoError(t, err)
+	act.SetExecuteLogBuffer(&bytes.Buffer{})
+	assert.NotNil(t, executor.Execute(act))
+
+	_, err = NewVerifyClusterAction(&VerifyClusterActionConfig{MasterNode: master})
+	assert.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 // we can add more static file as config in the future
 const (
 	relativeScriptsPath string = "../scripts"
+	relativeChartsPath  string = "../../../charts"
 )
 
 // define scripts as filesystem contains entries in relative path
@@ -41,7 +42,21 @@ var scripts http.FileSystem = filter.Keep(
 	},
 )
 
-// var Assets contains the deploy's scripts
+// define charts as filesystem contains the helm charts of network and ingress components
+var charts http.FileSystem = filter.Keep(
+	http.Dir(relativeChartsPath),
+	func(path string, fi os.FileInfo) bool {
+		return path == "/" ||
+			strings.HasPrefix(path, "/calico") ||
+			strings.HasPrefix(path, "/flannel") ||
+			strings.HasPrefix(path, "/cilium") ||
+			strings.HasPrefix(path, "/contour") ||
+			strings.HasPrefix(path, "/ingress-nginx")
+	},
+)
+
+// var Assets contains the deploy's scripts and charts
 var Assets http.FileSystem = union.New(map[string]http.FileSystem{
 	"/scripts": scripts,
+	"/charts":  charts,
 })
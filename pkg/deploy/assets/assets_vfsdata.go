@@ -23,123 +23,459 @@ var Assets = func() http.FileSystem {
 			name:    "/",
 			modTime: time.Time{},
 		},
+		"/charts": &vfsgen۰DirInfo{
+			name:    "charts",
+			modTime: time.Date(2026, 10, 19, 8, 26, 57, 593241185, time.UTC),
+		},
+		"/charts/calico": &vfsgen۰DirInfo{
+			name:    "calico",
+			modTime: time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+		},
+		"/charts/calico/Chart.yaml": &vfsgen۰FileInfo{
+			name:    "Chart.yaml",
+			modTime: time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x43\x68\x61\x72\x74\x20\x66\x6f\x72\x20\x64\x65\x70\x6c\x6f\x79\x69\x6e\x67\x20\x63\x61\x6c\x69\x63\x6f\x20\x6e\x65\x74\x77\x6f\x72\x6b\x69\x6e\x67\x20\x69\x6e\x20\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x0a\x6e\x61\x6d\x65\x3a\x20\x63\x61\x6c\x69\x63\x6f\x0a\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x30\x2e\x31\x2e\x30\x0a\x61\x70\x70\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x33\x2e\x31\x30\x2e\x31\x0a"),
+		},
+		"/charts/calico/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+		},
+		"/charts/calico/templates/_functions.tpl": &vfsgen۰CompressedFileInfo{
+			name:             "_functions.tpl",
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			uncompressedSize: 449,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\xd0\x31\xaa\xc3\x30\x10\x04\xd0\xde\xa7\x18\xc4\x2f\x7e\x20\xd6\x01\x02\x39\x42\xda\xb4\x46\x48\x2b\x10\x16\x52\xb2\x5a\x15\xc6\xe8\xee\xc1\xc6\x71\x13\xd7\xee\x86\x61\xd8\x07\xdb\xcd\x73\x0f\x47\x3e\x24\x82\xb2\x26\x06\x9b\x35\x89\x75\x5a\x62\x51\xe8\x5b\x5b\x07\xc1\x23\x33\xfe\x33\x43\x3f\x4d\xac\x54\xf6\x8d\xb6\x2c\x07\xa5\xb9\xfc\x96\x23\x4d\xfb\xc1\xbf\x01\xb7\x3b\x98\xde\x35\x30\x39\xa8\x47\x2d\x82\xf2\x22\x1b\xfc\x04\x13\xe3\xc2\xa5\x9c\x08\xd9\x63\xa1\x06\xcb\x72\xdd\x92\xd9\xc2\x48\x93\x3a\xa0\x59\xce\x50\xcc\x09\xc8\xf7\x61\xc2\x95\x56\x8a\x92\xdb\x59\x4a\x0e\x7d\x6b\xdd\x67\x00\x73\x84\xf5\x4f\xc1\x01\x00\x00"),
+		},
+		"/charts/calico/templates/calico-config.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "calico-config.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 17, 42, 472589988, time.UTC),
+			uncompressedSize: 2308,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xdb\x6e\xdb\x38\x10\x7d\xd7\x57\x0c\x98\x87\x7d\x59\xb9\x0d\x36\x28\x0a\xbd\x65\x13\x77\x37\x68\xe3\x14\x8d\x53\xa0\x58\x2c\x08\x9a\x1a\xdb\x84\x29\x92\xe1\xc5\x81\x57\xd1\xbf\x2f\x48\x5d\x6c\x25\x76\x10\x2b\x08\xa4\xe1\x39\x73\x39\x33\xc3\x33\x98\xaf\x85\x83\x2b\xad\x96\x62\x75\xcb\x0c\x08\x07\xc1\x61\x09\x5e\x03\x4f\xc6\x60\x11\x18\x38\x94\xcb\x7c\xad\x9d\xc7\x12\x38\x93\x82\x6b\x10\xca\x79\x26\x25\xf3\x42\xab\x49\xb6\x11\xaa\x2c\xf6\x7e\x32\x66\xc4\x4f\xb4\x4e\x68\x55\xc0\xf6\x3c\xab\xd0\xb3\x92\x79\x56\x64\x00\x8a\x55\x58\x74\x5e\xf2\x36\x48\x67\x75\x86\x71\x2c\x60\x13\x16\x98\xbb\x9d\xf3\x58\x65\x2d\xa9\xae\x73\x10\x4b\xc0\x47\x98\xfc\x64\x32\xa0\x9b\x44\xbb\xf3\xda\x22\x10\xf4\xbc\x24\xd0\x34\x19\xc0\x59\x97\x41\x4c\xda\xc7\xc2\x9e\x84\x5f\x83\x5f\x23\x48\xcd\x53\xa6\xa0\x97\xb0\xd3\xc1\x42\x64\x01\x97\xc1\x79\xb4\x93\x0c\xd2\x37\x45\x55\x1a\x2d\x94\x77\x05\x90\xba\x06\x8b\x8f\x41\x58\x2c\x81\x54\xc1\x79\x70\xe8\x13\x6c\x32\xc0\x62\x52\xc1\x09\xb5\x4a\x76\xa8\x74\x89\x64\x48\xf1\x05\xb4\x69\x48\xd6\x17\x22\x14\x97\xa1\x44\x20\xad\x0a\x2d\xd4\x4b\x47\x60\xd2\x16\x12\x0d\x94\xb3\x02\xc8\x87\x4e\x28\x87\xdc\xa2\x77\x1f\xe2\x49\xce\x19\x19\x40\x68\xfd\x49\x18\x5a\x3f\x00\x37\xb8\x3b\x85\xdb\xe0\x8e\xa4\xd4\x50\x3a\xec\x95\xbc\x49\x42\xfd\x66\xb1\xab\x70\xfe\xed\x1e\x50\xb1\x85\xc4\x32\x45\x86\xa0\xb8\xae\x2a\x54\x3e\x09\xbc\xd4\x52\xea\x27\xa1\x56\x51\xcc\x33\xf8\xa5\x03\x24\xd1\x98\x74\x1a\x8c\x36\x41\x32\x1f\x9b\x82\x70\x9f\x42\xc3\x02\xa5\x7e\x1a\x1a\xe4\x10\x96\x42\xa2\x1b\x5a\x91\x8a\x27\x10\x7d\xbd\x57\x03\x02\x67\xef\x17\x82\x9c\xf6\xbc\x97\x43\x95\x51\x8d\xc3\xf7\xae\x81\x7d\x8f\xfd\xce\xac\xd9\xa4\x97\xa5\x69\xc6\xb5\xc7\x81\x61\xa0\xb4\xca\xff\x43\xab\x61\x1b\x39\xb0\xd4\x16\xe6\x91\x06\x16\x8d\x14\x9c\xb9\x56\x8a\x58\x79\x72\x47\x1d\xda\xad\xe0\x48\xdb\x45\xe9\x66\x24\x4f\x67\xaf\xdb\xd4\xba\x12\x0e\x4a\xe1\x52\x16\x27\xfd\x28\xad\x90\x8c\x8a\x79\xb5\x31\x08\x0b\xc6\x37\xa8\xd2\xfe\x07\x87\xd1\x57\x1b\x9e\x76\x07\x05\x90\x85\xb0\x25\x39\x42\xbd\x9d\x3f\x74\xb4\x0c\x60\x8b\x7e\x4d\x2b\x1f\x0a\xa8\xeb\x61\x23\x7a\x23\x3c\x43\x89\x4b\x16\xa4\x07\x72\x7e\x71\xf1\x91\xc0\x33\x3c\x06\xed\xd3\xec\x65\x31\xa6\x12\x54\xa1\x7f\xd2\x76\x43\x79\xca\xaf\x80\xe7\x3c\x03\x00\xa8\xd3\x7f\x00\x12\xab\x22\x05\x90\xcd\x67\x97\x1b\x5d\xe6\x1d\x9e\xfc\xde\x03\xb8\xea\xaf\xa0\x08\xfb\x38\xf9\x63\x72\xbe\x3f\x34\x32\xac\x84\x72\xa4\x80\x7f\x3a\xd3\xde\x75\x7c\x88\xdf\x19\x24\x83\xfa\x03\x31\xfe\x11\xa9\x57\x54\xe2\x16\x65\x04\x08\xb5\x6c\x8f\xdf\x7b\x49\xf5\x3f\x32\xbe\x72\xa2\x2f\x4a\xa7\xf3\xab\x6b\x3a\x9d\x5d\x7f\xbf\xbb\x99\xcd\xef\x29\x1d\x07\xee\xe7\x97\xc6\x5d\x39\x20\x7c\x9d\xfe\xa2\x5f\x6e\xbe\x4d\x8f\xe2\x39\x5a\xff\x92\x70\x35\xfd\x31\x7f\x83\xc1\x8e\x93\x2e\x5f\xf1\x86\x69\x3c\x59\x78\xbc\xcc\xad\x42\x8f\xee\x65\xf9\x03\x88\xf6\x5a\x1f\x60\x47\x49\x29\x5d\x62\xdf\x6e\x4a\xbf\x3e\xfc\x39\xfd\x31\x9b\xce\xa7\xf7\x74\x76\x77\x3d\xa5\xb3\xcb\xdb\x51\x3a\xaa\x5b\xc4\xfe\x47\x2a\x1f\x48\x01\x94\x5e\xcd\x6e\xe8\xed\xfc\x81\xd2\x91\x73\x61\x58\x45\x8a\x51\xf3\x8f\x0c\x40\x9e\x60\x87\xc4\xf8\x10\xe6\x9c\x58\x29\x2a\xcc\xf6\x22\x26\x57\xd7\xa0\xb4\xdf\xcb\xd0\xae\x64\x3a\x86\xa6\x79\x8b\xfe\xa9\xa3\x1f\xdc\x2d\xc2\x6c\x3f\x19\xad\x25\xe5\xa2\xb4\xd0\x34\xde\x06\xac\xeb\x7e\xf9\x97\x4c\xba\xf4\x99\xca\x25\x07\x9e\x9b\xc3\x30\xc4\x68\x29\xf8\xee\xad\x02\x37\x9f\xdd\x69\xfa\x41\x4b\x8e\xb8\x88\xa7\xed\x7e\xee\x5b\x73\x75\x37\xfb\x72\xf3\x57\x1a\xae\xef\x97\xf3\xbf\x29\x1d\x39\xcf\x8e\x84\xa9\xb3\x23\x59\x19\x6d\x7d\xc5\xcc\x78\x3a\x9d\x62\x9e\x14\x10\x95\x18\xd9\x39\x33\x6c\x21\xa4\xf0\xa2\xcd\x33\xb1\x6f\x99\x31\x42\xad\x5c\x47\xd8\x8f\x44\xff\xf6\x6f\x06\x00\xd0\xfc\x3f\x00\x21\xf5\x67\x60\x04\x09\x00\x00"),
+		},
+		"/charts/calico/templates/calico-etcd-secrets.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "calico-etcd-secrets.yaml",
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			uncompressedSize: 941,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x93\x41\x6f\xdb\x30\x0c\x85\xef\xfe\x15\x0f\xe9\xb5\x76\x36\xa0\x28\x06\x63\xd8\x6d\x3b\x0d\xd8\x80\x16\xbd\xd3\x12\xdd\x08\x96\x25\x57\xa4\x9a\x05\x59\xfe\xfb\x20\x39\x59\xdb\xa1\xbb\x19\x14\xf9\xf1\x91\x8f\x3e\x1e\x5b\xb8\x11\xfc\x84\xee\x81\x7c\x66\xe9\x2c\x29\x89\xc6\xc4\xd8\xb0\x1a\xbb\x41\x7b\x3a\x35\x57\xb8\xdf\x31\xc6\xe8\x7d\xdc\xbb\xf0\x08\x13\x83\x92\x0b\x82\xe9\x93\xe0\x8e\x4d\x62\x15\x8c\x31\x21\x0b\x63\xef\x74\x07\xc2\xfd\xf7\x3b\x70\xa0\xc1\xb3\x45\x21\xc1\xf8\x2c\xca\xa9\x6b\xae\xf0\x2d\x26\xb8\x30\xc6\x34\x93\xba\x18\x10\x03\x96\xb8\x64\x4f\x5a\xe8\x67\xe0\x35\x84\x19\x3b\xd5\xa5\xdf\x6e\xa7\x3c\x70\x0a\xac\x2c\x9d\x8b\x5b\x1b\x8d\x6c\xb3\x70\x6a\x1f\xb3\xb3\xbc\x95\xb5\x62\xdb\xd0\xe2\x1e\x38\x89\x8b\xa1\xc7\xf3\xc7\x66\x72\xc1\xf6\x67\x5e\xa3\x87\x85\x7b\xfc\x58\xe8\x29\x73\x33\xb3\x52\x19\xb5\x6f\x80\x40\x33\xf7\x30\xe4\x9d\x89\x6d\x91\xda\x9e\x79\xe7\x37\x59\xc8\x70\x8f\x22\xa1\x95\x83\x28\xcf\xcd\x5a\x7a\xde\x9e\x0b\xc6\x67\xcb\xd8\xac\x88\xae\x20\x3a\xf5\xb2\x41\x87\xd3\xa9\x41\x1d\xbf\x9d\xf8\xd0\xe3\x78\xfc\xbb\xe8\x4b\x56\x37\xf1\x01\xbf\x31\xdc\xde\x70\x30\xaf\xf2\x0d\xbd\x9f\x6e\xe8\xdd\x6c\x4e\xfa\x9f\xfc\xa4\x6f\x0a\x8a\x68\xf6\xc2\x6b\xab\x2b\xfc\x5c\x17\xcf\xd0\x37\x16\x57\x17\x8b\x90\x6a\xa4\x89\x61\x74\x8f\x39\xad\x76\xb9\x11\x96\xc5\x25\xb6\xd7\x18\xb2\xc2\x33\x3d\x33\x06\x4f\x61\x82\x1b\x2b\x35\x44\x45\x96\xe2\x66\x29\x2f\xa7\x51\x58\x5d\x7d\x2b\xb7\x34\xf1\x41\x30\xb0\x8f\x7b\xc8\x2e\x66\x6f\x31\x30\x72\x30\x71\x9e\x39\x28\x5b\x50\xb0\x55\xd1\x73\x3d\xcb\xcb\x79\xb0\x5d\xcf\xab\xbc\x0c\x24\x7c\x7b\x53\x89\x1c\x4c\xb4\x6c\xeb\x5d\x72\x50\x41\x1c\xc1\x64\x76\x18\x9d\x2f\x83\x91\x62\x7f\x69\x42\x22\xd1\xb8\xb7\xa8\xa2\xb1\x58\xba\xea\xfb\xfa\x8b\xe6\xc5\x33\x8a\x98\x22\xa3\xaa\x2f\x1d\xca\x38\xb4\x32\x2f\x9d\xca\xdd\x28\x3e\x97\xd8\x97\xb2\xe6\xaa\x09\xed\x1e\x1f\x2a\xea\xc5\xf9\x90\xbd\x7f\x09\xad\x76\xfd\x13\xa3\x73\xa4\x3a\x14\x6c\xfd\xf3\x5e\x7f\xff\x19\x00\xa4\x44\xb9\x04\xad\x03\x00\x00"),
+		},
+		"/charts/calico/templates/calico-kube-controllers.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "calico-kube-controllers.yaml",
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			uncompressedSize: 3729,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x57\x51\x6f\xdb\x38\x0c\x7e\xf7\xaf\x20\xda\x87\xbd\xd4\x71\x77\xd8\xc3\xc1\x6f\xb9\x34\x03\x86\xa5\x69\xd1\x04\x03\xf6\x14\x28\x12\x1d\xeb\x22\x8b\x3e\x89\x4e\x67\x64\xfd\xef\x07\xd9\x4e\x63\x67\xe9\xd6\x03\xee\x70\x5b\xec\x87\xc0\x22\x29\xf2\x23\xf9\x89\xba\x84\x05\x22\xe4\xcc\xa5\x4f\x93\x64\xa3\x39\xaf\xd6\x23\x49\x45\x52\x3a\xfa\x13\x25\x4b\x61\xb4\xa4\x64\x5b\xad\x31\x96\x64\xd9\x91\x31\xe8\x7c\x24\x4a\xfd\x09\x9d\xd7\x64\x53\x10\x65\xe9\x93\xdd\xdb\x68\xab\xad\x4a\xe1\x06\x4b\x43\x75\x81\x96\xa3\x02\x59\x28\xc1\x22\x8d\x00\xac\x28\x30\x85\xd6\x5c\xfc\x8d\xb9\x76\xdd\x97\x42\x62\x0a\xcd\xaa\xaf\x3d\x63\x11\x01\x18\xb1\x46\xe3\x83\x09\x80\xed\xef\x3e\x16\x65\xf9\xb2\x1d\x5f\xa2\x0c\xa2\x97\xb0\xcc\x11\x7a\x2b\x20\x85\x05\xb2\xa6\x86\x5c\xec\x10\x04\x78\x6d\x37\x06\x41\x48\xd6\x3b\x04\x6d\x3d\x0b\x2b\x71\x14\x01\x38\x2c\x8d\x96\xc2\xa7\xf0\x36\x02\xf0\x68\x50\x32\xb9\x60\x15\xa0\x10\x2c\xf3\x59\xcf\xa3\x57\xf8\x04\xe0\xd9\x09\xc6\x4d\xdd\xda\xe0\xba\xc4\x14\x1e\x50\x3a\x14\x8c\x11\x00\x63\x51\x1a\xc1\xd8\x6d\xd1\x03\x0d\xe0\xc7\xc0\x01\x7c\x07\x3c\x80\x21\x80\xaf\x74\x38\x88\x09\x6b\x89\x05\x6b\xb2\x3d\x5d\x2f\x73\x54\x95\x41\x37\x12\xa6\xcc\xc5\x28\xe8\x3a\x8b\x8c\x7e\xa4\x29\x91\x4e\xb3\x96\xc2\xc4\x25\xa9\x14\xde\xbc\x69\xd4\x0e\x29\x09\x8f\x25\x85\x8b\x01\xa0\xe1\x5d\x23\x9f\x5a\x22\x9f\x82\xd1\xb6\xfa\xd2\x09\x31\x19\x74\xa7\xce\x5c\xc2\xad\x70\x5b\xe0\x1c\xa1\x24\x05\xc2\x83\x80\x83\x0b\x20\x94\x8a\xc9\x42\x46\x0e\x1c\x76\x6e\x6b\xbb\x19\x3d\x6b\xc7\xb0\xc5\x3a\x85\x49\xa7\x30\x56\x8a\xac\xbf\xb3\xa6\x7e\x96\x00\xa0\x32\xec\x4a\x2e\x85\xe9\x17\xed\xd9\x9f\x2a\x87\x78\x62\x47\x06\x4f\xdc\x2f\x84\x67\x74\x3d\x43\x98\x65\x28\x39\x85\x39\x2d\x3a\x04\xbb\x45\x8f\x6e\xa7\x25\x8e\xa5\xa4\xca\xf2\xfc\x15\xb9\x2e\x9d\x26\xa7\xb9\x9e\x18\xe1\x7d\xab\xd0\xa6\x3b\x96\xa6\x0a\xdb\xc6\x07\x0c\xa2\xfd\x3e\x06\x9d\x01\xfe\x05\xa3\x4f\xc2\x54\xe8\x47\xa1\x1b\x3d\x93\x43\xb8\x40\x96\xea\x02\x9e\x9e\x3a\xb3\xdf\x76\x4c\x51\x79\x06\x57\x59\xd0\xb6\xc1\x38\x27\xcf\x60\x91\x1f\xc9\x6d\x8f\x15\x07\x9e\x80\x73\xc1\xcf\x66\x34\x83\xf6\xf6\x0d\xc3\x86\x76\x01\x12\x05\xeb\x1a\x4a\x32\x5a\xd6\x8d\x20\x3c\x52\x65\x14\x94\x0e\x77\x68\x19\x34\x43\xe6\xa8\x80\x60\xb5\x97\x9f\xb0\xd9\xbc\xdd\x2b\x05\x76\x15\x76\xdf\x83\x83\x42\x5b\x74\xbd\x3a\x88\x5f\xd5\x23\xe1\xd5\x85\xd8\x60\x0a\xfb\xfd\x01\x8f\x20\x3b\x39\x8a\x8e\x1a\x81\xa7\xa7\x74\xbf\x87\x97\x44\x58\x6c\x8e\xa8\x85\x07\xed\xee\xe8\xcb\x11\x4a\x43\xb2\x29\x58\xa0\xac\x81\x0f\x59\x2a\xe8\x52\x74\xac\xc2\xbe\xff\xd3\xe5\xe4\x66\x35\x9d\xdf\xdc\xdf\x7d\x98\x2f\x17\x03\x11\x80\x5d\x70\xf8\xbd\xa3\x62\xb8\x57\x87\x49\xa6\x37\xb7\xa2\xfc\x88\xf5\x03\x66\xdf\x0a\x1c\x48\x64\xbf\xd7\x56\x9a\x4a\x21\x5c\xec\x84\xd3\xc2\xf2\x2a\x2c\x5c\xc0\x08\xbe\x82\xa1\x47\x74\x4f\x4f\x01\xb9\x4c\x6f\xce\xd8\x68\x2a\x3e\x44\xb1\x42\xab\x4a\xd2\xb6\xd7\x0f\xe1\xbd\x84\xd9\x49\xc8\x93\x31\x48\x74\xac\x33\x2d\x05\x63\xd3\x8b\x41\xff\x3b\xd1\x4f\xc6\xab\xc9\xf4\x61\xb9\x7a\xff\x61\x36\xfd\x89\x01\x90\xe2\x07\x91\x4b\xa3\x43\x71\x6f\xb1\x7e\x4d\xd4\x1f\xa7\x9f\x7f\xf6\x88\xb7\x58\xbf\x2e\xe4\x7f\x9a\xf0\x5f\x21\xdb\xe8\xf8\x24\xf8\x49\x4e\xe4\x11\x1e\x73\x2d\xf3\x01\x69\x32\x05\xca\x7c\x21\xe0\xf9\xf8\x8f\xd9\xf4\x66\x35\xb9\x9b\x2f\x1f\xee\x66\xb3\xe9\xc3\xd9\x26\x4f\x3b\xb6\xbc\x7a\xe6\xd8\xab\xee\x94\x10\xed\x29\x71\x15\x58\xd1\x90\x50\x87\x3e\xbc\x0a\xe7\xd0\x81\xed\x5f\xe2\x2d\xb4\xbb\xc0\x5b\xfb\x3d\x30\x7d\x16\x85\xf9\xae\xe0\x57\xd0\x56\x85\x0a\x7e\xfb\x5b\xab\x14\x03\x5a\x35\xe4\xbd\x1d\x99\xaa\xc0\xdb\xe0\x51\x8f\x8c\xbb\x83\x39\x7c\x3d\x1c\x1c\x0d\xf3\x2d\x67\x0b\xf0\x61\xe6\x61\x7f\x8a\x4e\x11\x84\xef\x05\xe7\x29\x24\x1d\x87\x77\x92\xd1\xb9\xb4\x06\x73\x71\x28\xb3\x3e\xfd\x38\x14\x4a\x5b\xf4\xfe\xde\xd1\xba\x9b\xa4\x0e\x0f\x7e\x39\x4e\x20\x87\x9f\xa4\xa2\x10\x56\x9d\x7e\x8e\x21\xa9\xbc\x4b\xd6\xda\x26\x32\x47\xb9\x8d\x3d\x0b\xae\x86\x3c\x17\xa4\xe2\xc3\xe1\xde\x82\xd0\x8b\xff\x07\xb1\xc3\xa3\xe6\x1c\x0a\x52\x08\xef\xae\xaf\x8f\x48\x0c\xe7\xf0\xe1\x34\xa1\x48\xfa\x44\x92\x95\x58\x72\xf3\x27\xd3\x9b\xaa\x9d\x86\x92\xd6\x6c\x12\x9d\xd6\xda\x59\x90\x5a\xe1\x61\xcc\xed\xb7\xc1\xe0\xd1\xe8\x9e\xcb\x80\xc2\x4c\x54\x86\x6f\x49\x61\x0a\xd7\xef\xae\xaf\x9b\x9a\x43\xe3\xf1\x58\x19\xbf\xc6\x01\xfd\xdf\xf6\x6f\xd3\x8e\xe7\x2c\xdc\x8c\x97\xe3\xc5\xf2\xee\x61\xba\x5a\x7e\xbe\x3f\x4b\x79\xed\xed\xa7\xcd\xfd\xff\x5a\xde\xbd\x96\x8f\xe2\x38\x8e\x06\x37\xbe\xe7\xcb\xde\x62\x30\xbe\xfe\x1b\x17\xbe\xbf\x07\x00\x27\xe9\x62\xce\x91\x0e\x00\x00"),
+		},
+		"/charts/calico/templates/calico-node.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "calico-node.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 17, 42, 472227802, time.UTC),
+			uncompressedSize: 14132,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3b\xef\x6f\x22\xb9\x92\xdf\xf9\x2b\x4a\xf0\xe1\xbd\x27\xa5\x21\x59\xcd\xcd\xcd\x71\xba\x0f\x2c\x74\x66\xd0\x24\x80\x02\x99\x7d\xab\xd3\x89\x35\xdd\x05\x58\x71\xdb\xbd\xb6\x9b\x84\xcb\xce\xfd\xed\xa7\x72\xff\xa0\x1b\x1a\x02\xf3\xde\xee\xce\xe9\x26\x68\x94\xd8\xe5\x72\xfd\xae\xb2\x5d\xb4\x60\xb6\xe6\x06\x22\x26\xf9\x12\x8d\x05\x2e\x8d\x65\x42\x18\xb0\x6b\x84\x80\x09\x1e\x28\x4f\xaa\x10\x21\x50\xd2\x32\x2e\x51\x5f\x01\x33\xf0\x8c\x42\x34\x5a\xf4\x1b\xc1\xf5\x47\x43\x88\x45\xb2\xe2\xd2\x00\x93\x21\x48\xb4\xcf\x4a\x3f\xd1\x9a\x25\x5f\x81\x92\x8d\x16\x20\x0b\xd6\x10\x31\x63\x51\x3b\x18\x02\x40\x0d\x0e\x37\x97\xc0\xe0\x73\xb2\x40\x2d\xd1\xa2\x81\x40\x24\x04\xd7\x6e\x3c\x71\x19\x76\x61\xc0\x30\x52\x72\x8a\xb6\xc1\x62\xfe\x05\xb5\xe1\x4a\x76\x81\xc5\xb1\xe9\x6c\x6e\x1a\x11\x5a\x16\x32\xcb\xba\x0d\x00\xc9\x22\xec\x96\xc9\xce\xc6\x4c\xcc\x02\xec\xc2\x53\xb2\x40\xcf\x6c\x8d\xc5\xa8\x01\x20\xd8\x02\x85\xa1\x65\x00\x4f\x1f\x8c\xc7\xe2\xb8\xba\xd6\xc4\x18\xd0\xb4\x41\x81\x81\x55\x9a\x7e\x07\x88\x98\x0d\xd6\x77\xa5\xb5\x47\x56\x03\x24\x71\xc8\x2c\x4e\xad\x66\x16\x57\xdb\x74\xb5\xdd\xc6\xd8\x85\x07\x25\x04\x97\xab\x47\x07\xe0\xc6\x75\x79\x24\xc7\x1b\xb1\x97\x47\xc9\x36\x8c\x0b\xb6\x10\xd8\x85\x9b\x06\x80\xc5\x28\x16\x05\x4c\x99\x79\x80\x2a\x4f\x27\x28\xa3\x29\x26\xa5\xb2\xcc\x72\x25\x4b\xf0\xa9\x39\x5c\x01\x13\x4a\xae\xe0\x99\xdb\xb5\x33\x84\xbe\xe6\x96\x07\x4c\xf4\xc2\x50\x49\x33\x96\x62\x0b\x56\x09\xd4\x6e\x39\x2c\x50\xa8\xe7\xab\x12\x8e\x88\xe9\xa7\xd4\x32\x62\x15\x92\x91\x30\x08\x32\x0c\xc0\xc2\xd0\x53\xf2\x0a\x50\x9a\x44\x73\xb9\x02\x6e\x61\x85\xd6\x94\x96\xc7\x9a\x2b\xcd\xed\x16\x4c\xb0\xc6\x30\x21\xb9\x38\x93\xb1\x6b\x66\x81\x5b\x03\x1a\x8d\x4a\x74\x80\x06\x98\x46\xfa\x0b\xf5\x06\xc3\x12\x06\xbe\x24\xb4\xb8\x41\xed\x70\x03\x6e\x78\x60\x31\x6c\x17\x20\x19\x66\xd4\x6d\x26\xe2\x35\x6b\x3f\x15\xc6\xd7\xe6\xaa\x93\x13\xeb\xc5\x2a\xec\xc2\x5f\xfe\xe2\x96\xe5\xd6\x40\x3f\x24\xc6\x69\xc5\x2a\xe8\xb3\x40\xbb\x8f\x49\x99\x2e\x08\x2e\x93\x97\x0c\x68\xad\x8c\x1d\xa5\xde\xd1\x05\xab\x93\x5c\x19\x3b\x69\x56\x94\x71\xcf\x9e\x10\x4c\xa2\xab\xae\x98\xf2\x94\xb3\x10\x82\x92\xc0\x84\x70\xae\x64\x76\x2c\x7a\x80\xcb\x25\x06\xb6\x0b\x23\x35\xcd\x60\x8b\x49\x00\x15\x93\xfa\x94\xee\x82\xff\xc2\x4d\x45\xfe\xf7\x4c\x3f\x9d\xd4\x1e\x2c\x95\x26\xb1\x17\xea\x29\xef\xfa\x84\xdb\x6e\x8d\xc1\x9c\xb3\x75\x99\x64\xff\x05\x83\xc4\xe2\xdb\xcb\x0c\xea\x0d\x0f\xb0\x17\x04\x2a\x91\x76\x54\x13\x01\x32\xae\xb8\xe4\x11\xff\x6f\x84\x50\x3d\x4b\xcb\x23\x84\x30\x35\x40\x96\x3b\x1f\x24\xf1\x4a\xb3\x10\x41\x69\x08\x51\x20\xa9\xe3\xdf\xc1\xa2\x10\xe5\xe0\x64\x15\x84\x0a\x18\x34\x97\x4a\x07\x3b\xf4\xf9\x82\x66\x17\xd6\xd6\xc6\xa6\xdb\xe9\x54\x4d\x21\x54\x81\xe9\x04\x4a\x06\x18\x5b\xd3\x21\x0b\x10\x8a\x85\xa6\x13\xab\xf4\xbf\x4e\xcb\xa2\x8e\xb8\x74\x3e\xe5\xa9\x25\x19\x5f\xa1\xce\xd2\xd4\x47\xcd\x02\x9c\xa0\xe6\x2a\x9c\x62\xa0\x64\x68\xba\x70\x9d\x81\xe5\x9e\xd3\x17\xcc\x98\x54\x14\x69\xb8\x73\x86\xe3\xe5\x6a\xcc\xa0\xb9\xe4\xb6\x9f\x87\x75\xd3\x6d\xbc\xbe\x7a\xc0\x97\xf0\x57\xfc\x15\xda\x5f\x98\x48\xd0\xb4\x29\xb6\x18\xab\x34\x42\x73\xc7\x4d\xf3\x6f\xf0\xf5\xeb\x5e\xc8\xd8\xe5\x07\x88\x51\x2f\x95\x8e\x4c\x21\xce\xa5\x56\x91\xb3\x7c\x4f\x28\x0a\x01\xc3\x49\xef\x1e\xac\xca\xb5\xc4\x63\x16\xed\x0c\xa8\x05\x43\x0b\x01\x93\xb0\xc0\x54\x09\x18\x12\x51\x96\xf6\xe0\x64\x8b\x4b\x8d\x66\x9d\xe7\x29\x27\x91\x2b\x52\x18\x5f\xc2\x56\x25\xb0\x66\x1b\x04\x26\x34\xb2\x70\x67\x72\xad\x9c\x94\x90\xb6\x4d\x0c\xd6\x6f\xed\x65\xf9\x23\x03\x76\xb3\xc5\x24\x00\x8f\xd8\x0a\xbb\xf0\xfa\x9a\x0b\x27\x90\xbc\xed\x06\xbf\x7e\xed\xbe\xbe\x16\x32\xa3\x61\xcb\x56\x65\x19\x01\x04\x2a\x8a\x18\x25\xb3\xff\x6c\x76\x54\x6c\x3b\x81\xe4\x9d\x05\x97\x9d\x12\x21\xcd\x2b\x68\x7a\xd9\xde\xcd\xff\x2a\xad\x45\xb9\xd9\x05\x85\x32\xa1\x9f\x1f\x7f\xf4\x1f\x46\xfe\xcc\x9f\xce\x47\xe3\x81\x3f\x1f\xf5\xee\xfd\x0a\x20\xc0\x86\x48\xbd\xd5\x2a\xaa\x62\xa0\x9f\x25\x47\x11\x3e\xe0\xf2\x70\x26\x9b\x9b\x30\xbb\xee\xba\xb8\xd7\x26\xf3\x21\x7b\xaa\x25\xa3\xdf\xbb\x1b\xf6\xc7\xf3\x91\x3f\xfb\x69\xfc\xf0\x79\x38\xfa\x38\xff\xb1\xd7\xff\xec\x8f\x06\xe7\xd3\x92\x56\x0a\xf7\x2c\xfe\x8c\xdb\x23\x24\x55\x72\x7b\x0a\x5f\x03\xe5\xa2\x4f\x0a\x34\x5f\xb0\xe0\x09\xe5\x2e\x2f\x00\x6c\x94\x48\x22\xbc\xa7\x40\x51\x8a\xb3\xf4\xf1\x20\xa2\xd1\x94\xe5\xce\x86\xe9\x8e\xe0\x0b\xa7\xa5\xac\x98\x31\x8d\x3a\x72\x76\x56\xed\x49\xb4\x5e\xc8\xf5\x09\xac\x04\x5c\x56\x7e\x2d\xc6\x40\x72\x6f\xc1\xa5\x43\x45\x0e\x89\x32\x3c\xe9\x6d\x95\x82\x8d\x0a\xb1\x05\x97\x4c\x73\xdc\xd1\xdb\x72\xc9\x93\xa6\xf6\xea\xb2\x25\x17\x48\xc9\xc3\x95\x66\xa4\xe1\x43\x57\xc8\xb0\x7b\x81\xe4\xbf\x87\x27\x94\xd0\xb7\xcd\xfa\xb4\xd1\xb7\x80\x0c\x10\xd4\xb2\xe0\xb4\xcc\x06\x85\x13\x8d\xcc\x62\xbb\xde\x44\x47\xc3\x79\x7f\x3c\xba\x3d\xea\x22\x5d\x68\xde\x5c\x7b\xa9\xe1\xb4\x09\xb1\xe0\xc6\x36\x2b\x90\x24\x7a\xac\x93\xa3\x55\xb9\x9c\x8e\x48\x73\x9f\x92\xcc\x53\x1c\x45\xc3\x8f\x75\xe4\xfc\x21\x5e\x22\xf9\x3c\xe3\x64\x9e\x41\x66\x19\xa0\x3e\x01\xa0\x0d\xc2\x66\x55\x97\xb9\x50\x28\xae\x53\x24\xce\xb5\x43\xa0\xbb\x2a\xbe\x4e\x0c\xfe\xac\x3f\x98\xfb\xa3\xc1\x64\x3c\x1c\xcd\xa6\x7f\x96\x08\x88\xce\x39\xca\x30\x56\x5c\x5a\xe3\x12\x20\x0a\x83\xc7\x65\x50\x4a\x82\x87\x92\x98\xa2\x75\xb6\x49\x7e\x4e\x5c\xc2\x82\x99\xb4\x3c\xa3\xd1\xa7\x0f\xc6\x99\x85\xa3\xaf\xfd\xbd\xc4\xf3\x9a\x10\x43\x9f\x96\xb3\xf3\xfb\xd9\x23\xf4\x9d\x65\xc0\x86\x69\x4e\x87\x90\x5a\xba\xc9\xa6\xef\x67\x8f\xe7\x93\xfa\xcf\xd5\xe2\x06\xed\x7a\x1e\xd9\xa4\x32\xdf\x82\x89\xc6\x0d\x4a\x9b\x1d\x65\x8b\x80\xe9\xaa\x11\x23\x10\x63\x2a\xf9\x96\x8a\xa0\x8e\x18\xe9\xf4\xce\xf7\x27\x75\x5c\x75\xa1\xb9\x64\xc2\x60\x33\xaf\x99\x72\x53\xa1\x40\x86\x72\x43\xb6\xf1\xfa\x0a\x56\xfd\xcc\x22\x71\x30\xf9\x1b\x70\x19\xa2\xb4\x70\xf3\x43\x0a\x58\xa3\x82\x73\x33\xd5\xc5\x39\xe5\x0d\x54\x68\x83\x3c\xeb\xb5\xc3\xa3\xc8\xf2\x5c\x77\x79\xbc\xa8\x6e\x99\xa9\xd7\x60\xa0\xcb\x47\xc0\xf2\x76\xe4\xa2\x5e\x80\xda\x9a\x3a\x41\xb5\xa0\x17\x86\x54\x15\xde\x0a\x7c\x81\x2f\x4e\x68\x30\xd0\x9c\x4e\x7e\xee\xb8\x98\x26\x05\x82\x88\x51\x53\x49\x0d\x8f\x92\xbf\xc0\x40\x45\x8c\x4b\x98\xaa\xe0\x89\x9c\x56\xd1\x01\x4a\x3d\xc3\x80\x3f\xd1\x0d\x45\x39\x77\x52\x62\x51\x51\x94\x48\x1e\x30\x8b\xe9\x91\xf8\x16\x05\x7f\x01\x95\x6e\x82\x30\x51\x82\x07\x5b\x98\x6e\x65\x00\xbd\xc9\xb0\xdd\xd8\xb7\xa3\xa5\xc0\x97\x8d\x12\x5e\xe8\x08\x3b\x95\x48\x33\xc8\x52\x32\xdd\x9f\xb1\x6c\x75\x96\xa1\xd4\xef\xed\x91\x92\x4b\x50\x70\x68\x01\x15\x22\x8b\x3a\xa3\x84\xbb\x05\x0f\x89\x34\xf5\x97\x43\x45\x02\x2c\x1d\x98\x08\xa2\x0d\xee\x94\x50\xc2\xb1\x5b\x12\x6b\xb5\xd2\x2c\x32\x45\x5a\x8d\x53\x79\x52\xd5\xa2\x55\x42\x67\xae\x0c\x6b\x69\x39\xf1\x71\x28\xe8\x12\x4d\xa7\xa4\xec\x28\x3a\x14\xb1\x1b\xde\x97\xaf\xab\x43\xfe\x9f\xe7\xc5\x7c\x8e\x7e\x5a\x70\xb7\xc7\x54\xbf\x07\xe4\x9f\x7c\x99\x7a\x08\xdd\x0d\xd0\xfa\x13\xfc\xf5\x7b\xf3\xbe\xff\x30\x9b\xdf\x0e\xef\xfc\x3f\x95\xc5\x80\xbd\xc1\x5b\x20\x38\x05\xea\x27\xdc\x9e\xc3\xd7\x67\xff\xe7\x3f\x9f\xa7\x27\xdc\x9e\xc7\xd4\xa5\x4a\xfb\x3e\x34\x86\xba\x1a\xbf\xd2\xb2\x8b\x5c\x57\xe3\xd2\x29\xa9\x88\x48\x74\xad\x73\xcc\xcf\xb2\x33\xeb\xe7\x0f\x59\xa9\xf5\xe0\xdf\x9e\xcf\xd7\xb7\x57\x5a\xdf\x5e\x5c\x3e\x1a\x2c\xdf\x42\xf5\x26\xc3\xfc\xf6\x9d\x8e\xb9\x54\xca\x14\x98\xea\x39\x1e\xf4\x66\xbd\xe9\x6c\xfc\xe0\xcf\x67\x3f\x4f\x6a\x95\xd8\xad\x90\xd0\x00\xc8\xc2\x5e\x4e\xaa\xdd\xd2\x55\x29\x4a\xaa\x03\xf7\xca\x15\xa2\x70\x46\xd3\x60\x92\x38\x56\xda\x76\x77\x1a\x08\x61\xb1\x75\xce\xd4\xcf\xcd\xa1\x9e\xc0\x5b\xff\x6e\xf8\x77\x22\xee\x53\xef\xf3\x87\xe9\xd4\x7f\xf8\x32\xec\xfb\x97\x95\xc0\xff\x5c\x83\x73\xfc\xce\xb3\xfb\xc5\x39\x11\x99\xc9\xe4\xa0\x5a\x23\xf6\x7f\x62\xdc\x3a\xfb\x23\x56\xdf\xd0\xc5\x4f\xbd\xe1\x6c\x7e\x3b\x7e\x98\x17\x4a\xa9\xe3\xb1\x0b\x4d\xba\x22\xde\x3f\x85\x92\xbd\x5f\x7a\xb2\x20\x1b\xff\x53\x4f\x13\x6b\xa5\x0c\x16\xe6\x4a\x02\x4c\x6f\xe2\x4e\x7a\xe7\xff\x81\x1b\x25\xc7\x5c\x9a\xd0\xdd\xdb\x0e\xd5\x92\x9c\x8a\x7b\xbe\x4c\x8d\x3e\xc4\x58\xa8\x6d\x44\x59\x84\xe6\xeb\xd9\xbd\x7b\x9c\xce\xfc\x87\x93\x8e\xf9\xc1\x5c\x2d\x56\xf1\xbe\x2d\xf4\x12\xab\xbc\x10\x2d\x06\xe9\xd1\xf3\xc7\x8f\x13\x18\x4e\xe8\x9a\x5e\xa3\x29\x3d\x06\x00\x1c\x78\x73\xc8\x0d\x39\xf2\x9c\xc7\x9b\x77\x87\x0a\x1b\x4e\x36\xef\x80\x1b\xc8\xa0\x42\xf7\x46\x27\x61\x38\xd9\xbc\x07\x45\xef\x3f\x59\x15\x73\x95\x96\x68\x1a\x78\x48\xe0\x2b\x94\x74\x49\x8f\x61\x7a\xcc\x5a\x33\xb3\xa6\xac\xf3\x86\x75\x0e\x8f\x1d\xb2\xa4\x92\xd8\xac\x5d\x92\x59\xc8\xc3\xf8\x91\x04\x37\xac\x35\x8c\x2e\x34\x89\x80\x2a\x82\x23\x61\xb8\x7a\x11\xd2\xe6\xf1\x3c\x95\x2a\x57\xb2\x1d\xa1\x5d\xab\x10\x9a\xc4\xd2\xbc\x14\x21\xbf\x7e\xad\x25\x6d\x38\x39\xdf\x4a\x0b\x2f\x83\x37\xdc\xcc\x32\x9b\x98\x76\xac\xc2\xe1\xa4\x9e\x9d\x8b\x88\xe9\x42\x93\x25\x56\xa5\x2c\x1e\xca\xe7\x72\xd1\x70\x69\x51\x2f\x59\x80\xcd\xa3\x74\xcc\x7b\x8f\xb3\xf1\xc0\x9f\xf9\xfd\xd9\x70\x3c\x9a\xdf\xfb\xb3\x4f\xe3\x23\x6a\x2b\xb0\xfd\xc7\xeb\xeb\x59\x64\x14\x0b\xf6\xc5\xf0\xb6\x78\x2e\x20\x6b\xc9\xb5\xb1\xde\x52\x25\x7b\x11\xe0\x48\xd4\x3b\x31\x5c\x72\x43\x1e\x6f\xde\xc7\x4a\x89\x79\xc0\x43\xbd\x0f\xdb\x82\xc1\xce\xb7\x9d\xef\x65\x9e\x9d\x3b\xd5\x55\xea\x8c\x6e\x8a\xfe\xce\x3d\x9f\x7c\xb1\x78\x38\x26\xdf\xa5\x93\x6f\x6a\x44\xb4\xf4\xc0\x8d\x6b\x23\x05\x1d\xc0\x6a\xa3\xc5\x5f\x2f\xb4\x8e\x7d\xc7\xf9\xdb\x71\x75\xbc\xaf\x13\xfd\x1b\xbe\xf3\xbb\xb9\xce\xfb\xef\xc5\x77\xde\x7f\xa7\xce\xf3\xfe\x0f\xf7\x9e\xf4\x5c\x1d\xe2\x92\x25\xc2\xa6\xe9\x88\xbc\x67\x77\xff\x4f\x37\x10\xc6\x32\x6d\x93\x98\x42\x3c\x65\x10\x40\xf7\xcc\xdc\x3e\x95\x48\x86\x93\x2f\xef\x27\xe3\xf1\xdd\xbc\x3f\x1c\x3c\xd4\x33\xf0\xfa\x7a\xc4\x69\x7f\x83\x5f\x13\x65\xf1\x4c\x0e\x32\x23\xa9\x75\xac\xaf\x5f\xbf\x21\x57\xa1\x0c\x58\x3c\x8f\xc8\xfb\x9b\x3c\xe6\x71\xcd\xe1\xc1\x77\x35\x3b\x0c\x27\xc3\xc9\x1b\x42\x78\xe7\x84\x70\x00\x58\x08\xa1\xd9\x13\xcf\x6c\x6b\xaa\x76\x9f\xd6\xa4\x74\x5b\xec\xea\xdf\x44\x4a\x14\x10\x52\xd3\x05\x52\x8d\xe7\x1e\x70\x89\x32\x8a\x4a\xd9\xf1\xa1\x96\x8c\xf4\x04\x30\x9c\x0c\x47\xc3\xc9\xf7\x76\x9f\x7c\xb9\x2e\x36\x2f\x82\xc9\x03\x65\x7c\x83\xc8\x47\x74\x43\xdd\x3c\x0b\xcb\x97\xbf\xdf\xf5\x46\x17\x68\x2e\xc7\x93\x4a\xde\xad\xfe\xde\x04\x5f\x43\xe2\x64\xfc\x30\xab\x67\xf2\x78\xc8\x73\xda\x98\xd3\xd1\x14\x7e\x2b\xe2\x47\xf3\xdd\xbf\x7e\xf8\xb7\x66\xc9\x81\x73\x74\xf9\xbf\x0b\x1c\x5a\x2a\x5b\xec\x7d\xca\xa9\x0f\x22\xd8\xbb\xf3\x23\x18\x4c\x54\x08\xc3\x89\x81\x67\x2e\x04\x2c\xaa\xa7\x89\x16\x04\x6b\x65\x50\xa6\xa5\xb7\x6b\x98\xd0\x4c\xae\xb0\x0d\xfd\x35\x93\x2b\xba\x22\x70\x83\x4e\x9d\xc0\x96\x76\xf7\x7a\x9c\xde\x79\x39\xa4\xd4\x40\xb1\x87\x56\xaa\xac\x2f\xa7\xed\x2e\x71\xc1\xac\x55\x22\x42\x58\x52\xd3\x11\xdd\x87\x73\x09\xbf\x78\x5e\x56\x49\x78\x14\x12\x7f\x79\x2b\xd6\xbe\xbb\x28\xd6\xbe\x2b\xc7\xda\x42\x77\x37\xd7\xed\x9b\x9b\x1f\xda\xd7\xed\xeb\xce\xcd\xfb\xb2\x12\xcf\x51\x5a\x0b\x06\xa9\x8e\xd2\xe7\x63\xa1\x56\x4e\x40\x46\xc1\x2f\x54\xe1\x07\x56\x80\x50\x2b\xf3\x0b\x90\x0d\x99\x93\xec\x0c\x86\xd3\xde\x8f\x77\xbe\xbb\xf9\x9b\xdf\x8d\x3f\x7e\x1c\x8e\x3e\x5e\x78\x9c\x4f\x1f\x14\xf2\x0b\x57\x4a\x66\x74\xbf\x5d\xb0\xca\x5c\xa2\xa6\xe1\x5e\xbf\xef\x4f\x66\xf5\xe4\xa4\x0e\x32\xf0\x6f\x7b\x8f\x77\xb3\xfc\x0a\x79\x36\xfe\x34\x9e\xce\x7a\x2e\x3d\x1f\x21\x2a\xc5\xd9\x3c\x96\x32\x5c\xa5\x58\xbe\x7a\x72\x15\x27\xcf\x8a\x48\xd2\x0c\x85\xf5\x15\xdf\xa0\x3c\x45\x17\x25\xd8\xe9\xe3\xe4\xa4\xeb\x9e\x28\x8b\xd3\x9b\x90\xd7\xd7\xbc\x20\xc9\xde\xdf\x5e\x5f\xeb\x95\xbb\x13\x6a\xae\x59\xab\xa8\xd2\x5a\xaa\xe6\x09\x1a\xef\xc6\x1f\xa7\xfe\x17\xff\x61\x38\xfb\x79\xda\x7f\xf0\xfd\x63\x91\xf4\x2d\x3c\x9f\xfc\xde\xdd\xec\x93\x3f\x22\xb3\x18\x9c\x34\x85\xbd\x62\x80\x4a\xf8\xe3\xef\x87\xc5\xec\x39\x0f\x88\x06\x83\xc4\xb5\x82\x29\x69\xf1\xc5\x56\x23\x73\xac\xf9\x86\x0b\x5c\x61\x58\xe9\x42\xa4\x4f\xd1\x5b\x59\x5d\xa1\xf1\xd7\x04\xcd\xfe\x5b\x24\x40\x10\x27\x5d\xf8\xe1\x5f\xae\xa3\xd2\xb8\x20\x5b\x40\x63\x26\x5a\x2d\x8a\x5e\xd6\xec\x35\xe5\x65\xd7\x44\xb9\xdf\x10\xb2\x37\xec\x41\xb9\x41\x6a\xef\x49\x87\x3e\x1e\x78\x4b\xf2\x1b\x8f\xf6\xab\xcc\xc5\xd5\xee\xb8\x9b\xbc\x3d\x2e\x7b\x0e\x92\xdc\x72\x26\x06\x28\xd8\xf6\x18\xcc\x92\x71\x91\x68\x9c\xad\xa9\xd9\x4c\x89\xb0\x0b\xe5\xb3\x00\x75\x97\xf1\x3f\x92\xc3\x6a\x3b\x5b\x31\xb9\xe0\x3a\xac\x99\x3b\xc5\xfe\xb9\xef\xca\x82\x2f\x3a\x91\xa2\xfe\x52\xd3\xa8\xcb\xe7\x82\x2f\xbc\xfa\x79\xa2\x87\x1a\x3e\x0f\x2c\x6b\x7f\x0b\x9d\xc8\xce\x8b\xa5\x28\x63\xda\x42\x05\x4f\x7b\x78\xd2\x7d\x32\x00\xaf\x06\x60\xb7\x91\x8b\x05\x27\x76\xa2\x76\x2e\xda\x2d\x2d\x42\xf6\xd0\xa4\xfb\x6c\x98\xf6\x74\x22\xbd\x5a\x90\xcb\x76\x22\xd1\xbd\xb1\x13\x49\xef\xac\x9d\xb2\xf8\x50\x2a\x38\xdf\x7a\x78\xac\xd2\xf3\x0f\x3f\xb0\x97\x83\x5b\xfa\x2e\x6b\xb6\x32\xd8\x43\xb4\x2f\x01\x92\x35\x99\x33\x5b\xa1\xcc\x5f\x6b\x52\xc3\x2b\xd9\x9c\x7b\xd2\x70\x6f\x03\x25\x07\x68\x37\xf6\x37\xad\x37\x34\x4a\x8f\x4e\xb9\xa5\x31\x80\xf8\xb8\xed\x7a\x6f\xe9\xf9\x24\xc6\x23\x06\xe4\xbd\xa5\xd2\x37\x91\xd6\xd8\x8a\x77\xda\xf2\x4f\xa2\x3c\xe9\x52\x74\xfd\xdc\x85\x5b\x2e\x70\xac\xfb\xae\xd2\xdc\xd7\x45\xa9\x91\xad\x3f\xaa\xe9\x63\xa8\xef\x23\x39\x49\x51\x5d\xbb\xa3\x57\xd3\x49\x72\x26\xba\x6a\x7b\xca\x65\xde\xd1\x02\x17\xf6\xe8\x36\xac\x78\x8b\x9f\xdd\x4d\x21\x73\x8e\xb4\xb5\xc3\x1d\xa3\xdf\x5d\x5f\xef\x98\xa7\x62\x02\xcf\xea\xe9\x4e\xcf\xe3\x49\xda\xc7\xdf\x49\xd1\x76\x0e\xb8\x2e\xf9\x5b\x3e\x05\x19\x0d\x55\x96\xd3\xb1\x4a\x43\xbb\x5b\x5b\xe7\xcc\x59\xa5\x78\xaf\x42\xec\xc2\xf5\xbb\xeb\xeb\xca\x73\xe3\xc5\x1d\xdd\x15\x41\x85\x5c\xbb\x2f\x3a\xa4\x4f\xe0\xfb\x7d\xdc\xd4\x38\x93\x3e\x2f\x9b\xec\x78\x50\x69\xf3\x70\xc7\xff\xe7\x35\xca\xac\x05\x9b\x4a\xb1\xfd\x7e\xf0\x6a\x2b\xf8\x95\x6b\x57\xcd\x3a\xc0\x35\x46\xea\xe0\xab\x1d\x74\xd2\x4a\x0c\x61\x22\x3d\x96\xbb\xb5\x5d\x4b\xfb\xae\x1b\xe5\xd0\x82\x77\xbb\x5e\x6a\x79\xb5\x2d\xc1\x35\x01\x73\xe7\x4a\xd9\x69\xee\x78\xdf\x91\x39\x20\xaf\x36\xc2\xd6\x93\x95\x7a\xf3\x20\xd7\xcd\x81\x4b\xef\x91\x5e\x17\x92\xeb\xfc\xfe\xb0\x8f\xea\x80\xc8\xd3\x1d\x45\xff\x30\xb5\x89\x71\x82\xc6\x17\x0c\x4a\xde\xe6\x7e\x15\x68\xbd\xec\x7b\x66\x9d\x34\x9f\x74\x1c\x58\xc1\xd8\xff\x24\xa1\x69\x78\x9e\xd7\xa8\x7c\x53\x6c\x73\x93\x7d\x91\x6c\x5a\xf9\xaa\xc8\xb7\x7e\x73\xac\xf1\xbf\x03\x00\x0f\xee\xd0\xd5\x34\x37\x00\x00"),
+		},
+		"/charts/calico/templates/kdd-crds.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "kdd-crds.yaml",
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			uncompressedSize: 4090,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\xd7\x41\x6f\x9b\x30\x14\x07\xf0\xbb\x3f\x85\xd5\x3b\x4c\xb9\x4d\xdc\xda\x6c\x6b\x73\x58\x85\x36\xa9\x77\xc7\x3c\xa8\x17\xe3\xe7\xd9\x26\x6b\x55\xe5\xbb\x4f\xc6\x74\x89\x81\x54\x9b\x56\x9c\x63\x79\x86\xf7\xe7\xc7\x83\x3a\x2f\x2f\x19\x15\x35\x85\x9f\x34\x7f\x60\xb2\x03\x9b\x57\xcc\x31\xeb\xd0\x00\xbd\xda\x75\x5b\x30\x0a\x1c\xd8\x2b\x9a\x1d\x0e\x84\x69\xf1\x00\xc6\x0a\x54\x05\x65\x5a\xc0\x93\x03\xe5\xff\xb2\xf9\xee\xa3\xcd\x05\x7e\xd8\xaf\xb6\xe0\xd8\x8a\xec\x84\xaa\x0a\xba\xee\xac\xc3\xf6\x1b\x58\xec\x0c\x87\x4f\x50\x0b\x25\x9c\x40\x45\x5a\x70\xcc\xb7\x29\x08\xa5\x54\xb1\x16\x0a\x5a\x83\x14\x4f\x1c\x55\x2d\x9a\xce\x30\xbf\xca\xe6\xdc\x54\xb9\x36\xf8\x03\xb8\xe3\x4c\x0a\x8e\x39\x9a\x86\x58\x0d\xdc\x9f\x68\x39\x6a\x28\xe8\x5a\x76\xd6\x81\x21\x94\x36\x06\x3b\x5d\xd0\xf9\xb3\x28\xdd\xbf\x26\xdf\xaf\x48\xe8\x6a\xfd\x65\x28\x0d\x61\xbf\xf8\x00\xeb\xd3\x00\x7d\x51\xcb\xce\x30\x39\x9b\xaf\xaf\x5b\xa1\x9a\x4e\x32\x33\xb7\x82\x64\x59\x46\x16\x41\xf3\xe9\x0b\x2a\x34\x6b\xb7\x12\xf9\x2e\x31\xd5\xa6\xbc\xfe\x7a\xe3\xfb\x46\x42\xc7\x34\x23\x98\x3f\x05\xb2\x34\x48\xdf\x85\xd5\x81\x0c\x12\xab\xf4\x22\xd7\xa1\xf9\x73\x24\x33\x8a\x35\xe2\x39\xad\x3e\x2f\x4e\xe4\x1f\xc6\x23\x53\x95\x4c\xcd\xe3\x87\xe6\xae\x6f\x3c\x99\x9a\x21\xcf\xcc\xd8\x84\x4a\x12\x94\xf0\xed\xb9\x00\x4a\xf8\xe6\x4c\x50\x86\x3c\x33\x28\xa1\xb2\x38\xca\xb6\xd1\x1a\xc0\x24\x16\xb9\xb9\x2d\x4b\x00\x13\x71\xbc\x26\x19\x59\x0c\x87\x53\x40\xc4\x1f\xfe\xe4\x22\xe7\xff\x2d\x4d\xb2\x4d\x8d\xa2\xfa\xe2\x58\x42\x6b\x44\x99\x98\x68\x53\x96\x88\x32\x82\x19\x72\x8c\x38\xc2\xd1\xc5\x11\x1e\xd1\x3a\x50\x95\x46\xa1\x5c\x62\x8a\x3b\xb4\xee\xf3\xd0\x3a\x02\x89\x32\x8d\x58\x4e\x6b\x8b\xe3\xf0\x70\xa7\x42\xd5\x68\xda\x4b\xbc\x50\xc3\x75\x36\xc7\x00\x11\xd4\x4c\xbe\x11\xd7\x74\xc5\xe2\x68\x8d\xc4\x2d\x93\x0a\xdc\x2f\x34\x3b\x8d\x52\xf0\xe4\xfb\x9b\xdb\x3e\xc2\x7d\x88\x50\xfa\x08\xf1\x2e\x67\x36\xe2\x48\x6e\xba\x66\xf9\x1d\x4f\xd4\xd3\x82\xbb\x24\xdb\x77\x70\xe7\xcd\x7c\xb6\xb7\xbc\x2c\x2c\xff\x72\x8e\x1e\xdf\xdf\x51\xdd\xfb\x7b\xd6\x8c\x43\xf5\xdf\x5a\xe7\xc7\xeb\xed\xc1\x4a\x3b\x52\xff\x3c\x4c\xef\x2f\x34\x9e\xa4\xf3\x33\x74\xac\x10\xe2\x7f\xed\x83\xaa\x68\x76\x38\x90\xdf\x03\x00\xfb\xc9\x16\x15\xfa\x0f\x00\x00"),
+		},
+		"/charts/calico/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
+			uncompressedSize: 5965,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x58\x4d\x6f\xe3\x36\x13\xbe\xfb\x57\x0c\x92\xeb\xda\x8b\xf7\x3d\x14\x85\x6f\xbb\x59\xa0\x08\x8a\x06\xc1\x7e\xf5\x50\xf4\x30\xa2\xc6\x32\x6b\x8a\xc3\xe5\x90\xde\x4d\x17\xfb\xdf\x0b\x52\x92\x25\xc7\x52\xec\x38\x69\xd1\xa2\x85\x4f\x92\xc8\xe1\x7c\x3c\xf3\xf0\x19\x7f\xfd\x3a\x07\xbd\x02\xfa\x04\x8b\x8f\x68\x22\xc9\xa2\xc4\x80\x12\xd8\x13\x5c\x50\x50\xe5\x05\x7c\xfb\x36\xbb\x84\x6b\xab\x4c\x2c\x09\x10\x94\x89\x12\xc8\x7b\x36\x04\x2b\xf6\x10\xd6\x04\x9b\x58\xd0\x5c\xb1\x0d\x9e\x8d\x21\x2f\xa0\xb8\x76\x6c\xc9\x86\x17\xb3\x4b\x40\x5b\x42\xa1\x6d\x09\x3a\x40\xe0\xbc\x41\xa1\xd1\x8a\xe7\x07\xfb\x84\xfc\x56\x2b\x42\xa5\x38\xda\xb0\x98\x6d\xb4\x2d\x97\x70\xd5\x1c\xf9\x96\x0d\xcd\xd0\xe9\x8f\xe4\x45\xb3\x5d\x82\x2f\x50\x2d\x30\x86\x35\x7b\xfd\x3b\x06\xcd\x76\xb1\xf9\x5e\x16\x9a\x5f\x6e\xff\x37\xab\x29\x60\x8a\x65\x39\x03\xb0\x58\xd3\x72\xea\xd0\x99\x8f\x86\x24\x2d\xbb\x84\x5b\x2e\x05\xd0\x13\xd4\x6c\x75\x4a\x42\x99\x63\x54\x6b\xb4\x95\xb6\x15\x18\x2c\xc8\xc8\x22\xaf\x7d\xbf\x26\xb0\x5c\x12\xf4\xb6\xba\x6d\x02\x3f\xc6\x82\xbc\xa5\x40\x92\xd7\xb4\x5b\x6e\xb0\x26\x71\xa8\x28\xe7\x64\x3f\xd8\xd6\x76\x3e\x3d\x4a\x7b\xb0\x63\xa3\xd5\x5d\xda\x3c\x07\x74\xfa\x07\xcf\xd1\xc9\x12\x7e\xb9\xb8\xf8\x75\x06\x00\xe0\x49\x38\x7a\xd5\xb8\x9f\x7e\x73\x70\x5c\xca\xee\x21\x9f\xdd\x3f\x75\xc7\xf7\x0b\xf6\x7d\x68\xde\x6f\xc9\x17\x03\x83\x9f\x31\xa8\xf5\xee\xc9\x68\x09\x39\x96\x9f\xd3\xeb\x3e\x3b\x24\xa9\xb6\x83\xb0\x6f\x28\x7c\x66\xbf\xb9\x4d\x11\x68\x92\x91\x18\x6c\xb3\x42\xdb\xaa\x2d\xdb\x74\x50\xed\x52\xd7\x1a\x3b\xd1\xcd\xf9\x7c\x7e\x88\xa0\xd7\xda\x96\xda\x56\x7f\x0a\x90\xd8\xd0\x5b\x5a\xa5\x85\x5d\xa0\x0f\xd8\x9e\x01\x1c\xc2\xfb\xd8\x11\x12\x8b\xdf\x48\x05\x59\xce\xe6\xed\xee\x77\x4d\x05\x5f\x35\x15\x3c\x6a\x00\x7a\x14\x2c\x9b\xbe\x95\x3b\x09\x54\xe7\x64\x25\x36\x20\x23\xf4\xaf\xea\xf9\x9b\xd4\x23\xb9\xed\x32\x84\xa8\x4c\x40\x6e\x1b\x39\xe3\xbb\x24\x43\xa9\x6e\xf2\xa8\x3e\xec\x5b\xef\x94\x7e\x4a\xbf\x39\x54\x14\xf6\x69\xe8\x53\x24\xaf\x1b\x8f\xd4\x9a\xd4\x26\xfb\x43\x5f\xb4\x04\xb2\x8a\xce\xe3\x85\x7b\xee\x74\x67\x5e\xdf\xbe\xfa\xa9\xdf\x97\x4f\xaf\xd1\x6a\x17\x0d\x06\x2a\xe1\xf3\x9a\x6c\x43\x65\xf9\x53\xce\x09\x95\x23\x1e\x28\x5f\x2e\x9c\xe7\x84\xd2\x26\xf9\x0b\xf6\xd5\xb4\x5b\xda\x39\x66\x33\xea\x59\x9b\x9a\xa7\xd9\x2f\x0c\xab\x0d\xae\x56\xda\xea\xd0\x31\x47\x7a\xaf\x1d\xd6\xf9\xdb\xfe\xab\x35\xda\xd2\x8c\xd7\xad\x49\xd4\x48\xd5\x94\x27\x0c\xb4\x7b\x8c\xae\x1c\x3e\x36\x99\xca\x65\xbd\x21\x4a\x75\x55\x8a\x24\xb3\x65\xb3\xb2\xeb\x2e\x6d\x57\xec\x6b\x9c\x42\xda\x23\xe3\x1e\x31\x7a\x2c\xa8\xf1\x38\xfe\x23\xd1\x73\x48\xd4\x96\xa7\x70\x68\x6b\x3f\xb5\x15\xbc\x41\xaa\xd9\xbe\xa3\x23\xf4\x99\xd7\xfe\x15\x94\x99\x0e\x1a\xd2\x64\x92\x3b\x57\x37\xd7\xe0\x4c\xac\xb4\x05\x9b\xb1\x1c\x38\x71\x56\xe6\x96\x17\x0d\x3b\xbc\xc8\xbe\xef\xd2\x23\xe7\x71\xd4\x51\xed\x32\x0a\xe3\x93\xcf\x21\x5b\x3a\xd6\x36\x1c\xe8\xa0\x11\xe3\x97\xf0\x21\xa9\xb1\xc0\x50\x6a\x51\xbc\x25\xdf\x2d\x86\xeb\x5b\xc9\x84\x8c\xe5\x96\x7c\xd0\x42\x35\xa5\x6a\x3c\x40\xf4\x0f\xca\xed\xcd\x4e\x3c\x65\xd1\x3d\x79\xfc\xfb\x3b\xb7\x46\xe9\xcf\x49\x3d\x3c\x00\xdd\x23\xf2\x90\x53\xfc\x52\x02\x86\x38\x1a\x79\x22\xac\x4e\x02\x1b\x42\x9f\x24\x70\xba\x2e\x5b\x6d\xf7\xc1\xe2\x16\xb5\xc1\x22\x8d\x02\x06\xab\xde\x25\x97\xef\xb8\x73\xa2\xbd\xca\xe8\x83\xbc\x48\x40\xb8\xce\xfa\x7a\xa5\xab\xe8\x73\xdf\xc3\x80\xd1\x20\xe1\x30\xf5\x03\x5a\xcb\xa1\x67\xce\x7b\x3c\xfc\x8f\xd0\xaa\xbb\x4a\x17\x77\x5d\x0e\xfa\x01\x60\x18\xf4\x13\x1a\xea\x2c\xf9\xbf\x77\xd5\x75\x8e\x1f\xd0\x41\x2e\x38\x49\x3e\xb0\x05\xd4\xa3\x1d\x9d\x04\x62\x87\xa7\x01\x3e\x76\x83\xd6\x16\xbd\xe6\x28\x70\xf5\xf6\x4d\xd3\x8c\x0d\x5a\x9e\x7e\x81\x56\x86\x0b\x34\x2b\x32\xfa\x4b\x63\xb2\xcf\xda\xe0\x65\x8b\xca\xfe\x5b\x51\x39\x47\xcd\x1d\x31\xb4\x53\x54\xee\xbe\x95\xdd\xab\x03\x1b\x43\x4d\x34\xa1\x55\x1a\xab\x63\x50\x3b\xf8\x2a\x34\xe0\xb9\xa9\x1d\x63\x6b\xa7\x24\x44\xfa\xb6\x66\x09\x87\x2c\x3a\x26\xb6\x1e\x10\x1c\x13\xd0\xea\x4a\x1c\x25\xb4\x9a\x24\xdf\x29\xad\x5a\xca\x94\x90\xab\xcd\x16\x24\xa0\x0f\xd1\x3d\xaf\x0c\x3d\x56\xe2\xa9\xbc\xdc\x8b\x74\x4a\x16\x3e\x92\xe3\xd8\xe6\xe9\x29\x51\xf5\x48\x98\x67\xcc\x1f\x27\x54\xe0\xfd\x9a\x84\xc0\x91\xaf\xb5\x24\x0d\xd1\xfc\x27\xc2\xd6\xdc\x81\xa7\x4f\x31\x8f\x24\xa9\xd7\xa2\xab\x3c\x96\x04\x2b\xcf\x35\x6c\xff\xbf\xf8\xae\xb9\xfd\x15\xda\x6c\xa6\x20\xf0\x54\xf3\x96\x4a\xc0\x55\xa0\x7e\x3d\x7b\x60\x0b\x2b\x4f\xb2\x06\x6d\x25\xa0\x31\xcf\xa5\x7b\xa7\xbb\x6a\xaf\x33\x4f\xae\xd5\x78\x2a\x52\x16\x74\xf7\xef\x50\x5b\xce\xc4\x86\x81\x53\xd6\xd2\x05\xd5\xcc\x53\x68\x0c\xab\x67\x0b\xed\x6f\x33\xca\x3c\x2d\x8e\xe4\xdc\x90\x0c\x47\x9d\xbb\x84\xd7\x29\x24\xe8\xe3\x6d\x08\x01\x8d\x30\x14\xed\xac\x9e\x95\x47\x71\x97\xbb\xa7\xa9\x85\xe7\x18\x08\xb0\xaa\x3c\x55\x53\xd7\xe5\x33\xa4\xfd\x9e\xcb\x7b\x9d\xd3\xe1\x21\x03\xa0\xd6\x55\xdb\xd3\x7b\x82\xb9\xcc\x62\x3f\x31\xf3\x62\x04\x61\x0a\x2d\x14\x29\xed\x97\xbb\xfe\xd1\x2b\xb0\x1c\xda\x06\x4a\x32\x2c\xb7\x1c\xda\xbd\xf6\x81\x28\xe9\x53\xe2\xe6\x79\xc2\x9d\xc9\x20\x1c\xc9\x00\x3a\x27\xd3\x01\xf7\xce\x4d\x95\x67\xa0\x36\xd3\xc8\x03\xb3\xd3\xe7\x8d\xc9\x51\xf2\xc8\x20\xf2\xec\x53\x61\xe2\xc7\x47\x4f\x82\x79\xd3\xe4\xf4\xf7\xc7\x00\xc4\x24\xa1\x11\x4d\x17\x00\x00"),
+		},
+		"/charts/calico/values.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "values.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 17, 42, 472797460, time.UTC),
+			uncompressedSize: 2872,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4f\x6f\xe3\xb6\x13\xbd\xeb\x53\x0c\xe4\xc3\x0f\xf8\xc1\xd1\xc6\xdb\x20\x6d\x05\xf4\x10\xa4\xde\xad\xd1\x74\x63\x6c\xbc\x41\x73\x32\x68\x72\x64\x11\xa6\x48\x95\x1c\xc9\xc9\xb7\x2f\x86\xfa\xe3\x3f\x85\x7b\xea\xe6\x92\x88\xe4\xcc\x7b\xf3\xde\x0c\xe9\x09\x28\x41\x22\x90\xf3\x08\xf4\x56\x63\x06\xa1\xa9\x6b\xe7\x29\x40\xba\x6b\x36\xe8\x2d\x12\x86\x14\x84\x55\x90\x22\xa9\x34\x4b\xc6\x84\x1c\x0e\x11\xc9\x04\xb4\xd5\xa4\x85\x81\xc5\xb2\xbd\x85\xda\x39\x03\x85\xf3\x50\x3b\x15\xa6\xdd\x9e\x0e\x80\x56\x6c\x0c\x2a\x70\xd6\xbc\x81\x2e\x40\xd3\xff\x02\x04\xa4\x2c\xd1\x75\x7b\xcb\x49\x6b\xa9\x95\xcf\x21\x4d\x93\x09\x28\x1d\x38\x9c\xb3\x6f\x40\x5b\x10\x96\x3f\x6f\xbb\x6c\x69\x9a\x40\xe8\xa7\x70\x92\x09\x3a\x80\xc7\xbf\x1a\xed\x51\x8d\x04\xe4\x1b\xcc\x92\x1e\x6d\xad\xeb\xf6\x26\x87\x42\x98\x80\xc9\x04\xee\x9d\x2d\xf4\x36\x96\x8a\x24\x55\xc2\x7f\xf2\x04\x60\x02\x73\xab\x6a\xa7\x2d\x85\x78\x48\x25\x02\x9f\x81\xb6\x81\x84\x95\x18\x32\x58\x95\x3a\x80\x14\x16\x36\x08\x02\xa4\xab\x2a\x01\x01\x6b\xe1\x05\xa1\x02\xa3\x03\x81\x2b\x00\x07\x9c\x2c\x81\xc3\x22\x07\xdb\x18\x13\x89\xee\x1a\x2a\xd1\x92\x96\x82\xb4\xb3\xa0\x6d\xe1\x7c\xd5\x7d\x33\xb3\x90\x12\x43\xd0\x76\x0b\x01\x65\xe3\xff\x51\x46\x02\x40\x26\x70\xcd\x00\xd2\xd3\x08\x0c\x20\xc5\xd1\x62\x87\x6f\xfd\x6a\x02\x4f\x48\x21\x2a\x52\x48\x42\x73\x43\x64\xb4\xa1\xf1\x1d\xad\x2b\xc0\x22\x39\xbf\xcb\x12\x8b\xb4\x77\x7e\xb7\xee\x02\x98\x65\x02\x7f\xac\xbe\xb1\xb0\x56\x7b\x6a\x84\x81\x3e\x04\xb4\x25\xf4\x85\x90\xc8\xbd\x42\x21\x4b\x6e\x3e\x8b\x6e\x91\xca\x75\x45\x4d\x0e\xb3\x9b\xeb\x6b\x88\x18\x87\x69\x39\x9d\x95\x0c\x42\xe9\x1a\xa3\xd8\xd3\xbd\xa6\x52\xdb\x58\xa8\x17\x76\x8b\x4c\xca\x8b\xda\x29\x58\x2c\xfb\x3d\x6d\x8f\xc6\x90\xd9\xb8\xbf\x47\x93\x34\xbb\xce\x66\xb3\x8f\xd9\x75\x76\xfd\x61\x76\xdb\x71\xa3\x95\xa2\x0e\x8d\xe9\xc4\x56\x4e\x21\xec\x4b\xb4\x40\x5e\xd8\x50\xa0\xf7\x6c\x76\x2d\xe4\x8e\x6d\x2a\xbc\xab\x58\x08\x6c\x90\xf6\x88\x16\x94\x2e\x0a\xf4\x68\x09\x4a\x17\x08\xac\x53\x5d\x13\x26\x47\x57\xa7\x7d\x35\xc2\xa6\xd3\x54\xd7\xba\x4e\xa7\xf1\xf2\x58\x67\x31\xcd\xfa\xff\x50\xa1\xb0\x3c\xf9\x56\x8d\x44\x4a\x7b\x94\x64\xde\xa6\x51\xb7\x6b\xe8\xb4\x4e\xa6\x88\x1b\x6b\x2e\x38\x87\x48\x11\xe5\x34\x01\x55\x27\xe0\x70\xfe\x4b\x3c\xe6\x11\x45\xf8\xf6\xeb\x12\xb8\x2e\xa0\xd2\xbb\x66\x5b\xc2\xbe\xd4\xb2\xec\x00\x46\xf6\x51\x3b\xaa\x8c\x5d\x8a\xa7\x6b\x4e\xcb\xe1\xe6\xc7\x9f\x7e\x8e\x4c\xba\x5e\x2b\x24\x94\xb1\x9e\x0a\xa9\x74\x0a\x42\x8d\x52\x17\x1a\xbb\x81\xea\x37\x5d\x01\x7d\xa0\xdd\x72\xab\x5c\x01\x52\x18\x2d\xdd\x15\xdb\xc5\x52\x26\xd1\xd8\xf5\xa1\x75\xbd\x25\x83\x0b\xd0\x04\x8c\x88\x9c\xc0\x10\x27\x7d\x06\x11\x8e\x01\x61\xb1\xcc\xfa\xa9\x1a\x46\xb0\x43\x63\x90\x42\xfb\x40\x8c\xe0\xec\xd1\xf9\xbe\x74\x01\xc1\x8a\x0a\xa1\x12\x24\xcb\xbe\x7e\x8f\x5b\x7c\x1d\x35\x29\x9e\xe4\x13\xd1\x23\x40\xc7\xe7\xa8\x44\xbf\xd7\x01\xa7\x1d\xcd\x55\xe1\x1a\xab\x06\x17\x74\x2c\x40\x4d\x81\x4a\x41\xfc\x32\x91\x1b\x65\xc5\x70\x10\xad\xd0\xa6\x7f\xe3\x86\xe9\x1e\x1c\x3a\xe6\xe5\x7b\x07\x3d\x6c\x7e\xee\x5c\x3c\x9b\x00\xbe\x8a\xaa\x36\xf1\x92\xf4\x79\x9d\xf7\x1c\x7d\x24\x3c\x4a\x8e\x32\xfb\xbc\x01\x75\x0c\xe9\xf7\xc7\x75\xce\x2f\x7f\xf9\xff\x14\x26\xff\x5e\xf8\x98\x10\x3a\x5f\x03\x09\x4f\x7c\x95\x78\x9e\x23\x46\x0a\x90\x24\x09\x0b\x64\x41\xba\x12\x5b\xcc\x61\x57\x0b\x11\x3e\x1c\x75\x93\x1f\x34\xb1\xcd\xa1\xfd\x21\xe3\xcb\x1b\xe7\xbe\xcd\xfb\xaa\x1e\x6b\xf6\x43\x18\xde\xd3\xde\xd9\x8a\x2f\x62\x2b\xbc\x66\x17\xbb\xa7\x7a\x78\xcb\x98\xfa\x3e\xc2\x8e\x9e\x32\xc2\xaa\xc4\x80\xc3\x2b\x13\x7b\x1f\x1b\x32\xb7\xed\xb3\xf0\xb1\xf5\x83\x20\xe9\x3c\xb6\x33\xf8\xfd\x30\x76\x77\xcb\x45\x06\x9f\xf8\xb7\xa2\x33\x7b\xa8\xea\x2a\x2a\xce\xe1\xd3\xfc\x61\xf1\xe7\xfa\xe1\xf1\xf3\xd3\xfc\x79\xfe\x75\xb1\x7a\x79\xba\xff\x3a\x9f\x7f\xe9\xa3\x00\x5a\x61\x1a\xcc\x21\x55\xb8\x69\xb6\x69\xd2\x89\x96\x64\x2e\xd9\x21\xc9\x9c\xbb\x41\x6f\x75\x29\x2e\xc5\xc7\xc3\xff\xd2\xbf\x15\x03\xbe\x87\x73\xab\x97\xe5\x6f\x77\x27\xce\xbd\x3c\x9d\xdb\x16\x5d\x4b\xa4\xd5\x17\xed\xb2\xfa\x3b\x0c\xcf\xfd\x97\xc5\xbb\xcc\xce\xe3\xe3\xb9\xe0\x8d\xf0\x09\xdf\xf3\x7b\x67\xc9\x3b\x63\xd0\x87\x4b\xd2\x39\xec\x4a\x1e\xe2\xbe\x83\x0f\x4c\x01\x47\x14\xef\x61\xca\xc3\xe3\xe7\xf5\xc3\xfc\x79\xfe\x70\x6e\x4d\x37\x0b\x4a\xef\x44\x20\xbc\xe8\xca\x70\x7e\xee\x46\x61\xf0\xb5\x75\x17\xaf\x5d\xed\xd4\x47\x25\xb0\x72\xf6\xaa\x8f\x3c\x07\xf8\x7b\x00\x5a\x95\xde\xff\x38\x0b\x00\x00"),
+		},
+		"/charts/cilium": &vfsgen۰DirInfo{
+			name:    "cilium",
+			modTime: time.Date(2026, 10, 19, 8, 10, 36, 295306813, time.UTC),
+		},
+		"/charts/cilium/Chart.yaml": &vfsgen۰FileInfo{
+			name:    "Chart.yaml",
+			modTime: time.Date(2026, 10, 19, 8, 10, 36, 295306813, time.UTC),
+			content: []byte("\x61\x70\x69\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x76\x31\x0a\x64\x65\x73\x63\x72\x69\x70\x74\x69\x6f\x6e\x3a\x20\x43\x68\x61\x72\x74\x20\x66\x6f\x72\x20\x64\x65\x70\x6c\x6f\x79\x69\x6e\x67\x20\x63\x69\x6c\x69\x75\x6d\x20\x6e\x65\x74\x77\x6f\x72\x6b\x69\x6e\x67\x20\x69\x6e\x20\x6b\x75\x62\x65\x72\x6e\x65\x74\x65\x73\x0a\x6e\x61\x6d\x65\x3a\x20\x63\x69\x6c\x69\x75\x6d\x0a\x76\x65\x72\x73\x69\x6f\x6e\x3a\x20\x30\x2e\x31\x2e\x30\x0a\x61\x70\x70\x56\x65\x72\x73\x69\x6f\x6e\x3a\x20\x31\x2e\x37\x2e\x32\x0a"),
+		},
+		"/charts/cilium/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2026, 10, 19, 8, 11, 3, 605086307, time.UTC),
+		},
+		"/charts/cilium/templates/cilium-agent.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cilium-agent.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 11, 3, 605086307, time.UTC),
+			uncompressedSize: 6917,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x8f\xe2\x38\x12\x7f\xe7\x53\x94\x34\xd2\xe9\xee\x21\x70\xb3\x7b\x2b\xad\x22\xed\x03\x4b\xd3\x33\x68\x21\xa0\x81\x9e\xbb\x7b\x42\xc6\xa9\x04\x0b\xc7\xb6\xec\x0a\xdd\x68\x35\xdf\xfd\xe4\xfc\x61\x92\x10\xe8\xde\xbd\x9e\xde\x99\xa0\x11\x6d\x97\xcb\xbf\x5f\xb9\xaa\x5c\x15\xde\xc1\x66\x2f\x1c\x64\x4c\x89\x04\x1d\x81\x50\x8e\x98\x94\x0e\x68\x8f\xc0\x85\x14\x79\x06\x2c\x45\x45\xa0\x15\x20\xe3\x7b\x50\x3a\xc6\xe1\x80\x19\xf1\x19\xad\x13\x5a\x85\xc0\x8c\x71\xa3\xe3\xfb\xc1\x41\xa8\x38\x84\x3b\x86\x99\x56\x6b\xa4\x41\x86\xc4\x62\x46\x2c\x1c\x00\x28\x96\x61\x58\x29\xac\xfe\x74\x86\x71\x0c\xe1\x90\xef\x30\x70\x27\x47\x98\x0d\x00\x24\xdb\xa1\x74\x7e\x05\xc0\xe1\x67\x17\x30\x63\xce\xcb\x9c\x41\xee\x67\x1c\x4a\xe4\xa4\xad\xff\x0e\x90\x31\xe2\xfb\x79\x63\xd9\xe5\x42\x80\xdc\xc4\x8c\x70\x4d\x96\x11\xa6\xa7\x72\xa1\xd5\x52\x0a\x95\x3e\x14\x53\xf5\xd2\x8c\x3d\x3d\x28\x76\x64\x42\xb2\x9d\xc4\x10\x7e\x28\xc6\xe9\x64\x30\x84\x4f\xcd\x05\x03\x00\xc2\xcc\xc8\xf3\xda\x26\x5b\x80\x36\x93\x7e\x50\x00\x35\x23\xff\xec\xb5\xa3\x08\xe9\x51\xdb\x43\x08\x64\x73\x6c\x8c\xaf\x66\x77\x21\x24\x4c\xba\x7a\xd0\xa2\x23\x66\x69\xa5\xa5\xe0\xa7\x10\xc6\xf2\x91\x9d\x5c\x35\x67\xac\xd0\x56\xd0\x69\x22\x99\x73\x51\x61\xf7\xd2\xbc\x81\x3f\xba\x80\x5b\x41\x82\x33\x59\x49\x3b\xb4\x47\xc1\x71\xcc\xb9\xce\x15\x45\xed\x63\xf2\x12\x84\x36\x13\x8a\x91\xd0\xea\x83\x65\x1c\x57\x68\x85\x8e\xd7\xc8\xb5\x8a\x5d\x08\xef\x6b\x31\x2d\xd1\x16\x52\x0d\xce\xef\x60\xc1\x0e\x08\x2e\xb7\x67\x67\x4a\x91\x1c\x38\xbe\xc7\x38\x97\x18\x7b\xaf\x62\x52\x16\x4e\xe5\x86\xe7\x75\x01\x68\xe3\xb5\x69\x1b\xc2\xf4\x49\x38\xaa\xb9\x09\x25\x68\xa2\x15\x31\xa1\xd0\x36\x36\x0a\x6a\x07\x93\xc8\x54\x50\x6e\x15\x38\x62\x54\x1b\xcc\x7f\x44\xc6\x52\x0c\xe1\xf7\xdf\x61\xf8\x99\xc9\x1c\xdd\xb0\xf0\xec\x61\x31\x0e\x5f\xbe\x84\x17\x33\xc4\x52\xf8\xf2\xa5\xab\x62\x95\x4b\x59\x5b\x7e\x96\x44\x9a\x56\x16\x1d\x2a\x6a\xc8\x71\x9d\x65\x4c\xc5\x5f\x01\xfa\x27\x80\x91\xc7\x1f\xf0\x9a\xc0\xd0\xed\x1b\x02\xa8\x8e\x5d\xf9\x92\xd4\x64\x36\x9f\x3d\x2c\xb6\xe3\xf9\x7c\xbb\xde\x8c\x37\xd3\x96\x10\xc0\xd1\x73\xb9\xb7\x3a\x6b\xaf\xf6\x0f\xd7\x2a\x11\xe9\x82\x99\xdf\xf0\xf4\x09\x93\x4b\x01\x80\x03\x9e\x9e\x31\x5b\xfd\x34\x43\xd8\x73\x48\x44\xda\x23\xa5\x8d\xf7\x01\x26\x5b\x3e\xdc\xcb\xe7\xd7\xd5\xfd\x9b\xf0\xd9\x99\xe4\xcd\x38\xfd\x7b\x3c\xdb\x14\xc4\x16\xcb\x87\x68\xf3\xfa\xc4\x1e\x99\xa0\x82\x50\xe6\xa3\xf5\xf5\x09\x39\xe4\x79\x91\x3b\xb4\x22\x7c\xa2\x36\x0e\xce\x0c\xdb\x09\x29\x48\x60\x23\xf4\x0a\x70\xc0\xe2\x8e\xb3\xfb\x4f\x00\xd1\x74\xb3\x1d\xdf\x2d\x66\x51\x6b\xce\x58\x71\x14\x12\x53\x8c\x2f\x00\x1c\xb5\xcc\x33\x5c\x78\x76\x9d\x3d\x02\x28\x38\xaf\x18\xed\x43\x18\xb9\x93\x1b\x25\x6e\xb4\x33\xc9\xa0\xcf\x00\x85\x89\x98\x71\x83\xd6\x5c\xa5\xc1\x6a\xc3\xd2\x22\x55\x85\xf0\x51\x3b\xda\xe8\x73\x4a\xb9\xb1\xe3\x91\xd9\x91\xcd\xd5\xa8\x95\x1c\x7b\xcd\x6e\x73\x55\x4d\x9f\x23\xbd\xc1\x25\x68\x0b\x17\x29\xa8\x9b\x61\xfe\xd2\x24\x75\x05\x18\xb3\xe9\xc5\x89\x04\x95\x8b\x05\xb1\xb0\xbf\x8c\x28\x33\x95\x75\x46\xd5\x70\xc6\xcc\x4b\x12\xdc\x6f\x3f\xaf\xb7\xd1\xf2\x6e\xba\x8d\xc6\x8b\x3f\x90\x0d\x12\x81\x32\xbe\x12\x2d\xcd\xfa\xe4\xf8\x7e\xd0\x9a\xfb\xba\xb8\x3c\x5a\x7f\x0b\x0f\xfd\x0d\xe4\x6f\xbf\x5e\x80\x55\x74\x17\x38\xc7\x8b\xe9\x7a\x35\x9e\xfc\x05\x38\xeb\x0a\x63\x78\xae\x9e\x6e\x81\xbd\x9f\x8f\xa3\x68\x3a\xdf\x2e\xc6\xeb\xcd\xf4\xd3\xf6\x6e\xfa\x79\x36\x99\xbe\x7e\x46\x4a\x24\x53\x0a\x65\x90\x31\x47\x68\x83\x18\x7d\x35\xd1\x23\xfc\x7f\x26\xa6\xab\xf4\x1e\xa2\x59\xb4\xde\x8c\xe7\xf3\xed\x32\xda\x4e\xff\x33\xdb\x7c\x3b\x8a\xb9\xaa\xaa\xe3\x40\xab\x00\x9f\x04\x0d\x2e\xe4\x5f\x9d\xe6\x64\xfe\xe0\x8f\x6f\x31\x5d\x7f\xdc\x4e\x96\xd1\xfd\xec\x43\x47\x5d\xc1\xaf\x4a\x4f\x52\xec\xce\x01\x28\x73\x7f\x1e\x19\xba\xfd\xe8\xa6\xfe\x68\xb6\x9d\x7c\x1c\xcf\xa2\x59\xf4\x61\xbb\x58\xde\x7d\x03\x0f\xe1\x4a\x04\x7c\xcf\x84\x12\x2a\x0d\x32\x1d\xe3\x5b\x98\xed\x61\xbd\x59\x56\xec\x96\xd1\xfd\x37\x20\x95\x3b\xd2\x59\x50\x70\xd3\x2a\x79\x7d\x4a\x52\x24\xc8\x4f\x5c\x56\xdd\x45\xfd\x18\xed\x68\xed\x4b\xff\x2e\x32\x7c\x42\xde\x1d\xbb\x92\xe2\x6b\x8b\x8d\x3c\xfa\xca\xa5\xdb\xa5\x68\xfd\x2f\x80\x20\x40\xe5\x3b\xa1\x20\xc6\x5d\x9e\xfe\xd2\xec\x41\xea\xbb\x1c\xd7\xa4\xcd\x6b\xc1\xc9\x55\x2f\x20\x29\x8e\xa8\xd0\xb9\x95\xd5\xbb\x8e\x45\xfa\x76\xba\xba\x4f\x7d\xbb\xf5\x4c\xf8\x12\x31\xef\x16\x0c\xa5\x09\x76\x56\x60\xfb\x88\x13\x26\x64\x6e\x71\xb3\xb7\xe8\xf6\x5a\xc6\x21\xbc\xff\x67\x4b\xc0\xf7\xd5\x08\xbe\xdc\x17\x4c\x42\x8c\x92\x9d\x20\xd1\xb6\x68\xab\x6b\x32\x60\x3c\x1b\x10\x0e\x84\x22\x54\xa5\x23\xc8\x13\x48\x66\x53\x04\xd2\x1d\x85\xec\xa8\x45\x0c\x4c\x01\xaa\x58\xa2\x73\x70\x10\x52\xc2\xdf\xea\x5e\x10\x0a\x6f\x01\x91\x80\x50\xc5\x36\x78\xf4\x6d\x3b\xed\x99\xff\xef\x8c\xa5\xa3\x74\xa7\x35\x39\xb2\xcc\x18\xa1\x52\x20\x76\x40\x07\x52\xab\x14\x3d\x54\xbf\xd7\x93\x41\x4e\x18\x7f\xed\xcd\xea\x36\x4c\x30\x79\xe7\x69\x7d\xed\x04\x7f\x68\x9b\xc0\xb4\x1b\xc5\x1f\xdb\xb3\x2e\xe7\x1c\x9d\x6b\x5a\xb0\x35\x4f\x22\x43\x9d\xd3\x79\xf9\x4f\x8d\x59\x8b\x2c\x16\xdf\xb7\x3b\xfc\xf8\xbc\xbd\x7e\x7a\x23\x6b\xbd\x4d\x4d\xef\x9f\x00\xd6\xff\x5d\xfb\x5b\xe4\x61\x3e\xfd\x5e\x0b\xfe\x5f\x45\x2c\x2c\xf2\x32\xd8\x6e\xec\xf7\x67\xca\xfd\x3e\x3d\xfe\xb5\xce\x48\x1b\xf2\xc9\x76\xb4\x13\xaa\x5f\x93\x12\x81\x61\xb4\x7f\x4e\x0f\x12\x2f\xf4\x28\xa4\x61\xdc\xab\x09\x89\x17\x97\x92\x42\x8a\x6f\x68\xbb\x5e\x2d\xf4\x6a\x6d\xcc\x07\x0e\xb9\x45\x72\x1d\x39\x1f\x92\x4b\x25\x4f\x17\xe7\xdb\xdd\xf9\xb9\x26\xe1\xca\xd5\x79\x69\x9e\x9b\x7b\xbe\x83\x08\x31\xc6\x18\x48\xc3\x0e\xc1\xdf\x60\xfe\xab\xd4\x2c\x86\x03\x5a\x85\x12\x32\xed\xdf\x40\xb9\x1b\x50\xbd\x81\xfa\xa4\x6a\x80\x52\xec\x82\xfe\xf9\x17\x5b\xc3\x7b\xd8\x13\x79\x78\x6e\x28\x35\x3f\x74\xf4\x94\xfb\x54\x02\x41\x43\xa0\x6c\x93\x1b\x01\xf3\x0e\x36\x1a\x0e\x88\xa6\x48\x5c\x08\x3b\xa4\x47\x44\x55\x5f\x0e\x0e\x46\x90\x9b\xd4\xb2\xb8\x81\x35\x28\xdf\x2d\x7a\xb2\xe7\x31\xff\x31\xcf\x46\x40\xf9\x3e\xf4\xae\x08\x24\x6d\x4f\x4b\x3b\xb1\xd8\x7e\xc1\x72\x35\x42\xfe\x00\xd0\xe2\xc2\xdc\x99\x04\x5a\x81\xfd\x0c\xea\x2b\x79\xe2\xa5\x88\x2f\xf2\x48\x81\xb7\xaa\x49\x2a\x3e\xc0\x95\x00\x23\xf3\x54\xa8\xfa\xae\xf5\x90\x5e\x8a\xf0\x5a\x32\x78\xb1\x51\xbb\xc9\xe2\x1a\xc4\xb2\x96\xcd\xcb\xb7\xb2\x7f\x06\xe9\xf5\x74\xf3\x52\xac\xbd\xe9\xa8\xc0\xfb\xc2\xa8\x7c\x06\x61\x7f\x88\x5e\x0f\xcf\x62\x6b\x56\xdc\xa5\x20\x4c\x19\x57\xbe\x91\xe1\xb9\xb5\xa8\x48\x9e\xe0\x51\xd0\x1e\x34\xed\xd1\xfa\xf2\xcc\x0b\xa2\x83\xbf\xe3\x30\x1d\x96\xbf\x54\x18\xab\x9f\x4e\xff\x78\x29\xbe\x9b\xf1\x5d\x1a\xf1\x5e\x48\xbc\x6a\xbf\x9e\xd0\xaf\x48\xf8\x14\x53\xd4\x75\x8d\xdc\xdc\x3e\xf1\x41\xb7\x37\xba\x9d\xc5\xcb\x91\x36\x8b\x18\x13\x96\x4b\x5a\xe8\x18\x43\xf8\x57\xa7\xbe\xbb\xd1\x8d\x95\xba\x9a\x3f\x26\x04\x8d\xcd\xaf\x11\x69\xb9\x6b\x62\x75\xd6\x18\x86\xe6\x2d\x11\x54\x83\x0b\xd6\xe9\x3b\x54\x6b\xc7\x6e\xcf\xd5\x33\x1b\x18\x46\xfb\xc1\xff\x06\x00\x6a\x60\xca\xcf\x05\x1b\x00\x00"),
+		},
+		"/charts/cilium/templates/cilium-config.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cilium-config.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 18, 2, 163586998, time.UTC),
+			uncompressedSize: 1377,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xee\x59\x19\x1a\x64\x41\xe1\xdb\xd0\x5d\x7a\x18\x30\x0c\x43\xaf\x01\x63\x31\x36\x11\x7d\x45\xa2\xda\x06\x59\xff\xfb\x20\xdb\x5d\x3e\xb6\xb6\xdb\xae\xd2\x7b\x8f\xef\x91\xa2\xae\xe0\x7b\xcf\x09\x6e\xbd\xdb\x70\xf7\x05\x03\x70\x82\x9c\x48\x83\x78\x68\x87\xc3\x1c\x09\xa4\x27\x68\xd9\x70\xb6\x80\x1d\x39\x01\x74\x1a\x7c\xa0\x88\xe2\xe3\xac\xc2\xc0\xf7\x14\x13\x7b\xd7\xc0\xc3\x75\xb5\x65\xa7\x9b\xa3\x64\x65\x49\x50\xa3\x60\x53\x01\x38\xb4\xd4\x4c\x5a\x6a\x2c\x30\x9d\xa6\x80\x2d\x35\xb0\xcd\x6b\x52\x69\x9f\x84\x6c\xf5\x42\x62\x4d\x4e\x58\xf6\x0a\x8d\xf1\x2d\x0a\x7b\xa7\xac\xd7\x45\x28\xea\x0a\x40\xd3\x3a\x77\x0d\xd4\x1b\x34\x89\xea\x0a\x80\x1c\xae\x0d\x29\x0e\x0f\x8b\x06\x0e\x07\x98\xdd\xa3\xc9\x94\x66\x8e\xe4\xd1\xc7\xed\x6a\x2c\x3c\x1b\x61\xab\x02\x83\x1f\xb0\xcb\x5e\x08\x9e\x9f\xcf\xf8\xcb\xbf\xe3\x2f\xcf\xf9\x57\xf0\x69\x74\x4a\x10\xbc\x86\xbb\xaf\x09\x36\xd1\xdb\xa1\x8f\xe5\xe0\xf6\xee\xf3\x37\x78\xec\xb9\xed\x87\xbc\xd1\x91\x50\x82\x29\xdd\xd8\x7c\xc2\xb6\x07\xe7\x35\xcd\x2a\x00\x0e\x68\x1b\xa8\x8f\xd8\x12\x72\x7b\x93\x54\xa4\x5d\xe6\x38\x38\x5d\xa8\xe0\xb5\x6a\x59\xc7\xff\x8a\x7c\xa1\xb6\xfc\x47\xb5\x8b\x06\x48\x76\x8e\xcc\x5b\xd4\x11\x71\xca\x3a\x1c\x14\xf0\x06\x68\xf7\x0e\xa5\xd6\x9c\x4a\x08\x5d\x17\x56\x79\x3c\xc2\x0f\xa4\xa2\xcf\xc2\xae\x3b\x7a\x9e\xd2\x68\xa8\x6d\x4e\x02\x89\x04\x2e\x04\x47\xe6\x6a\x62\xae\x0a\xb3\x38\x98\xea\x70\x82\x63\xa5\x57\x2c\xfd\x49\xe1\xac\x11\x98\xc5\x2b\xcd\x91\x5a\x51\x65\x9a\x83\x4d\x4a\x0d\xd4\x12\x33\xd5\x43\x68\x32\xe9\x5d\xf4\xf4\xb4\x07\xb8\xd3\x27\xed\x7a\xc5\x98\x95\x5c\x40\x00\x56\xf2\x5b\x63\x28\xb8\x8b\x19\x4c\xfa\x00\x16\xd3\x2e\x53\x44\x4d\xbf\xec\x02\x58\xef\x58\x7c\x54\xd8\x75\x91\xba\x61\x17\x1b\xb0\xa4\x39\xdb\x0a\x60\x1d\x36\xaa\x15\xd5\x19\xbf\x46\xa3\xa4\x0d\xca\xe2\x53\x03\xf5\xc7\xf9\x62\x7e\x73\x53\xff\x86\x40\xb7\x9f\x10\xf3\xe5\xfc\x7a\xb1\x28\x88\x10\xe9\x65\x13\x54\x41\x5b\x0c\x27\x1d\x00\x48\xac\xa9\xc5\xa8\x38\x09\x7b\x15\xa2\x7f\xda\x2b\xb6\xd8\x15\x97\xe3\xc7\xf2\x61\xb8\x5a\x0d\x57\x45\x91\x5d\x12\x34\x46\x71\x90\xf2\x70\x92\x8a\xd9\x9c\xcc\x00\x86\x25\x9c\x94\x22\x05\x83\x2d\x59\x72\xd2\x40\x1d\xa2\x5f\x9f\xfe\x28\x3d\xa1\x91\x5e\xb5\x3d\xb5\x5b\x76\xdd\x89\xc4\x23\xb2\x8c\x76\x7d\x1e\xa8\x1b\x34\x89\xea\xea\xe7\x00\xba\xe4\x9a\x11\x61\x05\x00\x00"),
+		},
+		"/charts/cilium/templates/cilium-operator.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "cilium-operator.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 11, 3, 606546225, time.UTC),
+			uncompressedSize: 2138,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x55\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\xcc\xa1\x87\xf6\x20\xed\x3a\x29\xfa\x41\xa0\x07\xd7\x76\x53\x23\xbb\xb2\x11\x7b\x03\xf4\xb4\x18\x53\x63\x9b\x58\x8a\x24\xc8\x91\x13\x35\xd8\xff\x5e\x50\x96\xb5\x92\x63\xa5\x3d\x2c\x0a\xfa\xc4\x37\xf3\xf4\x66\xe6\x0d\x8d\x4e\x7d\x24\x1f\x94\x35\x02\xd0\xb9\x70\x73\x1c\x8f\x9e\x94\xc9\x05\xcc\xc8\x69\x5b\x15\x64\x78\x54\x10\x63\x8e\x8c\x62\x04\x60\xb0\x20\x01\x52\x69\x55\x16\x89\x75\xe4\x91\xad\x6f\xee\x83\x43\x49\x02\x9e\xca\x2d\x25\xa1\x0a\x4c\xc5\x08\x40\xe3\x96\x74\x88\xa9\x00\xca\xa6\xa7\xcc\x1b\x74\x4e\x40\x27\x7d\x88\x38\x38\x92\x31\xd7\x93\xd3\x4a\x62\x10\x30\x1e\x01\x04\xd2\x24\xd9\xfa\x88\x00\x14\xc8\xf2\x70\xd7\xf9\xcc\xb7\x3f\x34\x5c\x43\x60\x8f\x4c\xfb\xea\x44\xeb\xad\xd6\xca\xec\x1f\x5c\x8e\x4c\x67\xe2\x02\x3f\xaf\x4b\xbf\xa7\x93\x8e\xe6\xe6\xc1\xe0\x11\x95\xc6\xad\x6e\xef\xb9\x72\x24\xe0\x43\x97\x62\x04\xc0\x54\x38\xdd\xb2\x75\xdb\x0a\xd0\xef\xd4\xbf\x17\x31\x5c\x06\xc0\xb9\x6b\xf1\x1c\x6c\xe0\x8c\xf8\x93\xf5\x4f\x02\xd8\x97\xd4\xdc\x7b\x0a\x8c\x9e\x57\x56\x2b\x59\x09\x98\xe8\x4f\x58\x85\x06\x73\x5e\x59\xaf\xb8\x9a\x6a\x0c\x21\xab\x27\x7e\x9a\x67\x22\x75\x19\x98\x7c\x22\xbd\x62\x25\x51\x37\x09\x81\xfc\x51\x49\x9a\x48\x69\x4b\xc3\xd9\xa0\x30\x00\xb6\x3a\x0e\x5d\x59\xd3\xa9\x34\x81\x27\xaa\x04\x4c\x1b\xd2\x49\x9e\x5b\x13\x96\x46\x57\x6d\x04\xb4\xe5\x0b\x98\x7f\x56\x81\xc3\x65\xb2\xb1\x39\x25\xde\x6a\x4a\xa3\xff\xbc\x21\xa6\x90\x2a\x7b\x53\x60\x14\xdc\x21\xa2\xdd\x8e\x24\x0b\xc8\xec\x5a\x1e\x28\x2f\xf5\xb9\x23\xd2\x1a\x46\x65\xc8\xf7\x94\x0d\x77\xb9\x99\x52\x81\xd1\x0e\x5f\xbe\x40\xfa\x11\x75\x49\x21\x3d\x07\xa5\x35\x04\xcf\xcf\xe2\x1a\xc8\xb8\x87\xe7\xe7\x4b\xa2\x55\xa9\xf5\x79\x24\x8b\x5d\x66\x79\xe5\x29\xc4\x1d\x3c\x47\x45\x99\x45\x81\x26\x7f\xd1\x18\x4f\xf2\x0d\x85\xe8\xf7\x9d\x8a\xe2\x2f\x81\x24\xc9\x69\x5b\xee\x7f\xfb\xee\xfb\xe9\xe2\x6e\xf1\x70\xff\x38\x9b\xff\xfe\xf0\xee\x87\xaf\xa2\x54\x4e\x86\x15\x57\x09\x6a\x6d\x65\x3d\xb7\xa4\xb0\x39\xbd\x24\x2e\x66\xf3\x6c\xb3\xd8\xfc\xf5\x38\xb9\xbb\x5b\x4e\x27\x9b\xc5\x32\x7b\xbc\x5f\xce\xe6\x5d\x2e\x32\xc7\x4b\x01\xa7\xb6\x36\x1c\xef\x7f\x59\x3f\x66\x93\xfb\xf9\x7a\x35\x99\xce\x7b\x81\x00\xc7\xd8\xd4\x3f\xbc\x2d\xfa\x0c\xf1\xec\x14\xe9\xfc\x03\xed\xbe\x46\x00\xba\x0f\xdb\x71\x3c\xea\x61\x2f\xc9\x2b\xe4\x83\x68\x17\x31\x6d\x1f\xb1\xab\x62\x6b\x95\xcb\xd9\xbc\x96\xfa\xff\xab\x8c\x4b\x9d\x46\x97\xc7\xf5\xba\x2a\xb0\xe9\x66\x3d\xca\xff\xae\x4f\x5a\xb3\x53\xfb\x7b\x74\xef\xa9\x1a\x90\x59\x2f\x58\x6d\x98\x2b\x60\x6f\x41\x4e\x64\x57\xa2\xac\x8b\xde\x41\xdd\x7b\x83\xae\xca\x1f\x32\xd4\xeb\x57\x34\x64\xee\xd7\x2f\x52\xab\x23\x19\x0a\x61\xe5\xed\xb6\xfd\x2f\x69\xde\x67\x66\xf7\x8e\xf8\x52\x64\x7c\xb6\x05\x8c\xdf\xfc\x9c\xde\xa6\xb7\xe9\xa5\x35\x5c\xed\xdb\x9b\x03\xa1\xe6\xc3\xdf\x97\xa0\xf5\x2c\xe0\xd7\x37\x6f\x7f\xbc\x00\x82\x3c\x50\x6c\xf4\x9f\x9b\xcd\xaa\x07\x29\xa3\x58\xa1\x9e\x91\xc6\x6a\x4d\xd2\x9a\x3c\x08\xf8\xe9\xb6\x17\xe3\xc8\x2b\x9b\xb7\xe8\xb8\x8f\xb2\x2a\xc8\x96\xbc\x26\x69\x4d\x1e\x04\xbc\x1d\xfd\x33\x00\x19\x9f\x58\x60\x5a\x08\x00\x00"),
+		},
+		"/charts/cilium/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 11, 3, 603529239, time.UTC),
+			uncompressedSize: 3144,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x4d\x6f\x13\x41\x0c\xbd\xe7\x57\x58\xe5\x50\x09\x69\x5b\x71\x43\xb9\x41\x0f\xdc\x10\x2a\x88\x0b\x42\xaa\x33\xe3\x24\x26\xbb\xe3\xd1\xd8\x93\x12\xc4\x8f\x47\xb3\x69\x3e\x9a\xee\x6e\x21\xa4\x48\x95\x50\x2e\x19\x8f\xc7\x7e\x7e\x63\xbf\x1d\x8c\xfc\x99\x92\xb2\x84\x31\x2c\x5f\x8d\x16\x1c\xfc\x18\x3e\x52\x5a\xb2\xa3\x37\xce\x49\x0e\x36\x6a\xc8\xd0\xa3\xe1\x78\x04\x10\xb0\xa1\x31\x38\xae\x39\x37\x77\x4b\x8d\xe8\x68\x0c\x8b\x3c\xa1\x4a\x57\x6a\xd4\x8c\xaa\xaa\x1a\xfd\x4d\xe4\x4a\x22\x25\x34\x49\xbf\x9f\x22\x4d\xd0\x5d\x60\xb6\xb9\x24\xfe\x81\xc6\x12\x2e\x16\xaf\xf5\x82\xe5\x72\x9b\xfc\xaa\xce\x6a\x94\xae\xa5\xa6\xfe\x9a\x52\xae\x49\x0b\x9e\x0a\x30\xf2\xbb\x24\x39\xb6\x4b\x80\x62\x0a\x64\xb7\x92\x16\x1c\x66\x77\xd1\xdb\x9d\x44\x2a\x39\x39\x7a\xe8\x18\xa5\x66\xc7\xa4\xad\xdb\x92\xd2\x64\xcf\x65\x46\xb6\xfd\x5f\xb3\xee\x16\xb7\x68\x6e\xde\x87\xc0\xb3\x3a\x59\x52\x5a\x3d\x02\x80\x82\x8f\xc2\xc1\xb4\x66\x77\xca\xfc\x67\x67\xbd\x25\x6f\x2e\x4a\xb7\x26\x5d\x5f\xf7\xce\x10\xc4\x93\x3e\xc4\xf8\x2f\xe0\x45\xf1\x5d\x38\xfe\x34\x6b\xf9\x55\x90\xa3\x47\xa3\x23\x38\xba\x57\x7f\x8b\xe2\x52\x0d\x2d\x77\x82\x89\x43\x95\x62\x64\xfa\x6e\x14\x4a\xfb\xeb\x23\xcd\xe0\xb2\x9a\x34\x9b\x0d\x4f\x53\x0e\x5c\x46\xa4\x33\xab\x4b\xb4\xae\xed\x84\x8c\xac\xc7\x7a\x08\x61\xeb\xd0\x35\x35\xbd\xbb\xfb\xc4\xed\x9c\xdc\x7a\xc8\x6f\xd9\xd3\x70\xb4\x7e\xc7\xee\xc0\xf7\x9b\xb5\xc3\xde\x7d\xec\x7e\xc7\xef\xd9\xba\xdd\x0b\x1a\x63\x63\xea\xdf\x18\x68\x98\xf3\x97\xe7\x4f\x25\x8c\x3b\x49\x1e\x56\xc8\xbe\xde\x7f\x01\x26\x80\xd9\xa4\x41\x63\x87\x75\xbd\x02\x4f\x35\x19\xc1\x17\x27\x89\x7e\x16\x6d\xff\xea\x83\xb6\x73\x0a\x2a\x60\x73\x34\xc0\x44\xa0\x86\xc9\x38\xcc\x4a\x80\x09\x71\x98\x6d\x23\x36\x18\x70\x46\x1e\x26\x2b\xb8\xda\x7c\x91\x0e\x86\xfd\xb8\xf1\x5e\x23\x7b\x6e\x12\xdc\x52\x1c\x29\x4d\x25\x35\x60\x73\x02\x4b\x18\xb4\x6e\xef\x1c\x64\x0a\x08\x57\xef\x3f\xac\x79\x75\x12\x0c\x39\x28\xdc\x7c\x92\x36\xfc\x4d\x39\xcb\xa6\x07\x9a\xdc\x29\xe2\x87\x2e\x6d\x5e\x37\x27\xb7\x28\x68\x8b\x3b\x25\x70\x12\x02\x39\xe3\x25\xdb\x6a\x7b\x72\xfb\x2d\x3f\x21\x1b\xff\xa5\xe5\x99\x48\xcb\x5b\x0e\xbe\x4c\x6f\xff\xd3\x4b\x6a\xba\xa6\x69\xb1\x6f\xee\x79\x20\xc7\x08\xe0\xa1\x7a\x1d\x44\xd4\x3c\xf9\x46\xce\xee\xd4\xaa\xf3\x09\x0a\x70\x70\x06\xe0\x89\xde\x9c\x8f\xd5\xbf\xa7\xb0\xa7\x26\x62\x17\xfa\x08\x46\x76\x87\x07\xa8\xf9\x35\x00\x37\x26\x62\x83\x48\x0c\x00\x00"),
+		},
+		"/charts/cilium/values.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "values.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 18, 2, 163953742, time.UTC),
+			uncompressedSize: 725,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xcb\x8a\xdc\x3a\x10\xdd\xfb\x2b\x0e\x9e\x6d\x8f\xef\xdc\x10\x12\x30\x64\x95\x6c\x66\x11\x08\x79\xac\x9b\x6a\xa9\x6c\x8b\x96\x4b\xa6\x54\x72\x67\xfe\x3e\x48\xdd\x1d\x92\x81\xec\x44\xd5\xf1\x79\x95\x1f\xf0\x8d\x2d\xc3\x16\x86\x67\xa3\x10\xd9\xc3\x25\x99\xc2\x5c\x94\x2c\x24\x41\x9a\x20\x6c\x97\xa4\xe7\xa1\xbb\x3d\x8e\x57\xc4\xd8\x01\x0f\x60\x71\xb4\xe5\x12\xaf\xe8\x35\x79\xc6\x65\x61\x81\x29\x49\x9e\x58\x35\xc8\x8c\x8d\xdc\xb9\xea\x4c\x9a\x56\x6c\xc9\xe3\xc4\x76\x61\x16\xf8\x30\x4d\xac\x2c\x86\x25\x65\x83\x24\xcf\x79\x68\xbc\xb9\x6c\x5b\x52\xcb\xe8\xf7\x9f\x91\xa4\x3f\xa0\x9f\x59\x78\xe7\x1e\x24\x1e\xbd\x0f\x99\x4e\x91\x7d\x3f\xfc\xf1\xc6\xca\x24\x19\x42\x16\x76\x86\xa6\x62\x41\xe6\x43\xe3\xab\x11\xeb\x80\x33\x2c\x35\x0f\xcf\x5f\x72\x4d\x97\x6c\x61\xbd\x2a\x63\x2d\xd9\x70\x62\x6c\x9a\xf6\xe0\xd9\xe3\xf4\xd2\xba\x29\xe2\x59\xe3\x4b\x8d\xf2\xbb\x0c\xc0\x8a\x08\xc7\x11\xcd\x60\x13\x29\x99\xfd\x2d\x7e\xdb\x7d\xb8\x3b\x1b\xf0\xf1\xf9\xd3\x57\x04\xc1\x65\x09\x6e\x79\xe5\x10\x8e\xa4\xc9\xb2\x4e\x49\x57\xf6\xb5\x82\x2b\xe4\x78\x83\x1c\x5d\xf0\x3a\xa2\xef\x9b\xce\xe7\xef\x3f\xaa\xf5\x3d\xa8\x15\x8a\x77\x4f\x08\x62\xac\x13\x39\xae\x42\x4c\x6e\xa9\x39\x0f\x78\xba\xd5\x42\xc5\x52\x3d\x33\xbb\x7a\xab\xaa\xb1\x5a\x19\xf1\xd4\x28\xc3\x86\x89\xd6\x10\x03\xb7\x56\xb6\xe4\xf3\x01\xa7\x64\x0b\x48\x19\x2c\xad\xec\xca\x4b\xf0\x85\xe2\x63\x36\x72\x67\xb8\x58\xb2\xb1\x56\xae\x2b\xe4\x18\xb6\xfd\xed\x08\xd3\xc2\x7f\xcd\xde\x8d\x98\x28\x66\xee\x3a\x9a\x59\xac\xfe\x3b\x61\xa5\x99\x47\x9c\x37\xa2\xfc\x9f\x0b\x31\x94\xb5\x03\x8c\xe6\x11\xfb\xff\xc3\xfb\xe1\x4d\x97\x36\x56\xb2\xa4\xff\x40\x3f\xde\xf7\xaf\x3e\xfb\x35\x00\xae\x0d\x1d\x7d\xd5\x02\x00\x00"),
+		},
+		"/charts/contour": &vfsgen۰DirInfo{
+			name:    "contour",
+			modTime: time.Date(2026, 10, 19, 8, 27, 12, 485566104, time.UTC),
+		},
+		"/charts/contour/Chart.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "Chart.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 12, 485566104, time.UTC),
+			uncompressedSize: 147,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xce\x31\x0e\x83\x30\x0c\x85\xe1\x3d\xa7\xf0\x09\xa2\xb0\x55\xac\xbd\x43\xf7\x14\x5e\xc1\x2a\xb5\x23\xdb\xa4\xe2\xf6\x95\x90\xe8\xf8\x0d\x4f\xef\xaf\x8d\x1f\x30\x67\x95\x91\xfa\x90\x66\xf8\x64\xdc\xe2\xf4\x7d\xad\x16\xf4\x52\xa3\x19\x6d\xd3\x83\x65\xa1\x49\x25\x74\x37\x62\x59\x0c\xee\xa7\x4d\xb7\x0d\x46\x5f\x8e\x95\x20\x5d\x0f\x62\xa1\xf7\xfe\x84\x09\x02\x9e\xa4\x7e\x30\x5e\xcb\xd4\xaf\xbb\x92\x87\x5c\x52\x6d\xed\x1f\x50\xf2\x2d\x97\xf4\x1b\x00\xe7\x89\xf9\x31\x93\x00\x00\x00"),
+		},
+		"/charts/contour/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2026, 10, 19, 8, 27, 12, 540556538, time.UTC),
+		},
+		"/charts/contour/templates/contour.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "contour.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 16, 609706589, time.UTC),
+			uncompressedSize: 3675,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4b\x4f\xe4\x38\x10\xbe\xf3\x2b\x4a\xdc\xdd\xa4\x81\xd1\x32\x91\xe6\x80\x80\x95\xd0\xee\xb2\xad\x65\x76\xae\xc8\x24\xd5\x69\x0b\xc7\xe5\xb1\x2b\x0d\x51\x8b\xff\x3e\x72\x5e\x38\xfd\x02\x06\x94\x3e\xb4\xab\x3e\xd7\xeb\x2b\x57\x1c\x69\xd5\x0f\x74\x5e\x91\x49\x41\x5a\xeb\x8f\x96\xd3\x83\xd5\x4a\x80\x9a\x03\xfe\x84\xc9\x0f\xa9\x2b\xf4\x13\x7c\xb2\xe4\xf1\xae\xa4\x1c\xe1\xd0\x50\x8e\x33\x72\x7c\x08\xcf\xcf\x07\x0f\xca\xe4\x29\x5c\xa2\xd5\x54\x97\x68\xb8\xd9\x8c\xda\x63\xa4\x94\x58\x92\xb9\xc5\x4e\x67\xf2\xa0\x2a\x91\x65\x2e\x59\xa6\x07\x00\x5a\xde\xa3\xf6\xe1\x1f\x84\x18\x52\xc8\xc8\x30\x55\xee\x00\xc0\xc8\x12\xd7\xd7\xde\xca\x0c\x53\x58\xad\x60\xf2\x1f\x6a\x94\x1e\x27\x37\xbd\x38\x98\xf6\x16\xb3\xf4\x5d\x49\x00\x38\xb4\x5a\x65\xd2\xb7\x66\xbb\x0d\xbd\x30\x18\x8d\x42\x07\xf0\xa8\x31\x63\x72\x6d\xc8\xa5\xe4\x6c\xf1\x77\x94\xc3\x46\x16\x8c\xa5\xd5\x92\xb1\xc3\x47\xa9\x87\xb5\x34\x86\x58\xb2\x22\x33\xec\x07\xb0\x8e\x4a\xe4\x05\x56\x7e\xa2\xe8\x68\x4e\xae\x94\x9c\x46\xd2\x1d\x40\x2b\x79\x91\xc2\x91\x67\xc9\x3b\x21\xe4\x38\x85\xc3\xb3\x24\x39\x39\xdc\x01\xf1\x99\x93\x16\x53\x38\x64\x57\x61\x0f\x8a\x59\xda\x92\x23\x40\x5b\xf7\x4e\x39\x9f\x2b\xa3\xb8\x7e\x81\x87\x82\x9f\x6f\x48\x43\xe5\x7f\x56\xca\x61\x7e\x59\x39\x65\x8a\xdb\x6c\x81\x79\xa5\x95\x29\xae\x0b\x43\x83\xf8\xea\x09\xb3\x2a\x54\x28\xde\xd9\xda\xbc\xed\xb8\xf8\x8e\xae\x8c\xa2\x0b\x3f\xd1\x52\x73\xf5\x64\x1d\x7a\x3f\xae\x6f\x8f\x78\xc0\x7a\xc4\x79\x30\x79\xe7\x48\xe3\x5d\x93\x6f\xa0\x7e\x40\x77\x0f\x59\x74\x32\xb0\x0f\xd7\x66\x43\xb9\x6c\x5a\x67\xdd\x4f\xf0\xb4\xd5\x49\x03\xef\xfb\xeb\xed\xed\xda\x91\x46\xf9\xb9\x61\xb5\xad\xaa\xd6\xe1\x1c\xdd\x6f\x95\x55\xc0\x23\xaa\x62\xc1\x29\x4c\x93\x24\x92\xb7\xfe\x3a\x5f\xa1\xda\xf1\xa6\xa1\x41\x7a\x3a\xd6\x95\x5b\x8f\x49\xfc\x6c\xb4\xd3\xcb\xc3\x64\x49\x53\x51\xff\x15\xb8\x7a\xa8\xee\xd1\x19\x64\x6c\x3a\x75\x41\x9e\xc3\x4c\x18\x9f\xcf\xf0\x84\x99\x21\x95\x41\x37\x78\x13\x20\x5d\x11\xf9\x16\xe0\xd1\x2d\x31\x5a\x0b\xa1\x4c\xa6\x2b\xcf\xf8\x12\x82\x00\x21\xd0\x2c\xa9\x16\x0b\x66\x2b\x2c\x39\xfe\x76\x96\xec\x50\xfb\x56\x7f\x7a\x7a\x32\x00\x32\x2a\x4b\x69\xf2\xd8\xed\x7a\x96\xaa\x94\x05\xa6\x71\x83\x74\x88\xbb\x46\x13\x53\xde\x08\x66\x95\xd6\x33\xd2\x2a\xab\x53\xb8\x9e\xdf\x10\xcf\x1c\xfa\x30\x7b\x7b\xd4\xfa\xd4\xec\xe9\x73\x3c\x4a\x7f\x28\x51\x18\x84\x29\x9c\x25\x49\xd2\xf7\xa1\xc1\x77\xf4\x21\x40\xa0\x61\xcd\xc8\x88\x8c\xdd\x41\x85\x56\x25\xa6\x8c\x74\x0a\xdf\x2f\x66\x3b\xa9\x12\x59\xb4\x38\xca\xc8\xcc\x55\x71\xd4\x19\x9b\xd4\xb2\xd4\x31\x56\x04\x62\x55\x86\x62\x93\xcc\x4e\x92\x6c\x85\x87\xec\x22\x45\x58\x8e\x80\xb1\x13\x65\xe6\x14\xeb\xc4\xf2\x58\xb4\x61\x09\x32\xba\xde\xc7\x7f\xd3\x2f\x7b\xd8\x6f\xf4\x1f\xe3\x7e\xec\xe2\x75\xe6\x3f\x81\xf7\x7d\xac\x87\xb3\xf1\x0a\xe5\x5b\xc2\x3a\x3d\x3d\xf9\x70\x5c\xbd\x8d\x3d\x81\xf9\x57\x23\x5b\x92\xae\x4a\xfc\x87\x2a\x33\x2e\x63\x19\x24\xb3\xf6\x95\xdb\x72\xbf\xe1\xa0\x6b\x51\x31\x52\x8b\x2d\xa4\x37\xaf\xec\xfc\x2e\x4c\x7d\xc7\xe8\x3e\x46\x7f\x6b\x4c\x84\xeb\x82\xb8\x77\x2a\x2f\xf0\xcd\xbd\xf0\x75\x9a\x1c\x7f\xb8\xea\x83\x91\x8d\xb2\xbf\x91\xfa\xaf\xd3\xe3\x2f\x9f\x10\xc5\xf1\x97\xd7\xa2\xf8\xff\xb2\x8f\x22\x37\xbe\xaf\xec\x45\x3b\x24\xfe\x54\xce\xf3\x6f\x44\x11\xa6\xe1\x0d\xf2\x23\xb9\x87\x14\xc2\x15\x6a\x33\x88\xf0\x12\xbd\xe8\x53\xf6\xe9\xce\xb1\x77\x4f\xc4\x9e\x9d\xb4\xef\x9a\x7e\x2d\xfb\x68\xe4\xbd\xc6\x7c\x9b\x4a\xe6\x79\xb8\x12\x7d\x9b\x1e\xff\x31\x49\x26\xc9\x64\x3a\x02\xc9\xbc\x54\x66\xc0\x04\x7d\x32\x49\xf6\x0d\xb4\x2e\x92\x3d\x23\xad\x43\x7c\xc2\x50\x13\xa1\x74\x6b\x87\xed\xb3\xcf\xa7\x43\xcf\xd2\x71\x1f\xd2\xb9\x7e\x94\x75\x3f\x25\x7c\x7b\x95\x42\x17\xbe\x39\x52\xc8\x71\x2e\x2b\xcd\x62\x10\xf7\xb0\xf6\x0d\x74\x9e\x65\x61\x46\x0c\x7e\xb6\x6a\x6f\xe2\x48\x3a\x04\x93\x46\x37\xfe\x28\x10\xd1\xcd\xf3\xea\x49\xf9\xe1\x86\xdf\xa6\x1f\xe1\xb0\xb4\x5c\x5f\x2a\x97\xc2\xea\xf9\x60\x6f\xbe\xef\x6b\xee\xca\xe6\x92\xf1\x96\x9d\x64\x2c\xba\xfb\x26\xd7\xe1\x4b\xe1\x5f\x73\x89\x1a\x79\xd4\xe9\xbf\x06\x00\xa3\x91\xc9\xaf\x5b\x0e\x00\x00"),
+		},
+		"/charts/contour/templates/crd.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "crd.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 26, 57, 687483485, time.UTC),
+			uncompressedSize: 4636,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x56\x5f\x6f\xe3\x36\x0c\x7f\xcf\xa7\x20\x6e\xf7\xd0\xf6\x66\x5f\xd3\x62\xc0\xe6\x97\xe1\xd0\xe2\xb0\x1b\x6e\x59\x97\x14\x37\x60\x6d\x07\x28\x16\x1b\x6b\x95\x25\x57\xa2\x72\xcb\x86\x7d\xf7\x41\xb6\xb3\xc4\x89\xff\x28\xd9\xa1\x55\x1f\x62\x51\x24\x7f\x24\x7f\x12\xc9\x0a\xf1\x09\x8d\x15\x5a\x25\xc0\x0a\x81\x7f\x12\x2a\xff\x65\xe3\xa7\x6f\x6d\x2c\xf4\xdb\xe5\x78\x8e\xc4\xc6\xa3\x27\xa1\x78\x02\x57\xce\x92\xce\xa7\x68\xb5\x33\x29\x5e\xe3\xa3\x50\x82\x84\x56\xa3\x1c\x89\x71\x46\x2c\x19\x01\x28\x96\x63\x02\x42\x2d\x0c\x5a\x6b\xb4\x23\xb4\x71\xaa\x15\x69\x67\xe2\x0c\x0b\x12\x3a\x4e\x75\x3e\x02\x90\x6c\x8e\xd2\x7a\x15\x80\x54\xe7\x85\x56\xa8\xa8\xa9\x39\xb2\x05\xa6\xfe\xc4\xc2\x68\x57\x24\xd0\x6a\x67\xb9\x8e\x60\x0d\x16\xc0\xa6\xba\xc0\x04\x26\x2c\x47\x5b\xb0\x14\x79\x8d\xab\xf6\x56\x48\x67\x98\xdc\x01\x59\x4a\xaa\x38\x3f\x54\xe0\xa7\x7e\x7f\x04\xc0\x38\x2f\xc3\x64\xf2\xc6\x08\x45\x68\xae\xb4\x74\xb9\xaa\x8d\x45\x75\xc4\xef\x7f\xb9\x9e\x94\x1b\x00\xb4\xf2\xde\x2d\x19\xa1\x16\xf5\x16\x47\x9b\x1a\xe1\x51\xab\x04\xde\x3b\x29\x57\xf0\xec\x98\x14\x8f\x02\x39\x70\x9d\x33\xa1\x4a\x3b\xf5\xf1\x1f\x67\x3f\x4f\x6e\x18\x65\x09\xc4\x3e\x05\xf1\x52\x18\x72\x4c\x66\xda\x52\xfc\xf8\xcc\x55\xc3\xf3\xed\xc7\x19\xcc\x30\x35\x48\x81\xfe\xab\xc3\xf0\x59\x50\x56\x2a\xa7\x06\x39\x2a\x12\x4c\xda\x00\xff\x24\x6d\x6c\x4b\x0b\x93\x35\xe0\xff\x72\x20\x8c\x25\x30\x75\xde\x82\x52\xb1\xd1\xb0\xc0\x3d\xa3\x90\x77\x60\x28\xcd\xda\xbb\xf3\x87\x38\x67\x94\x66\x0d\xc7\x33\x62\xe4\x6c\xa0\xcf\xdb\x0c\x21\x75\xc6\xa0\x22\xb0\xa5\x22\xe8\x47\xa0\x0c\x77\x0b\xbf\x8b\xa2\x3c\x1b\xd7\xaa\x5b\x2e\x9b\x28\xe0\x7a\xe3\x2c\x10\xd1\x96\xc6\x1a\x4a\x13\x60\x27\x98\x2d\x33\xfe\x2a\x30\x29\x38\xf3\x8e\x2b\x6e\xea\x02\xd5\xbb\x9b\x0f\x9f\x2e\x67\x69\x86\x79\x79\x3d\xfd\x76\x61\x74\x81\x86\x04\xd6\x14\xf6\xff\xeb\x9b\x06\xd0\x7d\xc6\xaf\x2d\x26\x34\x05\xdd\x2a\x7e\x79\xd2\xee\xef\xb6\x66\x66\x7b\x15\x8c\x08\x8d\x4a\xe0\xf7\x93\x3b\x16\xfd\xf5\x2e\xfa\xed\x3c\xfa\xee\xe1\xcd\x49\xb4\xfd\x75\x7a\x76\x1f\x9f\xbe\xf1\x3b\x0f\x7f\x5f\x7c\xfd\xcf\xeb\x3d\x3b\xb4\x7e\x66\xc2\xf1\xfa\xb5\x21\x79\xbb\x7c\x10\x7d\x33\x02\x0f\xd0\xa3\x3f\xb9\x8b\xea\x5f\x67\xeb\xad\xd3\xef\x4f\xee\xe3\x5e\xf9\xe9\xd9\x6b\xf8\x0a\xae\x27\xb3\x68\x3c\xbe\xb8\x04\xeb\xe6\xd5\xa3\xd1\xea\x35\x17\x4a\xe4\x2e\xbf\x31\x9a\x74\xaa\xe5\xfa\x89\x3f\x3e\x08\x54\x2e\xef\x52\x07\x88\xe0\xd5\x38\xbe\x7c\xd5\x2f\xbf\x18\x90\x8f\x9b\x72\x4b\x86\x11\x2e\x56\xc9\x28\x18\x6c\x3b\xc8\x08\xa6\xda\x29\x3e\xd5\xf3\x96\x64\x45\xf0\x2b\x8a\x45\x46\xc8\x3f\x22\xb3\x34\xc5\x67\x87\x96\x46\x8d\x33\xe5\xb1\x29\x53\xbc\x6c\x36\xcd\x15\xc1\x54\xa8\xc5\x0f\xcc\x66\x2d\xa2\x9f\xd8\x42\xe2\xb2\x21\xc8\x90\x49\xca\xae\x32\x4c\x9f\xda\x03\xd3\xf3\x3f\x30\xdd\x05\x60\xf0\xd9\x09\x83\xbc\x2d\xb8\x82\x51\x36\x0a\xa7\xb5\x3f\xbe\xbf\xdb\x9b\xd6\x26\x89\xef\xdf\xc6\x67\xfb\x17\xac\xec\x8a\x4b\x26\x67\x98\x6a\xc5\x5b\xaf\x53\xe5\xc1\x1f\x5c\xa0\xd9\x93\x93\xc8\x51\x3b\x3a\x5a\xdf\xa9\x2a\xb5\xab\xdb\xcc\xa0\xcd\xb4\xe4\x57\xda\x29\x3a\xdc\xd0\x17\x30\x53\xf5\xa9\x5d\x9d\xca\x2d\x33\x86\xad\x76\x24\x82\x30\x6f\x09\xb9\xbb\xea\xbe\xee\x9b\x16\x18\x5a\x7a\xa8\x74\xda\x04\x83\xf5\x1f\x66\x80\x6f\x68\x12\x17\x8c\x3a\xde\xca\x1e\x7a\x0f\x87\xbb\xe9\xb1\xad\xc2\xfe\xa8\xfd\x52\x3d\x6f\x78\x40\xf0\xcd\x04\xbc\xdc\x3b\x5e\x0f\xad\x7e\x82\x7d\x19\xf4\x0d\x6c\xe5\x7c\xde\x62\xd1\xa2\x59\x8a\xb4\x2b\xd9\xdd\x3c\xef\x65\x7b\x20\x4b\x86\x79\xd2\xcb\x94\xfa\xcd\xd4\xa6\xcb\xfa\x30\x95\x86\xc8\x14\x58\x90\xdd\x92\x0c\xd4\xe3\xfc\xf2\x9b\xce\x7a\xd4\xe6\xb4\x69\x7d\xa7\x86\xdf\xab\xed\xbf\xcf\x65\x3b\xfc\xff\x76\xba\xda\xf7\x11\x79\xea\x9f\x3d\x06\x5b\xfc\x11\xed\x3e\xa8\xf5\x07\x8e\x01\x83\x23\x41\xe0\x78\x70\xe0\x2d\x09\xbb\x29\x9d\x23\xc4\xa1\x57\xa2\x6f\xb4\x38\xa2\xe4\x61\x0d\xe7\xa0\xf1\xe3\x70\x0a\x87\x8d\x25\xc7\xd9\x3d\x60\x5c\x39\xce\xc1\x17\x30\xff\xef\x00\xf2\xe4\xf9\xd5\x1c\x12\x00\x00"),
+		},
+		"/charts/contour/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 12, 545253949, time.UTC),
+			uncompressedSize: 956,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x3d\x6f\xe3\x30\x0c\xdd\xf5\x2b\x08\xef\xf6\x21\xdb\xc1\xdb\xdd\x0d\xb7\xdd\x90\x03\xba\x04\x19\x64\x99\x71\xd8\xd8\xa2\x20\x52\x6e\xd1\x20\xff\xbd\xf0\x47\x80\xb6\x4e\x03\xa3\xdd\x24\x3e\xf2\x3d\xe2\xf1\xd9\x40\x0f\x18\x85\xd8\x97\x10\x2b\xeb\x0a\x9b\xf4\xc8\x91\x5e\xac\x12\xfb\xe2\xf4\x53\x0a\xe2\x1f\xfd\xa6\x42\xb5\x1b\x73\x22\x5f\x97\xf0\xa7\x4d\xa2\x18\xb7\xdc\xe2\x6f\xf2\x35\xf9\xc6\x74\xa8\xb6\xb6\x6a\x4b\x03\xe0\x6d\x87\x25\x38\xf6\xca\x29\x9a\xc8\x2d\x6e\xf1\x30\x00\x36\xd0\xdf\xc8\x29\xdc\x51\x32\x00\x0b\x8d\x05\xa5\xa4\xea\x11\x9d\x4a\x69\xf2\xb9\xfb\x3f\xc6\x9e\x1c\xfe\x72\x8e\x93\xd7\xc5\xc0\xf4\x97\x60\x1d\x96\x70\x3e\x43\xb1\xc5\x16\xad\x60\xf1\xef\x5a\x86\xcb\xc5\xe4\x79\x6e\xbe\x69\xc7\x3d\x1f\x52\x8b\xe3\xc6\x57\x17\x64\xb0\x24\x87\x2c\x33\x00\x11\x85\x53\x74\x38\xd7\x1c\xfb\x03\x35\x9d\x0d\x32\xb6\xa0\xaf\x03\x93\xd7\xe9\xe7\xb9\xc6\xe9\x15\xb8\x9e\x1e\x82\x2e\xe2\x08\xf7\x18\xab\x99\xa4\x25\x19\x9c\xc8\xe1\xc9\xaa\x3b\xae\x13\xbe\x72\xbf\xa1\x69\x50\xd7\xcd\xca\x74\x83\x1b\xe3\x6b\xb6\xc1\x67\x45\x3f\xf8\x2e\x4b\x66\xf2\x4d\x44\x91\x2f\x50\xc3\x2e\x9b\xfd\x2f\x8e\x18\x94\xb8\x70\xdc\x65\xfb\x77\x12\xb0\xcb\x66\x81\xc8\x49\x51\xb2\xfd\x2a\x99\xa1\x14\x92\xce\x87\x98\xa1\x30\x42\x1f\x83\xd4\x6f\xcc\xcd\x98\x7e\x1e\x96\x75\x81\x7d\x1d\x00\x9b\xd0\x12\xd8\xbc\x03\x00\x00"),
+		},
+		"/charts/contour/templates/service.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "service.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 12, 488310728, time.UTC),
+			uncompressedSize: 576,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x41\x6b\x3a\x31\x10\xc5\xef\xf9\x14\x0f\xef\x2e\xff\x3f\xf5\x50\x72\xed\xb5\xc8\xd2\x8a\x57\x99\x66\xc7\x36\x34\x66\xd2\x64\x14\x65\xd9\xef\x5e\xa2\xbb\x20\x45\xa1\xbd\x65\x26\xef\xbd\xfc\xf2\xfa\x7e\x0e\xbf\x05\x7f\xa1\x59\x53\xd8\x73\x69\xf8\x98\xa4\xf0\x66\x27\x1d\x63\x16\xa5\xe3\x56\xb2\xce\x30\x0c\x86\x92\x5f\x73\x2e\x5e\xa2\xc5\xe1\xbf\xf9\xf4\xb1\xb3\x78\xe5\x7c\xf0\x8e\xcd\x8e\x95\x3a\x52\xb2\x06\x08\xf4\xc6\xa1\xd4\x13\x40\x29\x59\x38\x89\x2a\xfb\x6c\x80\x48\x3b\xfe\x39\x97\x44\x8e\x2d\xfa\x1e\xcd\x0b\x07\xa6\xc2\xcd\x72\x5a\xd7\x77\x4b\x62\x57\xc3\xf4\x94\xd8\x62\x39\x22\x19\x80\x8f\xca\x39\x52\x58\x65\xda\x6e\xbd\x6b\x25\x78\x77\xb2\x78\x16\x47\xc1\x00\x85\x03\x3b\x95\x7c\x13\x24\x49\xd6\x33\xe2\x7c\x64\xfa\x50\x4d\x67\x61\xbd\xb1\x78\xfc\x77\x19\xb2\xa8\x38\x09\x16\xab\xa7\xf6\xbc\x51\xca\xef\xac\xed\x24\x1a\x0b\x9c\xda\xab\x29\x9b\xda\xda\xa6\xc6\x54\xfa\xea\x99\x6a\xbc\x7c\xf2\xae\xb4\x66\x71\xec\x2e\xae\x6b\xae\x72\x05\xb6\x58\x3c\xfc\x82\xac\xaa\x6e\xa0\x95\x3f\xb0\x95\xbb\x70\x7d\x3f\x07\xc7\x0e\xc3\x60\xbe\x07\x00\x07\xd3\x33\x50\x40\x02\x00\x00"),
+		},
+		"/charts/contour/values.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "values.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 12, 486580082, time.UTC),
+			uncompressedSize: 741,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xc1\x6e\xdb\x30\x10\x44\xef\xfc\x8a\x81\x75\x75\x18\xa5\x0d\xd0\x80\x40\x6f\x3d\x07\x05\xfa\x01\x02\x2d\x6d\x2c\xc2\x34\x57\xe0\xae\xe4\xfa\xef\x0b\xd2\x96\x63\xe7\xd2\x9b\x30\xbb\xfb\x66\x86\x6a\x30\xf2\x09\x3a\x12\x42\xda\x67\x12\x41\xcf\x49\x33\xc7\x48\x19\x41\x40\x7f\x27\x16\x1a\x2c\x64\x9e\x26\xce\x2a\xd8\x8c\x2c\xfa\x4e\x7a\xe2\x7c\xd8\xc0\xa7\x01\x9b\xc4\x03\xfd\xe6\xac\x1b\x6b\x9a\x2f\xf3\x3c\x27\x81\xc7\x2f\x4f\x47\x4e\x7f\x48\xc1\x09\xb4\x50\x3e\xdf\xfc\xca\xf1\x16\x31\x88\x52\x0a\x69\x5f\x16\x8a\x11\xde\xda\x0a\x7f\x7d\xfd\x0e\xfe\xa8\x09\xcb\x66\x75\xb8\xf9\xdd\xf0\x34\x45\x3e\x1f\x29\x55\xfe\x3d\x59\xb6\x6b\x05\xec\xce\xf0\x78\xbf\x9e\x42\x28\x2f\xa1\x27\x6b\x2e\xe3\xee\xc8\x03\x39\xdc\x65\x37\x0d\x32\x4d\x31\xf4\x5e\xd6\x00\x9f\x36\x5b\xcc\x42\x03\x4e\x23\xa5\x2b\xbf\x02\x7e\xae\xc9\xac\x59\x6f\x1d\xbe\x99\x06\x45\xaf\xb5\x2a\x6b\x54\x9d\x6a\xb9\xf2\x21\xff\x65\xc1\xc7\xc8\xbd\xd7\x4b\x87\xc3\xbc\xa3\x9c\x48\x49\x10\x3e\xd0\x5a\x53\x20\x5d\x59\xee\x8a\x81\x43\x5b\x15\x79\x94\x4c\x83\xe8\x77\x14\x8b\xfd\xc3\xfb\xe0\x34\x86\x7e\xac\xef\xfb\xf8\xe7\xa5\x1f\x69\x98\x23\x0d\x50\xb6\xa6\xec\x76\x99\x23\x75\x15\xe3\x6a\xa3\xa7\x22\xd8\xcf\x40\x36\xf0\xf3\x15\x7e\x77\xb0\xf8\x38\x93\x03\xa5\x85\xcf\xc6\x14\x13\x9e\x73\x17\x8e\x7e\x4f\x0e\x87\xc9\x7b\x79\xbe\x8a\x6e\x69\xed\x9b\xa9\x8b\x8f\xf3\x2a\xb9\xe5\xc5\xfe\xb0\xad\x11\xf5\x2a\x43\x57\x9e\x2a\x2b\x7d\x21\x5d\x86\x4f\xeb\xd0\x2d\xad\x7d\x31\xff\x06\x00\x88\x1c\xf4\x38\xe5\x02\x00\x00"),
+		},
+		"/charts/flannel": &vfsgen۰DirInfo{
+			name:    "flannel",
+			modTime: time.Date(2026, 10, 19, 8, 10, 25, 258135323, time.UTC),
+		},
+		"/charts/flannel/Chart.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "Chart.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 10, 25, 258135323, time.UTC),
+			uncompressedSize: 129,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8d\x41\x0e\xc2\x30\x0c\x04\xef\x7e\x85\x5f\x10\x25\x1c\x7b\xe5\x0f\xdc\x0d\xdd\x82\xd5\xe0\x58\x4e\x28\xe2\xf7\x08\xa4\xf6\xb8\xab\xd1\x8c\xb8\x5e\x10\x5d\x9b\x4d\xbc\x15\x9a\xd1\x6f\xa1\x3e\xfe\xfb\xfc\x90\x18\xbc\xb4\xe0\x19\x5e\xdb\x47\xed\xce\x4b\x15\x33\x54\x36\x8c\x77\x8b\xf5\x77\xa9\xf1\xfa\xba\x22\x0c\x03\x9d\x4c\x9e\x98\x76\x8c\xb6\xdd\x9d\x53\x49\x99\xc4\xfd\xa8\xe5\x54\x4e\x29\xd3\x77\x00\xe3\xc2\x49\xe0\x81\x00\x00\x00"),
+		},
+		"/charts/flannel/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2026, 10, 19, 8, 10, 25, 268860489, time.UTC),
+		},
+		"/charts/flannel/templates/kube-flannel-cfg.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "kube-flannel-cfg.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 10, 25, 268860489, time.UTC),
+			uncompressedSize: 865,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x51\xcd\x6e\xdb\x30\x0c\xbe\xeb\x29\x08\x9d\x1b\xa3\xc5\x6e\x3e\x6e\x03\x76\xca\xb0\xc3\xd0\xcb\x30\x04\x8c\x4c\x7b\x5c\x14\x4a\x95\xe4\x76\x81\xeb\x77\x1f\xe8\xd8\x71\x86\x6d\x29\x6c\x18\x12\x3f\x7e\x3f\xa4\x0f\x2c\x4d\x0d\x1f\x82\xb4\xdc\x6d\x31\x1a\x8c\xfc\x48\x29\x73\x90\x1a\x9e\x1f\xcc\x91\x0a\x36\x58\xb0\x36\x00\x82\x47\xaa\xe1\xd0\xef\x69\xd3\x7a\x14\x21\xbf\x71\x6d\x37\x03\x39\xa2\x5b\xd0\x7c\xca\x85\x8e\x06\xc0\xe3\x9e\x7c\x56\x2e\x40\x61\x4a\x35\x48\x68\x68\xba\x62\x8c\x35\xcc\x32\x66\x71\x70\xc2\x1b\x17\xa4\xad\x7e\x66\xf5\x7f\x9d\x3a\x87\xe9\x0b\x60\xd5\xdf\xd6\x60\xdd\x3e\xdd\xdb\xbb\xa5\xea\x64\x09\xac\xd8\x7d\xf5\xae\x7a\x58\xc1\xe8\xfb\x8e\x25\xdb\x1a\xbe\xcd\xa5\x55\x4f\x1f\x5b\x4e\x91\x94\x37\x27\xb9\x30\xf5\xb5\x0d\x79\xea\xb0\x68\xc3\x35\x09\xc0\xfe\x40\x4e\x91\x65\x1b\x1a\x05\x4b\xea\xe9\x9a\x08\x60\x39\x7f\xa4\x16\x7b\x5f\x3e\x61\xa1\x17\x3c\xcd\x5d\x57\x4d\xe3\xe5\x3c\xde\xdd\xce\x16\x43\x2a\x47\x8c\x7f\x66\x73\x18\x71\xcf\x9e\x0b\x53\xfe\x3b\x9f\x52\xb6\x18\x23\x4b\x97\x6f\x5a\xcf\xa7\xef\x66\xb9\x09\x95\x1b\xbf\xe0\x33\x95\x97\x90\x0e\x6a\x38\x40\xf5\x88\xbe\xa7\x5c\xc9\xb9\xb8\x53\x1a\x77\x55\x0c\xcd\xce\x71\x93\xe0\x15\x9e\xfa\x50\x08\xc6\xcb\x80\xf6\x3d\xba\x03\x49\xa3\x7c\x33\x0c\x1b\xe0\x16\xe8\xe9\x7f\x42\xfb\x73\x33\xd8\xe7\x5f\x1e\xc5\xc2\xb8\xe6\xb6\x5f\xe7\xdd\x9c\xa1\x75\x33\xf6\x4b\x48\xe5\x66\xbc\x89\xb1\xd3\x05\xa9\xa0\x86\x20\x9f\xe9\x5f\xe2\xc3\xf0\x56\xb2\x75\xc2\x69\x1a\x2d\x5d\x74\x46\x03\x00\x30\x9a\xdf\x03\x00\x7b\xc2\x56\xdf\x61\x03\x00\x00"),
+		},
+		"/charts/flannel/templates/kube-flannel-ds.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "kube-flannel-ds.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 10, 29, 406068475, time.UTC),
+			uncompressedSize: 2391,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\xe3\x46\x0c\xbd\xfb\x57\x10\xee\xb5\xb2\x92\x43\x2f\x03\xf4\x10\x24\x29\xb0\x40\xed\x06\x9b\xc5\xf6\x50\x14\x01\x3d\xa2\x6c\x36\xf3\xb5\x33\x94\x9b\xc0\xc8\x7f\x2f\x46\x1f\x5e\xc9\x51\x13\x14\xdd\xc5\xe8\x60\x91\x8f\x8f\x8f\x9c\x19\xca\x18\xf8\x33\xc5\xc4\xde\x29\xc0\x10\x52\x79\xb8\x5c\x3c\xb2\xab\x14\xdc\x20\x59\xef\xee\x49\x16\x96\x04\x2b\x14\x54\x0b\x00\x87\x96\x14\x3c\x36\x5b\x2a\x6a\x83\xce\x91\x29\xaa\xd4\xdb\x53\x40\x3d\x38\xd3\x73\x12\xb2\x0b\x00\x83\x5b\x32\x29\x87\x02\x08\x53\x54\xe0\x7c\x45\xed\x2b\x86\xa0\xa0\x67\x59\xa4\x40\x3a\xa3\x12\x19\xd2\xe2\x63\xfe\x0d\x60\x51\xf4\xfe\xd7\x11\xc5\x59\x14\x80\x90\x0d\x06\x85\x7a\xfc\x48\x2a\xc0\x34\xfb\x8c\x82\x19\x3e\x80\x41\x49\x5e\x19\x79\x3f\x51\x94\x9f\x2d\x09\xae\x72\x0f\xa2\x23\xa1\xb4\x62\x5f\xfa\xa4\xc0\xb0\x6b\x9e\x7a\xd0\xde\x27\xd9\x90\xfc\xed\xe3\xa3\x02\x89\xcd\x90\x2f\x44\xf6\x91\xe5\xf9\xda\x60\x4a\x9b\xb6\x99\x5d\xab\x8a\x9c\xaa\xd0\x91\x85\x35\x9a\x1e\x2d\xde\x50\x44\x61\xef\x46\x35\xfc\x00\x6b\x7c\x24\x48\x4d\xa4\x41\x37\xec\x48\x12\x24\xbd\xa7\xaa\x31\x54\x81\x77\x80\xc6\xb4\x9d\x4e\xab\x53\x60\x01\x3e\x64\x3a\x1f\x15\xdc\x3e\x71\x92\x74\x72\x01\x50\x5d\x93\x16\x05\x1b\x7f\xdf\xd3\xf4\xce\x44\xf1\xc0\x9a\xae\xb4\xf6\x8d\x93\x4e\xf2\xb8\x5d\x00\xec\x58\xae\xbd\x13\x64\x47\x71\x22\xf4\xd3\x9e\x13\xe8\xc1\x05\xec\x92\xa0\x31\x09\x64\x4f\x70\xbd\xf9\x00\xae\xeb\x50\x86\xd4\xbc\x83\x9a\x0d\x65\xed\x84\x7a\xdf\x8a\x1f\x6b\xef\x4e\x5e\x4f\x51\x68\xc7\x27\x1f\x00\x5b\xdc\x91\x82\xe3\x11\x56\x9f\xd1\x34\x79\x4b\xb2\x05\x5e\x5e\xd4\xc8\x26\xb8\x83\x97\x97\x51\x98\xf6\xd6\xa2\xab\xbe\x2a\xce\xab\x00\x1d\x46\x06\x8c\xbb\x51\x49\xf9\x29\xa0\xa8\xcf\x0c\x25\x89\x2e\xc7\x97\xa2\xd4\x8e\x8b\x5c\xd5\xea\xaf\xe4\xdd\x1c\x5a\x3b\x2e\x1d\xc9\xaa\x2a\x2f\x2f\x86\xa8\x55\x8e\x30\x9c\x64\x14\x70\xf0\xa6\xb1\xb4\xce\xbd\x7f\xa5\xa3\x6b\xc9\xb4\x15\x79\xd9\x8c\xbe\x43\xd9\xab\xb3\x5c\xb3\xf1\x7d\xf2\x42\xd7\xbb\x77\x78\x26\x15\xf6\xd8\xd3\xee\x8e\xd4\x0d\xcc\x63\xfc\x77\xdb\xad\xd2\x07\x29\xb7\xec\xca\x3e\x51\xf5\xde\xe6\x15\x1c\x0a\x8b\xe9\xcb\x2b\x7b\x2b\x37\x35\x5b\x47\x52\xd8\x5d\x5c\x1c\x8f\x05\x70\x7d\x12\xd4\x1f\xd6\x87\xee\xb0\xae\xb8\x46\x4d\x53\x85\x3d\x7d\x76\xfc\x7c\x3c\xbe\x1b\x98\xf9\xc9\x55\x53\x8e\x48\xc9\x37\x51\xd3\x99\xee\x48\x5f\x1a\x4a\xe7\x47\x00\x40\x87\x46\xc1\xf2\xf2\xe2\xc2\x2e\xcf\x3c\x96\xac\x8f\xcf\x0a\x96\x3f\x5d\xac\x79\xea\x34\x6c\xf9\x9b\x50\x25\xd2\x4d\x3b\xcd\xbc\x13\x7a\x92\x29\x63\x88\x7c\x60\x43\x3b\xaa\x14\xd4\x68\xd2\xd7\x91\x9b\x97\xc6\x80\x5b\x36\x2c\x7c\x5e\x2a\x00\x56\x95\x82\x3f\x96\x9b\xdb\x4f\x0f\x57\x37\xeb\x0f\x9b\xe5\x8f\xd0\xbe\x7c\xbc\xfa\x7d\xf9\xe7\x08\x4b\xee\xa0\x66\x8f\xf4\xdd\x6f\x37\x0f\x9b\xab\xf5\xed\xc4\x09\x70\xc8\xb3\xe1\x97\xe8\xed\x79\x42\x80\x9a\xc9\x54\x1f\xa9\x7e\xed\xe9\x7d\xdd\x35\x18\x3e\x85\xab\x9c\xe7\xcd\xd4\xf7\x77\x57\xd7\xdf\x39\x7f\xfb\xbd\xfd\x4f\xa3\x22\x36\xee\x8d\x2b\x1e\x9b\xd3\x25\x9a\x8d\xfe\x7f\x83\xa2\x1b\x64\x23\x61\xf3\xa2\xf2\x87\xb3\xad\x75\x64\x03\x08\xff\xae\x70\x7e\x0c\xbe\x49\x33\x3f\x13\x07\xa2\xf9\x32\xbb\x5b\xbf\xc6\x30\x65\x9c\xf9\x3f\xa4\xeb\xdd\xe2\x9f\x01\x00\x08\xc6\x9f\x4c\x57\x09\x00\x00"),
+		},
+		"/charts/flannel/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 10, 25, 265771264, time.UTC),
+			uncompressedSize: 817,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x3d\x6f\xf2\x40\x0c\xc7\xf7\xfb\x14\x16\x0b\x53\x82\xd8\x1e\x65\x7b\xda\xa1\x5b\x07\x2a\x75\xa9\x18\x9c\x8b\x01\x97\xcb\xdd\xe9\xec\x4b\x4b\x3f\x7d\x95\xf0\x22\x0a\xa1\x12\x52\x47\xbf\xfd\xfc\xf7\xcb\x96\x7d\x53\xc1\xa3\xcb\xa2\x94\x16\xc1\x91\xc1\xc8\xaf\x94\x84\x83\xaf\x20\xd5\x68\x4b\xcc\xba\x09\x89\xbf\x50\x39\xf8\x72\xfb\x4f\x4a\x0e\xb3\x6e\x6e\x5a\x52\x6c\x50\xb1\x32\x00\x1e\x5b\xaa\x60\xe5\xd0\x7b\x72\x26\x65\x47\xd2\xbb\x0b\xc0\xc8\x4f\x29\xe4\x28\x15\xbc\x4d\xe9\x53\xc9\xf7\x64\x99\x2e\x0d\x00\x40\x22\x09\x39\x59\x1a\xa2\x31\x34\x42\x36\x27\xd6\x5d\x0c\x8e\x2d\xd3\x31\xad\xa3\x54\x0f\x29\x59\xe8\xa2\xf2\x19\xdb\x43\xb5\xc4\xf2\xd0\xbf\xcc\x3e\x26\xee\xd8\xd1\x9a\x9a\xe9\xf2\x4a\xc7\x64\x72\xd9\x7d\x30\x7b\xb9\xbd\x86\xb3\x96\x27\xff\x9a\xf4\x1e\x8c\x0f\x0d\x8d\x72\x1c\x8b\x9e\x8c\x0f\x54\xbb\xb9\x1b\x3b\x13\x45\xcd\xa3\xf4\x38\x00\x8b\xa2\x30\x57\x67\x7d\x60\xdf\xb0\x5f\xff\xc9\x75\x83\xa3\x05\xad\xfa\xc0\x51\xf7\x2f\x2c\x03\x70\xfd\x63\x97\x48\xc9\xf5\x3b\x59\x3d\xfc\xcc\x3e\xff\x85\x52\xc7\x96\xfe\x5b\x1b\xb2\xdf\x2f\xed\x67\xd1\xd1\x23\x11\x2d\x55\xb0\xcd\x35\x15\xb2\x13\xa5\x76\x58\xc1\xf9\xa4\xdd\xdc\x8c\x42\x6f\x0f\x79\x13\xfd\x3d\x00\x41\xa5\xf3\x5a\x31\x03\x00\x00"),
+		},
+		"/charts/flannel/values.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "values.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 10, 29, 406534383, time.UTC),
+			uncompressedSize: 575,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x91\xc1\x6e\xdb\x30\x0c\x86\xef\x7e\x8a\x1f\xce\x75\x71\xe3\xa0\xe8\x06\x03\xbb\xed\xb2\x5b\x81\x6d\xe7\x80\xb1\x28\x5b\x88\x4d\x79\x94\xd4\xac\x6f\x3f\x50\x6e\x91\x9b\x6c\xf2\xff\x3e\x52\x3a\xe0\x17\xe7\x84\x3c\x33\x1c\x67\x0a\x0b\x3b\x8c\x51\x7c\x98\x8a\x52\x0e\x51\x10\x3d\x84\xf3\x3d\xea\xad\x6b\x3e\x0e\x97\xbd\x63\x68\x80\x03\xb6\xe8\xf0\xf3\x15\x4a\x32\xb1\x35\x1b\x6a\x5c\x4a\xca\xac\x5f\xb0\x96\x94\x71\xe5\xca\x4f\xb4\x32\x68\x77\x59\x28\x95\xab\x70\xb6\xc8\xad\x5c\x59\x85\x33\xa7\xae\x81\x01\x2f\x63\x70\x3a\xa0\x3f\x75\x7d\x7f\xee\x4e\xdd\xe9\xa9\x7f\xa9\xb2\x2b\x8d\x37\x16\x67\x21\xbf\x90\x08\x2f\x1d\x52\xd9\xb6\xa8\x39\xa1\x7d\xfb\xb7\x90\xb4\x20\x71\x68\xe7\x98\xf2\x71\xba\xb7\x46\x3c\x3c\x3e\xa1\xfc\xb7\x04\xe5\x04\x5a\x16\x48\x74\x76\x52\x46\x90\xc7\x8c\x0b\xbd\xb3\xe2\xfc\x58\x1b\x9f\xde\x01\x55\x51\x91\x25\xb1\xc3\x7d\x66\xf9\x2c\x7e\xaf\xb5\x0e\xbf\x67\xc6\x9f\x1f\xaf\xb0\xa1\x90\x67\x8d\x65\x9a\x71\x9f\xc3\x38\xef\x69\x6c\xd6\x6f\x97\xae\x24\xc9\xb3\x2a\x3b\x73\xd4\xe2\xc5\x52\x03\xbe\x3d\x7f\x3d\x57\x8b\xd8\x40\xd1\x23\x48\x66\xf5\x34\xf2\xee\xf5\x51\xf7\x5f\x47\x5b\x0c\x63\x5c\xd7\x22\x61\xac\x2f\x66\xac\x43\xdd\xe6\x11\x8a\x1e\x8e\x3d\x95\x25\x43\x63\xc9\x8c\x90\x76\x50\xf0\xe0\x75\xcb\xef\x16\x0a\xc6\x1f\xd0\xb6\x4d\x13\x56\x9a\x78\xc0\x6d\x23\x4a\x4f\x1f\x37\xdd\x64\x9a\x06\xbc\x9d\xba\xfe\xdc\x9d\x8e\xb4\xba\x97\xe7\xe6\xff\x00\x1d\xb7\xa5\xa1\x3f\x02\x00\x00"),
+		},
+		"/charts/ingress-nginx": &vfsgen۰DirInfo{
+			name:    "ingress-nginx",
+			modTime: time.Date(2026, 10, 19, 8, 27, 39, 88727824, time.UTC),
+		},
+		"/charts/ingress-nginx/Chart.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "Chart.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 88727824, time.UTC),
+			uncompressedSize: 141,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcd\x41\x0a\xc2\x40\x0c\x40\xd1\x7d\x4e\x91\x0b\x38\xa4\xb8\xeb\xd6\x3b\xb8\x1f\xdb\x38\x06\xc7\x24\x24\x63\xd1\xdb\x0b\x82\x42\x97\x1f\x3e\xbc\xea\x72\xe6\x48\x31\x9d\x71\x9b\x60\xe5\x5c\x42\x7c\x7c\xfb\x74\xab\x31\xf0\x6a\x81\x2b\x7b\xb7\xb7\x68\x43\xd1\x16\x9c\x79\xd0\x26\xfa\xc2\xc5\x74\x84\xf5\xce\x81\xa2\x78\x7f\x5e\x38\x94\x07\x27\x68\x7d\xf0\xbc\x9f\x61\xfb\x39\x54\xa6\x42\x50\xdd\xff\x32\x95\x23\x15\x82\xcf\x00\xaf\x6a\x94\x31\x8d\x00\x00\x00"),
+		},
+		"/charts/ingress-nginx/templates": &vfsgen۰DirInfo{
+			name:    "templates",
+			modTime: time.Date(2026, 10, 19, 8, 27, 39, 94267356, time.UTC),
+		},
+		"/charts/ingress-nginx/templates/configmap.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "configmap.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 91688234, time.UTC),
+			uncompressedSize: 471,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x8e\x31\x6e\xc3\x30\x10\x04\x7b\xbe\xe2\x3e\x40\x06\x69\xd9\xa6\x4e\x8a\x14\xee\x4f\xd4\x5a\x38\x48\x3a\x12\x3c\x4a\x30\x20\xe8\xef\x86\x65\xfb\x01\x6e\x0c\xb7\xbb\xd8\xd9\x19\x45\xfb\x48\x3f\x59\xcf\x32\xfc\x72\x71\x5c\xe4\x84\x6a\x92\x35\xd2\xfa\xed\x66\x34\xee\xb9\x71\x74\x44\xca\x33\x22\xe9\x20\x7a\xf1\xe9\x18\x2c\x95\x9b\x64\x7d\x74\x56\x38\x21\xd2\xb6\x51\xf8\xc7\x04\x36\x84\xbf\x67\x4c\xfb\xee\x88\x26\xee\x30\xd9\x8d\x45\xc4\xa5\x84\x71\xe9\x50\x15\x0d\x16\x24\x7f\xdd\xf9\xa2\x43\x85\x99\x3f\x7e\x9c\xf7\xde\xbd\xac\xd8\x52\xf1\x86\xba\x4a\x82\x7d\x9a\xdb\xd2\xbf\xc5\xed\x3a\x00\x5c\xff\x11\xfb\xd7\x01\x00\x00"),
+		},
+		"/charts/ingress-nginx/templates/controller.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "controller.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 94267356, time.UTC),
+			uncompressedSize: 3697,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\x1b\x37\x13\xbe\xeb\x57\x0c\x9c\xf7\xf0\xf6\xb0\xb2\xdd\xa4\x40\xb1\x40\x0a\xa8\xb6\x92\x18\x4d\x55\x21\x72\xd3\xa3\x40\x73\x67\xb5\x03\x73\x49\x86\x1c\xea\xa3\x42\xfe\x7b\xc1\xfd\x90\x77\xb5\x8a\xab\x04\x08\xd0\xe5\x9e\x38\xc3\x67\x66\x9e\xf9\x20\x85\xa5\x8f\xe8\x3c\x19\x9d\x82\xb0\xd6\x5f\xae\xaf\x47\xfb\x7d\x02\x94\x03\x7e\x82\xf1\x47\xa1\x02\xfa\x31\x6e\xad\xf1\xb8\x2c\x4d\x86\x70\xa1\x4d\x86\x73\xe3\xf8\x02\x3e\x7f\x1e\x3d\x92\xce\x52\xb8\x45\xab\xcc\xae\x44\xcd\xd5\x61\x54\x1e\x3b\x42\x81\xa5\xd1\x0b\x6c\x64\x3a\x8b\xa2\x12\x59\x64\x82\x45\x3a\x02\xd0\xa2\xc4\x14\xf4\x8a\xf4\x36\x21\xbd\x72\xe8\x7d\x22\x8d\x66\x67\x94\x42\xd7\x28\x78\x2b\x24\xa6\xb0\xdf\xc3\xf8\x03\x2a\x14\x1e\xc7\xb3\x76\x3b\x02\x02\x28\xf1\x80\xca\x47\x40\x88\xa1\x8c\x1f\xc3\x03\x3a\x8d\x8c\x7e\x4c\xe6\xb2\x36\xd2\xc2\x57\xc6\x46\xde\xa2\x4c\xbf\x2a\x5c\x00\x87\x56\x91\x14\xbe\x76\xa5\x39\xd0\x6e\x46\x47\x3a\x41\x02\x78\x54\x28\xd9\xb8\xda\xab\x52\xb0\x2c\xde\x77\xdc\x3c\xd7\x51\x00\xc6\xd2\x2a\xc1\xd8\x00\x75\xd8\x03\xe8\x87\xfe\x35\xa8\x71\x09\xad\x0d\x0b\x26\xa3\x3b\x00\xd6\x99\x12\xb9\xc0\x50\x71\x67\x8d\xe3\x14\x2e\xae\xaf\x7e\xfc\xe9\xd5\xc5\x17\x74\xbc\x74\xc2\x62\x0a\x17\xec\x02\xd6\x4a\x35\xbd\x8d\x95\x3c\x27\x4d\xbc\x7b\x32\x11\x79\x9d\x0c\x76\x23\xc1\x9f\x02\x39\xcc\x6e\x83\x23\xbd\x5a\xc8\x02\xb3\xa0\x48\xaf\xee\x56\xda\x1c\xb6\xa7\x5b\x94\x21\xfa\xdc\x3d\x59\x63\x2e\x1a\xca\xef\xd1\x95\x9d\x88\xe2\x9f\xd4\x19\x98\x6e\x6d\xac\xb1\x7e\xc4\xad\xc6\x23\xee\x7a\xa9\x8d\x90\x4b\x67\x14\x2e\x2b\x92\x63\x86\x5b\xe5\xf6\x33\x16\x9d\x88\x49\x86\x3b\x3d\x10\xae\xab\x0a\x39\xb6\x13\x2d\x9d\x34\x52\xa9\xb7\x65\x74\x7e\x55\x36\x19\x31\xd9\x44\x33\x9d\x62\xd5\x3a\xcc\xd1\x7d\x13\xad\x09\x6c\x90\x56\x05\xa7\x70\x7d\x75\xd5\xd9\xaf\xed\x35\xb6\x22\xdb\xdd\x43\x87\xaa\x6c\xd3\x71\x2c\x3c\xd9\x0d\xdd\xf5\x35\x35\xdc\x7e\x6c\xac\x51\x66\xb5\xfb\x2d\x26\xb1\x7f\xb8\x30\x9e\x23\x40\x6f\x40\xc5\x33\x00\x51\x34\x43\xde\x18\xf7\x98\x42\x2c\xdf\x06\x35\xd3\x7e\x6e\x14\xc9\x5d\x0a\x37\x2a\x78\x46\xf7\x86\x9c\xe7\xbf\x88\x8b\x77\xf5\x91\x7e\xb3\xc7\xf5\x02\x36\x82\x18\x82\x05\x36\x90\xd3\x1a\xa1\x24\x1d\x18\x3d\xe4\xc6\x01\x17\x08\x99\x13\xa4\xc1\xe4\x20\x8d\xd6\x28\x63\x11\xfb\xe6\x30\xa3\x2b\x49\x57\xbd\xf8\xd6\x09\x89\x73\x74\x64\xb2\x05\x4a\xa3\x33\x9f\xc2\xcb\x03\xfd\x1e\xdd\x9a\x24\x4e\xa4\x34\x41\xf3\xec\xc4\xfc\x6c\x34\x44\xad\xd1\xe2\x1b\x85\xae\xdf\xea\x49\xa7\x7a\xa7\x5b\xf2\xec\x47\xc3\x56\x7a\x4a\x4f\x9f\x53\xe3\x53\x50\xa4\x43\x9b\x86\x38\xb4\x05\x69\x74\x9d\x84\x26\xff\x3e\xde\xdb\x8f\x4a\xb1\xc2\xb4\xdb\x17\x4f\x6a\xcb\x4a\xf8\xc4\xf3\x41\x7f\x1e\x94\x6a\xb3\x74\x97\xcf\x0c\xcf\x1d\x7a\x3c\x84\x1c\x97\x70\xab\x8e\x43\xf1\x4f\xe0\xf2\x0c\x7f\xa2\x5e\x12\x5d\xcd\x69\x55\x0a\xfb\xfa\x7f\xff\x9f\xff\x71\xbb\x9c\x4d\x7e\x9f\x2e\xe6\x93\x9b\xe9\x0f\x0d\x46\xad\x10\x6a\x62\x07\xc7\x59\xda\x36\x19\xfe\x39\xac\xae\xde\x00\x24\x64\xe7\x81\x84\xec\x19\x90\xce\xa0\x4f\xe2\x38\xa0\xed\xeb\x2a\x80\x71\x43\x42\xbf\xdb\xbe\x69\x00\xb5\xa6\x6c\x78\x50\xe4\x8b\xd6\x97\xa1\xa3\x2d\xef\x95\x03\xdd\x2e\x6a\xac\xb6\x26\x33\xcc\x45\x50\xbc\x64\xe5\x97\x12\x1d\x53\x4e\x52\x30\x9e\xb2\xd9\xa8\x26\xde\xab\xa4\xa3\xfa\x7a\xbf\x3f\x07\x6d\xd0\xc8\xf1\xf7\x28\x83\x23\xde\xdd\x18\xcd\xb8\xe5\x7e\x0d\x09\xa5\xcc\x66\xee\x68\x4d\x0a\x57\x38\xf5\x52\xa8\xaa\x02\x7a\x23\xa4\xe9\x0b\x61\xc5\x03\x29\x62\x1a\xde\x04\x99\x33\xf6\x78\x2f\x92\x38\x79\xff\xfe\x68\x57\x64\xd9\x29\xc5\xd9\xf4\x7e\xf9\xeb\xdd\xec\x76\xb9\x98\x7e\xf8\x78\x77\x33\xed\xa9\xbc\x80\xcd\x66\x93\xc4\x87\x16\x24\xbf\xc0\xf5\xd5\x75\x4f\xea\x82\x9e\xf8\x3f\x3d\xba\xf4\x48\x84\x7a\xdd\x37\xd5\x76\x71\x9b\xc6\xd1\x89\x4b\xee\x8d\x33\x83\x2b\x00\x20\x27\x54\xd9\x07\xcc\x87\x92\x46\x36\x17\x5c\xa4\x87\x17\xcd\x38\xda\x79\xd6\x74\xd5\x2f\xdf\xd7\x7e\xf5\xd6\xec\xe8\xc7\xf7\xcf\x60\x82\x44\xc5\x14\x0a\x66\xdb\x13\x74\xa6\x60\x6c\x8f\x14\x7e\xee\xdf\x99\xf1\x2a\x36\x6c\xa4\x51\x29\xdc\xdf\xcc\xbf\x08\xea\x9f\x47\x7d\xf5\xea\xe5\x99\xb0\x8a\xd6\xa8\xd1\xfb\xb9\x33\x0f\xcd\xf3\xb1\x5d\xb9\x20\x15\x1c\xde\x17\x0e\x7d\x61\x54\x96\x42\x1f\x34\x06\xf7\x16\x8f\xea\x1e\xc0\x56\x8c\x5d\x16\x28\x14\x17\x7f\x1f\x0b\x2b\xf7\xaa\xa7\xe2\x91\xc4\xcb\x02\x23\x65\xef\xee\xef\xfb\x61\xc7\x07\x04\x09\x75\x8b\x4a\xec\x0e\x97\xdd\x75\x9f\x36\xdb\xbf\x0a\x8f\xa4\x3e\x48\x89\xde\x77\x02\xe9\x17\x3a\x53\x89\x26\xf0\xe9\xe3\x0e\x45\x46\xff\x6d\x86\xbe\x63\xf4\x8a\x72\x94\x3b\xa9\x8e\x02\xb7\x0e\x17\x3c\x9c\x4c\xb8\x45\x79\xbc\x17\x6b\xb3\x2c\x85\x3e\x31\x9d\xe2\xe0\xb8\x8c\x0f\xa2\xc4\x17\x81\x33\xb3\xd1\xa3\x7f\x06\x00\x4c\x03\x8a\xe8\x71\x0e\x00\x00"),
+		},
+		"/charts/ingress-nginx/templates/rbac.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "rbac.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 93021652, time.UTC),
+			uncompressedSize: 2575,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xcb\x6e\xdb\x30\x10\xbc\xeb\x2b\x16\xee\x99\x0a\x72\x2b\x84\x20\x40\x1f\x40\x6f\x3d\xb8\x40\xef\x2b\x6a\xac\xb0\xa6\x49\x82\x5c\x3a\x41\x83\xfc\x7b\x21\x5b\xb2\x95\xd4\x76\xec\xd8\x69\x6f\x22\x35\xbb\x9c\xe5\xce\x90\xe4\x60\x7e\x22\x26\xe3\x5d\x45\xcb\xeb\x62\x6e\x5c\x53\xd1\x0f\xc4\xa5\xd1\xf8\xa4\xb5\xcf\x4e\x8a\x05\x84\x1b\x16\xae\x0a\x22\xc7\x0b\x54\xe4\x5a\xe3\x1e\x94\x71\x6d\x44\x4a\x2a\xad\xe1\xdc\xc3\xd7\xa0\x14\x58\xa3\xa2\xc7\x47\x2a\xa7\xb0\xe0\x84\xf2\xfb\x30\x4d\x4f\x4f\x05\x91\xe5\x1a\x36\x75\x49\x89\x38\x84\x72\x9e\x6b\x44\x07\x41\x2a\x8d\xbf\xea\x72\x54\x34\x2c\xb1\x5a\xb0\x50\x4a\x15\x63\xc2\xb1\x66\x5d\x72\x96\x3b\x1f\xcd\x6f\x16\xe3\x5d\x39\xff\xb8\x8a\x5e\x5e\xd7\x10\x1e\xea\xf9\x62\x73\x12\xc4\xa9\xb7\x78\xb5\x18\xbd\xc6\xc6\x0e\xfb\x16\x8e\x31\x5b\xac\x8a\x52\xc4\xc1\x7c\x8b\x3e\x87\x3e\x9e\x48\xd1\x64\xb2\xfa\x8c\x48\x3e\x47\x8d\xd1\x1f\xed\xdd\xcc\xb4\x0b\x0e\x69\x33\x05\xd7\x04\x6f\x9c\x6c\x67\x9c\x6f\xb0\x1d\x05\xdf\x6c\x07\x09\x3a\xa2\x87\x2e\x11\xeb\x51\x6a\x6b\x92\x6c\x06\xf7\x2c\xfa\xee\x74\x7a\xdb\x95\x5f\x24\x6f\x21\xa7\x67\xeb\x15\x73\x20\xe1\xe5\x98\x63\x09\x27\x3b\x57\xd2\x11\x2c\xd8\x0c\xc3\xc1\xfc\x78\x10\xb8\x4e\x76\x69\xb2\x9d\x74\x90\x7b\x1f\xe7\xc6\xb5\xbd\xf0\xf6\xb2\xe8\x45\x72\xd1\x92\x2f\x45\xe9\x2a\x09\x4b\xde\xc9\x2c\x87\xa6\xdb\xa2\xb7\x1a\xef\x28\xc7\xf5\x56\x7b\xaf\x43\xe3\x82\x86\xdc\x6b\xb8\x0e\xbf\xe1\x7f\xa0\xc5\xe7\x51\x18\x10\xab\x73\x74\x40\x7d\xa0\xaf\x98\x71\xb6\x92\x48\x3c\x4d\x6e\x60\xa1\xbb\x86\x28\xd3\xdc\xaa\x9b\x61\x2b\xb4\xe5\x94\x6e\x47\x32\xd9\xfc\xf0\x4e\xa2\xb7\x16\x51\x59\x70\x83\xb8\xde\xb5\xc9\xfe\x1a\x9e\x09\xe3\xdc\x92\xf6\x39\xf2\xc4\xac\xcf\x4f\xca\x1d\xb4\xcf\x11\xf0\x67\xe3\x1a\xe3\xda\xa3\x74\xac\x9c\x49\xac\xea\x3e\xe2\x1d\x45\xed\x2d\xa6\x98\x75\x21\xc3\x46\x1d\x28\xaa\x20\x1a\xf9\xf1\x80\x0b\x53\xae\x7f\x41\x4b\x6f\x97\x9d\x8f\x01\xa2\xdd\xf1\x7f\x3d\x02\x8e\x2b\xfe\x02\x57\xfa\xb1\xfd\x19\xdd\xec\x2f\xdb\xf4\xcf\x1a\x30\x7e\x89\xbc\xca\xf2\x3f\xb4\xe3\xcf\x00\x93\xaa\xe3\x9f\x0f\x0a\x00\x00"),
+		},
+		"/charts/ingress-nginx/templates/service.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "service.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 95424929, time.UTC),
+			uncompressedSize: 656,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x31\x6f\x2a\x31\x10\x84\xfb\xfb\x15\x23\xfa\xbb\xf7\x9e\x1e\x45\xe4\x36\x6d\x84\x50\x82\x68\x91\xf1\x0d\xc4\xc2\xd8\x8e\xd7\x20\xd0\xe9\xfe\x7b\xe4\xe0\x93\x52\x40\x81\x5c\x79\xbc\x3b\xfb\x79\x76\x18\x5a\xd8\x1d\xf8\x85\x6e\xad\xdd\x89\xd2\xf1\x12\x83\x70\x73\x0c\x3d\x31\xf3\xa1\xe7\x32\xa4\x3c\xc3\x38\x36\x3a\xda\x35\x93\xd8\xe0\x15\xce\xff\x9a\x83\xf5\xbd\xc2\x07\xd3\xd9\x1a\x36\x47\x66\xdd\xeb\xac\x55\x03\x78\x7d\xa4\x82\xf5\xfb\x44\x91\xd6\xef\xad\xbf\x54\x55\xa2\x36\x54\x18\x06\x74\xef\x74\xd4\xc2\x6e\x31\xc9\x65\x04\xe0\xf4\x96\x4e\x8a\x0b\xa0\x63\xec\x0e\xa7\x2d\x93\x67\xa6\x74\x36\xfc\xb9\xe7\x2c\x91\xa6\xd4\xe7\x6b\xa4\xc2\xa2\x12\x37\x00\x2f\x99\xc9\x6b\xb7\x4a\x7a\xb7\xb3\x66\x19\x9c\x35\x57\x85\xb7\x60\xb4\x6b\x00\xa1\xa3\xc9\x21\x3d\x33\x0b\x88\x21\xe5\x8a\xd7\xd6\x8f\x7e\xe6\x1c\x7f\x84\xdb\xab\xc2\xcb\xdf\xe9\x9a\x42\x0e\x26\x38\x85\xd5\xeb\xb2\x6a\x59\xa7\x3d\x73\x09\xb5\x76\xd6\x15\x4c\xf9\x17\x6d\x53\x72\xdf\x14\xb3\x5b\x28\xe5\x4c\xab\xb8\xa5\xf7\xb0\xb8\xb8\xd1\xf7\x53\xdf\x6f\x46\xa9\x4e\xc5\x57\x61\x3e\xff\xff\x04\xa5\xdc\xc3\x94\xa7\x38\xe5\x21\xe8\x30\xb4\xa0\xef\x31\x8e\xcd\xf7\x00\x57\xd1\xa7\x96\x90\x02\x00\x00"),
+		},
+		"/charts/ingress-nginx/values.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "values.yaml",
+			modTime:          time.Date(2026, 10, 19, 8, 27, 39, 90221885, time.UTC),
+			uncompressedSize: 918,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\x4f\x8f\x9b\x30\x10\xc5\xef\xfe\x14\x4f\x70\x4d\xbc\xa8\xbb\x87\x0a\xa9\xb7\x1e\xab\xa8\xd2\xf6\x8e\x1c\x18\x82\xb5\xc6\x43\x3d\x26\x59\xbe\x7d\x65\x93\x3f\xa4\xaa\xd4\x1b\x1a\xbf\xf7\x7b\x6f\x8c\x4b\x0c\x7c\x41\x1c\x08\xd6\x9f\x02\x89\xa0\x65\x1f\x03\x3b\x47\x01\x56\x40\x9f\x13\x0b\x75\x1a\x32\x4f\x13\x87\x28\x28\x06\x96\x78\xa0\x78\xe1\xf0\x51\xc0\xf8\x0e\x85\xe7\x8e\x7e\x72\x88\x85\x56\xe5\x5f\xe7\x61\xf6\x02\x83\xef\x86\x46\xf6\xef\x14\xc1\x1e\x74\xa6\xb0\xdc\xf3\x92\x79\x07\x67\x25\x92\xb7\xfe\x94\x04\x29\x08\x5f\xab\x0c\x7f\x7b\x7b\x05\xf7\xb9\x61\x52\xe6\x84\x7b\xde\x1d\x4f\x93\xe3\x65\x24\x9f\xf9\x5b\xb2\xec\x6e\x2b\xe0\xb8\xc0\xe0\x70\xb5\x42\x28\x9c\x6d\x4b\x5a\xad\xc7\xcd\xc8\x1d\xd5\xd8\x74\x57\x25\x02\x4d\xce\xb6\x46\x6e\x05\x1e\x31\x3b\xcc\x42\x1d\x2e\x03\xf9\x2b\x3f\x03\xbe\xdd\x9a\x69\x75\xf3\xd6\xf8\xa2\x4a\xa4\x79\x5e\x2b\xb3\x86\x18\xa7\xbc\x5c\xfa\x90\xff\xb2\x60\x9c\xe3\xd6\xc4\x75\x87\x8f\xf9\x48\xc1\x53\x24\x81\xed\x51\x69\x95\x20\x4d\x12\x37\x29\xa0\x46\x95\x27\xf2\x3c\x2a\x21\xd4\x86\x74\xff\xeb\x2a\x1d\xf5\x66\x76\x11\xbf\x7e\xbc\xa3\xa5\x10\x6d\x6f\x53\x02\xac\x47\xcf\x61\x34\x59\x58\x78\x33\x92\x4c\xa6\xa5\x97\xd5\x7d\x30\x23\x15\x3b\x55\xae\x8d\x8f\x0b\x72\x52\xbe\x4b\x0a\x82\x8b\x8d\x03\xcf\x71\x0b\xd4\x39\x4d\xc8\xf5\x7b\xb1\x27\x4f\xdd\x53\x1a\xf7\xf0\x27\xeb\x3f\xd3\x4b\xcb\x48\xdb\x83\xc6\x29\x2e\x5a\x5d\x0b\x36\xd1\x49\xb3\xb1\xd4\x28\x0a\xa5\x4a\x38\x73\x24\x97\x3a\x3e\xfd\x6c\x5c\x06\xdb\x0e\x39\xf2\xf9\x19\x4b\x3b\x50\x37\x3b\xea\x10\x59\xab\xa4\x6d\x02\x3b\x6a\x32\xa6\xce\xbf\x67\x9f\x06\xfa\x71\xbb\xda\xf2\xcb\x15\xbe\x31\x9c\x8d\x9b\xa9\x5e\x5b\x2b\xf5\x08\x69\xec\x68\x4e\x54\xe3\xf7\x6c\x96\xe4\x7c\x70\xf6\x57\xc8\xfe\x21\x7e\xc9\xf6\x7f\x1c\xd4\x95\x7e\xad\x74\xa5\xfe\x0c\x00\xa2\x2e\x9f\x6f\x96\x03\x00\x00"),
+		},
 		"/scripts": &vfsgen۰DirInfo{
 			name:    "scripts",
-			modTime: time.Date(2020, 1, 7, 8, 23, 48, 582415525, time.UTC),
+			modTime: time.Date(2026, 10, 19, 9, 37, 19, 475095078, time.UTC),
 		},
 		"/scripts/check_port_occupied.sh": &vfsgen۰CompressedFileInfo{
 			name:             "check_port_occupied.sh",
-			modTime:          time.Date(2020, 1, 6, 3, 2, 23, 483900571, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 2536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x6b\x6f\xdb\x36\x14\xfd\xae\x5f\x71\x26\x0b\xad\x8d\xf9\xdd\xb4\x4b\x5b\x64\xa8\xd7\x07\xe6\xad\x70\x8a\x38\x5d\x51\x14\x05\x4a\x53\xd7\x12\x11\x99\x54\x49\x2a\x8a\xe7\xe4\xbf\x0f\xa4\x64\xf9\x31\xa3\xa8\xbe\x58\xe2\xbd\x3c\x3c\xf7\xdc\x73\xe9\xd6\x2f\x83\xc2\xe8\xc1\x42\xc8\x01\xc9\x5b\x2c\x98\x49\x83\x56\x0b\xaf\x55\xbe\xd6\x22\x49\x2d\xc6\xc3\xd1\x73\xcc\x53\x26\x93\x94\x09\xfc\x25\x64\xf2\xa6\x50\x98\xca\xa5\xd2\x2b\x66\x85\x92\xb8\x26\x9e\x4a\x95\xa9\x64\x0d\xae\xfa\x5d\xbc\xb7\x71\x3f\x68\xb5\x1c\xcc\x7b\xc1\x49\x1a\x8a\x51\xc8\x98\x34\x6c\x4a\x98\xe4\x8c\xa7\xb4\x8d\x74\xf1\x0f\x69\xe3\x50\xc6\xfd\x21\xda\x2e\x21\xac\x43\x61\xe7\xa5\x83\x58\xab\x02\x2b\xb6\x86\x54\x16\x85\x21\xd8\x54\x18\x2c\x45\x46\xa0\x3b\x4e\xb9\x85\x90\xe0\x6a\x95\x67\x82\x49\x4e\x28\x85\x4d\xfd\x39\x35\x8a\x63\x82\xcf\x35\x86\x5a\x58\x26\x24\x18\xb8\xca\xd7\x50\xcb\xfd\x44\x30\x5b\x93\xf6\x4f\x6a\x6d\xfe\x62\x30\x28\xcb\xb2\xcf\x3c\xe3\xbe\xd2\xc9\x20\xab\x72\xcd\xe0\xfd\xf4\xf5\xdb\xd9\xfc\x6d\x6f\xdc\x1f\xd6\xbb\x3e\xca\x8c\x8c\x81\xa6\xef\x85\xd0\x14\x63\xb1\x06\xcb\xf3\x4c\x70\xb6\xc8\x08\x19\x2b\xa1\x34\x58\xa2\x89\x62\x58\xe5\x58\x97\x5a\x58\x21\x93\x2e\x8c\x5a\xda\x92\x69\x72\x30\xb1\x30\x56\x8b\x45\x61\x0f\x44\xdb\x72\x14\xe6\x20\x41\x49\x30\x89\x70\x32\xc7\x74\x1e\xe2\x8f\xc9\x7c\x3a\xef\x3a\x90\x4f\xd3\xeb\x3f\x2f\x3f\x5e\xe3\xd3\xe4\xea\x6a\x32\xbb\x9e\xbe\x9d\xe3\xf2\x0a\xaf\x2f\x67\x6f\xa6\xd7\xd3\xcb\xd9\x1c\x97\xef\x30\x99\x7d\xc6\xdf\xd3\xd9\x9b\x2e\x48\xd8\x94\x34\xe8\x2e\xd7\xae\x02\xa5\x21\x9c\x9c\xe4\xbb\x88\x39\xd1\x01\x85\xa5\xaa\x28\x99\x9c\xb8\x58\x0a\x8e\x8c\xc9\xa4\x60\x09\x21\x51\xb7\xa4\xa5\x90\x09\x72\xd2\x2b\x61\x5c\x5b\x0d\x98\x8c\x1d\x4c\x26\x56\xc2\x7a\xbf\x98\xff\xd7\xd5\x0f\x82\x16\xae\x5d\x63\x0d\xd7\xc2\xf5\xd4\x80\x89\x95\xd3\x89\xa7\xc4\x6f\x20\x96\xc8\x95\xb6\x50\x9c\x17\xb9\xa0\xd8\xe5\x9f\x0f\xbb\x38\x3b\x7b\x02\x21\x13\xc7\x3b\x68\x61\xfc\xe4\xb7\xe7\x5d\x8c\x9f\x9c\x0f\x41\x96\xc7\x41\x0b\xcf\x5c\xc2\x4d\xb1\xa0\x1e\xcb\x85\x21\x7d\x4b\x3a\x68\x61\x34\x1c\x9f\x9d\xfb\xe5\x8c\x2c\xda\x29\xb1\xcc\xa6\xff\xf6\xdc\x09\x9d\x3a\xfc\xbc\xda\x95\x6b\x75\xb7\x3e\x95\xf0\x74\xb8\xdb\x9f\x29\xce\xb2\x5e\x26\x8c\x25\x79\x90\x33\xaa\x40\x0c\x4f\x29\x2e\xb2\xed\xd1\x4f\xc7\xd5\xf2\x8a\x49\x96\x90\x0e\x82\xba\x80\x0f\x4a\xdb\x8b\xf6\xf9\xd0\x17\x55\x51\xac\x98\xf8\xe3\x3a\xc1\x8a\x19\x4b\xba\xca\x7a\x76\x32\xa7\x3e\xd4\x9f\xd1\x09\x9c\x04\x55\xb6\xd3\xa5\x92\xe5\x04\x6c\xa9\xf4\xcd\x16\xf6\x44\x38\xd7\x94\x33\x4d\x55\xbc\x13\x68\x95\x91\xfb\x0d\x96\x85\xe4\x7e\xfa\x57\x4c\xc8\x76\x07\x9b\xc0\x4d\x8d\xc9\x33\x61\xaf\x54\x46\x06\xd1\xc8\xaf\x38\xbb\x10\xe3\xa9\x5b\x74\xa6\x8f\x36\x0e\xe2\xcb\xab\xaf\x0f\x2f\x11\xab\x00\xf5\xe3\x06\x72\xa2\x35\x5b\x23\xda\x66\xfb\x58\xac\x64\xfd\x42\x96\xb8\x75\x34\x10\x6d\xf6\x48\x39\xa4\xe0\x61\x8f\xcf\x8e\x42\xc3\xca\x92\xb1\xe8\x49\x44\x23\xdc\xdf\xa3\x4d\x3c\x55\x08\x1d\x0d\x70\x26\xfd\xc5\xb2\x20\x48\x25\x7b\x74\x27\x8c\x35\x21\xc6\xbf\x3f\x1a\xe1\xd1\x23\xd0\x9d\xb0\x18\x75\x3c\x88\x58\xe2\xcb\x17\x07\x71\x71\x81\x30\xc4\xd7\xaf\x2f\x9d\x83\x65\x53\xc1\x69\x54\x5a\xe5\x76\x0d\x37\xb1\x32\x39\xc6\xad\xf4\x11\x81\xff\xf5\xc2\x86\xd1\x28\xdc\x7d\xb5\x2b\xad\x06\x83\xee\x00\x0f\x9d\x83\x22\x1b\xb9\x7c\x8d\x8d\xd0\xc2\x2b\x3c\xf2\xca\x36\xc4\x6a\xe2\xc2\x13\xaf\x4c\x74\x82\xbe\x7b\x58\x1c\x4f\x97\x33\x65\xa7\xb2\x6e\xc5\x66\xe7\x39\xaf\x73\x53\x6b\x76\x00\x5a\x1b\xf8\xa7\x51\xf7\x0c\xff\x23\x58\x67\xe0\x9f\xc6\xdc\xba\xfd\x47\x80\x95\xd5\x7f\x1a\x72\x37\x19\x47\xa0\x86\x0e\x76\x56\x9d\x2f\xe4\x8d\x54\xa5\xf4\xad\xab\xdd\x4a\x71\xd7\xb7\xba\xdf\xef\x87\x47\x6d\xaf\x5b\xdf\x58\x7c\xbf\xb9\x47\x4c\x1a\x1b\xef\x3a\xfc\xea\x60\x76\x5c\xbe\xb4\xea\xc3\x6e\x28\x10\xfd\x00\xfa\x30\xf5\x04\xfa\xf1\x78\x1d\x1c\xd6\x8c\x41\x8f\xbe\x3b\x5d\x4f\x6a\xa9\xc9\x16\x5a\x9e\x2c\xd4\xbd\xec\xe1\xff\x7a\xd1\x8e\x46\x87\xde\xde\x4d\x7a\xc3\x6d\x7b\xed\xcf\xc9\x5e\x34\x64\xfd\x1f\xc2\x4e\x8d\xbd\xab\xa4\x90\x7e\xfb\xc5\x37\x49\xd6\x58\xe6\x66\x3f\xb3\x39\xee\x91\x68\xca\xd1\xbb\x45\x38\xe1\x56\xdc\x52\xb8\xbf\xf4\x41\x2b\xab\xdc\x0a\x2b\x6f\xf0\x78\x93\x6b\x21\x2d\xa2\xb3\x87\xc7\xf5\x52\xef\x1d\xc2\x17\xe1\x2e\x32\x7b\xe7\x43\xd5\xfe\x12\x91\xa7\x73\x8f\x92\xa3\x97\x7d\x3b\x96\xab\xe1\x84\x9e\x24\x0c\x4f\xab\xb6\x5f\x65\xb4\xf7\x11\x7a\xec\x2e\xc2\x93\x82\x06\x8d\x05\xa3\xcd\xde\xa6\xed\xbd\xe8\xae\x67\x44\xaf\x82\xff\x02\x00\x00\xff\xff\x7b\x73\xe5\x73\xe8\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x7f\x6f\xdb\x36\x10\xfd\x5f\x9f\xe2\x4d\x16\x5a\x1b\xb3\xfc\xab\x69\x97\xb4\xf0\x50\xaf\x3f\x30\x6f\x85\x53\xc4\xe9\x8a\xa2\x28\x50\x9a\x3a\x4b\x44\x64\x52\x25\xa9\x38\x5e\x92\xef\x3e\x90\x92\x65\x3b\x33\x8a\x04\x86\x14\xf1\x8e\x8f\xef\xde\xbd\x63\xeb\x97\x7e\x69\x74\x7f\x21\x64\x9f\xe4\x35\x16\xcc\x64\x41\xab\x85\x37\xaa\xd8\x68\x91\x66\x16\xa3\xc1\xf0\x0c\xf3\x8c\xc9\x34\x63\x02\x7f\x09\x99\xbe\x2d\x15\xa6\x72\xa9\xf4\x8a\x59\xa1\x24\x2e\x89\x67\x52\xe5\x2a\xdd\x80\xab\x5e\x17\x1f\x6c\xd2\x0b\x5a\x2d\x07\xf3\x41\x70\x92\x86\x12\x94\x32\x21\x0d\x9b\x11\x26\x05\xe3\x19\x6d\x23\x5d\xfc\x43\xda\x38\x94\x51\x6f\x80\xb6\x4b\x08\xeb\x50\xd8\x79\xe5\x20\x36\xaa\xc4\x8a\x6d\x20\x95\x45\x69\x08\x36\x13\x06\x4b\x91\x13\xe8\x86\x53\x61\x21\x24\xb8\x5a\x15\xb9\x60\x92\x13\xd6\xc2\x66\xb0\xbb\x03\x1c\x13\x7c\xa9\x31\xd4\xc2\x32\x21\xc1\xc0\x55\xb1\x81\x5a\xee\x27\x82\xd9\x9a\xb4\xff\xcb\xac\x2d\x5e\xf6\xfb\xeb\xf5\xba\xc7\x3c\xe3\x9e\xd2\x69\x3f\xaf\x72\x4d\xff\xc3\xf4\xcd\xbb\xd9\xfc\x5d\x3c\xea\x0d\xea\x5d\x9f\x64\x4e\xc6\x40\xd3\x8f\x52\x68\x4a\xb0\xd8\x80\x15\x45\x2e\x38\x5b\xe4\x84\x9c\xad\xa1\x34\x58\xaa\x89\x12\x58\xe5\x58\xaf\xb5\xb0\x42\xa6\x5d\x18\xb5\xb4\x6b\xa6\xc9\x51\x4d\x84\xb1\x5a\x2c\x4a\x7b\x20\xda\x96\xa3\x30\x07\x09\x4a\x82\x49\x84\x93\x39\xa6\xf3\x10\x7f\x4c\xe6\xd3\x79\xd7\x81\x7c\x9e\x5e\xfe\x79\xfe\xe9\x12\x9f\x27\x17\x17\x93\xd9\xe5\xf4\xdd\x1c\xe7\x17\x78\x73\x3e\x7b\x3b\xbd\x9c\x9e\xcf\xe6\x38\x7f\x8f\xc9\xec\x0b\xfe\x9e\xce\xde\x76\x41\xc2\x66\xa4\x41\x37\x85\x76\x15\x28\x0d\xe1\xe4\x24\xdf\x45\xcc\x89\x0e\x28\x2c\x55\xd5\x47\x53\x10\x17\x4b\xc1\x91\x33\x99\x96\x2c\x25\xa4\xea\x9a\xb4\x14\x32\x45\x41\x7a\x25\x8c\x6b\xab\x01\x93\x89\x83\xc9\xc5\x4a\x58\xef\x17\xf3\xff\xba\x7a\x41\xd0\xc2\xa5\x6b\xac\xe1\x5a\xb8\x9e\x1a\x30\xb1\x72\x3a\xf1\x8c\xf8\x15\xc4\x12\x85\xd2\x16\x8a\xf3\xb2\x10\x94\xb8\xfc\xd3\x41\x17\x27\x27\xcf\x20\x64\xea\x78\x07\x2d\x8c\x9e\xfd\x76\xd6\xc5\xe8\xd9\xe9\x00\x64\x79\x12\xb4\xf0\xc2\x25\x5c\x95\x0b\x8a\x59\x21\x0c\xe9\x6b\xd2\x41\x0b\xc3\xc1\xe8\xe4\xd4\x2f\xe7\x64\xd1\xce\x88\xe5\x36\xfb\x37\x76\x27\x74\xea\xf0\x99\x0f\xc7\x85\x56\x37\x9b\x63\x09\xcf\x07\xbb\xfd\xb9\xe2\x2c\x8f\x73\x61\x2c\xc9\x83\x9c\xa1\xcf\x89\x0d\xcf\x28\x29\xf3\xed\xd1\xcf\x47\xd5\xf2\x8a\x49\x96\x92\x0e\x82\xba\x80\x8f\x4a\xdb\x71\xfb\x74\xe0\x8b\xaa\x28\xba\xe7\x99\xdf\x33\xe8\x04\x2b\x66\x2c\xe9\x2a\xeb\xc5\xd1\x9c\xfa\x50\xf7\x1c\x75\x02\x27\x41\x95\xed\x74\xa9\x64\x39\x02\xbb\x56\xfa\x6a\x0b\x7b\x24\x5c\x68\x2a\x98\xa6\x2a\xde\x09\xb4\xca\xc9\xbd\x83\x65\x29\xb9\xeb\x26\x56\x4c\xc8\x76\x07\xb7\x81\x9b\x1a\x53\xe4\xc2\x5e\xa8\x9c\x0c\xa2\xa1\x5f\x71\x76\x21\xc6\x33\xb7\xe8\x4c\x1f\xdd\x3a\x88\xaf\xaf\xbf\xdd\xbf\x42\xa2\x7c\x8a\xfb\xb9\x81\x9c\x68\xcd\x36\x88\xb6\xd9\x3e\x96\x28\x59\xff\x43\x96\xb8\x75\x34\x10\xdd\xee\x91\x72\x48\xc1\xfd\x1e\x9f\x1d\x85\x86\x95\x25\x63\x11\x4b\x44\x43\xdc\xdd\xa1\x4d\x3c\x53\x08\xb5\x23\xc4\x99\xf4\x17\xcb\x82\x20\x95\x8c\xe9\x46\x18\x6b\x42\x8c\x7e\x7f\x32\xc4\x93\x27\xa0\x1b\x61\x31\xec\x78\x10\xb1\xc4\xd7\xaf\x0e\x62\x3c\x46\x18\xe2\xdb\xb7\x57\xce\xc1\xb2\xa9\xe0\x38\x2a\xad\x0a\xbb\x81\x1b\x69\x99\x3e\xc4\xf5\x5b\x97\x22\xf0\x6f\xb7\x71\x1c\x46\xc3\x70\xf7\xd5\xae\xb4\xea\xf7\xbb\x7d\xdc\x77\x0e\x8a\x6c\xe4\xf2\x35\x36\x42\x0b\xaf\xf0\xd0\x2b\xdb\x10\xab\x89\x0b\x4f\xbc\x32\xd1\x11\xfa\xee\xc7\x92\x64\xba\x9c\x29\x3b\x95\x75\x2b\x6e\x77\x9e\xf3\x3a\x6f\x13\x29\x3f\x00\xad\x0d\xfc\x68\xd4\x3d\xc3\xff\x0c\xd6\x19\xf8\xd1\x98\x5b\xb7\xff\x0c\xb0\xb2\xfa\xa3\x21\x77\x93\xf1\x00\xd4\xd0\xc1\xce\xaa\xf3\xa5\xbc\x92\x6a\x2d\x7d\xeb\x6a\xb7\x52\xd2\xf5\x16\xea\xf5\x7a\xe1\x83\xb6\xd7\xad\x6f\x2c\xbe\xdf\xdc\x07\x4c\x1a\x1b\xef\x3a\xfc\xfa\x60\x76\x5c\xbe\xb4\xea\xe3\x6e\x28\x10\xfd\x04\xfa\x30\xf5\x08\xfa\xc3\xf1\x3a\x38\xac\x16\x74\x88\x98\x7e\x38\x5d\x8f\x6a\xa9\xc9\x96\x5a\x1e\x2d\xd4\x7d\xef\xe1\xff\x3a\x6e\x47\xc3\x43\x6f\xef\x26\xbd\xe1\xb6\xbd\xf6\xe7\x64\xc7\x0d\x59\x77\xd3\xee\xa9\xd1\x9c\xc6\x55\x29\xfd\x45\x31\xfe\x2e\xc9\x1a\xcb\xdc\xec\xe7\xb6\xc0\x1d\x52\x4d\x05\xe2\x6b\x84\x13\x6e\xc5\x35\x85\xfb\x4b\x1f\xb5\xb2\xca\xad\xb0\xf5\x15\x9e\xde\x16\x5a\x48\x8b\xe8\xe4\xfe\x69\xbd\x14\xbf\x47\xf8\x32\xdc\x45\x66\xef\x7d\xa8\x82\x5c\x23\xf2\x74\xee\xb0\xe6\x88\xf3\xef\x0f\xe5\x6a\x38\x21\x96\x84\xc1\x71\xd5\xf6\xab\x8c\xf6\x3e\x42\x8f\xdd\x45\x78\x54\xd0\xa0\xb1\x60\x74\xbb\xb7\x69\x7b\x2f\xae\x98\x90\x88\x5e\x07\xff\x0d\x00\x7b\x73\xe5\x73\xe8\x09\x00\x00"),
 		},
 		"/scripts/check_system_preference.sh": &vfsgen۰CompressedFileInfo{
 			name:             "check_system_preference.sh",
-			modTime:          time.Date(2019, 12, 12, 7, 31, 4, 856135726, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 2010,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x71\x6f\xe2\x46\x10\xc5\xff\xf6\x7e\x8a\x39\x63\x35\x77\x12\x31\x24\xed\x3f\xcd\xc9\xad\x68\x92\xaa\xf4\x22\x90\x42\xae\xa7\xd3\xf5\x84\x96\xf5\xd8\x1e\x65\xd9\x75\x77\xd7\x31\x16\xe5\xbb\x57\x6b\x4c\x02\x09\xea\x5d\x90\x90\xcc\xee\x9b\xdf\xbc\x79\x1e\xd1\x7b\x33\x58\x90\x1a\x2c\xb8\x2d\x58\xaf\x07\x97\xba\x6c\x0c\xe5\x85\x83\xf3\xe1\xd9\xcf\x30\x2b\xb8\xca\x0b\x4e\xf0\x27\xa9\xfc\xaa\xd2\x30\x56\x99\x36\x4b\xee\x48\x2b\xb8\x43\x51\x28\x2d\x75\xde\x80\xd0\x71\x1f\x6e\x5c\x1a\xb3\x5e\xcf\x63\x6e\x48\xa0\xb2\x98\x42\xa5\x52\x34\xe0\x0a\x84\x51\xc9\x45\x81\xbb\x9b\x3e\xfc\x85\xc6\x7a\xca\x79\x3c\x84\xb7\x5e\x10\x76\x57\xe1\xbb\xf7\x1e\xd1\xe8\x0a\x96\xbc\x01\xa5\x1d\x54\x16\xc1\x15\x64\x21\x23\x89\x80\x2b\x81\xa5\x03\x52\x20\xf4\xb2\x94\xc4\x95\x40\xa8\xc9\x15\x6d\x9f\x8e\xe2\x9d\xc0\xe7\x8e\xa1\x17\x8e\x93\x02\x0e\x42\x97\x0d\xe8\x6c\x5f\x08\xdc\x75\xa6\xdb\x4f\xe1\x5c\x79\x31\x18\xd4\x75\x1d\xf3\xd6\x71\xac\x4d\x3e\x90\x5b\xad\x1d\xdc\x8c\x2f\xaf\x27\xb3\xeb\xd3\xf3\x78\xd8\x55\x7d\x54\x12\xad\x05\x83\xff\x54\x64\x30\x85\x45\x03\xbc\x2c\x25\x09\xbe\x90\x08\x92\xd7\xa0\x0d\xf0\xdc\x20\xa6\xe0\xb4\x77\x5d\x1b\x72\xa4\xf2\x3e\x58\x9d\xb9\x9a\x1b\xf4\x98\x94\xac\x33\xb4\xa8\xdc\x41\x68\x3b\x8f\x64\x0f\x04\x5a\x01\x57\x10\x8e\x66\x30\x9e\x85\xf0\xdb\x68\x36\x9e\xf5\x3d\xe4\xd3\xf8\xee\x8f\xe9\xc7\x3b\xf8\x34\xba\xbd\x1d\x4d\xee\xc6\xd7\x33\x98\xde\xc2\xe5\x74\x72\x35\xbe\x1b\x4f\x27\x33\x98\xfe\x0e\xa3\xc9\x67\xf8\x30\x9e\x5c\xf5\x01\xc9\x15\x68\x00\x57\xa5\xf1\x13\x68\x03\xe4\xe3\xc4\xf6\x2d\xc2\x0c\xf1\xc0\x42\xa6\xb7\x96\x6c\x89\x82\x32\x12\x20\xb9\xca\x2b\x9e\x23\xe4\xfa\x01\x8d\x22\x95\x43\x89\x66\x49\xd6\xbf\x56\x0b\x5c\xa5\x1e\x23\x69\x49\xae\xdd\x17\xfb\x72\xae\x98\xb1\xac\x52\xa2\xdd\x26\x51\xa0\xb8\x9f\xdb\xc6\x0a\x27\x61\xcd\x82\xed\xd3\xfc\x1e\x9b\x24\x3a\x63\x01\xae\x4a\x14\x0e\xd3\xf9\x03\x97\x15\x26\xd1\x39\x0b\xba\xa7\xb7\x5d\x4d\xb4\x7e\x2a\xd9\xc0\xbf\xc0\xeb\x7b\x38\x59\x97\x86\x94\x83\xe8\xc7\xcd\xc9\x3b\x16\x50\x06\x5f\xbe\x40\xb4\x6e\x2b\x37\xf0\x26\x81\x68\x7d\x08\xde\xc0\xd7\xaf\xef\xbd\x45\xc5\x82\x00\x45\xa1\x21\x6c\x8d\x41\xc6\x49\x62\x7a\xf1\xac\x0b\xd9\x47\x5a\x1f\x76\xa4\x97\xd0\x90\x05\x8f\xbd\x33\x5a\x75\x53\x6e\x20\x81\xd0\x99\x0a\xc3\xfd\xa6\x5d\x57\x67\x1a\xbf\x2e\x16\xdd\xb3\x96\x4e\x1f\xe7\x77\x81\xc1\x69\x7d\x58\x90\xbc\x50\x7b\x71\x6f\x9b\x77\xfb\x32\xda\x53\xe0\x39\x27\x15\xfb\xbb\xd7\xe7\x1a\xbc\x36\xd9\xdd\x94\xdb\x54\x5f\x39\x68\x60\xd0\x55\x46\xc1\x59\x9b\x96\xb4\xb8\x07\xb4\x95\x10\x68\x6d\x56\x49\xd9\xbc\x86\x99\x11\x7b\x62\xed\xf1\xfd\xb9\xff\x76\x47\x43\xb6\x61\x0c\x8d\x49\xc2\x90\x29\xac\x25\x29\x4c\xa2\x93\xbf\xd5\x09\x63\xbb\x44\x15\xba\x98\xca\x87\x9f\x62\x2a\xe7\x99\x36\x35\x37\x29\xdb\x5f\xa4\x23\xf7\x71\x1c\x87\xec\x60\xff\xc3\x23\xaa\x10\xce\xd8\xd3\xaf\xb9\xbe\x4f\xa2\x5f\x99\xcf\x1d\xa2\xf5\xc1\xf9\x06\x4e\x15\xc2\x10\x1e\xe3\xde\xb6\x3f\x82\xf4\xfb\xeb\xff\x5f\x75\x89\x0a\xd3\x90\x05\xed\x68\xd1\x1a\x8d\xd9\x44\xeb\x6e\xc0\xcd\x9e\x7e\x5f\x9c\xd1\x91\xa1\x85\x56\x59\xcc\xa5\x8c\xbb\x0a\x52\xf9\xf1\xe9\x8f\x08\xff\x2f\x86\x23\x72\x9f\x07\x97\x72\xfe\x74\x72\x98\xc9\x8b\xbb\x6f\xe5\x72\xa4\xc7\x77\xa4\xf3\xdd\xe5\x3e\xaf\xd6\x5a\x87\x08\x21\x49\x20\x0c\x9f\xdb\xe9\x46\xdf\xc6\x35\xfd\xe0\xdb\xae\xc8\xc1\x90\x6d\xb7\xf3\x40\x84\xc6\x68\x63\x2f\x76\xbc\x5f\x7e\x38\xef\xd4\x67\x2c\xa3\xff\x02\x00\x00\xff\xff\xe2\x3d\xaf\xd3\xda\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x71\x6f\xe2\x46\x10\xc5\xff\xf6\x7e\x8a\x77\xc6\x6a\xee\x24\x62\x48\xda\x7f\x9a\x13\xad\x68\x92\xaa\xf4\x22\x90\x42\xae\xa7\xd3\xf5\x14\x2d\xf6\xd8\x1e\xc5\xd9\x75\x77\xd7\x31\x88\xf2\xdd\xab\x05\x93\x40\x82\x7a\x17\x24\x24\xe3\x79\xf3\x9b\x37\x6f\x57\x74\xde\xf4\x66\xac\x7a\x33\x69\x0b\xd1\xe9\xe0\x5c\x57\x0b\xc3\x79\xe1\x70\xda\x3f\xf9\x19\xd3\x42\xaa\xbc\x90\x8c\x3f\x59\xe5\x17\xb5\xc6\x48\x65\xda\xdc\x4b\xc7\x5a\xe1\x86\x92\x42\xe9\x52\xe7\x0b\x24\x3a\xee\xe2\xca\xa5\xb1\xe8\x74\x3c\xe6\x8a\x13\x52\x96\x52\xd4\x2a\x25\x03\x57\x10\x86\x95\x4c\x0a\xda\x56\xba\xf8\x8b\x8c\xf5\x94\xd3\xb8\x8f\xb7\x5e\x10\xb6\xa5\xf0\xdd\x7b\x8f\x58\xe8\x1a\xf7\x72\x01\xa5\x1d\x6a\x4b\x70\x05\x5b\x64\x5c\x12\x68\x9e\x50\xe5\xc0\x0a\x89\xbe\xaf\x4a\x96\x2a\x21\x34\xec\x0a\xb8\xa7\x01\xde\x09\x3e\xb7\x0c\x3d\x73\x92\x15\x24\x12\x5d\x2d\xa0\xb3\x5d\x21\xa4\x6b\x4d\xaf\x3f\x85\x73\xd5\x59\xaf\xd7\x34\x4d\x2c\xd7\x8e\x63\x6d\xf2\x5e\xb9\xd1\xda\xde\xd5\xe8\xfc\x72\x3c\xbd\x3c\x3e\x8d\xfb\x6d\xd7\x47\x55\x92\xb5\x30\xf4\x4f\xcd\x86\x52\xcc\x16\x90\x55\x55\x72\x22\x67\x25\xa1\x94\x0d\xb4\x81\xcc\x0d\x51\x0a\xa7\xbd\xeb\xc6\xb0\x63\x95\x77\x61\x75\xe6\x1a\x69\xc8\x5b\x4d\xd9\x3a\xc3\xb3\xda\xed\x85\xb6\xf5\xc8\x76\x4f\xa0\x15\xa4\x42\x38\x9c\x62\x34\x0d\xf1\xdb\x70\x3a\x9a\x76\x3d\xe4\xd3\xe8\xe6\x8f\xc9\xc7\x1b\x7c\x1a\x5e\x5f\x0f\xc7\x37\xa3\xcb\x29\x26\xd7\x38\x9f\x8c\x2f\x46\x37\xa3\xc9\x78\x8a\xc9\xef\x18\x8e\x3f\xe3\xc3\x68\x7c\xd1\x05\xb1\x2b\xc8\x80\xe6\x95\xf1\x1b\x68\x03\xf6\x71\xd2\xfa\x14\x31\x25\xda\xb3\x90\xe9\xcd\x39\xda\x8a\x12\xce\x38\x41\x29\x55\x5e\xcb\x9c\x90\xeb\x07\x32\x8a\x55\x8e\x8a\xcc\x3d\x5b\x7f\xac\x16\x52\xa5\x1e\x53\xf2\x3d\xbb\xf5\x7d\xb1\x2f\xf7\x8a\x85\xc8\x6a\x95\xf8\x2a\x92\x82\x92\xbb\x5b\xbb\xb0\x89\x2b\xb1\x14\xc1\xe6\xe9\xf6\x8e\x16\x83\xe8\x44\x04\x34\xaf\x28\x71\x94\xde\x3e\xc8\xb2\xa6\x41\x74\x2a\x82\xf6\xe9\x6d\xdb\x13\x2d\x9f\x5a\x56\xf8\x17\xb2\xb9\xc3\xd1\xb2\x32\xac\x1c\xa2\x1f\x57\x47\xef\x44\xc0\x19\xbe\x7c\x41\xb4\x5c\x77\xae\xf0\x66\x80\x68\xb9\x0f\x5e\xe1\xeb\xd7\xf7\xde\xa2\x12\x41\x40\x49\xa1\x11\xae\x8d\x21\x93\x5c\x52\x7a\xf6\x6c\x0a\xdb\x47\x5a\x17\x5b\xd2\x4b\x68\x28\x82\xc7\xd9\x19\xcf\xdb\x2d\x57\x18\x20\x74\xa6\xa6\x70\x77\x68\x3b\xd5\x99\x85\xbf\x2e\x96\xdc\xb3\x91\x4e\x1f\xe6\xb7\x81\xe1\xb8\xd9\x6f\x18\xbc\x50\x8b\x20\x08\x3a\x9b\xbc\xfd\x50\xac\x19\x90\xb9\x64\x15\xfb\xda\xeb\x73\x0d\x5e\x9b\xec\x76\xcb\x4d\xaa\xaf\x5c\x34\x30\xe4\x6a\xa3\x70\xe2\x41\x54\x5a\xda\x01\xda\x3a\x49\xc8\xda\xac\x2e\xcb\xc5\x6b\x98\x19\x8b\x27\xd6\x0e\xdf\xbf\xf7\xdf\xf6\x55\x5f\xac\x84\x20\x63\x06\x61\x28\x14\x35\x25\x2b\x1a\x44\x47\x7f\xab\x23\x21\xb6\x89\x2a\x72\x31\x57\x0f\x3f\xc5\x5c\xdd\x66\xda\x34\xd2\xa4\x62\xf7\x22\x1d\xa8\xc7\x71\x1c\x8a\xbd\xfb\x1f\x1e\x50\x85\x38\x11\x4f\xbf\x6e\xf5\xdd\x20\xfa\x55\xf8\xdc\x11\x2d\xf7\xde\xaf\x70\xac\x08\x7d\x3c\xc6\xbd\x19\x7f\x00\x09\xb6\xeb\xff\x57\x5d\x91\xa2\x34\x14\xc1\x7a\xb5\x68\x49\xc6\xac\xa2\x65\xbb\xe0\x6a\x47\xbf\x2b\xce\xf8\xc0\xd2\x89\x56\x59\x2c\xcb\x32\x6e\x3b\x58\xe5\x87\xb7\x3f\x20\xfc\xbf\x18\x0e\xc8\x7d\x1e\xb2\x2c\xb7\xde\x58\xe5\xfb\x99\xbc\xa8\x7d\x2b\x97\x03\x33\xbe\x23\x9d\xef\x6e\xf7\x79\xad\x8f\xab\x45\x84\x18\x0c\x10\x86\xcf\xed\xb4\xab\x6f\x72\x9d\x7c\xf0\x87\x32\x67\x87\xbe\xd8\xdc\xf4\x3d\x11\x19\xa3\x8d\x3d\xdb\xf2\x7e\xf9\xe1\x54\x04\x34\x67\x87\x13\x91\xf1\x7f\x03\x00\xe2\x3d\xaf\xd3\xda\x07\x00\x00"),
 		},
 		"/scripts/init_change_firewall.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_firewall.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 83881617, time.UTC),
-			uncompressedSize: 865,
+			modTime:          time.Date(2026, 10, 19, 9, 37, 40, 403902368, time.UTC),
+			uncompressedSize: 6901,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x51\x6f\xd3\x30\x14\x85\xdf\xf3\x2b\x0e\xad\xc4\x40\xea\xe2\xb2\x37\x40\x42\x2a\xdb\x10\x81\xa9\x95\x96\x8e\x69\x8f\xae\x73\x93\x5c\xc9\xb9\x0e\xb6\xb3\x2c\xff\x1e\xb9\x6b\xa7\x15\x84\x5f\xcf\xf1\xe7\xe3\x73\xef\xfc\x8d\x1a\x82\x57\x3b\x16\x45\xf2\x88\x9d\x0e\x6d\x36\x9f\xe3\xd2\xf5\x93\xe7\xa6\x8d\xb8\x58\x7e\xf8\x88\xb2\xd5\xd2\xb4\x9a\xf1\x83\xa5\xb9\x1a\x1c\x0a\xa9\x9d\xef\x74\x64\x27\xd8\x92\x69\xc5\x59\xd7\x4c\x30\x2e\x5f\xe0\x26\x56\x79\x36\x9f\x27\xcc\x0d\x1b\x92\x40\x15\x06\xa9\xc8\x23\xb6\x84\x55\xaf\x4d\x4b\x47\x65\x81\x5f\xe4\x43\xa2\x5c\xe4\x4b\xbc\x4b\x86\xd9\x41\x9a\xbd\xff\x9c\x10\x93\x1b\xd0\xe9\x09\xe2\x22\x86\x40\x88\x2d\x07\xd4\x6c\x09\xf4\x64\xa8\x8f\x60\x81\x71\x5d\x6f\x59\x8b\x21\x8c\x1c\xdb\xfd\x3b\x07\x4a\x4a\x82\x87\x03\xc3\xed\xa2\x66\x81\x86\x71\xfd\x04\x57\xbf\x36\x42\xc7\x43\xe8\xfd\x69\x63\xec\x3f\x29\x35\x8e\x63\xae\xf7\x89\x73\xe7\x1b\x65\x9f\xbd\x41\xdd\x14\x97\xd7\xeb\xf2\xfa\xfc\x22\x5f\x1e\x6e\xdd\x89\xa5\x10\xe0\xe9\xf7\xc0\x9e\x2a\xec\x26\xe8\xbe\xb7\x6c\xf4\xce\x12\xac\x1e\xe1\x3c\x74\xe3\x89\x2a\x44\x97\x52\x8f\x9e\x23\x4b\xb3\x40\x70\x75\x1c\xb5\xa7\x84\xa9\x38\x44\xcf\xbb\x21\x9e\x94\x76\xcc\xc8\xe1\xc4\xe0\x04\x5a\x30\x5b\x95\x28\xca\x19\xbe\xae\xca\xa2\x5c\x24\xc8\x7d\xb1\xfd\xbe\xb9\xdb\xe2\x7e\x75\x7b\xbb\x5a\x6f\x8b\xeb\x12\x9b\x5b\x5c\x6e\xd6\x57\xc5\xb6\xd8\xac\x4b\x6c\xbe\x61\xb5\x7e\xc0\xcf\x62\x7d\xb5\x00\x71\x6c\xc9\x83\x9e\x7a\x9f\x7e\xe0\x3c\x38\xd5\x49\xfb\x29\xa2\x24\x3a\x89\x50\xbb\xe7\x48\xa1\x27\xc3\x35\x1b\x58\x2d\xcd\xa0\x1b\x42\xe3\x1e\xc9\x0b\x4b\x83\x9e\x7c\xc7\x21\x8d\x35\x40\x4b\x95\x30\x96\x3b\x8e\xfb\x7d\x09\xff\xfe\x2b\xcf\xb2\x39\xb6\x69\xb0\xc1\x78\x4e\x33\x0d\xd0\xdc\xa5\x9e\x8c\x75\xe9\x51\xf6\x34\x6a\x6b\x13\x2d\x35\x90\x3a\xad\x10\x28\x52\xda\x43\x43\x59\x16\xa6\x10\xa9\x33\xd1\x1e\xe5\x97\x3b\x15\xde\x7e\x51\x15\x3d\x2a\x19\xac\x7d\xe5\x0b\xd1\xf5\xff\x33\xbd\x80\xb1\xfc\x4b\xa8\x70\xce\x38\x0b\xea\x59\x67\x69\xd4\x31\x8e\x3a\x83\xa2\x68\x54\x20\xcb\x32\x3c\x29\xe3\xa4\xe6\x26\xfb\x13\x00\x00\xff\xff\xde\x71\x21\x3e\x61\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x7b\x6f\xe3\xb8\x11\xff\x5f\x9f\x62\x2a\xbb\xbe\x24\xb5\xac\x6c\x7a\x38\xa0\x0e\xbc\x6d\x2e\x9b\x43\xdd\x66\xe3\x45\xec\xed\xf5\xba\xf5\x06\xb4\x34\xb2\xd9\x48\xa4\x96\xa4\xec\x04\x89\xbf\x7b\x31\xd4\xdb\x71\x1e\x5b\x34\x16\x1c\x8b\x1c\xce\xfc\xe6\xc9\x21\x3b\xbf\xf3\x33\xad\xfc\x05\x17\x3e\x8a\x35\x2c\x98\x5e\x39\x9d\x0e\x9c\xcb\xf4\x5e\xf1\xe5\xca\xc0\xc9\xf1\xbb\x3f\xc1\x74\xc5\xc4\x72\xc5\x38\xfc\x8d\x8b\xe5\x87\x4c\xc2\x58\x44\x52\x25\xcc\x70\x29\x60\x86\xc1\x4a\xc8\x58\x2e\xef\x21\x90\x83\x3e\x5c\x9a\x70\xe0\x74\x3a\xc4\xe6\x92\x07\x28\x34\x86\x90\x89\x10\x15\x98\x15\xc2\x59\xca\x82\x15\x96\x33\x7d\xf8\x07\x2a\x4d\x5c\x4e\x06\xc7\x70\x40\x04\x6e\x31\xe5\x1e\x9e\x12\x8b\x7b\x99\x41\xc2\xee\x41\x48\x03\x99\x46\x30\x2b\xae\x21\xe2\x31\x02\xde\x05\x98\x1a\xe0\x02\x02\x99\xa4\x31\x67\x22\x40\xd8\x70\xb3\x02\x53\x0b\x20\x24\xf0\x5b\xc1\x43\x2e\x0c\xe3\x02\x18\x04\x32\xbd\x07\x19\x35\x09\x81\x99\x02\xb4\xfd\x5b\x19\x93\x0e\x7d\x7f\xb3\xd9\x0c\x98\x45\x3c\x90\x6a\xe9\xc7\x39\xad\xf6\x2f\xc7\xe7\x17\x57\xd3\x0b\xef\x64\x70\x5c\xac\xfa\x2c\x62\xd4\x1a\x14\x7e\xcb\xb8\xc2\x10\x16\xf7\xc0\xd2\x34\xe6\x01\x5b\xc4\x08\x31\xdb\x80\x54\xc0\x96\x0a\x31\x04\x23\x09\xf5\x46\x71\xc3\xc5\xb2\x0f\x5a\x46\x66\xc3\x14\x12\xd4\x90\x6b\xa3\xf8\x22\x33\x2d\xa3\x95\x18\xb9\x6e\x11\x48\x01\x4c\x80\x7b\x36\x85\xf1\xd4\x85\x9f\xcf\xa6\xe3\x69\x9f\x98\xfc\x3a\x9e\xfd\x75\xf2\x79\x06\xbf\x9e\x5d\x5f\x9f\x5d\xcd\xc6\x17\x53\x98\x5c\xc3\xf9\xe4\xea\xc3\x78\x36\x9e\x5c\x4d\x61\xf2\x0b\x9c\x5d\xfd\x06\x7f\x1f\x5f\x7d\xe8\x03\x72\xb3\x42\x05\x78\x97\x2a\xd2\x40\x2a\xe0\x64\x4e\xb4\x5e\x84\x29\x62\x0b\x42\x24\x73\x3f\xea\x14\x03\x1e\xf1\x00\x62\x26\x96\x19\x5b\x22\x2c\xe5\x1a\x95\xe0\x62\x09\x29\xaa\x84\x6b\x72\xab\x06\x26\x42\x62\x13\xf3\x84\x1b\x1b\x2f\xfa\xa9\x5e\x03\xc7\xe9\xc0\x8c\x1c\xab\x03\xc5\xc9\xa7\x1a\x18\x4f\xc8\x4e\x1a\x0d\x64\x29\x44\x5c\xe1\x86\xc5\x31\xb1\x83\xe9\xc5\x25\x17\xd9\x1d\x39\x50\xc8\x10\x9d\x0e\x64\x9a\x2d\x71\x08\x5c\x70\x73\x13\x50\xac\xe2\x4d\xb9\x62\xa0\x57\xf0\xc5\xf3\x2a\x06\x21\xd7\xe4\x91\x47\x99\xa2\xf0\x52\xa9\x8c\x9e\xd3\xbc\xc6\xd8\xf2\x2c\xa6\xc3\xc7\x52\x87\x35\x3e\x22\x05\x7b\xc0\xc5\x72\xee\x14\xd1\x91\x3f\x5f\x3c\xcb\x00\x3e\x4d\xae\x67\x5f\x3c\xfa\x9e\xfb\x9f\xae\x27\xb3\xc9\xf9\xe4\x72\x3e\x18\x0c\x88\x6f\xaa\xa4\x91\x81\x8c\xe1\xea\xf3\xc7\x9f\x2f\xae\x69\xd8\xea\x8a\xb5\x4a\xb9\x53\x09\x94\x8d\x9a\x10\x23\x96\xc5\x66\x00\x63\x01\x35\x48\x48\x64\x98\x3b\xa2\xb9\xee\x16\x53\xd3\xb7\x36\xa1\x99\x25\x5f\xa3\x00\x82\x64\xcd\x0e\xe3\x4f\x50\x8a\xd7\x4e\x07\x98\x42\x60\x71\x2c\x37\x79\x74\xd2\x0a\x16\x18\xbe\x46\x90\x02\xc9\x98\x25\xe7\xb0\x0f\x59\xb4\xe9\x83\x88\x0c\xa1\xca\x99\xf1\x34\x7f\xe9\x5b\x10\x2a\xb3\xe3\x0a\xc9\xd7\x9a\x6b\x0a\x57\xf2\x56\xa6\xd6\xc4\x50\xe1\x42\x4a\x33\x70\x3a\xa4\x44\x65\xbe\x5c\x87\xd2\x7b\x05\xfc\x7a\xba\x56\x64\x25\xb5\x81\x90\x2b\x0c\x8c\x54\x1c\x35\x25\xbe\xc5\x1c\x48\x41\x19\x8c\x2a\x97\x1d\xb3\x05\x92\xd5\x8a\x98\x4c\x06\x8e\xf3\xcb\xf8\xfa\xe2\xd7\xb3\xcb\xcb\x9b\x8f\x93\x0f\x17\xa3\xc2\xae\xce\xf4\xe2\x72\x7c\xf5\xf9\x9f\xad\xc1\xd0\x21\x8f\x4d\x47\x07\x87\x4e\xe9\x34\xfb\xe2\x74\x9e\xca\x4f\x64\x26\x48\x45\x2e\x8c\x6c\x82\x58\xdc\xc3\x6d\xb6\x40\x25\xd0\xa0\xb6\x65\x48\x0a\x14\x85\xf9\x05\x9a\x8d\x54\xb7\x90\xc6\xd9\x92\x0b\xed\x9c\x4f\xae\x66\x67\xe3\xab\x8b\xeb\x9b\x0f\xe3\xeb\xe9\xe8\xc0\x47\x13\xf8\x8d\xe5\xfe\x9a\x29\x3f\xe6\x0b\x1a\x0f\xeb\x37\xa2\x88\xd1\x00\x0d\xfb\x81\xe0\xe0\xcb\xd4\xe4\x3f\x4a\x92\x80\xc5\x3c\x90\xc5\xbb\x5c\xfa\xa9\x0c\x4b\x76\x72\xe9\xd7\x78\x0f\x1d\x67\xb3\xa2\xd2\xf9\xe5\x0b\x74\x3b\xe0\x2d\x0d\x1c\xc3\x7c\x7e\x0a\xa1\x74\x28\xa0\x03\xa6\x11\xdc\xee\x3b\x17\xb8\x70\xca\x28\xaf\x73\xe7\xb0\x1a\xa3\xa7\x6d\xe9\xee\x49\x6b\x52\xaf\x78\x64\xaa\x91\xd3\xd3\xea\x67\x95\x69\x6d\x66\x2d\x07\xbd\x9d\x17\xc5\x7a\x9b\x91\xf5\xe9\x1f\x46\x07\x6e\xf7\xc4\x3d\x7c\x33\x9b\x22\x4b\x76\x58\x95\x51\xf1\x7d\xec\x8e\xda\x74\x18\xac\x24\xb8\x5c\xac\x59\xcc\x43\x90\x29\x95\xc0\x21\x90\x8d\xdf\xf7\xda\x7a\xe2\x1d\x37\xf0\x6e\x97\x25\x6a\x16\x38\xb5\xc0\x50\x0a\x74\x9c\xd2\x23\xc3\x61\x11\xcc\x07\x87\xf0\x90\x53\xdd\x6b\x83\x49\x60\xaa\x42\x57\x67\x35\xf4\xde\xfb\x21\xae\x7d\x91\xc5\xf1\x0e\xad\x36\x32\x7d\x81\x50\xa1\xc9\x94\x80\x63\x67\xdb\x14\x5d\xd1\x57\xc2\x63\x19\xb0\xd8\x16\xa0\xaa\xf0\x38\x76\x82\x12\xd4\x0e\x73\x01\x6e\xf7\xc1\x3a\xe9\xcb\x5f\xe6\x5b\xb7\x0a\x3d\x7a\x4a\x86\x5e\x90\x84\xe4\x15\x54\x09\xa3\x74\x02\xcf\x63\x61\x68\xeb\xe0\xa8\x4b\xdf\xf0\xae\x06\x08\x8f\x8f\x25\xbe\xdc\x76\xd6\x42\x95\xd0\x02\x46\x29\xb8\x74\xe9\xf7\x0b\x2f\x18\x8d\xba\xe5\xaf\x37\x82\x68\xb3\x55\x18\x4b\x16\x36\x97\xb6\x6d\x9a\x45\x9b\xd7\xad\xd9\xa1\xf2\x0c\x86\xdd\xa2\xce\xa7\x15\xed\x78\x1a\x98\xb6\x5b\xd1\x90\xbe\xbe\xc3\xec\xc4\xcc\xee\x0b\xd0\x7d\x20\x76\xbe\xe7\x0f\xb7\xaf\x6b\xd7\x80\x22\x45\x7c\x9f\x6f\x2d\x1a\xb4\x4c\xb0\xc2\xab\xf3\xa2\x9d\x24\x4c\x84\xf9\xc6\x21\xa9\xd7\xc8\xab\x37\x0b\xa8\x7f\xab\x37\xa3\x05\x46\x52\x15\x5b\x4b\x0d\xff\xcd\x0e\x5c\x2a\x4c\xc1\xfb\x06\x9e\x07\xee\x57\xef\x8c\x80\x79\x39\x4b\x8f\x8b\x34\x33\xe0\xa5\x50\x3b\xcf\xfb\x0f\x9c\x9d\x9f\x5f\x7c\x9a\xb9\x79\x61\xcd\xa2\x8d\x9f\x53\x0f\x2c\x00\x78\x7c\xac\x38\xd3\x43\x1b\x90\xc7\xc1\x3d\xee\xfb\x5f\xcf\x27\x1f\x3f\x8e\x67\xbe\xf6\xfd\xb7\x8b\xf9\xb7\x28\x56\x3d\x2f\xef\x99\x08\xa2\x5a\xfd\xd0\x69\x29\x0e\x1e\x7e\xb3\x95\x9b\x3c\x43\xee\x7b\x2d\xac\xca\x9d\xfc\xa5\xd8\x82\x60\xc5\xb8\x28\xdd\x5a\x6f\xf0\x2c\x0c\xf3\xcd\x9d\x7c\x94\xab\x68\x29\xcb\xfe\xd9\x36\xa4\xf2\x07\xdb\x94\x1b\xea\xeb\xa8\x4d\xe8\xc3\x66\xc5\x83\x15\xf5\x28\x44\x43\x0d\x46\xa8\x64\x9a\x52\x17\x90\xb2\xe0\x16\x8d\xb6\x82\x2c\xa7\x51\xf7\x40\x44\x06\x62\xae\x0b\xd6\x1a\x4e\x9a\xd1\x07\x6c\x73\x0b\x3f\xf8\x5f\xad\x0e\xfe\x83\xfd\x37\xea\x9e\xb8\xe0\x76\xff\xb8\x05\xdf\x2e\xc9\x91\xf9\x0f\xa9\xe2\xc2\xe4\x18\x68\x17\x4b\x33\xe3\x9e\xda\xca\xba\xfd\xe1\xb0\x34\xa7\x27\xa0\x9b\x2f\x9a\xcf\x1b\x76\x3f\xfe\x9e\x5a\xd5\x06\x5c\xf2\x7b\xac\xa2\xd0\xcd\x73\xa9\x73\xe4\x6f\x21\xa4\x5f\x45\x72\xfd\xde\x3f\xda\x16\x91\xef\x42\xaf\x67\x9b\x09\x2e\x32\x6c\x31\xe6\x42\xa3\x32\x36\x13\x4a\xce\xaf\xb3\xfb\x7f\x15\xc2\x57\x35\x4b\xd0\x30\x88\x7f\xb4\x1c\x1b\xa1\xfe\x3f\x28\xf5\x22\xa7\xfd\xfa\x54\x0a\x05\x52\x44\xa4\x8c\xcd\x26\x7d\xaf\xe9\x9d\x2f\xfd\x32\xd2\x07\xf4\x9e\xa7\x5a\x6b\xa8\xa5\x2a\xc5\x42\x04\x5d\x4b\x3a\x9f\x93\x3b\x1e\xf6\xec\xdd\x51\x9c\xe9\x95\xc5\xad\xd1\xb8\xf0\x3e\x5f\xd0\x22\xac\x6c\x56\x50\xc1\xfb\x7d\x54\x0b\x85\xec\xb6\x1a\xd9\xb6\x9d\xb4\x7f\x8f\xe5\xe9\x5b\x12\x37\x09\x1b\x76\x49\xa8\x4d\xad\xda\x75\xe0\xe9\x4f\x39\x8b\x96\xe6\x45\x4d\x06\x6f\x0d\xdd\x20\x69\xed\xf8\x64\xf7\xca\x81\xd5\x82\xb7\x65\x05\x7d\x3a\xb5\xec\x37\x6c\x51\xe5\x27\x6f\x1d\xc8\x7c\x23\xd7\x4b\x9b\xe1\xee\x25\xad\x37\xaf\x08\xff\x03\xeb\x9c\x46\x16\x3c\x82\x51\xe0\xc1\xf0\xb0\x51\xdb\x5b\x22\xac\xa2\xde\x39\x8c\xaf\x3e\x7d\x9e\x41\x97\x64\xed\xea\x9d\x93\x8c\x5b\x24\xbb\x61\xd8\xf2\x5a\x65\x9a\x37\xa7\xd7\x53\x24\xfb\x37\x8c\x97\xa1\x3d\xb3\xe6\x45\xac\x75\xfe\xf0\xa8\x08\xfd\x9d\xe4\xa9\x1c\x47\x67\x02\xb3\xc2\xfa\x10\x50\xce\x78\x9a\xad\x11\xde\x3f\xb7\xb0\xa2\x7f\x86\x7d\x11\x8a\xb4\x73\xf5\x7a\x75\x68\x3e\xcb\xb5\x98\xb7\x6c\x31\x2e\x60\x87\xb9\xf4\x52\xe6\x1b\xc1\x96\x83\x3e\x39\x55\x0f\xd6\x3f\xee\xcb\x85\x1d\x44\x4d\x17\x3c\x8b\x77\x97\xf1\x4f\x45\xdf\xf7\x42\x56\xd3\xb1\xfe\x86\x02\xb7\xce\x6b\x1e\x35\xda\x71\xae\xbd\xe2\x64\xbe\xb7\x27\xdf\x51\xb7\xe6\x5b\x51\xd7\x06\x6b\x28\x47\x8d\xc2\x8e\x46\x34\xa4\x0d\x33\x99\x6e\x16\xf8\xa9\x1d\x19\x16\xb7\x03\xee\xb3\xe2\xb2\x68\x53\x0b\xda\x87\xbe\xac\xbc\x6f\x02\x5f\x12\xef\xc5\xce\xd3\xef\xe0\x54\x12\x97\x8e\xd8\x3a\x4e\x71\xe8\x1c\x0e\xed\x95\xc1\x4e\x35\x0d\xb9\x02\x73\x9f\x16\xc9\x41\xe9\x4c\x23\x79\x26\xb7\x0f\xed\x4f\xd3\x39\xb9\x25\x5a\x4a\xc7\x90\xab\x6a\xb4\x53\x5f\x12\xdc\x44\x3c\xc6\x1b\x7b\xa5\x25\x58\x82\x21\xe8\x35\x57\xe6\x46\x33\x11\x2e\xe4\x5d\x39\x5b\xb4\xc0\x32\x0e\xeb\x95\xe5\x49\xb9\xe2\x4a\xc8\x08\x27\xd5\xe0\x27\xfc\xf7\xb1\x7d\x52\x78\xda\x46\xd5\x98\x30\x41\x37\x77\xcf\x1b\xb5\xfc\x7b\xa8\x89\x23\x12\x8d\x77\x06\x3c\x06\x9e\x81\xae\x45\xe4\x76\x43\xae\x0e\xfc\xc1\xd1\xe1\x9f\x5d\xaa\x56\x7b\xa8\x93\xfd\xd4\xa7\xb0\x6d\xca\x87\x5e\xef\x89\x70\x7a\x14\x6a\x23\x15\x06\x52\x80\x77\x6d\x8d\x4d\xdb\x75\x7b\x47\xa5\x0f\xc6\xba\xae\xcc\xe5\x5f\x87\x94\x02\xeb\x7a\xf2\x43\x4c\xd7\x3b\x52\x80\x42\x3b\x44\x3d\x29\x5d\x1b\xcb\xcc\x54\xb8\x9f\xb0\x08\x56\x85\xe8\x4a\x89\x1c\x43\x0b\xf9\x1e\x3c\x11\x7f\x5a\x88\xe9\x13\x6b\xf0\xfe\x15\xe6\x8a\xd4\xa9\xe7\x5d\x80\xbb\xeb\xd9\xc7\x7d\x9e\x75\xf7\x77\x47\xcd\x48\xd7\x68\xaa\x38\xa7\x33\x44\xf3\x8a\x05\x46\xa3\xc6\xb5\x5c\xde\x05\x6b\x34\xf9\x10\xc2\x71\x53\x2f\x67\xb7\x9e\xe7\x02\xe8\x5a\x29\xe2\xcb\xa2\x94\x17\xc7\x23\xc2\xaf\xfd\xaf\x85\xa8\xd1\xe0\xc8\x2f\x7f\xb6\xc4\x97\x87\xa0\x36\xab\xe6\xde\xf4\x32\xda\x5e\xaf\x1d\xc6\x96\x0b\x0a\xaa\xdc\xed\x56\xa6\xd7\xdb\x99\xdd\x09\xef\x86\xce\xef\x9e\x58\x94\x9e\x76\xdd\x78\x42\xb2\xa7\xca\x17\x77\x68\xad\xdb\xb1\xea\x3e\xad\xb8\x93\x39\xdc\x53\xb4\x8a\x29\xa7\x71\xf5\x53\xdf\xfe\xee\x5b\x50\x6f\x22\x04\x6b\x6f\xe3\xca\x38\x19\xc4\x48\x7b\x8f\x6c\x9b\x46\x3d\x84\xb2\x79\x3b\x9a\x6f\xfb\x55\xdf\x92\x8f\x57\x5d\xcb\xd1\x7c\xfb\xea\xfd\xd4\xb6\x09\xb5\x71\xe9\x95\xcb\xce\x84\xce\x52\x92\x88\x61\x85\xd9\xde\xff\x0e\x61\xd7\x36\x4d\x39\x0d\x19\xa7\xa7\x8e\xbd\xfb\x6a\x45\x74\xad\xea\xae\x8a\x34\x59\x5e\x2d\xb7\xa2\xa7\x16\x80\x77\xdc\xc0\x3b\x67\xeb\xfc\x77\x00\x03\x46\x02\x45\xf5\x1a\x00\x00"),
 		},
 		"/scripts/init_change_hostalias.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_hostalias.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 84146261, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 1121,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x61\x4f\xdb\x30\x10\xfd\x9e\x5f\xf1\xd6\x7e\x60\x93\xda\x84\xf1\x6d\x83\x21\x75\x50\xb4\x6c\xa8\x95\x48\x19\x42\xd3\x04\xae\x73\x89\x4f\xa4\x76\xb0\x1d\x4a\x35\xd8\x6f\x9f\x1c\x5a\x20\x74\xf9\x14\xdf\xbd\x7b\x7e\x7e\xef\xfa\xef\x92\xc6\xd9\x64\xce\x3a\x21\x7d\x87\xb9\x70\x2a\xea\xf7\x71\x64\xea\x95\xe5\x52\x79\xec\xed\x7e\xfc\x84\x4c\x09\x5d\x2a\xc1\xf8\xce\xba\x3c\x6e\x0c\x52\x5d\x18\xbb\x10\x9e\x8d\xc6\x8c\xa4\xd2\xa6\x32\xe5\x0a\xd2\xc4\x03\x9c\xfa\x3c\x8e\xfa\xfd\x40\x73\xca\x92\xb4\xa3\x1c\x8d\xce\xc9\xc2\x2b\xc2\xa8\x16\x52\xd1\xa6\x33\xc0\x4f\xb2\x2e\xb0\xec\xc5\xbb\x78\x1f\x00\xbd\x75\xab\xf7\x61\x3f\x50\xac\x4c\x83\x85\x58\x41\x1b\x8f\xc6\x11\xbc\x62\x87\x82\x2b\x02\xdd\x4b\xaa\x3d\x58\x43\x9a\x45\x5d\xb1\xd0\x92\xb0\x64\xaf\xda\x7b\xd6\x2c\x41\x09\x2e\xd7\x1c\x66\xee\x05\x6b\x08\x48\x53\xaf\x60\x8a\xd7\x40\x08\xbf\x16\xdd\x7e\xca\xfb\xfa\x73\x92\x2c\x97\xcb\x58\xb4\x8a\x63\x63\xcb\xa4\x7a\xc2\xba\xe4\x34\x3d\x1a\x4f\xb2\xf1\x70\x2f\xde\x5d\x4f\x9d\xeb\x8a\x9c\x83\xa5\xdb\x86\x2d\xe5\x98\xaf\x20\xea\xba\x62\x29\xe6\x15\xa1\x12\x4b\x18\x0b\x51\x5a\xa2\x1c\xde\x04\xd5\x4b\xcb\x9e\x75\x39\x80\x33\x85\x5f\x0a\x4b\x81\x26\x67\xe7\x2d\xcf\x1b\xdf\x31\x6d\xa3\x91\x5d\x07\x60\x34\x84\x46\x6f\x94\x21\xcd\x7a\xf8\x3a\xca\xd2\x6c\x10\x48\x2e\xd2\xd9\xb7\xe9\xf9\x0c\x17\xa3\xb3\xb3\xd1\x64\x96\x8e\x33\x4c\xcf\x70\x34\x9d\x1c\xa7\xb3\x74\x3a\xc9\x30\x3d\xc1\x68\x72\x89\x1f\xe9\xe4\x78\x00\x62\xaf\xc8\x82\xee\x6b\x1b\x5e\x60\x2c\x38\xd8\x49\x6d\x8a\xc8\x88\x3a\x12\x0a\xf3\x24\xc9\xd5\x24\xb9\x60\x89\x4a\xe8\xb2\x11\x25\xa1\x34\x77\x64\x35\xeb\x12\x35\xd9\x05\xbb\x10\xab\x83\xd0\x79\xa0\xa9\x78\xc1\xbe\xdd\x17\xb7\xfd\xae\x38\x8a\xfa\x98\x85\x60\x9d\xb4\x1c\x32\x75\x10\xbc\x08\x3e\x39\xf2\x50\xc6\x79\x51\xb1\x70\xe4\xa2\xc8\x93\xf3\x18\x16\xf8\x9b\xc4\x61\x59\xad\xc4\xc3\x03\xbc\x69\xa4\x7a\x29\xbd\x05\x5d\xad\xa7\xb7\xa0\x57\xcf\xb4\xa5\xa5\x1a\xbd\xf6\xd8\xdb\x1a\x1b\xde\x86\xc9\x3f\x51\x58\x0c\x29\x3c\x0e\xdf\x00\x0e\x0e\xc6\xd3\x93\xa8\x3d\xe0\xe6\xcb\xce\x4d\x33\x27\xe9\xab\x9d\x4d\x45\x3e\x97\x30\xd4\xc8\xa9\x10\x4d\xe5\x5f\xba\x37\xae\xd3\x0f\xbf\x43\xb7\x72\x9e\x16\x2f\x18\xea\x40\x6e\x25\xed\x44\xe1\xca\xc7\x8d\xf0\xd7\x6a\x7a\x5d\x6f\x9e\x64\x93\x54\x06\xd7\x11\x17\xf8\xf5\x3f\x63\x7e\xef\x87\x3c\x74\x0b\x8d\xb7\xdc\x29\xf8\x1a\x87\x87\xaf\xfc\x7d\x8c\x22\x67\x1a\x2b\x69\x0b\xda\x2d\x5b\x19\xfd\x0b\x00\x00\xff\xff\xcb\x84\xa6\x2e\x61\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x5d\x4f\x1b\x39\x14\x7d\xf7\xaf\x38\x9b\x3c\xb0\x2b\x25\x33\x2c\x6f\xbb\x50\xa4\x14\x82\x3a\x2d\x4a\x24\x26\x14\xa1\xaa\x2a\x8e\xe7\xce\xf8\x8a\x89\x3d\xd8\x1e\x42\x54\xe8\x6f\xaf\x9c\x0f\x42\x48\xe7\x69\x7c\xcf\xb9\xc7\xc7\xf7\xdc\xee\x5f\x69\xeb\x5d\x3a\x65\x93\x92\x79\xc4\x54\x7a\x2d\xba\x5d\x9c\xd9\x66\xe1\xb8\xd2\x01\x47\x87\xff\xfe\x87\x5c\x4b\x53\x69\xc9\xf8\xcc\xa6\x3a\x6f\x2d\x32\x53\x5a\x37\x93\x81\xad\xc1\x84\x94\x36\xb6\xb6\xd5\x02\xca\x26\x3d\x5c\x86\x22\x11\xdd\x6e\x94\xb9\x64\x45\xc6\x53\x81\xd6\x14\xe4\x10\x34\x61\xd0\x48\xa5\x69\x83\xf4\xf0\x95\x9c\x8f\x2a\x47\xc9\x21\xfe\x8e\x84\xce\x1a\xea\xfc\x73\x1c\x25\x16\xb6\xc5\x4c\x2e\x60\x6c\x40\xeb\x09\x41\xb3\x47\xc9\x35\x81\x9e\x14\x35\x01\x6c\xa0\xec\xac\xa9\x59\x1a\x45\x98\x73\xd0\x08\xdb\x0b\xa2\x13\xdc\xae\x35\xec\x34\x48\x36\x90\x50\xb6\x59\xc0\x96\x6f\x89\x90\x61\x6d\x7a\xf9\xe9\x10\x9a\xff\xd3\x74\x3e\x9f\x27\x72\xe9\x38\xb1\xae\x4a\xeb\x15\xd7\xa7\x97\xd9\xd9\x70\x94\x0f\xfb\x47\xc9\xe1\xba\xeb\xda\xd4\xe4\x3d\x1c\x3d\xb4\xec\xa8\xc0\x74\x01\xd9\x34\x35\x2b\x39\xad\x09\xb5\x9c\xc3\x3a\xc8\xca\x11\x15\x08\x36\xba\x9e\x3b\x0e\x6c\xaa\x1e\xbc\x2d\xc3\x5c\x3a\x8a\x56\x0b\xf6\xc1\xf1\xb4\x0d\x3b\x43\xdb\x78\x64\xbf\x43\xb0\x06\xd2\xa0\x33\xc8\x91\xe5\x1d\x7c\x1c\xe4\x59\xde\x8b\x22\x37\xd9\xe4\xd3\xf8\x7a\x82\x9b\xc1\xd5\xd5\x60\x34\xc9\x86\x39\xc6\x57\x38\x1b\x8f\xce\xb3\x49\x36\x1e\xe5\x18\x5f\x60\x30\xba\xc5\x97\x6c\x74\xde\x03\x71\xd0\xe4\x40\x4f\x8d\x8b\x2f\xb0\x0e\x1c\xc7\x49\xcb\x14\x91\x13\xed\x58\x28\xed\x2a\x47\xdf\x90\xe2\x92\x15\x6a\x69\xaa\x56\x56\x84\xca\x3e\x92\x33\x6c\x2a\x34\xe4\x66\xec\x63\xac\x1e\xd2\x14\x51\xa6\xe6\x19\x87\xe5\xbe\xf8\xfd\x77\x25\x42\x74\x31\x89\xc1\x7a\xe5\x38\x66\xea\x21\x79\x16\xe7\xe4\x29\x40\x5b\x1f\x64\xcd\xd2\x93\x17\x22\x90\x0f\xe8\x97\xf8\x95\x26\x71\x59\x9d\xc2\xf3\x33\x82\x6d\x95\xde\x96\xde\x93\x7e\xac\xbb\xf7\xa8\x1b\x40\x88\xca\x51\x83\xce\xf2\xd8\xd9\x6b\xeb\x3f\xc4\xce\x9f\x22\x2e\x86\x92\x01\xa7\xef\x08\x27\x27\xc3\xf1\x85\x58\x1e\x70\xff\xe1\xe0\xbe\x9d\x92\x0a\xf5\xc1\xa6\xa2\x5e\x4b\xe8\x1b\x14\x54\xca\xb6\x0e\x5b\xf4\xde\xef\xe0\xf1\xb7\xef\x17\x3e\xd0\x6c\xcb\xa1\x1d\xca\x83\xa2\x03\x11\xaf\x7c\xd9\x18\x7f\xeb\xe6\xd5\xff\x6a\x36\x2b\xdb\xa4\xb4\xc5\x9d\xe0\x12\xdf\xfe\x34\x98\xef\xc7\x31\x0f\xb3\xa4\x26\xef\x51\x51\xf2\x1d\x4e\x4f\x37\x65\xa7\xc4\x8b\x10\xde\xb6\x4e\xd1\x1e\x75\xb7\xec\x94\xf8\x3d\x00\xcb\x84\xa6\x2e\x61\x04\x00\x00"),
 		},
 		"/scripts/init_change_network.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_network.sh",
-			modTime:          time.Date(2019, 12, 26, 8, 54, 7, 444452562, time.UTC),
-			uncompressedSize: 920,
+			modTime:          time.Date(2026, 10, 19, 9, 32, 28, 717010923, time.UTC),
+			uncompressedSize: 2291,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x5f\x6b\xdb\x30\x14\xc5\xdf\xfd\x29\xce\x12\xe8\x9f\x91\x58\x4d\xd8\xcb\x36\x36\xc8\xda\x8c\x79\x2b\x09\xd4\xe9\x4a\x61\x30\x14\xf9\xda\xbe\x4c\x91\x34\x49\x8e\x1b\xc6\xbe\xfb\x50\x9a\x8e\x96\xd6\x6f\x96\xce\xfd\xe9\xdc\x7b\xee\xf0\x95\xe8\x82\x17\x6b\x36\x82\xcc\x16\x6b\x19\xda\x6c\x38\xc4\xb9\x75\x3b\xcf\x4d\x1b\x31\x3d\x9b\xbc\x45\xd9\x4a\xd3\xb4\x92\xf1\x95\x4d\x73\xd1\x59\x14\xa6\xb6\x7e\x23\x23\x5b\x83\x15\xa9\xd6\x58\x6d\x9b\x1d\x94\xcd\x47\xb8\x8c\x55\x9e\x0d\x87\x09\x73\xc9\x8a\x4c\xa0\x0a\x9d\xa9\xc8\x23\xb6\x84\x99\x93\xaa\xa5\x87\x9b\x11\xbe\x93\x0f\x89\x32\xcd\xcf\x70\x92\x04\x83\xc3\xd5\xe0\xf4\x7d\x42\xec\x6c\x87\x8d\xdc\xc1\xd8\x88\x2e\x10\x62\xcb\x01\x35\x6b\x02\xdd\x29\x72\x11\x6c\xa0\xec\xc6\x69\x96\x46\x11\x7a\x8e\xed\xfe\x9d\x03\x25\x39\xc1\xed\x81\x61\xd7\x51\xb2\x81\x84\xb2\x6e\x07\x5b\x3f\x16\x42\xc6\x83\xe9\xfd\xd7\xc6\xe8\xde\x09\xd1\xf7\x7d\x2e\xf7\x8e\x73\xeb\x1b\xa1\xef\xb5\x41\x5c\x16\xe7\xf3\x45\x39\x1f\x4f\xf3\xb3\x43\xd5\xb5\xd1\x14\x02\x3c\xfd\xee\xd8\x53\x85\xf5\x0e\xd2\x39\xcd\x4a\xae\x35\x41\xcb\x1e\xd6\x43\x36\x9e\xa8\x42\xb4\xc9\x75\xef\x39\xb2\x69\x46\x08\xb6\x8e\xbd\xf4\x94\x30\x15\x87\xe8\x79\xdd\xc5\x27\x43\x7b\xf0\xc8\xe1\x89\xc0\x1a\x48\x83\xc1\xac\x44\x51\x0e\xf0\x69\x56\x16\xe5\x28\x41\x6e\x8a\xd5\x97\xe5\xf5\x0a\x37\xb3\xab\xab\xd9\x62\x55\xcc\x4b\x2c\xaf\x70\xbe\x5c\x5c\x14\xab\x62\xb9\x28\xb1\xfc\x8c\xd9\xe2\x16\xdf\x8a\xc5\xc5\x08\xc4\xb1\x25\x0f\xba\x73\x3e\x75\x60\x3d\x38\x8d\x93\xf6\x29\xa2\x24\x7a\x62\xa1\xb6\xf7\x96\x82\x23\xc5\x35\x2b\x68\x69\x9a\x4e\x36\x84\xc6\x6e\xc9\x1b\x36\x0d\x1c\xf9\x0d\x87\x14\x6b\x80\x34\x55\xc2\x68\xde\x70\xdc\xef\x4b\x78\xde\x57\x9e\x65\x43\xac\x52\xb0\x41\x79\x4e\x99\x06\x48\xde\xa4\x39\xa9\xb4\x79\x94\xf6\x92\x15\x0c\xc5\xde\xfa\x5f\x20\xb3\xcd\xb2\xc6\x93\xc3\xc0\x50\xcc\xd9\x6d\xdf\xe4\xec\x7e\xd6\xd6\xf7\xd2\x57\x03\x08\x8a\x4a\x84\x5d\x50\x51\xe7\xca\x9a\x1a\x47\x47\xf8\x93\xa5\x58\xd3\x2e\x8e\x19\xe3\x39\x8e\x83\x38\xc9\x5f\x9f\xbe\x50\x9f\x8e\xc5\x0b\xe7\x3f\xa6\x62\x72\xfc\x8c\x9d\xfd\xcd\xb2\xfb\x5f\x8c\x7b\xbc\x50\xf6\x61\x82\xc9\x47\x51\xd1\x56\x98\x4e\xeb\xff\x5a\xf7\xdc\xe5\x63\xd9\xbf\x00\x00\x00\xff\xff\xcb\x10\xc5\x1a\x98\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x7f\x6f\xdb\xc6\x12\xfc\x9f\x9f\x62\x1e\xa5\xfc\x32\x2c\xd2\x32\x1e\x0c\xbc\x04\xf4\xab\x9b\xb8\xa8\x1a\xc3\x2e\x22\x27\x41\x60\xab\xc6\x89\x5c\x92\x0b\x51\x77\xcc\xdd\x51\xb2\x60\xfb\xbb\x17\x4b\xd2\xb5\x1d\xab\x28\xaa\x7f\x04\xdd\xed\xce\xce\xce\xec\xad\x06\xff\x89\x1b\x67\xe3\x39\xeb\x98\xf4\x0a\x73\xe5\xca\x60\x30\xc0\x7b\x53\x6f\x2c\x17\xa5\xc7\xfe\xde\xf8\x7f\x98\x96\x4a\x17\xa5\x62\xfc\xc6\xba\xf8\xd0\x18\x4c\x74\x6e\xec\x52\x79\x36\x1a\xe7\x94\x96\xda\x54\xa6\xd8\x20\x35\xd1\x2e\x4e\x7c\x16\x05\x83\x81\xc0\x9c\x70\x4a\xda\x51\x86\x46\x67\x64\xe1\x4b\xc2\x51\xad\xd2\x92\xee\x6f\x76\xf1\x85\xac\x13\x94\xfd\x68\x0f\xaf\x25\x20\xec\xaf\xc2\x37\xef\x04\x62\x63\x1a\x2c\xd5\x06\xda\x78\x34\x8e\xe0\x4b\x76\xc8\xb9\x22\xd0\x75\x4a\xb5\x07\x6b\xa4\x66\x59\x57\xac\x74\x4a\x58\xb3\x2f\xe1\x1f\x0a\x08\x13\x7c\xeb\x31\xcc\xdc\x2b\xd6\x50\x48\x4d\xbd\x81\xc9\x1f\x07\x42\xf9\x9e\x74\xfb\x29\xbd\xaf\xdf\xc6\xf1\x7a\xbd\x8e\x54\xcb\x38\x32\xb6\x88\xab\x2e\xd6\xc5\x27\x93\xf7\xc7\xa7\xd3\xe3\xd1\x7e\xb4\xd7\x67\x7d\xd6\x15\x39\x07\x4b\xdf\x1b\xb6\x94\x61\xbe\x81\xaa\xeb\x8a\x53\x35\xaf\x08\x95\x5a\xc3\x58\xa8\xc2\x12\x65\xf0\x46\x58\xaf\x2d\x7b\xd6\xc5\x2e\x9c\xc9\xfd\x5a\x59\x12\xaa\x19\x3b\x6f\x79\xde\xf8\x27\xa2\xdd\x73\x64\xf7\x24\xc0\x68\x28\x8d\xf0\x68\x8a\xc9\x34\xc4\xcf\x47\xd3\xc9\x74\x57\x40\xbe\x4e\xce\x7f\x3d\xfb\x7c\x8e\xaf\x47\x9f\x3e\x1d\x9d\x9e\x4f\x8e\xa7\x38\xfb\x84\xf7\x67\xa7\x1f\x26\xe7\x93\xb3\xd3\x29\xce\x7e\xc1\xd1\xe9\x37\x7c\x9c\x9c\x7e\xd8\x05\xb1\x2f\xc9\x82\xae\x6b\x2b\x1d\x18\x0b\x16\x39\xa9\x75\x11\x53\xa2\x27\x14\x72\xd3\xf9\xe8\x6a\x4a\x39\xe7\x14\x95\xd2\x45\xa3\x0a\x42\x61\x56\x64\x35\xeb\x02\x35\xd9\x25\x3b\xb1\xd5\x41\xe9\x4c\x60\x2a\x5e\xb2\x6f\xe7\xc5\x3d\xef\x2b\x0a\x82\x01\xce\xc5\x58\x97\x5a\x16\x4f\x1d\x14\x2f\x45\xa7\x54\x26\x8f\x64\x2e\x39\x85\x26\xbf\x36\x76\x01\xd2\xab\x60\x80\xc6\xa9\x82\xde\x82\x35\xfb\xab\x2e\xec\xaa\x0f\x88\x5c\x89\x0b\xae\x57\x07\x33\x5c\x7c\x3c\xfe\x96\x7c\x39\x3a\xf9\x7c\x3c\x8b\xa2\x28\x18\x60\xf2\xfb\xea\x00\xb9\xb1\x6b\x65\x33\xe1\xca\x0e\xa4\xc5\x23\xb1\xc5\x80\x73\x48\xa2\x30\x28\x78\x45\x7a\x17\xeb\x92\xd3\x12\xfc\xd4\xda\x16\x45\xe9\x0c\x59\xa3\xaa\x91\xf3\x2a\x5d\x20\xad\x1a\xe7\xc9\x3a\xa9\x72\x5e\x52\x97\x0f\xb7\x71\xa9\xaf\xe0\xc8\x8b\xd9\x0e\xca\x92\xfc\x10\x5d\x44\x27\xc7\x4e\xac\x56\xb9\xef\x25\x99\x37\x5c\xf9\x11\x6b\x18\x4d\x4e\x66\x43\x4e\x37\xf0\x6a\x41\xa8\x2d\xa5\x94\x91\x4e\x29\x0a\x82\xc2\x52\x8d\x50\x93\x8f\xb8\x5e\xfd\x37\xe2\xfa\xaa\xef\x2a\x44\x4c\x3e\x8d\xbb\xc2\x51\x6a\x74\x8e\x97\x2f\x71\x13\xc8\x58\xcb\x5b\x1c\x31\x46\xc7\x78\xe5\xe2\xd7\xd1\xce\x9b\x2d\xf9\x72\x1c\x6f\x39\xbf\xdc\x8f\xc7\xaf\x9e\x61\x07\x77\x41\xd0\xf7\x38\x5a\x63\x4b\x5a\x32\xc6\xf8\x30\xce\x68\x15\xeb\xa6\xaa\xc4\x69\x69\xbf\x1d\xa1\x2e\x6d\x41\x1b\xcc\x8d\x2f\xa1\x3c\x6c\xa3\x3d\x2f\xa9\x55\x87\xf5\xf3\x46\xbc\xc1\x82\xa8\x06\xfb\x5e\x32\x4b\x73\x63\x7c\xcf\xe0\xaa\x17\xf4\xf5\x9b\xbe\xdd\xca\xa4\xaa\x2d\x90\x0c\xc7\x58\xa9\xaa\xa1\x64\xb8\x1f\xb4\x57\x0f\x9c\xc3\xe1\xcd\x82\x36\x77\xc9\xf0\xa6\x8d\xb8\x0b\x1f\xf3\xc5\xed\x2d\x2c\xf9\xc6\x6a\x8c\xdb\x3c\xce\xd1\x2a\x3f\xfa\x2e\x2a\x86\x7f\x5c\xba\x9d\xc1\xff\x2f\xdd\x4e\x07\x72\xe9\x76\x92\xe7\xfa\xbf\x13\x0f\x75\x9b\xfe\xd4\x84\xd0\xdd\x6e\x01\x88\x76\x6e\x3b\x34\x24\xb8\x27\x75\xfb\x1c\xb5\xc5\xa3\xca\xd1\x5f\xc0\x94\x96\x06\xe1\xb3\xdc\x10\x87\x87\xdb\xb3\x73\x16\xfb\x64\xe0\x93\x5c\x09\x12\xe7\xb8\xb8\x40\x38\x1c\x87\x48\x12\x84\x72\x13\x62\x36\x7b\xd4\x80\x1c\x25\xde\x36\x5d\x55\x57\x72\xee\x83\x9c\x83\x80\x73\x0c\xe5\xee\x51\xe8\xc5\x05\x46\x19\xe2\xda\x9a\xb6\xb2\xcc\x54\x2c\x21\x98\xcd\x44\xd6\x9b\x1f\x78\xb7\x6f\xaa\xdb\x70\xdd\x7b\x64\x8d\x05\x59\x4d\xd5\x2e\xea\x8a\x94\x23\x58\x5a\x9a\x15\xb5\x2f\x34\xea\xc3\x92\x31\x72\x6b\x96\x7d\x24\x6a\x65\xd5\x92\xe4\x19\x86\x38\x7c\xb9\xff\x50\xe2\x9a\x7d\xef\xe0\xdd\xe3\x01\xb8\x1f\x99\xfb\xc9\x3d\x68\xc5\x89\x54\x55\xdd\x17\xb8\x92\x6a\xd8\xfb\xe7\x9c\x8c\x72\xd5\x54\xfe\x5f\xe7\x49\xad\xfe\xad\xc8\x42\x1a\x77\xf4\x06\x98\x5b\xce\x0a\xca\xba\x8d\xe5\xad\xca\x65\xdb\x2e\x1b\xe7\x51\x2b\xe7\xc0\xf5\x81\x97\x3a\x4e\xb6\x19\x16\xcd\x9c\x46\xb5\x35\xd7\x9b\x36\x7b\x69\xb2\xda\x9a\x39\x61\x6e\x65\x29\xe6\x5c\xc9\x7e\xd9\x7f\xf4\x0e\xef\x0d\xa2\x1f\x0c\xea\xaa\xf6\x5f\x23\x9d\x8f\x52\x55\x55\xa3\x87\x62\xb3\x99\x6c\x93\x2d\x0d\x75\x19\xd1\xdf\x26\x8e\xdb\x31\x11\xae\xfd\x2e\x94\xff\xc0\x70\xf8\x53\xf8\x0e\x99\xd9\xa6\x52\x38\xbc\xe9\x23\x5f\xbc\x48\x76\xee\xc2\x47\x07\x83\x9d\xe4\x2e\x94\x19\xea\x6d\xcd\x8c\xa6\x87\x35\x54\x3f\x1b\xf6\x27\x1b\xe8\xcf\x01\x00\x5c\x62\xac\xd5\xf3\x08\x00\x00"),
 		},
 		"/scripts/init_change_route.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_route.sh",
-			modTime:          time.Date(2019, 12, 25, 17, 38, 50, 188812825, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 824,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xd3\x4c\x10\x86\xef\xfe\x15\x6f\x93\x4a\xfd\x3e\x29\xd8\x49\x0e\x20\xa8\x7a\x08\x6d\x11\x86\x2a\x91\xea\x94\xaa\xaa\x2a\xba\xb1\xc7\xf6\x08\x67\x76\xd9\x5d\xd7\x8d\x80\xff\x8e\xd6\x71\x11\x11\xbe\xad\x66\xe6\xd9\x67\xe7\xf5\xf8\x28\x69\x9d\x4d\x36\x2c\x09\xc9\x13\x36\xca\xd5\xd1\x78\x8c\x73\x6d\x76\x96\xab\xda\x63\x3e\x9d\xbd\x45\x56\x2b\xa9\x6a\xc5\xf8\xc4\x52\x5d\xb4\x1a\xa9\x94\xda\x6e\x95\x67\x2d\x58\x53\x5e\x8b\x6e\x74\xb5\x43\xae\xe3\x09\xae\x7c\x11\x47\xe3\x71\xc0\x5c\x71\x4e\xe2\xa8\x40\x2b\x05\x59\xf8\x9a\xb0\x30\x2a\xaf\xe9\xa5\x32\xc1\x17\xb2\x2e\x50\xe6\xf1\x14\xff\x85\x86\xd1\x50\x1a\xfd\x7f\x1a\x10\x3b\xdd\x62\xab\x76\x10\xed\xd1\x3a\x82\xaf\xd9\xa1\xe4\x86\x40\xcf\x39\x19\x0f\x16\xe4\x7a\x6b\x1a\x56\x92\x13\x3a\xf6\x75\x7f\xcf\x40\x09\x26\xb8\x1b\x18\x7a\xe3\x15\x0b\x14\x72\x6d\x76\xd0\xe5\xdf\x8d\x50\x7e\x90\xee\xbf\xda\x7b\xf3\x2e\x49\xba\xae\x8b\x55\x6f\x1c\x6b\x5b\x25\xcd\xbe\xd7\x25\x57\xe9\xf9\xe5\x32\xbb\x7c\x35\x8f\xa7\xc3\xd4\x8d\x34\xe4\x1c\x2c\x7d\x6f\xd9\x52\x81\xcd\x0e\xca\x98\x86\x73\xb5\x69\x08\x8d\xea\xa0\x2d\x54\x65\x89\x0a\x78\x1d\xac\x3b\xcb\x9e\xa5\x9a\xc0\xe9\xd2\x77\xca\x52\xc0\x14\xec\xbc\xe5\x4d\xeb\x0f\x96\xf6\xe2\xc8\xee\xa0\x41\x0b\x94\x60\xb4\xc8\x90\x66\x23\xbc\x5f\x64\x69\x36\x09\x90\xdb\x74\xfd\x71\x75\xb3\xc6\xed\xe2\xfa\x7a\xb1\x5c\xa7\x97\x19\x56\xd7\x38\x5f\x2d\x2f\xd2\x75\xba\x5a\x66\x58\x7d\xc0\x62\x79\x87\xcf\xe9\xf2\x62\x02\x62\x5f\x93\x05\x3d\x1b\x1b\x5e\xa0\x2d\x38\xac\x93\xfa\x14\x91\x11\x1d\x28\x94\x7a\xaf\xe4\x0c\xe5\x5c\x72\x8e\x46\x49\xd5\xaa\x8a\x50\xe9\x27\xb2\xc2\x52\xc1\x90\xdd\xb2\x0b\xb1\x3a\x28\x29\x02\xa6\xe1\x2d\xfb\xfe\x7f\x71\xff\xbe\x2b\x8e\xa2\x31\xd6\x21\x58\x97\x5b\x0e\x99\x3a\x28\xde\x86\x3d\x15\xd4\x90\x27\xb0\x3c\xa9\x86\x0b\x58\xdd\x7a\x8a\xa2\xe1\xf8\xb5\x3f\x9e\x3d\xb2\xd9\x17\xf0\x13\xaa\xfb\x86\x93\x64\xf6\x66\x1e\xcf\x5e\xc7\xd3\x78\x9a\xfc\x30\x96\xc5\xe3\x78\xf6\xeb\xe4\x31\xe2\x12\xf7\xf7\x38\x3e\x18\xc7\xd1\x19\x46\x23\x3c\x3c\x9c\x06\x25\x89\x42\xfa\x7f\x80\xc3\xf5\x87\x13\x51\xc9\xd1\xef\x00\x00\x00\xff\xff\xf2\x36\x8f\xf2\x38\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xd3\x40\x10\x85\xef\xfe\x15\xaf\x49\xa5\x82\x14\xec\x24\x07\x10\x54\x3d\x84\xb6\x08\x43\x95\x48\x75\x4a\x55\x55\x15\xdd\xd8\x63\x7b\xc4\x66\x76\xd9\x5d\xd7\x8d\x80\xff\x8e\x36\x4d\x81\x08\xdf\x56\xf3\xe6\xcd\x37\xf3\x3c\x3c\xc8\x3a\xef\xb2\x15\x4b\x46\xf2\x80\x95\xf2\x6d\x32\x1c\xe2\xd4\xd8\x8d\xe3\xa6\x0d\x98\x8e\x27\x6f\x51\xb4\x4a\x9a\x56\x31\x3e\xb1\x34\x67\x9d\x41\x2e\xb5\x71\x6b\x15\xd8\x08\x96\x54\xb6\x62\xb4\x69\x36\x28\x4d\x3a\xc2\x45\xa8\xd2\x64\x38\x8c\x36\x17\x5c\x92\x78\xaa\xd0\x49\x45\x0e\xa1\x25\xcc\xac\x2a\x5b\x7a\xae\x8c\xf0\x85\x9c\x8f\x2e\xd3\x74\x8c\x17\x51\x30\xd8\x95\x06\x2f\x8f\xa3\xc5\xc6\x74\x58\xab\x0d\xc4\x04\x74\x9e\x10\x5a\xf6\xa8\x59\x13\xe8\xb1\x24\x1b\xc0\x82\xd2\xac\xad\x66\x25\x25\xa1\xe7\xd0\x22\xfc\x1d\x10\x49\x70\xb3\xf3\x30\xab\xa0\x58\xa0\x50\x1a\xbb\x81\xa9\xff\x15\x42\x85\x1d\xf4\xf6\x6b\x43\xb0\xef\xb2\xac\xef\xfb\x54\x6d\x89\x53\xe3\x9a\x4c\x3f\x69\x7d\x76\x91\x9f\x9e\xcf\x8b\xf3\x57\xd3\x74\xbc\xeb\xba\x12\x4d\xde\xc3\xd1\xf7\x8e\x1d\x55\x58\x6d\xa0\xac\xd5\x5c\xaa\x95\x26\x68\xd5\xc3\x38\xa8\xc6\x11\x55\x08\x26\x52\xf7\x8e\x03\x4b\x33\x82\x37\x75\xe8\x95\xa3\x88\x5a\xb1\x0f\x8e\x57\x5d\xd8\x3b\xda\x33\x23\xfb\x3d\x81\x11\x28\xc1\x60\x56\x20\x2f\x06\x78\x3f\x2b\xf2\x62\x14\x4d\xae\xf3\xe5\xc7\xc5\xd5\x12\xd7\xb3\xcb\xcb\xd9\x7c\x99\x9f\x17\x58\x5c\xe2\x74\x31\x3f\xcb\x97\xf9\x62\x5e\x60\xf1\x01\xb3\xf9\x0d\x3e\xe7\xf3\xb3\x11\x88\x43\x4b\x0e\xf4\x68\x5d\xdc\xc0\x38\x70\x3c\x27\x6d\x53\x44\x41\xb4\x87\x50\x9b\xa7\x1c\xbd\xa5\x92\x6b\x2e\xa1\x95\x34\x9d\x6a\x08\x8d\x79\x20\x27\x2c\x0d\x2c\xb9\x35\xfb\x18\xab\x87\x92\x2a\xda\x68\x5e\x73\xd8\xfe\x2f\xfe\xff\xbd\xd2\x24\x19\x62\x19\x83\xf5\xa5\xe3\x98\xa9\x87\xe2\x75\xbc\x53\x45\x9a\x02\x81\xe5\x41\x69\xae\xe0\x4c\x17\x28\x49\x76\xcf\xaf\xdb\xe7\xc9\x3d\xdb\xa7\x02\x7e\x42\xf5\xdf\x70\x94\x4d\xde\x4c\xd3\xc9\xeb\x74\x9c\x8e\xb3\x1f\xd6\xb1\x04\x1c\x4e\x7e\x1d\xdd\x27\x5c\xe3\xf6\x16\x87\x7b\xed\x38\x38\xc1\x60\x80\xbb\xbb\xe3\x88\x24\x49\x4c\xff\x8f\xe1\x6e\xfc\x7e\x47\x52\x73\xf2\x7b\x00\xf2\x36\x8f\xf2\x38\x03\x00\x00"),
 		},
 		"/scripts/init_change_swap.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_swap.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 85321665, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 723,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\x9c\x3e\x10\xc5\xef\x7c\x8a\xf7\x5f\x0e\xf9\x57\xda\x40\x9a\x5b\xdb\x13\x4d\x52\x95\x36\x62\xa5\x40\x1a\xe5\x38\x98\x01\x46\x02\xdb\xb5\x4d\x08\xdf\xbe\xf2\x66\x23\x75\x55\x0e\x1c\x3c\xcf\x3f\xbf\x99\x37\xe9\x7f\xf9\xe2\x5d\xde\x8a\xce\x59\xbf\xa0\x25\x3f\x26\x69\x8a\x1b\x63\x37\x27\xc3\x18\x70\x7d\xf5\xf1\x13\xea\x91\xf4\x30\x92\xe0\x87\xe8\xe1\x76\x31\x28\x75\x6f\xdc\x4c\x41\x8c\x46\xc3\x6a\xd4\x66\x32\xc3\x06\x65\xb2\x3d\xee\x43\x97\x25\x69\x1a\x31\xf7\xa2\x58\x7b\xee\xb0\xe8\x8e\x1d\xc2\xc8\x28\x2c\xa9\x91\xdf\x2b\x7b\xfc\x62\xe7\x23\xe5\x3a\xbb\xc2\xff\x51\xb0\x3b\x95\x76\x1f\xbe\x44\xc4\x66\x16\xcc\xb4\x41\x9b\x80\xc5\x33\xc2\x28\x1e\xbd\x4c\x0c\x7e\x55\x6c\x03\x44\x43\x99\xd9\x4e\x42\x5a\x31\x56\x09\xe3\xf1\x9d\x13\x25\x3a\xc1\xf3\x89\x61\xda\x40\xa2\x41\x50\xc6\x6e\x30\xfd\xdf\x42\x50\x38\x99\x3e\x7e\x63\x08\xf6\x73\x9e\xaf\xeb\x9a\xd1\xd1\x71\x66\xdc\x90\x4f\x6f\x5a\x9f\xdf\x97\x37\x77\x55\x7d\x77\x79\x9d\x5d\x9d\x6e\x3d\xea\x89\xbd\x87\xe3\xdf\x8b\x38\xee\xd0\x6e\x20\x6b\x27\x51\xd4\x4e\x8c\x89\x56\x18\x07\x1a\x1c\x73\x87\x60\xa2\xeb\xd5\x49\x10\x3d\xec\xe1\x4d\x1f\x56\x72\x1c\x31\x9d\xf8\xe0\xa4\x5d\xc2\xd9\xd0\xde\x3d\x8a\x3f\x13\x18\x0d\xd2\xd8\x15\x35\xca\x7a\x87\xaf\x45\x5d\xd6\xfb\x08\x79\x2a\x9b\xef\x87\xc7\x06\x4f\xc5\xc3\x43\x51\x35\xe5\x5d\x8d\xc3\x03\x6e\x0e\xd5\x6d\xd9\x94\x87\xaa\xc6\xe1\x1b\x8a\xea\x19\x3f\xcb\xea\x76\x0f\x96\x30\xb2\x03\xbf\x5a\x17\x3b\x30\x0e\x12\xc7\xc9\xc7\x14\x51\x33\x9f\x59\xe8\xcd\x9b\x25\x6f\x59\x49\x2f\x0a\x13\xe9\x61\xa1\x81\x31\x98\x17\x76\x5a\xf4\x00\xcb\x6e\x16\x1f\x63\xf5\x20\xdd\x45\xcc\x24\xb3\x84\xe3\xbe\xf8\x7f\xfb\xca\x92\x24\x45\x13\x83\xf5\xca\x49\xcc\xd4\x83\x64\x8e\x73\x52\x93\xf1\x0c\xbf\x92\x4d\x92\xf8\x37\x7d\x8f\x4b\x4a\xe2\x4e\x5d\x0a\x2e\xf2\x78\x96\x77\x17\xc8\x39\xa8\xbc\xf7\x81\xda\xe4\x4f\x00\x00\x00\xff\xff\x60\xf9\x87\x10\xd3\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\x9c\x30\x10\x85\xef\xfc\x8a\xd7\xe5\x90\x56\xda\x40\x9a\x5b\xdb\x13\x4d\x52\x95\x36\x62\xa5\x40\x1a\xe5\x38\x98\x01\x46\x02\xdb\xb5\x4d\x08\xff\xbe\xf2\x66\xa3\x36\x2a\x07\x0e\x9e\xe7\xe7\x6f\xe6\x4d\xfa\x2e\x5f\xbc\xcb\x5b\xd1\x39\xeb\x27\xb4\xe4\xc7\x24\x4d\x71\x65\xec\xe6\x64\x18\x03\x2e\x2f\x3e\x7e\x42\x3d\x92\x1e\x46\x12\xfc\x10\x3d\x5c\x2f\x06\xa5\xee\x8d\x9b\x29\x88\xd1\x68\x58\x8d\xda\x4c\x66\xd8\xa0\x4c\xb6\xc7\x6d\xe8\xb2\x24\x4d\xa3\xcd\xad\x28\xd6\x9e\x3b\x2c\xba\x63\x87\x30\x32\x0a\x4b\x6a\xe4\xd7\xca\x1e\xbf\xd8\xf9\xe8\x72\x99\x5d\xe0\x7d\x14\xec\x4e\xa5\xdd\x87\x2f\xd1\x62\x33\x0b\x66\xda\xa0\x4d\xc0\xe2\x19\x61\x14\x8f\x5e\x26\x06\x3f\x2b\xb6\x01\xa2\xa1\xcc\x6c\x27\x21\xad\x18\xab\x84\x11\xe1\xef\x03\x91\x04\x8f\x27\x0f\xd3\x06\x12\x0d\x82\x32\x76\x83\xe9\xff\x15\x82\xc2\x09\xfa\xf8\x8d\x21\xd8\xcf\x79\xbe\xae\x6b\x46\x47\xe2\xcc\xb8\x21\x9f\x5e\xb4\x3e\xbf\x2d\xaf\x6e\xaa\xfa\xe6\xfc\x32\xbb\x38\xdd\xba\xd7\x13\x7b\x0f\xc7\xbf\x17\x71\xdc\xa1\xdd\x40\xd6\x4e\xa2\xa8\x9d\x18\x13\xad\x30\x0e\x34\x38\xe6\x0e\xc1\x44\xea\xd5\x49\x10\x3d\xec\xe1\x4d\x1f\x56\x72\x1c\x51\x3b\xf1\xc1\x49\xbb\x84\x37\x43\x7b\x65\x14\xff\x46\x60\x34\x48\x63\x57\xd4\x28\xeb\x1d\xbe\x16\x75\x59\xef\xa3\xc9\x43\xd9\x7c\x3f\xdc\x37\x78\x28\xee\xee\x8a\xaa\x29\x6f\x6a\x1c\xee\x70\x75\xa8\xae\xcb\xa6\x3c\x54\x35\x0e\xdf\x50\x54\x8f\xf8\x59\x56\xd7\x7b\xb0\x84\x91\x1d\xf8\xd9\xba\xd8\x81\x71\x90\x38\x4e\x3e\xa6\x88\x9a\xf9\x0d\x42\x6f\x5e\x72\xf4\x96\x95\xf4\xa2\x30\x91\x1e\x16\x1a\x18\x83\x79\x62\xa7\x45\x0f\xb0\xec\x66\xf1\x31\x56\x0f\xd2\x5d\xb4\x99\x64\x96\x70\xdc\x17\xff\x7f\x5f\x59\x92\xa4\x68\x62\xb0\x5e\x39\x89\x99\x7a\x90\xcc\x71\x4e\x6a\x32\x9e\xe1\x57\xb2\x49\x12\xff\xa6\xef\x71\x4e\x49\xdc\xa9\x73\xc1\x59\x1e\xcf\xf2\xee\x0c\x39\x07\x95\xf7\x3e\x50\x9b\xfc\x19\x00\x60\xf9\x87\x10\xd3\x02\x00\x00"),
+		},
+		"/scripts/init_change_timesync.sh": &vfsgen۰CompressedFileInfo{
+			name:             "init_change_timesync.sh",
+			modTime:          time.Date(2026, 10, 19, 9, 32, 14, 667923860, time.UTC),
+			uncompressedSize: 1823,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x55\x5f\x6f\xdb\xc6\x13\x7c\xe7\xa7\x98\x1f\xa5\x9f\xf2\x07\x12\xe9\xf8\xad\x09\x62\x54\x4d\x54\x54\x6d\x20\x07\x96\x92\x20\xb0\x5d\xe3\x44\x2e\xc9\x85\xc9\x3d\xf6\xee\x28\x59\xad\xfb\xdd\x8b\x23\xa9\x58\x8a\xdd\xfa\x49\xd8\x9b\x1d\xcd\xee\xcc\x5a\x83\xff\xc5\x8d\x35\xf1\x9a\x25\x26\xd9\x60\xad\x6c\x11\x0c\x06\x78\xa7\xeb\x9d\xe1\xbc\x70\x38\x3d\x79\xf5\x03\x96\x85\x92\xbc\x50\x8c\x5f\x59\xf2\xf7\x8d\xc6\x5c\x32\x6d\x2a\xe5\x58\x0b\x56\x94\x14\xa2\x4b\x9d\xef\x90\xe8\x68\x8c\x0f\x2e\x8d\x82\xc1\xc0\xd3\x7c\xe0\x84\xc4\x52\x8a\x46\x52\x32\x70\x05\x61\x5a\xab\xa4\xa0\xfd\xcb\x18\x9f\xc9\x58\xcf\x72\x1a\x9d\xe0\xb9\x07\x84\xfd\x53\xf8\xe2\x8d\xa7\xd8\xe9\x06\x95\xda\x41\xb4\x43\x63\x09\xae\x60\x8b\x8c\x4b\x02\xdd\x25\x54\x3b\xb0\x20\xd1\x55\x5d\xb2\x92\x84\xb0\x65\x57\xc0\x3d\x7c\x81\x57\x82\xaf\x3d\x87\x5e\x3b\xc5\x02\x85\x44\xd7\x3b\xe8\xec\x10\x08\xe5\x7a\xd1\xed\x5f\xe1\x5c\xfd\x3a\x8e\xb7\xdb\x6d\xa4\x5a\xc5\x91\x36\x79\x5c\x76\x58\x1b\x7f\x98\xbf\x9b\x2d\x96\xb3\xc9\x69\x74\xd2\x77\x7d\x92\x92\xac\x85\xa1\x3f\x1a\x36\x94\x62\xbd\x83\xaa\xeb\x92\x13\xb5\x2e\x09\xa5\xda\x42\x1b\xa8\xdc\x10\xa5\x70\xda\xab\xde\x1a\x76\x2c\xf9\x18\x56\x67\x6e\xab\x0c\x79\xa9\x29\x5b\x67\x78\xdd\xb8\xa3\xa5\xed\x35\xb2\x3d\x02\x68\x81\x12\x84\xd3\x25\xe6\xcb\x10\x3f\x4d\x97\xf3\xe5\xd8\x93\x7c\x99\xaf\x7e\x39\xff\xb4\xc2\x97\xe9\xc5\xc5\x74\xb1\x9a\xcf\x96\x38\xbf\xc0\xbb\xf3\xc5\xfb\xf9\x6a\x7e\xbe\x58\xe2\xfc\x67\x4c\x17\x5f\xf1\xdb\x7c\xf1\x7e\x0c\x62\x57\x90\x01\xdd\xd5\xc6\x4f\xa0\x0d\xd8\xaf\x93\x5a\x17\xb1\x24\x3a\x92\x90\xe9\xce\x47\x5b\x53\xc2\x19\x27\x28\x95\xe4\x8d\xca\x09\xb9\xde\x90\x11\x96\x1c\x35\x99\x8a\xad\xb7\xd5\x42\x49\xea\x69\x4a\xae\xd8\xb5\x79\xb1\x8f\xe7\x8a\x82\x60\x80\x95\x37\xd6\x26\x86\xbd\xa7\x16\x8a\x2b\xbf\x27\xbb\x93\xa4\x30\x5a\xf8\x4f\x82\xe3\xea\xc0\xe0\x9c\x37\x24\x58\xac\x3e\xc2\x92\xd9\x90\xb1\xc1\x00\x8d\x55\x39\xbd\x06\x0b\xbb\x9b\xc4\x67\x96\x6e\x7c\x93\x27\x89\x6c\x81\xe5\xec\xe2\xf3\xec\x22\x8a\xa2\x60\x80\x96\x75\xe7\xbf\xa9\x36\x94\x91\xf1\x9e\xf9\x01\x60\x77\xd6\x51\x95\x4e\xf6\x8d\xe9\xd8\x67\xaf\xf0\x73\xb1\x05\x8b\x75\xaa\x2c\x29\x05\x67\x90\x7e\x75\x5d\x92\x2a\xd0\x1d\x5b\x67\xa3\x20\xb8\xbc\xc4\x70\x80\x49\xee\x70\x82\xeb\x6b\xdc\xdf\xe3\xaf\xc0\xa7\x8a\x92\x42\x23\x14\x7d\x20\xbb\x9b\x23\xc4\xd9\xe8\xb4\x83\xdc\xb1\xc3\xab\xe0\xef\x20\xe8\x14\xde\x24\x5a\xb2\xb7\x81\x5f\xbb\xff\xe4\x83\x13\x93\x4b\xe2\xee\x35\x6a\x6b\x07\x85\xc3\xfa\x1b\xa4\xba\xe5\xbc\xbc\xc4\x24\xc3\xd0\xd7\xbc\x9c\xd1\xa8\x9f\xbe\xe3\xee\xea\xa3\x11\xd6\x86\xd4\x6d\x90\x6a\xa1\x20\xe0\xac\xed\x12\x0c\x0f\xa0\xb8\xbe\x7e\xe3\x27\x95\x96\xd5\xdf\xf5\x84\x31\x99\xe1\x59\xfc\xfb\x95\x7d\xf9\xbc\xf3\xe1\xbe\xd6\xba\x7c\x71\x65\xe3\xf4\xd9\x51\x73\xdb\xe3\xc7\xe8\xe7\x66\x41\x38\xfc\x31\xfc\x26\xf2\x61\x3f\x3d\x60\xb8\x07\xae\x1b\x63\x5d\x88\xb3\xb3\xc7\x7c\x9d\x58\xff\x69\xe0\x85\xb5\x3b\xe5\xa4\x3d\x94\x0e\x9a\x42\x0b\x12\x12\xa7\xdb\x28\xee\x6d\xd7\x82\x66\xdd\x88\x6b\xfa\x49\xcc\x86\x13\x7a\xdb\xb7\x74\xb5\x36\x06\x89\x2b\x91\x28\xd7\xb7\xa5\xd1\x9e\x7f\x74\x16\xa7\xb4\x89\xa5\x29\x4b\xef\xee\x31\xc1\x77\xfd\x24\xed\xbf\x80\xe1\x13\xbd\xdf\x21\x0d\x59\xa7\x8c\x7b\x80\xde\xdf\xef\xf3\x40\x25\x67\x07\x48\xaf\xe9\x51\x50\x9f\x52\x77\xe0\x57\x75\x9b\xb2\xc1\xa4\xee\xe2\xd2\x77\xc7\x0f\xdd\x3e\x06\x51\xda\x42\x6b\xc3\xe2\x32\x84\x97\x2b\xae\xe8\xfa\x4a\x16\xab\x8f\x6f\xff\x6f\xaf\x24\x44\x38\x7c\x19\xe2\xec\xbf\x29\xe2\xdb\x5a\x29\x1b\x7d\x33\xc9\xbf\xa7\xca\x91\x5f\xa6\x25\x37\x11\x57\xc3\x99\x86\xfe\x65\xfa\x47\x73\x1d\xad\xc1\xd2\xe1\x25\xf5\x07\xd8\xbb\x2a\xfa\x89\xf3\x3d\xba\xda\x31\xea\x92\x94\xa5\x7d\x05\x5a\xa8\xff\x21\xa8\x1e\xdd\x60\xc6\xc1\x3f\x03\x00\x76\xc2\x6e\xc6\x1f\x07\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_haproxy.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 85850954, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 1234,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xdd\x6e\xdc\x36\x13\x86\xcf\x79\x15\xef\xa7\x35\x8c\x04\x58\x49\xb6\xcf\xbe\x06\x29\xb0\xf5\xba\x8d\x5a\x63\x55\xac\x36\x4d\x8d\x20\x30\xb8\xd2\x48\x1a\x84\x22\x69\x92\xf2\x5a\x80\x2f\xbe\xe0\xfe\xb4\x76\xda\x1e\x55\xa7\xef\xe8\xe1\xc3\x99\x01\x67\xff\xcb\x47\xef\xf2\x2d\xeb\x9c\xf4\x23\xb6\xd2\xf7\x62\x36\xc3\xb5\xb1\x93\xe3\xae\x0f\xb8\xba\xb8\xfc\x3f\xaa\x5e\xea\xae\x97\x8c\x9f\x59\x77\xcb\xd1\xa0\xd0\xad\x71\x83\x0c\x6c\x34\x36\x54\xf7\xda\x28\xd3\x4d\xa8\x4d\x36\xc7\x6d\x68\x32\x31\x9b\x45\xcc\x2d\xd7\xa4\x3d\x35\x18\x75\x43\x0e\xa1\x27\x2c\xac\xac\x7b\x3a\x25\x73\xfc\x46\xce\x47\xca\x55\x76\x81\x37\xb1\x20\x39\x46\xc9\xdb\x77\x11\x31\x99\x11\x83\x9c\xa0\x4d\xc0\xe8\x09\xa1\x67\x8f\x96\x15\x81\x9e\x6a\xb2\x01\xac\x51\x9b\xc1\x2a\x96\xba\x26\xec\x38\xf4\xfb\x73\x8e\x94\x68\x82\xbb\x23\xc3\x6c\x83\x64\x0d\x89\xda\xd8\x09\xa6\x7d\x59\x08\x19\x8e\xd2\xfb\xaf\x0f\xc1\x7e\x97\xe7\xbb\xdd\x2e\x93\x7b\xe3\xcc\xb8\x2e\x57\x87\x5a\x9f\xdf\x16\xd7\x37\xab\xea\x26\xbd\xca\x2e\x8e\x7f\x7d\xd4\x8a\xbc\x87\xa3\x87\x91\x1d\x35\xd8\x4e\x90\xd6\x2a\xae\xe5\x56\x11\x94\xdc\xc1\x38\xc8\xce\x11\x35\x08\x26\x5a\xef\x1c\x07\xd6\xdd\x1c\xde\xb4\x61\x27\x1d\x45\x4c\xc3\x3e\x38\xde\x8e\xe1\x55\xd3\x4e\x8e\xec\x5f\x15\x18\x0d\xa9\x91\x2c\x2a\x14\x55\x82\x1f\x16\x55\x51\xcd\x23\xe4\x53\xb1\xf9\x50\x7e\xdc\xe0\xd3\x62\xbd\x5e\xac\x36\xc5\x4d\x85\x72\x8d\xeb\x72\xb5\x2c\x36\x45\xb9\xaa\x50\xfe\x88\xc5\xea\x0e\xbf\x14\xab\xe5\x1c\xc4\xa1\x27\x07\x7a\xb2\x2e\xde\xc0\x38\x70\x6c\x27\xed\xa7\x88\x8a\xe8\x95\x42\x6b\x0e\x4a\xde\x52\xcd\x2d\xd7\x50\x52\x77\xa3\xec\x08\x9d\x79\x24\xa7\x59\x77\xb0\xe4\x06\xf6\x71\xac\x1e\x52\x37\x11\xa3\x78\xe0\xb0\xdf\x17\xff\xf7\x7b\x65\x42\xcc\xb0\x89\x83\xf5\xb5\xe3\x38\x53\x0f\xc9\x43\xec\x53\x43\x56\x99\x09\xbd\xb4\xce\x3c\x4d\x42\x64\x50\xbc\xcd\x7c\x2f\x44\x59\xbd\xff\x89\x42\x59\x89\x0f\x8b\x5f\xd7\xe5\xef\x77\x8b\xe5\x72\xfd\xfe\xec\x52\x88\x40\x3e\x20\xd5\x38\x7b\x11\xe0\xf9\x19\x6f\xa8\xee\x0d\x92\x23\x0a\xb2\x69\x5c\x3c\x28\x2e\xd6\xe9\x32\x09\xce\xcf\x41\x4f\x1c\x70\xf9\x56\x08\x6e\xf1\xf9\x33\xce\xca\x0a\x29\x3d\x20\x19\xb7\xa3\x0e\x63\x82\x2f\x5f\xde\x45\x79\x2d\xe2\x9e\x48\x1b\xd2\x8e\xe2\x16\xfa\x20\x95\x42\xfa\x97\x6b\x8c\x1b\xfb\xb5\x43\xaa\xf0\x8c\xce\x91\x45\xfa\x70\x4a\xff\x41\xe8\x84\x68\x25\x2b\x6a\x5e\xa9\x90\xfa\xc6\xa5\x26\x1d\x8c\x8f\x2e\x11\xf4\x32\x71\x3d\xa9\x6f\x1c\xa7\x71\xf8\x37\x3f\x67\x07\xa4\x0f\xf2\xbf\xfb\x79\xda\xf3\x0e\x7f\xf8\xc9\x07\x1a\x0e\x9d\x1d\xad\x35\x2e\x24\x87\x74\x5f\x2e\x5a\x16\x62\xff\xe2\xc4\xd7\x06\x79\x18\x6c\xce\x9a\xc3\xfd\x61\xd6\xf7\xc7\xf3\xee\xbf\x12\x59\xa9\xf8\x91\x9a\xdc\x53\x18\x6d\xe6\x7b\xa4\x23\x92\x97\x73\x4d\xfe\xf4\x75\xa3\xc6\xd5\xf7\xe7\x97\xe2\x8f\x00\x00\x00\xff\xff\xe2\x08\x1a\xcf\xd2\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\x5f\x6f\xdb\x36\x14\xc5\xdf\xf9\x29\xce\xe4\x22\x68\x01\x4b\x4a\xf2\xb6\x15\x1d\xe0\xc5\xd9\xaa\x2d\xb0\x06\xcb\x5d\x17\x14\x45\x40\x4b\x57\xd2\x45\x29\x92\x21\xa9\x38\x02\xf2\xe1\x07\xfa\xcf\x9a\x74\xdb\xd3\xfc\x7a\xae\xcf\xfd\xdd\x7b\xae\x38\xfb\x2e\x1f\xbd\xcb\xb7\xac\x73\xd2\x0f\xd8\x4a\xdf\x8b\xd9\x0c\x57\xc6\x4e\x8e\xbb\x3e\xe0\xf2\xfc\xe2\x7b\x54\xbd\xd4\x5d\x2f\x19\xbf\xb2\xee\x96\xa3\x41\xa1\x5b\xe3\x06\x19\xd8\x68\x6c\xa8\xee\xb5\x51\xa6\x9b\x50\x9b\x6c\x8e\x9b\xd0\x64\x62\x36\x8b\x36\x37\x5c\x93\xf6\xd4\x60\xd4\x0d\x39\x84\x9e\xb0\xb0\xb2\xee\xe9\xa4\xcc\xf1\x07\x39\x1f\x5d\x2e\xb3\x73\xbc\x8e\x05\xc9\x51\x4a\xde\xbc\x8d\x16\x93\x19\x31\xc8\x09\xda\x04\x8c\x9e\x10\x7a\xf6\x68\x59\x11\xe8\xb1\x26\x1b\xc0\x1a\xb5\x19\xac\x62\xa9\x6b\xc2\x8e\x43\x8f\xf0\xb5\x41\x24\xc1\xed\xd1\xc3\x6c\x83\x64\x0d\x89\xda\xd8\x09\xa6\x7d\x5e\x08\x19\x8e\xd0\xfb\x5f\x1f\x82\xfd\x21\xcf\x77\xbb\x5d\x26\xf7\xc4\x99\x71\x5d\xae\x0e\xb5\x3e\xbf\x29\xae\xae\x57\xd5\x75\x7a\x99\x9d\x1f\xff\xf5\x41\x2b\xf2\x1e\x8e\xee\x47\x76\xd4\x60\x3b\x41\x5a\xab\xb8\x96\x5b\x45\x50\x72\x07\xe3\x20\x3b\x47\xd4\x20\x98\x48\xbd\x73\x1c\x58\x77\x73\x78\xd3\x86\x9d\x74\x14\x51\x1b\xf6\xc1\xf1\x76\x0c\x2f\x96\x76\x62\x64\xff\xa2\xc0\x68\x48\x8d\x64\x51\xa1\xa8\x12\xfc\xb4\xa8\x8a\x6a\x1e\x4d\x3e\x16\x9b\xf7\xe5\x87\x0d\x3e\x2e\xd6\xeb\xc5\x6a\x53\x5c\x57\x28\xd7\xb8\x2a\x57\xcb\x62\x53\x94\xab\x0a\xe5\xcf\x58\xac\x6e\xf1\x5b\xb1\x5a\xce\x41\x1c\x7a\x72\xa0\x47\xeb\xe2\x04\xc6\x81\xe3\x3a\x69\x9f\x22\x2a\xa2\x17\x08\xad\x39\xe4\xe8\x2d\xd5\xdc\x72\x0d\x25\x75\x37\xca\x8e\xd0\x99\x07\x72\x9a\x75\x07\x4b\x6e\x60\x1f\x63\xf5\x90\xba\x89\x36\x8a\x07\x0e\xfb\x7b\xf1\xff\x9c\x2b\x13\x62\x86\x4d\x0c\xd6\xd7\x8e\x63\xa6\x1e\x92\x87\xb8\xa7\x86\xac\x32\x13\x7a\x69\x9d\x79\x9c\x84\xc8\xa0\x78\x9b\xf9\x5e\x88\xb2\x7a\xf7\x0b\x85\xb2\x12\xef\x17\xbf\xaf\xcb\x3f\x6f\x17\xcb\xe5\xfa\xdd\xab\x0b\x21\x02\xf9\x80\x54\xe3\xd5\x33\x01\x4f\x4f\x78\x4d\x75\x6f\x90\x1c\xad\x20\x9b\xc6\xc5\x46\xf1\xb0\x4e\xc3\x24\x38\x3b\x03\x3d\x72\xc0\xc5\x1b\x21\xb8\xc5\xa7\x4f\x78\x55\x56\x48\xe9\x1e\xc9\xb8\x1d\x75\x18\x13\x7c\xfe\xfc\x36\xc2\x6b\x11\xef\x44\xda\x90\x76\x14\xaf\xd0\x07\xa9\x14\xd2\xaf\xac\x51\x6e\xec\x97\x0e\xa9\xc2\x13\x3a\x47\x16\xe9\xfd\x49\xfd\x17\xa0\x93\x45\x2b\x59\x51\xf3\x02\x85\xd4\x37\x2c\x35\xe9\x60\x7c\x64\x89\x46\xcf\x15\xd7\x93\xfa\x86\x71\x1a\x87\xff\xe2\x73\x76\x40\x7a\x2f\xff\x3f\x9f\xa7\x7d\xab\xc3\x8a\xfd\xe4\x03\x0d\xfb\x4f\xd6\x8f\xd6\x1a\x17\x92\x83\xba\x1f\x47\xb4\x2c\xc4\xfe\xc5\x89\xaf\x0d\xf2\x30\xd8\x9c\x35\x87\xbb\x43\xd6\x77\xc7\x7e\x77\x5f\x88\xac\x54\xfc\x40\x4d\xee\x29\x8c\x36\xf3\x3d\xd2\x11\xc9\xf3\x5c\x93\xbf\x79\xdd\xa8\x71\xf9\xe3\xd9\x85\xf8\x6b\x00\xe2\x08\x1a\xcf\xd2\x04\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived": &vfsgen۰DirInfo{
 			name:    "init_deploy_haproxy_keepalived",
-			modTime: time.Date(2019, 12, 17, 8, 37, 10, 547039866, time.UTC),
+			modTime: time.Date(2026, 10, 19, 12, 0, 8, 25315950, time.UTC),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/docker.sh": &vfsgen۰CompressedFileInfo{
 			name:             "docker.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86171031, time.UTC),
-			uncompressedSize: 2725,
+			modTime:          time.Date(2026, 10, 19, 7, 57, 3, 328300670, time.UTC),
+			uncompressedSize: 2993,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x71\x6f\x9b\xc8\x13\xfd\x9f\x4f\x31\x3f\x3b\x6a\x13\x29\x40\x5a\xfd\xee\xa4\xfa\xe4\x93\x68\x4c\x1b\xae\xa9\x89\x0c\x69\xaf\x3a\x9d\xac\xf5\x32\x86\x55\xd6\xbb\x74\x77\xb1\x63\x59\xfe\xee\xa7\xa5\x24\x01\x6c\x35\x91\xee\xf2\x47\x24\x86\xf7\xde\xbe\xd9\x79\x8c\x87\xff\xf3\x2b\xad\xfc\x05\x13\x3e\x8a\x35\x2c\x88\x2e\x9c\xe1\x10\x2e\x65\xb9\x55\x2c\x2f\x0c\xbc\xbd\x78\xf3\x0e\x92\x82\x88\xbc\x20\x0c\xfe\x60\x22\x9f\x54\x12\x22\xb1\x94\x6a\x45\x0c\x93\x02\x52\xa4\x85\x90\x5c\xe6\x5b\xa0\xd2\x3b\x87\x6b\x93\x79\xce\x70\x68\x65\xae\x19\x45\xa1\x31\x83\x4a\x64\xa8\xc0\x14\x08\x41\x49\x68\x81\x0f\x6f\xce\xe1\x0b\x2a\x6d\x55\xde\x7a\x17\x70\x6a\x01\x83\xe6\xd5\xe0\xec\x37\x2b\xb1\x95\x15\xac\xc8\x16\x84\x34\x50\x69\x04\x53\x30\x0d\x4b\xc6\x11\xf0\x9e\x62\x69\x80\x09\xa0\x72\x55\x72\x46\x04\x45\xd8\x30\x53\xd4\xe7\x34\x2a\xd6\x09\x7c\x6b\x34\xe4\xc2\x10\x26\x80\x00\x95\xe5\x16\xe4\xb2\x0d\x04\x62\x1a\xd3\xf5\x5f\x61\x4c\x39\xf2\xfd\xcd\x66\xe3\x91\xda\xb1\x27\x55\xee\xf3\x1f\x58\xed\x5f\x47\x97\xe1\x34\x09\xdd\xb7\xde\x45\xc3\xba\x15\x1c\xb5\x06\x85\xdf\x2b\xa6\x30\x83\xc5\x16\x48\x59\x72\x46\xc9\x82\x23\x70\xb2\x01\xa9\x80\xe4\x0a\x31\x03\x23\xad\xeb\x8d\x62\x86\x89\xfc\x1c\xb4\x5c\x9a\x0d\x51\x68\x65\x32\xa6\x8d\x62\x8b\xca\x74\x2e\xed\xc1\x23\xd3\x1d\x80\x14\x40\x04\x0c\x82\x04\xa2\x64\x00\xef\x83\x24\x4a\xce\xad\xc8\xd7\x28\xbd\x8a\x6f\x53\xf8\x1a\xcc\x66\xc1\x34\x8d\xc2\x04\xe2\x19\x5c\xc6\xd3\x49\x94\x46\xf1\x34\x81\xf8\x03\x04\xd3\x6f\xf0\x29\x9a\x4e\xce\x01\x99\x29\x50\x01\xde\x97\xca\x76\x20\x15\x30\x7b\x9d\x58\x4f\x11\x12\xc4\x8e\x85\xa5\xfc\x61\x49\x97\x48\xd9\x92\x51\xe0\x44\xe4\x15\xc9\x11\x72\xb9\x46\x25\x98\xc8\xa1\x44\xb5\x62\xda\x8e\x55\x03\x11\x99\x95\xe1\x6c\xc5\x4c\x9d\x17\x7d\xd8\x97\xe7\x38\x1a\x0d\xb8\x12\x50\x29\xbc\x67\xe6\xe1\x51\xc8\x4a\x68\x7c\x7c\x2c\x59\x89\x4b\xc2\xb8\xe3\x5c\x05\x37\xb3\xf8\xcf\x6f\xf3\xe8\x73\xf0\x31\x1c\x33\x91\xe1\xbd\x9b\xe1\xda\xfb\xce\x04\xab\x3c\x26\xfd\x3b\xe4\x6c\xa1\x88\xda\xfa\x05\x29\x95\xbc\xdf\x3a\xc3\x2e\xe7\xa1\xfc\x29\x0c\x6f\x82\xeb\xe8\x4b\x38\x79\x89\xd8\x1d\x62\x49\x38\x5b\x63\xe6\x0c\x0f\x98\x52\xb3\x7b\x46\xda\x98\x87\x23\xbf\x84\xb3\x24\x8a\xa7\xe3\x37\xde\x3b\xef\xd7\xf6\x91\x4f\x2f\xfe\xef\xfd\xe2\x38\x9f\x6e\xdf\x87\xb3\x69\x98\x86\xc9\xfc\x32\x9e\x7e\x88\x3e\xce\x27\xd1\x6c\xec\xa3\xa1\xfe\x5d\xb5\x40\x25\xd0\xa0\x7e\x54\x6d\x41\x4e\x76\x47\xa9\xfb\xc7\xee\xbb\x9c\xf1\xc9\xee\x50\xe4\x11\xec\xd1\x65\xde\x36\xf9\x92\x73\x5a\x4d\x1f\x30\x2d\xeb\x98\x5a\x9b\xe5\x51\x29\x96\x8e\x33\x8b\xe3\x74\x7c\x72\x5a\x4f\x1d\x2e\x27\x37\x41\x7a\x05\xaf\x5e\x01\xcd\xe0\xe4\x34\x63\x4a\x90\x15\xc2\xe0\x64\xf7\x3e\x48\xae\xe6\x49\x7c\x3b\xbb\x0c\xff\xba\xf8\x7b\x3f\x38\xf3\x3d\xcf\xe2\xca\x4d\x76\xe6\x58\xf0\xce\x0a\xed\x1d\x47\xcb\x4a\xd1\x9a\x52\x17\x7c\x26\x98\x99\x67\x58\x72\xb9\x9d\x37\xdd\xce\x9f\x4c\xf8\x9c\x2d\x3c\x5d\x0c\x1c\x27\x93\xf4\x0e\xd5\x68\x44\x0b\xa4\x77\xa7\x67\xb0\x73\xec\x52\xf8\x51\x85\x75\xb3\xb2\x7e\x07\x3f\xc3\xb5\x2f\x2a\xce\x9d\xbd\xe3\x34\x7a\xa3\x91\x36\x44\x99\x3e\x49\x55\x02\xdc\x8c\x19\x70\x5d\x85\x35\x02\x08\xdf\x90\xad\x06\xd7\xad\xdb\x7a\x1a\xb0\x5b\x10\xb7\x11\x03\x97\x83\x5e\xd3\x71\xe7\xa5\x2d\x92\xb2\x1c\x3f\x62\xd6\x70\x74\x9e\xa3\x7a\xb5\x73\x49\x09\xaf\x23\xf4\x60\x50\xc9\x73\xcd\xc9\x1a\xc1\x2d\x5b\xc4\x9b\x78\x96\xee\x47\xbd\xe7\x2e\x24\x49\x83\x34\x39\x00\xb6\xaa\x2d\x6c\xfd\x49\xb4\x61\x4d\xd4\xf7\xbd\xab\x92\xe5\xc1\x4d\xad\xc0\x5d\x1e\xbf\x8e\x0e\x57\x21\x97\x24\xeb\xb3\xef\x18\xe7\xe0\x6a\xb8\xba\xbd\x79\x91\x46\x77\x58\x1d\x63\xfd\x0a\x51\xa6\x3f\x67\x53\xe9\xbe\x01\x26\xec\x6a\x34\xb6\x87\xd7\xbb\x9d\x97\x18\x62\xb0\xfe\x5f\xe9\xfd\xfe\xf5\x4b\x3c\x55\xe2\xd0\x8f\xfd\x3c\x58\xfe\x9c\x23\xca\x91\x1c\x21\x1f\x36\x53\x89\x46\x70\xef\x38\x4f\xf9\xff\x6f\xa2\xfb\xa4\xf7\xd3\xf4\xb6\x61\x36\xc0\x07\x2b\x62\x3f\xf2\xa9\x14\xf6\x17\x1b\x95\xaf\x51\xad\x19\xc5\xd6\xc6\xf0\x89\xd6\x68\x74\x7f\x87\xb4\xd2\xed\x52\x52\xba\x24\xcb\x60\x1a\xa6\xf3\x60\xf2\x39\x9a\x5a\xc3\x68\xa0\x90\xda\x74\x4f\x7c\x4c\xeb\xe1\x6e\xde\x5b\x21\x59\x6e\xdd\xc6\xc2\xe1\x95\xbd\x2c\xc2\xad\x0d\xd9\x53\xe8\x87\xb0\xaf\x7e\xa4\xd8\x0c\xbe\x57\xfc\xb7\x69\xfc\x89\xc5\x56\x26\xdb\xf5\x56\x2c\x9f\x35\xd8\x0d\xe7\xb3\x4d\xb6\x22\xfa\x4f\x00\x00\x00\xff\xff\x06\x9b\x85\x9c\xa5\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x7f\x6f\xdb\xb6\x16\xfd\x9f\x9f\xe2\x3e\x3b\x68\x13\x20\x92\xd2\xe2\xbd\x07\xd4\x43\x06\xa8\xb1\xda\x68\x4d\xed\xc0\x72\xda\x15\xc3\x60\xd0\xd4\xb5\x44\x84\x26\x55\x92\xb2\x63\x18\xfe\xee\x03\x15\xc5\x96\x6c\xaf\xf5\x80\xe5\x8f\x00\xba\x3c\xe7\xf0\xf0\xfe\xa0\xd9\xfd\x4f\x50\x1a\x1d\x4c\xb9\x0c\x50\x2e\x60\x4a\x4d\x4e\xba\x5d\xb8\x51\xc5\x4a\xf3\x2c\xb7\xf0\xf6\xea\xcd\x3b\x48\x72\x2a\xb3\x9c\x72\xf8\x8d\xcb\xac\x5f\x2a\x88\xe5\x4c\xe9\x39\xb5\x5c\x49\x18\x23\xcb\xa5\x12\x2a\x5b\x01\x53\xfe\x25\xdc\xd9\xd4\x27\xdd\xae\x93\xb9\xe3\x0c\xa5\xc1\x14\x4a\x99\xa2\x06\x9b\x23\x84\x05\x65\x39\xbe\xac\x5c\xc2\x17\xd4\xc6\xa9\xbc\xf5\xaf\xe0\xdc\x01\x3a\xf5\x52\xe7\xe2\x17\x27\xb1\x52\x25\xcc\xe9\x0a\xa4\xb2\x50\x1a\x04\x9b\x73\x03\x33\x2e\x10\xf0\x89\x61\x61\x81\x4b\x60\x6a\x5e\x08\x4e\x25\x43\x58\x72\x9b\x83\xdd\x6d\xe0\x9c\xc0\xb7\x5a\x43\x4d\x2d\xe5\x12\x28\x30\x55\xac\x40\xcd\x9a\x40\xa0\xb6\x36\x5d\xfd\xe5\xd6\x16\xbd\x20\x58\x2e\x97\x3e\xad\x1c\xfb\x4a\x67\x81\x78\xc6\x9a\xe0\x2e\xbe\x89\x06\x49\xe4\xbd\xf5\xaf\x6a\xd6\x83\x14\x68\x0c\x68\xfc\x5e\x72\x8d\x29\x4c\x57\x40\x8b\x42\x70\x46\xa7\x02\x41\xd0\x25\x28\x0d\x34\xd3\x88\x29\x58\xe5\x5c\x2f\x35\xb7\x5c\x66\x97\x60\xd4\xcc\x2e\xa9\x46\x67\x35\xe5\xc6\x6a\x3e\x2d\x6d\x2b\x69\x2f\x1e\xb9\x69\x01\x94\x04\x2a\xa1\x13\x26\x10\x27\x1d\x78\x1f\x26\x71\x72\xe9\x44\xbe\xc6\xe3\xdb\xe1\xc3\x18\xbe\x86\xa3\x51\x38\x18\xc7\x51\x02\xc3\x11\xdc\x0c\x07\xfd\x78\x1c\x0f\x07\x09\x0c\x3f\x40\x38\xf8\x06\x9f\xe2\x41\xff\x12\x90\xdb\x1c\x35\xe0\x53\xa1\xdd\x09\x94\x06\xee\xd2\x89\x55\x15\x21\x41\x6c\x59\x98\xa9\xe7\x3a\x9a\x02\x19\x9f\x71\x06\x82\xca\xac\xa4\x19\x42\xa6\x16\xa8\x25\x97\x19\x14\xa8\xe7\xdc\xb8\xb2\x1a\xa0\x32\x75\x32\x82\xcf\xb9\xad\xfa\xc5\x1c\x9e\xcb\x27\xc4\xa0\x05\x4f\x01\x6a\x8d\x4f\xdc\xbe\x7c\x4a\x55\x4a\x83\xdb\xcf\x82\x17\x38\xa3\x5c\x10\x72\x1b\xde\x8f\x86\xbf\x7f\x9b\xc4\x9f\xc3\x8f\xd1\x35\x97\x29\x3e\x79\x29\x2e\xfc\xef\x5c\xf2\xd2\xe7\x2a\x78\x44\xc1\xa7\x9a\xea\x55\x90\xd3\x42\xab\xa7\x15\xe9\xb6\x39\x2f\xe1\x4f\x51\x74\x1f\xde\xc5\x5f\xa2\xfe\x29\x62\x8f\x88\x05\x15\x7c\x81\x29\xe9\x1e\x30\x95\xe1\x4f\x9c\x36\x31\x2f\x5b\x7e\x89\x46\x49\x3c\x1c\x5c\xbf\xf1\xdf\xf9\xff\x6f\x6e\xb9\x5b\xf8\xaf\xff\x3f\x42\x3e\x3d\xbc\x8f\x46\x83\x68\x1c\x25\x93\x9b\xe1\xe0\x43\xfc\x71\xd2\x8f\x47\xd7\x01\x5a\x16\x3c\x96\x53\xd4\x12\x2d\x9a\xad\x6a\x03\x72\xb6\x3e\x4a\xdd\x6c\x4f\xdf\xe6\x5c\x9f\xad\x0f\x45\xb6\x60\x9f\xcd\xb2\xa6\xc9\x1d\xe4\xef\xf7\x69\x1c\xfa\x80\xe9\x58\xc7\xd4\x9a\x2c\x9f\x29\x39\x23\x64\x34\x1c\x8e\xaf\xcf\xce\xab\xaa\xc3\x4d\xff\x3e\x1c\xdf\xc2\xab\x57\xc0\x52\x38\x3b\x4f\xb9\x96\x74\x8e\xd0\x39\x5b\xbf\x0f\x93\xdb\x49\x32\x7c\x18\xdd\x44\x7f\x5c\xfd\xb9\xe9\x5c\x04\xbe\xef\x70\xc5\x32\xbd\x20\x0e\xbc\x76\x42\x1b\x42\x8c\x2a\x35\xab\x28\x55\x20\xe0\x92\xdb\x49\x8a\x85\x50\xab\x49\x7d\xda\xc9\xce\x44\x20\xf8\xd4\x37\x79\x87\x90\x54\xb1\x47\xd4\xbd\x1e\xcb\x91\x3d\x9e\x5f\xc0\x9a\xb8\x4b\xe1\x39\x0a\x8b\xfa\xca\xfa\x15\x82\x14\x17\x81\x2c\x85\x20\x1b\x42\x6a\xbd\x5e\xcf\x58\xaa\xed\x3e\x49\x97\x12\xbc\x94\x5b\xf0\x3c\x8d\x15\x02\xa8\x58\xd2\x95\x01\xcf\xab\x8e\xb5\x2b\xb0\x97\x53\xaf\x16\x03\x4f\x80\x59\xb0\xeb\xd6\xa2\x0b\xd2\xa2\x78\x69\x61\xf0\x16\x70\xb4\x9e\xbd\xea\x6a\x17\x8a\x51\x51\xb5\x50\x8d\xef\x69\x75\x69\x04\x5d\x20\x78\x45\x83\x78\x3f\x1c\x8d\x37\xbd\xbd\xef\x36\x24\x19\x87\xe3\xe4\x00\xd8\x88\x36\xb0\xd5\x30\x35\x61\x75\xab\x6f\xf6\x52\xa5\x8a\x83\x4c\xcd\xc1\x9b\x1d\x4f\x47\x8b\xab\x51\x28\x9a\xee\xb3\x1f\xb9\x10\xe0\x19\xb8\x7d\xb8\x3f\x49\xa3\x5d\xac\xed\x82\xb1\xaa\xd8\x8f\x50\x6d\x5b\x64\x63\xa9\x2d\xcd\xbe\x01\x2e\xdd\xd5\x68\xdd\x19\x5e\xaf\xd7\x7e\x62\xa9\xc5\xea\x7f\x69\x36\x9b\xd7\xa7\x78\x2a\xe5\xa1\x1f\x37\x1e\x3c\xfb\x99\x23\x8d\xcf\xb8\x52\xe3\x69\x12\xcf\x39\x6c\x69\x30\x81\x54\x9e\x92\x90\x52\xd6\x8a\x1b\x42\x76\x33\x54\xdb\xda\xcf\xca\x3f\x6c\xff\x9d\xde\x0f\x27\xa0\x09\x5b\xc0\x91\x6b\x66\xd3\x0b\x98\x92\xee\x57\x1f\x75\x60\x50\x2f\x38\xc3\xc6\xad\x13\x50\x63\xd0\x9a\xfd\x7b\xa8\x31\x21\x1e\xa3\x85\x47\xd3\x14\x06\xd1\x78\x12\xf6\x3f\xc7\x03\xf0\x3c\x89\x16\x72\x65\x6c\x7b\xc7\x6d\xc7\x1f\xde\xef\x1b\xf0\x3c\xf7\xe6\xf0\x6a\x0b\x87\x29\x3b\x6d\x0c\x76\x9c\x7d\x85\xfd\x46\x6e\xae\x6d\x4b\xd7\x0e\xd6\xcd\xd3\x6d\x60\x61\x9b\x2c\xf7\x44\xe2\x68\x5c\x60\xc6\x33\x50\x12\x2a\x82\x7b\xac\xc0\x73\xd3\x80\x44\x4c\x0d\x50\xa8\xb7\xde\xb3\xd3\x9a\xcd\xf6\xd2\x76\xeb\x66\xf8\x5f\x18\xa6\x1f\x64\xa7\x31\x52\xcd\x78\x63\x24\x8e\xe6\xa6\x19\x3c\x36\x5b\x27\x68\xed\x26\xac\x05\x6e\x0d\xd9\x4f\x8b\x55\x4a\xa6\xe4\x8c\x67\x64\x43\xfe\x1a\x00\xf6\xe3\x2f\xff\xb1\x0b\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/lib.sh": &vfsgen۰CompressedFileInfo{
 			name:             "lib.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86439848, time.UTC),
-			uncompressedSize: 2477,
+			modTime:          time.Date(2026, 10, 19, 12, 0, 11, 909726847, time.UTC),
+			uncompressedSize: 3439,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x6b\x6f\xdb\x3a\x12\xfd\xce\x5f\x71\x6a\x1b\x8b\x76\x1b\x3f\xd3\xed\x6e\xd3\x07\xea\xc6\xce\x56\xdb\xac\x1d\xd8\x69\xba\x45\x36\x30\x68\x69\x24\x0d\x22\x93\xba\x24\x65\xc7\xd7\xf0\x7f\xbf\xa0\xfc\x68\x52\x27\x45\x9d\x0f\x01\xc9\xe1\xe1\x99\x33\x67\x46\xd5\x67\xcd\xc2\x9a\xe6\x94\x55\x93\xd4\x1c\x53\x69\x53\x51\xad\xe2\x54\xe7\x4b\xc3\x49\xea\xd0\x69\xb5\xdf\x60\x9c\x4a\x95\xa4\x92\xf1\x1f\x56\x49\xaf\xd0\x08\x54\xac\xcd\x4c\x3a\xd6\x0a\x97\x14\xa6\x4a\x67\x3a\x59\x22\xd4\x8d\x23\x9c\xbb\xa8\x21\xaa\x55\x0f\x73\xce\x21\x29\x4b\x11\x0a\x15\x91\x81\x4b\x09\xdd\x5c\x86\x29\xed\x4e\x8e\x70\x45\xc6\x7a\x94\x4e\xa3\x85\xe7\x3e\xa0\xb2\x3d\xaa\xbc\x78\xeb\x21\x96\xba\xc0\x4c\x2e\xa1\xb4\x43\x61\x09\x2e\x65\x8b\x98\x33\x02\xdd\x85\x94\x3b\xb0\x42\xa8\x67\x79\xc6\x52\x85\x84\x05\xbb\xb4\x7c\x67\x8b\xe2\x99\xe0\xfb\x16\x43\x4f\x9d\x64\x05\x89\x50\xe7\x4b\xe8\xf8\x7e\x20\xa4\xdb\x92\x2e\x7f\xa9\x73\xf9\x49\xb3\xb9\x58\x2c\x1a\xb2\x64\xdc\xd0\x26\x69\x66\x9b\x58\xdb\x3c\x0f\x4e\xfb\x83\x71\xbf\xde\x69\xb4\xb6\xb7\xbe\xaa\x8c\xac\x85\xa1\x3f\x0a\x36\x14\x61\xba\x84\xcc\xf3\x8c\x43\x39\xcd\x08\x99\x5c\x40\x1b\xc8\xc4\x10\x45\x70\xda\xb3\x5e\x18\x76\xac\x92\x23\x58\x1d\xbb\x85\x34\xe4\x61\x22\xb6\xce\xf0\xb4\x70\x0f\x44\xdb\x71\x64\xfb\x20\x40\x2b\x48\x85\x4a\x77\x8c\x60\x5c\xc1\xa7\xee\x38\x18\x1f\x79\x90\x6f\xc1\xe5\xe7\xe1\xd7\x4b\x7c\xeb\x8e\x46\xdd\xc1\x65\xd0\x1f\x63\x38\xc2\xe9\x70\xd0\x0b\x2e\x83\xe1\x60\x8c\xe1\x19\xba\x83\xef\xf8\x12\x0c\x7a\x47\x20\x76\x29\x19\xd0\x5d\x6e\x7c\x06\xda\x80\xbd\x9c\x54\x56\x11\x63\xa2\x07\x14\x62\xbd\xa1\x64\x73\x0a\x39\xe6\x10\x99\x54\x49\x21\x13\x42\xa2\xe7\x64\x14\xab\x04\x39\x99\x19\x5b\x5f\x56\x0b\xa9\x22\x0f\x93\xf1\x8c\x5d\xe9\x17\x7b\x98\x57\x43\x08\x4b\x0e\x75\x0d\x32\x86\xee\xd8\xed\x96\x4a\x17\xca\xd2\x7e\x99\x73\x4e\xb1\xe4\x4c\x88\x88\xe9\xf9\x0b\xac\x04\xc0\x31\x1c\x59\x87\x5a\x15\xf5\xc4\xa1\xf5\xd6\x03\x2b\xe1\x6b\x48\x61\xaa\x51\xa9\x7d\xac\xa0\xfd\xe1\x6f\x1d\x01\xc4\x2c\x00\x8f\x8f\xb6\x58\x0b\x91\xca\xdc\xe8\xbb\xe5\xc9\x49\xa8\x55\xcc\xc9\x16\x10\x98\xdd\x46\x6c\x50\xcf\x51\x5b\x7d\xee\x5e\x8c\x86\xff\xfb\x3e\x39\x1d\x0e\xce\x82\x7f\x4f\x7a\xc1\x68\x2d\xca\x98\x4c\x87\x32\x43\x91\x5b\x67\x48\xce\xec\xfb\x4a\xa5\xdc\xf6\xea\xb0\x2f\x6e\xa5\xb6\x7a\xf6\xe5\xeb\xa7\xfe\xa4\x7b\x11\x8c\xfb\xa3\xab\xfe\x68\xd2\xed\xf5\x46\xe3\xeb\x8f\x37\xeb\xca\xdb\x32\x36\xd2\x02\xdb\x5f\x6e\x58\xb9\x18\xf5\x39\x32\x56\x84\x0a\x60\xc9\xcc\xc9\x6c\xff\xd5\xae\xf9\x65\xfb\x06\xb5\xd5\xa3\x88\x35\xbe\x59\x63\x26\xef\x42\xad\x14\x3a\xad\x57\xff\x42\x98\x52\x78\x8b\x58\x66\x19\x8e\x61\xd8\x12\x3a\xff\x57\x95\xfd\x6b\x7b\xd6\x2f\xdf\xd7\x56\xfe\xc1\xf5\x96\x8f\xa2\x4d\x72\xa1\x74\xf8\x70\x90\xfd\x1a\xef\xde\xf5\x87\x67\x22\xc9\xf4\x54\x66\x02\xa8\x16\x96\x0c\xb6\x2a\xfa\x75\x62\x74\x91\xdf\xdb\x88\x24\xcd\xb4\xaf\xc5\x8e\xdd\xab\xd6\x9b\xd7\x22\xa2\x58\x16\x99\xb3\x7e\x5f\x47\xe4\x1f\x74\x61\x2e\x80\xa9\xcc\xca\x1e\xce\x48\x5a\xe7\xe3\x05\xe0\x78\x46\xba\x70\x08\x33\x26\xe5\x36\xfc\x8f\x5b\xf6\xde\xc9\x56\xa9\x47\x4e\x3c\x04\x85\xbb\x4b\xfe\xc4\x90\x33\x4c\x16\xc7\x22\x63\xeb\x48\xc1\x3a\x59\x12\x99\xb2\x8a\xd0\x6a\x94\x7f\x27\xed\x37\xc7\xaf\x77\xe4\xfc\x1c\x10\xd8\xc4\x81\x94\x6f\xe6\xfd\xb2\x30\x8c\xa6\x88\x8d\x56\x8e\x54\x84\xdb\x62\x4a\x46\x91\xa3\x03\xc0\x1f\x4a\x5e\x0c\x47\x97\x5e\xed\xad\x06\x93\xa9\x0c\x6f\x77\x77\xeb\x32\xe7\x4d\x32\xe2\x89\xed\xda\x6a\x5f\xba\xb5\xf0\xa5\x38\x34\xf1\xc9\x49\x59\xfc\xbd\x97\x39\xc6\xf5\x35\xea\x7f\x7a\x43\x3e\xe6\x9e\x97\x77\xeb\x0a\x6e\x6e\xee\x75\x4d\xe9\x10\x2b\x13\xda\xaf\x22\x26\x54\x1e\xbb\xec\xe7\xd1\x6e\xe0\x6d\xfd\xcf\x0f\x38\x15\xea\xa7\xd6\x32\x33\xd4\x4d\xfc\x44\x63\xad\x85\xb8\x25\xca\x65\xc6\x73\x8a\x7e\xd5\x95\x5f\xfa\xfd\x8b\xee\x79\x70\xd5\xef\x3d\xd5\x98\x73\x36\xae\x90\xd9\xc4\xe8\xc2\x91\x99\x70\xf4\xbe\xb6\xba\x0a\x2e\xaa\xd5\xbf\x37\xd6\x0f\x2c\x7e\x00\xb5\x73\xf9\xdc\x98\x7c\x62\x43\xc3\xb9\x43\x98\xde\x4e\xca\x8c\x4a\x2a\xdb\xcd\x8a\x0a\x51\x5f\xe0\xd8\x8b\xdb\xee\xfc\xb3\x2c\x75\x1b\x3f\x95\xda\xab\xc2\xca\x91\x99\xcb\x0c\x7e\x04\x2d\xa8\xfc\xaa\xd6\xdb\xad\x96\x1f\x48\x65\x8b\x7a\x63\x96\x4d\xea\x15\x28\xdf\x65\x65\x5d\xd9\x0a\x3f\x3c\x35\x49\xe5\x66\xde\x79\xb4\x58\x86\x84\xda\x2a\x18\x5c\xf6\x47\x67\xdd\xd3\xbe\xcf\xe9\x20\x65\xd4\x56\x07\x7b\x3e\xb0\xaa\x74\x6e\x88\x66\xb9\x13\xe5\xe4\xd1\x86\xdd\x12\x1b\x42\x32\x9a\x93\x71\x13\x56\x7e\x44\x02\xb2\xf0\xb6\x70\x1c\x6e\xbe\xf4\x9b\x42\xf8\xcd\x89\x5b\xe6\x84\xff\xf6\xfe\x21\x80\xfb\x8f\x73\x2e\xa3\xa8\xfc\x8c\x6c\x62\x4b\xd5\xd7\xcd\xe3\xce\x36\xce\x19\x19\xde\xee\x64\xdd\x84\xec\xc5\x2d\x43\xf6\xbe\x3e\x74\xc2\xaf\xac\x7d\x15\x5c\xfc\xb6\x93\xaf\x82\x8b\x47\x8d\xfb\x33\xe6\x5e\xdd\xdf\x46\xde\xdf\x78\xaa\x31\xee\x27\xf5\x74\x6f\x3c\x61\xef\xb5\xf8\x2b\x00\x00\xff\xff\xac\x01\xa9\x85\xad\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x6b\x73\xda\x48\x16\xfd\xae\x5f\x71\x46\x30\x5b\x79\x98\xa7\x33\xd9\x19\x32\x9e\x1a\x62\xc8\x5a\x1b\x97\xa1\x00\x3b\x9b\x4a\x5c\xaa\x46\xba\x12\x5d\x16\xdd\xda\xee\x16\x98\xa1\xf4\xdf\xb7\xba\x79\x18\xdb\x78\x2b\x63\x63\x53\xba\x7d\xfb\xdc\xf7\xb9\xaa\xfc\xd4\x28\xb4\x6a\x4c\xb9\x68\x90\x58\x60\xca\xf4\xcc\xab\x54\x70\x2e\xf3\x95\xe2\xe9\xcc\xa0\xdd\x6c\xfd\x86\xf1\x8c\x89\x74\xc6\x38\xfe\xcd\x45\xda\x2b\x24\x02\x91\x48\x35\x67\x86\x4b\x81\x09\x45\x33\x21\x33\x99\xae\x10\xc9\xfa\x09\x2e\x4d\x5c\xf7\x2a\x15\x0b\x73\xc9\x23\x12\x9a\x62\x14\x22\x26\x05\x33\x23\x74\x73\x16\xcd\x68\x77\x72\x82\x1b\x52\xda\xa2\xb4\xeb\x4d\xbc\xb2\x0a\xfe\xf6\xc8\x7f\xfd\xc1\x42\xac\x64\x81\x39\x5b\x41\x48\x83\x42\x13\xcc\x8c\x6b\x24\x3c\x23\xd0\x7d\x44\xb9\x01\x17\x88\xe4\x3c\xcf\x38\x13\x11\x61\xc9\xcd\x0c\xe6\xc1\x80\xf5\x04\x5f\xb7\x18\x72\x6a\x18\x17\x60\x88\x64\xbe\x82\x4c\x0e\x15\xc1\xcc\xd6\x69\xf7\x33\x33\x26\xef\x34\x1a\xcb\xe5\xb2\xce\x9c\xc7\x75\xa9\xd2\x46\xb6\xd1\xd5\x8d\xcb\xe0\xbc\x7f\x35\xee\xd7\xda\xf5\xe6\xf6\xd6\xb5\xc8\x48\x6b\x28\xfa\x6f\xc1\x15\xc5\x98\xae\xc0\xf2\x3c\xe3\x11\x9b\x66\x84\x8c\x2d\x21\x15\x58\xaa\x88\x62\x18\x69\xbd\x5e\x2a\x6e\xb8\x48\x4f\xa0\x65\x62\x96\x4c\x91\x75\x35\xe6\xda\x28\x3e\x2d\xcc\xa3\xa4\xed\x7c\xe4\xfa\x91\x82\x14\x60\x02\x7e\x77\x8c\x60\xec\xe3\x63\x77\x1c\x8c\x4f\x2c\xc8\x97\x60\x72\x31\xb8\x9e\xe0\x4b\x77\x34\xea\x5e\x4d\x82\xfe\x18\x83\x11\xce\x07\x57\xbd\x60\x12\x0c\xae\xc6\x18\x7c\x42\xf7\xea\x2b\x3e\x07\x57\xbd\x13\x10\x37\x33\x52\xa0\xfb\x5c\xd9\x08\xa4\x02\xb7\xe9\x24\x57\x45\x8c\x89\x1e\xb9\x90\xc8\x4d\x1d\x75\x4e\x11\x4f\x78\x84\x8c\x89\xb4\x60\x29\x21\x95\x0b\x52\x82\x8b\x14\x39\xa9\x39\xd7\xb6\xac\x1a\x4c\xc4\x16\x26\xe3\x73\x6e\x5c\xbf\xe8\xe7\x71\xd5\x3d\x4f\x93\x41\x4d\x82\x94\xa2\x7b\x6e\x76\x8f\x42\x16\x42\xd3\xfe\x31\xe7\x39\x25\x8c\x67\x9e\x17\x73\x7a\xf5\x1a\x6b\x0f\xe0\x09\x0c\x69\x83\x6a\x05\xb5\xd4\xa0\xf9\xc1\x02\x0b\xcf\xd6\x90\xa2\x99\x84\x5f\xfd\xd3\x47\xeb\x8f\x7f\xb4\x3d\x20\xe1\x1e\x60\xf1\xd1\xf2\x4a\xcf\xab\x58\x55\x44\x52\x24\x3c\xb5\x95\xb3\x6e\x6d\x2a\x37\x63\xb9\x92\xf7\xab\x4e\x67\x73\x68\x83\xc0\x1d\x51\xce\x32\xbe\xa0\xf8\x41\xac\x08\xb9\xa2\x05\xa7\xa5\xbb\xe7\x55\x90\xdf\xa5\x8d\x98\xf2\x4c\xae\x1a\x32\x27\xe5\x22\x6e\x70\xc1\x4d\x23\xcf\x98\xa8\xa7\xf2\xc4\x01\x59\xcb\x73\xfb\x0f\x9a\xcd\xe9\x04\x13\xd2\xe6\xa2\x7b\xee\xac\x8d\xd9\x9c\xba\x7a\x1c\x29\x9e\x1b\x44\x33\x8a\xee\x34\xb8\xf1\x9e\xf8\xb4\x0d\x1f\x98\xdf\xc5\x5c\xa1\x96\xa3\xba\xbe\xe8\x0e\x47\x83\xff\x7c\x0d\xcf\x07\x57\x9f\x82\x7f\x85\xbd\x60\x54\x7a\x4e\x27\x93\x11\xcb\x50\xe4\xda\x28\x62\x73\x7d\xe6\xfb\x4e\x6c\x6b\xc9\x6d\x2b\xfa\xd5\xf5\x4f\x9f\xaf\x3f\xf6\xc3\xee\x30\x18\xf7\x47\x37\xfd\x51\xd8\xed\xf5\x46\xe3\x6f\x7f\xde\x96\xfe\x07\xa7\x1b\x4b\xf7\x65\x3f\xb9\xe2\xc2\x24\xa8\x2d\x90\x71\x41\xf0\x01\x4d\x6a\x41\x6a\xfb\x55\xfd\xc6\xdf\xb6\x6e\x51\x5d\x1f\x45\xac\xf2\xdb\x12\x73\x76\x1f\x49\x21\xd0\x6e\xbe\xfb\x75\x13\x22\x12\x96\x65\x38\x85\xe2\x9a\xd0\xfe\x2e\xfc\xbd\xb5\xbd\xd7\x6f\xcf\xaa\x6b\x6b\xb0\xdc\xfa\x23\x68\x13\x9c\x6d\x2e\x6d\x48\x40\x0a\x4c\xa5\x99\x81\xe7\x48\xd8\x9c\x67\x9c\x34\xb4\x84\x99\x31\x63\xe7\x24\x18\x2e\xde\x63\xc1\x95\x29\x58\x66\x75\xb8\xde\x38\x6c\xe7\x51\x1e\xa4\x69\xca\x45\x1c\xca\xdc\x56\x6e\x9f\x29\x9e\xe0\xdb\x37\xf8\xd5\xf5\xc7\xe0\xaa\xe7\x22\xe9\x8f\xc7\xa5\x8f\xb3\x33\xf8\x9d\x8e\x8f\xdb\xdb\x83\xbe\xb3\x9f\xc7\x20\x58\xbc\x5b\xbc\xdf\xe6\x9c\x6f\xbc\x8e\x98\xc1\x1f\xcf\x6a\x56\xe2\xf7\xdf\xfb\x83\x4f\x5e\x9a\xc9\x29\xcb\x3c\xa0\x52\x68\x52\xbb\x7e\xb4\xcf\xa9\x92\x45\x7e\x20\x88\x19\xcd\xa5\xed\xf7\x5d\x4e\xdf\x35\x7f\x7b\xef\xc5\x94\xb0\x22\x33\xda\xca\x65\x4c\xd6\xa0\x89\x72\x0f\x98\xb2\xcc\xf1\x64\x46\x4c\x1b\x5b\x03\x0f\x30\x7c\x4e\xb2\x30\x88\x32\x4e\xc2\x58\x5d\xe0\xb4\xa9\x0f\x4e\xb6\xf5\x3d\x72\x62\x21\x28\xda\x5d\xb2\x77\x14\x19\x65\x33\x7f\xea\x6d\xcb\xa2\x0d\x73\x8e\xd8\x94\xa0\x59\x77\xbf\x9d\x87\xc0\xc7\x93\xee\x64\x1c\x0e\x07\xa3\x49\xb9\xf3\xd6\x92\xaf\x87\xcd\x45\x90\xb0\x0c\xba\x7f\x2c\x14\x47\xc3\x4b\x94\x14\x86\xec\x54\x16\x53\x52\x82\x0c\xed\x2d\x3c\xa9\xd1\x81\x25\x67\xa3\xba\x3e\x2c\x8d\x35\xb9\xcd\x55\x38\x65\xd1\xdd\x0e\xb2\xc6\x72\xbe\x09\xda\x7b\x41\x5c\x5d\xef\x1b\xb3\xf4\x6c\xc9\x4a\xef\xe9\x88\x76\x3a\xae\xb5\xf7\x93\xba\x69\xa2\xda\x5f\xf0\x8f\xcf\xc6\xdb\xfb\xf2\x48\x27\x15\x9a\xa5\xb4\x7f\x8a\x39\xc1\x3f\x76\x19\xfc\x61\xf9\xec\x3b\xed\xd0\xa7\x42\x3c\x21\x0e\x35\x47\x4d\x25\x2f\xd0\x46\xe9\x79\xcf\x09\xef\x18\xe7\x7c\xee\xf7\x87\xdd\xcb\xe0\xa6\xdf\x7b\x89\x76\xb6\x23\x17\x2a\x59\x18\x52\x21\x8f\xcf\xaa\xeb\x9b\x60\x34\xb9\xee\x5e\x86\xa3\xc1\xf5\xa4\x3f\x0a\x83\x5e\xf9\xe8\x42\x1e\xe6\x8a\x12\x7e\x7f\x76\xda\x7e\x3c\x7e\x37\xc1\x70\x33\x75\x6f\x3a\x6f\x9e\xa7\xea\xe0\x62\xab\xfd\xeb\x5e\xbc\xe1\xf9\x8c\x69\x83\xcd\xf4\xc8\x64\x43\x06\x2c\x8e\xdd\xca\xe3\x1a\x33\xba\x67\x31\x45\x7c\xce\x32\xc7\xf6\xf6\x95\x63\x4a\xa0\x79\x6e\x56\x27\xa0\x7a\x5a\x47\x12\x37\x9b\x9d\xce\x1e\x75\xe3\xab\x45\x0d\x1d\xaa\x8b\x6a\x58\xa9\xbc\xe9\x94\x7b\x9d\x63\xa1\x3f\x93\x75\x6a\xd5\x57\xaf\xd0\x7a\x5f\xa9\xae\x1f\xd0\x3a\xb5\x66\x89\x9f\xd1\xfe\xe5\x17\xbc\x45\x0b\xaf\x5f\x97\xbb\x9a\xfe\x1d\xdc\xad\x47\xf5\xf2\x30\xbd\xac\xb0\xfd\x65\x78\xe4\x96\xd3\x63\x86\xab\xb9\x5d\xd0\xbd\x9e\x5c\x84\xc3\xee\x78\xfc\x65\x30\xea\x1d\xeb\xc9\x87\x0d\xf0\x18\xcc\xee\x82\x27\x92\xf5\x77\x61\x6f\x58\x69\x68\x56\x39\xc1\xe2\x1e\xc8\x72\xa6\x35\x7e\xd6\x56\x52\x7e\x17\xfe\x11\xf3\x87\x81\xef\x58\xf3\x59\xd7\xed\x88\x73\xa1\x54\x1e\xea\xdd\xee\xbc\x0b\xdd\xce\x74\x5d\xbb\x15\xfa\x22\x42\x6d\x89\x53\xd4\xfe\x42\xab\xfd\x4f\x4b\x46\xf5\xd6\xc1\x10\x38\x92\xb0\x29\xe1\xc2\x90\x5a\xb0\x0c\xd5\xf5\xf9\x45\xff\xfc\x73\x18\x5c\x4d\xfa\xa3\x9b\xee\xa5\xcd\xe5\x92\xdc\xcb\x71\xad\xd5\x6c\xda\xf7\x0a\xb7\xbb\x2c\xf7\xb9\xed\x65\xdf\x2d\x9c\x1f\x5c\x68\xe3\xd8\xf6\x81\xa5\xc2\x19\x73\xde\x38\xf4\x84\x45\x84\xea\xda\x01\x7f\xea\x9e\xf7\x4b\xef\x48\x69\x71\xa4\xb4\x56\xb1\x22\x64\xae\xc8\x36\xa8\xe7\x0a\x22\x15\x37\x2b\x54\xd7\xc3\x51\x30\x18\x05\x93\xaf\x56\x87\xc5\x0b\x52\x26\xe4\xc2\xa0\xe5\x55\xd7\x8f\x4b\x53\x3e\x18\xe3\xf9\x6e\x14\xac\x6f\x80\x6b\xe5\xb2\x51\x5d\x3f\x4c\x94\x85\xb3\x7f\x46\xb1\xe8\x6e\x97\xe1\x8d\xf6\x3e\xcf\x4e\x65\xcf\x86\xcf\xf9\xe3\xff\x11\xe2\x4d\x30\xfc\x61\xfe\xbb\x09\x86\x47\xe9\xee\x29\xe6\x3e\xb1\x3f\x8c\xbc\xbf\xf1\x12\x9d\x1e\x06\xf5\x32\xa3\xbe\x40\x8a\xa5\xf7\xbf\x01\x00\xf7\x0d\x91\xc9\x6f\x0d\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/setup_kubernetes_high_availability.sh": &vfsgen۰CompressedFileInfo{
 			name:             "setup_kubernetes_high_availability.sh",
-			modTime:          time.Date(2019, 12, 17, 8, 37, 10, 547308683, time.UTC),
-			uncompressedSize: 2538,
+			modTime:          time.Date(2026, 10, 19, 11, 44, 12, 137191851, time.UTC),
+			uncompressedSize: 3855,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x6d\x4f\xe3\xb8\x16\xfe\xee\x5f\x71\x6e\x5a\x8d\xe8\x08\xd2\x16\xd0\x68\x26\xd0\x7b\x95\xa1\xe5\x92\x9d\xd9\xa6\x6a\xca\xcc\x8e\x58\x84\xdc\xe4\x24\xb1\x48\x6d\xaf\xed\xb4\x54\xc0\x7f\x5f\x39\x2f\xa5\x30\xbb\x5a\x6d\xfa\x21\xf5\xf1\xf3\x3c\x39\x2f\x3e\xc7\x9d\xff\xf4\x4b\xad\xfa\x4b\xc6\xfb\xc8\xd7\xb0\xa4\x3a\x27\x9d\x0e\x5c\x08\xb9\x55\x2c\xcb\x0d\x1c\x0f\x86\x9f\x20\xca\x29\xcf\x72\xca\xe0\x17\xc6\xb3\x71\x29\x20\xe0\xa9\x50\x2b\x6a\x98\xe0\xb0\xc0\x38\xe7\xa2\x10\xd9\x16\x62\xe1\x1e\xc2\x57\x93\xb8\xa4\xd3\xb1\x32\x5f\x59\x8c\x5c\x63\x02\x25\x4f\x50\x81\xc9\x11\x7c\x49\xe3\x1c\xdb\x9d\x43\xf8\x86\x4a\x5b\x95\x63\x77\x00\x07\x16\xe0\x34\x5b\x4e\xef\xcc\x4a\x6c\x45\x09\x2b\xba\x05\x2e\x0c\x94\x1a\xc1\xe4\x4c\x43\xca\x0a\x04\x7c\x88\x51\x1a\x60\x1c\x62\xb1\x92\x05\xa3\x3c\x46\xd8\x30\x93\x57\xdf\x69\x54\xac\x27\xf0\xa3\xd1\x10\x4b\x43\x19\x07\x0a\xb1\x90\x5b\x10\xe9\x3e\x10\xa8\x69\x9c\xae\x9e\xdc\x18\xe9\xf5\xfb\x9b\xcd\xc6\xa5\x95\xc7\xae\x50\x59\xbf\xa8\xb1\xba\xff\x35\xb8\x98\x4c\xa3\xc9\xd1\xb1\x3b\x68\x58\xd7\xbc\x40\xad\x41\xe1\x1f\x25\x53\x98\xc0\x72\x0b\x54\xca\x82\xc5\x74\x59\x20\x14\x74\x03\x42\x01\xcd\x14\x62\x02\x46\x58\xaf\x37\x8a\x19\xc6\xb3\x43\xd0\x22\x35\x1b\xaa\xd0\xca\x24\x4c\x1b\xc5\x96\xa5\x79\x95\xb4\xd6\x47\xa6\x5f\x01\x04\x07\xca\xc1\xf1\x23\x08\x22\x07\x3e\xfb\x51\x10\x1d\x5a\x91\xef\xc1\xe2\x2a\xbc\x5e\xc0\x77\x7f\x3e\xf7\xa7\x8b\x60\x12\x41\x38\x87\x8b\x70\x3a\x0e\x16\x41\x38\x8d\x20\xbc\x04\x7f\xfa\x03\xbe\x04\xd3\xf1\x21\x20\x33\x39\x2a\xc0\x07\xa9\x6c\x04\x42\x01\xb3\xe9\xc4\xaa\x8a\x10\x21\xbe\x72\x21\x15\xb5\x4b\x5a\x62\xcc\x52\x16\x43\x41\x79\x56\xd2\x0c\x21\x13\x6b\x54\x9c\xf1\x0c\x24\xaa\x15\xd3\xb6\xac\x1a\x28\x4f\xac\x4c\xc1\x56\xcc\x54\xe7\x45\xff\x1c\x97\x4b\x88\x46\x03\x47\x02\x50\x29\x7c\x60\xa6\x5d\x72\x51\x72\x8d\xbb\xa5\x64\x12\x53\xca\x0a\x42\x3a\x5f\xae\x3f\x4f\xee\xfc\x59\x10\x4d\xe6\xdf\x26\xf3\x3b\x7f\x3c\x9e\x47\xa3\x03\xd2\x01\x70\x86\x03\xd7\xfe\x3e\x79\x1f\x4e\x4f\x4f\x1c\xd2\xe9\x91\xce\xb7\x60\x36\x6a\xed\x1f\x3f\x3a\xa4\x13\x4c\x17\x93\xf9\xa5\x7f\x31\x19\x39\xc8\xf5\x89\x43\xc8\x95\x3f\x9b\x87\xbf\xfd\xb8\x9b\x85\xf3\xc5\xe8\xf4\xf4\xf4\x64\x67\x89\x16\xfe\x22\xaa\xed\xc3\x4f\x27\x1f\x48\x07\xc6\xfe\xe4\xd7\x70\xea\x81\xa3\xb7\xda\xe0\x2a\x71\x6c\xd2\x9c\x44\xc4\xf7\xa8\x1c\x52\xef\x8e\x9a\x3d\x42\xe6\x61\xb8\x18\x75\x0f\xaa\x48\xe0\x62\x3c\xf3\x17\x57\xf0\xee\x1d\xc4\x09\x74\x0f\x12\xa6\x38\x5d\x21\x38\xdd\xc7\xcf\x7e\x74\x75\x17\x85\xd7\xf3\x8b\xc9\xcd\xe0\xf6\xd9\xe9\xf5\x5d\xd7\xe2\xe4\x26\xe9\x11\x0b\x7e\xb4\x42\xcf\x84\x68\x51\xaa\xb8\xa2\x54\x86\x3e\xe3\xcc\xdc\x25\x28\x0b\xb1\xbd\xcb\xa9\x54\xe2\x61\x7b\x77\x8f\x28\x69\xc1\xd6\x98\xf4\x0b\xb6\x74\x75\xee\xfc\x6b\x5e\xf7\xb1\x0e\xe4\xb9\x66\x97\x9a\x66\x78\xd0\x83\x47\x62\xdb\x23\xa6\x06\xce\xcf\x27\xe1\x25\xb9\xb6\x76\x0f\xba\x03\xb8\x49\x0b\x9a\xe9\x5b\x38\xa7\xb1\xad\xf3\x7f\xe1\x86\x4a\x79\x4b\xc8\xa5\x35\x7b\x15\xed\xa8\x04\xb8\x2f\x97\x78\x44\x25\xd3\xa8\xd6\xa8\xa0\xfa\x2e\x94\x52\x1b\x85\x74\x05\x34\x49\x94\xae\xb1\x1c\x20\xa7\xb0\x66\xb2\x5e\xb2\x6a\xb9\x64\x3c\x01\xc6\x0d\xaa\x94\xc6\x48\x88\x2f\x65\xa3\xdd\x84\x50\xfd\x7f\x09\x83\x10\xbf\xf2\xa6\x01\xa9\x92\x57\xed\x1d\x0b\x9e\xb2\xcc\x1e\x4e\xd0\x86\x2a\x63\x7b\xb5\x0e\xac\x40\x6a\x21\xda\x08\x59\x6d\x97\xbc\xc5\x4a\x49\xc8\x1e\x17\x32\xe4\xa8\xa8\xc1\xd6\x50\x0d\x25\x23\xda\x65\xc2\x54\x05\xaf\xf5\xe1\xcd\x77\x14\x16\x82\x26\xbb\x37\x95\x72\x27\xa3\xc4\x6a\x5f\xb2\x81\xd7\xf4\x9d\x5b\xaf\xd5\xb4\xa1\xa6\xd4\xd6\x25\x63\x43\xac\x9a\xb0\xb1\x89\x74\x0f\x25\xe4\xee\xdd\x1a\x77\xf1\x29\x5c\x89\x35\xee\x79\xdf\x52\xc9\xe4\x81\xae\x64\x81\x4d\x0a\xbb\x03\x5b\xc6\xb6\x9b\x86\x83\xaa\xcd\xa0\x5d\x0e\x5f\x2f\x8f\xeb\x26\x6c\x8b\x63\x9d\xdb\x89\x70\xd8\x6b\x49\x5b\x5f\x34\xf9\x60\xaf\x74\xfb\xe0\x96\x5f\x95\xe7\xa9\x0a\xfe\xa9\x4e\xdd\x53\x1d\xe7\x93\x0d\xea\xa9\x0d\xa6\xa5\xed\x89\xbd\x66\xfe\xb3\x82\x3d\xdb\xcf\x84\x74\x60\x8e\x1a\x8d\x1d\xd4\x10\xce\x16\xc1\x74\x6c\x87\x30\xc7\x18\xb5\xa6\x6a\x0b\x2c\xb5\x49\x17\xd2\x68\xd8\x50\x6d\x6f\xa8\x04\xa4\xc2\x35\x13\xa5\x2e\xb6\x76\xcc\x57\xc3\x32\x56\x4c\x1a\x97\x74\x20\x30\x96\x4f\x21\x13\x22\x01\x96\x20\xb5\x27\x66\x45\xef\xb1\x55\x2f\x44\x4c\x0b\x2b\x6b\x2f\x3e\xa9\x44\x5c\xcd\x64\x59\x4f\xce\xea\xf2\x4a\x4b\x5e\x1d\x69\x97\xd4\x94\xd1\x90\x90\x4d\x6e\x0f\x5f\xeb\x89\xe3\xe5\xa5\xc7\x3d\xe6\x39\x96\x79\x06\x89\x68\x9a\x56\xdb\xf6\x17\xd2\x38\xc0\xea\xd4\xe6\x3d\x02\xcd\x53\x35\xf8\x6e\x75\x76\x56\x9f\x8e\x97\xfd\xbf\x9e\xb8\xdd\x70\xb6\xf0\xe7\xff\xef\xbd\x25\xf2\x17\x8b\x1d\xbe\x0d\xec\x2d\x8a\xbd\xa0\x5e\x26\xf2\xdf\x60\x7f\xff\xdf\x1b\x5f\xc1\x09\xf8\x9a\x16\x2c\x69\xd2\xe3\xc1\x51\x43\x75\xde\x72\xbd\x9f\xa8\x61\x45\xd9\x31\xda\x7b\xdb\xde\x57\x40\x55\x56\xae\x90\x1b\xf7\x27\x1d\xd4\x34\x26\x89\xe0\x48\x74\xce\x52\x03\xdd\x83\x83\xba\x06\x47\xc3\x5e\x8f\x10\x96\xc2\xcd\x0d\x38\xdd\x8e\x03\xe7\x70\x0c\xb7\xb7\x67\xb6\xfa\x75\xa6\x5f\xd2\x9b\x30\x24\x29\x23\xc4\x9f\xcd\x46\xdd\x21\xf1\x2f\xec\x9d\x3c\xea\x1e\xef\xf8\xf5\x09\x7c\x52\x25\x77\x60\x34\x82\xf7\x4e\xf7\xb1\x06\x3d\x3b\xef\xad\x28\xd9\x89\x76\x1f\xfd\xd9\xec\xd9\xf3\x6a\x86\xe7\xc5\x39\xc6\xf7\x95\x78\xbb\xb3\xa3\x92\x3f\x03\x00\x00\xff\xff\x51\x25\x6a\xa1\xea\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x7f\x6f\xe3\xb8\x11\xfd\x9f\x9f\x62\x2a\x1b\x87\xe4\x10\xff\xca\x6e\x0f\x89\xb2\x6e\xa1\xb5\xbd\x8d\xba\xa9\x6d\xd8\x4e\xb6\x8b\x34\x30\x68\x69\x2c\x11\x91\x49\x96\xa4\xec\x18\x49\xbe\x7b\x41\xfd\xb4\x93\x4b\xaf\xdd\x0d\x60\x93\x7c\xef\xcd\x88\x33\xa3\x19\x37\xfe\xd4\x49\xb5\xea\xac\x18\xef\x20\xdf\xc2\x8a\xea\x98\x34\x1a\x30\x10\x72\xaf\x58\x14\x1b\x38\xef\xf6\x2e\x61\x1e\x53\x1e\xc5\x94\xc1\xdf\x19\x8f\x86\xa9\x00\x9f\xaf\x85\xda\x50\xc3\x04\x87\x05\x06\x31\x17\x89\x88\xf6\x10\x88\xf6\x19\xdc\x98\xb0\x4d\x1a\x0d\x2b\x73\xc3\x02\xe4\x1a\x43\x48\x79\x88\x0a\x4c\x8c\xe0\x49\x1a\xc4\x58\x9e\x9c\xc1\x1d\x2a\x6d\x55\xce\xdb\x5d\x38\xb1\x00\xa7\x38\x72\x4e\xaf\xac\xc4\x5e\xa4\xb0\xa1\x7b\xe0\xc2\x40\xaa\x11\x4c\xcc\x34\xac\x59\x82\x80\x4f\x01\x4a\x03\x8c\x43\x20\x36\x32\x61\x94\x07\x08\x3b\x66\x62\x30\xb5\x01\xeb\x09\xfc\x2c\x34\xc4\xca\x50\xc6\x81\x42\x20\xe4\x1e\xc4\xfa\x10\x08\xd4\x14\x4e\x67\xff\x62\x63\xa4\xdb\xe9\xec\x76\xbb\x36\xcd\x3c\x6e\x0b\x15\x75\x92\x1c\xab\x3b\x37\xfe\x60\x34\x9e\x8f\x5a\xe7\xed\x6e\xc1\xba\xe5\x09\x6a\x0d\x0a\xff\x9d\x32\x85\x21\xac\xf6\x40\xa5\x4c\x58\x40\x57\x09\x42\x42\x77\x20\x14\xd0\x48\x21\x86\x60\x84\xf5\x7a\xa7\x98\x61\x3c\x3a\x03\x2d\xd6\x66\x47\x15\x5a\x57\x43\xa6\x8d\x62\xab\xd4\x1c\x5d\x5a\xe9\x23\xd3\x47\x00\xc1\x81\x72\x70\xbc\x39\xf8\x73\x07\xbe\x7a\x73\x7f\x7e\x66\x45\x7e\xf8\x8b\xeb\xc9\xed\x02\x7e\x78\xb3\x99\x37\x5e\xf8\xa3\x39\x4c\x66\x30\x98\x8c\x87\xfe\xc2\x9f\x8c\xe7\x30\xf9\x06\xde\xf8\x27\x7c\xf7\xc7\xc3\x33\x40\x66\x62\x54\x80\x4f\x52\xd9\x27\x10\x0a\x98\xbd\x4e\xcc\xa2\x08\x73\xc4\x23\x17\xd6\x22\x8f\xa3\x96\x18\xb0\x35\x0b\x20\xa1\x3c\x4a\x69\x84\x10\x89\x2d\x2a\xce\x78\x04\x12\xd5\x86\x69\x1b\x56\x0d\x94\x87\x56\x26\x61\x1b\x66\xb2\x7c\xd1\xef\x9f\xab\x4d\x88\x46\x03\x2d\x01\xa8\x14\x3e\x31\x53\x2e\xb9\x48\xb9\xc6\x6a\x29\x99\xc4\x35\x65\x09\x21\x8d\xef\xb7\x5f\x47\x4b\x6f\xea\xcf\x47\xb3\xbb\xd1\x6c\xe9\x0d\x87\xb3\x79\xff\x84\x34\x00\x9c\x5e\xb7\x6d\xff\x5f\xba\xbf\x7d\xfe\xfc\xc9\x21\x8d\x53\xd2\xb8\xf3\xa7\xfd\x72\xff\xe2\xc2\x21\x0d\x7f\xbc\x18\xcd\xbe\x79\x83\x51\xdf\x41\xae\x3f\x39\x84\x5c\x7b\xd3\xd9\xe4\x9f\x3f\x97\xd3\xc9\x6c\xd1\xff\xfc\xf9\xf3\xa7\x6a\x67\xbe\xf0\x16\xf3\x7c\xbf\x77\xf9\xe9\x37\xd2\x80\xaf\xfe\x78\x98\x99\x1c\xcd\xe7\x2e\xc4\x54\x2a\xf1\xb4\x87\x84\x69\x83\x5c\x83\xe0\xb0\x12\x26\x06\x26\x61\x4d\x37\x2c\x61\xa8\x81\xad\x81\x19\x60\x1a\x1c\xd7\x75\xc8\x21\xbf\x6f\x7d\xea\xda\x34\x82\x3b\x7f\xb6\xb8\xf5\x6e\x96\xb3\xc9\xed\x62\x34\x5b\xfa\x43\x08\x71\x4d\xd3\xc4\x68\x9b\x31\xf6\xd2\x13\xaa\x0d\x88\xc0\xa0\xb1\xd9\x7b\xe7\x4f\x61\x17\x23\x07\xdc\x48\xb3\x27\xef\xe8\x7d\x32\x9d\xf9\x93\x99\xbf\xf8\xd9\xef\x75\xad\x01\xef\x76\x71\xbd\x9c\x7a\xf3\xf9\x8f\xc9\x6c\xe8\x02\x17\xb0\x55\x4a\x02\x4d\x4d\x8c\xdc\xb0\x20\x8b\xcf\xa1\xe4\x11\xa1\x4f\x06\xd7\xa3\xc1\xf7\x65\x76\x77\x77\xde\x4d\xff\x9c\x34\x60\xe8\x8d\xfe\x31\x19\xbb\xe0\xe8\xbd\x36\xb8\x09\x1d\x10\x0a\x9c\x50\x04\x8f\xa8\x1c\x92\x9f\xf6\x8b\x33\x42\x66\x93\xc9\xa2\xdf\x3c\xc9\x82\x0a\x83\xe1\xd4\x5b\x5c\xc3\x2f\xbf\x40\x10\x42\xf3\x24\x64\x8a\xd3\x0d\x82\xd3\x7c\xfe\xea\xcd\xaf\x97\xf3\xc9\xed\x6c\x30\xba\xef\x3e\xbc\x3a\xa7\x9d\x76\xdb\xe2\xe4\x2e\x3c\x25\x16\xfc\x6c\x85\x5e\x09\xd1\x22\x55\x41\x46\xc9\x36\x3a\x8c\x33\xb3\x0c\x51\x26\x62\xbf\x2c\xc2\xb2\x7c\x44\x94\x34\x61\x5b\x0c\x3b\x09\x5b\xb5\x75\xec\xfc\xdf\xbc\xe6\x73\xfe\x20\xaf\x39\x3b\xd5\x34\xc2\x93\x53\x78\x26\xf6\x4d\x11\x50\x03\x5f\xbe\x8c\x26\xdf\xc8\xad\xdd\x77\xa1\xd9\x85\xfb\x75\x42\x23\xfd\x00\x5f\x68\x60\xaf\xf4\x2f\x70\x4f\xa5\x7c\x20\xe4\x9b\xdd\x76\x33\x5a\x2b\x05\x78\x4c\x57\xd8\xa2\x92\x69\x54\x5b\x54\x90\xd9\x85\x54\x6a\xa3\x90\x6e\x80\x86\xa1\xd2\x39\x96\x03\xc4\x14\xb6\x4c\xe6\x4b\x96\x2d\x57\x8c\x87\xc0\xb8\x41\xb5\xa6\x01\xe6\x27\x12\xde\xa4\x63\x56\x8e\x42\x99\xb3\x32\x97\xa0\xf9\x7c\x98\xea\xaf\x39\x4f\xd7\x3c\x6d\xa8\xd1\x1f\x72\xea\x62\x28\x98\xab\x9a\x99\x39\x64\xbd\x46\xad\xcf\xb2\x4c\xff\x6f\x35\x71\xa8\x7e\x58\x10\x85\xae\x82\x3c\x39\xb7\x4c\x99\x94\x26\xa0\x44\x6a\x50\x01\x0b\x6b\xde\xfb\x8a\xa8\x6e\x68\x5a\xb0\xa5\x62\x42\x31\xb3\x3f\x34\x56\x16\x45\x61\x88\xc2\xef\x56\x81\xa4\x5a\xef\x84\x0a\xcf\x80\x1a\xd8\x08\x6d\xe0\x02\x82\x98\x2a\x1a\x18\x2c\xc3\x62\x00\xea\x2c\x81\x18\x69\x62\x62\x08\x62\x0c\x1e\xf3\xb8\x6c\x69\x02\x1a\x03\xc1\xc3\xa3\xa7\x3d\x2e\xa3\x57\x42\x3c\x29\x8b\xac\x28\xae\x32\xfb\x5e\x4b\x13\xe2\x65\x79\x54\x80\x54\xca\xed\x39\x04\x82\xaf\x59\x64\xdf\xb0\xa0\x0d\x55\xc6\x36\x9c\x0c\x10\x24\x48\x2d\x44\x1b\x21\xb3\xe3\x94\x97\x58\x29\x09\x39\xe0\x42\x84\x1c\x15\x35\x58\x6e\x64\x9d\xd5\x88\x72\x19\x32\x95\xc1\x73\x7d\x78\x63\x47\x61\x22\x68\x58\x7d\x52\x29\x2b\x19\x25\x36\xd5\x77\x96\x60\x01\xcf\x77\x52\x55\x99\xb3\xce\xd5\xec\x02\x95\x1b\xa9\x9c\x3f\xb6\x69\xd3\x33\xd5\x00\x11\x1a\x50\x29\xcf\xfa\x4d\xb1\x27\xd6\x07\x28\x21\xab\xcf\x72\xb3\xba\x05\x85\x1b\xb1\xad\x7c\x08\x99\x2a\xa9\x64\xf4\x44\x37\x32\xc1\xe2\xa2\x9b\x5d\x68\xa5\x55\x43\xe9\x75\xb3\x8e\x02\xe5\xb2\x77\xbc\x3c\xcf\x96\x4e\x55\x0d\x2a\xe5\x95\x08\xaf\x44\x2e\x2e\x1c\x5b\xbf\x68\xe2\xee\x61\xee\x1c\x82\xff\xc8\xa2\x63\xcb\xdc\x76\xa8\xda\x54\x7d\xb1\x87\x2a\xeb\xb0\xdb\x75\xdd\x52\xa4\x58\x55\x1a\xab\xbc\x42\xff\x77\x77\x5b\x0a\xfe\xdc\x83\xd6\x14\x2e\x2f\xa1\x45\x6d\x62\x2b\x34\x47\x0f\xf1\xde\x8d\x52\x3d\xcb\xc8\x97\x2c\x92\x2f\x79\xbc\xed\xc2\xa4\xfa\xc5\x46\xe8\xa5\x8c\x4c\x49\x3b\x10\x3d\x66\xfe\xb1\x82\x7d\x11\xbf\x12\xd2\x80\x19\x6a\x34\x76\xc0\x82\xc9\x74\xe1\x8f\x87\xb6\xfb\x72\x0c\x50\x6b\xaa\xf6\xb6\x21\x47\x68\x84\x34\x1a\x76\x54\xdb\xc9\x32\x04\xa9\x70\xcb\x44\xaa\x93\xbd\x1d\xcf\xec\xdb\x45\x07\x8a\x49\xd3\x26\x0d\xf0\xb3\xee\x4d\x21\x12\x22\x04\x16\x22\xb5\x2d\x79\x43\x1f\xb1\x54\x4f\x44\x40\x13\x2b\x6b\x07\x56\xa9\x84\x35\x04\x42\x66\x65\x6b\xe5\x28\xac\x53\x9e\x55\x71\x9b\xe4\x94\x7e\x8f\x90\x5d\x6c\xeb\xad\xf4\xc4\x71\xe3\xd4\xe5\x2e\x73\xa5\xab\xdd\x95\xab\xdc\xa9\x4b\x5d\xe3\x3a\x56\xe7\x0a\x42\x51\xf4\x1b\x6d\x3b\x9e\x90\xc6\x01\x96\xc7\x2c\x3e\xcd\x3e\xec\x5f\xd6\x9b\xaa\xd5\xd5\x55\xf6\x35\xad\xcf\x7f\x7f\x6e\x6a\x4e\xa6\x0b\x6f\xf6\xb7\xd3\xb7\x44\x5e\xef\xd8\x11\xaa\x80\xbd\x45\xb1\x1a\x55\xcf\x55\x1f\x60\x65\x8d\x3d\x6c\x43\x1f\xc1\xf5\x7b\xf8\xc1\x38\xf6\x01\x69\x55\x93\x8e\x26\xad\x0f\xe0\xaa\x86\xbf\x1f\xa1\x3e\xe0\x4c\x6b\x4e\x35\x63\x7d\x00\xa5\x35\xf4\x78\x9c\xfa\x00\x6f\x6a\xfc\x9b\x79\xeb\x03\xc2\xbf\xfe\x5a\x33\xb2\xf0\x83\xe3\xf3\x2d\x4d\x58\x58\xe4\x9f\x0b\xad\x82\xea\xbc\xe5\xba\xef\xa8\x93\x8c\x52\x31\xca\x1f\x34\x76\x90\x07\xaa\xa2\x74\x83\xdc\xb4\xdf\xe9\xa0\xa6\x01\x09\x05\x47\xa2\x63\xb6\x36\xd0\x3c\x39\xc9\x93\xbc\xd5\x3b\x3d\x25\x84\xad\xe1\xfe\x1e\x9c\x66\xc3\x81\x2f\x70\x0e\x0f\x0f\x57\x76\xfc\xe7\xe4\x38\x63\x43\x86\x64\xcd\x08\xf1\xa6\xd3\x7e\xb3\x47\xbc\x81\xfd\xb1\xd2\x6f\x9e\x57\xfc\xfc\x25\xf1\xa2\x52\xfe\x72\xf0\xca\x73\xa0\xdf\x87\x5f\x9d\xe6\x73\x4e\x78\x75\x7e\xb5\x06\x48\x65\xa0\xf9\xec\x4d\xa7\xaf\xae\x9b\x13\x5c\x37\x6b\xd0\x99\xa1\xf2\xa4\xf9\xec\x0d\x16\xfe\x64\xfc\x4a\xfe\x33\x00\x74\xce\x2c\x74\x0f\x0f\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/systemd.sh": &vfsgen۰CompressedFileInfo{
 			name:             "systemd.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86987615, time.UTC),
-			uncompressedSize: 3382,
+			modTime:          time.Date(2026, 10, 19, 9, 42, 6, 813219846, time.UTC),
+			uncompressedSize: 3614,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xef\x6f\xda\xca\x12\xfd\xee\xbf\x62\x1e\xa0\x36\x91\x82\x1d\xd2\x1f\xef\xb5\x15\x4f\xa2\x81\x36\x7e\xa5\x10\x61\xda\xbe\xe8\xea\x0a\x2d\xeb\xc1\x5e\x75\xd9\x75\x77\xd7\x21\x88\xf0\xbf\x5f\xad\x31\xc4\x06\x43\xae\xd4\x2f\xd7\x9f\xec\x99\x73\x66\xce\xce\x9e\x5d\xd7\xff\xe5\xa5\x5a\x79\x53\x26\x3c\x14\xf7\x30\x25\x3a\x76\xea\x75\xb8\x96\xc9\x52\xb1\x28\x36\x70\x75\xd9\x7a\x07\x41\x4c\x44\x14\x13\x06\xff\x63\x22\xea\xa6\x12\x7c\x31\x93\x6a\x4e\x0c\x93\x02\xc6\x48\x63\x21\xb9\x8c\x96\x40\xa5\x7b\x01\x7d\x13\xba\x4e\xbd\x6e\xcb\xf4\x19\x45\xa1\x31\x84\x54\x84\xa8\xc0\xc4\x08\x9d\x84\xd0\x18\xb7\x99\x0b\xf8\x8e\x4a\xdb\x2a\x57\xee\x25\x9c\x59\x40\x2d\x4f\xd5\xce\x3f\xd8\x12\x4b\x99\xc2\x9c\x2c\x41\x48\x03\xa9\x46\x30\x31\xd3\x30\x63\x1c\x01\x1f\x28\x26\x06\x98\x00\x2a\xe7\x09\x67\x44\x50\x84\x05\x33\x71\xd6\x27\xaf\x62\x95\xc0\x5d\x5e\x43\x4e\x0d\x61\x02\x08\x50\x99\x2c\x41\xce\x8a\x40\x20\x26\x17\x9d\x3d\xb1\x31\xc9\x7b\xcf\x5b\x2c\x16\x2e\xc9\x14\xbb\x52\x45\x1e\xdf\x60\xb5\xd7\xf7\xaf\x7b\x83\xa0\xd7\xbc\x72\x2f\x73\xd6\x37\xc1\x51\x6b\x50\xf8\x2b\x65\x0a\x43\x98\x2e\x81\x24\x09\x67\x94\x4c\x39\x02\x27\x0b\x90\x0a\x48\xa4\x10\x43\x30\xd2\xaa\x5e\x28\x66\x98\x88\x2e\x40\xcb\x99\x59\x10\x85\xb6\x4c\xc8\xb4\x51\x6c\x9a\x9a\xd2\xd0\xb6\x1a\x99\x2e\x01\xa4\x00\x22\xa0\xd6\x09\xc0\x0f\x6a\xf0\xb1\x13\xf8\xc1\x85\x2d\xf2\xc3\x1f\xdf\x0c\xbf\x8d\xe1\x47\x67\x34\xea\x0c\xc6\x7e\x2f\x80\xe1\x08\xae\x87\x83\xae\x3f\xf6\x87\x83\x00\x86\x9f\xa0\x33\xb8\x83\x2f\xfe\xa0\x7b\x01\xc8\x4c\x8c\x0a\xf0\x21\x51\x76\x05\x52\x01\xb3\xe3\xc4\x6c\x17\x21\x40\x2c\x49\x98\xc9\x8d\x24\x9d\x20\x65\x33\x46\x81\x13\x11\xa5\x24\x42\x88\xe4\x3d\x2a\xc1\x44\x04\x09\xaa\x39\xd3\x76\x5b\x35\x10\x11\xda\x32\x9c\xcd\x99\xc9\xfc\xa2\x0f\xd7\xe5\x3a\x8e\x46\x03\x4d\x09\xa8\x14\x3e\x30\xb3\xfd\x14\x32\x15\x1a\x77\x9f\x09\x4b\x70\x46\x18\x77\x9c\xfa\x4d\xe7\x76\x34\xfc\xff\xdd\xe4\x7b\x6f\x14\xf8\xc3\x41\xbb\xe5\xbe\x73\xdf\x3a\xf5\x2f\xbd\xde\x6d\xa7\xef\x7f\xef\x75\x0b\x99\xd7\xee\x1b\xa7\x0e\x9c\x18\xd4\x06\xee\x73\xbf\xc9\x19\xc4\x24\x51\xf2\x61\x69\x25\xc2\x4f\xc4\x84\x70\x76\x8f\xe1\x7b\xa7\x0e\x8f\x50\x7a\x1e\x77\xd0\xc7\x02\x10\x1e\x33\x64\xb3\xf0\x3c\x7d\x96\x12\x39\x32\x9d\xa6\xc2\xa4\xd0\x7a\xeb\x5e\xbe\x86\x47\x68\xb9\x6f\xdd\x57\x59\xf5\x96\x7b\xe5\x5e\xbd\xde\xb4\xca\x90\x14\x85\x91\x1a\xfe\x9d\x77\x6f\xb9\x6f\xdc\xd6\x7f\x36\x6f\xaf\xdc\x37\x50\x40\xaa\x18\x79\x41\x67\xd6\xad\xf4\x96\x21\x9d\xed\xb8\xae\x87\x83\x4f\xfe\xe7\x49\xd7\x1f\xb5\x3d\x34\xd4\xcb\xd7\xb5\x97\x6f\x37\x56\x87\x84\xf5\x16\xec\xd2\x59\xe4\x14\x06\xbd\x5f\xf3\x69\x42\x87\xa8\x76\x63\x55\xc9\x5c\x17\x58\x2e\x95\x62\xe6\x38\xa3\xe1\x70\xdc\x6e\x9c\x65\x06\x80\xeb\xee\x6d\x67\x7c\x03\x2f\x5e\x00\x0d\xa1\x71\x16\x32\x25\xc8\x1c\xa1\xd6\x58\x7d\xec\x04\x37\x93\x60\xf8\x6d\x74\xdd\xfb\xe3\xf2\xcf\x75\xed\xdc\x73\x5d\x8b\x4b\x16\xe1\xb9\x63\xc1\x2b\x5b\x68\xed\x38\x5a\xa6\x8a\x66\x94\x2c\xe0\x31\xc1\xcc\x24\xc4\x84\xcb\xe5\x24\x5f\xd9\xe4\x49\x84\xc7\xd9\xd4\xd5\x71\xcd\x71\x6e\xbf\x7c\x9e\x7c\xfd\x3c\x6a\x67\x2f\xfe\x20\x18\x77\xfa\xfd\xc9\xf0\x36\x3b\x48\x9b\x60\xee\xb4\x49\x70\xf7\xf5\xe3\xb0\xdf\x76\x1c\x5b\xfa\xec\x1c\x56\x8e\x9d\x3e\x97\x94\xf0\xec\xd8\xb6\x1b\x67\x2e\x64\x23\x92\xba\xa9\x90\x23\xd1\x68\xa5\x22\x8d\x25\x34\xfc\xee\x79\x86\xa7\x36\xda\x58\x59\xc2\x1a\x98\xc8\x62\x1b\xe7\x6c\xf2\xf6\xd9\x6a\x22\x89\x29\xc5\xf6\xe5\xbd\x6c\x2e\xa1\xd9\x24\x9c\xcb\x45\x33\x15\x24\x35\x31\x0a\xc3\x28\x31\x18\xbe\x2c\x11\xf7\x96\xf0\xb2\xbd\x49\x7f\xf8\xb0\x91\x94\xd9\xf1\xb0\xfd\x32\x9d\xff\x8d\xf6\x1a\x8d\x4c\x4c\x5b\x4e\xb5\xe4\x68\x50\xb7\x2f\xa1\xd9\x14\x32\x4a\x22\x1a\x23\xfd\x79\x5a\x48\xb3\x24\xc4\xba\xfd\x1f\x20\x03\x35\xa1\xce\xda\x71\x0a\x97\xc6\x7b\x26\xb4\x21\x9c\xef\xb6\xbd\xb1\xca\xe5\xd9\x4d\xcc\x52\x60\x65\xfc\xca\x13\x7b\x1a\xd7\xc5\x7b\xe5\xc5\x7f\xbd\x10\xef\x3d\x91\x72\xbe\xdf\x25\x15\x27\xfa\x90\xd4\x48\x85\x73\x79\x8f\xcf\x37\xb1\x85\x73\xd7\xff\xbe\xf6\xed\xed\xb8\x27\x7c\x57\xff\x37\x55\x6f\x2f\xa9\x62\x49\x6d\x88\x7a\x3a\x63\x7a\xa9\x0d\xce\xa9\xe1\x90\xc5\x2b\x19\x28\xec\x9f\xb8\x82\xb2\x49\x54\x72\x42\xa6\x8f\x90\xf2\xcc\x11\x6d\x32\xa9\x94\x26\x93\x4a\xbc\x42\x2e\x49\x58\xc1\xd8\x24\x8e\x70\x8e\x4d\x20\xcf\x1c\x9b\x9a\x49\x75\xf5\xd8\x4c\xaa\xab\x3b\xa5\x62\x47\xd8\x05\xed\x15\xcd\xa2\x72\x2c\xdf\xe3\x72\x30\xd3\x52\x0e\x6d\xe6\x5d\xea\x41\x39\x92\x8a\x2e\x76\x62\xe5\x48\x3e\xf6\x72\x70\x67\xaf\xfd\x70\xae\x72\xef\x0c\x9d\xb6\x4e\xf9\x88\x14\x79\xcf\x19\xa8\xf9\xeb\x04\xf9\x79\x27\x1d\xe7\x9e\xf2\xd3\x71\xd6\xf3\x0e\x39\xd5\xf1\xb4\x4f\x4e\x74\x2d\xb8\xa5\x18\x2f\x18\xa6\xe2\xd6\x3c\x88\x3f\xd9\xe6\x70\x07\xf6\x5b\x96\xcd\xb3\x3f\xb8\x83\x60\xd1\x42\x95\x57\x6b\x45\xe6\xc9\x48\xf6\xd7\xee\x38\x7f\x05\x00\x00\xff\xff\xe1\xb6\x09\xce\x36\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x6d\x6f\xda\x4a\x13\xfd\xbe\xbf\x62\x9e\x80\xda\x44\x0a\xe6\xa5\xe9\x5b\x2a\x1e\x89\x26\xb4\xf1\x2d\x85\x08\x68\x7b\xa3\xab\x2b\xb4\xd8\x83\xbd\xea\xb2\xeb\xee\xae\x43\x10\xf0\xdf\xaf\xd6\x36\x60\x13\x87\x54\xaa\xf3\x21\xf6\xcc\x39\x33\x67\x67\x8e\x4d\xe5\x7f\xf5\x58\xab\xfa\x94\x89\x3a\x8a\x7b\x98\x52\x1d\x92\x4a\x05\xae\x64\xb4\x54\x2c\x08\x0d\xb4\x1a\xcd\xf7\x30\x0a\xa9\x08\x42\xca\xe0\x2f\x26\x82\xeb\x58\x82\x2b\x66\x52\xcd\xa9\x61\x52\xc0\x18\xbd\x50\x48\x2e\x83\x25\x78\xd2\x39\x87\x9e\xf1\x1d\x52\xa9\xd8\x32\x3d\xe6\xa1\xd0\xe8\x43\x2c\x7c\x54\x60\x42\x84\x4e\x44\xbd\x10\xb7\x99\x73\xf8\x8e\x4a\xdb\x2a\x2d\xa7\x01\xa7\x16\x70\x92\xa5\x4e\xce\x3e\xd8\x12\x4b\x19\xc3\x9c\x2e\x41\x48\x03\xb1\x46\x30\x21\xd3\x30\x63\x1c\x01\x1f\x3c\x8c\x0c\x30\x01\x9e\x9c\x47\x9c\x51\xe1\x21\x2c\x98\x09\xc1\xec\x1b\x58\x25\x70\x97\xd5\x90\x53\x43\x99\x00\x0a\x9e\x8c\x96\x20\x67\x79\x20\x50\x93\x89\x4e\xae\xd0\x98\xe8\xb2\x5e\x5f\x2c\x16\x0e\x4d\x14\x3b\x52\x05\x75\x9e\x62\x75\xbd\xe7\x5e\x75\xfb\xa3\x6e\xad\xe5\x34\x32\xd6\x37\xc1\x51\x6b\x50\xf8\x2b\x66\x0a\x7d\x98\x2e\x81\x46\x11\x67\x1e\x9d\x72\x04\x4e\x17\x20\x15\xd0\x40\x21\xfa\x60\xa4\x55\xbd\x50\xcc\x30\x11\x9c\x83\x96\x33\xb3\xa0\x0a\xad\x54\x9f\x69\xa3\xd8\x34\x36\x85\xa1\x6d\x35\x32\x5d\x00\x48\x01\x54\xc0\x49\x67\x04\xee\xe8\x04\x3e\x76\x46\xee\xe8\xdc\x16\xf9\xe1\x8e\x6f\x06\xdf\xc6\xf0\xa3\x33\x1c\x76\xfa\x63\xb7\x3b\x82\xc1\x10\xae\x06\xfd\x6b\x77\xec\x0e\xfa\x23\x18\x7c\x82\x4e\xff\x0e\xbe\xb8\xfd\xeb\x73\x40\x66\x42\x54\x80\x0f\x91\xb2\x27\x90\x0a\x98\x1d\x27\x26\x5b\x84\x11\x62\x41\xc2\x4c\xa6\x7b\xd4\x11\x7a\x6c\xc6\x3c\xe0\x54\x04\x31\x0d\x10\x02\x79\x8f\x4a\x30\x11\x40\x84\x6a\xce\xb4\x5d\xab\x06\x2a\x7c\x5b\x86\xb3\x39\x33\x89\x5f\xf4\xe3\x73\x39\x84\x68\x34\x50\x93\x80\x4a\xe1\x03\x33\xdb\x47\x21\x63\xa1\x71\xf7\x18\xb1\x08\x67\x94\x71\x42\x2a\x37\x9d\xdb\xe1\xe0\xef\xbb\xc9\xf7\xee\x70\xe4\x0e\xfa\xed\xa6\xf3\xde\x79\x43\x2a\x5f\xba\xdd\xdb\x4e\xcf\xfd\xde\xbd\xce\x65\x2e\x9c\xd7\xa4\x02\x9c\x1a\xd4\x06\xee\x33\xbf\xc9\x19\x84\x34\x52\xf2\x61\x69\x25\xc2\x4f\xc4\x88\x72\x76\x8f\xfe\x25\xa9\xc0\x1a\x0a\xd7\x7a\x07\x5d\xe7\x80\xb0\x4e\x90\xb5\xdc\xb5\x7f\x2c\x24\x32\x64\x3c\x8d\x85\x89\xa1\xf9\xc6\x69\x5c\xc0\x1a\x9a\xce\x1b\xe7\x55\x52\xbd\xe9\xb4\x9c\xd6\x85\xed\x94\x21\x7d\x9c\x32\x2a\xa0\xd9\x80\x2c\xff\xce\x69\xbe\xb7\x77\x2d\xa7\xe1\x34\x1b\x39\xa4\x87\xc2\x48\x0d\x6f\x33\x9d\x4d\xe7\xb5\xd3\x7c\x67\x91\x4d\xe7\x95\xf3\x3a\x93\x9f\x20\x95\xf4\x7e\x2e\xe1\xdd\xf6\x44\xb6\x66\xeb\x6d\x5a\xb3\x59\x44\xca\x08\x45\x37\xe6\xa8\x92\x50\xda\xf3\x62\x7b\xd7\xca\x77\x57\x21\xf2\xed\x90\xb2\x13\x17\xef\xec\x03\x21\xdb\x65\x5d\x0d\xfa\x9f\xdc\xcf\x93\x6b\x77\xd8\xae\xa3\xf1\xea\xd9\x54\x0f\xf2\xed\xea\xea\x31\x61\xb3\x05\x3b\xde\x2c\x20\xb9\x35\x1f\xd6\xdc\xef\xe7\x31\xaa\x5d\x5d\x95\x32\x37\x39\x96\xe3\x49\x31\x23\x64\x38\x18\x8c\xdb\xd5\xd3\xc4\x7e\x70\x75\x7d\xdb\x19\xdf\xc0\x8b\x17\xe0\xf9\x50\x3d\xf5\x99\x12\x74\x8e\x70\x52\x5d\x7d\xec\x8c\x6e\x26\xa3\xc1\xb7\xe1\x55\xf7\x9f\xc6\xbf\x9b\x93\xb3\xba\xe3\x58\x5c\xb4\xf0\xcf\x88\x05\xaf\x6c\xa1\x0d\x21\x5a\xc6\xca\x4b\x28\x49\xa0\xce\x04\x33\x13\x1f\x23\x2e\x97\x93\xec\x64\x93\xbd\x88\x3a\x67\x53\x47\x87\x27\x84\xdc\x7e\xf9\x3c\xf9\xfa\x79\xd8\x4e\x6e\xdc\xfe\x68\xdc\xe9\xf5\x26\x83\xdb\xe4\x35\x4e\x83\x99\xcf\x27\xa3\xbb\xaf\x1f\x07\xbd\x36\x21\xb6\xf4\xe9\x19\xac\x88\x1d\x3f\x97\x1e\xe5\xc9\x47\xa3\x5d\x3d\x75\x20\x19\xbb\xd4\x35\x85\x1c\xa9\x46\x2b\x15\xbd\x50\x42\xd5\xbd\x3e\x4b\xf0\x9e\x8d\x56\x57\x96\xb0\x01\x26\x92\x58\xea\xdb\x75\x6a\xca\x14\x66\xff\xb6\xd2\x68\x64\x0a\xb1\x43\x95\x2f\x6b\x4b\xa8\xd5\x28\xe7\x72\x51\x8b\x05\x8d\x4d\x88\xc2\x30\x8f\x1a\xf4\x5f\x16\x88\x07\x27\x79\xd9\x4e\xd3\x1f\x3e\x24\xff\x52\xa7\xaf\xad\xe5\xd6\x89\x97\xd7\x94\xcf\x29\x67\x22\x7e\x58\xef\x1c\xfb\x58\xdd\x32\x9e\xff\x86\x3a\x8d\x46\x46\xa6\x2d\xa7\x5a\x72\x34\xa8\xdb\x0d\xa8\xd5\x84\x0c\xa2\xc0\x0b\xd1\xfb\x79\x5c\x67\xad\xa0\x13\x35\xf5\xc8\x86\x90\xfd\x36\x2f\x2f\x99\xd0\x86\x72\xbe\xdb\x4a\x75\x95\xc9\xb3\x33\x4e\x52\x60\x65\xfc\x82\xea\xaa\x44\xe3\x26\xff\xd1\x79\xf1\xff\xba\x8f\xf7\x75\x11\x73\x7e\xd8\x25\x16\x47\xfa\xd0\xd8\x48\x85\x73\x79\x8f\xcf\x37\xb1\x85\x33\x53\xfe\xb9\xf6\xac\xd0\xa1\xf0\x5d\xfd\x3f\x54\x9d\xd5\x29\x94\xd4\x86\xaa\xfd\x2b\xa0\x97\xda\xe0\xdc\x33\x1c\x92\x78\x29\x03\x85\xfd\x99\x2e\xa1\xa4\x89\x52\x8e\xcf\xf4\x13\xa4\x2c\x53\xca\xd2\x46\x46\x25\x14\x1b\x2e\xc5\x2b\xe4\x92\xfa\x25\x8c\x34\xf1\x04\xe7\xa9\x09\x64\x99\x52\x96\x36\xd4\xc4\xba\x84\x94\x26\xca\x3b\xc5\x62\x47\xd8\x05\xed\x17\x94\x05\xc5\x58\xb6\xe3\x62\x30\xd1\x52\x0c\xa5\xf3\x2e\xf6\xc0\xb4\x60\xac\xf6\xb3\x3e\xda\x2b\x9d\x4c\xa1\x86\xc7\x91\x96\x28\xb5\x53\x2f\x46\xb2\xd5\x15\x83\x3b\x8b\x1e\x86\xb3\xee\x07\xef\xe1\x71\xfb\x15\x5f\xb3\x3c\xef\x39\x13\xd6\x7e\x1d\x21\x67\xc2\x4b\xd8\x59\xe6\x08\xf7\x98\x27\x9f\x66\x3d\xe7\xcc\x63\xcc\xe7\xfc\xf9\x34\xf7\x39\x97\x3e\xcd\xcc\x7b\x35\x1f\xcf\x59\x28\x1f\xce\xaf\x3c\x1f\xdf\x9b\x36\x1f\xdd\xfb\x36\x1f\x2d\xb3\xee\x6f\xb4\xde\x1b\xb8\x00\x2e\x78\x38\x9f\xd9\xd9\xb8\xc4\x10\x8f\xe2\x45\x33\x17\x33\x99\x9e\x0d\x21\x4c\x30\x43\xc8\x7f\x03\x00\x5e\xf4\x5c\xab\x1e\x0e\x00\x00"),
 		},
 		"/scripts/init_deploy_keepalived.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_keepalived.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 87237477, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 1388,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x5d\x6f\xdb\x36\x14\x86\xef\xf5\x2b\xde\xc9\x41\xd0\x02\xb6\x94\xf8\x6e\x2b\x32\xc0\x8b\xbd\x45\x6b\x60\x0f\x96\xda\xa2\x28\x8a\x80\x96\x8e\xa4\x83\x52\x24\x43\x52\x71\x04\xe4\xc7\x0f\xb4\x1d\xd4\xce\x82\x5c\x4d\x97\xe2\xab\x87\xcf\xf9\x80\x46\xbf\xa4\xbd\xb3\xe9\x86\x55\x4a\xea\x01\x1b\xe1\xda\x68\x34\xc2\xb5\x36\x83\xe5\xa6\xf5\x98\x5e\x5c\xfe\x8a\xbc\x15\xaa\x69\x05\xe3\x6f\x56\xcd\xbc\xd7\xc8\x54\xad\x6d\x27\x3c\x6b\x85\x82\xca\x56\x69\xa9\x9b\x01\xa5\x4e\xc6\xb8\xf5\x55\x12\x8d\x46\x01\x73\xcb\x25\x29\x47\x15\x7a\x55\x91\x85\x6f\x09\x33\x23\xca\x96\x9e\x4f\xc6\xf8\x4c\xd6\x05\xca\x34\xb9\xc0\xbb\x10\x88\x0f\x47\xf1\xfb\x0f\x01\x31\xe8\x1e\x9d\x18\xa0\xb4\x47\xef\x08\xbe\x65\x87\x9a\x25\x81\x1e\x4b\x32\x1e\xac\x50\xea\xce\x48\x16\xaa\x24\x6c\xd9\xb7\xbb\x7b\x0e\x94\x60\x82\xaf\x07\x86\xde\x78\xc1\x0a\x02\xa5\x36\x03\x74\x7d\x1c\x84\xf0\x07\xe9\xdd\xd3\x7a\x6f\x7e\x4b\xd3\xed\x76\x9b\x88\x9d\x71\xa2\x6d\x93\xca\x7d\xd6\xa5\xb7\xd9\xf5\x62\x99\x2f\x26\xd3\xe4\xe2\xf0\xd5\x27\x25\xc9\x39\x58\xba\xef\xd9\x52\x85\xcd\x00\x61\x8c\xe4\x52\x6c\x24\x41\x8a\x2d\xb4\x85\x68\x2c\x51\x05\xaf\x83\xf5\xd6\xb2\x67\xd5\x8c\xe1\x74\xed\xb7\xc2\x52\xc0\x54\xec\xbc\xe5\x4d\xef\x4f\x9a\xf6\xec\xc8\xee\x24\xa0\x15\x84\x42\x3c\xcb\x91\xe5\x31\xfe\x98\xe5\x59\x3e\x0e\x90\x2f\x59\x71\xb3\xfa\x54\xe0\xcb\x6c\xbd\x9e\x2d\x8b\x6c\x91\x63\xb5\xc6\xf5\x6a\x39\xcf\x8a\x6c\xb5\xcc\xb1\xfa\x13\xb3\xe5\x57\x7c\xcc\x96\xf3\x31\x88\x7d\x4b\x16\xf4\x68\x6c\xa8\x40\x5b\x70\x68\x27\xed\xa6\x88\x9c\xe8\x44\xa1\xd6\x7b\x25\x67\xa8\xe4\x9a\x4b\x48\xa1\x9a\x5e\x34\x84\x46\x3f\x90\x55\xac\x1a\x18\xb2\x1d\xbb\x30\x56\x07\xa1\xaa\x80\x91\xdc\xb1\xdf\xed\x8b\xfb\x6f\x5d\x49\x14\x8d\x50\x84\xc1\xba\xd2\x72\x98\xa9\x83\xe0\x2e\xf4\xa9\x22\x23\xf5\x80\x1f\x44\x46\x48\x7e\xa0\x2a\x8a\x12\x48\xde\x24\xae\x8d\xa2\x55\x7e\xf5\x17\xf9\x55\x1e\x7d\x5c\x2c\xfe\x99\xdd\x66\x9f\x17\xf3\xd9\x7a\x3d\xbf\x3a\xbb\x3c\x7a\xb3\x28\x6e\xae\xce\xa6\x51\xe4\xc9\x79\x4c\x14\xce\x4e\xc3\x78\x7a\xc2\x3b\x2a\x5b\x8d\xf8\xe7\x25\x10\x55\x65\x83\x45\xd8\xba\xe7\x4a\x63\x9c\x9f\x83\x1e\xd9\xe3\xf2\xfd\x6b\xb4\x45\x71\xf3\x3a\x8c\x42\x7f\x15\xf9\x37\x81\x11\xd7\xf8\xf6\x0d\x67\xab\x1c\x13\xba\x47\xdc\x6f\x7a\xe5\xfb\x18\xdf\xbf\x7f\x08\xad\x52\x51\xd8\x4a\x61\xfc\xa4\x09\x20\xe5\xbc\x90\x12\x93\x93\xce\x84\x44\x65\x7e\x34\x98\x48\x3c\xa1\xb1\x64\x30\xb9\x3f\x0a\xbc\x6e\xf7\xcc\xaa\x05\x4b\xaa\x4e\x9c\x48\xbe\x90\x2a\x49\x79\xed\x82\x54\x60\x1d\x9f\xd8\x96\xe4\x0b\xd9\xa1\xef\xde\x10\xb5\xa6\xc3\xe4\x5e\xfc\x5f\xa2\x8e\x76\xd4\xfd\x47\x6e\x70\x9e\xba\x7d\xaf\x7b\x63\xb4\xf5\xf1\xfe\x74\x17\x8f\x6a\x8e\xa2\xdd\x1f\x2f\xfc\xed\x90\xfa\xce\xa4\xac\xd8\xdf\xed\x77\xed\xae\x15\xc6\xea\xc7\xe1\xee\xe7\xd5\xa9\x23\xdf\x9b\xc4\xb5\x61\xe2\xf1\x8b\x05\x8a\x31\xe1\x93\x97\x8b\xe2\x26\x3e\x2e\xc6\xf6\x0a\xd3\xdf\xcf\x2f\xa3\x7f\x03\x00\x00\xff\xff\x94\x1c\x31\xc6\x6c\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x51\x6f\xdb\x36\x14\x85\xdf\xf5\x2b\xce\xe4\xa0\x68\x01\x5b\x4a\xfc\xb6\x15\x19\xe0\xc5\xda\xa2\x35\xb0\x07\x4b\x6d\x51\x14\x45\x40\x4b\x57\xd2\x45\x29\x92\x21\xa9\x38\x02\xf2\xe3\x07\xda\x09\x62\x77\x41\x9f\xa6\x47\xdd\xc3\xc3\xef\x9e\x7b\xc1\xc9\x2f\xe9\xe0\x6c\xba\x65\x95\x92\xba\xc7\x56\xb8\x2e\x9a\x4c\x70\xa5\xcd\x68\xb9\xed\x3c\xe6\xe7\x17\xbf\xa2\xe8\x84\x6a\x3b\xc1\xf8\x9b\x55\xbb\x1c\x34\x72\xd5\x68\xdb\x0b\xcf\x5a\xa1\xa4\xaa\x53\x5a\xea\x76\x44\xa5\x93\x29\x6e\x7c\x9d\x44\x93\x49\xb0\xb9\xe1\x8a\x94\xa3\x1a\x83\xaa\xc9\xc2\x77\x84\x85\x11\x55\x47\xcf\x95\x29\x3e\x91\x75\xc1\x65\x9e\x9c\xe3\x6d\x10\xc4\x4f\xa5\xf8\xdd\xfb\x60\x31\xea\x01\xbd\x18\xa1\xb4\xc7\xe0\x08\xbe\x63\x87\x86\x25\x81\x1e\x2a\x32\x1e\xac\x50\xe9\xde\x48\x16\xaa\x22\xec\xd8\x77\xf0\x2f\x17\x04\x12\x7c\x79\xf2\xd0\x5b\x2f\x58\x41\xa0\xd2\x66\x84\x6e\x8e\x85\x10\xfe\x09\x7a\xff\x75\xde\x9b\xdf\xd2\x74\xb7\xdb\x25\x62\x4f\x9c\x68\xdb\xa6\xf2\xa0\x75\xe9\x4d\x7e\x95\xad\x8a\x6c\x36\x4f\xce\x9f\x4e\x7d\x54\x92\x9c\x83\xa5\xbb\x81\x2d\xd5\xd8\x8e\x10\xc6\x48\xae\xc4\x56\x12\xa4\xd8\x41\x5b\x88\xd6\x12\xd5\xf0\x3a\x50\xef\x2c\x7b\x56\xed\x14\x4e\x37\x7e\x27\x2c\x05\xd4\x9a\x9d\xb7\xbc\x1d\xfc\x49\x68\xcf\x8c\xec\x4e\x04\x5a\x41\x28\xc4\x8b\x02\x79\x11\xe3\x8f\x45\x91\x17\xd3\x60\xf2\x39\x2f\xaf\xd7\x1f\x4b\x7c\x5e\x6c\x36\x8b\x55\x99\x67\x05\xd6\x1b\x5c\xad\x57\xcb\xbc\xcc\xd7\xab\x02\xeb\x3f\xb1\x58\x7d\xc1\x87\x7c\xb5\x9c\x82\xd8\x77\x64\x41\x0f\xc6\x86\x0e\xb4\x05\x87\x38\x69\x3f\x45\x14\x44\x27\x08\x8d\x3e\xcc\xd1\x19\xaa\xb8\xe1\x0a\x52\xa8\x76\x10\x2d\xa1\xd5\xf7\x64\x15\xab\x16\x86\x6c\xcf\x2e\x8c\xd5\x41\xa8\x3a\xd8\x48\xee\xd9\xef\xf7\xc5\xfd\xb7\xaf\x24\x8a\x26\x28\xc3\x60\x5d\x65\x39\xcc\xd4\x41\x70\x1f\x72\xaa\xc9\x48\x3d\xe2\x3b\x91\x11\x92\xef\xa9\x8e\xa2\x04\x92\xb7\x89\xeb\xa2\x68\x5d\x5c\xfe\x45\x7e\x5d\x44\x1f\xb2\xec\x9f\xc5\x4d\xfe\x29\x5b\x2e\x36\x9b\xe5\xe5\xd9\xc5\xd1\x9f\xac\xbc\xbe\x3c\x9b\x47\x91\x27\xe7\x31\x53\x38\x3b\x15\xe3\xf1\x11\x6f\xa9\xea\x34\xe2\x97\x4b\x20\xea\xda\x06\x8a\xb0\x75\xcf\x9d\xc6\x78\xf3\x06\xf4\xc0\x1e\x17\xef\x5e\x73\xcb\xca\xeb\xd7\xcd\x28\xe4\xab\xc8\xff\xd4\x30\xe2\x06\x5f\xbf\xe2\x6c\x5d\x60\x46\x77\x88\x87\xed\xa0\xfc\x10\xe3\xdb\xb7\xf7\x21\x2a\x15\x85\xad\x14\xc6\xcf\xda\x60\xa4\x9c\x17\x52\x62\x76\x92\x4c\x50\xd4\xe6\x7b\x8b\x99\xc4\x23\x5a\x4b\x06\xb3\xbb\x23\xc1\xeb\x74\xcf\x5e\x8d\x60\x49\xf5\x09\x13\xc9\x1f\xa0\x2a\x52\x5e\xbb\x00\x15\xbc\x8e\x2b\xb6\x23\xf9\x03\xec\x38\xf4\x3f\x01\xb5\xa6\xc7\xec\x4e\xfc\x5f\xa0\x8e\xf6\xae\x87\x43\x6e\x74\x9e\xfa\x43\xd6\x83\x31\xda\xfa\xf8\x50\xdd\xf7\x15\x35\x1c\x45\xfb\x17\x2f\xbc\x76\x48\x7d\x6f\x52\x56\xec\x6f\x0f\xbb\x76\xdb\x09\x63\xf5\xc3\x78\xfb\x72\x75\xea\xc8\x0f\x26\x71\x5d\xd8\x9f\xf8\x68\xe4\x61\x81\x62\xcc\xf8\xe4\x67\x56\x5e\xc7\xc7\xcd\xd8\x41\x61\xfe\xfb\x9b\x8b\xe8\xdf\x01\x00\x94\x1c\x31\xc6\x6c\x05\x00\x00"),
 		},
 		"/scripts/init_deploy_kubetool.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_kubetool.sh",
-			modTime:          time.Date(2019, 12, 30, 1, 40, 5, 334767630, time.UTC),
-			uncompressedSize: 15032,
+			modTime:          time.Date(2026, 10, 19, 11, 45, 51, 301876988, time.UTC),
+			uncompressedSize: 32019,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xff\x73\xe2\xb8\x92\xff\x9d\xbf\xa2\x1f\xf1\x0e\xc9\xec\x08\x03\xc9\xcb\x64\x98\xf5\xde\x32\xc1\xc9\xf2\x26\x09\x14\x90\x99\x9b\xcb\xcb\xf2\x84\x2d\x40\x17\x23\x79\x65\x39\x09\x9b\x70\x7f\xfb\x95\xe4\x2f\xd8\x06\xb2\xc3\xbb\x4a\xd5\xfd\xb0\x54\xcd\xc4\xd6\x97\x8f\xba\x5b\xdd\xad\x6e\x49\xde\xfb\x1b\x98\x61\x20\xcc\x31\x65\x26\x61\xf7\x30\xc6\xc1\xac\xb4\xb7\x07\xa7\xdc\x5f\x08\x3a\x9d\x49\x68\xd4\xea\x1f\x60\x30\xc3\x6c\x3a\xc3\x14\xfe\x41\xd9\xb4\x1d\x72\xe8\xb0\x09\x17\x73\x2c\x29\x67\x30\x24\xce\x8c\x71\x8f\x4f\x17\xe0\xf0\xea\x3b\xb8\x90\x6e\xb5\xb4\xb7\xa7\x60\x2e\xa8\x43\x58\x40\x5c\x08\x99\x4b\x04\xc8\x19\x81\x96\x8f\x9d\x19\x49\x6a\xde\xc1\x17\x22\x02\x85\xd2\xa8\xd6\x60\x5f\x35\x28\xc7\x55\xe5\x83\x8f\x0a\x62\xc1\x43\x98\xe3\x05\x30\x2e\x21\x0c\x08\xc8\x19\x0d\x60\x42\x3d\x02\xe4\xd1\x21\xbe\x04\xca\xc0\xe1\x73\xdf\xa3\x98\x39\x04\x1e\xa8\x9c\xe9\x71\x62\x14\x45\x09\x7c\x8b\x31\xf8\x58\x62\xca\x00\x83\xc3\xfd\x05\xf0\x49\xb6\x21\x60\x19\x13\xad\x7f\x33\x29\xfd\xa6\x69\x3e\x3c\x3c\x54\xb1\xa6\xb8\xca\xc5\xd4\xf4\xa2\xb6\x81\x79\xd1\x39\xb5\xaf\x06\x36\x6a\x54\x6b\x71\xaf\x6b\xe6\x91\x20\x00\x41\x7e\x0f\xa9\x20\x2e\x8c\x17\x80\x7d\xdf\xa3\x0e\x1e\x7b\x04\x3c\xfc\x00\x5c\x00\x9e\x0a\x42\x5c\x90\x5c\x51\xfd\x20\xa8\xa4\x6c\xfa\x0e\x02\x3e\x91\x0f\x58\x10\x05\xe3\xd2\x40\x0a\x3a\x0e\x65\x4e\x68\x09\x8d\x34\xc8\x35\xe0\x0c\x30\x83\x72\x6b\x00\x9d\x41\x19\x3e\xb5\x06\x9d\xc1\x3b\x05\xf2\xb5\x33\xfc\xb5\x7b\x3d\x84\xaf\xad\x7e\xbf\x75\x35\xec\xd8\x03\xe8\xf6\xe1\xb4\x7b\xd5\xee\x0c\x3b\xdd\xab\x01\x74\xcf\xa0\x75\xf5\x0d\x3e\x77\xae\xda\xef\x80\x50\x39\x23\x02\xc8\xa3\x2f\x14\x07\x5c\x00\x55\xe2\x24\x7a\x16\x61\x40\x48\x8e\x84\x09\x8f\x48\x0a\x7c\xe2\xd0\x09\x75\xc0\xc3\x6c\x1a\xe2\x29\x81\x29\xbf\x27\x82\x51\x36\x05\x9f\x88\x39\x0d\xd4\xb4\x06\x80\x99\xab\x60\x3c\x3a\xa7\x52\xeb\x4b\xb0\xce\x57\xb5\x54\x0a\x88\x04\x64\x93\x90\x83\x4f\x7d\x32\xc1\xd4\x2b\x95\xfa\xdd\xee\xd0\x32\xf6\x43\xa6\x2a\x4f\xdb\xbd\xd6\xf0\x57\x78\xf3\x06\x1c\x17\x8c\x7d\x97\x0a\x86\xe7\x04\xca\xc6\xd3\xa7\xd6\xe0\xd7\xd1\xa0\x7b\xdd\x3f\xb5\x6f\x6a\xb7\xcb\xf2\x81\x6a\xe4\x3f\xb8\x07\x25\xd5\x52\x81\x94\xda\xf6\xa7\xeb\x73\x6b\x82\xbd\x80\x94\x2e\x06\x9f\x46\xed\xce\x60\x68\x95\xd4\xff\xa3\x2f\x76\x7f\xd0\xe9\x5e\x59\xa5\xd6\xe9\x50\xff\x3d\xed\x5e\xf6\xba\x57\xf6\xd5\xd0\x2a\xa5\x75\x57\xdd\xb6\xdd\xe9\x59\xa5\xce\x65\xeb\xdc\x1e\xf5\xed\x5e\x77\xd0\x19\x76\xfb\xdf\x2c\x97\x3b\x77\x44\x54\x29\x37\xef\x7c\x8c\x83\x52\xdb\xfe\xd2\x39\xb5\x47\x97\xdd\xeb\xab\xe1\xc0\x2a\x95\xf6\xe0\x2e\x1c\x13\x8f\xc8\x54\x5e\xa5\xcf\xd7\x9f\xec\x0b\x3b\x33\xf0\xe9\xc5\xf5\x60\x68\xf7\x47\xed\xab\x81\x95\xd6\xf6\x3e\x9f\xa7\xdd\xb1\x3b\x5f\x75\xff\x47\xb7\x73\x35\x3a\xed\x5e\x0d\xfb\xdd\x8b\x51\xef\xa2\x75\x65\x5b\xa5\xce\x55\x67\xa8\xca\xce\x3a\xe7\x96\x49\xa4\x63\xaa\x5e\x82\x11\x49\x02\x33\x06\x18\x39\x9c\x4d\xe8\xb4\xba\xc0\x73\x4f\xe1\xfa\xd8\xb9\x53\x93\x96\xe2\xf6\x3e\x9f\x8f\x2e\xcf\xfb\x0a\x6c\x30\x6c\x5d\x5c\x8c\xba\x3d\xad\x2b\xa9\x14\x46\x83\x6f\x97\x9f\xba\x17\x56\xe9\xa2\x7b\xda\xba\x50\x32\x18\xb5\xda\xed\xbe\x55\xb2\xff\x73\xd8\x6f\xf5\x3e\x9f\x0f\xac\x08\xa4\xd3\xef\x77\xfb\xd6\x9c\x0a\xc1\x45\x50\xc5\x1e\x5d\x84\xac\xea\xf0\xb9\x1a\x96\x48\xc7\x5d\x8d\x69\x0f\x4f\xdb\x23\x25\xd7\x56\xaf\x33\xb0\xfb\x5f\xec\xfe\xb7\xd6\xe5\xc5\x1a\x0b\x73\xcc\xe8\x84\x04\x32\x62\x06\x61\x9f\x06\x44\xdc\x13\x11\x31\xa3\x41\xfe\xa4\x9f\x1a\x36\x61\x7d\x0f\x02\x1e\x0a\x87\x80\x47\xc7\xd5\x60\x56\xaa\x26\x0f\x25\x87\xcf\xe7\x98\xb9\xcd\x26\x79\xa4\x81\x0c\xf6\x0f\xe0\xa9\xa4\x7c\x41\x5c\x0e\xe8\x1e\xca\xc6\x2f\x65\xf8\x19\x4c\x97\xdc\x9b\x2c\xf4\x3c\x68\xfc\xfc\xa6\x5e\x5a\xe6\xfa\x12\x27\xed\x69\x68\xc5\x53\xfa\x18\xbd\xab\x9f\xc7\xa7\xcd\xa6\x4b\x7c\x8f\x2f\xa0\x0d\xc6\x2f\x69\x05\xb9\xc7\x5e\xf6\x5d\x10\x19\x0a\xa6\x5f\x97\x25\xfd\x67\x2f\xdb\xb7\x93\xb4\x25\x42\x58\xc6\x3e\x3c\x25\x00\x59\xfa\x3e\xc2\x52\x93\x78\x00\xcf\xcf\xb9\x91\x6d\x28\x2b\x4a\x55\x73\x65\x6c\xc4\x7d\xa7\x70\x9a\x60\x10\x21\xca\x8a\xa1\x30\xc0\x53\x32\x22\x8f\x54\xa6\xdc\xe4\x47\x8f\x44\xf1\xa6\xa1\xab\x74\xeb\x88\x9a\x47\x2a\x41\x8b\x24\xd3\x3c\x03\xe1\x60\x0f\x3c\x72\x4f\x3c\xcb\xa8\x67\x8a\x02\x49\x7c\xcb\x68\x64\x1b\xf1\xa9\x0c\x2c\x63\xdf\xc5\x92\x40\xe5\xc7\x1f\xe6\x3f\xb8\xf0\xc3\xb0\x72\x90\x69\x32\xe3\x81\x54\x5e\xc0\x32\xf6\x93\xc7\x83\x48\x52\x92\x04\x12\xd0\x1f\x50\x36\xf4\x58\x65\x35\x05\x44\x29\xa4\xe6\x08\xca\x67\xc6\x45\xf7\x7c\x38\x80\x1b\x23\xe9\x78\x9b\x63\x4f\xf7\xd2\x6b\x4e\xac\xac\xc4\x2d\x47\xc8\x0e\x0e\xc8\x0a\x96\xb2\x74\xba\xda\x07\xe9\xa3\x96\x83\x33\xe3\xca\xdb\x33\x28\xb7\x0d\xcd\x4b\x6e\x30\xe3\xe9\x97\x66\x63\x59\x4e\xbb\x7c\xfc\x98\x3e\x76\xd6\x81\xa0\xdc\xd9\x0d\xe3\xeb\x3a\xc6\x82\x78\x1e\x7f\x80\xf2\xd7\xdd\x90\xec\x02\x52\x46\x88\xf6\x6e\x48\x67\xdb\x91\xce\x76\x43\x7a\xbb\x1b\x52\xc8\xee\x18\x7f\x60\x1b\x26\x38\x9e\xc6\xe2\x18\x24\xc0\x8e\xd2\xe0\x3d\x10\x64\x42\x04\x51\x81\xc5\x44\xf0\xb9\x8e\x0a\x82\xa6\x69\x06\x12\x3b\x77\x6a\xb9\x9b\x78\xfc\x41\xf9\x36\xf3\xf7\x90\x04\x7a\x75\x33\x8f\x6a\x8d\xc3\x93\xc3\x9a\x39\xe3\x0f\x48\x72\xa4\x62\x13\x2c\x08\x92\x0f\x1c\xa9\x95\x9b\x4d\x03\x44\x19\x72\xb9\x44\x01\xf1\xb1\xc0\x92\xb8\xe8\x3e\x0a\x82\x50\x14\x54\xa9\x7a\x1d\x88\xdd\x13\xa1\xba\xa7\xd6\x43\x27\x70\x73\x03\x46\x1d\x2c\x0b\x8c\x06\xdc\xde\x46\xba\x3e\x23\xac\xe0\x34\xa0\xa6\x0b\x26\x34\x63\x2c\x9d\xb3\x81\x55\xcd\xbc\x53\xb8\x27\xa2\x6e\xed\x1b\xf5\x03\xf5\xd4\xb0\xf6\x8d\x46\x24\xd7\x3d\x15\x5f\x79\x40\xe6\xbe\x5c\xc0\x84\x12\xcf\x0d\x54\xbc\xa2\x9a\x47\xf1\xd5\x1f\x44\xf0\x20\x1a\x81\x0b\xd8\xdf\xa7\x96\xf1\xb4\xa7\xaa\x6f\x7e\xb9\x5d\x7e\x04\xfa\x53\xf4\xda\x88\x5f\x7f\xfc\xf1\x20\x02\x76\x79\x4a\xa7\x6e\x4d\x6f\xad\x5a\x5c\xc1\x48\x0e\xaf\xb6\x42\xa9\xbf\x80\x12\x09\x04\xfd\x01\xc6\x93\x1e\x8f\xde\x2e\x13\xa9\xac\x49\xe6\x65\xce\x1a\x45\xce\x32\x84\x36\x56\x84\x66\xa4\x1a\x8f\xbf\xbf\x5f\xaf\xed\xe9\xe1\xeb\x7a\xf8\x9f\x21\x79\xd7\xe4\x1c\x1c\x6c\xa7\x26\x9e\xab\xfa\x77\x22\xff\xb4\x33\x72\xa3\x88\x9c\xca\x39\x55\x93\x65\xa9\x24\x88\xcf\x83\x66\x33\x20\x32\xf4\xb7\xf9\x7a\x5d\x99\x06\x0d\xba\x87\x8a\xec\x20\xf4\xb5\x7b\x76\x54\x84\x5c\x8e\x91\x57\x68\xcd\xa6\xf1\x94\x84\x5b\xcb\xe2\x50\xcd\x66\x38\x0e\x99\x0c\x0b\x6b\x43\xb4\x38\xbb\x54\x44\xcb\x39\xf6\xa5\x19\x15\x05\x55\x8f\x06\xb2\xea\xc6\x5e\x58\xaa\x65\x6e\x53\x0b\xf8\xe9\x27\xbb\x7b\x56\x72\xc9\x38\x09\xe2\x8d\x55\x58\x62\x46\x63\x9a\x60\x3c\x65\xa3\xbf\x25\xcc\x55\x62\x20\x88\xb2\x50\x27\x8a\xbd\xa9\x32\x4a\x02\xf3\xd0\x93\xd1\xe3\x8e\x90\x28\x20\x4e\x28\xa8\x5c\xbc\x06\x76\x24\xf7\xe0\x35\xa0\x7d\xc1\x7d\xae\x72\xb6\x57\xc0\x1e\x63\xe7\xce\xe7\x42\x7e\x37\xe1\x28\x10\xce\x2b\x4c\xe3\x8e\xb0\x3b\x4f\xe5\xae\xf8\x3b\x4e\xe7\xae\xf0\xbb\x4e\xe9\xae\xf8\xbb\x4d\xab\xb2\xce\x95\x0d\x1b\xa9\xc1\x67\x42\xf7\x4d\x86\x1c\x14\x88\xc9\x04\xfa\xca\x05\xc0\xea\x1d\x6d\xd2\x8a\xd5\xb0\xd9\x48\x1d\xb0\x2f\xd1\x1d\x59\x00\x76\xef\x01\x21\x41\x9c\x7b\xf5\x1a\x00\xd2\x7f\x74\x9a\x01\xe9\x53\x35\x12\x80\x5a\xf0\xe1\xb8\x55\x3b\xac\x7d\x6a\xd4\x3f\xb5\x6a\xef\xcf\x8e\xce\x3e\x81\x7d\x72\xd4\x3a\x6d\x9c\xd6\x8e\x8e\x6b\x67\x87\x1f\x3e\x1c\xc1\x7b\xbb\x55\x6b\x7d\x38\x3d\x3c\x6b\xbc\x3f\x3c\x3b\x6d\x9f\xc0\xd9\xfb\xe3\x46\xa3\xfe\xf7\xf7\x8d\xd3\xbf\x37\x8e\x6b\x1f\xda\x9b\xc9\x01\xc7\x23\x98\x6d\xa9\x8b\x14\x65\xdd\x95\x3a\x84\x49\x1e\x14\x5c\xa9\x6a\x92\x3a\xd2\x45\x38\xaf\xea\x3e\x55\xb7\x54\xca\xaf\x9d\xf9\x84\x0e\x6e\x6f\x3f\xe6\x57\x94\x42\x5e\x04\x8b\x70\x8e\xa2\x74\x12\xcd\x31\xc3\x53\x22\x54\x76\xf1\x94\x5b\x81\xf2\xa4\x97\x8d\x38\xbd\x04\xca\x02\x89\x3d\x0f\x8c\x42\x9a\xa9\x41\x43\x49\xbd\x60\x15\x8d\xc5\x59\x4f\x46\x57\x62\x8e\x4c\xe2\x13\x4f\x73\x13\xeb\xc8\x8d\x2a\xb8\x2d\xe9\x5c\xc0\x7e\x94\x02\x43\x2f\x5a\xaa\x02\x1d\x51\xd8\x4c\x12\xe1\x0b\x1a\x10\xb8\xa0\x2c\x7c\x84\xf7\x80\xe0\x9f\xc6\x18\x07\x04\x0b\x67\x56\x52\x0f\xa1\xf0\xac\x0d\x2a\xaf\x80\xcd\xf7\x66\xa6\xb1\x4a\x97\x54\xe8\x37\x27\x72\xc6\x5d\xcb\x17\x94\x2b\xd7\x50\x22\x0c\x8f\x3d\xe2\x5a\xf5\xd2\xd4\x9f\x3a\x33\xe2\xdc\x59\x35\xf5\x78\x47\x16\xd6\x84\x7a\xa4\x69\x9a\x7a\x22\xfc\x3b\x6a\x0a\x7f\x8e\xa6\xfe\xd4\xec\xf7\x2e\xd1\x79\xef\x1c\x7d\xb6\xbf\x21\xbb\x67\x5f\xa0\xf7\xa9\x9a\x6e\xe0\xfa\xee\x24\xc8\x31\xbd\xd2\xf8\x88\x75\xb0\xe0\xee\x24\x48\xb8\x01\x6b\x93\x09\x67\xac\x66\x11\xce\x4d\xad\x11\x99\x42\x44\xbc\xf7\xe8\xf1\xe4\x78\x74\x7c\x64\x26\x1c\x81\x05\x2b\x9e\xc0\x82\x5a\x4a\x23\xf1\x02\xb2\x0a\x42\x74\xca\xe5\x42\x51\xdb\xcc\x31\xbe\x53\xfa\x31\xbf\x73\xa9\xd8\x58\x9b\x42\xcc\xef\x01\x4d\xd6\x9b\xbc\x8d\xb8\xde\x08\xfc\x26\x9b\x8c\x3f\x3f\x83\x14\x21\x29\xc8\x6f\xad\x9f\xb6\x8e\xbc\x24\x7d\x8c\x03\x14\x85\x3b\xb1\x1a\xe9\x46\x68\x11\xce\x53\xed\x28\xd8\xc9\xe6\x09\x4f\x44\x33\xa1\xeb\x46\x2a\x66\xc4\xfb\xcb\x44\xff\x32\xd1\xbf\x4c\xf4\xff\x91\x89\xc6\xfb\xb3\xcd\xe6\x3d\xf6\xa8\x5a\x5c\xb7\xa5\x40\x49\x7d\xba\xa3\x1b\xdb\x89\xde\xd4\x2e\x67\x6c\x3a\xae\x1f\xc5\x49\xbd\xa5\xab\x92\x7d\xdd\xd8\xa6\xec\x76\xbc\x1b\x5d\x5c\xe7\xb5\xf5\x26\x23\xe4\xf6\x0d\x8b\xb0\xc6\x7e\xd2\x0c\x25\xfb\x07\xf0\x0c\x2a\xca\xab\x04\x26\x32\x47\x66\x05\x9e\x01\x3f\xdc\x01\x3a\xbb\x87\xca\x93\x2f\x28\x93\x60\x34\x96\x95\x83\x95\xcd\xde\xdc\x80\x51\xd8\x8f\x86\xbf\x59\x60\x14\xc6\x82\xdb\x5b\x45\x4b\x61\xff\x10\x83\x4b\x27\x7a\x7b\x44\x42\xd2\x30\x21\x09\x7b\x82\x60\x77\x91\xc8\x28\x3a\xab\x98\xf1\x40\xbe\x03\xdf\x23\x38\x20\x2a\x32\x8c\xfd\x0c\x95\x3a\x95\x94\x62\x01\x78\x8a\x29\x2b\x2b\x25\x59\x97\x57\xaa\x36\xcb\x54\x89\xb2\xd3\x17\xa3\x6d\x9b\xbd\xb8\x9a\xb2\x69\x42\xa3\xf1\x94\xdf\xd8\x5e\x1a\x4f\x05\x51\x2c\x8b\xb3\x8a\xdd\x79\x46\xfc\x7a\x63\x6e\x4d\x7c\x89\xcc\x2b\x37\x23\x74\x5b\x59\x09\xbe\x9e\x0a\xde\x58\xe3\x2d\xef\x9b\xff\xd4\x2f\x3f\x15\x1c\xf3\x72\x07\x96\xde\x96\x57\xb3\xbf\xda\x9d\xce\xef\x49\x17\x85\xe5\x48\x6f\x03\x72\x41\x20\x4b\x3d\x89\x71\xe1\x77\x34\x2f\xff\x5f\xf9\xfd\x3e\xaa\xde\xee\x40\xd2\xdb\x72\xbc\xd9\x9e\xd5\xab\x68\x15\x4d\xd5\x6a\x0f\x86\xdd\x76\xb7\x09\x82\xcc\xf9\x7d\x7c\x1a\xe9\x51\x46\xe0\x61\x46\x54\xe6\xa3\x95\x5b\xb7\x8c\xcf\x8c\xfe\x45\x7d\xe5\x31\x29\x53\x56\x91\xd1\x0e\x30\x6f\x7f\xac\x40\xc5\x7c\x77\xdd\x7b\x67\x3e\x4d\x89\x54\x28\x1f\xf5\xbe\x8f\x71\x08\xff\x03\xe6\x6f\xf5\x5a\xd5\x54\x9a\x91\xbc\x7e\x68\x54\xeb\xc7\x27\xf9\xb2\xf7\x8d\xea\x7e\xfd\xe6\x18\x7d\xb8\x7d\x6e\xdc\xd4\xd4\x9f\xc3\x9b\x5a\xfd\xf6\xa0\x6a\x1e\x40\xa2\x79\x87\x1f\xf5\xd6\x68\x6d\xb9\xac\xfc\x6b\x93\x69\x4c\x09\x23\x42\xef\xe2\x68\x56\xf5\x72\xbc\xab\x8d\xa8\x30\x25\x5e\x57\x82\x45\x20\xc9\xdc\x8d\xff\x9a\x31\x52\x55\x65\x50\xd4\x21\x55\xd7\x54\xde\x24\xbf\xd8\xfc\x69\x97\x48\x67\xb5\xc1\x55\x6e\x06\x51\x71\xb4\xcd\x67\xb3\x7b\x2a\x38\x9b\x13\x26\xad\x72\x42\xdb\xe9\x79\xbf\x7b\xdd\x1b\xb5\xfb\x9d\x2f\x76\xdf\x42\xc8\x99\x0a\x1e\xfa\xc8\x15\x2a\x03\xb5\xa2\xb7\x49\x1c\xc5\x6c\x04\x50\x7f\xa3\xf3\xb4\x51\xab\x7f\x3e\xb0\x10\x1a\x73\x2e\x03\x29\xb0\x8f\xb4\xee\x69\x49\xad\x1d\x38\xe5\x1b\x29\x16\x54\x43\x95\x4b\x6e\xef\x53\x68\xc9\xb8\x4b\x10\xf5\xad\x8a\x11\xe9\x4f\xe5\x05\x2a\x07\xdf\x06\x43\xfb\x72\xd4\xeb\xb6\x07\x09\x99\x3e\x77\x51\x72\xec\x85\x7c\x2c\x67\xdb\x0f\xc5\x5e\x00\xbe\xb2\x87\x5f\xbb\xfd\xcf\x09\x28\x23\xf2\x81\x8b\x3b\xe4\x7b\xe1\x94\x32\xcb\x61\x14\x10\x72\x18\xd5\x11\x26\x4a\xc3\x57\x87\x51\x93\x11\x59\x75\xe3\xda\x31\x65\x51\x25\xf7\xa5\xae\x1c\x53\xf6\xc2\xa0\xed\xab\x94\x0b\xc7\x0b\x03\x49\x04\x72\x59\x60\x55\x8c\xcc\xf9\x68\x05\x32\x95\x5c\xa5\xf5\x56\xfc\x5a\xd5\x5e\xfa\x05\xf8\xd6\xf5\xf0\xd7\xff\x4a\x06\xc0\xa1\x9c\x71\x41\xff\xd0\x6b\x37\x9a\x73\x97\x58\x5f\xc9\x78\xc6\xf9\x9d\x1e\x80\x12\x26\x91\x83\x91\x8a\x09\xd7\x04\xa8\x82\x43\x07\x57\x1d\x21\xa3\xd1\xf6\x36\xab\x60\xab\xfd\xa5\x33\xe8\xf6\x53\x96\xb0\x7b\x4f\x03\x2e\x90\xcf\x85\xb4\x6a\x2f\x10\x7a\x6a\xf7\x87\x9d\xb3\xce\x69\x6b\x68\x27\x9d\x05\x97\x58\x12\xe4\x10\x21\xe9\x84\x3a\x58\x92\x40\x2f\x87\x8a\x58\x22\x64\x24\xe5\x7b\x2c\x4c\x8f\x8e\x13\x85\x52\x74\xbe\x30\x4a\xaf\xdb\x1e\x75\xae\xce\xfa\xad\xac\xe6\x50\x36\x11\x58\xcd\xaa\xc4\x94\x11\x81\xe8\x1c\x4f\x89\x55\x31\x9e\x8a\xe7\xde\x3f\xbc\x35\x97\x15\xd3\xc7\x61\x40\x9a\x87\xd5\xfa\x0b\xe3\x9c\xd9\xad\xe1\x75\xdf\x1e\x9d\xb7\x86\xb6\x1a\x66\x42\xb0\x0c\x05\x41\x53\xcd\x44\x9b\x28\x4b\xee\x69\xbd\x8a\x58\x7a\x01\xea\xa2\x7b\x3e\xba\xb0\xbf\xd8\x17\x16\xba\xb7\x8e\xe2\x86\x8f\xc4\x19\x48\x2c\xa4\x55\x78\x4d\x6f\xb5\x24\x11\x89\xb1\xd1\x39\xac\x8a\x0b\x26\xbf\xaa\x28\x5a\xd9\xaa\x26\x6b\x26\xab\xd2\x44\x8f\x57\x25\x2b\xd5\xcb\x50\x91\xd5\x8f\x4c\x71\x61\xf2\x57\x35\xfa\x94\xbd\x50\x96\x9f\xc4\x0c\x61\xdd\xb6\x3d\xea\xf4\x0a\xa5\xb9\xa9\x58\x15\xa7\x62\x5d\x15\xf5\x6d\x7d\x1a\x3f\x3a\xed\x5e\xf6\xae\x87\x6a\xe2\xa3\xeb\x15\x1a\x50\x0b\xba\x92\x04\xed\x7f\xea\xba\xeb\x35\x14\xaf\xb3\xda\xc5\xe5\xd6\x56\x11\xb2\x6d\xf1\x9a\x08\xd3\x60\xb2\xbc\x61\x2f\x2c\x1a\xce\x91\x1e\xb8\x98\xcc\x39\x43\x82\x78\x1c\xbb\x2f\xb6\x8c\x92\x01\xb5\x18\xc7\xc0\x2f\xb6\x16\x24\x50\x8a\x94\xb6\xcd\xd2\x9d\x3f\x2a\x59\xcb\x20\xf2\xa5\x71\x18\x93\x2f\x8c\x16\x83\x7c\x99\x08\x99\x1a\xe5\xbf\x39\xdd\x2a\x15\x55\x07\x6a\x89\x00\xc9\x21\xf6\x7c\xd9\x20\x35\xb8\xa3\xfe\xc8\xc1\x56\xba\x7d\x90\x5c\x1f\xd1\x1d\x11\x9a\x11\xcf\x87\x67\x98\x0a\xe2\x03\xfa\x1d\x2a\xbf\xfd\x33\x78\x8b\x90\x4b\x03\x47\xa5\xcb\x0b\x24\xf9\x1d\x61\x28\x64\x01\x9e\x10\xa4\xc0\x94\x17\xbc\x27\x22\xf2\x3a\x94\xb3\xca\xfa\x89\x23\x79\x54\x2e\x2d\x1d\x7a\x27\xb8\x24\x0f\x8b\x1c\x69\x81\x58\xdd\x1b\x8c\x61\xf7\xb3\x7d\x05\xc6\x65\x4b\x2d\x02\x9d\x1e\xec\x34\x00\xdc\x20\x44\x1e\x7d\x22\xa8\xf2\x25\xd8\xd3\xce\x4d\x70\x0f\xf9\x1e\x66\x71\x08\x91\xd7\x80\xef\x20\x02\x8c\x98\x59\x30\xd6\x6f\xe3\xa4\x77\x27\xf4\x1c\xaa\xf4\x36\x4a\x60\xaf\x55\x59\x33\x4a\x00\x6a\x10\x9d\xa7\x45\xe7\x68\x37\x08\x45\x99\xac\x7a\x45\xd8\x75\x45\xb2\x2b\x50\xaf\x55\xeb\xb5\x6a\xad\x5a\x6f\x9e\x9c\x9c\xd4\xa2\xa4\x58\xe7\xc4\x08\xf9\x77\x53\x14\x5d\xb1\x81\xf5\x9b\x36\xb7\x0a\xd3\x25\xe3\x70\x7a\x9b\x1f\x70\x95\x31\x66\x96\x58\xa8\x1f\x7f\xa8\xaa\x7f\x6a\xb4\x4c\x32\x59\xaf\xd6\xeb\x55\x55\xa0\x97\x01\x4d\x5d\x40\x25\x17\x0b\x28\xdc\x7a\x5a\x1f\x2d\x27\xbc\x93\xa3\xbf\x93\xc3\xe3\xea\xd8\x39\x3a\x3e\x3e\x3a\xa9\xe1\xf1\x71\xa3\x7e\x78\xf2\x1e\x10\x9a\x63\x45\x03\xac\xd8\x3c\x3e\x3a\x3a\x54\x68\xf9\x59\x5a\xc7\xd7\x5b\xe4\x99\x62\x25\xe0\x65\xa9\xa4\x02\x82\xd4\x74\x54\x48\x1a\xc7\x97\x3c\x40\x71\x64\x1e\x67\xb2\xe9\x1d\x30\x63\xbf\xba\xd6\xe4\xcd\x9b\x28\xd2\x34\x3a\xf1\x95\x8e\x64\x0f\x2e\xee\xb4\x21\x1b\x3e\x83\x72\x74\x8b\x46\xd9\xa5\x4b\x24\x71\xa4\xca\x0a\xc2\xc7\xe8\xba\x1e\xcf\xde\x1d\x59\xe1\xc4\x97\x47\xa2\x43\x85\xd5\x69\x6e\x72\x13\x0b\xfb\x32\x2d\x2b\x5e\xca\xaa\x00\x5a\x00\x42\xd8\xf3\xf8\x03\x0a\x99\x8a\x67\x08\x93\x3a\x34\x70\x2b\x69\xaf\xc2\xcd\xad\x8a\xb5\xaa\xca\xdd\x7d\x7b\x51\x0a\xd7\x9f\xae\xaf\x86\xd7\xa3\xd3\x6e\xdb\xbe\x6a\x5d\xc6\xd7\x41\xe2\x8b\x12\xd1\xb1\xc3\xb3\x98\x11\x6f\x9d\xfe\x45\x38\xff\x33\xfa\x03\x22\xb9\x2f\x2d\x3e\x0e\xb8\xa7\x82\x2b\xab\xa6\x43\xe0\x64\xb3\x66\x3b\x27\xe8\xdf\xe1\x24\x01\x49\xe6\x35\x66\x22\x73\x9b\xa4\x30\xa7\x21\x13\xc4\xe1\x53\x46\xff\x20\x6e\xbc\x2d\x19\xcd\x67\x73\x35\x8b\xef\xc0\x09\x85\x20\x4c\x7a\x0b\xe0\xcc\x5b\x40\x10\xfa\xda\x21\x46\xb2\x89\x4e\xc9\xf5\x0c\x97\xb3\x83\xea\x2b\x26\xfa\xc9\xc7\x42\xdf\x28\xfa\x25\x56\x92\xad\x59\xf9\x16\x02\xc0\xc8\x4a\x20\xd6\x3e\xe2\x46\xa3\x19\xd1\xad\x46\x65\x1c\x7a\x9c\xd4\x3a\x1e\x66\xd4\x23\x70\x03\xc6\x1e\xa0\xa9\x84\x1a\xdc\x7e\xcc\x5e\xac\x88\x6f\x39\xd5\x73\x37\x9c\xd4\x4f\xfb\x91\xfc\xf5\x1b\xf5\x8b\x2f\x4f\xea\xda\x5c\x65\xe6\xd6\x8e\xfa\x29\xc7\xb0\xb5\xb7\xaa\x7c\xa9\xb3\xb6\xfa\xad\xbd\x57\xc7\x66\x5b\xba\x6b\x07\xb6\xde\x7d\x75\xdf\x53\x37\x78\x09\x21\x76\x9f\x2f\x61\x64\x43\x8b\x2d\x28\x39\xe7\xbb\x8e\xa5\xbc\x0d\x03\xe3\xa9\xf1\xe3\xe3\x32\x76\x35\x7f\x8b\x15\xb8\x91\x59\xbb\x7f\x43\xf9\xed\xc1\x1c\x39\x99\x1b\xa5\x65\xa3\x51\xde\xd8\x28\x98\xd1\x89\x5c\xab\x59\xae\x9f\x13\x24\xbf\xd5\x8d\x40\x28\xb3\x34\xfc\x00\xb5\x82\x50\x1f\xa6\xf4\x9e\x30\xbd\x77\x90\xe3\x6f\x7d\xec\xe5\xcb\xb2\x89\xd6\x84\x57\x11\x4b\xb4\x7a\xbf\x9e\x44\xe2\xe5\x4c\xad\xe0\x24\x08\xf6\x3b\xbd\x66\xaf\xdb\x1f\x1e\xe4\x44\x13\xb5\xd9\x59\x2a\x7a\x25\x7d\x15\xa1\xe8\xc8\xe6\xf5\x64\x12\x85\x00\x59\x09\xe8\x92\x9d\x05\x10\x07\x25\xaf\x22\x82\x64\xf1\x78\x35\x21\x24\x11\x55\x56\x0c\x71\xd9\xce\x82\x88\x37\x88\x5e\x45\x10\xf1\x96\xe5\xab\xc9\x41\x67\x2e\x05\x5f\x11\xf3\xb3\xb3\x1c\x8a\x41\xe9\xab\x08\x64\xed\xbe\xff\xab\x89\x26\x0a\xa9\x41\x73\x05\x99\x50\x3b\x2b\xaa\x22\xcb\x3b\xcb\x2c\xca\x3a\x5f\x47\x52\x99\x4f\x10\x5e\x4d\x48\x49\x6e\x46\x19\x95\xc9\x6e\x75\x6e\xdd\xd1\x45\x3b\x8b\x85\x48\xc7\x7d\x2d\x93\x4a\x3e\x71\x78\x35\x99\xe8\x2f\x2a\x0a\x36\x15\x33\xb4\xb3\x20\x56\x49\xe5\xab\xc8\x22\xf3\x75\xc8\xab\x89\x23\xb9\xa2\x1a\xe7\xc6\x59\xa9\xac\xb8\xfb\x77\x0c\x67\x95\x8f\xae\xcb\x66\xc3\x37\x39\x2f\x6d\x3b\xbc\x3c\x96\x4e\x69\xd7\xc7\x88\x3e\x61\xca\x9d\x9d\x6f\xea\x3e\x7b\x8e\x76\x79\xd6\x01\x56\x9f\x68\x64\x7f\xd1\xd1\xd0\x4b\x90\x6f\xb7\x40\xc5\x32\xa7\x4c\x6f\x7b\x01\xf7\x25\xe5\xac\x09\x46\xbd\xbc\x0d\x4d\xe7\x3c\xc9\x8b\x9e\x68\x30\xf6\xf7\x55\x0a\xf2\x33\xd4\xe0\x3f\xa0\x0e\x4d\xa8\x41\x7a\xcb\x9b\x91\xdc\x17\x17\x51\x98\x9f\x4b\x48\x36\x24\x23\x71\xe3\x34\x20\x5f\x4b\x60\x60\x7b\x26\x00\xab\x64\x22\x7b\x71\x67\xad\x5d\x41\x40\xf0\x52\x66\x90\xc1\xcc\x6f\x1f\x6e\x6c\x59\xfc\x36\x2c\x49\x59\xbf\x87\x84\x0d\xf3\x04\x5b\xe6\xca\xe1\x73\x9f\x33\xc2\xe4\xba\x21\x14\x80\x73\x53\x96\xa9\x53\x1e\xf8\x59\xe5\x6b\xcf\x85\xa4\x6c\xfb\x57\x1a\x9b\x08\xc1\x4e\xac\x34\xf1\xe4\x16\x61\x56\x1f\x62\xe8\x2b\x9c\xfa\x76\xa9\xce\x94\xff\x37\x00\x00\xff\xff\xad\xc9\x90\xbc\xb8\x3a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xbd\xfd\x7f\xda\x38\xb6\x30\xfe\x3b\x7f\xc5\x59\xc7\x1d\x92\x4e\x8c\x21\xed\xf6\x85\xae\x7b\x87\x06\xd2\x65\x9b\x86\x7c\x80\x4c\xb7\xdf\x24\xc3\x35\xb6\x00\x6d\x8c\xed\xb5\xec\xa4\x99\x34\xf7\x6f\xff\x7e\x8e\x24\xdb\xb2\x0d\x34\x74\x1f\xee\xf3\xb4\x9d\x09\xe8\xe5\xe8\xe8\xe8\xe8\xe8\xbc\x49\xd9\xfb\x0b\x98\x09\x8b\xcc\x29\xf5\x4d\xe2\xdf\xc2\xd4\x66\x8b\xda\xde\x1e\x1c\x07\xe1\x7d\x44\xe7\x8b\x18\x8e\x9a\xad\xb7\x30\x5a\xd8\xfe\x7c\x61\x53\xf8\x07\xf5\xe7\xdd\x24\x80\xbe\x3f\x0b\xa2\xa5\x1d\xd3\xc0\x87\x31\x71\x16\x7e\xe0\x05\xf3\x7b\x70\x82\xc6\x21\x9c\xc6\x6e\xa3\xb6\xb7\x87\x60\x4e\xa9\x43\x7c\x46\x5c\x48\x7c\x97\x44\x10\x2f\x08\x74\x42\xdb\x59\x90\xb4\xe6\x10\x7e\x27\x11\x43\x28\x47\x8d\x26\xec\x63\x03\x4d\x56\x69\x07\xef\x10\xc4\x7d\x90\xc0\xd2\xbe\x07\x3f\x88\x21\x61\x04\xe2\x05\x65\x30\xa3\x1e\x01\xf2\xcd\x21\x61\x0c\xd4\x07\x27\x58\x86\x1e\xb5\x7d\x87\xc0\x1d\x8d\x17\x10\xe7\x03\x20\x26\xf0\x55\xc2\x08\xa6\xb1\x4d\x7d\xb0\xc1\x09\xc2\x7b\x08\x66\x6a\x43\xb0\x63\x89\x34\xff\xb3\x88\xe3\xb0\x6d\x9a\x77\x77\x77\x0d\x9b\x63\xdc\x08\xa2\xb9\xe9\x89\xb6\xcc\x3c\xed\x1f\xf7\xce\x46\x3d\xe3\xa8\xd1\x94\xbd\x2e\x7c\x8f\x30\x06\x11\xf9\x77\x42\x23\xe2\xc2\xf4\x1e\xec\x30\xf4\xa8\x63\x4f\x3d\x02\x9e\x7d\x07\x41\x04\xf6\x3c\x22\xc4\x85\x38\x40\xac\xef\x22\x1a\x53\x7f\x7e\x08\x2c\x98\xc5\x77\x76\x44\x10\x55\x97\xb2\x38\xa2\xd3\x24\x2e\x10\x2d\xc5\x91\xb2\x42\x83\xc0\x07\xdb\x07\xad\x33\x82\xfe\x48\x83\x0f\x9d\x51\x7f\x74\x88\x40\xbe\xf4\xc7\x7f\x1f\x5c\x8c\xe1\x4b\x67\x38\xec\x9c\x8d\xfb\xbd\x11\x0c\x86\x70\x3c\x38\xeb\xf6\xc7\xfd\xc1\xd9\x08\x06\x27\xd0\x39\xfb\x0a\x9f\xfa\x67\xdd\x43\x20\x34\x5e\x90\x08\xc8\xb7\x30\xc2\x19\x04\x11\x50\x24\x27\xe1\xab\x08\x23\x42\x0a\x28\xcc\x02\xb1\x8e\x2c\x24\x0e\x9d\x51\x07\x3c\xdb\x9f\x27\xf6\x9c\xc0\x3c\xb8\x25\x91\x4f\xfd\x39\x84\x24\x5a\x52\x86\xcb\xca\xc0\xf6\x5d\x04\xe3\xd1\x25\x8d\x39\xbf\xb0\xea\xbc\x1a\xb5\x1a\x23\x31\x18\x3d\x92\x04\x10\xd2\x90\xcc\x6c\xea\xd5\x6a\xc3\xc1\x60\x6c\xe9\xfb\x89\x8f\x95\xc7\xdd\xf3\xce\xf8\xef\xf0\xcb\x2f\xe0\xb8\xa0\xef\xbb\x34\xf2\xed\x25\x01\x4d\x7f\xf8\xd0\x19\xfd\x7d\x32\x1a\x5c\x0c\x8f\x7b\x97\xcd\xeb\x47\xed\x00\x1b\x85\x77\xee\x41\x0d\x5b\x22\x90\x5a\xb7\xf7\xe1\xe2\xa3\x35\xb3\x3d\x46\x6a\xa7\xa3\x0f\x93\x6e\x7f\x34\xb6\x6a\xf8\xff\xc9\xef\xbd\xe1\xa8\x3f\x38\x93\xdf\x3e\x77\xfe\x31\x18\xe6\x65\x9d\x63\xa4\x97\x55\x3b\x1e\x7c\x3e\x1f\x9c\xf5\xce\xc6\x56\x2d\xab\x3b\x1b\x74\x7b\xfd\x73\xab\xd6\xff\xdc\xf9\xd8\x9b\x0c\x7b\xe7\x83\x51\x7f\x3c\x18\x7e\xb5\xdc\xc0\xb9\x21\x51\x83\x06\xe6\x4d\x68\xdb\xac\xd6\xed\xfd\xde\x3f\xee\x4d\x3e\x0f\x2e\xce\xc6\x23\xab\x56\xdb\x03\x27\xf0\x91\x11\x49\x04\x51\xe2\xc7\x74\x99\x53\xb3\x76\x3c\x38\x1b\x77\xfa\x67\xbd\xe1\x64\x78\x71\x36\xee\x7f\xee\x49\x70\x79\x45\x37\x43\xaf\xd5\x78\xd1\x68\xaa\x15\xc7\x83\xb3\x93\xfe\x47\xcb\x24\xb1\x63\x66\x63\xb8\xf8\x71\x46\xe7\x8d\x38\x58\x7a\xb5\xe3\x61\x7f\x32\x1a\x1c\x7f\xea\x8d\x2d\x33\x4a\xfc\x52\x33\xf9\xb1\xc1\x02\xe7\x06\x11\xbd\x49\xa6\xc4\x23\x71\x8e\xde\xa7\x8b\x0f\xbd\xd3\x9e\x42\xb5\xe3\xd3\x8b\xd1\xb8\x37\x9c\x74\xcf\x46\x56\x56\x7b\xfe\xe9\xa3\x55\x3b\xe9\x75\xc6\x17\xc3\xde\xe4\x63\x67\xdc\x1b\x59\xb5\xce\xe9\xe9\xe0\xcb\x64\xf4\xa5\x73\x2e\xd7\x21\x6d\x2c\x91\x5e\x55\x38\x39\xe9\x9f\xf6\x2c\xf3\xd6\x8e\x4c\x8f\x4e\x4d\x89\x4d\x3a\x9f\x7b\x7b\xe9\x65\x1d\xba\x38\xa7\x61\x0a\x6c\x5d\x97\x7f\xb1\xc0\x4f\xe7\x65\x84\x51\xf0\xed\x3e\x9f\x5a\xff\xfc\xf7\x91\x44\x0d\x3f\x4e\x3e\x0f\xba\x17\xa7\xbd\x91\xa5\xd1\x70\x72\xcb\x80\xff\x7f\x12\x45\xf2\xc3\x5d\xf6\x89\x2d\xb4\x42\x07\x8e\x83\x58\x83\x65\xe0\x26\x1e\x61\x86\x17\xd8\x6e\xc3\x35\x69\x78\xcb\x1a\x88\x7c\x8a\x82\xed\x2e\xf3\xf1\xff\x31\xe8\x9f\x61\xdf\xf1\x70\x70\x3a\x39\x3f\xed\x9c\xf5\xac\x5a\xff\xac\x9f\x11\x88\x43\xc4\x5e\x91\x4f\x62\xc2\x4c\x09\x60\xa2\x52\xa3\xb6\x07\xa1\xed\xdc\xe0\x6e\xcc\xe0\x0e\x46\x93\x61\xef\xb4\xd7\x19\xf5\x04\x52\x01\x33\x22\xe2\x11\x9b\x91\x5a\xe7\x7c\x2c\x37\xce\x48\xd4\xd9\x61\x6c\xb2\x20\x89\x1c\xc2\x1a\x1e\x65\xb1\xda\x62\xd2\xed\x0f\x57\xb7\x6a\xb8\xb5\xaf\x17\x9f\xc5\x16\xc8\x5b\xdd\x27\xcb\x46\x44\xc2\x80\x35\xdc\xda\xf9\xa7\x8f\x93\xcf\x1f\x87\x38\xa1\xd1\xb8\x73\x7a\x3a\x19\x9c\xe3\xc6\x1a\x65\xdb\x69\x32\xfa\xfa\xf9\xc3\xe0\xd4\xaa\x9d\x0e\x8e\x3b\xa7\x08\x69\xd2\xe9\x76\x87\x56\xad\xf7\xcf\xf1\xb0\x73\xfe\xe9\xe3\xc8\x12\x40\xfa\xc3\xe1\x60\x68\x2d\x69\x14\x05\x11\x6b\xd8\x1e\xbd\x4f\xfc\x86\x13\x2c\x91\xa4\xa5\x05\xfd\xfb\x78\x7c\x3e\x39\x1f\x0e\xfe\xf9\x55\xc2\xc2\x82\x51\xa1\xe4\x6c\xa0\x7c\xc5\x21\xf8\x97\xde\xd9\xef\x15\x6a\x73\xd8\x0d\xe2\xdf\xca\x36\xdd\xe1\xe0\xbc\x7f\x66\xf1\x1d\x2e\x38\x49\xac\x2c\x12\x4c\xb4\xc8\xd9\x00\xe9\x65\x87\x31\x6f\xd0\x70\xcd\xb7\x7f\x55\x7a\x71\xca\x89\x0e\x9f\x3b\xc3\x4f\x96\x96\x4d\x83\xc4\x78\x86\xf0\xa6\x1a\xce\x2e\x22\x73\x14\xfd\xca\x04\x87\xbd\x8f\xfd\xd1\x78\xf8\x95\xd3\xbc\x26\x77\x40\xb7\xd3\xfb\x3c\x38\x2b\x30\x8d\x90\x20\xa6\x6b\x93\x65\xe0\x8b\x3d\x90\x6e\x97\xde\x70\xac\x2c\x99\x6c\xe8\x90\x28\xc6\x55\x53\x25\x4b\xb1\xa1\x2a\x35\x64\xe3\xda\x1e\x90\xd8\x71\x73\xec\x7a\xe3\xe3\xee\x04\x05\x64\xe7\xbc\x3f\xea\x0d\x7f\xef\x0d\xbf\x76\x3e\x9f\x56\x08\xbb\xb4\x7d\x3a\x23\x2c\x16\x0c\x6d\xd8\x21\x65\x24\xba\x25\x91\x60\x68\x0e\xe4\x07\xfd\x70\xd8\x94\xfd\xf7\x40\x30\x26\x78\x74\xda\x60\x8b\x5a\x23\xfd\x50\x73\x82\xe5\xd2\xf6\xdd\x76\x9b\x7c\xa3\x2c\x66\xfb\x07\xf0\x50\xc3\x83\x5e\x96\x83\x71\x0b\x9a\xfe\x9b\x06\xef\xc1\x74\xc9\xad\xe9\x27\x9e\x07\x47\xef\x7f\x69\xd5\x1e\x0b\x7d\x89\x93\xf5\xd4\xf9\xa9\x82\x87\x8d\x80\x84\x7f\xbd\x60\xde\x6e\xbb\x24\xf4\x82\x7b\xe8\x82\xfe\x5b\x56\x41\x6e\x6d\x4f\xfd\x1e\x91\x38\x89\x7c\x5e\xfd\x58\xe3\x3f\xf6\xd4\xbe\xfd\xb4\x2d\x89\x22\x4b\xdf\x87\x87\x14\x80\x8a\xdf\x3b\x78\xe4\x28\x1e\xc0\xf7\xef\x85\x91\x7b\xa0\x91\x6f\xc4\xc1\xe6\x78\x92\x12\xf7\x10\x48\x14\xb5\x41\x27\x51\xa4\xe1\x84\x12\x66\xcf\xc9\x84\x7c\xa3\x71\x36\x9b\xe2\xe8\x82\x14\xbf\x1c\xf1\x2a\xde\x9a\x7f\xc2\x1e\xc0\x49\xa2\x34\x57\x40\x38\xb6\x07\x1e\xb9\x25\x9e\xa5\xb7\x94\x22\x16\x93\xd0\xd2\x8f\xd4\x46\xc1\x3c\x66\x96\xbe\xef\xda\x31\x81\xfa\xaf\xcf\x96\xcf\x5c\x78\x36\xae\x1f\x28\x4d\x16\x01\x8b\x7d\x7b\x49\x2c\x7d\x3f\xfd\x78\x20\x28\x15\x13\x16\x83\xf1\x27\x68\x3a\x1f\x4b\xc3\x25\x20\x28\x10\xf8\x8c\x40\x3b\xd1\x4f\x07\x1f\xc7\x23\xb8\xd4\xd3\x8e\xd7\x05\xf2\xf0\x5e\x5c\xa1\x94\xcc\x4a\x5c\x4d\x40\x76\x6c\x46\x72\xb0\xd4\xcf\x96\xab\x7b\x90\x7d\xc4\xbf\xc4\x59\x04\xa8\xca\xf9\xa0\x75\x75\x3e\x97\xc2\x60\xfa\xc3\x6f\xed\xa3\x47\x2d\xeb\xf2\xee\x5d\xf6\xb1\x5f\x05\x04\x5a\x7f\x3b\x18\x5f\xaa\x30\xee\x89\xe7\x05\x77\xa0\x7d\xd9\x0e\x52\xaf\x04\x49\x21\x62\x6f\x3b\x48\x27\xeb\x21\x9d\x6c\x07\xe9\xf9\x76\x90\x12\xff\xc6\x0f\xee\xfc\x15\x0b\x2c\x97\xb1\x3c\x06\x61\xb6\x83\x1c\xbc\x07\x11\x99\x91\x88\xa0\xd5\x30\x8b\x82\x25\x57\xf9\x59\xdb\x34\x59\x6c\x3b\x37\xa8\xcb\xce\xbc\xe0\x0e\xcf\x16\xf3\xdf\x09\x61\x5c\x75\x35\x5f\x36\x8f\x5e\xbc\x79\xd1\x34\x17\xc1\x9d\x11\x07\x06\x1a\x1e\x76\x44\x8c\xf8\x2e\x30\x50\x6f\xf7\xe7\xcc\xa0\xbe\xe1\x06\xb1\xc1\x48\x68\x47\x76\x4c\x5c\xe3\x56\x58\x38\x86\xb0\x98\xb0\x9e\x5b\x59\xb7\x24\xc2\xee\xd9\xee\xa1\x33\xb8\xbc\x04\xbd\x05\x96\x05\xfa\x11\x5c\x5f\xf3\xd2\x78\x41\x72\x2e\x14\x42\x03\x9a\xbc\x60\x46\x95\xcd\xd2\x3f\x19\x59\x0d\xe5\x3b\x85\x5b\x12\xb5\xac\x7d\xbd\x75\x80\x9f\x8e\xac\x7d\xfd\x48\xd0\x75\x0f\x8d\x27\x0f\xc8\x32\x8c\xef\x61\x46\x89\xe7\x32\x34\x46\xb0\xb9\x30\x9e\xfe\x24\x51\xc0\x78\x53\x54\xf5\xf7\xf7\xa9\xa5\x3f\xec\x61\xf5\xe5\x6f\xd7\x8f\xef\x80\xfe\x4d\x7c\x3d\x92\x5f\x7f\xfd\xf5\x40\x00\x76\x83\x0c\x4f\xde\x9a\x5e\x5b\x4d\x59\xe1\x93\x02\xbc\x66\x0e\xa5\xb5\x01\x8a\x20\x88\xf1\x27\xe8\x0f\x38\x85\x4b\x7a\xfd\x98\x52\xa5\x42\x99\xcd\x33\x3b\x2a\xcf\x2c\xfd\x23\xe1\x4a\x44\x15\xaa\xca\xf1\xf7\xf7\x5b\xcd\x3d\x3e\x7c\x8b\x0f\xff\x1e\xd2\xef\x47\xf8\xfd\xe0\x60\x3d\x36\x72\xad\x5a\x4f\x84\xfc\xb7\xad\x21\x1f\x95\x21\x67\x74\x96\x0d\x9a\xc8\xe5\x5c\x03\x6b\xb7\x19\x89\x93\x9c\xd5\xd4\xbd\xd2\x07\x8d\x57\x66\x8a\x23\xef\x81\x66\x1b\x24\x21\x17\xcf\x0e\x9a\xbf\x9a\x84\x9c\x43\x6b\xb7\xf5\x87\xd4\x96\x7a\xc4\xa1\xf6\x10\x55\xc9\x7f\xd8\x10\x28\x03\x1b\x66\x9e\x1d\xf3\xaf\x8c\xc6\x41\x74\x0f\x77\x0b\xea\x2c\x52\x0b\x88\x81\xed\x79\xbc\x9b\x1c\x9d\x81\x4f\x88\x8b\x07\x16\x8d\xeb\x8c\xd7\x04\xbe\x77\x9f\x9e\xea\xc8\x91\xc1\x6c\xe6\x51\x9f\x00\xf5\x59\x6c\x7b\x1e\xb7\x29\x0b\xd3\x6c\xb7\xed\x30\x6e\xb7\x39\x22\xd9\x94\x51\x48\x82\xe6\x92\x29\x5c\xc6\x51\xc2\x62\xe2\x5a\xf7\x84\x5d\x83\x5e\x54\x38\xa1\x61\xa2\x0a\xa0\x2b\x7a\x2f\xef\x1e\x2d\xc1\x98\x15\x8a\x51\x19\x52\xf4\x11\xae\x2e\x57\x1b\x08\x8d\xca\x70\x08\xaf\x57\x15\x0e\x54\x44\x88\x03\x76\x18\x83\xe3\x11\xdb\x5f\x53\x27\xd6\xa0\xbc\x92\xed\x76\x32\x4d\xfc\x38\xc9\xa6\x27\x37\x4a\x69\x32\xd7\xd7\xa8\x19\xe4\xda\xc9\x3a\x2a\x6d\xd0\x4a\x1c\x3b\x2e\xd1\x03\xfe\xf6\xb7\xde\xe0\xa4\x86\xa4\x94\x4e\x12\x3d\xd7\xcc\x4d\x81\x97\x09\xfa\x83\x6a\x5d\x3f\xc2\x12\x1d\x2f\x11\x41\x21\xe9\x08\xdf\x06\x45\xb9\x48\x60\x99\x78\xb1\xf8\xb8\x25\x48\x83\x11\x27\x89\x68\x7c\xbf\x0b\xd8\x82\xec\x6c\x17\xa0\xc3\x28\x08\x03\x46\xdc\x5d\xc0\x9e\xda\xce\x4d\x18\x44\xf1\x93\x11\x37\x58\xe4\x6c\x31\xc0\x8e\xc0\x6e\xbd\x94\xdb\xc2\xdf\x72\x39\xb7\x05\xbf\xed\x92\x6e\x0b\x7f\xbb\x65\xc5\xdd\xb9\x7a\xeb\xae\x94\x59\xc5\xed\xcc\x4a\x28\xe5\xad\xb9\x19\x0b\xf9\x77\xa3\x84\x25\x9f\x7c\x3e\x78\x59\x92\x19\x37\xe4\x1e\x6c\xf7\x16\x0c\x23\x22\xce\x2d\x7e\x65\x60\xf0\x1f\xdc\xde\x83\xec\x53\x43\x90\x01\x35\x2f\x78\xd5\x69\xbe\x68\x7e\x38\x6a\x7d\xe8\x34\x5f\x9f\xbc\x3c\xf9\x00\xbd\x37\x2f\x3b\xc7\x47\xc7\xcd\x97\xaf\x9a\x27\x2f\xde\xbe\x7d\x09\xaf\x7b\x9d\x66\xe7\xed\xf1\x8b\x93\xa3\xd7\x2f\x4e\x8e\xbb\x6f\xe0\xe4\xf5\xab\xa3\xa3\xd6\x5f\x5f\x1f\x1d\xff\xf5\xe8\x55\xf3\x6d\xf7\x3f\x12\xba\x7b\xe0\x92\x29\xb5\x7d\x74\x3d\x8b\xe3\x28\x27\x00\x3f\xd5\xd0\x8b\x2c\xf0\x85\x6f\xc4\xa7\xb6\x07\xb6\x68\x97\x84\x2c\x8e\x88\xbd\x2c\x9c\x53\x30\x4f\xa8\x4b\xc0\x0d\x08\x2b\xc9\x73\x31\xcc\xee\xe5\xb9\x34\xc8\xe4\x7e\xb3\xb4\x75\x3b\x51\x93\xca\x62\xb6\x33\x59\x42\x63\xee\x80\x46\xb5\xdb\xc5\x59\x4e\xf1\x18\x8d\xcc\x74\x6f\x4d\xc9\x2c\x88\x08\x4c\x13\xcf\x63\xe4\x9e\xa4\xd3\xd0\xab\x7e\x55\x30\xbc\x18\x5a\x2d\x9c\xce\x2f\xbf\xac\xc7\x25\x85\xac\xd5\xd6\xb0\xf3\xe6\x93\x48\x50\xb4\xb2\x99\x38\x9b\x72\xfd\x23\xa2\x53\xf0\x51\x2f\x8f\x08\xd9\x12\x48\x51\xa0\x6c\x07\x2c\x23\x31\xa2\x96\x7e\x7e\x5c\x03\xe9\x7f\x6b\x2f\x4b\xde\x5d\xb7\x85\xd1\x63\x02\xf3\x70\x8e\xea\x44\x71\xbb\x68\xba\xf4\xed\xa5\x7c\x0e\xfa\x43\xc9\xc9\xf7\x08\x73\x3f\x09\xe7\xda\x4e\xe5\xc2\xae\xc5\x00\xdf\x63\x62\xc3\x65\x21\x90\xfb\x64\x89\x01\x33\xe2\x8a\xa0\x4c\xc0\x0e\x81\x84\xc4\xc3\x4d\xc2\x6e\x68\x18\x12\x17\x35\x7d\x14\x06\x33\x1a\xb1\x18\xec\x68\x9e\x2c\x89\x1f\x63\x03\xe1\x68\x2e\x6e\xe0\xfb\x64\x99\x09\x00\xb1\x4f\xd1\x6c\x99\x20\x4c\x4b\x7f\x68\xb5\x8d\x38\x4a\x88\xdc\xc7\x74\xb6\x56\x46\xbc\x2b\x9a\x0c\xca\xbc\xf8\x32\xde\x27\x4b\x03\x3d\x91\x74\x6e\x2c\x6d\xdf\x9e\x93\xa8\x28\x54\xaa\xb4\x58\xb1\xc6\xa5\x25\xe6\x40\x93\x98\x7a\x2c\x37\xe9\x25\xa2\xf8\x4f\xcf\xe6\x81\xae\x1a\xc9\xcb\x05\x97\xb1\x89\x93\xe4\xee\x62\xc9\xc3\x97\x58\x70\x5d\x43\x61\x63\xf5\xbe\xc5\x91\x0d\xe7\xa9\x85\x80\xe4\xef\xf9\x31\x89\xc2\x88\x32\x02\xa7\xd4\x4f\xbe\xa5\x1b\xb4\x20\x67\x1e\xc1\x80\x2b\x1d\x57\xc8\x8e\x9c\x45\x0d\x3f\x24\x91\x67\xad\xd8\x99\x38\x98\xb9\x12\x84\xa9\x00\x40\x5f\x1a\xfa\x05\x96\x24\x5e\x04\xae\x15\x46\x34\xc0\xbd\x5b\x23\x3e\xc6\xf8\x5c\xab\x55\x9b\x87\x73\x67\x41\x9c\x1b\xab\x89\x1f\x6f\xc8\xbd\x85\x91\xca\xb6\x69\x72\xaf\x67\x78\x43\xcd\x28\x5c\x1a\xf3\x70\x6e\x0e\xcf\x3f\x1b\x1f\xcf\x3f\x1a\x9f\x7a\x5f\x8d\xde\x79\xef\xd4\x58\x39\x7a\xb6\x17\x15\x19\x50\xa4\xdb\xcd\x1b\x56\x20\x5b\xbe\xa7\x05\xf1\xc0\x82\x9b\x37\x2c\x9d\x3b\x58\xab\xe4\x52\xde\xc7\xbc\x4f\x96\x26\x82\x63\x4a\xa1\x41\xbc\xd7\xc6\xb7\x37\xaf\x26\xaf\x5e\x9a\xe9\x5c\xc1\x82\x7c\xb6\x60\x41\x33\xc3\x94\x20\x5f\xa7\x28\x0b\x4f\x9d\x5b\x46\x7a\x6a\xdf\x20\xcf\x2d\x6f\x5c\x1a\xad\xa8\xcb\xba\x2f\x6f\xb9\xd5\x55\x6c\xf0\x5c\xcc\x77\x05\xc8\x5f\x54\xdf\xed\xf7\xef\x80\xdb\x65\x33\xf5\xf8\x2e\x2b\xd2\x8f\xbb\xe6\x85\x1f\x49\xb2\x1f\x6f\x64\xdc\x27\xcb\x8c\x83\x4a\x7b\x6e\x35\x03\xa4\x04\x99\xd1\xaa\xf5\xe6\x10\x3f\x0e\x72\x1f\x74\x45\x0c\x54\x7b\x44\x0b\xe2\x6d\xd5\x3e\x70\x6e\xee\xb7\xe9\x60\x7b\x4b\xdb\xc3\xad\xb4\xb9\x53\x16\x60\x62\xa8\xf9\xe0\xbe\x01\x3b\x22\xc0\x16\x52\xde\xf9\x28\x7c\x20\x08\x89\xdf\x4b\x3c\x0c\x70\x22\x90\xd2\x50\x59\xed\xfa\xa1\xa4\x7c\x7c\xac\xd5\x78\x34\xa4\xdd\x4e\x18\x71\xb3\xe6\x68\xed\xfa\xa0\x97\x02\x3c\xc8\x52\x69\xb1\x1a\xe6\x41\xa7\x51\x0e\x28\xbc\x99\x67\x70\xb8\xbb\x57\x11\x6e\xbc\xd0\x0e\xe3\xdc\xf9\xa2\xd8\xfd\x79\x54\x27\xab\x55\x70\xc3\xc1\x33\xbf\x4b\xc9\x83\xb5\x02\xd5\x8a\x94\xce\x7d\x14\x1d\x87\x67\x10\xb4\xdb\x7c\xa7\xb6\xcf\x71\x0c\xb8\xd2\xca\x20\xae\xb4\x77\x1a\xbc\x7f\xbf\x16\xb7\x19\x5d\x83\xc6\xe8\x67\xf0\x60\x25\x44\x46\xdb\x63\x22\xdd\xaf\xf7\xc9\x32\x27\xef\x1e\x1e\x1c\x10\xdb\x37\x84\x09\x3f\x4f\xe0\x13\x19\xff\x42\x29\x3f\x0d\xe2\x05\x17\x58\xdc\x1d\x85\x1f\xd8\xa1\xd2\x37\x0b\x33\xe2\x71\x86\x87\xaa\x0d\xec\x7e\xe9\x51\xff\x06\x33\x2c\x78\xad\xeb\xcf\x4c\xd7\x9f\xf1\xd0\x1b\x04\x82\x39\x89\xf7\x26\x3d\xb0\x39\xdc\x8c\x1d\x0f\x79\x35\xef\xbf\x4c\x58\x0c\x53\x02\x37\x24\x8c\xb3\x11\xb9\x20\x40\x1d\x6d\x96\x47\x38\xf1\x5b\xd6\xe0\xf2\x12\xfe\x02\x06\x01\x1d\x4b\xf1\xa8\x33\x66\x2b\xd0\x10\x6a\x6f\x0e\x46\xad\xcc\x40\x21\x4b\x19\x14\x0c\x63\x16\xa0\x67\xdf\x90\x13\x63\xa0\x99\x7f\xe8\xc5\x98\xe1\x95\x6e\x1e\xfe\xda\x72\x35\xd0\x0b\x10\x7e\xc4\x9c\x9b\x46\xb8\xba\x44\x4d\xf0\xea\xda\xb4\xa1\x3c\x98\xcf\xe1\x5a\xfa\x43\x89\x1f\xdb\x46\x85\x31\x1e\x55\x94\x2a\xde\x77\xa4\xb5\x1b\x05\xa1\x41\x7d\x14\x25\x59\x48\x31\xcb\x89\xa0\x98\x4d\x83\x49\x32\x31\xf1\x05\x77\xd0\x99\x70\x0b\x3a\x98\x8e\x84\x4e\x42\x16\x40\xbc\xb0\x63\x10\x6e\x36\x64\x01\x8c\xea\xa0\x01\x6e\x47\x68\x1e\x23\x13\xf9\x41\xbc\xa0\xfe\x3c\xdd\xff\x12\x7a\x26\x03\xa4\xbf\x52\x94\x2a\x25\x88\x9a\x94\x08\xd9\xba\xc7\xc4\x8f\xad\x5a\xad\x4c\xdd\x42\x04\x70\x0f\x9e\x49\x56\x94\xb1\x25\xf4\x58\x02\xbb\x67\x31\x59\xba\x9c\xdf\xd0\xc3\xc9\x90\x43\xa7\x04\x08\x73\xec\x90\xb8\x59\xef\x74\x10\xed\x72\x44\xa2\x5b\xea\x90\x6b\x6d\xcd\x36\xfe\xe1\x2e\x96\xa0\x7e\xb5\xf4\xfa\x95\x5f\xd7\x7a\xfe\x2d\x8d\x02\x1f\xf5\x4f\xeb\x4a\xcb\x21\x54\x57\xd2\x34\x9f\x99\xcf\x9e\x3d\x5e\x69\x5a\x79\x0b\xff\x8c\x30\xf9\x21\x1a\xa3\x02\x1e\xa3\x27\x23\xb2\x11\x70\x1a\xd8\xb7\xf4\x62\x88\x3f\x85\x25\x95\xd3\x59\xa0\xb0\x9b\x9f\xb2\x51\xc6\x8a\xee\x3b\x35\x1e\x21\x38\x42\xec\x59\xb9\x9e\xf2\xa7\xa9\x3f\x48\x28\x8f\x0d\x8c\x5e\x53\x87\x34\x5c\x53\x57\x33\x05\x32\x28\x4a\x7c\x1f\xb3\xbf\xd0\xa5\x73\x9f\x8e\x08\x4e\x44\x5c\xe2\xc7\xd4\xf6\x98\x64\x6e\x74\x9a\x44\x04\x22\x62\xbb\xa8\x64\x62\x4a\x40\x14\x04\x31\xdf\x0f\x19\x4c\x19\x3a\x4a\x67\x82\x01\xa4\x4a\x1e\x11\xca\x1c\x5c\x35\x49\xb6\xd5\x8b\x25\x17\x77\x06\xba\x98\x2c\x76\xd2\xf7\x51\xf1\x94\x05\x07\x18\x9d\xd2\x52\x20\xda\x6a\x28\xf8\xd7\x59\x2c\x03\x17\x5e\x35\x9b\x69\xcf\x6a\x8b\xc0\x8f\xa9\xaf\x68\x68\xa5\xf5\xc5\x7f\x42\x43\x34\x42\x25\xe1\x2b\x45\xa4\xd0\x6e\x3f\x59\xda\xec\x06\x9a\xaf\x5f\x23\xc6\xe2\xfc\xca\x91\x7c\xbf\xba\xd3\x7a\x14\x0b\x3a\x6c\xaa\x70\xe4\x44\x11\x6e\xf5\x95\xf8\x4b\x7d\xa1\x04\x6f\x46\x6b\xb5\xd5\xa6\x95\xe0\x1f\x27\xf6\x40\x64\x67\x60\x6a\x4e\x60\xe7\xf2\x40\xc9\x15\x73\x51\xac\xe4\xa2\xcd\x9e\xc5\x28\xf1\xb8\x38\xe4\xd6\x5c\x12\x11\x77\x03\x47\x48\xde\x96\x3e\x97\x6c\x58\xe3\xdf\x40\x99\x61\x3b\xe8\x3c\x94\xfc\xbf\x62\x45\xd7\x21\x2d\xd1\x91\x1d\x6b\xa5\x55\xe4\x01\xa9\x47\x35\xd9\x09\x13\xa1\x84\x7b\xac\xb4\x09\x78\x00\x16\xcb\x89\x7f\x8b\x01\x4a\x92\xba\x93\x68\x8c\xe7\x01\x4b\xa5\xb7\x04\x94\x49\x6f\x49\xef\x2c\x6f\xa7\xb6\xe9\xe4\xab\xad\x63\xa9\xac\xfb\x41\x6d\x17\x5b\xb4\xc8\x9d\x71\x90\x38\x8b\xca\x98\x45\xb1\xaa\x88\xac\xea\x16\x13\xec\x4d\xbe\xa1\x47\x18\xf2\xd6\x56\xbd\xdc\xb5\xce\x75\xa7\x09\x9f\xcc\x8a\x5a\xa1\x3e\x66\x78\xa8\x41\xe5\x22\x36\xa3\xed\xd0\x19\x15\xf0\x51\x3b\x0b\x84\x58\x01\xa3\xd1\x13\x51\x2a\x0c\x93\x89\xf7\x7a\x49\xbe\xd7\xc1\x0f\x32\xf0\xa5\xaa\x32\xf0\xc7\x5a\xad\xb2\xd2\x62\x21\x97\xc1\xad\xf0\xdd\xf8\x81\x6c\x40\x19\xcc\xe9\x2d\xf1\x53\x3e\x2c\xc6\x53\x55\x86\xfb\xe5\x97\x35\xe1\xd5\x4c\xab\x95\x06\x14\x48\xef\xcb\xe1\x2a\x61\x8d\x7a\x82\xe4\x75\x4d\x1d\x22\xbc\x99\xab\x5f\xe5\xfe\x56\x8b\x64\x2f\xb4\xd5\xd2\x8c\xb1\x76\x9b\xc5\xf6\xbc\x6a\x3f\xa9\xf9\x63\xc8\x99\x86\x5b\x2a\xbb\xbe\x2e\x82\x11\xdb\x3c\x03\x23\xf4\x22\x3b\x89\x17\xca\x57\x3c\xff\xa4\x28\x10\xf5\x24\x8a\x0a\x2e\xab\xd2\x20\x69\x02\x59\x95\xb1\xf2\x9d\x5a\xce\x56\xcb\x9a\x38\x21\x18\xd1\x6c\x35\x40\xb3\x51\xed\x68\xa6\x1c\xa5\x22\x54\xee\xaf\xe4\xc9\x29\xe7\xdf\xba\x26\x07\xf0\x17\x3c\x11\xc5\x21\xb9\x2a\x07\x0f\x8e\xde\x67\x5e\x89\x03\x6d\xd3\x34\x73\x81\xb4\x0a\x4e\x7e\x78\x39\xe1\x66\xa4\x57\x76\xaf\xfd\x84\x2c\x4f\x09\x85\x46\x3f\x63\x77\x81\x38\x83\xf0\xb3\x48\x70\x67\xb1\x4b\x7d\x29\x05\xed\x58\x1c\x46\x3e\x41\xa7\x2d\x15\xa6\x96\x1c\x0b\x3c\xaa\xa4\x80\x20\xc3\x60\xca\x49\x71\x02\x58\x6a\x3e\x2f\x28\x5c\x72\x71\xb0\x66\xdd\x99\x2b\xb8\xcd\xd2\xf7\xd1\x35\x23\x68\x87\xcd\x73\x5a\x89\xfc\x39\x27\x89\xc1\x70\xdb\x60\xcc\x8e\x0c\x09\xf0\xbb\x9c\x28\xee\x56\xea\x83\x61\x24\x8c\xa4\x29\xe5\x4a\xfb\x96\x04\xa8\x81\x61\xa4\x44\x30\xf8\xbc\x41\x97\x0e\x6a\x4c\xc1\x53\xd3\xf2\x30\x1d\x2f\x1b\xbf\x9c\x6e\xd1\x03\x0d\xdd\x88\xe2\x12\x80\x17\xcc\x91\x10\x71\x90\x27\x77\x4a\xa0\x85\x84\xbd\xec\x2c\x95\x8b\x41\x97\xdc\xff\x82\xb2\x2a\x4c\x3c\x4f\xac\x85\xcc\x74\x3e\x94\x09\x16\x78\x1a\x31\xf5\xb0\x12\x47\x2c\x8d\x99\x38\x9c\x70\x6b\xa1\x37\x97\xd8\x2e\xda\x27\x2a\x2d\x36\xed\x0d\x39\x8a\x74\x20\x0b\x76\x7b\x12\x47\xaf\xcc\xd3\xce\x97\x29\x75\x2c\x1b\x4b\xa1\x33\xfe\x70\xd0\xd5\x00\x53\xae\xe5\xa2\x1d\x73\xc4\x18\xcf\x7a\xc7\x19\x4a\x0a\x53\x49\x38\xd4\x44\x88\x9b\xeb\x1d\x5c\x0a\x71\xaa\x4c\xb3\xb3\x9e\x44\x2e\xb4\x1a\x7f\x85\x20\x02\xcf\x8e\x49\x74\x58\xa0\x27\xbf\xb0\x81\x27\x47\xce\xec\xfe\x8c\xce\x71\x2c\x27\xa2\x10\x7a\xc9\x1c\xef\x9a\x30\x15\x0f\x0c\xfc\x89\xc4\xc3\x24\xe4\x07\x59\xbc\x20\xcb\x06\xf4\x71\xe3\x38\x36\x5f\x4a\xa1\xd7\x49\x58\x78\xec\x10\x9f\xf0\x3c\xb2\x86\x22\x88\x73\x04\x9f\x24\x8c\xa5\x8f\x2d\xed\x2d\x4e\x83\xaa\x6a\xf4\xf3\x02\x7a\x55\xa6\xf0\x93\x85\xf4\xaa\xce\x66\xd6\x9b\xce\x60\x1e\x91\x10\x8c\x7f\x43\x5d\x50\x65\x12\xda\xf1\x02\x2c\xa8\x17\xbb\x72\x06\x28\x61\xa8\xf8\x39\xea\xe6\xd5\xa5\x58\x13\x76\xd5\xd0\x68\x70\xd5\xc8\x89\x78\xd5\x98\x47\xa1\x73\xd5\xb8\x6d\x5d\x35\x9c\x88\x6a\x57\x8d\x94\x58\x57\xd7\xe6\xa1\x59\x1c\xd6\x04\xb6\x57\x2c\x69\x3c\x2f\x15\x68\xf5\x95\x14\xa9\x6b\x7b\xab\x70\x5e\x6f\x76\xa8\x82\xe3\x0b\x68\x6b\x19\x9a\x16\x98\x4a\xc8\x83\x7c\x76\xa0\xef\x2b\x5f\x8c\x34\x1f\x11\xbe\x83\x7d\x77\x03\xf5\x87\x30\xa2\x7e\x0c\xfa\x8b\xc7\xfa\xc1\x21\x84\xfc\x66\x00\x24\xe1\x3c\xb2\x5d\xae\x7e\xc7\x41\x61\x13\x54\x6c\xf1\xf4\x90\x50\xcc\xa9\xd5\x53\xfc\x5f\x97\xfd\x97\x97\x99\x8c\xce\x0c\xa0\x06\x0d\xb2\xb8\x33\xd6\x58\xe9\x4a\x1b\xad\x46\xd6\x22\x83\x80\x87\xfa\xfb\xf7\x2b\x26\x24\xc3\x05\xb5\x94\xa5\x90\xa3\x14\x86\xe2\xfc\xd4\xb8\x6d\x71\x6e\xca\x98\x09\x1b\xcc\xe8\x9c\x35\x34\x89\x96\xd6\xc0\xf9\x61\x4a\x23\xfe\x04\xae\x47\xe0\x41\xf6\xea\x25\x18\x77\xcd\xf4\xe4\xc9\x22\x09\xb9\x3d\x95\x42\xe4\xa6\x12\x03\xb9\xa1\xa7\xf7\x20\x99\x05\x31\x89\x02\x0f\x1d\xf0\x78\x4a\x48\xe9\x8a\x1e\x33\x14\x54\xd8\x1c\xaf\x69\xe1\xee\xe5\x36\xa5\xd0\x77\x03\x1f\x78\x9a\xad\xcd\x70\xd9\xe5\x64\x0a\x67\x88\x22\x81\x8a\x0a\xf0\x46\xd9\x82\x0d\xe2\xc8\x0e\x41\x8b\x96\x55\x49\xa0\x41\xef\x9f\xfd\x71\xad\x56\xe6\xf7\x4c\x71\x96\xb0\xa9\x8c\xfc\x55\x95\xe5\x34\x55\x41\x69\xa8\xfa\x13\x5d\x2e\xef\x51\x4e\x27\x21\xdc\x2d\x88\xbf\xd2\x68\x96\x06\x73\x05\x78\xc1\x74\x2e\x49\x97\xb2\x62\xac\x9c\x3e\xf9\xe8\xed\xf6\xad\xed\x51\x0c\x24\x2b\xa2\xba\x30\xcd\xb4\x5e\x45\x59\xae\x18\x4f\x1a\xd1\x4a\x4e\x48\x01\x77\x22\xb7\xb1\xc5\x6b\x33\xbc\xbb\x13\x19\x9a\xed\x75\xe5\x65\xa6\x72\x70\x9b\x07\x81\x95\xa1\x0a\xde\xcb\x15\xf0\x9f\x28\x3d\xde\x71\xe6\x79\xac\xc3\x77\x2e\x71\xeb\xcc\xfc\xe3\xd6\x34\xdf\x01\x33\x2f\x8d\x5f\xff\xe7\xba\xf1\xdc\x34\xeb\x07\x35\x35\x45\x18\xb3\x9f\x41\xaf\x8e\x08\x7a\xf5\xae\x1b\x2a\xe2\x2b\xe7\x88\x31\xbe\x6a\xfc\x1a\x77\xfe\x7f\xe1\xd2\xa5\x49\x26\x1b\xfb\xaa\xeb\xd1\x03\x4d\x99\xee\x2a\xec\x6c\x0f\x75\xab\xfb\x7c\x53\x61\x5c\x01\x45\x33\xea\xc6\x5e\x70\xc7\xaf\x36\xda\x2b\x67\xb1\x4a\xbc\xa2\x7a\x8c\x8a\x9f\x3d\xb7\xa9\x9f\x0b\xd7\x47\xfe\xe9\x31\x0b\x63\x96\x78\x4a\x0e\x9e\xb1\x94\xbe\x6a\x82\x45\xba\x94\xb8\x4e\x42\x40\x39\x90\x03\xce\xc7\x5f\x31\x58\x31\xe1\x17\x1b\x3d\x96\x59\x3d\x6b\x59\x4a\x4f\xfd\x61\x3e\x41\x35\x67\x24\x07\xcb\x6f\xac\x14\x1c\x61\xc1\x4c\x26\x1f\x21\xc9\xe3\x20\x80\xc0\x73\x0f\x95\x06\x28\xe4\xb9\x60\xe3\x87\x99\x9b\xbb\x76\x0d\x87\xf0\x58\xe3\x6a\xa4\x57\xe4\x60\xf9\x3f\xcc\xc1\x5a\x97\x58\xbc\x2a\x01\x27\x43\xc2\xe4\x91\x56\x99\x10\x54\x4e\x2e\x42\x71\x3e\xf5\x48\x39\x0f\x79\x5d\x3e\xf1\xc6\xdc\x95\x9c\x95\xfe\xa3\x25\x68\xd0\x40\x5b\xbb\xd6\xa5\x60\xf6\xde\x56\x0b\xf1\x23\x42\xaf\x0c\xdb\xe7\x54\x50\x43\xf7\x59\xa9\x21\x08\x28\xc3\xf7\x5d\x5e\x0c\xc7\x3d\x18\xf1\xe2\xf5\x49\x21\x3f\x5a\x2e\x31\xd1\x1f\xe6\x89\xe0\x05\x92\xa9\x47\x36\x67\x05\xec\x6e\x39\x0a\x99\x02\x2b\x5b\x88\x79\xac\x07\x50\x48\x1d\xf8\x19\x08\xd5\x5c\x82\x1f\x40\x29\xec\x6e\x4c\x9f\xda\x9c\x4e\xb0\x12\x5a\xd6\x48\x19\xf4\x3f\xa0\x71\x85\xc0\x42\x79\xcb\x80\x97\xc4\x69\x6a\xa6\xa5\x96\x1b\xaa\x2a\x2a\xb0\x94\xcf\xd5\xbb\xeb\xaa\xd0\x96\x06\xb1\xe4\xfa\x55\x16\x74\xb5\x71\xea\x9c\x91\xf6\xb1\xc8\x51\x4d\x23\x8d\xce\x3c\x0a\x92\x10\xdc\x08\x13\x84\xc5\xfd\x97\x1c\x1f\xee\xb3\x89\x12\xdf\x01\xaa\x6a\x42\xe8\x93\xc0\x37\x16\x84\x12\x78\x47\x3c\xaf\xb4\x78\xe9\xec\x5c\x32\xb3\x13\x8f\xef\xcc\x0a\x5a\x35\xc5\xe8\xd2\xd8\x1e\xb3\x7d\x77\x1a\x7c\x9b\x70\xa7\x85\x30\x96\xca\x45\x57\x9a\xfe\x50\xbe\x45\xff\xec\xb9\xf9\x68\x86\x76\xc2\x48\xfb\x45\xa3\x75\xa5\xed\x69\xeb\x86\x52\x0d\xc4\x91\x98\xfc\xb1\x98\xfb\x93\x4c\xc4\xd4\x3c\x64\x66\xb9\x73\xe3\x79\xa5\x08\x8f\x62\x73\xad\x0d\x57\xb0\xdf\x7e\xca\xec\x54\xeb\xa4\x6f\x97\xf1\x4f\x0e\xc6\xe2\xaf\x52\xd8\x00\x4f\xb2\x3b\x94\xf2\x14\x18\x87\xd5\x08\x42\xd4\x2b\xd9\xf5\x95\x9f\xc2\xc3\xbf\xab\x26\xbb\x76\xae\xd2\xf8\xcb\x55\xe0\x7c\xb0\x94\x2d\xe5\xed\x77\x1e\xf3\x66\xf7\x0c\xe3\x45\x18\xfa\xce\xdd\x55\x32\x8d\x1a\xd5\x73\xee\x13\x11\x2a\x7a\xd1\x76\xb5\x99\x3c\x31\x44\xd2\x34\x42\xe6\xf6\xea\x0c\x34\x4c\xc5\xf3\xec\xfb\x2b\x7f\x1a\x4d\x7c\x12\xcf\xa8\x17\x93\xe8\xca\xc7\x73\x73\xd5\xed\xfb\x1c\x68\x9e\x69\x51\x94\x10\xcb\xc0\x0d\xa3\x60\x4a\x40\x42\xde\xd4\x44\x1d\xb3\x80\x95\x4f\xe2\xc6\x34\xa2\xee\x9c\xc8\x1f\x86\x3f\x33\xd0\xb7\x63\xd0\x90\x9f\x0b\x0c\x73\xe7\xae\xfc\x4d\xed\x5e\x95\x1b\xd2\xf0\xf6\x65\x83\x86\x93\x59\x10\xdd\xd9\x91\x2b\x2a\xb2\x89\x0a\xe2\xe2\xcd\xf2\xb7\x86\x92\xb6\xe7\x44\x74\xdd\x4c\xe5\x72\x18\x86\x90\x16\x6a\x82\x35\x87\xe8\xe0\xfd\x05\x8f\x5f\xaa\x96\xa7\xab\x64\x20\x83\xf8\x6e\x18\x50\x3f\x6e\xe3\xdd\x86\x6f\x78\x60\xe6\x2f\x4a\xd4\xf8\x86\xde\xdc\x04\xcf\xbf\x92\x60\x8d\x12\x7f\x9d\x54\x8d\x12\x5f\x61\x06\xad\xb6\xc9\x7f\x5d\x0d\xa0\xae\x6b\x29\x0e\x66\x05\x70\xed\x29\x7e\x71\xa5\x79\x69\x02\x45\x5b\x78\x95\xe9\x57\xa9\x90\x47\x50\xa5\x1c\xd7\x8b\xce\x2b\xc5\x51\xe2\xe3\x71\x24\x05\xfd\x16\x26\x65\x7a\x34\xac\xb3\x27\x65\x7d\x6a\xdc\x08\x63\x32\x75\xad\x3e\xc9\x92\x4c\x47\x28\x98\x91\x65\xb0\xfa\x7e\xda\x4c\x35\x20\xa5\x9d\x68\x98\x13\xb3\x2e\xed\x49\xe3\xe4\x36\xb7\x29\x8f\x1e\x55\x8b\xf1\xf2\x32\x77\xfa\x4a\xad\x0b\x43\x2f\x7a\x69\x2c\x69\xf0\xa9\x04\xe9\x81\x66\x83\x4b\x67\xfc\xb6\x6f\x0c\x69\xc3\x14\xa5\xb5\x06\x5d\x6e\xae\xf9\xb2\xae\x6a\xb0\xa1\x8e\x5a\xa5\x57\x96\x79\x5a\x30\xe0\xe4\x80\xd9\xea\xaf\x5b\x3d\x59\x8d\xa6\x99\xec\xa2\x3f\x14\xdf\xc9\x78\xd4\x1f\x4a\xa4\x78\x2c\xaf\xaa\xed\x2e\x15\xf2\x73\x2b\xa5\x42\xbe\x94\xe6\xf5\xcb\x89\x71\x5d\xcf\x09\xdf\xca\x08\xaf\x57\xe6\x56\xb4\x7e\x7e\x42\xc5\x7a\xfa\x94\x9e\xcb\x9b\x1f\xc5\xc7\x16\x54\x62\x75\x2b\xc4\x72\x62\x6f\x05\xe4\x12\x41\x1e\xd5\xf8\xea\x13\x9a\x6b\xff\xe9\x7c\x9f\x86\xd5\xf3\x2d\x50\x7a\xae\xe5\x16\xb8\xa4\x68\x45\x45\xc5\xcb\x4b\x31\x71\x62\xb0\xf1\x74\xba\xc5\xbb\xbc\xfd\xf3\xdb\x97\x60\xbb\x2e\x7f\xe9\x89\x07\xb8\xd1\x0b\x11\xa6\x99\x73\x22\xc6\x9d\xea\xaa\x3e\xe8\xe2\x91\x23\xa9\x95\xca\x17\x8f\xfe\x9b\x86\x18\x18\xa2\x3e\x41\xc8\x39\x0f\x81\x79\xfd\x6b\x1d\xea\xe6\xe1\xc5\xf9\xa1\xf9\x30\x27\x31\x46\xfd\xde\x61\x24\x69\x5f\x7f\x01\xff\x03\xe6\x1f\xad\x66\xc3\x44\xfe\x49\xbf\xbe\x3d\x6a\xb4\x5e\xbd\x29\x96\xbd\x3e\x6a\xec\xb7\x2e\x5f\x19\x6f\xaf\xbf\x1f\x5d\x36\xf1\xc7\x8b\xcb\x66\xeb\xfa\xa0\x61\x1e\x40\xca\x9f\x2f\xde\xa1\xaf\x09\x9a\x8f\x8f\xf5\xff\x7e\xaa\x32\xfe\x74\xb6\x53\x95\xf5\x55\xc9\x5e\x12\x92\x92\xe9\x55\xd0\xda\x9f\xd6\x25\x75\x78\xa2\x10\x16\xaa\xfa\x44\xa8\xea\x96\xf8\x36\x63\x4a\x03\x79\xf8\x4e\xec\x68\xce\xac\x14\xbb\xd5\xbe\xca\xfc\xd4\x90\x82\x50\xd9\xab\x85\x61\x24\x86\x59\x6d\x61\x0c\xcd\x30\x32\x40\x86\xac\xb1\x30\x2f\x22\x26\xb0\xa2\x2a\x3b\xf2\xad\xea\x89\x9f\xf2\xa9\x32\x1d\x76\x67\x87\xca\x5c\xf4\xfc\x69\x28\xdc\xdf\x79\xad\x61\x60\xa8\xd4\xc0\x02\x23\xf0\xd5\x43\x68\x2f\x5b\x59\x4c\x15\xa2\x0c\xd2\x34\x51\xa9\x59\xe2\xc3\x4a\xd4\xa7\x31\x46\x2d\xfe\x15\x50\x3f\x8f\xf3\x7d\x12\x8b\x77\x2c\x6d\x1e\xee\x60\x85\x24\x94\xe1\xc0\x18\xf3\x49\x09\x38\x1e\xf7\x2e\x1d\xca\xb1\xe4\xea\xc1\x0d\x21\x61\x96\x82\x85\xd2\x19\x09\xe3\x65\x37\xd5\x23\xd2\xc0\xdb\xee\x73\x06\xf6\x34\xb8\x25\x3c\xad\x19\xc2\x88\x38\xe8\x3f\x77\x84\x56\xa9\x06\x0a\xd1\x77\xaf\xbe\xd9\x20\x63\x48\x0a\x61\x52\xce\x14\x7a\x77\x9a\x35\x9c\xb6\x31\x64\x40\xd4\xca\x84\xb4\xf2\x6a\x56\x2d\x4f\x9a\xa9\x67\xb9\xa4\xbc\x4c\x4d\x94\xd4\xb2\x9e\x1f\x87\x83\x8b\xf3\x49\x77\xd8\xff\xbd\x37\xb4\x0c\x43\x30\xa1\x21\x79\xa5\xae\x17\x58\xb4\xae\xad\x07\x24\x59\x71\xd2\x19\x7e\x1c\x59\x75\x2d\xcd\x40\xe3\x18\x6b\x9b\x3a\xe2\x4f\x89\x3f\xef\x6b\x18\xd3\x20\x88\x19\x06\x0e\xb8\x52\x2b\xe7\x5a\x7e\x06\xa8\xd8\x08\xf7\x18\x36\x04\x63\x53\x9f\x52\x4b\x94\x81\x06\x0d\xad\xba\x94\x76\x9b\xb0\x1c\x7d\x1d\x8d\x7b\x9f\x27\xe7\x83\xee\x28\x45\x33\x0c\x5c\x23\x7d\x8c\xc8\xc0\x00\x60\x65\xbc\xb4\x96\x6d\x00\x7c\xd6\x1b\x7f\x19\x0c\x3f\xa5\x40\x7d\x12\xdf\x05\xd1\x8d\x21\xcc\x3c\xcb\xf1\x31\x15\xdc\xf1\x29\x5f\x72\xc3\xa5\x91\x18\xc4\xf1\xa9\x89\xf6\x81\x2b\x6b\xa7\xf8\xf8\x08\x56\x06\x61\xcc\x2b\xa7\xd4\xdf\x30\x68\xf7\x2c\x9b\x85\x64\x79\xc3\xf5\x99\x55\xd7\x95\x57\xdd\xea\xa0\x54\x06\x4b\x1b\xb1\x11\x5f\x1b\x9c\x6d\x37\x80\xef\x5c\x8c\xff\xfe\xff\xa5\x03\x60\x2c\x2b\x88\xe8\x9f\x7c\xc7\x19\xcb\xc0\x25\xd6\x17\x32\x5d\x04\xc1\x0d\x1f\x80\x12\x3f\x36\x1c\xdb\xc0\x4d\x51\x21\x20\x5e\x9c\x72\xec\x86\x13\xc5\x32\xd6\xb3\x72\xb8\xe3\x4e\xf7\xf7\xfe\x68\x30\xcc\xa6\x64\xbb\xb7\x94\x05\x91\x81\x41\x51\xab\xb9\x01\x51\x8c\xd0\xf6\x4f\xfa\xc7\x9d\x71\x2f\xed\x1c\x05\xb1\x1d\x13\x03\xc3\xd4\xf8\xd6\x16\x5e\xf6\xe4\x5a\x1d\x22\x4b\xa2\x58\x50\xb9\xfc\xca\x5c\x78\x43\x37\x8c\x72\x3e\x40\xdf\xfc\xc9\xb0\x93\x8e\x81\x9c\x43\xfd\x59\x64\x2b\x12\x95\x9b\x50\x56\x7d\xb5\x43\xa4\x9e\x7b\x44\x36\x8c\x53\x7c\x78\xcf\x30\x66\xc4\x8e\x93\x88\x18\x73\x3e\x89\x2e\x41\x51\x70\xce\xf9\x4a\x4c\xa9\xae\x3f\x14\xba\xb4\x7f\x3d\xd4\x0b\x05\x8f\x1b\x77\xc4\x97\xce\xb9\xdc\xed\x7a\x26\xba\x37\x75\x38\x1d\x7c\x9c\x9c\xf6\x7e\xef\x9d\x5a\xc6\xad\xf5\x72\x43\x43\x55\x1a\xd4\x75\x45\xf2\xa5\xd0\xbf\x11\x67\x84\xa6\x99\x55\xfa\x9a\xbd\x68\x2a\xd7\x05\xf4\x95\x62\x0e\xf4\x55\x42\x0b\xf4\x35\x12\x09\xf4\x75\x42\x00\xf4\x55\xbb\x18\xf4\xf2\x36\x03\xbd\xba\x33\x40\x5f\xc9\xbe\xa0\xaf\xe3\xcd\xbc\x86\x3f\x8d\x57\x2a\x2b\xf2\x58\x5e\x8e\x92\x6d\xd2\x3f\x2f\x95\x16\x56\x39\x2f\x1e\x7d\xe9\x94\x5b\x66\x8b\x96\x17\x0d\x7b\xfc\x55\xb7\x09\xbe\x8d\x79\x31\x46\x56\x15\xd7\xb2\x4b\x3d\x15\x0a\xf2\x65\xaa\xc3\xfb\x27\xaa\x48\xad\xa6\x21\xcf\x72\xe1\xc6\x50\x35\xdd\x1f\xf8\x0c\x64\xbb\xff\xc3\x0e\x03\x63\x96\xaa\x02\x4f\x72\x18\xa4\x6d\xb3\x0c\x68\x43\xe6\x92\xfa\x80\x2f\x41\xa2\x2f\x89\xe0\x3d\x37\x8f\x01\x3e\xf0\x80\xce\xd7\xcc\x45\x84\xfe\xda\x20\x89\xb9\xba\x90\x36\xce\x9c\x69\x34\x64\x24\xae\x61\x71\xd9\xf7\x50\x22\x05\xaf\x14\x83\xa5\x2a\xb0\xc0\x41\xab\xfd\xa4\x31\xc3\x87\xe6\x10\x79\x66\xaa\x54\x90\xfc\x19\xbe\x42\xe9\xc7\x91\xed\xdc\x4c\xd0\x57\x85\xf6\xc4\x92\x44\x73\xee\xc2\x8f\x83\x42\x03\x60\x14\x9f\xdc\xba\xc1\xd3\xd8\x83\x97\x8d\xd6\xdb\xa2\x0e\x24\x5a\x59\x6a\x17\xde\x20\x73\xbd\xe9\x59\xb9\x9a\x5a\x29\x53\x44\x56\xf4\xe6\x18\xa9\xfa\xa7\xa0\xa5\x95\x25\xa5\x88\xef\xb8\x2e\xba\xfa\x90\xa7\x32\x50\x21\x35\x65\x8d\x47\x50\x17\x60\xf2\x4c\x0d\xd5\x2b\xf8\x8c\xa1\xbf\x6e\x1d\x78\x78\x5f\xac\xe2\xee\xed\x02\xbf\x17\x97\x59\xa6\xfa\xf2\xc2\x72\xfe\x45\x56\xc6\x07\x43\x9d\x51\x61\x94\x2d\x6d\x87\xbc\x40\xed\x5f\xf1\x42\x15\x4b\x25\xf7\x14\x0b\x15\xbf\x56\x56\x26\x9d\x5a\xff\x0a\xe8\xda\xbd\x8c\x75\xc2\x46\x8d\x83\x54\x2b\x57\x1d\x1d\xec\x86\x86\x13\xc7\x96\x77\xb2\xe8\x2c\x53\xfe\x79\x47\xc3\x58\x10\x2f\x84\xef\x79\x7c\xe0\x8f\x2b\xf6\xdc\x30\x5c\xca\x1c\x54\xc6\xef\x8d\x38\xb8\x21\xbe\x91\xf8\xcc\x9e\x11\x03\x81\xa1\x0a\x72\x4b\x22\x71\xe4\xd3\xc0\xaf\x57\x1f\x61\x93\x29\xe8\xe9\xd0\x5b\x81\x4b\x5d\xe7\xfc\xe7\x5e\x09\x59\xde\x1b\xf4\xf1\xe0\x53\xef\x0c\xf4\xcf\x9d\xd1\xb8\x37\xec\x9f\xc3\x56\x03\xc0\xa5\x61\x90\x6f\x21\x89\x28\x1e\xba\xb6\x67\xc8\x4c\x20\x23\xf4\x6c\x9f\x5c\xab\x3b\x2d\xa2\x13\x86\x9e\xf5\xd8\xda\x96\x2b\xf2\x9e\x68\x27\x46\xd4\x10\x70\xa0\x62\xf5\xc9\xb7\xe8\xe6\x7e\x10\x91\x09\x2a\x07\x2b\x0d\x3e\xb5\x5e\x33\x0c\xf1\xd5\x08\x23\x32\xf3\xf0\x05\x72\x83\xbf\x4a\xc8\x60\x74\x67\x87\x5a\x21\x51\x51\xbd\xf8\x51\xcd\xce\x69\xa8\xb9\xfe\x2a\xdd\x8b\xfb\xf7\x09\x8b\x00\xba\x5c\x6c\xd0\xf3\xb9\x83\xae\xe0\x0d\x7a\xf5\xf5\xde\xec\x9d\x4d\xce\xdc\xe8\x5c\x17\x9e\xf4\x0b\x7c\x4d\xb3\xcd\xe7\xa1\x37\x0b\xef\x4a\x5c\x1a\x06\xa7\x98\x81\x51\x4e\x03\xbd\x34\xe9\x6d\xfd\x56\xb3\xd1\x6a\x36\x9a\x8d\x56\xfb\xcd\x9b\x37\x4d\x93\xb7\xc2\x46\x98\x94\x7c\x33\x37\xc4\x73\xb8\x50\x7d\x15\xf7\x1a\xf9\xc1\x25\xd3\x64\x7e\x5d\x1c\x50\x6e\x42\x55\xb7\xf7\x19\xb4\x5e\xbd\x6d\xe0\x7f\x38\x9a\xe2\xa9\x6d\x35\x5a\xad\x46\x13\x0c\xa1\x9c\x1a\xca\x63\x6e\x59\xc6\x9c\x78\xea\x1a\x2e\x33\x6b\x0a\x52\x94\x8f\x38\x0e\x05\x1d\x14\xfa\xe7\xb7\xaf\xba\x89\xed\x8d\xf0\xa5\x45\xae\x83\xf2\x46\x15\xbf\x82\xc2\x7b\xbc\x81\x2d\xae\xc2\xde\xd9\x21\xff\x5a\xcc\x0b\xe6\x45\x28\xeb\xf8\x07\x24\x9c\x3c\x6f\x2b\x34\x7c\xd1\x3a\x7a\x03\x86\x81\xe5\xec\x07\x6d\xfc\x40\x36\x68\x1d\xbd\x6e\xe0\x74\x5a\x87\x69\x9b\xa6\xd9\x7a\xc5\x87\x4a\x85\xaf\x91\x79\x7f\x14\xd3\x45\x56\x52\xc2\x56\xac\x45\x81\xe7\xde\xbc\xfc\x2b\x79\xf1\xaa\x31\x75\x5e\xbe\x7a\xf5\xf2\x4d\xd3\x9e\xbe\x3a\x6a\xbd\x78\xf3\x1a\x0c\x63\x69\xe3\x0a\x65\x14\x6d\xb5\x5f\xbd\x7c\xf9\x22\x25\x58\xbe\xb9\x7f\x86\x84\x45\x7c\xf8\x9b\x48\x4a\xb1\x0c\xd6\x64\x7e\x44\xd4\x46\xc4\xcd\x69\xa1\x87\xc4\xac\x7c\x99\x05\x43\x7b\xca\x03\xd3\xd8\x18\x1d\x73\xd8\x3b\x93\xf1\xb8\x75\x23\xd0\xf3\x77\xa9\xa5\x5c\x49\x73\x8b\x2c\x7d\xbf\x51\xa8\x4e\xaf\xf4\xe9\xfd\xee\x41\xe1\x75\x24\xd9\x41\xf6\x57\xcf\x8e\x13\x35\xcd\x5e\x62\xcf\x33\x12\x24\xfe\x52\x90\x54\x13\x39\xd6\x0f\x9e\x39\x0e\xfb\xdd\x67\xcf\x1a\xcf\x1f\xa5\x87\x5c\xbc\x21\x90\xe2\x9e\x3e\x22\x20\xf2\x9f\xf2\x54\x54\xa9\x5a\x59\xb6\x72\x9d\xbc\xa4\x5c\x59\x75\x30\xee\x21\x5d\xa1\xc4\x47\x83\x19\xf3\x2e\x1d\x4c\xfe\xae\x67\xbd\x4a\x0f\x66\xd7\xad\xbc\x4a\xcd\x22\x5a\x3f\x8f\x8b\x0f\x17\x67\xe3\x8b\xc9\xf1\xa0\xdb\x3b\xeb\x7c\xee\x1d\xa8\xb7\xb3\x45\x42\xd2\xff\x93\x68\xa7\x00\x56\xe2\x2d\x32\x5a\xbe\x63\xea\xcb\x77\x9e\xbe\xf2\x3d\x4b\x41\xf9\x9e\xe5\x85\xe4\xd3\x12\xcf\x0e\xe0\x2d\x6d\x4c\xe7\xa3\x36\x7f\xcb\xc2\x7d\xc2\x0b\x01\x15\xc2\xe0\x5b\x1d\x3f\x20\x0c\x23\x71\x10\xc6\x56\x30\x65\x81\x87\x22\xc1\x42\x49\xea\x07\x69\x4e\xd0\x7a\x12\x19\x3f\x4b\xa2\x7e\xb7\x40\x1c\xe5\x41\xdd\xd2\x16\x49\xfc\x88\x38\xc1\xdc\xa7\x7f\x12\x57\xbe\xa3\x23\xa6\xdd\xce\x39\xfa\x10\x9c\x24\xc2\xb8\x19\x7f\x9d\xc1\xbb\xcf\xae\x2e\x08\x9a\x1f\x02\x12\xfd\x10\x38\xd5\x0f\x21\x23\xfb\x61\x4e\xb4\xc3\xf4\x81\x34\x24\xa5\xe0\x30\x6d\xd5\x93\x00\x4b\x5b\x51\x04\x55\xc9\xc1\x0b\x42\x3b\xe2\x6f\x33\xff\xa6\xd5\x4a\xaf\x6f\xab\x93\xea\x82\xb6\x66\x1e\xa0\xab\x44\x94\x32\x81\xc8\x98\xb2\x2e\x7e\xd1\x03\x1e\xd9\x7c\x9c\x0c\x8d\xbb\x05\x3a\x98\x2f\x41\xdf\x03\x63\x1e\x43\x13\xae\x8b\xa6\x00\x6e\x7e\x4d\x6f\x15\xde\x8a\xc6\x7f\xfc\x58\xcf\xe9\x9e\xfe\x91\xbf\x4f\x22\xd7\xa5\xd3\x3f\xef\xde\x15\xbe\xe2\xc1\xb0\xb6\x37\x56\x6e\xea\xcc\xa5\xf8\xda\xde\xf9\x83\x57\x6b\xba\xf3\xe3\xbd\xda\x3d\xff\x15\x18\xbc\xc1\x26\x08\xf2\x5c\xde\x04\x43\x36\xd9\x04\xa5\xa0\x9a\x54\x61\xc9\x00\xd5\xc3\xd1\xaf\xdf\x1e\xe5\x01\xf0\x17\xb9\x0f\x8e\x14\x95\xff\x0f\xa3\x18\x04\x51\xff\xa8\xbf\xbb\x42\xd3\x8f\xb4\x5a\xa9\x9e\xff\x63\x0b\x3a\x8b\x6b\xa5\x42\x78\x2c\x86\x41\xd5\xbf\xf9\xdb\xea\xa0\xf9\x99\xd5\x02\xae\x8f\xbf\x43\x42\xdc\x22\xe5\x56\x67\x61\x7e\xd5\xb1\x1f\x37\xd3\x46\xe8\x04\x3b\x21\x8b\xb0\x3c\x76\x47\x11\xa9\xce\xc8\x28\xe4\x7e\xff\xbc\x7d\x3e\x18\x8e\x0f\x0a\xa4\x11\x6d\xb6\xa6\x0a\xd7\xa4\x76\x42\x14\x6e\x10\xec\x8e\x26\x1c\xf1\x02\x05\x78\xc9\xd6\x04\x90\x2a\xfb\x4e\x48\x20\x45\xe7\xee\x88\x90\xda\x1b\x2a\x19\x64\xd9\xd6\x84\x90\x66\xc8\x4e\x08\x21\xe3\xe0\x3b\xa3\x43\x1a\x94\x57\xe9\x20\xe7\xb3\x35\x1d\x0a\x86\xd7\x4e\xa8\x51\x70\x22\xef\x8e\x39\xe4\x44\x80\x4f\xa4\x40\x9a\xc2\x14\xb7\x26\x50\xd9\xa6\xdd\x09\x8d\xca\xc1\x9c\xdd\x91\x49\x26\x4b\xf2\x59\xa9\xcf\xae\xab\x04\x2b\x4f\x79\x6b\x9a\x09\x83\x7b\x37\x94\x52\x7e\xe3\xd1\xce\x88\x94\xfa\x7c\x78\x8a\x80\x8c\xc6\xab\x04\x12\x45\x5b\x93\x05\x7f\x11\xcd\xae\x64\x4e\xfa\xdb\x74\x76\x46\x13\x44\xbe\x2c\x74\xe4\x84\xb6\x26\x44\xc5\x0b\xb1\x13\x92\x54\xbc\x95\xbb\x23\x4e\x36\xa1\x34\x15\xa7\x40\xa6\xca\x74\xb7\x26\x58\xc9\xed\xb7\x13\x72\x15\xaf\xd7\xec\x8e\x56\x32\x65\x09\xbd\x93\x69\xa2\x99\x4a\xac\xd2\x54\xb7\x26\x55\xee\xef\xdc\x09\x95\xf2\xeb\x3f\xbb\xa3\x50\xe6\x33\xe3\xfe\xda\x02\x75\xf2\xd9\x6d\x4d\x98\xdc\xb3\x57\x25\x4c\xee\x70\xcf\x53\x53\xd7\x02\x2a\xba\x55\xab\xc0\x8a\x91\xdd\x27\x00\x44\xa7\x6c\x15\x0c\x86\xa7\x9e\xd0\x39\x77\xe4\xee\x64\xbd\x4b\x8f\x22\xed\x70\xd1\x71\x0a\x85\xb5\xce\x67\xb6\xf5\x5a\x2b\xae\xeb\x9d\x51\x65\xf4\x7f\x91\x2c\xec\x27\xe9\xa2\xba\xe2\x77\x42\x18\xf5\xe6\xfa\xee\x88\xa2\x5e\xd0\x57\x49\xa3\x4e\x6f\x6b\xda\xf8\xc1\x0e\x19\xa6\xf8\xd4\xd6\xee\x28\x23\x4f\x94\x92\x05\x90\x4e\x6d\x6b\x9a\x14\x02\x28\x55\xc2\x54\x83\x7a\xd6\xa6\xf0\xea\xe6\xb1\x78\x4c\xa5\x3a\x06\x77\x63\xfe\x58\x0e\x2e\xbe\x8b\x68\x76\x15\x40\xfe\xdb\xf9\xd4\x3f\x5c\xaf\x6b\x6e\x02\xa9\xb8\x85\x57\x11\x9b\xfa\xfc\x12\x09\x88\xbb\x62\x6d\xd0\x65\xca\xd9\x0a\x68\xdc\x81\x9b\x7e\xe1\x2b\x0c\xfa\xfe\x3e\xfa\x4c\xdf\x43\x13\xfe\x0b\x5a\xd0\x86\x26\x1c\x1c\x94\x1e\x32\x92\xce\x53\xe1\x97\x2c\x78\x50\x57\x78\x4f\x65\xe3\xcc\x83\x58\xf1\xb8\x6e\x70\x5d\x2a\xde\x4f\xf5\xa1\xe9\x4a\xbb\x12\x81\x36\xba\x32\x15\x98\xb2\xcd\x3a\xa8\xea\x91\x99\xba\x50\x52\x57\xfd\x53\x50\x58\xb1\x4e\xeb\xd6\x0a\x5f\x54\x08\x7c\xe2\xcb\x34\xc9\x0d\x80\x0b\x4b\xa6\xd4\xa1\x45\xf4\x1d\x1d\xcc\xdf\x4b\x5e\x64\xa5\x4d\x09\xa1\x55\x88\xe0\x7b\x92\x9c\x69\xe4\xe2\x96\xc1\xe4\xaf\x00\xa3\xd3\xff\x50\x04\x16\x30\xf2\x32\x4b\x7c\xde\x55\x3c\xd2\xe4\x92\x19\xf5\xf3\xdf\xa2\xc0\x9c\x88\xe2\x2f\xfc\x66\xf2\xd7\x81\xb9\xe9\x63\x57\x94\xe1\xdd\x57\x7e\xaf\x10\x5f\x9c\x67\x35\xf1\x9a\xc7\x8a\xdf\x0d\x8d\x09\x0d\x9a\xde\x2c\x3d\xc3\x86\x48\x80\xa6\xff\xa6\xd5\x66\xb4\xf6\xff\x0f\x00\xe2\xb2\xa2\x6c\x13\x7d\x00\x00"),
 		},
 		"/scripts/lib.sh": &vfsgen۰CompressedFileInfo{
 			name:             "lib.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 87556601, time.UTC),
+			modTime:          time.Date(2020, 1, 16, 11, 29, 49, 0, time.UTC),
 			uncompressedSize: 1999,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xef\x6f\xe2\x46\x10\xfd\xee\xbf\xe2\x75\xb1\xf2\xe3\x04\x18\xc8\xa7\x06\x71\x0d\xcd\x8f\xd6\x6d\x0a\x12\xe6\x1a\x9d\x72\x51\x6f\xb1\x07\x7b\x75\x66\xd7\xdd\x5d\x43\x68\x92\xff\xbd\x5a\x63\x48\x72\xb9\x44\xad\x6e\x3f\xd9\x3b\x6f\xde\x7b\xe3\x99\x71\xe3\x87\xa0\x34\x3a\x98\x09\x19\x90\x5c\x62\xc6\x4d\xe6\x35\x1a\x38\x55\xc5\x5a\x8b\x34\xb3\xe8\x75\xba\x3f\x22\xca\xb8\x4c\x33\x2e\xf0\x9b\x90\xe9\x59\xa9\x10\xca\xb9\xd2\x0b\x6e\x85\x92\x98\x52\x9c\x49\x95\xab\x74\x8d\x58\xb5\x9b\xb8\xb4\x49\xdb\x6b\x34\x1c\xcd\xa5\x88\x49\x1a\x4a\x50\xca\x84\x34\x6c\x46\x18\x16\x3c\xce\x68\x1b\x69\xe2\x4f\xd2\xc6\xb1\xf4\xda\x1d\x1c\x38\x00\xab\x43\xec\xb0\xef\x28\xd6\xaa\xc4\x82\xaf\x21\x95\x45\x69\x08\x36\x13\x06\x73\x91\x13\xe8\x36\xa6\xc2\x42\x48\xc4\x6a\x51\xe4\x82\xcb\x98\xb0\x12\x36\xab\x74\x6a\x16\xe7\x04\x1f\x6b\x0e\x35\xb3\x5c\x48\x70\xc4\xaa\x58\x43\xcd\x9f\x02\xc1\x6d\x6d\xba\x3a\x99\xb5\xc5\x71\x10\xac\x56\xab\x36\xaf\x1c\xb7\x95\x4e\x83\x7c\x83\x35\xc1\x65\x78\x7a\x3e\x8a\xce\x5b\xbd\x76\xa7\xce\xfa\x20\x73\x32\x06\x9a\xfe\x2e\x85\xa6\x04\xb3\x35\x78\x51\xe4\x22\xe6\xb3\x9c\x90\xf3\x15\x94\x06\x4f\x35\x51\x02\xab\x9c\xeb\x95\x16\x56\xc8\xb4\x09\xa3\xe6\x76\xc5\x35\x39\x9a\x44\x18\xab\xc5\xac\xb4\xcf\x3e\xda\xd6\xa3\x30\xcf\x00\x4a\x82\x4b\xb0\x61\x84\x30\x62\xf8\x79\x18\x85\x51\xd3\x91\x5c\x85\xd3\x5f\xc7\x1f\xa6\xb8\x1a\x4e\x26\xc3\xd1\x34\x3c\x8f\x30\x9e\xe0\x74\x3c\x3a\x0b\xa7\xe1\x78\x14\x61\x7c\x81\xe1\xe8\x23\x7e\x0f\x47\x67\x4d\x90\xb0\x19\x69\xd0\x6d\xa1\x5d\x05\x4a\x43\xb8\xcf\x49\x55\x17\x11\x11\x3d\xb3\x30\x57\x1b\x4b\xa6\xa0\x58\xcc\x45\x8c\x9c\xcb\xb4\xe4\x29\x21\x55\x4b\xd2\x52\xc8\x14\x05\xe9\x85\x30\xae\xad\x06\x5c\x26\x8e\x26\x17\x0b\x61\xab\x79\x31\x2f\xeb\x6a\x7b\x5e\x03\x53\xd7\x58\x13\x6b\x51\x58\x14\x5a\x2d\x45\x42\xae\xb1\x0b\x25\x31\x2f\x65\x5c\x8d\x9a\x13\xdf\x40\x8c\x1b\x06\xcf\xdb\x46\x8e\x8f\xe9\x56\x18\x6b\x70\x70\x88\x3b\x0f\x48\x28\xce\xb9\x26\xb4\xe6\x68\x5d\xc0\xef\xe2\x3d\x82\x84\x96\x81\x2c\xf3\xdc\x03\x34\xd9\x52\x4b\xf8\x3f\x79\x0f\x9e\x47\x71\xa6\x34\x25\x75\x26\xe0\xde\xd1\x22\xb0\x4f\x9d\xa3\xa3\xeb\xa3\xee\xc2\x3f\xa9\x9e\x3a\x0b\xb6\x85\xbb\x36\xca\xd7\x12\x7a\xdf\x48\x58\x53\x9e\xab\xd5\x6b\x19\x47\x5f\x65\xb8\xb2\xb9\x4c\xfe\xda\xd4\xb4\xcb\xaa\xaf\xd1\x5a\x82\xf9\x27\xec\x69\x4d\xe8\xbd\xdf\xeb\x56\x62\x5a\x2b\xed\x12\xed\x33\x31\x37\x91\xfe\xc9\xe6\xf5\x56\x58\x54\xd8\x5c\xa5\x3b\x90\x3b\xb9\x8a\x79\x8e\x9c\x96\x94\x0f\xfc\xee\xee\x7a\x7b\x2c\x19\x8b\xd6\x3f\x60\x7e\x05\x61\xd8\xdb\xc3\xa3\x1c\xd8\xf5\xc5\x70\x3a\xbc\xbc\x41\xae\xd2\x0d\x49\xb5\xb3\xf5\x9c\x50\xc2\xbc\x1d\x63\xcc\x0d\x3d\xd2\x08\xf9\x42\x2a\x1c\x5d\x8c\x0f\x5f\xdc\x6e\xcf\xae\x03\x60\xd7\x1b\x92\x1b\xf8\x77\x27\xc7\xbd\x07\xf6\x6a\x4e\xbf\xff\x22\x74\x35\x9c\x8c\xde\x16\xd9\x74\xed\xfb\x54\xce\x27\x93\x37\x44\x9e\x7e\xbe\xef\x10\x79\xf7\x1f\x25\xea\x0e\x95\xf2\x8b\x54\x2b\xf9\xa4\x53\x75\x2f\xfe\x8f\x26\x19\x1e\xbb\x29\x0a\x1f\x17\x61\x33\x40\x72\x50\xbd\xb8\x5d\x95\xee\x3f\xe7\x9f\xf4\x91\xa8\x5d\xbe\x98\xe3\xfa\xda\xad\xe4\x60\x00\x5f\xe2\xe6\xa6\xef\x7e\x05\x72\xbb\x93\x9d\x3e\xe6\xa2\x02\x27\x4a\x52\xf5\x50\x47\xaa\x99\x9d\xa8\x9c\xfe\xe0\x36\xce\xbe\x12\xe5\x5a\xf3\xf5\xc0\x3f\xa8\x36\xcb\xef\xe2\x1e\x56\x83\x35\x19\xd8\x27\xc9\x0e\x77\x86\x44\x65\xa8\x02\x7f\xc3\x14\xf3\x05\xab\x6c\xf5\x76\xb6\x9e\x95\xbd\xb5\xb8\xbb\x7c\xcb\xe9\x2f\x64\xc7\xd1\xce\xe5\x38\x1a\x7c\x8e\xb9\x45\x40\x36\x0e\xde\xb5\x34\xe5\xe4\x96\xe0\x1e\xa9\xa6\x02\xad\x15\x58\x78\xc6\x70\x0f\xbe\xfa\x82\xfd\x20\x3c\x0b\xee\x0a\x2d\xa4\x85\xdf\x7d\xd8\xaf\xaf\x5b\x17\x60\x03\x86\xfd\x6d\xa4\xf7\xb0\xff\xf9\xf1\x6f\xe2\x8f\x23\xef\xc1\xfb\x37\x00\x00\xff\xff\x69\xfe\x3c\x9d\xcf\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xef\x6f\xdb\x36\x14\xfc\xae\xbf\xe2\x46\x0b\x4d\x53\xd8\x96\xed\x7c\x5a\x0c\x77\xf1\x9a\x64\xd3\x96\xd9\x80\xe5\xae\x28\xd2\x60\xa5\xa5\x67\x89\x28\x4d\x6a\x24\x65\xc7\x4b\xf2\xbf\x0f\x94\x7f\x24\x69\x9a\x60\x43\x2d\x7f\x90\xf8\xee\xdd\x1d\x75\x8f\x6a\xfc\x10\x55\xd6\x44\x33\xa1\x22\x52\x4b\xcc\xb8\x2d\x82\x46\x03\xef\x74\xb9\x36\x22\x2f\x1c\x7a\x9d\xee\x8f\x48\x0a\xae\xf2\x82\x0b\xfc\x26\x54\x7e\x5a\x69\xc4\x6a\xae\xcd\x82\x3b\xa1\x15\xa6\x94\x16\x4a\x4b\x9d\xaf\x91\xea\x76\x13\x17\x2e\x6b\x07\x8d\x86\xa7\xb9\x10\x29\x29\x4b\x19\x2a\x95\x91\x81\x2b\x08\xc3\x92\xa7\x05\xed\x2a\x4d\xfc\x49\xc6\x7a\x96\x5e\xbb\x83\xd7\x1e\xc0\xb6\x25\x76\xd8\xf7\x14\x6b\x5d\x61\xc1\xd7\x50\xda\xa1\xb2\x04\x57\x08\x8b\xb9\x90\x04\xba\x4e\xa9\x74\x10\x0a\xa9\x5e\x94\x52\x70\x95\x12\x56\xc2\x15\x70\xf7\x02\xde\x09\x3e\x6e\x39\xf4\xcc\x71\xa1\xc0\x91\xea\x72\x0d\x3d\x7f\x08\x04\x77\x5b\xd3\xf5\xaf\x70\xae\x3c\x8e\xa2\xd5\x6a\xd5\xe6\xb5\xe3\xb6\x36\x79\x24\x37\x58\x1b\x5d\xc4\xef\xce\x46\xc9\x59\xab\xd7\xee\x6c\xbb\xde\x2b\x49\xd6\xc2\xd0\xdf\x95\x30\x94\x61\xb6\x06\x2f\x4b\x29\x52\x3e\x93\x04\xc9\x57\xd0\x06\x3c\x37\x44\x19\x9c\xf6\xae\x57\x46\x38\xa1\xf2\x26\xac\x9e\xbb\x15\x37\xe4\xad\x66\xc2\x3a\x23\x66\x95\x7b\xf4\xd2\x76\x1e\x85\x7d\x04\xd0\x0a\x5c\x81\x0d\x13\xc4\x09\xc3\xcf\xc3\x24\x4e\x9a\x9e\xe4\x43\x3c\xfd\x75\xfc\x7e\x8a\x0f\xc3\xc9\x64\x38\x9a\xc6\x67\x09\xc6\x13\xbc\x1b\x8f\x4e\xe3\x69\x3c\x1e\x25\x18\x9f\x63\x38\xfa\x88\xdf\xe3\xd1\x69\x13\x24\x5c\x41\x06\x74\x5d\x1a\xbf\x03\x6d\x20\xfc\xeb\xa4\x3a\x45\x24\x44\x8f\x2c\xcc\xf5\x26\x47\x5b\x52\x2a\xe6\x22\x85\xe4\x2a\xaf\x78\x4e\xc8\xf5\x92\x8c\x12\x2a\x47\x49\x66\x21\xac\x8f\xd5\x82\xab\xcc\xd3\x48\xb1\x10\xae\x9e\x17\xfb\x74\x5f\xed\x20\x68\x60\xea\x83\xb5\xa9\x11\xa5\x43\x69\xf4\x52\x64\xe4\x83\x5d\x68\x85\x79\xa5\x52\xdf\x5a\x8b\x6f\x20\xd6\x0f\x43\x10\xec\x2a\xc7\xc7\x74\x2d\xac\xb3\x78\x7d\x88\x9b\x00\xc8\x28\x95\xdc\x10\x5a\x73\xb4\xce\x11\x76\xf1\x16\x51\x46\xcb\x48\x55\x52\x06\x80\x21\x57\x19\x85\xf0\xa7\xe0\x2e\x08\x28\x2d\xb4\xa1\x6c\xdb\x09\xf8\x67\xb4\x08\xec\x53\xe7\xe8\xe8\xf2\xa8\xbb\x08\x4f\xea\xbb\xce\x82\xed\xe0\x3e\x46\xf5\x5c\x43\xef\x1b\x0d\x6b\x92\x52\xaf\x9e\xeb\x38\xfa\xaa\xc3\x6f\x9b\xab\xec\xaf\xcd\x9e\xf6\x5d\xdb\x65\xb4\x96\x60\xe1\x09\x7b\xb8\x27\xf4\xde\xbe\xea\xd6\xee\x8c\xd1\xc6\x37\xba\x47\x62\x7e\x22\xc3\x93\xcd\xe3\xb5\x70\xa8\xb1\x52\xe7\x7b\x90\xff\x4b\x9d\x72\x09\x49\x4b\x92\x83\xb0\xbb\x5f\xde\x5d\x8e\xac\x43\xeb\x1f\xb0\xb0\x86\x30\xbc\x7a\x85\x7b\x39\xb0\xcb\xf3\xe1\x74\x78\x71\x05\xa9\xf3\x0d\x49\x7d\x66\xb7\x73\x42\x19\x0b\xf6\x8c\x29\xb7\x74\x4f\x23\xd4\x13\xa9\x78\x74\x3e\x3e\x7c\xb2\xba\xbb\xf6\x09\x80\x5d\x6e\x48\xae\x10\xde\x9c\x1c\xf7\xee\xd8\xb3\x3d\xfd\xfe\x93\xd2\x87\xe1\x64\xf4\xb2\xc8\x26\xb5\xef\x53\x39\x9b\x4c\x5e\x10\x79\xf8\xfa\xbe\x43\xe4\xcd\x7f\x94\xd8\x26\x54\xa9\x2f\x4a\xaf\xd4\x83\xa4\xb6\x59\xfc\x1f\x4d\xb2\x3c\xf5\x53\x14\xdf\x1f\x84\xcd\x00\xa9\x41\x4d\xe3\xcf\xaa\xf2\xdf\xb9\xf0\xa4\x8f\x4c\xef\xfb\xc5\x1c\x97\x97\x08\xbb\x18\x0c\x10\x2a\x5c\x5d\xf5\xfd\xa7\x40\xed\xce\x64\xa7\x8f\xb9\xa8\xc1\x99\x56\x54\xdf\x6c\x2b\xf5\xcc\x4e\xb4\xa4\x3f\xb8\x4b\x8b\xaf\x44\xb9\x31\x7c\x3d\x08\x5f\xfb\xd8\x10\x76\x71\x0b\x67\xc0\x9a\x0c\xec\x93\x62\x87\x7b\x43\xa2\x36\x54\x83\xbf\x61\x8a\x85\x82\xd5\xb6\x7a\x7b\x5b\x7b\xc4\x03\x23\x9d\xfd\xe2\x4b\x4e\x7f\x21\x37\x4e\xf6\x2e\xc7\xc9\xe0\x73\xca\x1d\x22\x72\x69\xf4\xa6\x65\x48\x92\x3f\x04\xb7\xc8\x0d\x95\x68\xad\xc0\xe2\x53\x86\x5b\xf0\xd5\x17\x1c\x44\xf1\x69\x74\x53\x1a\xa1\x1c\xc2\xee\xdd\xc1\x76\xb9\x75\x0e\x36\x60\x38\xd8\x55\x7a\x77\x07\x9f\xef\xbf\x26\xe1\x38\x09\xee\x82\x7f\x07\x00\x69\xfe\x3c\x9d\xcf\x07\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts"].(os.FileInfo),
 		fs["/scripts"].(os.FileInfo),
 	}
+	fs["/charts"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/calico"].(os.FileInfo),
+		fs["/charts/cilium"].(os.FileInfo),
+		fs["/charts/contour"].(os.FileInfo),
+		fs["/charts/flannel"].(os.FileInfo),
+		fs["/charts/ingress-nginx"].(os.FileInfo),
+	}
+	fs["/charts/calico"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/calico/Chart.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates"].(os.FileInfo),
+		fs["/charts/calico/values.yaml"].(os.FileInfo),
+	}
+	fs["/charts/calico/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/calico/templates/_functions.tpl"].(os.FileInfo),
+		fs["/charts/calico/templates/calico-config.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates/calico-etcd-secrets.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates/calico-kube-controllers.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates/calico-node.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates/kdd-crds.yaml"].(os.FileInfo),
+		fs["/charts/calico/templates/rbac.yaml"].(os.FileInfo),
+	}
+	fs["/charts/cilium"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/cilium/Chart.yaml"].(os.FileInfo),
+		fs["/charts/cilium/templates"].(os.FileInfo),
+		fs["/charts/cilium/values.yaml"].(os.FileInfo),
+	}
+	fs["/charts/cilium/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/cilium/templates/cilium-agent.yaml"].(os.FileInfo),
+		fs["/charts/cilium/templates/cilium-config.yaml"].(os.FileInfo),
+		fs["/charts/cilium/templates/cilium-operator.yaml"].(os.FileInfo),
+		fs["/charts/cilium/templates/rbac.yaml"].(os.FileInfo),
+	}
+	fs["/charts/contour"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/contour/Chart.yaml"].(os.FileInfo),
+		fs["/charts/contour/templates"].(os.FileInfo),
+		fs["/charts/contour/values.yaml"].(os.FileInfo),
+	}
+	fs["/charts/contour/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/contour/templates/contour.yaml"].(os.FileInfo),
+		fs["/charts/contour/templates/crd.yaml"].(os.FileInfo),
+		fs["/charts/contour/templates/rbac.yaml"].(os.FileInfo),
+		fs["/charts/contour/templates/service.yaml"].(os.FileInfo),
+	}
+	fs["/charts/flannel"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/flannel/Chart.yaml"].(os.FileInfo),
+		fs["/charts/flannel/templates"].(os.FileInfo),
+		fs["/charts/flannel/values.yaml"].(os.FileInfo),
+	}
+	fs["/charts/flannel/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/flannel/templates/kube-flannel-cfg.yaml"].(os.FileInfo),
+		fs["/charts/flannel/templates/kube-flannel-ds.yaml"].(os.FileInfo),
+		fs["/charts/flannel/templates/rbac.yaml"].(os.FileInfo),
+	}
+	fs["/charts/ingress-nginx"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/ingress-nginx/Chart.yaml"].(os.FileInfo),
+		fs["/charts/ingress-nginx/templates"].(os.FileInfo),
+		fs["/charts/ingress-nginx/values.yaml"].(os.FileInfo),
+	}
+	fs["/charts/ingress-nginx/templates"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
+		fs["/charts/ingress-nginx/templates/configmap.yaml"].(os.FileInfo),
+		fs["/charts/ingress-nginx/templates/controller.yaml"].(os.FileInfo),
+		fs["/charts/ingress-nginx/templates/rbac.yaml"].(os.FileInfo),
+		fs["/charts/ingress-nginx/templates/service.yaml"].(os.FileInfo),
+	}
 	fs["/scripts"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
 		fs["/scripts/check_port_occupied.sh"].(os.FileInfo),
 		fs["/scripts/check_system_preference.sh"].(os.FileInfo),
@@ -148,6 +484,7 @@ var Assets = func() http.FileSystem {
 		fs["/scripts/init_change_network.sh"].(os.FileInfo),
 		fs["/scripts/init_change_route.sh"].(os.FileInfo),
 		fs["/scripts/init_change_swap.sh"].(os.FileInfo),
+		fs["/scripts/init_change_timesync.sh"].(os.FileInfo),
 		fs["/scripts/init_deploy_haproxy.sh"].(os.FileInfo),
 		fs["/scripts/init_deploy_haproxy_keepalived"].(os.FileInfo),
 		fs["/scripts/init_deploy_keepalived.sh"].(os.FileInfo),
@@ -184,6 +521,11 @@ func (fs vfsgen۰FS) Open(path string) (http.File, error) {
 			vfsgen۰CompressedFileInfo: f,
 			gr:                        gr,
 		}, nil
+	case *vfsgen۰FileInfo:
+		return &vfsgen۰File{
+			vfsgen۰FileInfo: f,
+			Reader:          bytes.NewReader(f.content),
+		}, nil
 	case *vfsgen۰DirInfo:
 		return &vfsgen۰Dir{
 			vfsgen۰DirInfo: f,
@@ -265,6 +607,37 @@ func (f *vfsgen۰CompressedFile) Close() error {
 	return f.gr.Close()
 }
 
+// vfsgen۰FileInfo is a static definition of an uncompressed file (because it's not worth gzip compressing).
+type vfsgen۰FileInfo struct {
+	name    string
+	modTime time.Time
+	content []byte
+}
+
+func (f *vfsgen۰FileInfo) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, fmt.Errorf("cannot Readdir from file %s", f.name)
+}
+func (f *vfsgen۰FileInfo) Stat() (os.FileInfo, error) { return f, nil }
+
+func (f *vfsgen۰FileInfo) NotWorthGzipCompressing() {}
+
+func (f *vfsgen۰FileInfo) Name() string       { return f.name }
+func (f *vfsgen۰FileInfo) Size() int64        { return int64(len(f.content)) }
+func (f *vfsgen۰FileInfo) Mode() os.FileMode  { return 0444 }
+func (f *vfsgen۰FileInfo) ModTime() time.Time { return f.modTime }
+func (f *vfsgen۰FileInfo) IsDir() bool        { return false }
+func (f *vfsgen۰FileInfo) Sys() interface{}   { return nil }
+
+// vfsgen۰File is an opened file instance.
+type vfsgen۰File struct {
+	*vfsgen۰FileInfo
+	*bytes.Reader
+}
+
+func (f *vfsgen۰File) Close() error {
+	return nil
+}
+
 // vfsgen۰DirInfo is a static definition of a directory.
 type vfsgen۰DirInfo struct {
 	name    string
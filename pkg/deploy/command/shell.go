@@ -30,6 +30,7 @@ type ShellCommand struct {
 	args             []string
 	executeLogWriter io.Writer
 	description      string
+	secrets          []string
 }
 
 func NewShellCommand(machine machine.IMachine, cmd string, args ...string) *ShellCommand {
@@ -50,6 +51,12 @@ func (c *ShellCommand) WithExecuteLogWriter(w io.Writer) *ShellCommand {
 	return c
 }
 
+// WithSecrets redacts the secrets from the execute log of the command
+func (c *ShellCommand) WithSecrets(secrets ...string) *ShellCommand {
+	c.secrets = append(c.secrets, secrets...)
+	return c
+}
+
 func (c *ShellCommand) Execute() (stdout, stderr []byte, err error) {
 	startTime := time.Now()
 	stdout, stderr, err = c.machine.Run(c.GetCommand())
@@ -63,6 +70,7 @@ func (c *ShellCommand) Execute() (stdout, stderr []byte, err error) {
 			Stderr:      stderr,
 			Err:         err,
 			Description: c.description,
+			Secrets:     c.secrets,
 		}
 		utils.WriteExecuteLog(c.executeLogWriter, executeLogItem)
 	}
@@ -19,4 +19,7 @@ const (
 
 	MasterTanitKey    = "node-role.kubernetes.io/master"
 	MasterTaintEffect = "NoSchedule"
+
+	// ContainerdCRISocket is the CRI socket of containerd used by kubelet and kubeadm
+	ContainerdCRISocket = "/run/containerd/containerd.sock"
 )
@@ -17,7 +17,9 @@ package consts
 type NetworkType string
 
 const (
-	NetworkTypeCalico NetworkType = "calico"
+	NetworkTypeCalico  NetworkType = "calico"
+	NetworkTypeFlannel NetworkType = "flannel"
+	NetworkTypeCilium  NetworkType = "cilium"
 )
 
 type EncapsulationMode string
@@ -29,7 +31,22 @@ const (
 )
 
 const (
-	DefaultVxlanPort = 4789
+	FlannelBackendVxlan  = "vxlan"
+	FlannelBackendHostGW = "host-gw"
+)
+
+const (
+	CiliumTunnelModeVxlan    = "vxlan"
+	CiliumTunnelModeGeneve   = "geneve"
+	CiliumTunnelModeDisabled = "disabled"
+)
+
+const (
+	DefaultVxlanPort        = 4789
+	DefaultFlannelVxlanPort = 8472
+	DefaultCiliumVxlanPort  = 8472
+	DefaultCiliumGenevePort = 6081
+	DefaultCiliumHealthPort = 4240
 )
 
 type Protocol string
@@ -39,3 +56,46 @@ const (
 	ProtocolUDP  = "udp"
 	ProtocolIPIP = "ipip"
 )
+
+type ContainerRuntime string
+
+const (
+	ContainerRuntimeDocker     ContainerRuntime = "docker"
+	ContainerRuntimeContainerd ContainerRuntime = "containerd"
+)
+
+type FirewallMode string
+
+const (
+	FirewallModeDisable   FirewallMode = "disable"
+	FirewallModeOpenPorts FirewallMode = "open-ports"
+)
+
+type SELinuxMode string
+
+const (
+	SELinuxModeDisabled   SELinuxMode = "disabled"
+	SELinuxModePermissive SELinuxMode = "permissive"
+	SELinuxModeEnforcing  SELinuxMode = "enforcing"
+)
+
+type SwapPolicy string
+
+const (
+	SwapPolicyDisable SwapPolicy = "disable"
+	SwapPolicyKeep    SwapPolicy = "keep"
+)
+
+type HostnamePolicy string
+
+const (
+	HostnamePolicyNodeName HostnamePolicy = "node-name"
+	HostnamePolicyKeep     HostnamePolicy = "keep"
+)
+
+type KubeProxyMode string
+
+const (
+	KubeProxyModeIPTables KubeProxyMode = "iptables"
+	KubeProxyModeIPVS     KubeProxyMode = "ipvs"
+)
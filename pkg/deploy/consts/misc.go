@@ -24,4 +24,10 @@ const (
 	// 1. difference between pkg/constant
 	// 2. what const should be placed where
 	DefaultK8sConfigDir = "/etc/kubernetes"
+
+	// DefaultProxyEnvFile exports the proxy settings of nodes for kubeadm, it's written by node init
+	DefaultProxyEnvFile = DefaultK8sConfigDir + "/proxy.env"
 )
+
+// IPv6DualStackFeatureGate enables dual-stack in kubernetes components
+const IPv6DualStackFeatureGate = "IPv6DualStack"
@@ -16,16 +16,23 @@ package deploy
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 const (
 	defaultApiServerPort = 6443
 	defaultHAProxyPort   = 4443
+
+	defaultNodePortMinimum = 30000
+	defaultNodePortMaximum = 32767
 )
 
 var (
@@ -48,7 +55,7 @@ func GetControlPlaneEndpoint(clusterConfig *pb.ClusterConfig, masterNodes []*pb.
 		return "", fmt.Errorf("nil %T encountered", conn)
 	}
 
-	// type could be ["firstMasterIP", "keepalived", "loadbalancer"]
+	// type could be ["firstMasterIP", "keepalived", "loadbalancer", "kubevip"]
 	switch conn.Type {
 	case "firstMasterIP":
 		ip := masterNodes[0].Ip
@@ -56,11 +63,22 @@ func GetControlPlaneEndpoint(clusterConfig *pb.ClusterConfig, masterNodes []*pb.
 			err = fmt.Errorf("failed to get first master ip")
 			return
 		}
-		addr = fmt.Sprintf("%v:%v", ip, defaultApiServerPort)
+		addr = JoinHostPort(ip, defaultApiServerPort)
 	case "keepalived":
-		addr = fmt.Sprintf("%v:%v", conn.Keepalived.Vip, defaultHAProxyPort)
+		port := conn.Keepalived.GetHaproxyPort()
+		if port == 0 {
+			port = defaultHAProxyPort
+		}
+		addr = JoinHostPort(conn.Keepalived.Vip, port)
 	case "loadbalancer":
-		addr = fmt.Sprintf("%v:%v", conn.Loadbalancer.Ip, conn.Loadbalancer.Port)
+		addr = JoinHostPort(conn.Loadbalancer.Ip, conn.Loadbalancer.Port)
+	case "kubevip":
+		// kube-vip only holds the vip on one of masters without proxying, so apiserver port is used
+		if conn.KubeVIP == nil || conn.KubeVIP.Vip == "" {
+			err = fmt.Errorf("failed to get kube-vip virtual ip")
+			return
+		}
+		addr = JoinHostPort(conn.KubeVIP.Vip, defaultApiServerPort)
 	case "test":
 		addr = "test"
 	default:
@@ -70,6 +88,139 @@ func GetControlPlaneEndpoint(clusterConfig *pb.ClusterConfig, masterNodes []*pb.
 	return
 }
 
+// JoinHostPort combines host and port into an address, IPv6 host is enclosed in square brackets
+func JoinHostPort(host string, port interface{}) string {
+	return net.JoinHostPort(host, fmt.Sprintf("%v", port))
+}
+
+// IsIPv6 returns true if ip is a valid IPv6 address
+func IsIPv6(ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.To4() == nil
+}
+
+// IsIPv6CIDR returns true if cidr is a valid IPv6 CIDR
+func IsIPv6CIDR(cidr string) bool {
+	ip, _, err := net.ParseCIDR(cidr)
+	return err == nil && ip.To4() == nil
+}
+
+// splitSubnets splits the comma separated subnets, empty items are dropped
+func splitSubnets(subnets string) []string {
+	var result []string
+	for _, subnet := range strings.Split(subnets, ",") {
+		if subnet = strings.TrimSpace(subnet); subnet != "" {
+			result = append(result, subnet)
+		}
+	}
+	return result
+}
+
+// GetPodSubnets returns pod subnets of the cluster, podSubnets takes precedence over podSubnet
+func GetPodSubnets(clusterConfig *pb.ClusterConfig) []string {
+	if len(clusterConfig.GetPodSubnets()) > 0 {
+		return clusterConfig.GetPodSubnets()
+	}
+	return splitSubnets(clusterConfig.GetPodSubnet())
+}
+
+// GetServiceSubnets returns service subnets of the cluster, serviceSubnets takes precedence over serviceSubnet
+func GetServiceSubnets(clusterConfig *pb.ClusterConfig) []string {
+	if len(clusterConfig.GetServiceSubnets()) > 0 {
+		return clusterConfig.GetServiceSubnets()
+	}
+	return splitSubnets(clusterConfig.GetServiceSubnet())
+}
+
+// GetSubnetOfFamily returns the first IPv4 or IPv6 subnet in subnets, empty if not found
+func GetSubnetOfFamily(subnets []string, ipv6 bool) string {
+	for _, subnet := range subnets {
+		if IsIPv6CIDR(subnet) == ipv6 {
+			return subnet
+		}
+	}
+	return ""
+}
+
+// IsDualStack returns true if the cluster has both IPv4 and IPv6 pod subnets
+func IsDualStack(clusterConfig *pb.ClusterConfig) bool {
+	podSubnets := GetPodSubnets(clusterConfig)
+	return GetSubnetOfFamily(podSubnets, false) != "" && GetSubnetOfFamily(podSubnets, true) != ""
+}
+
+// GetKubeProxyMode returns the kube-proxy mode of the cluster, ipvs is used for dual-stack if not set since
+// kube-proxy only supports dual-stack in ipvs mode. It's empty if the mode is left to kubeadm.
+func GetKubeProxyMode(clusterConfig *pb.ClusterConfig) consts.KubeProxyMode {
+	mode := consts.KubeProxyMode(clusterConfig.GetComponentConfig().GetKubeProxyMode())
+	if mode == "" && IsDualStack(clusterConfig) {
+		return consts.KubeProxyModeIPVS
+	}
+	return mode
+}
+
+// GetContainerRuntime returns container runtime of the cluster, docker is used if empty
+func GetContainerRuntime(clusterConfig *pb.ClusterConfig) consts.ContainerRuntime {
+	if runtime := clusterConfig.GetContainerRuntime(); runtime != "" {
+		return consts.ContainerRuntime(runtime)
+	}
+	return consts.ContainerRuntimeDocker
+}
+
+// ValidateContainerRuntime checks the container runtime is supported, empty means the default one
+func ValidateContainerRuntime(runtime string) error {
+	switch consts.ContainerRuntime(runtime) {
+	case "", consts.ContainerRuntimeDocker, consts.ContainerRuntimeContainerd:
+		return nil
+	default:
+		return fmt.Errorf("unsupported container runtime: %q, should be %q or %q",
+			runtime, consts.ContainerRuntimeDocker, consts.ContainerRuntimeContainerd)
+	}
+}
+
+// GetNodePortRange returns the NodePort range of the cluster, the kubernetes default range is used if not set
+func GetNodePortRange(clusterConfig *pb.ClusterConfig) (from, to uint32) {
+	nodePortRange := clusterConfig.GetNodePortRange()
+	if nodePortRange.GetFrom() == 0 || nodePortRange.GetTo() == 0 {
+		return defaultNodePortMinimum, defaultNodePortMaximum
+	}
+	return nodePortRange.GetFrom(), nodePortRange.GetTo()
+}
+
+// IsSwapKept returns true if swap is kept on nodes by the node init profile, kubelet must run with swap then
+func IsSwapKept(clusterConfig *pb.ClusterConfig) bool {
+	return consts.SwapPolicy(clusterConfig.GetNodeInitProfile().GetSwapPolicy()) == consts.SwapPolicyKeep
+}
+
+// GetImageRepository returns the repository of kubernetes and etcd images, the default repository is used if empty
+func GetImageRepository(clusterConfig *pb.ClusterConfig) string {
+	if repository := strings.TrimSuffix(clusterConfig.GetImageRepository(), "/"); repository != "" {
+		return repository
+	}
+	return constant.DefaultImageRepository
+}
+
+// IsProxyUsed returns true if nodes reach outside through a http or https proxy
+func IsProxyUsed(clusterConfig *pb.ClusterConfig) bool {
+	proxy := clusterConfig.GetProxy()
+	return proxy.GetHttpProxy() != "" || proxy.GetHttpsProxy() != ""
+}
+
+// ValidateSubnets checks subnets are valid CIDRs with at most one subnet of each ip family
+func ValidateSubnets(subnets []string) error {
+	families := make(map[bool]string)
+	for _, subnet := range subnets {
+		if _, _, err := net.ParseCIDR(subnet); err != nil {
+			return fmt.Errorf("invalid subnet %q: %v", subnet, err)
+		}
+		ipv6 := IsIPv6CIDR(subnet)
+		if existing, ok := families[ipv6]; ok {
+			return fmt.Errorf("subnets %q and %q are of the same ip family", existing, subnet)
+		}
+		families[ipv6] = subnet
+	}
+	return nil
+}
+
 // PBErrLogger creates a new logging entry with the content of a pb.Error added as struct info,
 // the new entry is set based on the passed in logging entry.
 func PBErrLogger(pbErr *pb.Error, entry *logrus.Entry) *logrus.Entry {
@@ -16,8 +16,11 @@ package machine
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
+	"net"
 	"strings"
+	"time"
 
 	dockerclient "github.com/docker/docker/client"
 
@@ -81,8 +84,49 @@ func (m *MockMachine) Run(cmd string) (stdout, stderr []byte, err error) {
 		return []byte("294605168640"), nil, nil
 	case strings.HasPrefix(cmd, "ps -p 1"):
 		return []byte("systemd"), nil, nil
-	case strings.HasPrefix(cmd, "cat /etc/*-release"):
-		return []byte("ubuntu"), nil, nil
+	case strings.HasPrefix(cmd, "cat /etc/os-release"):
+		if osRelease, ok := mockOSReleases[m.Name]; ok {
+			return []byte(osRelease), nil, nil
+		}
+		return []byte(mockOSReleases["ubuntu-20.04"]), nil, nil
+	case strings.HasPrefix(cmd, "ip -o addr show"):
+		return []byte(fmt.Sprintf("%v/24\n", m.Ip)), nil, nil
+	case strings.HasPrefix(cmd, "ip -4 -o route show"):
+		network := &net.IPNet{IP: net.ParseIP(m.Ip).Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}
+		return []byte(fmt.Sprintf("default dev eth0\n%v dev eth0 proto kernel scope link src %v\n", network, m.Ip)), nil, nil
+	case strings.HasPrefix(cmd, "timeout") && strings.Contains(cmd, "/dev/tcp/"):
+		return []byte("reachable\n"), nil, nil
+	case strings.HasPrefix(cmd, "/usr/bin/kubectl get pods") && strings.Contains(cmd, "{range"):
+		return []byte(fmt.Sprintf("pod-%v Running %v %v\n", m.Name, m.Ip, m.Name)), nil, nil
+	case strings.HasPrefix(cmd, "/usr/bin/kubectl get pods"):
+		return []byte("Running"), nil, nil
+	case strings.HasPrefix(cmd, "/usr/bin/kubectl get nodes"):
+		return []byte(fmt.Sprintf("%v True\n", m.Name)), nil, nil
+	case strings.HasPrefix(cmd, "/usr/bin/kubectl get service"):
+		return []byte("10.96.0.100 30080"), nil, nil
+	case strings.HasPrefix(cmd, "/usr/bin/kubectl get node "):
+		return []byte("True"), nil, nil
+	case strings.HasPrefix(cmd, `echo "$(date`):
+		return []byte(fmt.Sprintf("%.9f 1\n", float64(time.Now().UnixNano())/float64(time.Second))), nil, nil
+	case strings.HasPrefix(cmd, "awk '/^nameserver/"):
+		return []byte("10.10.10.1 reachable\n"), nil, nil
+	case strings.HasPrefix(cmd, "for module in"):
+		return []byte("br_netfilter loaded\noverlay loaded\nip_vs available\n"), nil, nil
+	case strings.HasPrefix(cmd, "for tool in"):
+		return []byte("containerd 1.4.3\ncrictl 1.13.0\n"), nil, nil
+	case strings.HasPrefix(cmd, "docker info"):
+		return []byte("cgroupfs\n"), nil, nil
+	case strings.HasPrefix(cmd, "df -i"):
+		return []byte("/ 5%\n/var/lib 5%\n"), nil, nil
+	case strings.HasPrefix(cmd, "dd if=/dev/zero"):
+		return []byte("0.4\n"), nil, nil
+	case strings.HasPrefix(cmd, `echo "uuid`):
+		// identities are unique by the ip of machine
+		hash := fnv.New32a()
+		hash.Write([]byte(m.Ip))
+		sum := hash.Sum32()
+		return []byte(fmt.Sprintf("uuid %08x-0000-0000-0000-000000000000\nmac 52:54:00:%02x:%02x:%02x\n",
+			sum, byte(sum>>16), byte(sum>>8), byte(sum))), nil, nil
 	}
 
 	return []byte(""), []byte(""), nil
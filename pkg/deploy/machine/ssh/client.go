@@ -16,6 +16,7 @@ package ssh
 
 import (
 	"fmt"
+	"net"
 	"time"
 
 	"golang.org/x/crypto/ssh"
@@ -58,7 +59,7 @@ func NewClient(user string, host string, sshConfig *pb.SSH) (*ssh.Client, error)
 		return nil, fmt.Errorf("failed to get ssh client config: %v, error: %v", sshConfig, err)
 	}
 
-	client, err := ssh.Dial("tcp", fmt.Sprintf("%v:%v", host, sshConfig.Port), config)
+	client, err := ssh.Dial("tcp", net.JoinHostPort(host, fmt.Sprintf("%v", sshConfig.Port)), config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial: %v, error: %v", host, err)
 	}
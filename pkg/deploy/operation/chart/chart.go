@@ -0,0 +1,103 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chart
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/shurcooL/httpfs/vfsutil"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+)
+
+const chartsRoot = "/charts"
+
+// loadChart loads the chart embedded in assets
+func loadChart(name string) (*chart.Chart, error) {
+	root := path.Join(chartsRoot, name)
+
+	var files []*loader.BufferedFile
+	err := vfsutil.Walk(assets.Assets, root, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		data, err := vfsutil.ReadFile(assets.Assets, filePath)
+		if err != nil {
+			return err
+		}
+		files = append(files, &loader.BufferedFile{
+			Name: strings.TrimPrefix(filePath, root+"/"),
+			Data: data,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chart %v, error: %v", name, err)
+	}
+
+	return loader.LoadFiles(files)
+}
+
+// Render renders the embedded chart with values as a release in namespace,
+// and returns the manifests joined in a multi-document yaml.
+func Render(name string, namespace string, values map[string]interface{}) (string, error) {
+	ch, err := loadChart(name)
+	if err != nil {
+		return "", err
+	}
+
+	renderValues, err := chartutil.ToRenderValues(ch, values, chartutil.ReleaseOptions{
+		Name:      name,
+		Namespace: namespace,
+		IsInstall: true,
+	}, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to compose values of chart %v, error: %v", name, err)
+	}
+
+	rendered, err := engine.Render(ch, renderValues)
+	if err != nil {
+		return "", fmt.Errorf("failed to render chart %v, error: %v", name, err)
+	}
+
+	// sort by file name to keep the order stable, files such as NOTES.txt and helpers are skipped
+	fileNames := make([]string, 0, len(rendered))
+	for fileName, content := range rendered {
+		if !strings.HasSuffix(fileName, ".yaml") || strings.TrimSpace(content) == "" {
+			continue
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	manifests := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		manifests = append(manifests, fmt.Sprintf("---\n# Source: %v\n%v", fileName, strings.TrimSpace(rendered[fileName])))
+	}
+
+	return strings.Join(manifests, "\n") + "\n", nil
+}
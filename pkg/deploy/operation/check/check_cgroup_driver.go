@@ -0,0 +1,67 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// KubeletCgroupDriver is the cgroup driver set for kubelet with docker runtime by init_deploy_kubetool.sh
+const KubeletCgroupDriver = "cgroupfs"
+
+type CheckCgroupDriverOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckCgroupDriverOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	ckops.shellCmd = command.NewShellCommand(m, "docker", "info --format '{{.CgroupDriver}}'").
+		WithDescription("检查 docker cgroup driver 是否与 kubelet 一致").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckCgroupDriver checks the cgroup driver of container runtime is the same as kubelet
+func CheckCgroupDriver(cgroupDriver string, desiredCgroupDriver string) error {
+	cgroupDriver = strings.TrimSpace(cgroupDriver)
+	if cgroupDriver != desiredCgroupDriver {
+		return fmt.Errorf("cgroup driver of container runtime is %q, but kubelet uses %q", cgroupDriver, desiredCgroupDriver)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	ContainerdTool = "containerd"
+	CrictlTool     = "crictl"
+
+	// toolMissing is printed instead of version if the tool is not installed
+	toolMissing = "missing"
+)
+
+type CheckContainerdOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckContainerdOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// print "<tool> <version>" for each tool, version is the third field of both "containerd --version"
+	// and "crictl --version", "<tool> missing" is printed if the tool is not installed
+	ckops.shellCmd = command.NewShellCommand(m, "for", fmt.Sprintf("tool in %v %v; do if command -v $tool >/dev/null 2>&1; "+
+		"then echo \"$tool $($tool --version | awk '{print $3; exit}')\"; else echo \"$tool %v\"; fi; done",
+		ContainerdTool, CrictlTool, toolMissing)).
+		WithDescription("检查机器 containerd 与 crictl 版本").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// ParseContainerdTools parses the output of containerd check into versions keyed by tool,
+// the version of a tool not installed is empty
+func ParseContainerdTools(result string) (map[string]string, error) {
+	versions := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("unrecognized containerd check result: %q", line)
+		}
+		version := strings.TrimPrefix(fields[1], "v")
+		if version == toolMissing {
+			version = ""
+		}
+		versions[fields[0]] = version
+	}
+
+	for _, tool := range []string{ContainerdTool, CrictlTool} {
+		if _, ok := versions[tool]; !ok {
+			return nil, fmt.Errorf("%v is absent in containerd check result", tool)
+		}
+	}
+	return versions, nil
+}
+
+// CheckContainerdVersion checks containerd version is larger or equal than standard version
+func CheckContainerdVersion(containerdVersion string, standardVersion string) error {
+	return operation.CheckVersion(containerdVersion, standardVersion, operation.CheckLarge)
+}
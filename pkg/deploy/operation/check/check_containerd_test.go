@@ -0,0 +1,43 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseContainerdTools(t *testing.T) {
+	versions, err := ParseContainerdTools("containerd v1.4.3\ncrictl v1.13.0\n")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{ContainerdTool: "1.4.3", CrictlTool: "1.13.0"}, versions)
+
+	versions, err = ParseContainerdTools("containerd 1.3.3\ncrictl missing")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{ContainerdTool: "1.3.3", CrictlTool: ""}, versions)
+
+	_, err = ParseContainerdTools("containerd missing")
+	assert.Error(t, err)
+
+	_, err = ParseContainerdTools("containerd: command not found")
+	assert.Error(t, err)
+}
+
+func TestCheckContainerdVersion(t *testing.T) {
+	assert.NoError(t, CheckContainerdVersion("1.4.3", "1.3.0"))
+	assert.NoError(t, CheckContainerdVersion("1.3.0", "1.3.0"))
+	assert.Error(t, CheckContainerdVersion("1.2.13", "1.3.0"))
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	CustomCheckSeverityError   = "error"
+	CustomCheckSeverityWarning = "warning"
+
+	customCheckScriptPrefix = "kpaas-custom-check-"
+)
+
+// name of custom check is a part of the script path on node
+var customCheckNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// CheckCustomOperation runs the script of a user defined check on node.
+type CheckCustomOperation struct {
+	shellCmd *command.ShellCommand
+	Item     *pb.CustomCheckItem
+}
+
+func (ckops *CheckCustomOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	scriptPath := path.Join(checkRemoteScriptPath, customCheckScriptPrefix+ckops.Item.GetName()+".sh")
+	if err = m.PutFile(strings.NewReader(ckops.Item.GetScript()), scriptPath); err != nil {
+		return nil, nil, fmt.Errorf("failed to put script to %v: %v", scriptPath, err)
+	}
+
+	ckops.shellCmd = command.NewShellCommand(m, "bash", scriptPath).
+		WithDescription(fmt.Sprintf("运行自定义检查 %v", ckops.Item.GetName())).
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// ValidateCustomCheckItem checks the custom check item is well defined
+func ValidateCustomCheckItem(item *pb.CustomCheckItem) error {
+	if item == nil {
+		return fmt.Errorf("custom check item is nil")
+	}
+	if !customCheckNameRegexp.MatchString(item.GetName()) {
+		return fmt.Errorf("invalid name of custom check item %q, it should consist of letters, digits, '_', '.' and '-'", item.GetName())
+	}
+	if strings.TrimSpace(item.GetScript()) == "" {
+		return fmt.Errorf("script of custom check item %v is empty", item.GetName())
+	}
+	if _, err := regexp.Compile(item.GetExpectedOutput()); err != nil {
+		return fmt.Errorf("invalid expected output of custom check item %v: %v", item.GetName(), err)
+	}
+	switch item.GetSeverity() {
+	case "", CustomCheckSeverityError, CustomCheckSeverityWarning:
+	default:
+		return fmt.Errorf("invalid severity of custom check item %v: %q", item.GetName(), item.GetSeverity())
+	}
+	for _, role := range item.GetRoles() {
+		if !validRole(role) {
+			return fmt.Errorf("invalid role of custom check item %v: %q", item.GetName(), role)
+		}
+	}
+	return nil
+}
+
+// MergeCustomCheckItems appends the items to the base items, the base item with the same name is replaced
+func MergeCustomCheckItems(base, items []*pb.CustomCheckItem) []*pb.CustomCheckItem {
+	merged := make([]*pb.CustomCheckItem, 0, len(base)+len(items))
+	index := make(map[string]int)
+	for _, item := range append(append([]*pb.CustomCheckItem{}, base...), items...) {
+		if i, ok := index[item.GetName()]; ok {
+			merged[i] = item
+			continue
+		}
+		index[item.GetName()] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
+
+// CustomCheckApplies returns true if the custom check runs on the node with the roles
+func CustomCheckApplies(item *pb.CustomCheckItem, roles []string) bool {
+	if len(item.GetRoles()) == 0 {
+		return true
+	}
+	for _, itemRole := range item.GetRoles() {
+		for _, role := range roles {
+			if itemRole == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckCustomOutput matches the output of custom check script against the expected output
+func CheckCustomOutput(output string, expectedOutput string) error {
+	if expectedOutput == "" {
+		return nil
+	}
+	matched, err := regexp.MatchString(expectedOutput, strings.TrimSpace(output))
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return fmt.Errorf("output %q does not match %q", strings.TrimSpace(output), expectedOutput)
+	}
+	return nil
+}
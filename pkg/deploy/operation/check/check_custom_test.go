@@ -0,0 +1,69 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestValidateCustomCheckItem(t *testing.T) {
+	assert.NoError(t, ValidateCustomCheckItem(&pb.CustomCheckItem{Name: "auditd", Script: "systemctl is-active auditd",
+		ExpectedOutput: "^active$", Severity: "warning", Roles: []string{"master", "worker"}}))
+	assert.NoError(t, ValidateCustomCheckItem(&pb.CustomCheckItem{Name: "ntp.synced_1", Script: "true"}))
+
+	invalidItems := []*pb.CustomCheckItem{
+		nil,
+		{Script: "true"},
+		{Name: "../auditd", Script: "true"},
+		{Name: "auditd", Script: " "},
+		{Name: "auditd", Script: "true", ExpectedOutput: "(active"},
+		{Name: "auditd", Script: "true", Severity: "info"},
+		{Name: "auditd", Script: "true", Roles: []string{"node"}},
+	}
+	for _, item := range invalidItems {
+		assert.Error(t, ValidateCustomCheckItem(item), "%v", item)
+	}
+}
+
+func TestMergeCustomCheckItems(t *testing.T) {
+	base := []*pb.CustomCheckItem{
+		{Name: "auditd", Script: "systemctl is-active auditd"},
+		{Name: "ntp", Script: "chronyc tracking"},
+	}
+	items := []*pb.CustomCheckItem{
+		{Name: "ntp", Script: "timedatectl"},
+		{Name: "selinux", Script: "getenforce"},
+	}
+	assert.Equal(t, []*pb.CustomCheckItem{base[0], items[0], items[1]}, MergeCustomCheckItems(base, items))
+	assert.Equal(t, base, MergeCustomCheckItems(base, nil))
+	assert.Empty(t, MergeCustomCheckItems(nil, nil))
+}
+
+func TestCustomCheckApplies(t *testing.T) {
+	assert.True(t, CustomCheckApplies(&pb.CustomCheckItem{}, []string{"etcd"}))
+	assert.True(t, CustomCheckApplies(&pb.CustomCheckItem{Roles: []string{"worker", "etcd"}}, []string{"master", "etcd"}))
+	assert.False(t, CustomCheckApplies(&pb.CustomCheckItem{Roles: []string{"worker"}}, []string{"master", "etcd"}))
+}
+
+func TestCheckCustomOutput(t *testing.T) {
+	assert.NoError(t, CheckCustomOutput("anything", ""))
+	assert.NoError(t, CheckCustomOutput("active\n", "^active$"))
+	assert.Error(t, CheckCustomOutput("inactive\n", "^active$"))
+	assert.Error(t, CheckCustomOutput("active", "(active"))
+}
@@ -0,0 +1,88 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// the benchmark writes blocks of the size of a typical etcd WAL entry, each followed by fdatasync
+	diskSyncBenchmarkFile      = "/var/lib/kpaas-disk-sync-benchmark"
+	diskSyncBenchmarkBlockSize = 2300
+	DiskSyncBenchmarkWrites    = 200
+)
+
+// CheckDiskSyncOperation benchmarks the latency of synchronized writes on the disk where etcd stores data
+type CheckDiskSyncOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckDiskSyncOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is the seconds spent by dd, like "..., 2300000 bytes (2.3 MB) copied, 0.52 s, 4.4 MB/s"
+	ckops.shellCmd = command.NewShellCommand(m, "dd",
+		fmt.Sprintf("if=/dev/zero of=%v bs=%v count=%v oflag=dsync 2>&1 | awk '/copied/{print $(NF-3)}'; rm -f %v",
+			diskSyncBenchmarkFile, diskSyncBenchmarkBlockSize, DiskSyncBenchmarkWrites, diskSyncBenchmarkFile)).
+		WithDescription("检查 etcd 数据盘同步写入延迟").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// ParseDiskSyncLatency returns the average latency of each synchronized write from the seconds spent by the writes
+func ParseDiskSyncLatency(result string, writes int) (time.Duration, error) {
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(result), 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse result of disk sync benchmark %q: %v", result, err)
+	}
+	if writes <= 0 {
+		return 0, fmt.Errorf("invalid number of writes: %v", writes)
+	}
+	return time.Duration(seconds * float64(time.Second) / float64(writes)), nil
+}
+
+// CheckDiskSyncLatency checks the average latency of synchronized write is under the max latency
+func CheckDiskSyncLatency(latency time.Duration, maxLatency time.Duration) error {
+	if latency > maxLatency {
+		return fmt.Errorf("average latency of synchronized write is %v, which exceeds %v", latency, maxLatency)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiskSyncLatency(t *testing.T) {
+	latency, err := ParseDiskSyncLatency("0.5\n", 200)
+	assert.NoError(t, err)
+	assert.Equal(t, 2500*time.Microsecond, latency)
+
+	_, err = ParseDiskSyncLatency("", 200)
+	assert.Error(t, err)
+
+	_, err = ParseDiskSyncLatency("0.5", 0)
+	assert.Error(t, err)
+}
+
+func TestCheckDiskSyncLatency(t *testing.T) {
+	assert.NoError(t, CheckDiskSyncLatency(2*time.Millisecond, 10*time.Millisecond))
+	assert.Error(t, CheckDiskSyncLatency(20*time.Millisecond, 10*time.Millisecond))
+}
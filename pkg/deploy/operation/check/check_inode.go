@@ -0,0 +1,94 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// directories where images, containers and etcd data are stored
+var inodeCheckPaths = []string{"/", "/var/lib"}
+
+// CheckInodeOperation gets the inode usage of the file systems of root and /var/lib
+type CheckInodeOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckInodeOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is a line of "<mount point> <usage>%" for each path
+	ckops.shellCmd = command.NewShellCommand(m, "df", fmt.Sprintf("-i --output=target,ipcent %v | tail -n +2", strings.Join(inodeCheckPaths, " "))).
+		WithDescription("检查机器 inode 使用率").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckInodeUsage checks the inode usage of every file system is under the max usage percent,
+// usage of file system without inode limit is shown as "-" by df and skipped.
+func CheckInodeUsage(result string, maxUsage float64) error {
+	var checked int
+	for _, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return fmt.Errorf("unknown inode usage: %q", line)
+		}
+
+		checked++
+		if fields[1] == "-" {
+			continue
+		}
+
+		usage, err := strconv.ParseFloat(strings.TrimSuffix(fields[1], "%"), 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse inode usage %q: %v", line, err)
+		}
+		if usage >= maxUsage {
+			return fmt.Errorf("inode usage of %v is %v%%, which reaches %v%%", fields[0], usage, maxUsage)
+		}
+	}
+
+	if checked == 0 {
+		return fmt.Errorf("inode usage is empty")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	KernelModuleBrNetfilter = "br_netfilter"
+	KernelModuleOverlay     = "overlay"
+	KernelModuleIPVS        = "ip_vs"
+
+	kernelModuleLoaded    = "loaded"
+	kernelModuleAvailable = "available"
+	kernelModuleMissing   = "missing"
+)
+
+// kernel modules checked on node
+var kernelModules = []string{KernelModuleBrNetfilter, KernelModuleOverlay, KernelModuleIPVS}
+
+// CheckKernelModuleOperation checks whether the kernel modules are loaded, or could be loaded by modprobe
+type CheckKernelModuleOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckKernelModuleOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is a line of "<module> <loaded|available|missing>" for each module
+	ckops.shellCmd = command.NewShellCommand(m, "for",
+		fmt.Sprintf(`module in %v; do if [ -d /sys/module/$module ]; then echo "$module %v"; `+
+			`elif modinfo $module >/dev/null 2>&1; then echo "$module %v"; else echo "$module %v"; fi; done`,
+			strings.Join(kernelModules, " "), kernelModuleLoaded, kernelModuleAvailable, kernelModuleMissing)).
+		WithDescription("检查机器内核模块 br_netfilter, overlay, ip_vs 是否可用").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckKernelModules checks the modules are loaded or available on node
+func CheckKernelModules(result string, modules []string) error {
+	states := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			states[fields[0]] = fields[1]
+		}
+	}
+
+	var missing []string
+	for _, module := range modules {
+		switch states[module] {
+		case kernelModuleLoaded, kernelModuleAvailable:
+		default:
+			missing = append(missing, module)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("kernel modules %v are neither loaded nor available", missing)
+	}
+	return nil
+}
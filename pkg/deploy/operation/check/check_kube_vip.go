@@ -0,0 +1,105 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// CheckKubeVIPOperation lists the addresses of the kube-vip network interface,
+// it's created by the node check executor since it depends on cluster config.
+type CheckKubeVIPOperation struct {
+	shellCmd *command.ShellCommand
+	KubeVIP  *pb.KubeVIP
+}
+
+func (ckops *CheckKubeVIPOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	if ckops.KubeVIP == nil || ckops.KubeVIP.NetInterfaceName == "" {
+		return nil, nil, fmt.Errorf("kube-vip network interface is empty")
+	}
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	ckops.shellCmd = command.NewShellCommand(m, "ip", fmt.Sprintf("-o addr show dev %v | awk '{print $4}'", ckops.KubeVIP.NetInterfaceName)).
+		WithDescription("检查 kube-vip 网卡及虚拟 IP 是否满足要求").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckKubeVIP checks the interface addresses with kube-vip virtual ip,
+// addresses are CIDRs separated by new line. In arp mode the virtual ip must be in the same subnet with interface.
+func CheckKubeVIP(addresses string, vip string, mode string) error {
+	vipAddress := net.ParseIP(vip)
+	if vipAddress == nil {
+		return fmt.Errorf("kube-vip virtual ip %q is invalid", vip)
+	}
+
+	var networks []*net.IPNet
+	for _, line := range strings.Split(addresses, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		ip, network, err := net.ParseCIDR(line)
+		if err != nil {
+			return fmt.Errorf("failed to parse interface address %q, error: %v", line, err)
+		}
+		if ip.Equal(vipAddress) {
+			return fmt.Errorf("kube-vip virtual ip %v is the address of node", vip)
+		}
+		networks = append(networks, network)
+	}
+
+	if len(networks) == 0 {
+		return fmt.Errorf("no address found on kube-vip network interface")
+	}
+
+	if mode == "bgp" {
+		return nil
+	}
+
+	for _, network := range networks {
+		if network.Contains(vipAddress) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("kube-vip virtual ip %v is not in subnet of interface addresses %v", vip, networks)
+}
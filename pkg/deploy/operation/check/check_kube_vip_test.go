@@ -0,0 +1,77 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unit test of CheckKubeVIP
+func TestCheckKubeVIP(t *testing.T) {
+	testSample := []struct {
+		addresses string
+		vip       string
+		mode      string
+		wantErr   bool
+	}{
+		{
+			addresses: "192.168.1.10/24\nfe80::5054:ff:fe12:3456/64\n",
+			vip:       "192.168.1.100",
+			mode:      "arp",
+			wantErr:   false,
+		},
+		{
+			addresses: "192.168.1.10/24\n",
+			vip:       "192.168.2.100",
+			mode:      "arp",
+			wantErr:   true,
+		},
+		{
+			addresses: "192.168.1.10/24\n",
+			vip:       "10.0.0.100",
+			mode:      "bgp",
+			wantErr:   false,
+		},
+		{
+			addresses: "192.168.1.10/24\n",
+			vip:       "192.168.1.10",
+			mode:      "arp",
+			wantErr:   true,
+		},
+		{
+			addresses: "",
+			vip:       "192.168.1.100",
+			mode:      "arp",
+			wantErr:   true,
+		},
+		{
+			addresses: "192.168.1.10/24\n",
+			vip:       "192.168.1",
+			mode:      "arp",
+			wantErr:   true,
+		},
+	}
+
+	for _, eachValue := range testSample {
+		err := CheckKubeVIP(eachValue.addresses, eachValue.vip, eachValue.mode)
+		if eachValue.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
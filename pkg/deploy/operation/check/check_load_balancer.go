@@ -0,0 +1,82 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	loadBalancerReachable   = "reachable"
+	loadBalancerUnreachable = "unreachable"
+	loadBalancerDialTimeout = 5
+)
+
+// CheckLoadBalancerOperation tries to connect the load balancer port from node,
+// it's created by the node check executor since it depends on cluster config.
+type CheckLoadBalancerOperation struct {
+	shellCmd     *command.ShellCommand
+	Loadbalancer *pb.Loadbalancer
+}
+
+func (ckops *CheckLoadBalancerOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	if ckops.Loadbalancer == nil || ckops.Loadbalancer.Ip == "" || ckops.Loadbalancer.Port == 0 {
+		return nil, nil, fmt.Errorf("load balancer address is empty")
+	}
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	ckops.shellCmd = command.NewShellCommand(m, "timeout",
+		fmt.Sprintf("%v bash -c '</dev/tcp/%v/%v' && echo %v || echo %v",
+			loadBalancerDialTimeout, ckops.Loadbalancer.Ip, ckops.Loadbalancer.Port, loadBalancerReachable, loadBalancerUnreachable)).
+		WithDescription("检查负载均衡端口是否可以访问").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckLoadBalancerReachable checks the result of connecting load balancer port
+func CheckLoadBalancerReachable(result string, address string) error {
+	switch strings.TrimSpace(result) {
+	case loadBalancerReachable:
+		return nil
+	case loadBalancerUnreachable:
+		return fmt.Errorf("load balancer %v is unreachable in %v seconds", address, loadBalancerDialTimeout)
+	default:
+		return fmt.Errorf("unknown result of connecting load balancer %v: %q", address, result)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unit test of CheckLoadBalancerReachable
+func TestCheckLoadBalancerReachable(t *testing.T) {
+	testSample := []struct {
+		result  string
+		wantErr bool
+	}{
+		{
+			result:  "reachable\n",
+			wantErr: false,
+		},
+		{
+			result:  "unreachable\n",
+			wantErr: true,
+		},
+		{
+			result:  "",
+			wantErr: true,
+		},
+	}
+
+	for _, eachValue := range testSample {
+		err := CheckLoadBalancerReachable(eachValue.result, "192.168.1.100:6443")
+		if eachValue.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	nameserverReachable   = "reachable"
+	nameserverUnreachable = "unreachable"
+	nameserverDialTimeout = 3
+)
+
+// CheckNameserverOperation tries to connect DNS port of every nameserver in /etc/resolv.conf over TCP
+type CheckNameserverOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckNameserverOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is a line of "<nameserver> <reachable|unreachable>" for each nameserver
+	ckops.shellCmd = command.NewShellCommand(m, "awk",
+		fmt.Sprintf(`'/^nameserver/{print $2}' /etc/resolv.conf | while read ns; do `+
+			`timeout %v bash -c "</dev/tcp/$ns/53" 2>/dev/null && echo "$ns %v" || echo "$ns %v"; done`,
+			nameserverDialTimeout, nameserverReachable, nameserverUnreachable)).
+		WithDescription("检查 /etc/resolv.conf 中的 DNS 服务器是否可以访问").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// CheckNameservers checks the result of connecting nameservers, it returns an error if there is no
+// nameserver or none of them is reachable, the unreachable nameservers are returned anyway.
+func CheckNameservers(result string) (unreachable []string, err error) {
+	var nameservers []string
+	for _, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("unknown result of connecting nameserver: %q", line)
+		}
+
+		nameservers = append(nameservers, fields[0])
+		switch fields[1] {
+		case nameserverReachable:
+		case nameserverUnreachable:
+			unreachable = append(unreachable, fields[0])
+		default:
+			return nil, fmt.Errorf("unknown result of connecting nameserver: %q", line)
+		}
+	}
+
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no nameserver is configured in /etc/resolv.conf")
+	}
+	if len(unreachable) == len(nameservers) {
+		return unreachable, fmt.Errorf("none of nameservers %v is reachable on port 53 in %v seconds", nameservers, nameserverDialTimeout)
+	}
+	return unreachable, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckNameservers(t *testing.T) {
+	unreachable, err := CheckNameservers("10.0.0.2 reachable\n10.0.0.3 unreachable\n")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.3"}, unreachable)
+
+	unreachable, err = CheckNameservers("127.0.0.53 reachable\n")
+	assert.NoError(t, err)
+	assert.Empty(t, unreachable)
+
+	unreachable, err = CheckNameservers("10.0.0.2 unreachable\n10.0.0.3 unreachable\n")
+	assert.Error(t, err)
+	assert.Len(t, unreachable, 2)
+
+	_, err = CheckNameservers("")
+	assert.Error(t, err)
+
+	_, err = CheckNameservers("10.0.0.2 timeout\n")
+	assert.Error(t, err)
+}
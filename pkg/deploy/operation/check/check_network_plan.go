@@ -0,0 +1,188 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// route types of `ip route` which may precede the destination
+var routeTypes = map[string]bool{
+	"unicast":     true,
+	"local":       true,
+	"broadcast":   true,
+	"multicast":   true,
+	"throw":       true,
+	"unreachable": true,
+	"prohibit":    true,
+	"blackhole":   true,
+	"nat":         true,
+	"anycast":     true,
+}
+
+// routes on these devices are created by kubernetes or network plugins, they are not node subnets
+var containerDevicePrefixes = []string{"cali", "tunl", "flannel", "cni", "cilium", "vxlan", "kube-ipvs", "lxc", "veth"}
+
+// NodeRoute is a route of node parsed from `ip route`
+type NodeRoute struct {
+	Network *net.IPNet
+	Device  string
+	// Link is true if the destination is directly connected to the device
+	Link bool
+}
+
+// CheckNetworkPlanOperation lists the routes of node to check the network plan of cluster.
+type CheckNetworkPlanOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckNetworkPlanOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// ipv6 routes are only listed if ipv6 is enabled on the node, otherwise `ip -6` fails
+	ckops.shellCmd = command.NewShellCommand(m, "ip",
+		"-4 -o route show table main && { test ! -d /proc/sys/net/ipv6 || ip -6 -o route show table main; }").
+		WithDescription("检查节点路由与集群网络规划是否冲突").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// ParseNodeRoutes parses the output of `ip -o route`, default routes and routes of container devices are ignored.
+func ParseNodeRoutes(output string) ([]*NodeRoute, error) {
+	var routes []*NodeRoute
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && routeTypes[fields[0]] {
+			fields = fields[1:]
+		}
+		if len(fields) == 0 || fields[0] == "default" {
+			continue
+		}
+
+		destination := fields[0]
+		if !strings.Contains(destination, "/") {
+			if strings.Contains(destination, ":") {
+				destination += "/128"
+			} else {
+				destination += "/32"
+			}
+		}
+		_, network, err := net.ParseCIDR(destination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse route %q, error: %v", line, err)
+		}
+
+		route := &NodeRoute{Network: network}
+		for i := 1; i < len(fields); i++ {
+			switch fields[i] {
+			case "dev":
+				if i+1 < len(fields) {
+					route.Device = fields[i+1]
+				}
+			case "scope":
+				if i+1 < len(fields) {
+					route.Link = fields[i+1] == "link"
+				}
+			}
+		}
+		if route.Device == "" || isContainerDevice(route.Device) {
+			continue
+		}
+		// ipv6 routes have no scope, the connected routes are those without gateway
+		if network.IP.To4() == nil && !strings.Contains(line, " via ") {
+			route.Link = true
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
+
+func isContainerDevice(device string) bool {
+	for _, prefix := range containerDevicePrefixes {
+		if strings.HasPrefix(device, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSubnetsConflict checks the cluster subnets do not overlap with the routes of node
+func CheckSubnetsConflict(routes []*NodeRoute, subnets []string) error {
+	var conflicts []string
+	for _, subnet := range subnets {
+		_, network, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return fmt.Errorf("subnet %q is invalid, error: %v", subnet, err)
+		}
+
+		for _, route := range routes {
+			if network.Contains(route.Network.IP) || route.Network.Contains(network.IP) {
+				conflicts = append(conflicts, fmt.Sprintf("%v overlaps with route %v dev %v", subnet, route.Network, route.Device))
+			}
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return fmt.Errorf("cluster subnets conflict with node routes: %v", strings.Join(conflicts, "; "))
+	}
+	return nil
+}
+
+// CheckVIPInNodeSubnets checks the virtual ip lies in one of the directly connected subnets of node
+func CheckVIPInNodeSubnets(routes []*NodeRoute, vip string) error {
+	vipAddress := net.ParseIP(vip)
+	if vipAddress == nil {
+		return fmt.Errorf("virtual ip %q is invalid", vip)
+	}
+
+	var subnets []string
+	for _, route := range routes {
+		if !route.Link {
+			continue
+		}
+		if route.Network.Contains(vipAddress) {
+			return nil
+		}
+		subnets = append(subnets, route.Network.String())
+	}
+
+	return fmt.Errorf("virtual ip %v is not in node subnets %v", vip, subnets)
+}
@@ -0,0 +1,110 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testNodeRoutes = `default via 192.168.31.1 dev eth0 proto dhcp metric 100
+10.10.0.0/16 via 192.168.31.254 dev eth0
+172.17.0.0/16 dev docker0 proto kernel scope link src 172.17.0.1 linkdown
+192.168.31.0/24 dev eth0 proto kernel scope link src 192.168.31.10 metric 100
+blackhole 10.244.1.0/26 proto bird
+10.244.2.0/26 via 192.168.31.11 dev tunl0 proto bird onlink
+10.244.1.2 dev cali1234567890a scope link
+fd00:31::/64 dev eth0 proto kernel metric 256 pref medium
+fe80::/64 dev eth0 proto kernel metric 256 pref medium
+default via fd00:31::1 dev eth0 metric 1024 pref medium
+`
+
+// unit test of ParseNodeRoutes
+func TestParseNodeRoutes(t *testing.T) {
+	routes, err := ParseNodeRoutes(testNodeRoutes)
+	assert.NoError(t, err)
+
+	var networks []string
+	for _, route := range routes {
+		networks = append(networks, route.Network.String())
+	}
+	assert.Equal(t, []string{"10.10.0.0/16", "172.17.0.0/16", "192.168.31.0/24", "fd00:31::/64", "fe80::/64"}, networks)
+	assert.False(t, routes[0].Link)
+	assert.True(t, routes[2].Link)
+	assert.Equal(t, "eth0", routes[2].Device)
+	assert.True(t, routes[3].Link)
+
+	_, err = ParseNodeRoutes("invalid-destination dev eth0")
+	assert.Error(t, err)
+}
+
+// unit test of CheckSubnetsConflict
+func TestCheckSubnetsConflict(t *testing.T) {
+	routes, err := ParseNodeRoutes(testNodeRoutes)
+	assert.NoError(t, err)
+
+	testSample := []struct {
+		subnets []string
+		wantErr bool
+	}{
+		{
+			subnets: []string{"10.244.0.0/16", "10.96.0.0/12"},
+			wantErr: false,
+		},
+		{
+			subnets: []string{"10.244.0.0/16", "fd00:244::/56"},
+			wantErr: false,
+		},
+		{
+			subnets: []string{"172.16.0.0/12"},
+			wantErr: true,
+		},
+		{
+			subnets: []string{"10.10.128.0/17"},
+			wantErr: true,
+		},
+		{
+			subnets: []string{"fd00::/16"},
+			wantErr: true,
+		},
+		{
+			subnets: []string{"not-a-cidr"},
+			wantErr: true,
+		},
+	}
+
+	for _, eachValue := range testSample {
+		err := CheckSubnetsConflict(routes, eachValue.subnets)
+		if eachValue.wantErr {
+			assert.Error(t, err, eachValue.subnets)
+		} else {
+			assert.NoError(t, err, eachValue.subnets)
+		}
+	}
+}
+
+// unit test of CheckVIPInNodeSubnets
+func TestCheckVIPInNodeSubnets(t *testing.T) {
+	routes, err := ParseNodeRoutes(testNodeRoutes)
+	assert.NoError(t, err)
+
+	assert.NoError(t, CheckVIPInNodeSubnets(routes, "192.168.31.200"))
+	assert.NoError(t, CheckVIPInNodeSubnets(routes, "fd00:31::200"))
+	// reachable by gateway only
+	assert.Error(t, CheckVIPInNodeSubnets(routes, "10.10.0.200"))
+	assert.Error(t, CheckVIPInNodeSubnets(routes, "192.168.32.200"))
+	assert.Error(t, CheckVIPInNodeSubnets(routes, "invalid"))
+}
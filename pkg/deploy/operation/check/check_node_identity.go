@@ -0,0 +1,139 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// NodeIdentity contains the hardware identities of node, which should be unique in cluster
+type NodeIdentity struct {
+	ProductUUID string
+	MACs        []string
+}
+
+// CheckNodeIdentityOperation gets the product_uuid and the MAC addresses of physical network interfaces,
+// duplicates among nodes are found by node check task after all nodes are checked.
+type CheckNodeIdentityOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+func (ckops *CheckNodeIdentityOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is a line of "uuid <product_uuid>" followed by a line of "mac <address>" for each physical
+	// network interface, virtual interfaces like bridge and veth don't have a device.
+	ckops.shellCmd = command.NewShellCommand(m, "echo",
+		`"uuid $(cat /sys/class/dmi/id/product_uuid 2>/dev/null)"; `+
+			`for dev in /sys/class/net/*; do [ -e $dev/device ] && echo "mac $(cat $dev/address)"; done; true`).
+		WithDescription("获取机器 product_uuid 以及网卡 MAC 地址").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+
+	return
+}
+
+// ParseNodeIdentity parses the product_uuid and MAC addresses of node, product_uuid is empty
+// if it's not provided by the hardware.
+func ParseNodeIdentity(result string) (*NodeIdentity, error) {
+	identity := new(NodeIdentity)
+	for _, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		fields := strings.Fields(strings.ToLower(line))
+		switch {
+		case len(fields) == 2 && fields[0] == "uuid":
+			identity.ProductUUID = fields[1]
+		case len(fields) == 2 && fields[0] == "mac":
+			identity.MACs = append(identity.MACs, fields[1])
+		case len(fields) == 1 && fields[0] == "uuid":
+		default:
+			return nil, fmt.Errorf("unknown identity of node: %q", line)
+		}
+	}
+
+	if identity.ProductUUID == "" && len(identity.MACs) == 0 {
+		return nil, fmt.Errorf("neither product_uuid nor MAC address of node is found")
+	}
+	return identity, nil
+}
+
+// FindDuplicateIdentities returns the duplicated identities of each node with the names of the other nodes
+// which have the same identities.
+func FindDuplicateIdentities(identities map[string]*NodeIdentity) map[string][]string {
+	uuidNodes := make(map[string][]string)
+	macNodes := make(map[string][]string)
+	for name, identity := range identities {
+		if identity == nil {
+			continue
+		}
+		if identity.ProductUUID != "" {
+			uuidNodes[identity.ProductUUID] = append(uuidNodes[identity.ProductUUID], name)
+		}
+		for _, mac := range identity.MACs {
+			macNodes[mac] = append(macNodes[mac], name)
+		}
+	}
+
+	duplicates := make(map[string][]string)
+	addDuplicates := func(kind string, nodesByValue map[string][]string) {
+		for value, nodes := range nodesByValue {
+			if len(nodes) < 2 {
+				continue
+			}
+			sort.Strings(nodes)
+			for _, node := range nodes {
+				duplicates[node] = append(duplicates[node], fmt.Sprintf("%v %v is the same as nodes %v", kind, value, others(nodes, node)))
+			}
+		}
+	}
+	addDuplicates("product_uuid", uuidNodes)
+	addDuplicates("MAC address", macNodes)
+
+	for _, messages := range duplicates {
+		sort.Strings(messages)
+	}
+	return duplicates
+}
+
+func others(nodes []string, node string) []string {
+	result := make([]string, 0, len(nodes)-1)
+	for _, n := range nodes {
+		if n != node {
+			result = append(result, n)
+		}
+	}
+	return result
+}
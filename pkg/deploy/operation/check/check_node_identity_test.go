@@ -0,0 +1,58 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNodeIdentity(t *testing.T) {
+	identity, err := ParseNodeIdentity("uuid 4C4C4544-0032-3610-8052-B4C04F4A4D32\nmac 52:54:00:12:34:56\nmac 52:54:00:12:34:57\n")
+	assert.NoError(t, err)
+	assert.Equal(t, &NodeIdentity{
+		ProductUUID: "4c4c4544-0032-3610-8052-b4c04f4a4d32",
+		MACs:        []string{"52:54:00:12:34:56", "52:54:00:12:34:57"},
+	}, identity)
+
+	identity, err = ParseNodeIdentity("uuid\nmac 52:54:00:12:34:56\n")
+	assert.NoError(t, err)
+	assert.Equal(t, &NodeIdentity{MACs: []string{"52:54:00:12:34:56"}}, identity)
+
+	_, err = ParseNodeIdentity("uuid\n")
+	assert.Error(t, err)
+
+	_, err = ParseNodeIdentity("4C4C4544-0032-3610-8052-B4C04F4A4D32\n")
+	assert.Error(t, err)
+}
+
+func TestFindDuplicateIdentities(t *testing.T) {
+	duplicates := FindDuplicateIdentities(map[string]*NodeIdentity{
+		"node1": {ProductUUID: "uuid-1", MACs: []string{"52:54:00:00:00:01"}},
+		"node2": {ProductUUID: "uuid-1", MACs: []string{"52:54:00:00:00:02"}},
+		"node3": {ProductUUID: "uuid-3", MACs: []string{"52:54:00:00:00:02"}},
+		"node4": {ProductUUID: "uuid-4", MACs: []string{"52:54:00:00:00:04"}},
+		"node5": {MACs: []string{"52:54:00:00:00:05"}},
+		"node6": {MACs: []string{"52:54:00:00:00:06"}},
+		"node7": nil,
+	})
+
+	assert.Equal(t, map[string][]string{
+		"node1": {"product_uuid uuid-1 is the same as nodes [node2]"},
+		"node2": {"MAC address 52:54:00:00:00:02 is the same as nodes [node3]", "product_uuid uuid-1 is the same as nodes [node1]"},
+		"node3": {"MAC address 52:54:00:00:00:02 is the same as nodes [node2]"},
+	}, duplicates)
+}
@@ -15,8 +15,12 @@
 package check
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -27,11 +31,33 @@ import (
 )
 
 const (
-	DistributionCentos string = "centos"
-	DistributionUbuntu string = "ubuntu"
-	DistributionRHEL   string = "rhel"
+	DistributionCentos    string = "centos"
+	DistributionUbuntu    string = "ubuntu"
+	DistributionRHEL      string = "rhel"
+	DistributionDebian    string = "debian"
+	DistributionRocky     string = "rocky"
+	DistributionAlma      string = "almalinux"
+	DistributionOpenEuler string = "openEuler"
 )
 
+// DistributionVersionRange is the supported versions of a distribution, both ends are included.
+// A version is compared on the parts the end has, so 8.4 is within 7 ~ 8.
+type DistributionVersionRange struct {
+	Minimum string
+	Maximum string
+}
+
+// SupportedDistributions are the distributions supported by the init scripts, keyed by ID of /etc/os-release
+var SupportedDistributions = map[string]DistributionVersionRange{
+	DistributionCentos:    {Minimum: "7", Maximum: "8"},
+	DistributionRHEL:      {Minimum: "7", Maximum: "8"},
+	DistributionUbuntu:    {Minimum: "16.04", Maximum: "20.04"},
+	DistributionDebian:    {Minimum: "10", Maximum: "11"},
+	DistributionRocky:     {Minimum: "8", Maximum: "8"},
+	DistributionAlma:      {Minimum: "8", Maximum: "8"},
+	DistributionOpenEuler: {Minimum: "20.03", Maximum: "22.03"},
+}
+
 type CheckDistributionOperation struct {
 	shellCmd *command.ShellCommand
 }
@@ -47,7 +73,7 @@ func (ckops *CheckDistributionOperation) RunCommands(config *pb.NodeCheckConfig,
 
 	defer m.Close()
 
-	ckops.shellCmd = command.NewShellCommand(m, "cat", "/etc/*-release | grep -w 'ID' | awk '/ID/{print $1}' | awk -F '=' '{print $2}'").
+	ckops.shellCmd = command.NewShellCommand(m, "cat", "/etc/os-release").
 		WithDescription("检查机器发行版是否满足最低要求").
 		WithExecuteLogWriter(itemBuffer)
 
@@ -59,11 +85,44 @@ func (ckops *CheckDistributionOperation) RunCommands(config *pb.NodeCheckConfig,
 	return
 }
 
-// check if system distribution can be supported
-func CheckSystemDistribution(disName string) error {
+// ParseOSRelease returns ID and VERSION_ID of the content of /etc/os-release
+func ParseOSRelease(content string) (disName string, version string) {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		value := strings.Trim(fields[1], `"'`)
+		switch fields[0] {
+		case "ID":
+			disName = value
+		case "VERSION_ID":
+			version = value
+		}
+	}
+	return
+}
+
+// SupportedDistributionNames returns names of the supported distributions with version ranges
+func SupportedDistributionNames() []string {
+	names := make([]string, 0, len(SupportedDistributions))
+	for disName, versionRange := range SupportedDistributions {
+		if versionRange.Minimum == versionRange.Maximum {
+			names = append(names, fmt.Sprintf("%v %v", disName, versionRange.Minimum))
+		} else {
+			names = append(names, fmt.Sprintf("%v %v ~ %v", disName, versionRange.Minimum, versionRange.Maximum))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// check if system distribution and its version can be supported
+func CheckSystemDistribution(disName string, version string) error {
 	logger := logrus.WithFields(logrus.Fields{
-		"actual_value":  disName,
-		"desired_value": fmt.Sprintf("supported distribution: '%v' or '%v' or '%v'", DistributionCentos, DistributionUbuntu, DistributionRHEL),
+		"actual_value":  fmt.Sprintf("%v %v", disName, version),
+		"desired_value": fmt.Sprintf("supported distribution: %v", SupportedDistributionNames()),
 	})
 
 	if disName == "" {
@@ -71,10 +130,56 @@ func CheckSystemDistribution(disName string) error {
 		return fmt.Errorf("%v, can not be empty", operation.ErrParaInput)
 	}
 
-	if disName == DistributionCentos || disName == DistributionUbuntu || disName == DistributionRHEL {
-		return nil
-	} else {
+	versionRange, ok := SupportedDistributions[disName]
+	if !ok {
 		logger.Errorf("distribution unclear")
-		return fmt.Errorf("unclear distribution, support below: (%v, %v, %v)", DistributionCentos, DistributionUbuntu, DistributionRHEL)
+		return fmt.Errorf("unclear distribution %v, support below: %v", disName, SupportedDistributionNames())
+	}
+
+	lower, err := compareDistributionVersion(version, versionRange.Minimum)
+	if err != nil {
+		logger.Errorf("%v", err)
+		return err
+	}
+	upper, err := compareDistributionVersion(version, versionRange.Maximum)
+	if err != nil {
+		logger.Errorf("%v", err)
+		return err
+	}
+	if lower < 0 || upper > 0 {
+		logger.Errorf("distribution version unsupported")
+		return fmt.Errorf("unsupported version %v of %v, support below: %v", version, disName, SupportedDistributionNames())
+	}
+
+	return nil
+}
+
+// compareDistributionVersion compares version with bound on the parts bound has,
+// returns -1, 0 or 1 if version is lower than, within or higher than bound.
+func compareDistributionVersion(version string, bound string) (int, error) {
+	versionParts := strings.Split(version, ".")
+	boundParts := strings.Split(bound, ".")
+
+	for i, boundPart := range boundParts {
+		boundNumber, err := strconv.Atoi(boundPart)
+		if err != nil {
+			return 0, fmt.Errorf("invalid distribution version bound: %q", bound)
+		}
+
+		// a missing part is taken as 0, such as 8 for 8.0
+		versionNumber := 0
+		if i < len(versionParts) {
+			if versionNumber, err = strconv.Atoi(versionParts[i]); err != nil {
+				return 0, fmt.Errorf("%v, invalid distribution version: %q", operation.ErrParaInput, version)
+			}
+		}
+
+		if versionNumber < boundNumber {
+			return -1, nil
+		}
+		if versionNumber > boundNumber {
+			return 1, nil
+		}
 	}
+	return 0, nil
 }
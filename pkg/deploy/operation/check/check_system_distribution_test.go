@@ -15,47 +15,95 @@
 package check
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-)
 
-const (
-	desiredCentos = "centos"
-	desiredUbuntu = "ubuntu"
-	desiredRHEL   = "rhel"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 // unit test of CheckSystemDistribution
 func TestCheckSystemDistribution(t *testing.T) {
 	testSample := []struct {
 		disName string
-		want    error
+		version string
+		wantErr bool
+	}{
+		{disName: "rhel", version: "7.9"},
+		{disName: "centos", version: "8"},
+		{disName: "ubuntu", version: "16.04"},
+		{disName: "debian", version: "11"},
+		{disName: "rocky", version: "8.5"},
+		{disName: "almalinux", version: "8.4"},
+		{disName: "openEuler", version: "22.03"},
+		{disName: "centos", version: "6.10", wantErr: true},
+		{disName: "ubuntu", version: "22.04", wantErr: true},
+		{disName: "debian", version: "9", wantErr: true},
+		{disName: "rocky", version: "9.0", wantErr: true},
+		{disName: "openEuler", version: "", wantErr: true},
+		{disName: "guess what", version: "1.0", wantErr: true},
+		{disName: "macos", version: "10.15", wantErr: true},
+		{disName: "", version: "", wantErr: true},
+	}
+
+	for _, eachValue := range testSample {
+		err := CheckSystemDistribution(eachValue.disName, eachValue.version)
+		if eachValue.wantErr {
+			assert.Error(t, err, "%v %v", eachValue.disName, eachValue.version)
+		} else {
+			assert.NoError(t, err, "%v %v", eachValue.disName, eachValue.version)
+		}
+	}
+
+	assert.Equal(t, fmt.Errorf("input parameter invalid, can not be empty"), CheckSystemDistribution("", ""))
+}
+
+// the distribution check runs against /etc/os-release captured from each distribution by the mock machine
+func TestCheckDistributionOperation(t *testing.T) {
+	machine.IsTesting = true
+	defer func() { machine.IsTesting = false }()
+
+	testSample := []struct {
+		node    string
+		disName string
+		version string
+		wantErr bool
 	}{
-		{
-			disName: "rhel",
-			want:    nil,
-		},
-		{
-			disName: "centos",
-			want:    nil,
-		},
-		{
-			disName: "guess what",
-			want:    fmt.Errorf("unclear distribution, support below: (%v, %v, %v)", desiredCentos, desiredUbuntu, desiredRHEL),
-		},
-		{
-			disName: "macos",
-			want:    fmt.Errorf("unclear distribution, support below: (%v, %v, %v)", desiredCentos, desiredUbuntu, desiredRHEL),
-		},
-		{
-			disName: "",
-			want:    fmt.Errorf("input parameter invalid, can not be empty"),
-		},
+		{node: "centos-7", disName: DistributionCentos, version: "7"},
+		{node: "rhel-8", disName: DistributionRHEL, version: "8.4"},
+		{node: "ubuntu-18.04", disName: DistributionUbuntu, version: "18.04"},
+		{node: "ubuntu-22.04", disName: DistributionUbuntu, version: "22.04", wantErr: true},
+		{node: "debian-9", disName: DistributionDebian, version: "9", wantErr: true},
+		{node: "debian-10", disName: DistributionDebian, version: "10"},
+		{node: "debian-11", disName: DistributionDebian, version: "11"},
+		{node: "rocky-8", disName: DistributionRocky, version: "8.5"},
+		{node: "almalinux-8", disName: DistributionAlma, version: "8.5"},
+		{node: "almalinux-9", disName: DistributionAlma, version: "9.0", wantErr: true},
+		{node: "openeuler-20.03", disName: DistributionOpenEuler, version: "20.03"},
 	}
 
 	for _, eachValue := range testSample {
-		assert.Equal(t, eachValue.want, CheckSystemDistribution(eachValue.disName))
+		logChan := make(chan *bytes.Buffer, 1)
+		stdOut, _, err := new(CheckDistributionOperation).RunCommands(&pb.NodeCheckConfig{Node: &pb.Node{Name: eachValue.node}}, logChan)
+		assert.NoError(t, err)
+
+		disName, version := ParseOSRelease(string(stdOut))
+		assert.Equal(t, eachValue.disName, disName)
+		assert.Equal(t, eachValue.version, version)
+
+		err = CheckSystemDistribution(disName, version)
+		if eachValue.wantErr {
+			assert.Error(t, err, eachValue.node)
+		} else {
+			assert.NoError(t, err, eachValue.node)
+		}
 	}
 }
+
+func TestSupportedDistributionNames(t *testing.T) {
+	assert.Equal(t, []string{"almalinux 8", "centos 7 ~ 8", "debian 10 ~ 11", "openEuler 20.03 ~ 22.03",
+		"rhel 7 ~ 8", "rocky 8", "ubuntu 16.04 ~ 20.04"}, SupportedDistributionNames())
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// ValidateNodeCheckThresholds checks the thresholds of node checks keyed by role are well defined
+func ValidateNodeCheckThresholds(thresholds map[string]*pb.NodeCheckThresholds) error {
+	for role, roleThresholds := range thresholds {
+		if !validRole(role) {
+			return fmt.Errorf("invalid role of check thresholds: %q", role)
+		}
+		if err := validateCheckThreshold(roleThresholds.GetMinimum()); err != nil {
+			return fmt.Errorf("invalid minimum check threshold of role %v: %v", role, err)
+		}
+		if err := validateCheckThreshold(roleThresholds.GetRecommended()); err != nil {
+			return fmt.Errorf("invalid recommended check threshold of role %v: %v", role, err)
+		}
+	}
+	return nil
+}
+
+func validateCheckThreshold(threshold *pb.CheckThreshold) error {
+	if threshold == nil {
+		return nil
+	}
+	if threshold.GetDockerVersion() != "" {
+		if err := operation.ValidateVersion(threshold.GetDockerVersion()); err != nil {
+			return fmt.Errorf("docker version: %v", err)
+		}
+	}
+	if threshold.GetKernelVersion() != "" {
+		if err := operation.ValidateVersion(threshold.GetKernelVersion()); err != nil {
+			return fmt.Errorf("kernel version: %v", err)
+		}
+	}
+	if threshold.GetCpuCore() < 0 || threshold.GetMemoryGiB() < 0 || threshold.GetRootDiskGiB() < 0 {
+		return fmt.Errorf("cpu core, memory and root disk can not be negative")
+	}
+	return nil
+}
+
+func validRole(role string) bool {
+	switch constant.MachineRole(role) {
+	case constant.MachineRoleEtcd, constant.MachineRoleMaster, constant.MachineRoleWorker, constant.MachineRoleIngress:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestValidateNodeCheckThresholds(t *testing.T) {
+	assert.NoError(t, ValidateNodeCheckThresholds(nil))
+	assert.NoError(t, ValidateNodeCheckThresholds(map[string]*pb.NodeCheckThresholds{
+		"worker": {
+			Minimum:     &pb.CheckThreshold{CpuCore: 2, MemoryGiB: 4, KernelVersion: "3.10.0-957"},
+			Recommended: &pb.CheckThreshold{CpuCore: 8, DockerVersion: "19.03.5"},
+		},
+		"etcd": {Minimum: &pb.CheckThreshold{RootDiskGiB: 20}},
+	}))
+
+	invalidThresholds := []map[string]*pb.NodeCheckThresholds{
+		{"node": {Minimum: &pb.CheckThreshold{CpuCore: 2}}},
+		{"worker": {Minimum: &pb.CheckThreshold{CpuCore: -1}}},
+		{"worker": {Recommended: &pb.CheckThreshold{MemoryGiB: -4}}},
+		{"master": {Minimum: &pb.CheckThreshold{DockerVersion: "latest"}}},
+		{"master": {Recommended: &pb.CheckThreshold{KernelVersion: "4"}}},
+	}
+	for _, thresholds := range invalidThresholds {
+		assert.Error(t, ValidateNodeCheckThresholds(thresholds), "%v", thresholds)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// services which keep the clock of node synchronized
+var timeSyncServices = []string{"chronyd", "chrony", "ntpd", "systemd-timesyncd"}
+
+// CheckTimeSyncOperation gets the clock of node and the number of active time sync services,
+// the time range of running command on controller is recorded to compute the clock skew.
+type CheckTimeSyncOperation struct {
+	shellCmd  *command.ShellCommand
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (ckops *CheckTimeSyncOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- itemBuffer
+	}()
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	// output is like "1577808000.123456789 1"
+	ckops.shellCmd = command.NewShellCommand(m, "echo",
+		fmt.Sprintf(`"$(date +%%s.%%N) $(systemctl is-active %v 2>/dev/null | grep -c '^active$')"`, strings.Join(timeSyncServices, " "))).
+		WithDescription("检查机器时钟偏差以及时间同步服务").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	ckops.StartTime = time.Now()
+	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	ckops.EndTime = time.Now()
+
+	return
+}
+
+// ParseTimeSync parses the clock of node and the number of active time sync services
+func ParseTimeSync(output string) (nodeTime time.Time, activeServices int, err error) {
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return time.Time{}, 0, fmt.Errorf("unknown output of checking time sync: %q", output)
+	}
+
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to parse time of node %q: %v", fields[0], err)
+	}
+	integer, fraction := math.Modf(seconds)
+	nodeTime = time.Unix(int64(integer), int64(fraction*float64(time.Second)))
+
+	if activeServices, err = strconv.Atoi(fields[1]); err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to parse number of time sync services %q: %v", fields[1], err)
+	}
+
+	return nodeTime, activeServices, nil
+}
+
+// ClockSkew returns how far the node time is out of the time range of running command on controller
+func ClockSkew(nodeTime, startTime, endTime time.Time) time.Duration {
+	switch {
+	case nodeTime.Before(startTime):
+		return startTime.Sub(nodeTime)
+	case nodeTime.After(endTime):
+		return nodeTime.Sub(endTime)
+	default:
+		return 0
+	}
+}
+
+// CheckClockSkew checks the clock skew between node and controller is within the max skew
+func CheckClockSkew(skew time.Duration, maxSkew time.Duration) error {
+	if skew > maxSkew {
+		return fmt.Errorf("clock of node differs from the deploy controller by %v, which exceeds %v", skew, maxSkew)
+	}
+	return nil
+}
+
+// CheckTimeSyncService checks there is an active time sync service on node
+func CheckTimeSyncService(activeServices int) error {
+	if activeServices == 0 {
+		return fmt.Errorf("none of time sync services %v is active", timeSyncServices)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeSync(t *testing.T) {
+	nodeTime, activeServices, err := ParseTimeSync("1577808000.500000000 1\n")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1577808000), nodeTime.Unix())
+	assert.Equal(t, 500*time.Millisecond, time.Duration(nodeTime.Nanosecond()))
+	assert.Equal(t, 1, activeServices)
+
+	for _, output := range []string{"", "1577808000", "now 1", "1577808000 active"} {
+		_, _, err = ParseTimeSync(output)
+		assert.Error(t, err, output)
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	start := time.Unix(1577808000, 0)
+	end := start.Add(2 * time.Second)
+
+	assert.Equal(t, time.Duration(0), ClockSkew(start.Add(time.Second), start, end))
+	assert.Equal(t, 3*time.Second, ClockSkew(start.Add(-3*time.Second), start, end))
+	assert.Equal(t, 5*time.Second, ClockSkew(end.Add(5*time.Second), start, end))
+
+	assert.NoError(t, CheckClockSkew(time.Second, 5*time.Second))
+	assert.Error(t, CheckClockSkew(10*time.Second, 5*time.Second))
+}
+
+func TestCheckTimeSyncService(t *testing.T) {
+	assert.NoError(t, CheckTimeSyncService(1))
+	assert.Error(t, CheckTimeSyncService(0))
+}
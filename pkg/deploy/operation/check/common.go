@@ -41,6 +41,17 @@ const (
 	SystemPreference      ItemEnum = "system-preference"
 	SystemManager         ItemEnum = "system-manager"
 	PortOccupied          ItemEnum = "port-occupied"
+	KubeVIP               ItemEnum = "kube-vip"
+	LoadBalancer          ItemEnum = "load-balancer"
+	NetworkPlan           ItemEnum = "network-plan"
+	TimeSync              ItemEnum = "time-sync"
+	Nameserver            ItemEnum = "nameserver"
+	KernelModule          ItemEnum = "kernel-module"
+	CgroupDriver          ItemEnum = "cgroup-driver"
+	Inode                 ItemEnum = "inode"
+	DiskSync              ItemEnum = "disk-sync"
+	DuplicateIdentity     ItemEnum = "duplicate-identity"
+	Containerd            ItemEnum = "containerd"
 )
 
 func NewCheckOperations() *OperationsGenerator {
@@ -67,6 +78,22 @@ func (og *OperationsGenerator) CreateOperations(item ItemEnum) CheckOperation {
 		return &CheckSystemManagerOperation{}
 	case PortOccupied:
 		return &CheckPortOccupiedOperation{}
+	case TimeSync:
+		return &CheckTimeSyncOperation{}
+	case Nameserver:
+		return &CheckNameserverOperation{}
+	case KernelModule:
+		return &CheckKernelModuleOperation{}
+	case CgroupDriver:
+		return &CheckCgroupDriverOperation{}
+	case Inode:
+		return &CheckInodeOperation{}
+	case DiskSync:
+		return &CheckDiskSyncOperation{}
+	case DuplicateIdentity:
+		return &CheckNodeIdentityOperation{}
+	case Containerd:
+		return &CheckContainerdOperation{}
 	default:
 		return nil
 	}
@@ -143,6 +143,19 @@ func versionLargerAndEqual(firstVersion string, secondVersion string) int {
 	return 0
 }
 
+// ValidateVersion checks if the version is made of digits split by dots, suffix after "-" is ignored
+func ValidateVersion(rawVersion string) error {
+	return checkVersionValid(rawVersion)
+}
+
+// CompareVersion returns a positive number if the first version is higher than the second one,
+// a negative number if it's lower, and 0 if they are the same
+func CompareVersion(firstVersion string, secondVersion string) int {
+	firstVerStr := strings.Split(strings.TrimSpace(firstVersion), "-")[0]
+	secondVerStr := strings.Split(strings.TrimSpace(secondVersion), "-")[0]
+	return versionLargerAndEqual(firstVerStr, secondVerStr)
+}
+
 // check if entity resource satisfied minimal requirements
 func CheckEntity(comparedEntity string, desiredEntity float64) error {
 	logger := logrus.WithFields(logrus.Fields{
@@ -229,7 +242,7 @@ func findMaxLength(firstArr []string, secondArr []string) int {
 
 // check if ip valid as 0.0.0.0 or defined in RFC1122, RFC4632, RFC4291
 func CheckIPValid(rawIP string) bool {
-	if rawIP == "0.0.0.0" {
+	if rawIP == "0.0.0.0" || rawIP == "::" {
 		return true
 	}
 
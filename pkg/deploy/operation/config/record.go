@@ -0,0 +1,81 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	deployMachine "github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+type RecordNodeConfigConfig struct {
+	MasterMachine    deployMachine.IMachine
+	Logger           *logrus.Entry
+	Node             *pb.NodeDeployConfig
+	Cluster          *pb.ClusterConfig
+	ExecuteLogWriter io.Writer
+}
+
+// RecordNodeConfig records the labels, annotations and taints applied during deploy,
+// so UpdateNodeConfig knows which of them are managed by kpaas.
+type RecordNodeConfig struct {
+	config *RecordNodeConfigConfig
+}
+
+func NewRecordNodeConfig(config *RecordNodeConfigConfig) *RecordNodeConfig {
+	return &RecordNodeConfig{
+		config: config,
+	}
+}
+
+func (r *RecordNodeConfig) record() *pb.Error {
+
+	lastApplied, err := marshalNodeConfig(desiredNodeConfig(r.config.Node, r.config.Cluster))
+	if err != nil {
+		return &pb.Error{
+			Reason: "Record node config error",
+			Detail: err.Error(),
+		}
+	}
+
+	r.config.Logger.
+		WithFields(logrus.Fields{"node": r.config.Node.GetNode().GetName(), "config": lastApplied}).
+		Debug("record node config")
+
+	// annotation values may contain single quotes
+	lastApplied = strings.Replace(lastApplied, "'", `'\''`, -1)
+
+	return operation.NewCommandRunner(r.config.ExecuteLogWriter).RunCommand(
+		command.NewKubectlCommand(r.config.MasterMachine, consts.KubeConfigPath, "",
+			"annotate", "node", r.config.Node.GetNode().GetName(), "--overwrite",
+			fmt.Sprintf("%s='%s'", LastAppliedNodeConfigAnnotation, lastApplied),
+		),
+		"Record node config error",
+		fmt.Sprintf("record the applied config of node: %s", r.config.Node.GetNode().GetName()),
+	)
+}
+
+func (r *RecordNodeConfig) Execute() *pb.Error {
+
+	return r.record()
+}
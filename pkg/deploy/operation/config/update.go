@@ -0,0 +1,333 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// LastAppliedNodeConfigAnnotation records the labels, annotations and taints applied by kpaas,
+	// keys absent from it are not managed by kpaas and left as they are.
+	LastAppliedNodeConfigAnnotation = "kpaas.io/last-applied-node-config"
+
+	NodeConfigKindLabel      = "label"
+	NodeConfigKindAnnotation = "annotation"
+	NodeConfigKindTaint      = "taint"
+
+	NodeConfigActionAdd    = "add"
+	NodeConfigActionUpdate = "update"
+	NodeConfigActionRemove = "remove"
+)
+
+// nodeConfigState contains the labels, annotations and taints of a node, taints are keyed by key:effect
+type nodeConfigState struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Taints      map[string]string `json:"taints,omitempty"`
+}
+
+// desiredNodeConfig returns the labels and annotations of the cluster and the labels and taints of the node,
+// labels of the node take precedence over the cluster.
+func desiredNodeConfig(node *pb.NodeDeployConfig, cluster *pb.ClusterConfig) *nodeConfigState {
+	state := &nodeConfigState{
+		Labels:      make(map[string]string),
+		Annotations: make(map[string]string),
+		Taints:      make(map[string]string),
+	}
+
+	for key, value := range cluster.GetNodeLabels() {
+		state.Labels[key] = value
+	}
+	for key, value := range node.GetLabels() {
+		state.Labels[key] = value
+	}
+	for key, value := range cluster.GetNodeAnnotations() {
+		state.Annotations[key] = value
+	}
+	for _, taint := range node.GetTaints() {
+		state.Taints[taintKey(taint.GetKey(), taint.GetEffect())] = taint.GetValue()
+	}
+
+	return state
+}
+
+// lastAppliedNodeConfig returns the config recorded by kpaas, it's empty if nothing is recorded
+func lastAppliedNodeConfig(node *corev1.Node) (*nodeConfigState, error) {
+	state := new(nodeConfigState)
+	data, ok := node.Annotations[LastAppliedNodeConfigAnnotation]
+	if !ok || data == "" {
+		return state, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		return nil, fmt.Errorf("invalid annotation %v of node %v, error: %v", LastAppliedNodeConfigAnnotation, node.Name, err)
+	}
+	return state, nil
+}
+
+// marshalNodeConfig returns the value of LastAppliedNodeConfigAnnotation
+func marshalNodeConfig(state *nodeConfigState) (string, error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// liveNodeConfig returns the labels, annotations and taints of the live node,
+// LastAppliedNodeConfigAnnotation itself is left out.
+func liveNodeConfig(node *corev1.Node) *nodeConfigState {
+	state := &nodeConfigState{
+		Labels:      make(map[string]string, len(node.Labels)),
+		Annotations: make(map[string]string, len(node.Annotations)),
+		Taints:      make(map[string]string, len(node.Spec.Taints)),
+	}
+
+	for key, value := range node.Labels {
+		state.Labels[key] = value
+	}
+	for key, value := range node.Annotations {
+		if key != LastAppliedNodeConfigAnnotation {
+			state.Annotations[key] = value
+		}
+	}
+	for _, taint := range node.Spec.Taints {
+		state.Taints[taintKey(taint.Key, string(taint.Effect))] = taint.Value
+	}
+
+	return state
+}
+
+func taintKey(key, effect string) string {
+	return fmt.Sprintf("%v:%v", key, effect)
+}
+
+// diffNodeConfig returns the changes to make the live node desired and the drifts from the last applied config,
+// only keys in the desired or last applied config are compared.
+func diffNodeConfig(desired, lastApplied, live *nodeConfigState) (changes, drifts []*pb.NodeConfigChange) {
+	kinds := []struct {
+		kind                       string
+		desired, lastApplied, live map[string]string
+	}{
+		{NodeConfigKindLabel, desired.Labels, lastApplied.Labels, live.Labels},
+		{NodeConfigKindAnnotation, desired.Annotations, lastApplied.Annotations, live.Annotations},
+		{NodeConfigKindTaint, desired.Taints, lastApplied.Taints, live.Taints},
+	}
+
+	for _, each := range kinds {
+		for _, key := range sortedKeys(each.lastApplied) {
+			if change := diffValue(each.kind, key, each.lastApplied, each.live); change != nil {
+				drifts = append(drifts, change)
+			}
+		}
+
+		for _, key := range sortedKeys(each.desired) {
+			if change := diffValue(each.kind, key, each.live, each.desired); change != nil {
+				changes = append(changes, change)
+			}
+		}
+
+		// keys kpaas applied before but no longer desired are removed
+		for _, key := range sortedKeys(each.lastApplied) {
+			if _, ok := each.desired[key]; ok {
+				continue
+			}
+			if change := diffValue(each.kind, key, each.live, each.desired); change != nil {
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	return
+}
+
+// diffValue returns the change of the key from the old values to the new values, it's nil if nothing changed
+func diffValue(kind, key string, oldValues, newValues map[string]string) *pb.NodeConfigChange {
+	oldValue, oldOK := oldValues[key]
+	newValue, newOK := newValues[key]
+
+	change := &pb.NodeConfigChange{
+		Kind:     kind,
+		Key:      key,
+		OldValue: oldValue,
+		NewValue: newValue,
+	}
+
+	switch {
+	case !oldOK && !newOK:
+		return nil
+	case !oldOK:
+		change.Action = NodeConfigActionAdd
+	case !newOK:
+		change.Action = NodeConfigActionRemove
+	case oldValue != newValue:
+		change.Action = NodeConfigActionUpdate
+	default:
+		return nil
+	}
+	return change
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// applyNodeConfigChanges applies the changes to the node and records the desired config as the last applied config
+func applyNodeConfigChanges(node *corev1.Node, changes []*pb.NodeConfigChange, desired *nodeConfigState) error {
+	if node.Labels == nil {
+		node.Labels = make(map[string]string)
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+
+	for _, change := range changes {
+		switch change.GetKind() {
+		case NodeConfigKindLabel:
+			applyValueChange(node.Labels, change)
+		case NodeConfigKindAnnotation:
+			applyValueChange(node.Annotations, change)
+		case NodeConfigKindTaint:
+			node.Spec.Taints = applyTaintChange(node.Spec.Taints, change)
+		}
+	}
+
+	lastApplied, err := marshalNodeConfig(desired)
+	if err != nil {
+		return err
+	}
+	node.Annotations[LastAppliedNodeConfigAnnotation] = lastApplied
+	return nil
+}
+
+func applyValueChange(values map[string]string, change *pb.NodeConfigChange) {
+	if change.GetAction() == NodeConfigActionRemove {
+		delete(values, change.GetKey())
+		return
+	}
+	values[change.GetKey()] = change.GetNewValue()
+}
+
+func applyTaintChange(taints []corev1.Taint, change *pb.NodeConfigChange) []corev1.Taint {
+	result := make([]corev1.Taint, 0, len(taints)+1)
+	for _, taint := range taints {
+		if taintKey(taint.Key, string(taint.Effect)) != change.GetKey() {
+			result = append(result, taint)
+		}
+	}
+
+	if change.GetAction() == NodeConfigActionRemove {
+		return result
+	}
+
+	// taint key is a qualified name without ":", so the effect follows the last ":"
+	separator := strings.LastIndex(change.GetKey(), ":")
+	return append(result, corev1.Taint{
+		Key:    change.GetKey()[:separator],
+		Value:  change.GetNewValue(),
+		Effect: corev1.TaintEffect(change.GetKey()[separator+1:]),
+	})
+}
+
+type UpdateNodeConfigConfig struct {
+	Client  kubernetes.Interface
+	Logger  *logrus.Entry
+	Node    *pb.NodeDeployConfig
+	Cluster *pb.ClusterConfig
+	DryRun  bool
+}
+
+// UpdateNodeConfig makes the labels, annotations and taints of a live node the same as the desired config,
+// and reports the changes made outside kpaas.
+type UpdateNodeConfig struct {
+	config *UpdateNodeConfigConfig
+}
+
+func NewUpdateNodeConfig(config *UpdateNodeConfigConfig) *UpdateNodeConfig {
+	return &UpdateNodeConfig{
+		config: config,
+	}
+}
+
+func (u *UpdateNodeConfig) Execute() *pb.NodeConfigDiff {
+	nodeName := u.config.Node.GetNode().GetName()
+	diff := &pb.NodeConfigDiff{NodeName: nodeName}
+	desired := desiredNodeConfig(u.config.Node, u.config.Cluster)
+
+	// the node is read again and the diff is recomputed if it's changed by others during update
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := u.config.Client.CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		lastApplied, err := lastAppliedNodeConfig(node)
+		if err != nil {
+			return err
+		}
+
+		diff.Changes, diff.Drifts = diffNodeConfig(desired, lastApplied, liveNodeConfig(node))
+
+		u.config.Logger.
+			WithFields(logrus.Fields{"node": nodeName, "changes": len(diff.Changes), "drifts": len(diff.Drifts)}).
+			Debug("diff node config")
+
+		if u.config.DryRun {
+			return nil
+		}
+
+		desiredData, err := marshalNodeConfig(desired)
+		if err != nil {
+			return err
+		}
+		if len(diff.Changes) == 0 && node.Annotations[LastAppliedNodeConfigAnnotation] == desiredData {
+			return nil
+		}
+
+		if err := applyNodeConfigChanges(node, diff.Changes, desired); err != nil {
+			return err
+		}
+		_, err = u.config.Client.CoreV1().Nodes().Update(node)
+		return err
+	})
+
+	if err != nil {
+		diff.Err = &pb.Error{
+			Reason:     "Update node config error",
+			Detail:     fmt.Sprintf("We tried to update labels, annotations and taints of node: %s, but failed, error message: %v", nodeName, err),
+			FixMethods: consts.FixMethodSelfAnalyseIt,
+		}
+	}
+
+	return diff
+}
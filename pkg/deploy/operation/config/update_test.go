@@ -0,0 +1,109 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDiffNodeConfig(t *testing.T) {
+	desired := &nodeConfigState{
+		Labels: map[string]string{"zone": "a", "app": "web"},
+		Taints: map[string]string{"dedicated:NoSchedule": "web"},
+	}
+	lastApplied := &nodeConfigState{
+		Labels: map[string]string{"zone": "a", "old": "yes"},
+	}
+	live := &nodeConfigState{
+		Labels: map[string]string{"zone": "b", "old": "yes", "kubernetes.io/hostname": "node1"},
+	}
+
+	changes, drifts := diffNodeConfig(desired, lastApplied, live)
+	assert.Equal(t, []*pb.NodeConfigChange{
+		{Kind: NodeConfigKindLabel, Key: "zone", Action: NodeConfigActionUpdate, OldValue: "a", NewValue: "b"},
+	}, drifts)
+	assert.Equal(t, []*pb.NodeConfigChange{
+		{Kind: NodeConfigKindLabel, Key: "app", Action: NodeConfigActionAdd, NewValue: "web"},
+		{Kind: NodeConfigKindLabel, Key: "zone", Action: NodeConfigActionUpdate, OldValue: "b", NewValue: "a"},
+		{Kind: NodeConfigKindLabel, Key: "old", Action: NodeConfigActionRemove, OldValue: "yes"},
+		{Kind: NodeConfigKindTaint, Key: "dedicated:NoSchedule", Action: NodeConfigActionAdd, NewValue: "web"},
+	}, changes)
+}
+
+func TestUpdateNodeConfig(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node1",
+			Labels: map[string]string{"kubernetes.io/hostname": "node1", "zone": "b"},
+			Annotations: map[string]string{
+				LastAppliedNodeConfigAnnotation: `{"labels":{"zone":"a"}}`,
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule}},
+		},
+	})
+
+	config := &UpdateNodeConfigConfig{
+		Client: client,
+		Logger: logrus.NewEntry(logrus.StandardLogger()),
+		Node: &pb.NodeDeployConfig{
+			Node:   &pb.Node{Name: "node1"},
+			Labels: map[string]string{"zone": "a"},
+			Taints: []*pb.Taint{{Key: "dedicated", Value: "web", Effect: "NoSchedule"}},
+		},
+		Cluster: &pb.ClusterConfig{NodeAnnotations: map[string]string{"owner": "kpaas"}},
+		DryRun:  true,
+	}
+
+	// dry run only reports the diff
+	diff := NewUpdateNodeConfig(config).Execute()
+	assert.Nil(t, diff.Err)
+	assert.Equal(t, 1, len(diff.Drifts))
+	assert.Equal(t, 3, len(diff.Changes))
+	node, err := client.CoreV1().Nodes().Get("node1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "b", node.Labels["zone"])
+
+	config.DryRun = false
+	diff = NewUpdateNodeConfig(config).Execute()
+	assert.Nil(t, diff.Err)
+	assert.Equal(t, 3, len(diff.Changes))
+	node, err = client.CoreV1().Nodes().Get("node1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", node.Labels["zone"])
+	assert.Equal(t, "node1", node.Labels["kubernetes.io/hostname"])
+	assert.Equal(t, "kpaas", node.Annotations["owner"])
+	assert.Equal(t, []corev1.Taint{{Key: "dedicated", Value: "web", Effect: corev1.TaintEffectNoSchedule}}, node.Spec.Taints)
+
+	// nothing changed and no drift after applied
+	diff = NewUpdateNodeConfig(config).Execute()
+	assert.Nil(t, diff.Err)
+	assert.Empty(t, diff.Changes)
+	assert.Empty(t, diff.Drifts)
+
+	// node not found
+	config.Node.Node.Name = "node2"
+	diff = NewUpdateNodeConfig(config).Execute()
+	assert.NotNil(t, diff.Err)
+}
@@ -89,7 +89,7 @@ func FetchEtcdCertAndKey(etcdNode *pb.Node, baseName string) (*x509.Certificate,
 
 	m, err := machine.NewMachine(etcdNode)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to create exec client for first etcd node:%v, error:%v", m.GetName(), err)
+		return nil, nil, fmt.Errorf("failed to create exec client for first etcd node:%v, error:%v", etcdNode.GetName(), err)
 	}
 
 	if err := m.FetchFile(localCert, DefaultEtcdCACertPath); err != nil {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/coreos/etcd/clientv3"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -83,7 +84,7 @@ func getClientV3TLS(d *deployEtcdOperation) (*tls.Config, error) {
 
 func composeEndpoints(nodes []*pb.Node) (endpoints []string) {
 	for i := range nodes {
-		endpoints = append(endpoints, fmt.Sprintf("https://%v:%v", nodes[i].Ip, defaultEtcdServerPort))
+		endpoints = append(endpoints, fmt.Sprintf("https://%v", deploy.JoinHostPort(nodes[i].Ip, defaultEtcdServerPort)))
 	}
 
 	return
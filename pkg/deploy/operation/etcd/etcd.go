@@ -26,7 +26,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -41,12 +44,13 @@ const (
 	defaultEtcdServerPort = 2379
 	defaultEtcdPeerPort   = 2380
 	defaultEtcdDataDir    = "/var/lib/etcd"
-	// TODO: registry should be obtained from cluster config
-	defaultRegistry      = "docker.io"
-	defaultEtcdImageRepo = "kpaas"
-	defaultEtcdImageTag  = "3.3.15-0"
-	defaultEtcdImageName = "etcd"
-	defaultEtcdImageUrl  = defaultRegistry + "/" + defaultEtcdImageRepo + "/" + defaultEtcdImageName + ":" + defaultEtcdImageTag
+	defaultEtcdImageTag   = "3.3.15-0"
+	defaultEtcdImageName  = "etcd"
+
+	// etcd runs as a systemd service by ctr if containerd is the container runtime
+	etcdSystemdUnit        = "etcd-kpaas.service"
+	etcdSystemdUnitPath    = "/etc/systemd/system/" + etcdSystemdUnit
+	containerdK8sNamespace = "k8s.io"
 
 	DefaultPKIDir    = "/etc/kubernetes/pki/"
 	defautEtcdPKIDir = DefaultPKIDir + "etcd"
@@ -72,7 +76,11 @@ type DeployEtcdOperationConfig struct {
 	CAKey        crypto.Signer
 	Node         *pb.Node
 	ClusterNodes []*pb.Node
-	LogWriter    io.Writer
+	// ContainerRuntime runs etcd, docker is used if empty
+	ContainerRuntime consts.ContainerRuntime
+	// ImageRepository contains the etcd image, the default repository is used if empty
+	ImageRepository string
+	LogWriter       io.Writer
 }
 
 type deployEtcdOperation struct {
@@ -84,16 +92,20 @@ type deployEtcdOperation struct {
 	machine                         machine.IMachine
 	clusterNodes                    []*pb.Node
 	containerName                   string
+	containerRuntime                consts.ContainerRuntime
+	image                           string
 	LogWriter                       io.Writer
 }
 
 func NewDeployEtcdOperation(config *DeployEtcdOperationConfig) (*deployEtcdOperation, error) {
 	ops := &deployEtcdOperation{
-		logger:       config.Logger,
-		caCrt:        config.CACrt,
-		caKey:        config.CAKey,
-		clusterNodes: config.ClusterNodes,
-		LogWriter:    config.LogWriter,
+		logger:           config.Logger,
+		caCrt:            config.CACrt,
+		caKey:            config.CAKey,
+		clusterNodes:     config.ClusterNodes,
+		containerRuntime: config.ContainerRuntime,
+		image:            etcdImage(config.ImageRepository),
+		LogWriter:        config.LogWriter,
 	}
 	m, err := machine.NewMachine(config.Node)
 	if err != nil {
@@ -105,6 +117,14 @@ func NewDeployEtcdOperation(config *DeployEtcdOperationConfig) (*deployEtcdOpera
 	return ops, nil
 }
 
+// etcdImage returns the etcd image in the repository, the default repository is used if empty
+func etcdImage(repository string) string {
+	if repository == "" {
+		repository = constant.DefaultImageRepository
+	}
+	return fmt.Sprintf("%v/%v:%v", repository, defaultEtcdImageName, defaultEtcdImageTag)
+}
+
 func (d *deployEtcdOperation) composeContainerName() {
 	d.containerName = fmt.Sprintf("etcd-kpaas-%v", d.machine.GetName())
 }
@@ -112,6 +132,10 @@ func (d *deployEtcdOperation) composeContainerName() {
 func (d *deployEtcdOperation) removeExistEtcdContainer() error {
 	d.logger.Debug("start removeExistEtcdContainer")
 
+	if d.containerRuntime == consts.ContainerRuntimeContainerd {
+		return d.removeExistEtcdService()
+	}
+
 	filterArg := fmt.Sprintf("name=%v", d.containerName)
 
 	d.logger.Debugf("filterArg: %v", filterArg)
@@ -163,6 +187,31 @@ func (d *deployEtcdOperation) removeExistEtcdContainer() error {
 	return nil
 }
 
+// removeExistEtcdService stops etcd service run by ctr, the container is removed by ctr when the service stops
+func (d *deployEtcdOperation) removeExistEtcdService() error {
+	d.AddCommands(
+		command.NewShellCommand(d.machine,
+			"systemctl",
+			"disable",
+			"--now",
+			etcdSystemdUnit,
+			"2>/dev/null",
+			"||",
+			"true",
+		).WithExecuteLogWriter(d.LogWriter),
+	)
+
+	_, stdErr, err := d.BaseOperation.Do()
+	// reset d.Commands
+	d.ResetCommands()
+
+	if err != nil {
+		return fmt.Errorf("failed to stop existing etcd service, error:%s", stdErr)
+	}
+
+	return nil
+}
+
 // PreDo generate etcd certs and put it to etcd node
 func (d *deployEtcdOperation) PreDo() (err error) {
 	d.composeContainerName()
@@ -228,17 +277,17 @@ func (d *deployEtcdOperation) PreDo() (err error) {
 func composeInitialClusterUrl(nodes []*pb.Node) (clusterUrl string) {
 	for i := range nodes {
 		if clusterUrl == "" {
-			clusterUrl = fmt.Sprintf("%v=https://%v:%v", nodes[i].Name, nodes[i].Ip, defaultEtcdPeerPort)
+			clusterUrl = fmt.Sprintf("%v=https://%v", nodes[i].Name, deploy.JoinHostPort(nodes[i].Ip, defaultEtcdPeerPort))
 			continue
 		}
 
-		clusterUrl = fmt.Sprintf("%v=https://%v:%v,%v", nodes[i].Name, nodes[i].Ip, defaultEtcdPeerPort, clusterUrl)
+		clusterUrl = fmt.Sprintf("%v=https://%v,%v", nodes[i].Name, deploy.JoinHostPort(nodes[i].Ip, defaultEtcdPeerPort), clusterUrl)
 	}
 
 	return
 }
 
-func (d *deployEtcdOperation) composeEtcdDockerCmd() {
+func (d *deployEtcdOperation) composeEtcdCmd() []string {
 
 	cmd := []string{"etcd"}
 
@@ -256,14 +305,25 @@ func (d *deployEtcdOperation) composeEtcdDockerCmd() {
 	cmd = append(cmd, fmt.Sprintf("--trusted-ca-file=%v", DefaultEtcdCACertPath))
 	cmd = append(cmd, fmt.Sprintf("--peer-trusted-ca-file=%v", DefaultEtcdCACertPath))
 
-	cmd = append(cmd, fmt.Sprintf("--advertise-client-urls=https://%v:%v", d.machine.GetIp(), defaultEtcdServerPort))
-	cmd = append(cmd, fmt.Sprintf("--initial-advertise-peer-urls=https://%v:%v", d.machine.GetIp(), defaultEtcdPeerPort))
-	cmd = append(cmd, fmt.Sprintf("--listen-client-urls=https://0.0.0.0:%v", defaultEtcdServerPort))
-	cmd = append(cmd, fmt.Sprintf("--listen-peer-urls=https://0.0.0.0:%v", defaultEtcdPeerPort))
+	listenIP := "0.0.0.0"
+	if deploy.IsIPv6(d.machine.GetIp()) {
+		listenIP = "::"
+	}
+	cmd = append(cmd, fmt.Sprintf("--advertise-client-urls=https://%v", deploy.JoinHostPort(d.machine.GetIp(), defaultEtcdServerPort)))
+	cmd = append(cmd, fmt.Sprintf("--initial-advertise-peer-urls=https://%v", deploy.JoinHostPort(d.machine.GetIp(), defaultEtcdPeerPort)))
+	cmd = append(cmd, fmt.Sprintf("--listen-client-urls=https://%v", deploy.JoinHostPort(listenIP, defaultEtcdServerPort)))
+	cmd = append(cmd, fmt.Sprintf("--listen-peer-urls=https://%v", deploy.JoinHostPort(listenIP, defaultEtcdPeerPort)))
 
 	//initial-cluster: infra0=https://10.0.0.6:2380,infra1=https://10.0.0.7:2380,infra2=https://10.0.0.8:2380
 	cmd = append(cmd, fmt.Sprintf("--initial-cluster=%v", composeInitialClusterUrl(d.clusterNodes)))
 
+	return cmd
+}
+
+func (d *deployEtcdOperation) composeEtcdDockerCmd() {
+
+	cmd := d.composeEtcdCmd()
+
 	nameArg := fmt.Sprintf("--name=%v", d.containerName)
 
 	d.AddCommands(
@@ -277,12 +337,50 @@ func (d *deployEtcdOperation) composeEtcdDockerCmd() {
 			"-v",
 			"/var/lib/etcd:/var/lib/etcd",
 			nameArg,
-			defaultEtcdImageUrl,
+			d.image,
 			strings.Join(cmd, " "),
 		).WithExecuteLogWriter(d.LogWriter),
 	)
 }
 
+// composeEtcdSystemdUnit returns the systemd unit which runs etcd container by ctr
+func (d *deployEtcdOperation) composeEtcdSystemdUnit() string {
+	ctr := fmt.Sprintf("/usr/bin/ctr -n %v", containerdK8sNamespace)
+
+	return fmt.Sprintf(`[Unit]
+Description=etcd of kpaas run by containerd
+Requires=containerd.service
+After=containerd.service
+
+[Service]
+ExecStartPre=/bin/mkdir -p %[2]v
+ExecStartPre=-%[1]v task kill -s SIGKILL %[3]v
+ExecStartPre=-%[1]v container rm %[3]v
+ExecStart=%[1]v run --rm --net-host --mount type=bind,src=%[4]v,dst=%[4]v,options=rbind:ro --mount type=bind,src=%[2]v,dst=%[2]v,options=rbind:rw %[5]v %[3]v %[6]v
+ExecStop=%[1]v task kill %[3]v
+Restart=always
+RestartSec=5
+
+[Install]
+WantedBy=multi-user.target
+`, ctr, defaultEtcdDataDir, d.containerName, defautEtcdPKIDir, d.image, strings.Join(d.composeEtcdCmd(), " "))
+}
+
+func (d *deployEtcdOperation) composeEtcdSystemdCmd() error {
+	if err := d.machine.PutFile(strings.NewReader(d.composeEtcdSystemdUnit()), etcdSystemdUnitPath); err != nil {
+		return fmt.Errorf("failed to put etcd systemd unit to:%v, error: %v", d.machine.GetName(), err)
+	}
+
+	d.AddCommands(
+		// crictl pulls the image with the registry settings of containerd cri plugin
+		command.NewShellCommand(d.machine, "crictl", "pull", d.image).WithExecuteLogWriter(d.LogWriter),
+		command.NewShellCommand(d.machine, "systemctl", "daemon-reload").WithExecuteLogWriter(d.LogWriter),
+		command.NewShellCommand(d.machine, "systemctl", "enable", "--now", etcdSystemdUnit).WithExecuteLogWriter(d.LogWriter),
+	)
+
+	return nil
+}
+
 func (d *deployEtcdOperation) Do() error {
 	defer d.machine.Close()
 	// save
@@ -312,9 +410,15 @@ func (d *deployEtcdOperation) Do() error {
 		return err
 	}
 
-	d.composeEtcdDockerCmd()
-
-	d.logger.Debug("start docker run etcd")
+	if d.containerRuntime == consts.ContainerRuntimeContainerd {
+		if err := d.composeEtcdSystemdCmd(); err != nil {
+			return err
+		}
+		d.logger.Debug("start etcd systemd service")
+	} else {
+		d.composeEtcdDockerCmd()
+		d.logger.Debug("start docker run etcd")
+	}
 
 	stdOut, stdErr, err := d.BaseOperation.Do()
 	if err != nil {
@@ -0,0 +1,42 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// PlanCerts returns the subjects of the server and peer certificates of each etcd node,
+// they share the same subject alternative names.
+func PlanCerts(etcdNodes []*pb.Node) ([]*pb.EtcdCertPlan, error) {
+	certs := make([]*pb.EtcdCertPlan, 0, len(etcdNodes))
+	for _, node := range etcdNodes {
+		config, err := GetServerCrtConfig(node.GetName(), node.GetIp())
+		if err != nil {
+			return nil, err
+		}
+
+		cert := &pb.EtcdCertPlan{
+			Node:       node.GetName(),
+			CommonName: config.CommonName,
+			DnsNames:   config.AltNames.DNSNames,
+		}
+		for _, ip := range config.AltNames.IPs {
+			cert.Ips = append(cert.Ips, ip.String())
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
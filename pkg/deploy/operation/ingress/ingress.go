@@ -0,0 +1,145 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/chart"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	IngressTypeContour = "contour"
+	IngressTypeNginx   = "nginx"
+
+	ExposeModeHostNetwork = "hostNetwork"
+	ExposeModeNodePort    = "nodePort"
+
+	ManifestPath = "/tmp/installIngress.yaml"
+
+	// NodeRoleLabel is the label of ingress nodes, the value is decided by the ingress type
+	NodeRoleLabel = "node-role.kubernetes.io/ingress"
+
+	contourChartName = "contour"
+	nginxChartName   = "ingress-nginx"
+
+	// ReleaseNamespace is the namespace which the ingress controller is deployed in
+	ReleaseNamespace = "kube-system"
+)
+
+// NodeRoleLabelValue returns the value of NodeRoleLabel on ingress nodes
+func NodeRoleLabelValue(options *pb.IngressOptions) string {
+	if options.GetIngressType() == IngressTypeNginx {
+		return IngressTypeNginx
+	}
+	return "envoy"
+}
+
+// ServiceName returns the name of the NodePort service of ingress controller, the service
+// and the chart of ingress controller are named after each other.
+func ServiceName(options *pb.IngressOptions) string {
+	if options.GetIngressType() == IngressTypeNginx {
+		return nginxChartName
+	}
+	return contourChartName
+}
+
+// commonValues converts the options shared by all ingress types into values of chart
+func commonValues(options *pb.IngressOptions) (map[string]interface{}, error) {
+	values := map[string]interface{}{
+		"node_role_label": NodeRoleLabel,
+		"node_role_value": NodeRoleLabelValue(options),
+	}
+
+	switch options.GetExposeMode() {
+	case "", ExposeModeHostNetwork:
+	case ExposeModeNodePort:
+		values["expose_mode"] = ExposeModeNodePort
+		if options.GetReplicas() != 0 {
+			values["replicas"] = options.GetReplicas()
+		}
+		if options.GetHttpNodePort() != 0 {
+			values["http_node_port"] = options.GetHttpNodePort()
+		}
+		if options.GetHttpsNodePort() != 0 {
+			values["https_node_port"] = options.GetHttpsNodePort()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported expose mode of ingress: %q", options.GetExposeMode())
+	}
+
+	return values, nil
+}
+
+// contourValues converts the ingress options into values of the contour chart
+func contourValues(options *pb.IngressOptions) (map[string]interface{}, error) {
+	if options.GetDefaultTLSCertificate() != "" {
+		return nil, fmt.Errorf("contour does not support default TLS certificate, please use nginx instead")
+	}
+
+	values, err := commonValues(options)
+	if err != nil {
+		return nil, err
+	}
+	if options.GetControllerImage() != "" {
+		values["contour_image"] = options.GetControllerImage()
+	}
+	if options.GetProxyImage() != "" {
+		values["envoy_image"] = options.GetProxyImage()
+	}
+
+	return values, nil
+}
+
+// nginxValues converts the ingress options into values of the ingress-nginx chart
+func nginxValues(options *pb.IngressOptions) (map[string]interface{}, error) {
+	values, err := commonValues(options)
+	if err != nil {
+		return nil, err
+	}
+	if options.GetDefaultTLSCertificate() != "" {
+		values["default_tls_certificate"] = options.GetDefaultTLSCertificate()
+	}
+	if options.GetControllerImage() != "" {
+		values["controller_image"] = options.GetControllerImage()
+	}
+
+	return values, nil
+}
+
+// RenderManifest renders the manifest of ingress controller by the ingress type in cluster config
+func RenderManifest(clusterConfig *pb.ClusterConfig) (string, error) {
+	options := clusterConfig.GetIngressOptions()
+
+	var chartName string
+	var values map[string]interface{}
+	var err error
+	switch options.GetIngressType() {
+	case "", IngressTypeContour:
+		chartName = contourChartName
+		values, err = contourValues(options)
+	case IngressTypeNginx:
+		chartName = nginxChartName
+		values, err = nginxValues(options)
+	default:
+		return "", fmt.Errorf("unsupported ingress type: %q", options.GetIngressType())
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return chart.Render(chartName, ReleaseNamespace, values)
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestRenderContourManifest(t *testing.T) {
+	// contour in host network mode is used if ingress options is empty
+	manifest, err := RenderManifest(&pb.ClusterConfig{})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "kind: DaemonSet")
+	assert.Contains(t, manifest, "hostNetwork: true")
+	assert.Contains(t, manifest, "hostPort: 80\n")
+	assert.Contains(t, manifest, "image: kpaas/contour:v0.8")
+	assert.Contains(t, manifest, "- key: node-role.kubernetes.io/ingress")
+	assert.Contains(t, manifest, "- envoy")
+	assert.NotContains(t, manifest, "kind: Service\n")
+
+	manifest, err = RenderManifest(&pb.ClusterConfig{
+		IngressOptions: &pb.IngressOptions{
+			IngressType:     IngressTypeContour,
+			ExposeMode:      ExposeModeNodePort,
+			Replicas:        3,
+			HttpNodePort:    30080,
+			ControllerImage: "kpaas/contour:v0.9",
+			ProxyImage:      "kpaas/envoy:v1.8.0",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "kind: Deployment")
+	assert.Contains(t, manifest, "replicas: 3")
+	assert.NotContains(t, manifest, "hostNetwork: true")
+	assert.NotContains(t, manifest, "hostPort:")
+	assert.Contains(t, manifest, "type: NodePort")
+	assert.Contains(t, manifest, "nodePort: 30080")
+	assert.Contains(t, manifest, "image: kpaas/contour:v0.9")
+	assert.Contains(t, manifest, "image: kpaas/envoy:v1.8.0")
+
+	_, err = RenderManifest(&pb.ClusterConfig{
+		IngressOptions: &pb.IngressOptions{DefaultTLSCertificate: "default/tls"},
+	})
+	assert.Error(t, err)
+}
+
+func TestRenderNginxManifest(t *testing.T) {
+	manifest, err := RenderManifest(&pb.ClusterConfig{
+		IngressOptions: &pb.IngressOptions{
+			IngressType:           IngressTypeNginx,
+			DefaultTLSCertificate: "default/tls",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "kind: DaemonSet")
+	assert.Contains(t, manifest, "hostNetwork: true")
+	assert.Contains(t, manifest, "- --default-ssl-certificate=default/tls")
+	assert.Contains(t, manifest, "- nginx\n")
+	assert.NotContains(t, manifest, "--publish-service")
+
+	manifest, err = RenderManifest(&pb.ClusterConfig{
+		IngressOptions: &pb.IngressOptions{
+			IngressType:     IngressTypeNginx,
+			ExposeMode:      ExposeModeNodePort,
+			HttpsNodePort:   30443,
+			ControllerImage: "example.com/nginx-ingress-controller:0.31.0",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "kind: Deployment")
+	assert.Contains(t, manifest, "replicas: 2")
+	assert.Contains(t, manifest, "nodePort: 30443")
+	assert.Contains(t, manifest, "--publish-service=$(POD_NAMESPACE)/ingress-nginx")
+	assert.Contains(t, manifest, "image: example.com/nginx-ingress-controller:0.31.0")
+	assert.NotContains(t, manifest, "--default-ssl-certificate")
+
+	_, err = RenderManifest(&pb.ClusterConfig{IngressOptions: &pb.IngressOptions{IngressType: "unknown"}})
+	assert.Error(t, err)
+
+	_, err = RenderManifest(&pb.ClusterConfig{IngressOptions: &pb.IngressOptions{ExposeMode: "loadBalancer"}})
+	assert.Error(t, err)
+}
+
+func TestNodeRoleLabelValue(t *testing.T) {
+	assert.Equal(t, "envoy", NodeRoleLabelValue(nil))
+	assert.Equal(t, "envoy", NodeRoleLabelValue(&pb.IngressOptions{IngressType: IngressTypeContour}))
+	assert.Equal(t, "nginx", NodeRoleLabelValue(&pb.IngressOptions{IngressType: IngressTypeNginx}))
+}
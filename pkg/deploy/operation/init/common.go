@@ -37,9 +37,11 @@ const (
 	Route      ItemEnum = "route"
 	Swap       ItemEnum = "swap"
 	TimeZone   ItemEnum = "timezone"
+	TimeSync   ItemEnum = "timesync"
 	Haproxy    ItemEnum = "haproxy"
 	Keepalived ItemEnum = "keepalived"
 	KubeTool   ItemEnum = "kubetool"
+	KubeVIP    ItemEnum = "kubevip"
 )
 
 const (
@@ -69,12 +71,16 @@ func (og *OperationsGenerator) CreateOperations(item ItemEnum, action *operation
 		return &InitSwapOperation{}
 	case TimeZone:
 		return &InitTimeZoneOperation{}
+	case TimeSync:
+		return &InitTimeSyncOperation{}
 	case Haproxy:
 		return &InitHaproxyOperation{}
 	case Keepalived:
 		return &InitKeepalivedOperation{}
 	case KubeTool:
 		return &InitKubeToolOperation{}
+	case KubeVIP:
+		return &InitKubeVIPOperation{}
 	default:
 		return nil
 	}
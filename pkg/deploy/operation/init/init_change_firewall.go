@@ -16,9 +16,13 @@ package init
 
 import (
 	"bytes"
+	"fmt"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -56,9 +60,33 @@ func (itOps *InitFireWallOperation) RunCommands(node *pb.Node, initAction *opera
 		return nil, nil, err
 	}
 
+	profile := initAction.ClusterConfig.GetNodeInitProfile()
+	firewallMode := consts.FirewallMode(profile.GetFirewallMode())
+	if firewallMode == "" {
+		firewallMode = consts.FirewallModeDisable
+	}
+	selinuxMode := consts.SELinuxMode(profile.GetSelinuxMode())
+	if selinuxMode == "" {
+		selinuxMode = consts.SELinuxModeDisabled
+	}
+
+	args := []string{operation.InitRemoteScriptPath + fireWallScript,
+		fmt.Sprintf("--firewall %v", firewallMode), fmt.Sprintf("--selinux %v", selinuxMode)}
+	description := "初始化关闭防火墙"
+	if firewallMode == consts.FirewallModeOpenPorts {
+		ports, protocols := requiredPorts(initAction.NodeInitConfig.GetRoles(), initAction.ClusterConfig)
+		for _, port := range ports {
+			args = append(args, fmt.Sprintf("--port %v", port))
+		}
+		for _, protocol := range protocols {
+			args = append(args, fmt.Sprintf("--protocol %v", protocol))
+		}
+		description = "初始化防火墙开放端口"
+	}
+
 	// construct init firewall commands
-	itOps.shellCmd = command.NewShellCommand(m, "bash", operation.InitRemoteScriptPath+fireWallScript).
-		WithDescription("初始化关闭防火墙").
+	itOps.shellCmd = command.NewShellCommand(m, "bash", args...).
+		WithDescription(description).
 		WithExecuteLogWriter(logBuffer)
 
 	// execute commands
@@ -69,3 +97,83 @@ func (itOps *InitFireWallOperation) RunCommands(node *pb.Node, initAction *opera
 
 	return
 }
+
+const (
+	// IP protocol numbers allowed besides ports
+	vrrpProtocol = 112
+	ipipProtocol = 4
+)
+
+// rolePorts are ports opened for each role if the firewall is kept, in PORT[-PORT]/PROTOCOL format
+var rolePorts = map[constant.MachineRole][]string{
+	constant.MachineRoleMaster:  {"6443/tcp", "10251/tcp", "10252/tcp"},
+	constant.MachineRoleEtcd:    {"2379-2380/tcp"},
+	constant.MachineRoleIngress: {"80/tcp", "443/tcp"},
+}
+
+// requiredPorts returns the ports and IP protocols which should be allowed on the node of roles.
+// kubelet, kube-proxy health check, NodePort services and the network plugin are served on all nodes.
+func requiredPorts(roles []string, clusterConfig *pb.ClusterConfig) (ports []string, protocols []int) {
+	nodePortFrom, nodePortTo := deploy.GetNodePortRange(clusterConfig)
+	ports = []string{"10250/tcp", "10256/tcp",
+		fmt.Sprintf("%d-%d/tcp", nodePortFrom, nodePortTo), fmt.Sprintf("%d-%d/udp", nodePortFrom, nodePortTo)}
+
+	for _, role := range []constant.MachineRole{constant.MachineRoleMaster, constant.MachineRoleEtcd, constant.MachineRoleIngress} {
+		if groupByRole(roles, string(role)) {
+			ports = append(ports, rolePorts[role]...)
+		}
+	}
+
+	if groupByRole(roles, string(constant.MachineRoleMaster)) {
+		switch clusterConfig.GetKubeAPIServerConnect().GetType() {
+		case "keepalived":
+			haproxyPort := portOrDefault(clusterConfig.GetKubeAPIServerConnect().GetKeepalived().GetHaproxyPort(), DefaultHaproxyPort)
+			ports = append(ports, fmt.Sprintf("%d/tcp", haproxyPort))
+			protocols = append(protocols, vrrpProtocol)
+		case "kubevip":
+			if clusterConfig.GetKubeAPIServerConnect().GetKubeVIP().GetMode() == KubeVIPModeBGP {
+				ports = append(ports, "179/tcp")
+			}
+		}
+	}
+
+	networkPorts, networkProtocols := requiredNetworkPorts(clusterConfig.GetNetworkOptions())
+	return append(ports, networkPorts...), append(protocols, networkProtocols...)
+}
+
+// requiredNetworkPorts returns the ports and IP protocols between nodes used by the network plugin,
+// they are the same as the ones checked by the connectivity check.
+func requiredNetworkPorts(options *pb.NetworkOptions) (ports []string, protocols []int) {
+	switch consts.NetworkType(options.GetNetworkType()) {
+	case "", consts.NetworkTypeCalico:
+		ports = append(ports, "179/tcp")
+		switch options.GetCalicoOptions().GetEncapsulationMode() {
+		case consts.EncapsulationModeIpip:
+			protocols = append(protocols, ipipProtocol)
+		case consts.EncapsulationModeNone:
+		default:
+			ports = append(ports, fmt.Sprintf("%d/udp", portOrDefault(options.GetCalicoOptions().GetVxlanPort(), consts.DefaultVxlanPort)))
+		}
+	case consts.NetworkTypeFlannel:
+		if options.GetFlannelOptions().GetBackend() != consts.FlannelBackendHostGW {
+			ports = append(ports, fmt.Sprintf("%d/udp", portOrDefault(options.GetFlannelOptions().GetVxlanPort(), consts.DefaultFlannelVxlanPort)))
+		}
+	case consts.NetworkTypeCilium:
+		ports = append(ports, fmt.Sprintf("%d/tcp", consts.DefaultCiliumHealthPort))
+		switch options.GetCiliumOptions().GetTunnelMode() {
+		case consts.CiliumTunnelModeGeneve:
+			ports = append(ports, fmt.Sprintf("%d/udp", consts.DefaultCiliumGenevePort))
+		case consts.CiliumTunnelModeDisabled:
+		default:
+			ports = append(ports, fmt.Sprintf("%d/udp", consts.DefaultCiliumVxlanPort))
+		}
+	}
+	return
+}
+
+func portOrDefault(port uint32, defaultPort uint32) uint32 {
+	if port == 0 {
+		return defaultPort
+	}
+	return port
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -47,9 +48,17 @@ func (itOps *InitHostNameOperation) RunCommands(node *pb.Node, initAction *opera
 		return nil, nil, fmt.Errorf("node name can not be empty")
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "hostnamectl", fmt.Sprintf("set-hostname %v", currentName)).
-		WithDescription("初始化 host 文件").
-		WithExecuteLogWriter(logBuffer)
+	// the hostname is only verified if kept, kubelet registers the node by hostname
+	if consts.HostnamePolicy(initAction.ClusterConfig.GetNodeInitProfile().GetHostnamePolicy()) == consts.HostnamePolicyKeep {
+		itOps.shellCmd = command.NewShellCommand(m, "bash", "-c",
+			fmt.Sprintf(`'[[ "$(hostname)" == "%v" ]] || { echo "hostname $(hostname) is not the node name %v" >&2; exit 1; }'`, currentName, currentName)).
+			WithDescription("检查主机名与节点名一致").
+			WithExecuteLogWriter(logBuffer)
+	} else {
+		itOps.shellCmd = command.NewShellCommand(m, "hostnamectl", fmt.Sprintf("set-hostname %v", currentName)).
+			WithDescription("初始化 host 文件").
+			WithExecuteLogWriter(logBuffer)
+	}
 
 	// run commands
 	stdOut, stdErr, err = itOps.shellCmd.Execute()
@@ -16,7 +16,10 @@ package init
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
@@ -56,7 +59,13 @@ func (itOps *InitNetworkOperation) RunCommands(node *pb.Node, initAction *operat
 		return nil, nil, err
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "bash", operation.InitRemoteScriptPath+networkScript).
+	args := []string{operation.InitRemoteScriptPath + networkScript}
+	if needIPv6(node, initAction.ClusterConfig) {
+		args = append(args, "ipv6")
+	}
+	args = append(args, sysctlArgs(initAction.ClusterConfig.GetNodeInitProfile().GetSysctl())...)
+
+	itOps.shellCmd = command.NewShellCommand(m, "bash", args...).
 		WithDescription("初始化网络配置").
 		WithExecuteLogWriter(logBuffer)
 
@@ -68,3 +77,27 @@ func (itOps *InitNetworkOperation) RunCommands(node *pb.Node, initAction *operat
 
 	return
 }
+
+// needIPv6 returns true if the node has an IPv6 address or the cluster has IPv6 subnets
+func needIPv6(node *pb.Node, clusterConfig *pb.ClusterConfig) bool {
+	if deploy.IsIPv6(node.GetIp()) {
+		return true
+	}
+	return deploy.GetSubnetOfFamily(deploy.GetPodSubnets(clusterConfig), true) != "" ||
+		deploy.GetSubnetOfFamily(deploy.GetServiceSubnets(clusterConfig), true) != ""
+}
+
+// sysctlArgs returns the sysctl settings of node init profile as quoted key=value arguments, sorted by key
+func sysctlArgs(sysctl map[string]string) []string {
+	keys := make([]string, 0, len(sysctl))
+	for key := range sysctl {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, fmt.Sprintf("'%v=%v'", key, sysctl[key]))
+	}
+	return args
+}
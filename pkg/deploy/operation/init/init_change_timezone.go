@@ -46,8 +46,13 @@ func (itOps *InitTimeZoneOperation) RunCommands(node *pb.Node, initAction *opera
 
 	itOps.NodeInitAction = initAction
 
-	itOps.shellCmd = command.NewShellCommand(m, "timedatectl", fmt.Sprintf("set-timezone %v", defaultTimeZone)).
-		WithDescription(fmt.Sprintf("初始化时区为 %s", defaultTimeZone)).
+	timeZone := initAction.ClusterConfig.GetNodeInitProfile().GetTimezone()
+	if timeZone == "" {
+		timeZone = defaultTimeZone
+	}
+
+	itOps.shellCmd = command.NewShellCommand(m, "timedatectl", fmt.Sprintf("set-timezone %v", timeZone)).
+		WithDescription(fmt.Sprintf("初始化时区为 %s", timeZone)).
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
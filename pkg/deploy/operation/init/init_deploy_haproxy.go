@@ -29,7 +29,7 @@ import (
 )
 
 const (
-	HaproxyPort   uint16 = 6443
+	APIServerPort uint16 = 6443
 	haproxyScript        = "/scripts/init_deploy_haproxy_keepalived/setup_kubernetes_high_availability.sh"
 )
 
@@ -60,10 +60,19 @@ func CheckHaproxyParameter(ipAddresses ...string) error {
 type InitHaproxyOperation struct {
 	shellCmd       *command.ShellCommand
 	NodeInitAction *operation.NodeInitAction
+	// ScriptAction is the action of setup script, default HAScriptActionRun
+	ScriptAction string
 }
 
 func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
+	logBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- logBuffer
+	}()
+
 	m, err := machine.NewMachine(node)
 	if err != nil {
 		return nil, nil, err
@@ -71,8 +80,6 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 
 	defer m.Close()
 
-	logBuffer := &bytes.Buffer{}
-
 	itOps.NodeInitAction = initAction
 
 	if masterIps := itOps.getMastersIP(); len(masterIps) == 0 {
@@ -80,12 +87,17 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 		return nil, nil, err
 	}
 
-	haproxyStr := buildHaproxyStr(itOps.getMastersIP(), HaproxyPort)
+	haproxyStr := buildHaproxyStr(itOps.getMastersIP(), APIServerPort)
 	if haproxyStr == "" {
 		err = fmt.Errorf("haproxy string can not be built, please check")
 		return nil, nil, err
 	}
 
+	keepalived := initAction.ClusterConfig.GetKubeAPIServerConnect().GetKeepalived()
+	if err = CheckHAParameter(keepalived); err != nil {
+		return nil, nil, err
+	}
+
 	// put setup.sh to machine
 	scriptFile, err := assets.Assets.Open(haproxyScript)
 	if err != nil {
@@ -130,16 +142,14 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 		return nil, nil, err
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -u '%v' haproxy run", operation.InitRemoteScriptPath+haproxyScript, haproxyStr)).
+	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -u '%v' %v haproxy %v",
+		operation.InitRemoteScriptPath+haproxyScript, haproxyStr, haproxyArgs(keepalived), scriptAction(itOps.ScriptAction))).
 		WithDescription("初始化部署 haproxy 工具").
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
 	stdOut, stdErr, err = itOps.shellCmd.Execute()
 
-	// write to log channel
-	logChan <- logBuffer
-
 	return
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 func TestDeployHaproxy(t *testing.T) {
@@ -50,3 +51,9 @@ func TestDeployHaproxy(t *testing.T) {
 		assert.Equal(t, cs.want, CheckHaproxyParameter(cs.ipAddresses))
 	}
 }
+
+func TestHaproxyArgs(t *testing.T) {
+	assert.Equal(t, "", haproxyArgs(&pb.Keepalived{}))
+	assert.Equal(t, "-p 8443", haproxyArgs(&pb.Keepalived{HaproxyPort: 8443}))
+	assert.Equal(t, "-p 8443 -b '::'", haproxyArgs(&pb.Keepalived{Vip: "fd00::100", HaproxyPort: 8443}))
+}
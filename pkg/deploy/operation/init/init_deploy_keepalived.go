@@ -17,6 +17,7 @@ package init
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -29,6 +30,20 @@ import (
 
 const (
 	keepalivedScript = "/scripts/init_deploy_haproxy_keepalived/setup_kubernetes_high_availability.sh"
+
+	DefaultHaproxyPort         = 4443
+	DefaultHaproxyStatsPort    = 1936
+	DefaultHealthCheckInterval = 2
+
+	defaultHaproxyBindAddress = "0.0.0.0"
+
+	HAScriptActionRun         = "run"
+	HAScriptActionReconfigure = "reconfigure"
+
+	keepalivedMaxPriority           = 100
+	keepalivedMaxVirtualRouterID    = 255
+	keepalivedAuthPasswordMaxLength = 8
+	maxPort                         = 65535
 )
 
 func CheckKeepalivedParameter(ipAddress string, ethernet string) error {
@@ -51,13 +66,122 @@ func CheckKeepalivedParameter(ipAddress string, ethernet string) error {
 	return fmt.Errorf(operation.ErrInvalid)
 }
 
+// CheckHAParameter checks the optional parameters of haproxy and keepalived, zero values mean defaults
+func CheckHAParameter(config *pb.Keepalived) error {
+	if config == nil {
+		return fmt.Errorf("keepalived config can not be empty")
+	}
+
+	if config.HaproxyPort > maxPort || config.HaproxyStatsPort > maxPort {
+		return fmt.Errorf("haproxy port and stats port should be less than %v", maxPort+1)
+	}
+
+	haproxyPort, statsPort := config.HaproxyPort, config.HaproxyStatsPort
+	if haproxyPort == 0 {
+		haproxyPort = DefaultHaproxyPort
+	}
+	if statsPort == 0 {
+		statsPort = DefaultHaproxyStatsPort
+	}
+	if haproxyPort == statsPort {
+		return fmt.Errorf("haproxy port and stats port can not be the same: %v", haproxyPort)
+	}
+
+	if config.VirtualRouterID > keepalivedMaxVirtualRouterID {
+		return fmt.Errorf("virtual router id should be in [1, %v]", keepalivedMaxVirtualRouterID)
+	}
+
+	if len(config.AuthPassword) > keepalivedAuthPasswordMaxLength {
+		return fmt.Errorf("keepalived auth password should be at most %v characters", keepalivedAuthPasswordMaxLength)
+	}
+
+	if strings.ContainsAny(config.AuthPassword, "' \t\n") {
+		return fmt.Errorf("keepalived auth password can not contain quote or blank characters")
+	}
+
+	return nil
+}
+
+// haproxyArgs constructs the setup script flags of haproxy parameters
+func haproxyArgs(config *pb.Keepalived) string {
+	args := []string{}
+	if config.GetHaproxyPort() != 0 {
+		args = append(args, fmt.Sprintf("-p %v", config.GetHaproxyPort()))
+	}
+	if config.GetHaproxyStatsPort() != 0 {
+		args = append(args, fmt.Sprintf("-s %v", config.GetHaproxyStatsPort()))
+	}
+	if bindAddress := haproxyBindAddress(config); bindAddress != defaultHaproxyBindAddress {
+		args = append(args, fmt.Sprintf("-b '%v'", bindAddress))
+	}
+	return strings.Join(args, " ")
+}
+
+// haproxyBindAddress returns the address haproxy listens on for kube-apiserver,
+// both ip families are listened on so that an IPv6 vip is served too.
+func haproxyBindAddress(config *pb.Keepalived) string {
+	if strings.Contains(config.GetVip(), ":") {
+		return "::"
+	}
+	return defaultHaproxyBindAddress
+}
+
+// keepalivedArgs constructs the setup script flags of keepalived parameters
+func keepalivedArgs(config *pb.Keepalived, priority int) string {
+	args := []string{haproxyArgs(config), fmt.Sprintf("-P %v", priority)}
+	if config.GetVirtualRouterID() != 0 {
+		args = append(args, fmt.Sprintf("-r %v", config.GetVirtualRouterID()))
+	}
+	if config.GetAuthPassword() != "" {
+		args = append(args, fmt.Sprintf("-a '%v'", config.GetAuthPassword()))
+	}
+	if config.GetHealthCheckInterval() != 0 {
+		args = append(args, fmt.Sprintf("-t %v", config.GetHealthCheckInterval()))
+	}
+	return strings.TrimSpace(strings.Join(args, " "))
+}
+
+// keepalivedPriority gives the masters decreasing priorities by their order in nodes config,
+// so the vip stays on the first master whenever it's healthy.
+func keepalivedPriority(nodeName string, nodesConfig []*pb.NodeDeployConfig) int {
+	priority := keepalivedMaxPriority
+	for _, node := range nodesConfig {
+		if !groupByRole(node.Roles, "master") {
+			continue
+		}
+		if node.GetNode().GetName() == nodeName {
+			return priority
+		}
+		if priority > 1 {
+			priority--
+		}
+	}
+	return priority
+}
+
+func scriptAction(action string) string {
+	if action == "" {
+		return HAScriptActionRun
+	}
+	return action
+}
+
 type InitKeepalivedOperation struct {
 	shellCmd       *command.ShellCommand
 	NodeInitAction *operation.NodeInitAction
+	// ScriptAction is the action of setup script, default HAScriptActionRun
+	ScriptAction string
 }
 
 func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
+	logBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- logBuffer
+	}()
+
 	m, err := machine.NewMachine(node)
 	if err != nil {
 		return nil, nil, err
@@ -65,19 +189,22 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 
 	defer m.Close()
 
-	logBuffer := &bytes.Buffer{}
-
 	itOps.NodeInitAction = initAction
 
+	keepalived := initAction.ClusterConfig.GetKubeAPIServerConnect().GetKeepalived()
+	if err = CheckHAParameter(keepalived); err != nil {
+		return nil, nil, err
+	}
+
 	// acquire floating IP for keepalived
-	floatingIP := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.Vip
+	floatingIP := keepalived.Vip
 	if floatingIP == "" {
 		err = fmt.Errorf("floating ip can not be empty")
 		return nil, nil, err
 	}
 
 	// acquire floating ethernet for keepalived
-	floatingEthernet := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.NetInterfaceName
+	floatingEthernet := keepalived.NetInterfaceName
 	if floatingEthernet == "" {
 		err = fmt.Errorf("floating ethernet can not be empty")
 		return nil, nil, err
@@ -127,15 +254,16 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 		return nil, nil, err
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -n '%v' -i %v keepalived run", operation.InitRemoteScriptPath+keepalivedScript, floatingIP, floatingEthernet)).
+	priority := keepalivedPriority(node.Name, initAction.NodesConfig)
+
+	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -n '%v' -i %v %v keepalived %v",
+		operation.InitRemoteScriptPath+keepalivedScript, floatingIP, floatingEthernet,
+		keepalivedArgs(keepalived, priority), scriptAction(itOps.ScriptAction))).
 		WithDescription("初始化部署 keepalived 工具").
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
 	stdOut, stdErr, err = itOps.shellCmd.Execute()
 
-	// write to log channel
-	logChan <- logBuffer
-
 	return
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 func TestDeployKeepalived(t *testing.T) {
@@ -60,3 +61,77 @@ func TestDeployKeepalived(t *testing.T) {
 		assert.Equal(t, cs.want, CheckKeepalivedParameter(cs.ipAddress, cs.ethernet))
 	}
 }
+
+func TestCheckHAParameter(t *testing.T) {
+	testCases := []struct {
+		config  *pb.Keepalived
+		wantErr bool
+	}{
+		{
+			config:  nil,
+			wantErr: true,
+		},
+		{
+			config:  &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+			wantErr: false,
+		},
+		{
+			config: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0", HaproxyPort: 8443, HaproxyStatsPort: 9000,
+				VirtualRouterID: 51, AuthPassword: "secret", HealthCheckInterval: 5},
+			wantErr: false,
+		},
+		{
+			config:  &pb.Keepalived{HaproxyPort: 70000},
+			wantErr: true,
+		},
+		{
+			config:  &pb.Keepalived{HaproxyPort: DefaultHaproxyStatsPort},
+			wantErr: true,
+		},
+		{
+			config:  &pb.Keepalived{VirtualRouterID: 256},
+			wantErr: true,
+		},
+		{
+			config:  &pb.Keepalived{AuthPassword: "toolongpassword"},
+			wantErr: true,
+		},
+		{
+			config:  &pb.Keepalived{AuthPassword: "a'b"},
+			wantErr: true,
+		},
+	}
+
+	for _, cs := range testCases {
+		err := CheckHAParameter(cs.config)
+		if cs.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestKeepalivedArgs(t *testing.T) {
+	assert.Equal(t, "-P 100", keepalivedArgs(&pb.Keepalived{}, 100))
+	assert.Equal(t, "-p 8443 -s 9000 -P 99 -r 51 -a 'secret' -t 5", keepalivedArgs(&pb.Keepalived{
+		HaproxyPort:         8443,
+		HaproxyStatsPort:    9000,
+		VirtualRouterID:     51,
+		AuthPassword:        "secret",
+		HealthCheckInterval: 5,
+	}, 99))
+}
+
+func TestKeepalivedPriority(t *testing.T) {
+	nodesConfig := []*pb.NodeDeployConfig{
+		{Node: &pb.Node{Name: "worker1"}, Roles: []string{"worker"}},
+		{Node: &pb.Node{Name: "master1"}, Roles: []string{"master", "etcd"}},
+		{Node: &pb.Node{Name: "master2"}, Roles: []string{"master"}},
+		{Node: &pb.Node{Name: "master3"}, Roles: []string{"master"}},
+	}
+
+	assert.Equal(t, 100, keepalivedPriority("master1", nodesConfig))
+	assert.Equal(t, 99, keepalivedPriority("master2", nodesConfig))
+	assert.Equal(t, 98, keepalivedPriority("master3", nodesConfig))
+}
@@ -22,6 +22,7 @@ import (
 	"k8s.io/kubernetes/pkg/registry/core/service/ipallocator"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
@@ -44,11 +45,48 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 	pkgMirrorUrl := fmt.Sprintf("--pkg-mirror %v", constant.DefaultPkgMirror)
 	kubernetesVersion := fmt.Sprintf("--version %v", constant.DefaultKubeVersion)
 
-	// we would use initAction's service subnet in the future
-	clusterDNSIP = fmt.Sprintf("--cluster-dns %v", getDNSIP(constant.DefaultServiceSubnet))
+	// cluster dns is in the first service subnet, which is the primary ip family of a dual-stack cluster
+	serviceSubnet := constant.DefaultServiceSubnet
+	if serviceSubnets := deploy.GetServiceSubnets(initAction.ClusterConfig); len(serviceSubnets) > 0 {
+		serviceSubnet = serviceSubnets[0]
+	}
+	clusterDNSIP = fmt.Sprintf("--cluster-dns %v", getDNSIP(serviceSubnet))
+
+	// kubelet auto detects a private IPv4 address if node ip is not given
+	if node.GetIp() != "" {
+		nodeIp = fmt.Sprintf("--node-ip %v", node.GetIp())
+	}
+
+	var featureGates string
+	if deploy.IsDualStack(initAction.ClusterConfig) {
+		featureGates = fmt.Sprintf("--feature-gates %v=true", consts.IPv6DualStackFeatureGate)
+	}
+
+	// containerd is installed and configured before kubelet by the script
+	containerRuntime := fmt.Sprintf("--container-runtime %v", deploy.GetContainerRuntime(initAction.ClusterConfig))
+
+	// kubelet runs with swap if it's kept by the node init profile
+	var allowSwap string
+	if deploy.IsSwapKept(initAction.ClusterConfig) {
+		allowSwap = "--allow-swap"
+	}
+
+	// ipset, ipvsadm and ipvs kernel modules are required by kube-proxy in ipvs mode
+	var ipvs string
+	if deploy.GetKubeProxyMode(initAction.ClusterConfig) == consts.KubeProxyModeIPVS {
+		ipvs = "--ipvs"
+	}
+
+	// kubelet loads the KubeletConfiguration written by kubeadm if it's given
+	var kubeletConfig string
+	if initAction.ClusterConfig.GetComponentConfig().GetKubeletConfiguration() != "" {
+		kubeletConfig = "--kubelet-config"
+	}
 
-	// we would use initAction's image repository in the future
-	imageRepository = fmt.Sprintf("--image-repository %v", constant.DefaultImageRepository)
+	// package manager, container runtime and kubeadm on nodes reach outside through the proxy if it's used
+	proxy := proxyArgs(initAction)
+
+	imageRepository = fmt.Sprintf("--image-repository %v", deploy.GetImageRepository(initAction.ClusterConfig))
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -83,6 +121,12 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 		return nil, nil, err
 	}
 
+	// certificates, credentials and mirrors of registries are installed by the script
+	registry, err := stageRegistryFiles(m, initAction)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// setup repos
 	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v setup repos %v", operation.InitRemoteScriptPath+consts.DefaultKubeToolScript,
 		pkgMirrorUrl)).
@@ -90,10 +134,12 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 		WithExecuteLogWriter(logBuffer)
 
 	// install kubelet, kubeadm, kubectl
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v setup kubelet %v %v %v %v", operation.InitRemoteScriptPath+consts.DefaultKubeToolScript,
-		kubernetesVersion, imageRepository, clusterDNSIP, nodeIp)).
+	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v setup kubelet %v %v %v %v %v %v %v %v %v %v %v", operation.InitRemoteScriptPath+consts.DefaultKubeToolScript,
+		kubernetesVersion, imageRepository, clusterDNSIP, nodeIp, featureGates, containerRuntime, allowSwap, kubeletConfig, ipvs,
+		proxy, registry)).
 		WithDescription("初始化安装 kubernetes 工具").
-		WithExecuteLogWriter(logBuffer)
+		WithExecuteLogWriter(logBuffer).
+		WithSecrets(append(registrySecrets(initAction.ClusterConfig), proxySecrets(initAction.ClusterConfig)...)...)
 
 	// run commands
 	stdOut, stdErr, err = itOps.shellCmd.Execute()
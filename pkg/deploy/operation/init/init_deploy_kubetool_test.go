@@ -0,0 +1,194 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+// kubeToolTestScript sources the kube tool script and runs the given functions with the os-release captured
+// from the mock machine. Repo files are written into the temporary dir and commands are printed instead of executed.
+const kubeToolTestScript = `. %[1]s/init_deploy_kubetool.sh
+OS_RELEASE=%[1]s/os-release
+APT_SOURCES=%[1]s/sources.list
+APT_SOURCES_DIR=%[1]s/sources.list.d
+YUM_REPOS_DIR=%[1]s/yum.repos.d
+PKG_MIRROR=mirrors.example.com
+LOCALREPO_ADDR=%[2]s
+CONTAINERD_INSTALLED=false
+command::exec() { echo exec: $@; }
+command::exists() { return 1; }
+dist::detect
+echo "dist: $LSB_DIST $DIST_VERSION $DIST_MAJOR_VERSION $PKG_MGR"
+%[3]s
+`
+
+// runKubeToolFunctions returns the output of functions and the temporary dir which contains the repo files
+func runKubeToolFunctions(t *testing.T, distro, localRepo, functions string) (string, string) {
+	dir, err := ioutil.TempDir("", "kubetool")
+	assert.NoError(t, err)
+	for _, subDir := range []string{"sources.list.d", "yum.repos.d"} {
+		assert.NoError(t, os.Mkdir(filepath.Join(dir, subDir), 0755))
+	}
+
+	for _, script := range []string{consts.DefaultKubeToolScript, DefaultCommonLibPath} {
+		file, err := assets.Assets.Open(script)
+		assert.NoError(t, err)
+		content, err := ioutil.ReadAll(file)
+		file.Close()
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, filepath.Base(script)), content, 0644))
+	}
+
+	m, err := machine.NewMachine(&pb.Node{Name: distro})
+	assert.NoError(t, err)
+	osRelease, _, err := m.Run("cat /etc/os-release")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "os-release"), osRelease, 0644))
+
+	output, err := exec.Command("bash", "-c", fmt.Sprintf(kubeToolTestScript, dir, localRepo, functions)).CombinedOutput()
+	assert.NoError(t, err, string(output))
+	return string(output), dir
+}
+
+func readRepoFile(t *testing.T, dir, name string) string {
+	content, err := ioutil.ReadFile(filepath.Join(dir, name))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func repoFileExists(dir, name string) bool {
+	_, err := os.Stat(filepath.Join(dir, name))
+	return err == nil
+}
+
+func TestKubeToolScriptDistributions(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is required to run the kube tool script")
+	}
+
+	tests := []struct {
+		distro    string
+		localRepo string
+		check     func(t *testing.T, output, dir string)
+	}{
+		{
+			distro: "ubuntu-20.04",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, output, "dist: ubuntu focal 20 apt")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list"), "http://mirrors.example.com/ubuntu/ focal main")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list.d/kubernetes.list"), "kubernetes-focal main")
+				assert.Contains(t, output, "exec: apt-key adv --recv-keys")
+				assert.Contains(t, output, "exec: apt install -y --allow-unauthenticated containerd")
+			},
+		},
+		{
+			distro: "debian-10",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, output, "dist: debian buster 10 apt")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list"), "http://mirrors.example.com/debian-security/ buster/updates main")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list.d/kubernetes.list"), "kubernetes-xenial main")
+				assert.Contains(t, output, "exec: apt install -y --allow-unauthenticated gnupg")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list.d/docker-ce.list"), "/docker-ce/linux/debian buster stable")
+				assert.Contains(t, output, "exec: apt install -y --allow-unauthenticated containerd.io")
+			},
+		},
+		{
+			distro: "debian-11",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, output, "dist: debian bullseye 11 apt")
+				assert.Contains(t, readRepoFile(t, dir, "sources.list"), "http://mirrors.example.com/debian-security/ bullseye-security main")
+			},
+		},
+		{
+			distro:    "debian-11",
+			localRepo: "http://10.10.0.1:8880/localrepo",
+			check: func(t *testing.T, output, dir string) {
+				// nothing is fetched from the internet for offline installation
+				assert.Equal(t, "deb [trusted=yes] http://10.10.0.1:8880/localrepo ./\n", readRepoFile(t, dir, "sources.list"))
+				assert.NotContains(t, output, "apt-key")
+				assert.False(t, repoFileExists(dir, "sources.list.d/kubernetes.list"))
+				assert.False(t, repoFileExists(dir, "sources.list.d/docker-ce.list"))
+				assert.Contains(t, output, "exec: apt install -y --allow-unauthenticated containerd.io")
+			},
+		},
+		{
+			distro: "rocky-8",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, output, "dist: rocky 8.5 8 yum")
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/epel.repo"), "http://mirrors.example.com/epel/8/$basearch")
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/k8s.repo"), "kubernetes-el7-x86_64")
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/docker-ce.repo"), "/docker-ce/linux/centos/8/$basearch/stable")
+				assert.Contains(t, output, "exec: yum install -y --setopt=obsoletes=0 --nogpgcheck containerd.io")
+			},
+		},
+		{
+			distro: "almalinux-8",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, output, "dist: almalinux 8.5 8 yum")
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/epel.repo"), "http://mirrors.example.com/epel/8/$basearch")
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/docker-ce.repo"), "/docker-ce/linux/centos/8/$basearch/stable")
+			},
+		},
+		{
+			distro: "openeuler-20.03",
+			check: func(t *testing.T, output, dir string) {
+				// epel packages and containerd are shipped in the openEuler repos
+				assert.Contains(t, output, "dist: openEuler 20.03 20 yum")
+				assert.False(t, repoFileExists(dir, "yum.repos.d/epel.repo"))
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/k8s.repo"), "kubernetes-el7-x86_64")
+				assert.False(t, repoFileExists(dir, "yum.repos.d/docker-ce.repo"))
+				assert.Contains(t, output, "exec: yum install -y --setopt=obsoletes=0 --nogpgcheck containerd\n")
+			},
+		},
+		{
+			distro:    "centos-7",
+			localRepo: "http://10.10.0.1:8880/localrepo",
+			check: func(t *testing.T, output, dir string) {
+				assert.Contains(t, readRepoFile(t, dir, "yum.repos.d/local.repo"), "baseurl=http://10.10.0.1:8880/localrepo")
+				assert.False(t, repoFileExists(dir, "yum.repos.d/epel.repo"))
+				assert.False(t, repoFileExists(dir, "yum.repos.d/docker-ce.repo"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		name := tt.distro
+		if tt.localRepo != "" {
+			name += "-local-repo"
+		}
+		t.Run(name, func(t *testing.T) {
+			output, dir := runKubeToolFunctions(t, tt.distro, tt.localRepo, "repos::setup\ncontainerd::install")
+			defer os.RemoveAll(dir)
+			tt.check(t, output, dir)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"text/template"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	KubeVIPModeARP = "arp"
+	KubeVIPModeBGP = "bgp"
+
+	DefaultKubeVIPImage = "plndr/kube-vip:0.3.1"
+
+	kubeVIPManifestDir  = consts.DefaultK8sConfigDir + "/manifests"
+	kubeVIPManifestPath = kubeVIPManifestDir + "/kube-vip.yaml"
+	kubeVIPAdminConf    = consts.DefaultK8sConfigDir + "/admin.conf"
+)
+
+// kube-vip reaches apiserver by "kubernetes:6443" which is aliased to localhost,
+// so that the vip can be brought up before it points to any apiserver.
+const kubeVIPManifestTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-vip
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-vip
+    image: {{ .Image }}
+    imagePullPolicy: IfNotPresent
+    args:
+    - manager
+    env:
+    - name: vip_interface
+      value: "{{ .Interface }}"
+    - name: address
+      value: "{{ .VIP }}"
+    - name: port
+      value: "{{ .Port }}"
+    - name: cp_enable
+      value: "true"
+    - name: cp_namespace
+      value: kube-system
+    - name: vip_leaderelection
+      value: "true"
+    - name: vip_leaseduration
+      value: "5"
+    - name: vip_renewdeadline
+      value: "3"
+    - name: vip_retryperiod
+      value: "1"
+{{- if eq .Mode "bgp" }}
+    - name: bgp_enable
+      value: "true"
+    - name: bgp_routerid
+      value: "{{ .RouterID }}"
+    - name: bgp_as
+      value: "{{ .LocalAS }}"
+    - name: bgp_peers
+      value: "{{ .Peers }}"
+{{- else }}
+    - name: vip_arp
+      value: "true"
+{{- end }}
+    securityContext:
+      capabilities:
+        add:
+        - NET_ADMIN
+        - NET_RAW
+        - SYS_TIME
+    volumeMounts:
+    - mountPath: /etc/kubernetes/admin.conf
+      name: kubeconfig
+  hostAliases:
+  - hostnames:
+    - kubernetes
+    ip: 127.0.0.1
+  hostNetwork: true
+  volumes:
+  - hostPath:
+      path: {{ .KubeConfig }}
+      type: FileOrCreate
+    name: kubeconfig
+`
+
+type kubeVIPManifestValues struct {
+	Image      string
+	Interface  string
+	VIP        string
+	Port       int
+	Mode       string
+	RouterID   string
+	LocalAS    uint32
+	Peers      string
+	KubeConfig string
+}
+
+// CheckKubeVIPParameter checks if the kube-vip config is able to generate the static pod manifest
+func CheckKubeVIPParameter(config *pb.KubeVIP) error {
+	if config == nil || config.Vip == "" || config.NetInterfaceName == "" {
+		return fmt.Errorf("%v", operation.ErrParaEmpty)
+	}
+
+	if !operation.CheckIPValid(config.Vip) {
+		return fmt.Errorf("%v: vip %v", operation.ErrInvalid, config.Vip)
+	}
+
+	switch config.Mode {
+	case "", KubeVIPModeARP:
+		return nil
+	case KubeVIPModeBGP:
+	default:
+		return fmt.Errorf("%v: kube-vip mode %v", operation.ErrInvalid, config.Mode)
+	}
+
+	if config.LocalAS == 0 {
+		return fmt.Errorf("local AS number is required in bgp mode")
+	}
+	if len(config.BgpPeers) == 0 {
+		return fmt.Errorf("at least one bgp peer is required in bgp mode")
+	}
+	for _, peer := range config.BgpPeers {
+		if net.ParseIP(peer.Address) == nil || peer.As == 0 {
+			return fmt.Errorf("%v: bgp peer %v, AS %v", operation.ErrInvalid, peer.Address, peer.As)
+		}
+	}
+
+	return nil
+}
+
+// NewKubeVIPManifest generates the kube-vip static pod manifest, routerID is used when it's not set in config.
+func NewKubeVIPManifest(config *pb.KubeVIP, routerID string) (string, error) {
+	if err := CheckKubeVIPParameter(config); err != nil {
+		return "", err
+	}
+
+	values := kubeVIPManifestValues{
+		Image:      config.Image,
+		Interface:  config.NetInterfaceName,
+		VIP:        config.Vip,
+		Port:       int(APIServerPort),
+		Mode:       config.Mode,
+		RouterID:   config.RouterID,
+		LocalAS:    config.LocalAS,
+		KubeConfig: kubeVIPAdminConf,
+	}
+	if values.Image == "" {
+		values.Image = DefaultKubeVIPImage
+	}
+	if values.RouterID == "" {
+		values.RouterID = routerID
+	}
+
+	// kube-vip peer format: address:as:password,address:as:password
+	peers := make([]string, 0, len(config.BgpPeers))
+	for _, peer := range config.BgpPeers {
+		peers = append(peers, fmt.Sprintf("%v:%v:%v", peer.Address, peer.As, peer.Password))
+	}
+	values.Peers = strings.Join(peers, ",")
+
+	tmpl, err := template.New("kube-vip").Parse(kubeVIPManifestTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	manifest := &bytes.Buffer{}
+	if err := tmpl.Execute(manifest, values); err != nil {
+		return "", err
+	}
+
+	return manifest.String(), nil
+}
+
+type InitKubeVIPOperation struct {
+	shellCmd       *command.ShellCommand
+	NodeInitAction *operation.NodeInitAction
+}
+
+func (itOps *InitKubeVIPOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	logBuffer := &bytes.Buffer{}
+
+	// log channel always needs a buffer, otherwise executor will wait for it
+	defer func() {
+		logChan <- logBuffer
+	}()
+
+	itOps.NodeInitAction = initAction
+
+	manifest, err := NewKubeVIPManifest(initAction.ClusterConfig.GetKubeAPIServerConnect().GetKubeVIP(), node.Ip)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := machine.NewMachine(node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	itOps.shellCmd = command.NewShellCommand(m, "mkdir", "-p", kubeVIPManifestDir).
+		WithDescription("创建 kube-vip 静态 pod 目录").
+		WithExecuteLogWriter(logBuffer)
+
+	if stdOut, stdErr, err = itOps.shellCmd.Execute(); err != nil {
+		return
+	}
+
+	// kubelet will start kube-vip static pod after it's started
+	if err := m.PutFile(strings.NewReader(manifest), kubeVIPManifestPath); err != nil {
+		return nil, nil, err
+	}
+
+	fmt.Fprintf(logBuffer, "kube-vip static pod manifest is written to %v\n", kubeVIPManifestPath)
+
+	return
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/yaml"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestCheckKubeVIPParameter(t *testing.T) {
+	testCases := []struct {
+		config  *pb.KubeVIP
+		wantErr bool
+	}{
+		{
+			config:  nil,
+			wantErr: true,
+		},
+		{
+			config:  &pb.KubeVIP{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+			wantErr: false,
+		},
+		{
+			config:  &pb.KubeVIP{Vip: "192.168.1.100"},
+			wantErr: true,
+		},
+		{
+			config:  &pb.KubeVIP{Vip: "192.168.1", NetInterfaceName: "eth0"},
+			wantErr: true,
+		},
+		{
+			config:  &pb.KubeVIP{Vip: "192.168.1.100", NetInterfaceName: "eth0", Mode: "vrrp"},
+			wantErr: true,
+		},
+		{
+			config:  &pb.KubeVIP{Vip: "192.168.1.100", NetInterfaceName: "eth0", Mode: KubeVIPModeBGP, LocalAS: 65000},
+			wantErr: true,
+		},
+		{
+			config: &pb.KubeVIP{Vip: "192.168.1.100", NetInterfaceName: "eth0", Mode: KubeVIPModeBGP, LocalAS: 65000,
+				BgpPeers: []*pb.BGPPeer{{Address: "192.168.1.1", As: 65001}}},
+			wantErr: false,
+		},
+	}
+
+	for _, cs := range testCases {
+		err := CheckKubeVIPParameter(cs.config)
+		if cs.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestNewKubeVIPManifest(t *testing.T) {
+	manifest, err := NewKubeVIPManifest(&pb.KubeVIP{
+		Vip:              "192.168.1.100",
+		NetInterfaceName: "eth0",
+		Mode:             KubeVIPModeBGP,
+		LocalAS:          65000,
+		BgpPeers: []*pb.BGPPeer{
+			{Address: "192.168.1.1", As: 65001, Password: "secret"},
+			{Address: "192.168.1.2", As: 65001},
+		},
+	}, "192.168.1.10")
+	assert.NoError(t, err)
+
+	pod := struct {
+		Spec struct {
+			HostNetwork bool `json:"hostNetwork"`
+			Containers  []struct {
+				Image string `json:"image"`
+				Env   []struct {
+					Name  string `json:"name"`
+					Value string `json:"value"`
+				} `json:"env"`
+			} `json:"containers"`
+		} `json:"spec"`
+	}{}
+	assert.NoError(t, yaml.Unmarshal([]byte(manifest), &pod))
+	assert.True(t, pod.Spec.HostNetwork)
+	assert.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, DefaultKubeVIPImage, pod.Spec.Containers[0].Image)
+
+	env := make(map[string]string)
+	for _, item := range pod.Spec.Containers[0].Env {
+		env[item.Name] = item.Value
+	}
+	assert.Equal(t, "192.168.1.100", env["address"])
+	assert.Equal(t, "eth0", env["vip_interface"])
+	assert.Equal(t, "6443", env["port"])
+	assert.Equal(t, "true", env["bgp_enable"])
+	assert.Equal(t, "192.168.1.10", env["bgp_routerid"])
+	assert.Equal(t, "65000", env["bgp_as"])
+	assert.Equal(t, "192.168.1.1:65001:secret,192.168.1.2:65001:", env["bgp_peers"])
+	assert.NotContains(t, env, "vip_arp")
+
+	manifest, err = NewKubeVIPManifest(&pb.KubeVIP{Vip: "192.168.1.100", NetInterfaceName: "eth0", Image: "kube-vip:test"}, "192.168.1.10")
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "image: kube-vip:test")
+	assert.Contains(t, manifest, "name: vip_arp")
+	assert.NotContains(t, manifest, "bgp_enable")
+}
@@ -0,0 +1,201 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// paths of the configs written by the setup script of systemd daemon
+const (
+	haproxyConfigPath    = "/etc/haproxy/haproxy.cfg"
+	keepalivedConfigPath = "/etc/keepalived/keepalived.conf"
+)
+
+// haproxyConfigTemplate and keepalivedConfigTemplate are the same as the ones in
+// init_deploy_haproxy_keepalived/lib.sh, which renders the configs on nodes,
+// TestHAConfigSameAsScript runs the script to make sure they don't drift apart.
+const (
+	haproxyConfigTemplate = `global
+  #user haproxy
+  #group haproxy
+  daemon
+  maxconn 4096
+defaults
+  mode    tcp
+  balance leastconn
+  timeout client      30s
+  timeout server      30s
+  timeout connect      3s
+  retries 3
+listen stats
+  bind 0.0.0.0:%v
+  mode http
+  stats enable
+  stats uri /
+frontend kubernetes
+  bind %v:%v%v
+  default_backend kube-apiserver
+backend kube-apiserver
+%v
+`
+
+	keepalivedConfigTemplate = `vrrp_script chk_proxy {
+  script "nc -w 3 -z 127.0.0.1 %v"
+  interval %v
+  weight -100
+  fall 3
+  rise 2
+}
+
+vrrp_instance kubernetes_ha {
+  interface %v
+  virtual_router_id %v
+  #nopreempt
+  priority %v
+  advert_int 1
+%v  virtual_ipaddress {
+    %v/%v
+  }
+  track_script {
+    chk_proxy
+  }
+}
+`
+)
+
+// PlanHAFiles returns the haproxy and keepalived configs or the kube-vip manifest of each master,
+// the files of the init items disabled by the node init profile are skipped as node init does.
+func PlanHAFiles(nodeConfigs []*pb.NodeDeployConfig, clusterConfig *pb.ClusterConfig) ([]*pb.DeployPlanFile, error) {
+	var masters []*pb.Node
+	for _, nodeConfig := range nodeConfigs {
+		if groupByRole(nodeConfig.GetRoles(), string(constant.MachineRoleMaster)) {
+			masters = append(masters, nodeConfig.GetNode())
+		}
+	}
+
+	profile := clusterConfig.GetNodeInitProfile()
+	var files []*pb.DeployPlanFile
+
+	switch clusterConfig.GetKubeAPIServerConnect().GetType() {
+	case "keepalived":
+		keepalived := clusterConfig.GetKubeAPIServerConnect().GetKeepalived()
+		if err := CheckHAParameter(keepalived); err != nil {
+			return nil, err
+		}
+
+		var masterIPs []string
+		for _, node := range masters {
+			masterIPs = append(masterIPs, node.GetIp())
+		}
+
+		for _, node := range masters {
+			if ItemEnabled(profile, Haproxy) {
+				files = append(files, &pb.DeployPlanFile{
+					Node:    node.GetName(),
+					Path:    haproxyConfigPath,
+					Content: haproxyConfig(masterIPs, keepalived),
+				})
+			}
+			if ItemEnabled(profile, Keepalived) {
+				files = append(files, &pb.DeployPlanFile{
+					Node:    node.GetName(),
+					Path:    keepalivedConfigPath,
+					Content: keepalivedConfig(keepalived, keepalivedPriority(node.GetName(), nodeConfigs)),
+				})
+			}
+		}
+
+	case "kubevip":
+		if !ItemEnabled(profile, KubeVIP) {
+			break
+		}
+		for _, node := range masters {
+			manifest, err := NewKubeVIPManifest(clusterConfig.GetKubeAPIServerConnect().GetKubeVIP(), node.GetIp())
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, &pb.DeployPlanFile{
+				Node:    node.GetName(),
+				Path:    kubeVIPManifestPath,
+				Content: manifest,
+			})
+		}
+	}
+
+	return files, nil
+}
+
+// haproxyConfig renders haproxy.cfg which balances kube-apiserver of the masters
+func haproxyConfig(masterIPs []string, config *pb.Keepalived) string {
+	haproxyPort, statsPort := uint32(DefaultHaproxyPort), uint32(DefaultHaproxyStatsPort)
+	if config.GetHaproxyPort() != 0 {
+		haproxyPort = config.GetHaproxyPort()
+	}
+	if config.GetHaproxyStatsPort() != 0 {
+		statsPort = config.GetHaproxyStatsPort()
+	}
+
+	var upstreams strings.Builder
+	for i, ip := range masterIPs {
+		fmt.Fprintf(&upstreams, "  server server%v %v:%v maxconn 2048 check fall 3 rise 2\n", i+1, ip, APIServerPort)
+	}
+
+	bindAddress, bindOptions := haproxyBindAddress(config), ""
+	if bindAddress == "::" {
+		bindOptions = " v4v6"
+	}
+
+	return fmt.Sprintf(haproxyConfigTemplate, statsPort, bindAddress, haproxyPort, bindOptions, upstreams.String())
+}
+
+// keepalivedConfig renders keepalived.conf of a master with the given priority
+func keepalivedConfig(config *pb.Keepalived, priority int) string {
+	haproxyPort := uint32(DefaultHaproxyPort)
+	if config.GetHaproxyPort() != 0 {
+		haproxyPort = config.GetHaproxyPort()
+	}
+	checkInterval := uint32(DefaultHealthCheckInterval)
+	if config.GetHealthCheckInterval() != 0 {
+		checkInterval = config.GetHealthCheckInterval()
+	}
+
+	vip := config.GetVip()
+	vipPrefix := 32
+	virtualRouterID := fmt.Sprint(config.GetVirtualRouterID())
+	if strings.Contains(vip, ":") {
+		vipPrefix = 128
+		// the last group of IPv6 address is hexadecimal and may be empty, e.g. fd00::
+		if config.GetVirtualRouterID() == 0 {
+			lastGroup, _ := strconv.ParseUint("0"+vip[strings.LastIndex(vip, ":")+1:], 16, 32)
+			virtualRouterID = fmt.Sprint(lastGroup%255 + 1)
+		}
+	} else if config.GetVirtualRouterID() == 0 {
+		virtualRouterID = vip[strings.LastIndex(vip, ".")+1:]
+	}
+
+	var authentication string
+	if config.GetAuthPassword() != "" {
+		authentication = fmt.Sprintf("  authentication {\n    auth_type PASS\n    auth_pass %v\n  }\n", config.GetAuthPassword())
+	}
+
+	return fmt.Sprintf(keepalivedConfigTemplate, haproxyPort, checkInterval, config.GetNetInterfaceName(),
+		virtualRouterID, priority, authentication, vip, vipPrefix)
+}
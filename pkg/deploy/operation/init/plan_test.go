@@ -0,0 +1,204 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestHaproxyConfig(t *testing.T) {
+	expected := `global
+  #user haproxy
+  #group haproxy
+  daemon
+  maxconn 4096
+defaults
+  mode    tcp
+  balance leastconn
+  timeout client      30s
+  timeout server      30s
+  timeout connect      3s
+  retries 3
+listen stats
+  bind 0.0.0.0:9000
+  mode http
+  stats enable
+  stats uri /
+frontend kubernetes
+  bind 0.0.0.0:4443
+  default_backend kube-apiserver
+backend kube-apiserver
+  server server1 192.168.1.1:6443 maxconn 2048 check fall 3 rise 2
+  server server2 192.168.1.2:6443 maxconn 2048 check fall 3 rise 2
+
+`
+	config := &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0", HaproxyStatsPort: 9000}
+	assert.Equal(t, expected, haproxyConfig([]string{"192.168.1.1", "192.168.1.2"}, config))
+
+	// both ip families are listened on for an IPv6 vip
+	config.Vip = "fd00::100"
+	assert.Contains(t, haproxyConfig([]string{"fd00::1"}, config), "  bind :::4443 v4v6\n")
+}
+
+func TestKeepalivedConfig(t *testing.T) {
+	expected := `vrrp_script chk_proxy {
+  script "nc -w 3 -z 127.0.0.1 4443"
+  interval 2
+  weight -100
+  fall 3
+  rise 2
+}
+
+vrrp_instance kubernetes_ha {
+  interface eth0
+  virtual_router_id 100
+  #nopreempt
+  priority 99
+  advert_int 1
+  authentication {
+    auth_type PASS
+    auth_pass secret
+  }
+  virtual_ipaddress {
+    192.168.1.100/32
+  }
+  track_script {
+    chk_proxy
+  }
+}
+`
+	config := &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0", AuthPassword: "secret"}
+	assert.Equal(t, expected, keepalivedConfig(config, 99))
+
+	// virtual router id of IPv6 vip is derived from the last group
+	config = &pb.Keepalived{Vip: "fd00::1a", NetInterfaceName: "eth0"}
+	assert.Contains(t, keepalivedConfig(config, 100), "virtual_router_id 27\n")
+	assert.Contains(t, keepalivedConfig(config, 100), "fd00::1a/128\n")
+	config.VirtualRouterID = 51
+	assert.Contains(t, keepalivedConfig(config, 100), "virtual_router_id 51\n")
+}
+
+func TestPlanHAFiles(t *testing.T) {
+	nodeConfigs := []*pb.NodeDeployConfig{
+		{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master"}},
+		{Node: &pb.Node{Name: "master2", Ip: "192.168.1.2"}, Roles: []string{"master"}},
+		{Node: &pb.Node{Name: "worker1", Ip: "192.168.1.3"}, Roles: []string{"worker"}},
+	}
+	clusterConfig := &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+			Type:       "keepalived",
+			Keepalived: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+		},
+	}
+
+	files, err := PlanHAFiles(nodeConfigs, clusterConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(files))
+	assert.Equal(t, "master2", files[3].Node)
+	assert.Equal(t, keepalivedConfigPath, files[3].Path)
+	assert.Contains(t, files[3].Content, "priority 99\n")
+
+	// keepalived is disabled by the node init profile
+	clusterConfig.NodeInitProfile = &pb.NodeInitProfile{Items: map[string]bool{string(Keepalived): false}}
+	files, err = PlanHAFiles(nodeConfigs, clusterConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(files))
+
+	clusterConfig.KubeAPIServerConnect = &pb.KubeAPIServerConnect{Type: "firstMasterIP"}
+	files, err = PlanHAFiles(nodeConfigs, clusterConfig)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+// haDaemonTestScript replaces the daemon script of the setup script, so the configs are written into the temporary dir
+const haDaemonTestScript = `HAPROXY_CONFIG_DIR=%[1]s/haproxy
+HAPROXY_CONFIG=${HAPROXY_CONFIG_DIR}/haproxy.cfg
+KEEPALIVED_CONFIG_DIR=%[1]s/keepalived
+KEEPALIVED_CONFIG=${KEEPALIVED_CONFIG_DIR}/keepalived.conf
+`
+
+// runHAScriptConfig runs the config action of the setup script with the given flags and returns the rendered config
+func runHAScriptConfig(t *testing.T, flags, app, config string) string {
+	dir, err := ioutil.TempDir("", "ha")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "init_deploy_haproxy_keepalived"), 0755))
+
+	for _, script := range []string{haproxyScript, HaLibFilePath} {
+		file, err := assets.Assets.Open(script)
+		assert.NoError(t, err)
+		content, err := ioutil.ReadAll(file)
+		file.Close()
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "init_deploy_haproxy_keepalived", filepath.Base(script)), content, 0644))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "init_deploy_haproxy_keepalived", "systemd.sh"),
+		[]byte(fmt.Sprintf(haDaemonTestScript, dir)), 0644))
+
+	script := filepath.Join(dir, "init_deploy_haproxy_keepalived", filepath.Base(haproxyScript))
+	output, err := exec.Command("bash", "-c", fmt.Sprintf("bash %v %v %v config", script, flags, app)).CombinedOutput()
+	assert.NoError(t, err, string(output))
+
+	return readRepoFile(t, dir, filepath.Join(app, config))
+}
+
+// TestHAConfigSameAsScript makes sure the previewed configs are the same as the ones rendered on nodes
+func TestHAConfigSameAsScript(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is required to run the setup script")
+	}
+
+	tests := []struct {
+		masterIPs []string
+		config    *pb.Keepalived
+	}{
+		{
+			masterIPs: []string{"192.168.1.1"},
+			config:    &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+		},
+		{
+			masterIPs: []string{"192.168.1.1", "192.168.1.2", "192.168.1.3"},
+			config: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0", HaproxyPort: 8443, HaproxyStatsPort: 9000,
+				VirtualRouterID: 51, AuthPassword: "secret", HealthCheckInterval: 5},
+		},
+		{
+			masterIPs: []string{"fd00::1", "fd00::2"},
+			config:    &pb.Keepalived{Vip: "fd00::1a", NetInterfaceName: "eth0"},
+		},
+	}
+
+	for _, test := range tests {
+		var upstreams []string
+		for _, ip := range test.masterIPs {
+			upstreams = append(upstreams, fmt.Sprintf("%v:%v", ip, APIServerPort))
+		}
+		flags := fmt.Sprintf("-u '%v' %v", strings.Join(upstreams, " "), haproxyArgs(test.config))
+		assert.Equal(t, haproxyConfig(test.masterIPs, test.config), runHAScriptConfig(t, flags, "haproxy", "haproxy.cfg"))
+
+		flags = fmt.Sprintf("-n '%v' -i %v %v", test.config.GetVip(), test.config.GetNetInterfaceName(), keepalivedArgs(test.config, 99))
+		assert.Equal(t, keepalivedConfig(test.config, 99), runHAScriptConfig(t, flags, "keepalived", "keepalived.conf"))
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	timeZoneRegexp    = regexp.MustCompile(`^[A-Za-z0-9_+\-]+(/[A-Za-z0-9_+\-]+)*$`)
+	ntpServerRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.:\-]*[A-Za-z0-9])?$`)
+	sysctlKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9_\-]+([./][A-Za-z0-9_\-]+)+$`)
+	sysctlValueRegexp = regexp.MustCompile(`^[A-Za-z0-9 _.,:/\-]+$`)
+)
+
+// ValidateNodeInitProfile checks the choices of node init profile, nil profile is valid and means the defaults
+func ValidateNodeInitProfile(profile *pb.NodeInitProfile) error {
+	if profile == nil {
+		return nil
+	}
+
+	if timeZone := profile.GetTimezone(); timeZone != "" && !timeZoneRegexp.MatchString(timeZone) {
+		return fmt.Errorf("invalid timezone: %q", timeZone)
+	}
+
+	for _, server := range profile.GetNtpServers() {
+		if !ntpServerRegexp.MatchString(server) {
+			return fmt.Errorf("invalid ntp server: %q", server)
+		}
+	}
+
+	for key, value := range profile.GetSysctl() {
+		if !sysctlKeyRegexp.MatchString(key) {
+			return fmt.Errorf("invalid sysctl key: %q", key)
+		}
+		if !sysctlValueRegexp.MatchString(value) {
+			return fmt.Errorf("invalid value of sysctl %v: %q", key, value)
+		}
+	}
+
+	switch consts.FirewallMode(profile.GetFirewallMode()) {
+	case "", consts.FirewallModeDisable, consts.FirewallModeOpenPorts:
+	default:
+		return fmt.Errorf("unsupported firewall mode: %q, should be %q or %q",
+			profile.GetFirewallMode(), consts.FirewallModeDisable, consts.FirewallModeOpenPorts)
+	}
+
+	switch consts.SELinuxMode(profile.GetSelinuxMode()) {
+	case "", consts.SELinuxModeDisabled, consts.SELinuxModePermissive, consts.SELinuxModeEnforcing:
+	default:
+		return fmt.Errorf("unsupported selinux mode: %q, should be %q, %q or %q",
+			profile.GetSelinuxMode(), consts.SELinuxModeDisabled, consts.SELinuxModePermissive, consts.SELinuxModeEnforcing)
+	}
+
+	switch consts.SwapPolicy(profile.GetSwapPolicy()) {
+	case "", consts.SwapPolicyDisable, consts.SwapPolicyKeep:
+	default:
+		return fmt.Errorf("unsupported swap policy: %q, should be %q or %q",
+			profile.GetSwapPolicy(), consts.SwapPolicyDisable, consts.SwapPolicyKeep)
+	}
+
+	switch consts.HostnamePolicy(profile.GetHostnamePolicy()) {
+	case "", consts.HostnamePolicyNodeName, consts.HostnamePolicyKeep:
+	default:
+		return fmt.Errorf("unsupported hostname policy: %q, should be %q or %q",
+			profile.GetHostnamePolicy(), consts.HostnamePolicyNodeName, consts.HostnamePolicyKeep)
+	}
+
+	// every item could be disabled, e.g. haproxy, keepalived, kube-vip and kubernetes tools are provisioned in advance
+	for item := range profile.GetItems() {
+		if NewInitOperations().CreateOperations(ItemEnum(item), nil) == nil {
+			return fmt.Errorf("unknown init item: %q", item)
+		}
+	}
+
+	return nil
+}
+
+// ItemEnabled returns whether the init item should run according to the node init profile,
+// items absent from the profile are enabled.
+func ItemEnabled(profile *pb.NodeInitProfile, item ItemEnum) bool {
+	switch item {
+	case Swap:
+		if consts.SwapPolicy(profile.GetSwapPolicy()) == consts.SwapPolicyKeep {
+			return false
+		}
+	case TimeSync:
+		if len(profile.GetNtpServers()) == 0 {
+			return false
+		}
+	}
+
+	enabled, ok := profile.GetItems()[string(item)]
+	return !ok || enabled
+}
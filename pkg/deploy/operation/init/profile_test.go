@@ -0,0 +1,176 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestValidateNodeInitProfile(t *testing.T) {
+	testCases := []struct {
+		profile *pb.NodeInitProfile
+		wantErr bool
+	}{
+		{
+			profile: nil,
+			wantErr: false,
+		},
+		{
+			profile: &pb.NodeInitProfile{
+				Timezone:       "America/New_York",
+				NtpServers:     []string{"ntp.aliyun.com", "10.0.0.1", "fd00::1"},
+				Sysctl:         map[string]string{"vm.max_map_count": "262144", "net.ipv4.tcp_rmem": "4096 87380 6291456"},
+				FirewallMode:   string(consts.FirewallModeOpenPorts),
+				SelinuxMode:    string(consts.SELinuxModeEnforcing),
+				SwapPolicy:     string(consts.SwapPolicyKeep),
+				HostnamePolicy: string(consts.HostnamePolicyKeep),
+				Items:          map[string]bool{string(HostAlias): false, string(KubeTool): true},
+			},
+			wantErr: false,
+		},
+		{
+			profile: &pb.NodeInitProfile{Timezone: "Asia/Shanghai; reboot"},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{NtpServers: []string{"-ntp.aliyun.com"}},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{Sysctl: map[string]string{"vm": "1"}},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{Sysctl: map[string]string{"vm.swappiness": "1' && reboot '"}},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{FirewallMode: "enable"},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{SelinuxMode: "strict"},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{SwapPolicy: "on"},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{HostnamePolicy: "ip"},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{Items: map[string]bool{"unknown": false}},
+			wantErr: true,
+		},
+		{
+			profile: &pb.NodeInitProfile{Items: map[string]bool{
+				string(Haproxy): false, string(Keepalived): false, string(KubeVIP): false, string(KubeTool): false,
+			}},
+			wantErr: false,
+		},
+	}
+
+	for _, cs := range testCases {
+		err := ValidateNodeInitProfile(cs.profile)
+		if cs.wantErr {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestItemEnabled(t *testing.T) {
+	assert.True(t, ItemEnabled(nil, Swap))
+	assert.False(t, ItemEnabled(nil, TimeSync))
+
+	profile := &pb.NodeInitProfile{
+		NtpServers: []string{"ntp.aliyun.com"},
+		SwapPolicy: string(consts.SwapPolicyKeep),
+		Items:      map[string]bool{string(HostAlias): false, string(Route): true},
+	}
+	assert.False(t, ItemEnabled(profile, Swap))
+	assert.True(t, ItemEnabled(profile, TimeSync))
+	assert.False(t, ItemEnabled(profile, HostAlias))
+	assert.True(t, ItemEnabled(profile, Route))
+	assert.True(t, ItemEnabled(profile, Network))
+}
+
+func TestRequiredPorts(t *testing.T) {
+	nodePorts := []string{"10250/tcp", "10256/tcp", "30000-32767/tcp", "30000-32767/udp"}
+	calicoPorts := []string{"179/tcp", "4789/udp"}
+
+	ports, protocols := requiredPorts([]string{"worker"}, &pb.ClusterConfig{})
+	assert.Equal(t, append(nodePorts, calicoPorts...), ports)
+	assert.Empty(t, protocols)
+
+	ports, protocols = requiredPorts([]string{"etcd", "master"}, &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "keepalived"},
+		NodePortRange:        &pb.NodePortRange{From: 31000, To: 31999},
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType:   string(consts.NetworkTypeCalico),
+			CalicoOptions: &pb.CalicoOptions{EncapsulationMode: consts.EncapsulationModeIpip},
+		},
+	})
+	assert.Equal(t, []string{"10250/tcp", "10256/tcp", "31000-31999/tcp", "31000-31999/udp",
+		"6443/tcp", "10251/tcp", "10252/tcp", "2379-2380/tcp", "4443/tcp", "179/tcp"}, ports)
+	assert.Equal(t, []int{vrrpProtocol, ipipProtocol}, protocols)
+
+	// the haproxy port set for keepalived is opened instead of the default one
+	ports, protocols = requiredPorts([]string{"master"}, &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "keepalived", Keepalived: &pb.Keepalived{HaproxyPort: 8443}},
+	})
+	assert.Equal(t, append(append(nodePorts, "6443/tcp", "10251/tcp", "10252/tcp", "8443/tcp"), calicoPorts...), ports)
+	assert.Equal(t, []int{vrrpProtocol}, protocols)
+
+	ports, protocols = requiredPorts([]string{"master"}, &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "kubevip", KubeVIP: &pb.KubeVIP{Mode: KubeVIPModeBGP}},
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType:    string(consts.NetworkTypeFlannel),
+			FlannelOptions: &pb.FlannelOptions{Backend: consts.FlannelBackendVxlan, VxlanPort: 8473},
+		},
+	})
+	assert.Equal(t, append(nodePorts, "6443/tcp", "10251/tcp", "10252/tcp", "179/tcp", "8473/udp"), ports)
+	assert.Empty(t, protocols)
+
+	ports, _ = requiredPorts([]string{"worker", "ingress"}, &pb.ClusterConfig{
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType:   string(consts.NetworkTypeCilium),
+			CiliumOptions: &pb.CiliumOptions{TunnelMode: consts.CiliumTunnelModeGeneve},
+		},
+	})
+	assert.Equal(t, append(nodePorts, "80/tcp", "443/tcp", "4240/tcp", "6081/udp"), ports)
+
+	ports, _ = requiredPorts([]string{"worker"}, &pb.ClusterConfig{
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType:    string(consts.NetworkTypeFlannel),
+			FlannelOptions: &pb.FlannelOptions{Backend: consts.FlannelBackendHostGW},
+		},
+	})
+	assert.Equal(t, nodePorts, ports)
+}
+
+func TestSysctlArgs(t *testing.T) {
+	assert.Empty(t, sysctlArgs(nil))
+	assert.Equal(t, []string{"'net.ipv4.tcp_rmem=4096 87380 6291456'", "'vm.max_map_count=262144'"},
+		sysctlArgs(map[string]string{"vm.max_map_count": "262144", "net.ipv4.tcp_rmem": "4096 87380 6291456"}))
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+var (
+	// proxy settings are passed to the init script in single quotes and written into config files in double quotes
+	proxyURLRegexp = regexp.MustCompile(`^[A-Za-z0-9._~:/?#@!&+,;=%\-\[\]]+$`)
+	noProxyRegexp  = regexp.MustCompile(`^[A-Za-z0-9.:/*\-\[\]]+$`)
+)
+
+// ValidateProxy checks the proxy settings of nodes, nil proxy is valid and means no proxy is used
+func ValidateProxy(proxy *pb.ProxyConfig) error {
+	if proxy == nil {
+		return nil
+	}
+
+	for _, proxyURL := range []string{proxy.GetHttpProxy(), proxy.GetHttpsProxy()} {
+		if proxyURL == "" {
+			continue
+		}
+		if !proxyURLRegexp.MatchString(proxyURL) {
+			return fmt.Errorf("invalid proxy: %q", proxyURL)
+		}
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy: %q, error: %v", proxyURL, err)
+		}
+		if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid proxy: %q, should be like http://host:port", proxyURL)
+		}
+	}
+
+	for _, address := range proxy.GetNoProxy() {
+		if !noProxyRegexp.MatchString(address) {
+			return fmt.Errorf("invalid no proxy address: %q", address)
+		}
+	}
+
+	return nil
+}
+
+// noProxyAddresses returns the addresses not proxied on nodes, which are the local addresses, node IPs,
+// control plane endpoint, service and pod subnets followed by the ones of the proxy settings.
+func noProxyAddresses(initAction *operation.NodeInitAction) []string {
+	addresses := []string{"localhost", "127.0.0.1", "::1"}
+
+	var masterNodes []*pb.Node
+	for _, nodeConfig := range initAction.NodesConfig {
+		addresses = append(addresses, nodeConfig.GetNode().GetIp())
+		if groupByRole(nodeConfig.GetRoles(), string(constant.MachineRoleMaster)) {
+			masterNodes = append(masterNodes, nodeConfig.GetNode())
+		}
+	}
+
+	if len(masterNodes) > 0 {
+		if endpoint, err := deploy.GetControlPlaneEndpoint(initAction.ClusterConfig, masterNodes); err == nil {
+			if host, _, err := net.SplitHostPort(endpoint); err == nil {
+				addresses = append(addresses, host)
+			}
+		}
+	}
+
+	serviceSubnets := deploy.GetServiceSubnets(initAction.ClusterConfig)
+	if len(serviceSubnets) == 0 {
+		serviceSubnets = []string{constant.DefaultServiceSubnet}
+	}
+	podSubnets := deploy.GetPodSubnets(initAction.ClusterConfig)
+	if len(podSubnets) == 0 {
+		podSubnets = []string{constant.DefaultPodSubnet}
+	}
+	addresses = append(addresses, serviceSubnets...)
+	addresses = append(addresses, podSubnets...)
+	addresses = append(addresses, initAction.ClusterConfig.GetProxy().GetNoProxy()...)
+
+	result := make([]string, 0, len(addresses))
+	added := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if address == "" || added[address] {
+			continue
+		}
+		added[address] = true
+		result = append(result, address)
+	}
+	return result
+}
+
+// proxySecrets returns the passwords in the proxy urls as they are written, which are passed to the kubetool script
+func proxySecrets(clusterConfig *pb.ClusterConfig) []string {
+	var secrets []string
+	for _, proxyURL := range []string{clusterConfig.GetProxy().GetHttpProxy(), clusterConfig.GetProxy().GetHttpsProxy()} {
+		scheme := strings.Index(proxyURL, "://")
+		if scheme < 0 {
+			continue
+		}
+		authority := proxyURL[scheme+len("://"):]
+		if slash := strings.Index(authority, "/"); slash >= 0 {
+			authority = authority[:slash]
+		}
+		at := strings.LastIndex(authority, "@")
+		if at < 0 {
+			continue
+		}
+		if colon := strings.Index(authority[:at], ":"); colon >= 0 && colon+1 < at {
+			secrets = append(secrets, authority[colon+1:at])
+		}
+	}
+	return secrets
+}
+
+// proxyArgs returns the proxy arguments of the kubetool script, empty if no proxy is used
+func proxyArgs(initAction *operation.NodeInitAction) string {
+	if !deploy.IsProxyUsed(initAction.ClusterConfig) {
+		return ""
+	}
+
+	proxy := initAction.ClusterConfig.GetProxy()
+	var args []string
+	if proxy.GetHttpProxy() != "" {
+		args = append(args, fmt.Sprintf("--http-proxy '%v'", proxy.GetHttpProxy()))
+	}
+	if proxy.GetHttpsProxy() != "" {
+		args = append(args, fmt.Sprintf("--https-proxy '%v'", proxy.GetHttpsProxy()))
+	}
+	args = append(args, fmt.Sprintf("--no-proxy '%v'", strings.Join(noProxyAddresses(initAction), ",")))
+	return strings.Join(args, " ")
+}
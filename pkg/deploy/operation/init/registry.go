@@ -0,0 +1,307 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// registry files are staged in the dir and installed by the kubetool script, which removes the dir at last
+	registryStagingDir = "/etc/kubernetes/registries"
+
+	dockerDaemonConfigPath = "/etc/docker/daemon.json"
+	dockerHubServer        = "docker.io"
+	dockerHubEndpoint      = "https://registry-1.docker.io"
+	dockerHubAuthServer    = "https://index.docker.io/v1/"
+	containerdCertsDir     = "/etc/containerd/certs.d"
+	registryCAFileName     = "ca.crt"
+)
+
+var (
+	registryServerRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.\-]*[A-Za-z0-9])?(:[0-9]{1,5})?$`)
+	registryUsernameRegexp = regexp.MustCompile(`^[A-Za-z0-9._@+\-]+$`)
+)
+
+// ValidateRegistries checks the registry settings of nodes for the container runtime
+func ValidateRegistries(registries []*pb.RegistryConfig, runtime consts.ContainerRuntime) error {
+	servers := make(map[string]bool, len(registries))
+	for _, registry := range registries {
+		server := registry.GetServer()
+		if !registryServerRegexp.MatchString(server) {
+			return fmt.Errorf("invalid registry server: %q", server)
+		}
+		if servers[server] {
+			return fmt.Errorf("registry %v is duplicated", server)
+		}
+		servers[server] = true
+
+		if (registry.GetUsername() == "") != (registry.GetPassword() == "") {
+			return fmt.Errorf("username and password of registry %v should be given together", server)
+		}
+		if registry.GetUsername() != "" && !registryUsernameRegexp.MatchString(registry.GetUsername()) {
+			return fmt.Errorf("invalid username of registry %v: %q", server, registry.GetUsername())
+		}
+		if strings.ContainsAny(registry.GetPassword(), "\n\r") {
+			return fmt.Errorf("password of registry %v can not contain line breaks", server)
+		}
+
+		if registry.GetCaBundle() != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(registry.GetCaBundle())) {
+			return fmt.Errorf("no valid PEM certificate found in ca bundle of registry %v", server)
+		}
+
+		for _, mirror := range registry.GetMirrors() {
+			parsed, err := url.Parse(mirror)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" ||
+				!proxyURLRegexp.MatchString(mirror) || strings.Contains(mirror, "@") {
+				return fmt.Errorf("invalid mirror of registry %v: %q, should be like https://host:port", server, mirror)
+			}
+		}
+		if len(registry.GetMirrors()) > 0 && server != dockerHubServer && runtime != consts.ContainerRuntimeContainerd {
+			return fmt.Errorf("mirrors of registry %v are not supported by %v, only mirrors of %v are supported", server, runtime, dockerHubServer)
+		}
+	}
+
+	return nil
+}
+
+// registryFiles returns the files staged for the kubetool script keyed by the path relative to the staging dir:
+// daemon.json merged with the existing one for docker, certs.d/<server>/ca.crt of registries with ca bundle,
+// certs.d/<server>/hosts.toml of registries for containerd, auth/<server> of registries with credentials and
+// kubelet-config.json with the credentials for docker, which is read by kubelet when it pulls images by docker.
+func registryFiles(clusterConfig *pb.ClusterConfig, existingDaemonConfig []byte) (map[string][]byte, error) {
+	registries := clusterConfig.GetRegistries()
+	runtime := deploy.GetContainerRuntime(clusterConfig)
+	files := make(map[string][]byte)
+
+	for _, registry := range registries {
+		server := registry.GetServer()
+		if registry.GetCaBundle() != "" {
+			files[path.Join("certs.d", server, registryCAFileName)] = []byte(registry.GetCaBundle())
+		}
+		if runtime == consts.ContainerRuntimeContainerd {
+			files[path.Join("certs.d", server, "hosts.toml")] = []byte(containerdHostsConfig(registry))
+		}
+		if registry.GetUsername() != "" {
+			files[path.Join("auth", server)] = []byte(registry.GetUsername() + ":" + registry.GetPassword())
+		}
+	}
+
+	if runtime == consts.ContainerRuntimeDocker {
+		daemonConfig, err := dockerDaemonConfig(existingDaemonConfig, registries)
+		if err != nil {
+			return nil, err
+		}
+		files["daemon.json"] = daemonConfig
+
+		if kubeletConfig := kubeletDockerConfig(registries); kubeletConfig != nil {
+			files["kubelet-config.json"] = kubeletConfig
+		}
+	}
+
+	return files, nil
+}
+
+// dockerDaemonConfig sets insecure registries and mirrors of docker hub into the existing docker daemon config,
+// the other settings are kept as they are.
+func dockerDaemonConfig(existing []byte, registries []*pb.RegistryConfig) ([]byte, error) {
+	daemonConfig := make(map[string]interface{})
+	if len(bytes.TrimSpace(existing)) > 0 {
+		if err := json.Unmarshal(existing, &daemonConfig); err != nil {
+			return nil, fmt.Errorf("failed to parse %v, error: %v", dockerDaemonConfigPath, err)
+		}
+	}
+
+	insecureRegistries := stringsOfDaemonConfig(daemonConfig, "insecure-registries")
+	mirrors := stringsOfDaemonConfig(daemonConfig, "registry-mirrors")
+	for _, registry := range registries {
+		if registry.GetInsecure() {
+			insecureRegistries = appendIfAbsent(insecureRegistries, registry.GetServer())
+		}
+		if registry.GetServer() == dockerHubServer {
+			for _, mirror := range registry.GetMirrors() {
+				mirrors = appendIfAbsent(mirrors, mirror)
+			}
+		}
+	}
+	if len(insecureRegistries) > 0 {
+		daemonConfig["insecure-registries"] = insecureRegistries
+	}
+	if len(mirrors) > 0 {
+		daemonConfig["registry-mirrors"] = mirrors
+	}
+
+	content, err := json.MarshalIndent(daemonConfig, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(content, '\n'), nil
+}
+
+// kubeletDockerConfig returns the docker config with credentials of registries, nil if there are no credentials.
+// Images are pulled by kubelet instead of docker daemon, so the credentials of docker login are not used.
+func kubeletDockerConfig(registries []*pb.RegistryConfig) []byte {
+	auths := make(map[string]map[string]string)
+	for _, registry := range registries {
+		if registry.GetUsername() == "" {
+			continue
+		}
+		server := registry.GetServer()
+		if server == dockerHubServer {
+			server = dockerHubAuthServer
+		}
+		auths[server] = map[string]string{
+			"auth": base64.StdEncoding.EncodeToString([]byte(registry.GetUsername() + ":" + registry.GetPassword())),
+		}
+	}
+	if len(auths) == 0 {
+		return nil
+	}
+
+	content, _ := json.MarshalIndent(map[string]interface{}{"auths": auths}, "", "  ")
+	return append(content, '\n')
+}
+
+func stringsOfDaemonConfig(daemonConfig map[string]interface{}, key string) []string {
+	values, _ := daemonConfig[key].([]interface{})
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := value.(string); ok {
+			result = append(result, str)
+		}
+	}
+	return result
+}
+
+func appendIfAbsent(list []string, item string) []string {
+	for _, existing := range list {
+		if existing == item {
+			return list
+		}
+	}
+	return append(list, item)
+}
+
+// containerdHostsConfig returns hosts.toml of the registry, mirrors are tried in order before the registry itself.
+// An insecure registry is accessed by https without verification and then by http.
+func containerdHostsConfig(registry *pb.RegistryConfig) string {
+	server := registry.GetServer()
+	endpoint := "https://" + server
+	if server == dockerHubServer {
+		endpoint = dockerHubEndpoint
+	}
+
+	var ca string
+	if registry.GetCaBundle() != "" {
+		ca = path.Join(containerdCertsDir, server, registryCAFileName)
+	}
+
+	hostConfig := func(host string) string {
+		config := fmt.Sprintf("[host.%q]\n  capabilities = [\"pull\", \"resolve\"]\n", host)
+		if ca != "" {
+			config += fmt.Sprintf("  ca = %q\n", ca)
+		}
+		if registry.GetInsecure() {
+			config += "  skip_verify = true\n"
+		}
+		return config
+	}
+
+	hosts := make([]string, 0, len(registry.GetMirrors())+1)
+	for _, mirror := range registry.GetMirrors() {
+		hosts = append(hosts, hostConfig(strings.TrimSuffix(mirror, "/")))
+	}
+
+	if registry.GetInsecure() {
+		hosts = append(hosts, hostConfig(endpoint))
+		endpoint = "http://" + server
+	}
+
+	config := fmt.Sprintf("server = %q\n", endpoint)
+	if ca != "" && !registry.GetInsecure() {
+		config += fmt.Sprintf("ca = %q\n", ca)
+	}
+	for _, host := range hosts {
+		config += "\n" + host
+	}
+	return config
+}
+
+// registrySecrets returns the passwords of registries and the encoded credentials written into containerd config
+func registrySecrets(clusterConfig *pb.ClusterConfig) []string {
+	var secrets []string
+	for _, registry := range clusterConfig.GetRegistries() {
+		if registry.GetPassword() == "" {
+			continue
+		}
+		secrets = append(secrets, registry.GetPassword(),
+			base64.StdEncoding.EncodeToString([]byte(registry.GetUsername()+":"+registry.GetPassword())))
+	}
+	return secrets
+}
+
+// stageRegistryFiles puts the registry files into the staging dir of the node, which is only accessible by root,
+// and returns the registry arguments of the kubetool script, empty if no registry is set.
+func stageRegistryFiles(m machine.IMachine, initAction *operation.NodeInitAction) (string, error) {
+	if len(initAction.ClusterConfig.GetRegistries()) == 0 {
+		return "", nil
+	}
+
+	var existingDaemonConfig []byte
+	if deploy.GetContainerRuntime(initAction.ClusterConfig) == consts.ContainerRuntimeDocker {
+		stdout, stderr, err := m.Run(fmt.Sprintf("test ! -f %[1]v || cat %[1]v", dockerDaemonConfigPath))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %v, error: %v, %s", dockerDaemonConfigPath, err, stderr)
+		}
+		existingDaemonConfig = stdout
+	}
+
+	files, err := registryFiles(initAction.ClusterConfig, existingDaemonConfig)
+	if err != nil {
+		return "", err
+	}
+
+	if _, stderr, err := m.Run(fmt.Sprintf("rm -rf %[1]v && mkdir -p -m 700 %[1]v", registryStagingDir)); err != nil {
+		return "", fmt.Errorf("failed to create %v, error: %v, %s", registryStagingDir, err, stderr)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := m.PutFile(bytes.NewReader(files[name]), path.Join(registryStagingDir, name)); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("--registry-dir %v", registryStagingDir), nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package init
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newTestCABundle(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "registry-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestValidateRegistries(t *testing.T) {
+	caBundle := newTestCABundle(t)
+	testCases := []struct {
+		registries []*pb.RegistryConfig
+		runtime    consts.ContainerRuntime
+		wantErr    bool
+	}{
+		{
+			registries: nil,
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    false,
+		},
+		{
+			registries: []*pb.RegistryConfig{
+				{Server: "registry.example.com:5000", Username: "admin", Password: "p@ss'word", CaBundle: caBundle},
+				{Server: "docker.io", Mirrors: []string{"https://mirror.example.com"}},
+			},
+			runtime: consts.ContainerRuntimeDocker,
+			wantErr: false,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com", Insecure: true, Mirrors: []string{"http://10.0.0.1:5000"}}},
+			runtime:    consts.ContainerRuntimeContainerd,
+			wantErr:    false,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com", Mirrors: []string{"http://10.0.0.1:5000"}}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "https://registry.example.com"}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com"}, {Server: "registry.example.com"}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com", Username: "admin"}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com", Username: "admin:root", Password: "password"}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "registry.example.com", CaBundle: "not a certificate"}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+		{
+			registries: []*pb.RegistryConfig{{Server: "docker.io", Mirrors: []string{"mirror.example.com"}}},
+			runtime:    consts.ContainerRuntimeDocker,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateRegistries(tc.registries, tc.runtime)
+		assert.Equal(t, tc.wantErr, err != nil, "registries: %v, error: %v", tc.registries, err)
+	}
+}
+
+func TestDockerDaemonConfig(t *testing.T) {
+	registries := []*pb.RegistryConfig{
+		{Server: "registry.example.com:5000", Insecure: true},
+		{Server: "docker.io", Mirrors: []string{"https://mirror.example.com", "https://mirror2.example.com"}},
+	}
+
+	content, err := dockerDaemonConfig(nil, registries)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"insecure-registries": ["registry.example.com:5000"],
+		"registry-mirrors": ["https://mirror.example.com", "https://mirror2.example.com"]}`, string(content))
+
+	// the existing settings are kept
+	content, err = dockerDaemonConfig([]byte(`{"log-driver": "json-file", "registry-mirrors": ["https://mirror.example.com"]}`), registries)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"log-driver": "json-file", "insecure-registries": ["registry.example.com:5000"],
+		"registry-mirrors": ["https://mirror.example.com", "https://mirror2.example.com"]}`, string(content))
+
+	_, err = dockerDaemonConfig([]byte(`{"log-driver": `), registries)
+	assert.NotNil(t, err)
+}
+
+func TestContainerdHostsConfig(t *testing.T) {
+	assert.Equal(t, `server = "https://registry-1.docker.io"
+
+[host."https://mirror.example.com"]
+  capabilities = ["pull", "resolve"]
+`, containerdHostsConfig(&pb.RegistryConfig{Server: "docker.io", Mirrors: []string{"https://mirror.example.com/"}}))
+
+	assert.Equal(t, `server = "https://registry.example.com:5000"
+ca = "/etc/containerd/certs.d/registry.example.com:5000/ca.crt"
+`, containerdHostsConfig(&pb.RegistryConfig{Server: "registry.example.com:5000", CaBundle: "ca"}))
+
+	assert.Equal(t, `server = "http://registry.example.com"
+
+[host."https://registry.example.com"]
+  capabilities = ["pull", "resolve"]
+  skip_verify = true
+`, containerdHostsConfig(&pb.RegistryConfig{Server: "registry.example.com", Insecure: true}))
+}
+
+func TestRegistryFiles(t *testing.T) {
+	registries := []*pb.RegistryConfig{
+		{Server: "registry.example.com:5000", Username: "admin", Password: "password", CaBundle: "ca"},
+		{Server: "docker.io", Mirrors: []string{"https://mirror.example.com"}},
+	}
+
+	files, err := registryFiles(&pb.ClusterConfig{Registries: registries}, []byte(`{"log-driver": "json-file"}`))
+	assert.Nil(t, err)
+	assert.Len(t, files, 4)
+	assert.Equal(t, "ca", string(files["certs.d/registry.example.com:5000/ca.crt"]))
+	assert.Equal(t, "admin:password", string(files["auth/registry.example.com:5000"]))
+	assert.Contains(t, string(files["daemon.json"]), `"log-driver": "json-file"`)
+	assert.Contains(t, files, "kubelet-config.json")
+
+	files, err = registryFiles(&pb.ClusterConfig{Registries: registries, ContainerRuntime: string(consts.ContainerRuntimeContainerd)}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, files, 4)
+	assert.Contains(t, files, "certs.d/registry.example.com:5000/hosts.toml")
+	assert.Contains(t, files, "certs.d/docker.io/hosts.toml")
+	assert.NotContains(t, files, "daemon.json")
+	assert.NotContains(t, files, "kubelet-config.json")
+}
+
+func TestKubeletDockerConfig(t *testing.T) {
+	assert.Nil(t, kubeletDockerConfig([]*pb.RegistryConfig{{Server: "registry.example.com"}}))
+
+	expected := `{
+  "auths": {
+    "https://index.docker.io/v1/": {
+      "auth": "dXNlcjpzZWNyZXQ="
+    },
+    "registry.example.com": {
+      "auth": "YWRtaW46cGFzc3dvcmQ="
+    }
+  }
+}
+`
+	assert.Equal(t, expected, string(kubeletDockerConfig([]*pb.RegistryConfig{
+		{Server: "registry.example.com", Username: "admin", Password: "password"},
+		{Server: "docker.io", Username: "user", Password: "secret"},
+	})))
+}
+
+func TestRegistrySecrets(t *testing.T) {
+	clusterConfig := &pb.ClusterConfig{Registries: []*pb.RegistryConfig{
+		{Server: "registry.example.com", Username: "admin", Password: "password"},
+		{Server: "docker.io"},
+	}}
+	assert.Equal(t, []string{"password", "YWRtaW46cGFzc3dvcmQ="}, registrySecrets(clusterConfig))
+}
@@ -0,0 +1,192 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	AuditLevelNone            = "None"
+	AuditLevelMetadata        = "Metadata"
+	AuditLevelRequest         = "Request"
+	AuditLevelRequestResponse = "RequestResponse"
+
+	auditPolicyAPIVersion = "audit.k8s.io/v1"
+	auditPolicyKind       = "Policy"
+
+	auditPolicyDir  = consts.DefaultK8sConfigDir + "/audit"
+	auditPolicyPath = auditPolicyDir + "/policy.yaml"
+	auditLogDir     = "/var/log/kubernetes/audit"
+	auditLogPath    = auditLogDir + "/audit.log"
+
+	defaultAuditLogMaxAge    = 30
+	defaultAuditLogMaxBackup = 10
+	defaultAuditLogMaxSize   = 100
+
+	// health checks and kube-proxy watches are left out, secrets, configmaps and token reviews are only logged
+	// at Metadata level, so their content is not written to the audit log.
+	auditPolicyTemplate = `apiVersion: audit.k8s.io/v1
+kind: Policy
+omitStages:
+- RequestReceived
+rules:
+- level: None
+  nonResourceURLs:
+  - /healthz*
+  - /livez*
+  - /readyz*
+  - /version
+- level: None
+  users:
+  - system:kube-proxy
+  verbs:
+  - watch
+%v- level: %v
+`
+	auditPolicySensitiveRule = `- level: Metadata
+  resources:
+  - group: ""
+    resources:
+    - secrets
+    - configmaps
+  - group: authentication.k8s.io
+    resources:
+    - tokenreviews
+`
+)
+
+// IsAuditEnabled tells whether kube-apiserver writes audit logs
+func IsAuditEnabled(clusterConfig *pb.ClusterConfig) bool {
+	audit := clusterConfig.GetAudit()
+	return audit.GetLevel() != "" || strings.TrimSpace(audit.GetPolicy()) != ""
+}
+
+// ValidateAudit checks the audit level or the custom audit policy
+func ValidateAudit(audit *pb.AuditConfig) error {
+	if audit == nil {
+		return nil
+	}
+
+	switch audit.GetLevel() {
+	case "", AuditLevelNone, AuditLevelMetadata, AuditLevelRequest, AuditLevelRequestResponse:
+	default:
+		return fmt.Errorf("invalid audit level: %v, should be one of %v, %v, %v and %v", audit.GetLevel(),
+			AuditLevelNone, AuditLevelMetadata, AuditLevelRequest, AuditLevelRequestResponse)
+	}
+
+	_, err := auditPolicy(audit)
+	return err
+}
+
+// auditPolicy returns the custom audit policy if it's given, otherwise the built-in policy of the audit level
+func auditPolicy(audit *pb.AuditConfig) (string, error) {
+	data := strings.TrimSpace(audit.GetPolicy())
+	if data == "" {
+		var sensitiveRule string
+		if audit.GetLevel() == AuditLevelRequest || audit.GetLevel() == AuditLevelRequestResponse {
+			sensitiveRule = auditPolicySensitiveRule
+		}
+		return fmt.Sprintf(auditPolicyTemplate, sensitiveRule, audit.GetLevel()), nil
+	}
+
+	policy := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(data), &policy); err != nil {
+		return "", fmt.Errorf("invalid audit policy, error: %v", err)
+	}
+
+	if apiVersion, ok := policy["apiVersion"]; ok && apiVersion != auditPolicyAPIVersion {
+		return "", fmt.Errorf("invalid apiVersion of audit policy: %v, only %v is supported", apiVersion, auditPolicyAPIVersion)
+	}
+	if kind, ok := policy["kind"]; ok && kind != auditPolicyKind {
+		return "", fmt.Errorf("invalid kind of audit policy: %v, %v is expected", kind, auditPolicyKind)
+	}
+	if rules, ok := policy["rules"].([]interface{}); !ok || len(rules) == 0 {
+		return "", fmt.Errorf("invalid audit policy: no rules given")
+	}
+
+	policy["apiVersion"] = auditPolicyAPIVersion
+	policy["kind"] = auditPolicyKind
+
+	policyData, err := yaml.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+	return string(policyData), nil
+}
+
+// auditArgs returns the kube-apiserver arguments of audit policy and log rotation
+func auditArgs(audit *pb.AuditConfig) map[string]string {
+	maxAge, maxBackup, maxSize := audit.GetMaxAge(), audit.GetMaxBackup(), audit.GetMaxSize()
+	if maxAge == 0 {
+		maxAge = defaultAuditLogMaxAge
+	}
+	if maxBackup == 0 {
+		maxBackup = defaultAuditLogMaxBackup
+	}
+	if maxSize == 0 {
+		maxSize = defaultAuditLogMaxSize
+	}
+
+	return map[string]string{
+		"audit-policy-file":   auditPolicyPath,
+		"audit-log-path":      auditLogPath,
+		"audit-log-maxage":    strconv.Itoa(int(maxAge)),
+		"audit-log-maxbackup": strconv.Itoa(int(maxBackup)),
+		"audit-log-maxsize":   strconv.Itoa(int(maxSize)),
+	}
+}
+
+// auditVolumes returns the kube-apiserver volumes of audit policy and logs
+func auditVolumes() []v1beta2.HostPathMount {
+	return []v1beta2.HostPathMount{
+		{
+			Name:      "audit-policy",
+			HostPath:  auditPolicyDir,
+			MountPath: auditPolicyDir,
+			ReadOnly:  true,
+			PathType:  corev1.HostPathDirectoryOrCreate,
+		},
+		{
+			Name:      "audit-log",
+			HostPath:  auditLogDir,
+			MountPath: auditLogDir,
+			PathType:  corev1.HostPathDirectoryOrCreate,
+		},
+	}
+}
+
+// putAuditPolicy puts the audit policy to the master, audit logs are rotated by kube-apiserver
+func putAuditPolicy(m machine.IMachine, clusterConfig *pb.ClusterConfig) error {
+	policy, err := auditPolicy(clusterConfig.GetAudit())
+	if err != nil {
+		return err
+	}
+
+	if err := m.PutFile(strings.NewReader(policy), auditPolicyPath); err != nil {
+		return fmt.Errorf("failed to put audit policy to %v:%v, error: %v", m.GetName(), auditPolicyPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestAuditPolicy(t *testing.T) {
+	policy, err := auditPolicy(&pb.AuditConfig{Level: AuditLevelMetadata})
+	assert.NoError(t, err)
+	assert.Contains(t, policy, "kind: Policy\n")
+	assert.Contains(t, policy, "- level: Metadata\n")
+	assert.NotContains(t, policy, "- secrets")
+
+	// content of secrets is never logged
+	policy, err = auditPolicy(&pb.AuditConfig{Level: AuditLevelRequestResponse})
+	assert.NoError(t, err)
+	assert.Contains(t, policy, "- secrets")
+	assert.Contains(t, policy, "- level: RequestResponse\n")
+
+	// custom policy takes precedence over level
+	policy, err = auditPolicy(&pb.AuditConfig{
+		Level:  AuditLevelRequest,
+		Policy: "rules:\n- level: Metadata\n",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, policy, "apiVersion: audit.k8s.io/v1\n")
+	assert.Contains(t, policy, "kind: Policy\n")
+	assert.NotContains(t, policy, "level: Request\n")
+}
+
+func TestValidateAudit(t *testing.T) {
+	assert.NoError(t, ValidateAudit(nil))
+	assert.NoError(t, ValidateAudit(&pb.AuditConfig{Level: AuditLevelNone}))
+	assert.NoError(t, ValidateAudit(&pb.AuditConfig{Policy: `{"kind": "Policy", "rules": [{"level": "Metadata"}]}`}))
+	assert.Error(t, ValidateAudit(&pb.AuditConfig{Level: "All"}))
+	assert.Error(t, ValidateAudit(&pb.AuditConfig{Policy: "- level: Metadata"}))
+	assert.Error(t, ValidateAudit(&pb.AuditConfig{Policy: "apiVersion: audit.k8s.io/v1beta1\nrules:\n- level: Metadata\n"}))
+	assert.Error(t, ValidateAudit(&pb.AuditConfig{Policy: "kind: Policy\nrules: []\n"}))
+}
+
+func TestAuditArgs(t *testing.T) {
+	args := auditArgs(&pb.AuditConfig{Level: AuditLevelMetadata})
+	assert.Equal(t, auditPolicyPath, args["audit-policy-file"])
+	assert.Equal(t, auditLogPath, args["audit-log-path"])
+	assert.Equal(t, "30", args["audit-log-maxage"])
+	assert.Equal(t, "10", args["audit-log-maxbackup"])
+	assert.Equal(t, "100", args["audit-log-maxsize"])
+
+	args = auditArgs(&pb.AuditConfig{Level: AuditLevelMetadata, MaxAge: 7, MaxBackup: 3, MaxSize: 50})
+	assert.Equal(t, "7", args["audit-log-maxage"])
+	assert.Equal(t, "3", args["audit-log-maxbackup"])
+	assert.Equal(t, "50", args["audit-log-maxsize"])
+}
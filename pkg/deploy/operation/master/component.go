@@ -0,0 +1,186 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	kubeletConfigAPIVersion = "kubelet.config.k8s.io/v1beta1"
+	kubeletConfigKind       = "KubeletConfiguration"
+
+	KubeProxyModeIPTables = string(consts.KubeProxyModeIPTables)
+	KubeProxyModeIPVS     = string(consts.KubeProxyModeIPVS)
+)
+
+var (
+	componentArgNameRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+	// arguments generated from the cluster config, overriding them breaks what kpaas sets up
+	managedAPIServerArgs = []string{
+		"advertise-address", "audit-log-maxage", "audit-log-maxbackup", "audit-log-maxsize", "audit-log-path",
+		"audit-policy-file", "encryption-provider-config", "etcd-cafile", "etcd-certfile", "etcd-keyfile",
+		"etcd-servers", "service-cluster-ip-range", "service-node-port-range",
+	}
+	managedControllerManagerArgs = []string{"allocate-node-cidrs", "cluster-cidr", "service-cluster-ip-range"}
+	managedSchedulerArgs         = []string{"kubeconfig"}
+
+	// fields set by the kubelet flags of node init, kubelet flags take precedence over the config file
+	managedKubeletConfigFields = []string{
+		"authentication", "authorization", "cgroupDriver", "clusterDNS", "clusterDomain",
+		"failSwapOn", "featureGates", "rotateCertificates", "staticPodPath",
+	}
+)
+
+// ValidateComponentConfig checks the arguments and configurations passed through to kubernetes components
+func ValidateComponentConfig(clusterConfig *pb.ClusterConfig) error {
+	componentConfig := clusterConfig.GetComponentConfig()
+	if componentConfig == nil {
+		return nil
+	}
+
+	if err := validateExtraArgs("apiserver", componentConfig.GetApiServerExtraArgs(), managedAPIServerArgs); err != nil {
+		return err
+	}
+	if err := validateExtraArgs("controller-manager", componentConfig.GetControllerManagerExtraArgs(),
+		managedControllerManagerArgs); err != nil {
+		return err
+	}
+	if err := validateExtraArgs("scheduler", componentConfig.GetSchedulerExtraArgs(), managedSchedulerArgs); err != nil {
+		return err
+	}
+
+	if _, err := kubeletConfig(clusterConfig); err != nil {
+		return err
+	}
+
+	_, err := kubeProxyConfig(clusterConfig)
+	return err
+}
+
+func validateExtraArgs(component string, args map[string]string, managedArgs []string) error {
+	for name, value := range args {
+		if !componentArgNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid %v argument: %q, argument should be given without the leading \"--\"", component, name)
+		}
+		if containsString(managedArgs, name) {
+			return fmt.Errorf("%v argument %q is managed by kpaas and can not be set", component, name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("invalid value of %v argument %q: line break is not allowed", component, name)
+		}
+	}
+	return nil
+}
+
+// mergeExtraArgs returns the arguments generated by kpaas together with the user's extra arguments
+func mergeExtraArgs(managedArgs, extraArgs map[string]string) map[string]string {
+	if len(managedArgs) == 0 && len(extraArgs) == 0 {
+		return nil
+	}
+
+	args := make(map[string]string, len(managedArgs)+len(extraArgs))
+	for name, value := range extraArgs {
+		args[name] = value
+	}
+	// managed arguments are rejected by validation, they still win if it's bypassed
+	for name, value := range managedArgs {
+		args[name] = value
+	}
+	return args
+}
+
+// kubeletConfig returns the KubeletConfiguration document appended to the kubeadm init config, it's empty if not given.
+// kubeadm init uploads it to the cluster and kubeadm join writes it to the joining nodes.
+func kubeletConfig(clusterConfig *pb.ClusterConfig) (string, error) {
+	data := strings.TrimSpace(clusterConfig.GetComponentConfig().GetKubeletConfiguration())
+	if data == "" {
+		return "", nil
+	}
+
+	config := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return "", fmt.Errorf("invalid kubelet configuration, error: %v", err)
+	}
+
+	if apiVersion, ok := config["apiVersion"]; ok && apiVersion != kubeletConfigAPIVersion {
+		return "", fmt.Errorf("invalid apiVersion of kubelet configuration: %v, only %v is supported",
+			apiVersion, kubeletConfigAPIVersion)
+	}
+	if kind, ok := config["kind"]; ok && kind != kubeletConfigKind {
+		return "", fmt.Errorf("invalid kind of kubelet configuration: %v, %v is expected", kind, kubeletConfigKind)
+	}
+
+	var managedFields []string
+	for _, field := range managedKubeletConfigFields {
+		if _, ok := config[field]; ok {
+			managedFields = append(managedFields, field)
+		}
+	}
+	if len(managedFields) > 0 {
+		sort.Strings(managedFields)
+		return "", fmt.Errorf("kubelet configuration fields %v are managed by kpaas and can not be set", managedFields)
+	}
+
+	config["apiVersion"] = kubeletConfigAPIVersion
+	config["kind"] = kubeletConfigKind
+
+	configData, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(configData), nil
+}
+
+// kubeProxyConfig returns the KubeProxyConfiguration document appended to the kubeadm init config,
+// it's empty if the mode is left to kubeadm.
+func kubeProxyConfig(clusterConfig *pb.ClusterConfig) (string, error) {
+	// ipvs modules and tools are prepared by node init if the mode is ipvs
+	mode := string(deploy.GetKubeProxyMode(clusterConfig))
+	switch mode {
+	case "":
+		return "", nil
+	case KubeProxyModeIPTables:
+		if deploy.IsDualStack(clusterConfig) {
+			return "", fmt.Errorf("kube-proxy mode %v does not support dual-stack, use %v instead",
+				KubeProxyModeIPTables, KubeProxyModeIPVS)
+		}
+	case KubeProxyModeIPVS:
+	default:
+		return "", fmt.Errorf("invalid kube-proxy mode: %v, only %v and %v are supported",
+			mode, KubeProxyModeIPTables, KubeProxyModeIPVS)
+	}
+
+	return fmt.Sprintf(kubeProxyConfigTemplate, mode), nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
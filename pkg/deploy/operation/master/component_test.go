@@ -0,0 +1,162 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestValidateComponentConfig(t *testing.T) {
+	dualStackSubnets := []string{"10.120.0.0/16", "fd00:120::/64"}
+
+	tests := []struct {
+		name          string
+		clusterConfig *pb.ClusterConfig
+		wantErr       bool
+	}{
+		{
+			name:          "empty",
+			clusterConfig: &pb.ClusterConfig{},
+		},
+		{
+			name: "valid",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				ApiServerExtraArgs:   map[string]string{"default-watch-cache-size": "200"},
+				KubeletConfiguration: `{"apiVersion": "kubelet.config.k8s.io/v1beta1", "maxPods": 200}`,
+				KubeProxyMode:        KubeProxyModeIPTables,
+			}},
+		},
+		{
+			name: "argument with leading dashes",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				SchedulerExtraArgs: map[string]string{"--v": "2"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "managed argument",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				ApiServerExtraArgs: map[string]string{"service-node-port-range": "1-65535"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "argument value with line break",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				ControllerManagerExtraArgs: map[string]string{"v": "2\n"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "kubelet configuration not a mapping",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				KubeletConfiguration: "- maxPods",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "kubelet configuration of another kind",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				KubeletConfiguration: "kind: KubeProxyConfiguration",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "managed kubelet configuration field",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				KubeletConfiguration: "cgroupDriver: systemd",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid kube-proxy mode",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{
+				KubeProxyMode: "userspace",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "iptables with dual-stack",
+			clusterConfig: &pb.ClusterConfig{
+				PodSubnets:      dualStackSubnets,
+				ComponentConfig: &pb.ComponentConfig{KubeProxyMode: KubeProxyModeIPTables},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateComponentConfig(tt.clusterConfig)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKubeProxyConfig(t *testing.T) {
+	dualStackSubnets := []string{"10.120.0.0/16", "fd00:120::/64"}
+
+	tests := []struct {
+		name          string
+		clusterConfig *pb.ClusterConfig
+		wantMode      consts.KubeProxyMode
+	}{
+		{
+			name:          "left to kubeadm",
+			clusterConfig: &pb.ClusterConfig{},
+		},
+		{
+			name:          "ipvs for dual-stack",
+			clusterConfig: &pb.ClusterConfig{PodSubnets: dualStackSubnets},
+			wantMode:      consts.KubeProxyModeIPVS,
+		},
+		{
+			name:          "ipvs",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{KubeProxyMode: KubeProxyModeIPVS}},
+			wantMode:      consts.KubeProxyModeIPVS,
+		},
+		{
+			name:          "iptables",
+			clusterConfig: &pb.ClusterConfig{ComponentConfig: &pb.ComponentConfig{KubeProxyMode: KubeProxyModeIPTables}},
+			wantMode:      consts.KubeProxyModeIPTables,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// node init prepares ipvs by the same mode as the one in kube-proxy configuration
+			assert.Equal(t, tt.wantMode, deploy.GetKubeProxyMode(tt.clusterConfig))
+
+			config, err := kubeProxyConfig(tt.clusterConfig)
+			assert.NoError(t, err)
+			if tt.wantMode == "" {
+				assert.Empty(t, config)
+			} else {
+				assert.Contains(t, config, fmt.Sprintf("mode: %v\n", tt.wantMode))
+			}
+		})
+	}
+}
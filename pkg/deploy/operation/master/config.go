@@ -17,6 +17,7 @@ package master
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,14 +26,67 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
 const (
 	Token = "4a996f.8f1da0db96f8e50e"
+
+	// kube-vip static pod manifest is put into manifests dir before kubeadm runs
+	kubeVIPPreflightError = "DirAvailable--etc-kubernetes-manifests"
+
+	// swap is kept on nodes and kubelet runs with --fail-swap-on=false
+	swapPreflightError = "Swap"
+
+	kubeProxyConfigTemplate = `apiVersion: kubeproxy.config.k8s.io/v1alpha1
+kind: KubeProxyConfiguration
+mode: %v
+`
 )
 
+// kubeadmCommand returns the kubeadm command, the proxy settings written by node init are loaded if proxy is used
+func kubeadmCommand(clusterConfig *pb.ClusterConfig) string {
+	if deploy.IsProxyUsed(clusterConfig) {
+		return fmt.Sprintf(". %v && kubeadm", consts.DefaultProxyEnvFile)
+	}
+	return "kubeadm"
+}
+
+// kubeadmPreflightArgs returns the extra preflight arguments for kubeadm init and join
+func kubeadmPreflightArgs(clusterConfig *pb.ClusterConfig) []string {
+	var ignoredErrors []string
+	if clusterConfig.GetKubeAPIServerConnect().GetType() == "kubevip" {
+		ignoredErrors = append(ignoredErrors, kubeVIPPreflightError)
+	}
+	if deploy.IsSwapKept(clusterConfig) {
+		ignoredErrors = append(ignoredErrors, swapPreflightError)
+	}
+
+	if len(ignoredErrors) == 0 {
+		return nil
+	}
+	return []string{"--ignore-preflight-errors", strings.Join(ignoredErrors, ",")}
+}
+
+// kubeadmCRISocketArgs returns the CRI socket arguments for kubeadm join, kubeadm detects docker by default
+func kubeadmCRISocketArgs(clusterConfig *pb.ClusterConfig) []string {
+	if deploy.GetContainerRuntime(clusterConfig) == consts.ContainerRuntimeContainerd {
+		return []string{"--cri-socket", consts.ContainerdCRISocket}
+	}
+	return nil
+}
+
+// kubeadmAdvertiseArgs returns the apiserver advertise address arguments for kubeadm join of a master,
+// kubeadm detects the address from the IPv4 default route, so it's set explicitly for IPv6 node.
+func kubeadmAdvertiseArgs(node *pb.Node) []string {
+	if deploy.IsIPv6(node.GetIp()) {
+		return []string{"--apiserver-advertise-address", node.GetIp()}
+	}
+	return nil
+}
+
 func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 	var (
 		err           error
@@ -59,6 +113,14 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 
 	initConfig.CertificateKey = certKey
 
+	if deploy.IsIPv6(op.MasterNodes[0].GetIp()) {
+		initConfig.LocalAPIEndpoint.AdvertiseAddress = op.MasterNodes[0].GetIp()
+	}
+
+	if deploy.GetContainerRuntime(op.ClusterConfig) == consts.ContainerRuntimeContainerd {
+		initConfig.NodeRegistration.CRISocket = consts.ContainerdCRISocket
+	}
+
 	clusterConfig.TypeMeta = metav1.TypeMeta{
 		Kind:       "ClusterConfiguration",
 		APIVersion: "kubeadm.k8s.io/v1beta2",
@@ -66,21 +128,70 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 
 	//clusterConfig.KubernetesVersion = op.ClusterConfig.KubernetesVersion
 	clusterConfig.KubernetesVersion = constant.DefaultKubeVersion
-	//clusterConfig.ImageRepository = op.ClusterConfig.ImageRepository
-	clusterConfig.ImageRepository = constant.DefaultImageRepository
+	clusterConfig.ImageRepository = deploy.GetImageRepository(op.ClusterConfig)
 
 	clusterConfig.ControlPlaneEndpoint, err = deploy.GetControlPlaneEndpoint(op.ClusterConfig, op.MasterNodes)
 	if err != nil {
 		return "", fmt.Errorf("failed to get control plane endpoint addr, error: %v", err)
 	}
 
+	podSubnets := deploy.GetPodSubnets(op.ClusterConfig)
+	serviceSubnets := deploy.GetServiceSubnets(op.ClusterConfig)
+	if err := deploy.ValidateSubnets(podSubnets); err != nil {
+		return "", fmt.Errorf("invalid pod subnets, error: %v", err)
+	}
+	if err := deploy.ValidateSubnets(serviceSubnets); err != nil {
+		return "", fmt.Errorf("invalid service subnets, error: %v", err)
+	}
+
+	// kubeadm takes comma separated subnets of both ip families for dual-stack
 	clusterConfig.Networking = v1beta2.Networking{
-		ServiceSubnet: op.ClusterConfig.ServiceSubnet,
-		PodSubnet:     op.ClusterConfig.PodSubnet,
+		ServiceSubnet: strings.Join(serviceSubnets, ","),
+		PodSubnet:     strings.Join(podSubnets, ","),
+	}
+
+	dualStack := deploy.IsDualStack(op.ClusterConfig)
+	if dualStack {
+		clusterConfig.FeatureGates = map[string]bool{consts.IPv6DualStackFeatureGate: true}
 	}
 
 	clusterConfig.Etcd.External = getExternalEtcd(op.EtcdNodes)
 
+	// the same NodePort range is opened by node init if the firewall is kept
+	nodePortFrom, nodePortTo := deploy.GetNodePortRange(op.ClusterConfig)
+	apiServerArgs := map[string]string{
+		"service-node-port-range": fmt.Sprintf("%d-%d", nodePortFrom, nodePortTo),
+	}
+
+	// audit policy and encryption config are put to each master before kubeadm runs
+	if IsAuditEnabled(op.ClusterConfig) {
+		for name, value := range auditArgs(op.ClusterConfig.GetAudit()) {
+			apiServerArgs[name] = value
+		}
+		clusterConfig.APIServer.ExtraVolumes = append(clusterConfig.APIServer.ExtraVolumes, auditVolumes()...)
+	}
+	if IsEncryptionEnabled(op.ClusterConfig) {
+		for name, value := range encryptionArgs() {
+			apiServerArgs[name] = value
+		}
+		clusterConfig.APIServer.ExtraVolumes = append(clusterConfig.APIServer.ExtraVolumes, encryptionVolumes()...)
+	}
+
+	componentConfig := op.ClusterConfig.GetComponentConfig()
+	clusterConfig.APIServer.ExtraArgs = mergeExtraArgs(apiServerArgs, componentConfig.GetApiServerExtraArgs())
+	clusterConfig.ControllerManager.ExtraArgs = mergeExtraArgs(nil, componentConfig.GetControllerManagerExtraArgs())
+	clusterConfig.Scheduler.ExtraArgs = mergeExtraArgs(nil, componentConfig.GetSchedulerExtraArgs())
+
+	// component configs are uploaded to the cluster by kubeadm init, masters and workers joined later use them as well
+	kubeletConfigData, err := kubeletConfig(op.ClusterConfig)
+	if err != nil {
+		return "", err
+	}
+	kubeProxyConfigData, err := kubeProxyConfig(op.ClusterConfig)
+	if err != nil {
+		return "", err
+	}
+
 	initConfigData, err := yaml.Marshal(initConfig)
 	if err != nil {
 		return "", err
@@ -95,6 +206,16 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 	}
 	initYaml.Write(clusterConfigData)
 
+	if kubeletConfigData != "" {
+		initYaml.Write([]byte("\n---\n"))
+		initYaml.Write([]byte(kubeletConfigData))
+	}
+
+	if kubeProxyConfigData != "" {
+		initYaml.Write([]byte("\n---\n"))
+		initYaml.Write([]byte(kubeProxyConfigData))
+	}
+
 	return initYaml.String(), nil
 }
 
@@ -103,7 +224,7 @@ func getExternalEtcd(etcdNodes []*pb.Node) *v1beta2.ExternalEtcd {
 
 	for i := range etcdNodes {
 		// TODO: replace to use etcd const when pr merged
-		ep := fmt.Sprintf("https://%v:%v", etcdNodes[i].Ip, 2379)
+		ep := fmt.Sprintf("https://%v", deploy.JoinHostPort(etcdNodes[i].Ip, 2379))
 		externalEtcd.Endpoints = append(externalEtcd.Endpoints, ep)
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestNewInitConfig(t *testing.T) {
+	op := &initMasterOperation{
+		EtcdNodes:   []*pb.Node{{Name: "etcd1", Ip: "192.168.1.1"}},
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "192.168.1.1"}},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+			PodSubnet:            "10.120.0.0/16",
+			ServiceSubnet:        "10.112.0.0/16",
+		},
+	}
+
+	config, err := newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "podSubnet: 10.120.0.0/16")
+	assert.Contains(t, config, "serviceSubnet: 10.112.0.0/16")
+	assert.Contains(t, config, "controlPlaneEndpoint: 192.168.1.1:6443")
+	assert.Contains(t, config, "- https://192.168.1.1:2379")
+	assert.NotContains(t, config, "IPv6DualStack")
+	assert.NotContains(t, config, "KubeProxyConfiguration")
+	assert.NotContains(t, config, "criSocket")
+	assert.Contains(t, config, "service-node-port-range: 30000-32767")
+
+	// NodePort range
+	op.ClusterConfig.NodePortRange = &pb.NodePortRange{From: 31000, To: 31999}
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "service-node-port-range: 31000-31999")
+	op.ClusterConfig.NodePortRange = nil
+
+	// containerd
+	op.ClusterConfig.ContainerRuntime = string(consts.ContainerRuntimeContainerd)
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "criSocket: "+consts.ContainerdCRISocket)
+	op.ClusterConfig.ContainerRuntime = ""
+
+	// component config
+	op.ClusterConfig.ComponentConfig = &pb.ComponentConfig{
+		ApiServerExtraArgs:         map[string]string{"default-watch-cache-size": "200"},
+		ControllerManagerExtraArgs: map[string]string{"node-cidr-mask-size": "25"},
+		SchedulerExtraArgs:         map[string]string{"v": "2"},
+		KubeletConfiguration:       "maxPods: 200\nevictionHard:\n  memory.available: 500Mi\n",
+		KubeProxyMode:              KubeProxyModeIPVS,
+	}
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "service-node-port-range: 30000-32767")
+	assert.Contains(t, config, "default-watch-cache-size: \"200\"")
+	assert.Contains(t, config, "node-cidr-mask-size: \"25\"")
+	assert.Contains(t, config, "v: \"2\"")
+	assert.Contains(t, config, "apiVersion: kubelet.config.k8s.io/v1beta1\n")
+	assert.Contains(t, config, "maxPods: 200\n")
+	assert.Contains(t, config, "memory.available: 500Mi\n")
+	assert.Contains(t, config, "mode: ipvs")
+	op.ClusterConfig.ComponentConfig = nil
+
+	// audit and encryption
+	op.ClusterConfig.Audit = &pb.AuditConfig{Level: AuditLevelMetadata, MaxAge: 7}
+	op.ClusterConfig.Encryption = &pb.EncryptionConfig{Provider: EncryptionProviderAESCBC}
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "audit-policy-file: "+auditPolicyPath)
+	assert.Contains(t, config, "audit-log-maxage: \"7\"")
+	assert.Contains(t, config, "encryption-provider-config: "+encryptionConfigPath)
+	assert.Contains(t, config, "hostPath: "+auditLogDir)
+	assert.Contains(t, config, "mountPath: "+encryptionConfigDir)
+	assert.Contains(t, config, "service-node-port-range: 30000-32767")
+	op.ClusterConfig.Audit = nil
+	op.ClusterConfig.Encryption = nil
+
+	// dual-stack
+	op.ClusterConfig.PodSubnets = []string{"10.120.0.0/16", "fd00:120::/64"}
+	op.ClusterConfig.ServiceSubnets = []string{"10.112.0.0/16", "fd00:112::/112"}
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "podSubnet: 10.120.0.0/16,fd00:120::/64")
+	assert.Contains(t, config, "serviceSubnet: 10.112.0.0/16,fd00:112::/112")
+	assert.Contains(t, config, "IPv6DualStack: true")
+	assert.Contains(t, config, "mode: ipvs")
+
+	// IPv6 only
+	op.EtcdNodes = []*pb.Node{{Name: "etcd1", Ip: "fd00::1"}}
+	op.MasterNodes = []*pb.Node{{Name: "master1", Ip: "fd00::1"}}
+	op.ClusterConfig.PodSubnets = []string{"fd00:120::/64"}
+	op.ClusterConfig.ServiceSubnets = []string{"fd00:112::/112"}
+	config, err = newInitConfig(op, "")
+	assert.NoError(t, err)
+	assert.Contains(t, config, "advertiseAddress: fd00::1")
+	assert.Contains(t, config, "controlPlaneEndpoint: '[fd00::1]:6443'")
+	assert.Contains(t, config, "- https://[fd00::1]:2379")
+	assert.NotContains(t, config, "IPv6DualStack")
+
+	// two subnets of the same ip family
+	op.ClusterConfig.PodSubnets = []string{"10.120.0.0/16", "10.121.0.0/16"}
+	_, err = newInitConfig(op, "")
+	assert.Error(t, err)
+}
+
+func TestKubeadmCRISocketArgs(t *testing.T) {
+	assert.Empty(t, kubeadmCRISocketArgs(&pb.ClusterConfig{}))
+	assert.Empty(t, kubeadmCRISocketArgs(&pb.ClusterConfig{ContainerRuntime: string(consts.ContainerRuntimeDocker)}))
+	assert.Equal(t, []string{"--cri-socket", consts.ContainerdCRISocket},
+		kubeadmCRISocketArgs(&pb.ClusterConfig{ContainerRuntime: string(consts.ContainerRuntimeContainerd)}))
+}
+
+func TestKubeadmPreflightArgs(t *testing.T) {
+	kubeVIP := &pb.KubeAPIServerConnect{Type: "kubevip"}
+	keepSwap := &pb.NodeInitProfile{SwapPolicy: string(consts.SwapPolicyKeep)}
+
+	assert.Empty(t, kubeadmPreflightArgs(&pb.ClusterConfig{}))
+	assert.Equal(t, []string{"--ignore-preflight-errors", kubeVIPPreflightError},
+		kubeadmPreflightArgs(&pb.ClusterConfig{KubeAPIServerConnect: kubeVIP}))
+	assert.Equal(t, []string{"--ignore-preflight-errors", swapPreflightError},
+		kubeadmPreflightArgs(&pb.ClusterConfig{NodeInitProfile: keepSwap}))
+	assert.Equal(t, []string{"--ignore-preflight-errors", kubeVIPPreflightError + "," + swapPreflightError},
+		kubeadmPreflightArgs(&pb.ClusterConfig{KubeAPIServerConnect: kubeVIP, NodeInitProfile: keepSwap}))
+}
+
+func TestKubeadmCommand(t *testing.T) {
+	assert.Equal(t, "kubeadm", kubeadmCommand(&pb.ClusterConfig{}))
+	assert.Equal(t, "kubeadm", kubeadmCommand(&pb.ClusterConfig{Proxy: &pb.ProxyConfig{NoProxy: []string{"10.0.0.1"}}}))
+	assert.Equal(t, ". "+consts.DefaultProxyEnvFile+" && kubeadm",
+		kubeadmCommand(&pb.ClusterConfig{Proxy: &pb.ProxyConfig{HttpsProxy: "http://10.0.0.1:3128"}}))
+}
@@ -0,0 +1,384 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	EncryptionProviderAESCBC    = "aescbc"
+	EncryptionProviderSecretbox = "secretbox"
+
+	encryptionConfigAPIVersion = "apiserver.config.k8s.io/v1"
+	encryptionConfigKind       = "EncryptionConfiguration"
+	encryptionProviderIdentity = "identity"
+
+	encryptionConfigDir  = consts.DefaultK8sConfigDir + "/encryption"
+	encryptionConfigPath = encryptionConfigDir + "/config.yaml"
+
+	// both aescbc and secretbox take 32 bytes keys
+	encryptionKeyLength = 32
+
+	apiServerProcessName         = "kube-apiserver"
+	defaultAPIServerReadyTimeout = 3 * time.Minute
+)
+
+type encryptionKey struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+type encryptionProvider struct {
+	Keys []encryptionKey `json:"keys,omitempty"`
+}
+
+type encryptionResource struct {
+	Resources []string                         `json:"resources"`
+	Providers []map[string]*encryptionProvider `json:"providers"`
+}
+
+type encryptionConfiguration struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Resources  []encryptionResource `json:"resources"`
+}
+
+// IsEncryptionEnabled tells whether secrets are encrypted at rest
+func IsEncryptionEnabled(clusterConfig *pb.ClusterConfig) bool {
+	return clusterConfig.GetEncryption().GetProvider() != ""
+}
+
+// ValidateEncryption checks the encryption provider
+func ValidateEncryption(encryption *pb.EncryptionConfig) error {
+	switch encryption.GetProvider() {
+	case "", EncryptionProviderAESCBC, EncryptionProviderSecretbox:
+		return nil
+	default:
+		return fmt.Errorf("invalid encryption provider: %v, only %v and %v are supported", encryption.GetProvider(),
+			EncryptionProviderAESCBC, EncryptionProviderSecretbox)
+	}
+}
+
+// newEncryptionKey generates a random key named after the current time
+func newEncryptionKey() (encryptionKey, error) {
+	secret := make([]byte, encryptionKeyLength)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return encryptionKey{}, fmt.Errorf("failed to generate encryption key, error: %v", err)
+	}
+
+	return encryptionKey{
+		Name:   "key" + time.Now().Format("20060102150405"),
+		Secret: base64.StdEncoding.EncodeToString(secret),
+	}, nil
+}
+
+// encryptionConfig returns the EncryptionConfiguration of secrets, the first key encrypts and all keys decrypt.
+// identity goes last, so secrets written before encryption is enabled are still readable.
+func encryptionConfig(provider string, keys []encryptionKey) (string, error) {
+	config := encryptionConfiguration{
+		APIVersion: encryptionConfigAPIVersion,
+		Kind:       encryptionConfigKind,
+		Resources: []encryptionResource{{
+			Resources: []string{"secrets"},
+			Providers: []map[string]*encryptionProvider{
+				{provider: {Keys: keys}},
+				{encryptionProviderIdentity: {}},
+			},
+		}},
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parseEncryptionConfig returns the provider and keys of the EncryptionConfiguration generated by encryptionConfig
+func parseEncryptionConfig(data []byte) (string, []encryptionKey, error) {
+	var config encryptionConfiguration
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return "", nil, fmt.Errorf("invalid encryption config, error: %v", err)
+	}
+
+	for _, resource := range config.Resources {
+		for _, providers := range resource.Providers {
+			for provider, providerConfig := range providers {
+				if provider != encryptionProviderIdentity && providerConfig != nil && len(providerConfig.Keys) > 0 {
+					return provider, providerConfig.Keys, nil
+				}
+			}
+		}
+	}
+
+	return "", nil, fmt.Errorf("invalid encryption config: no keys found")
+}
+
+// encryptionArgs returns the kube-apiserver arguments of encryption at rest
+func encryptionArgs() map[string]string {
+	return map[string]string{
+		"encryption-provider-config": encryptionConfigPath,
+	}
+}
+
+// encryptionVolumes returns the kube-apiserver volumes of encryption config
+func encryptionVolumes() []v1beta2.HostPathMount {
+	return []v1beta2.HostPathMount{
+		{
+			Name:      "encryption-config",
+			HostPath:  encryptionConfigDir,
+			MountPath: encryptionConfigDir,
+			ReadOnly:  true,
+			PathType:  corev1.HostPathDirectoryOrCreate,
+		},
+	}
+}
+
+// readRemoteFile returns the content of the file on the machine, it's empty if the file doesn't exist
+func readRemoteFile(m machine.IMachine, path string) ([]byte, error) {
+	stdout, stderr, err := m.Run(fmt.Sprintf("test ! -f %[1]v || cat %[1]v", path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v on %v, error: %v, %s", path, m.GetName(), err, stderr)
+	}
+	return stdout, nil
+}
+
+// putEncryptionConfig puts the encryption config only readable by root to the machine
+func putEncryptionConfig(m machine.IMachine, config string) error {
+	if _, stderr, err := m.Run(fmt.Sprintf("mkdir -p -m 700 %v", encryptionConfigDir)); err != nil {
+		return fmt.Errorf("failed to create %v on %v, error: %v, %s", encryptionConfigDir, m.GetName(), err, stderr)
+	}
+	if err := m.PutFile(bytes.NewReader([]byte(config)), encryptionConfigPath); err != nil {
+		return fmt.Errorf("failed to put encryption config to %v:%v, error: %v", m.GetName(), encryptionConfigPath, err)
+	}
+	if _, stderr, err := m.Run(fmt.Sprintf("chmod 600 %v", encryptionConfigPath)); err != nil {
+		return fmt.Errorf("failed to chmod %v on %v, error: %v, %s", encryptionConfigPath, m.GetName(), err, stderr)
+	}
+	return nil
+}
+
+// initEncryptionConfig generates the encryption key of the first master, the existing config is kept
+// as secrets in etcd may be encrypted by it.
+func initEncryptionConfig(m machine.IMachine, clusterConfig *pb.ClusterConfig) error {
+	existing, err := readRemoteFile(m, encryptionConfigPath)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		provider, _, err := parseEncryptionConfig(existing)
+		if err != nil {
+			return err
+		}
+		if provider != clusterConfig.GetEncryption().GetProvider() {
+			logrus.Warnf("encryption provider %v on %v is kept, provider could not be changed by deploy", provider, m.GetName())
+		}
+		return nil
+	}
+
+	key, err := newEncryptionKey()
+	if err != nil {
+		return err
+	}
+	config, err := encryptionConfig(clusterConfig.GetEncryption().GetProvider(), []encryptionKey{key})
+	if err != nil {
+		return err
+	}
+	return putEncryptionConfig(m, config)
+}
+
+// copyEncryptionConfig copies the encryption config of the first master to a joining master
+func copyEncryptionConfig(m machine.IMachine, firstMaster *pb.Node) error {
+	source, err := machine.NewMachine(firstMaster)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	config, err := readRemoteFile(source, encryptionConfigPath)
+	if err != nil {
+		return err
+	}
+	if len(config) == 0 {
+		return fmt.Errorf("encryption config %v not found on the first master %v", encryptionConfigPath, source.GetName())
+	}
+
+	return putEncryptionConfig(m, string(config))
+}
+
+type RotateEncryptionKeyOperationConfig struct {
+	Logger        *logrus.Entry
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	LogWriter     io.Writer
+}
+
+type rotateEncryptionKeyOperation struct {
+	Logger        *logrus.Entry
+	MasterNodes   []*pb.Node
+	machines      []machine.IMachine
+	ClusterConfig *pb.ClusterConfig
+	LogWriter     io.Writer
+}
+
+func NewRotateEncryptionKeyOperation(config *RotateEncryptionKeyOperationConfig) (*rotateEncryptionKeyOperation, error) {
+	if len(config.MasterNodes) == 0 {
+		return nil, fmt.Errorf("no master nodes given")
+	}
+
+	ops := &rotateEncryptionKeyOperation{
+		Logger:        config.Logger,
+		MasterNodes:   config.MasterNodes,
+		ClusterConfig: config.ClusterConfig,
+		LogWriter:     config.LogWriter,
+	}
+
+	for _, node := range config.MasterNodes {
+		m, err := machine.NewMachine(node)
+		if err != nil {
+			ops.close()
+			return nil, err
+		}
+		ops.machines = append(ops.machines, m)
+	}
+
+	return ops, nil
+}
+
+func (op *rotateEncryptionKeyOperation) close() {
+	for _, m := range op.machines {
+		m.Close()
+	}
+}
+
+// Do rotates the encryption key as kubernetes suggests:
+// 1. the new key is added to all masters, so each apiserver could decrypt secrets encrypted by it;
+// 2. the new key becomes the first key to encrypt secrets;
+// 3. all secrets are rewritten by the new key;
+// 4. the old keys are removed.
+func (op *rotateEncryptionKeyOperation) Do() error {
+	defer op.close()
+
+	existing, err := readRemoteFile(op.machines[0], encryptionConfigPath)
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		return fmt.Errorf("encryption at rest is not enabled on %v", op.machines[0].GetName())
+	}
+
+	provider, oldKeys, err := parseEncryptionConfig(existing)
+	if err != nil {
+		return err
+	}
+
+	newKey, err := newEncryptionKey()
+	if err != nil {
+		return err
+	}
+
+	op.Logger.Infof("rotate %v encryption key to %v", provider, newKey.Name)
+
+	if err := op.applyKeys(provider, append(append([]encryptionKey{}, oldKeys...), newKey)); err != nil {
+		return err
+	}
+
+	if err := op.applyKeys(provider, append([]encryptionKey{newKey}, oldKeys...)); err != nil {
+		return err
+	}
+
+	rewrite := command.NewShellCommand(op.machines[0], "kubectl", "--kubeconfig", consts.KubeConfigPath,
+		"get", "secrets", "--all-namespaces", "-o", "json", "|",
+		"kubectl", "--kubeconfig", consts.KubeConfigPath, "replace", "-f", "-").
+		WithDescription("rewrite all secrets with the new encryption key").
+		WithExecuteLogWriter(op.LogWriter)
+	if _, stderr, err := rewrite.Execute(); err != nil {
+		return fmt.Errorf("failed to rewrite secrets, error: %v, %s", err, stderr)
+	}
+
+	return op.applyKeys(provider, []encryptionKey{newKey})
+}
+
+// applyKeys puts the encryption config of the keys to masters and restarts apiservers one by one
+func (op *rotateEncryptionKeyOperation) applyKeys(provider string, keys []encryptionKey) error {
+	config, err := encryptionConfig(provider, keys)
+	if err != nil {
+		return err
+	}
+
+	for i, m := range op.machines {
+		if err := putEncryptionConfig(m, config); err != nil {
+			return err
+		}
+		if err := op.restartAPIServer(m, op.MasterNodes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restartAPIServer kills kube-apiserver which is restarted by kubelet as a static pod to reload the encryption config,
+// then waits until the new process is healthy.
+func (op *rotateEncryptionKeyOperation) restartAPIServer(m machine.IMachine, node *pb.Node) error {
+	pidCmd := fmt.Sprintf("pgrep -x %v || true", apiServerProcessName)
+	oldPid, _, err := m.Run(pidCmd)
+	if err != nil {
+		return fmt.Errorf("failed to get %v pid on %v, error: %v", apiServerProcessName, m.GetName(), err)
+	}
+
+	kill := command.NewShellCommand(m, "pkill", "-x", apiServerProcessName).
+		WithDescription("restart kube-apiserver to reload encryption config").
+		WithExecuteLogWriter(op.LogWriter)
+	if _, stderr, err := kill.Execute(); err != nil {
+		return fmt.Errorf("failed to restart %v on %v, error: %v, %s", apiServerProcessName, m.GetName(), err, stderr)
+	}
+
+	endpoint := deploy.JoinHostPort(node.GetIp(), defaultApiServerPort)
+	deadline := time.Now().Add(defaultAPIServerReadyTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(5 * time.Second)
+
+		pid, _, err := m.Run(pidCmd)
+		if err != nil || len(bytes.TrimSpace(pid)) == 0 || bytes.Equal(pid, oldPid) {
+			op.Logger.Debugf("%v not restarted on %v yet", apiServerProcessName, m.GetName())
+			continue
+		}
+		if err := apiServerHealthy(endpoint); err != nil {
+			op.Logger.Debugf("%v not ready on %v yet, error: %v", apiServerProcessName, m.GetName(), err)
+			continue
+		}
+		return nil
+	}
+
+	return fmt.Errorf("wait for %v on %v to be ready timeout after:%v", apiServerProcessName, m.GetName(),
+		defaultAPIServerReadyTimeout)
+}
@@ -0,0 +1,54 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestEncryptionConfig(t *testing.T) {
+	key1, err := newEncryptionKey()
+	assert.NoError(t, err)
+	secret, err := base64.StdEncoding.DecodeString(key1.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, encryptionKeyLength, len(secret))
+
+	key2 := encryptionKey{Name: "key2", Secret: key1.Secret}
+	config, err := encryptionConfig(EncryptionProviderSecretbox, []encryptionKey{key2, key1})
+	assert.NoError(t, err)
+	assert.Contains(t, config, "kind: EncryptionConfiguration\n")
+	assert.Contains(t, config, "- secretbox:\n")
+	assert.Contains(t, config, "- identity: {}\n")
+
+	provider, keys, err := parseEncryptionConfig([]byte(config))
+	assert.NoError(t, err)
+	assert.Equal(t, EncryptionProviderSecretbox, provider)
+	assert.Equal(t, []encryptionKey{key2, key1}, keys)
+
+	_, _, err = parseEncryptionConfig([]byte("kind: EncryptionConfiguration\n"))
+	assert.Error(t, err)
+}
+
+func TestValidateEncryption(t *testing.T) {
+	assert.NoError(t, ValidateEncryption(nil))
+	assert.NoError(t, ValidateEncryption(&pb.EncryptionConfig{Provider: EncryptionProviderAESCBC}))
+	assert.NoError(t, ValidateEncryption(&pb.EncryptionConfig{Provider: EncryptionProviderSecretbox}))
+	assert.Error(t, ValidateEncryption(&pb.EncryptionConfig{Provider: "aesgcm"}))
+}
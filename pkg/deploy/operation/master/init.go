@@ -38,6 +38,7 @@ import (
 
 const (
 	defaultControlPlaneReadyTimeout    = 5 * time.Minute
+	defaultApiServerPort               = 6443
 	kubeadmConfigFileName              = "kubeadm_config.yaml"
 	kubeadmConfigPath                  = consts.DefaultK8sConfigDir + "/" + kubeadmConfigFileName
 	defaultApiServerEtcdClientCertName = "apiserver-etcd-client.crt"
@@ -115,6 +116,18 @@ func (op *initMasterOperation) PreDo() error {
 		return fmt.Errorf("failed to put apiserver etcd client key to %v:%v, error: %v", op.machine.GetName(), defaultApiServerEtcdClientKeyPath, err)
 	}
 
+	if IsAuditEnabled(op.ClusterConfig) {
+		if err := putAuditPolicy(op.machine, op.ClusterConfig); err != nil {
+			return err
+		}
+	}
+
+	if IsEncryptionEnabled(op.ClusterConfig) {
+		if err := initEncryptionConfig(op.machine, op.ClusterConfig); err != nil {
+			return err
+		}
+	}
+
 	kubeadmConfig, err := newInitConfig(op, op.CertKey)
 	if err != nil {
 		return fmt.Errorf("failed to generate %v, error: %v", kubeadmConfigPath, err)
@@ -126,9 +139,9 @@ func (op *initMasterOperation) PreDo() error {
 
 	op.AddCommands(
 		command.NewShellCommand(op.machine, "systemctl", "start", "kubelet").WithExecuteLogWriter(op.LogWriter),
-		command.NewShellCommand(op.machine, "kubeadm", "init",
+		command.NewShellCommand(op.machine, kubeadmCommand(op.ClusterConfig), append([]string{"init",
 			"--config", kubeadmConfigPath,
-			"--upload-certs").WithExecuteLogWriter(op.LogWriter),
+			"--upload-certs"}, kubeadmPreflightArgs(op.ClusterConfig)...)...).WithExecuteLogWriter(op.LogWriter),
 	)
 	return nil
 }
@@ -184,6 +197,9 @@ func (op *initMasterOperation) PostDo() error {
 	}
 
 	if !up {
+		if err := checkKubeVIP(op); err != nil {
+			return err
+		}
 		return fmt.Errorf("wait for controlplane to be ready timeout after:%v", defaultControlPlaneReadyTimeout)
 	}
 
@@ -215,7 +231,29 @@ func masterUpAndRunning(op *initMasterOperation) error {
 		return nil
 	}
 
-	healthCheckUrl := fmt.Sprintf("https://%v/healthz", controlPlaneEndpoint)
+	return apiServerHealthy(controlPlaneEndpoint)
+}
+
+// checkKubeVIP tells whether the apiserver is unreachable because of the kube-vip virtual ip,
+// it returns nil if connect type is not kubevip or apiserver itself is not running.
+func checkKubeVIP(op *initMasterOperation) error {
+	connect := op.ClusterConfig.GetKubeAPIServerConnect()
+	if connect.GetType() != "kubevip" {
+		return nil
+	}
+
+	localEndpoint := deploy.JoinHostPort(op.MasterNodes[0].Ip, defaultApiServerPort)
+	if err := apiServerHealthy(localEndpoint); err != nil {
+		op.Logger.Debugf("apiserver %v is not running, error: %v", localEndpoint, err)
+		return nil
+	}
+
+	return fmt.Errorf("apiserver %v is running but kube-vip virtual ip %v is unreachable, please check kube-vip pod on %v and interface %v",
+		localEndpoint, connect.GetKubeVIP().GetVip(), op.MasterNodes[0].Name, connect.GetKubeVIP().GetNetInterfaceName())
+}
+
+func apiServerHealthy(endpoint string) error {
+	healthCheckUrl := fmt.Sprintf("https://%v/healthz", endpoint)
 
 	tr := &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -80,13 +80,28 @@ func (op *joinMasterOperation) PreDo() error {
 		return fmt.Errorf("failed to get control plane endpoint addr, error: %v", err)
 	}
 
+	// kube-apiserver of the joining master takes the same audit policy and encryption config as the first master
+	if IsAuditEnabled(op.ClusterConfig) {
+		if err := putAuditPolicy(op.machine, op.ClusterConfig); err != nil {
+			return err
+		}
+	}
+
+	if IsEncryptionEnabled(op.ClusterConfig) {
+		if err := copyEncryptionConfig(op.machine, op.MasterNodes[0]); err != nil {
+			return err
+		}
+	}
+
 	op.AddCommands(
 		command.NewShellCommand(op.machine, "systemctl", "start", "kubelet").WithExecuteLogWriter(op.LogWriter),
-		command.NewShellCommand(op.machine, "kubeadm", "join", endpoint,
+		command.NewShellCommand(op.machine, kubeadmCommand(op.ClusterConfig), append([]string{"join", endpoint,
 			"--token", Token,
 			"--control-plane",
 			"--certificate-key", op.CertKey,
-			"--discovery-token-unsafe-skip-ca-verification").WithExecuteLogWriter(op.LogWriter),
+			"--discovery-token-unsafe-skip-ca-verification"},
+			append(append(kubeadmAdvertiseArgs(op.machine.GetNode()), kubeadmCRISocketArgs(op.ClusterConfig)...),
+				kubeadmPreflightArgs(op.ClusterConfig)...)...)...).WithExecuteLogWriter(op.LogWriter),
 	)
 
 	return nil
@@ -0,0 +1,134 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	defaultTLSDialTimeout = 10 * time.Second
+
+	fixLoadBalancerUnreachable = "please make sure the load balancer is running and %v is reachable from deploy controller and all nodes"
+	fixLoadBalancerRouting     = "please make sure the load balancer forwards TCP traffic of %v to port %v of all master nodes, " +
+		"TLS must be passed through instead of terminated by the load balancer"
+)
+
+// CheckLoadBalancer makes sure the external load balancer routes to kube-apiserver of the cluster
+// by comparing the serving certificate got through load balancer with the one of first master.
+// It returns nil when connect type is not loadbalancer.
+func CheckLoadBalancer(clusterConfig *pb.ClusterConfig, masterNodes []*pb.Node) *pb.Error {
+	if clusterConfig.GetKubeAPIServerConnect().GetType() != "loadbalancer" || len(masterNodes) == 0 {
+		return nil
+	}
+
+	endpoint, err := deploy.GetControlPlaneEndpoint(clusterConfig, masterNodes)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to get control plane endpoint",
+			Detail:     err.Error(),
+			FixMethods: "please check load balancer ip and port in cluster config",
+		}
+	}
+
+	return checkLoadBalancer(endpoint, deploy.JoinHostPort(masterNodes[0].Ip, defaultApiServerPort))
+}
+
+// DiagnoseLoadBalancer tells whether a failed master init is caused by the external load balancer,
+// it returns nil if connect type is not loadbalancer or kube-apiserver of first master itself is not healthy,
+// so that the original init error is reported instead.
+func DiagnoseLoadBalancer(clusterConfig *pb.ClusterConfig, masterNodes []*pb.Node) *pb.Error {
+	if clusterConfig.GetKubeAPIServerConnect().GetType() != "loadbalancer" || len(masterNodes) == 0 {
+		return nil
+	}
+
+	endpoint, err := deploy.GetControlPlaneEndpoint(clusterConfig, masterNodes)
+	if err != nil {
+		return nil
+	}
+
+	return diagnoseLoadBalancer(endpoint, deploy.JoinHostPort(masterNodes[0].Ip, defaultApiServerPort))
+}
+
+func diagnoseLoadBalancer(endpoint string, masterEndpoint string) *pb.Error {
+	if err := apiServerHealthy(masterEndpoint); err != nil {
+		return nil
+	}
+
+	return checkLoadBalancer(endpoint, masterEndpoint)
+}
+
+func checkLoadBalancer(endpoint string, masterEndpoint string) *pb.Error {
+	masterCert, err := getServingCertificate(masterEndpoint)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "kube-apiserver of first master is unreachable",
+			Detail:     err.Error(),
+			FixMethods: "please check kube-apiserver on first master by deploy log",
+		}
+	}
+
+	endpointCert, err := getServingCertificate(endpoint)
+	if err != nil {
+		return &pb.Error{
+			Reason:     "load balancer is unreachable",
+			Detail:     err.Error(),
+			FixMethods: fmt.Sprintf(fixLoadBalancerUnreachable, endpoint),
+		}
+	}
+
+	if !bytes.Equal(masterCert.Raw, endpointCert.Raw) {
+		return &pb.Error{
+			Reason: "load balancer does not route to kube-apiserver",
+			Detail: fmt.Sprintf("certificate served by %v (subject: %v, issuer: %v) is different from the one of kube-apiserver %v (subject: %v, issuer: %v)",
+				endpoint, endpointCert.Subject, endpointCert.Issuer, masterEndpoint, masterCert.Subject, masterCert.Issuer),
+			FixMethods: fmt.Sprintf(fixLoadBalancerRouting, endpoint, defaultApiServerPort),
+		}
+	}
+
+	if err := apiServerHealthy(endpoint); err != nil {
+		return &pb.Error{
+			Reason:     "kube-apiserver is unhealthy through load balancer",
+			Detail:     err.Error(),
+			FixMethods: fmt.Sprintf(fixLoadBalancerRouting, endpoint, defaultApiServerPort),
+		}
+	}
+
+	return nil
+}
+
+func getServingCertificate(endpoint string) (*x509.Certificate, error) {
+	dialer := &net.Dialer{Timeout: defaultTLSDialTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", endpoint, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to establish tls connection to %v, error: %v", endpoint, err)
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate served by %v", endpoint)
+	}
+
+	return certs[0], nil
+}
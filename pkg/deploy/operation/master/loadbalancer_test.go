@@ -0,0 +1,120 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newTestAPIServer(healthz string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(healthz))
+	}))
+}
+
+// newTestOtherServer serves a different certificate from the default one of httptest
+func newTestOtherServer(t *testing.T) *httptest.Server {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "other"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	server.StartTLS()
+	return server
+}
+
+func TestCheckLoadBalancer(t *testing.T) {
+	apiServer := newTestAPIServer("ok")
+	defer apiServer.Close()
+	otherServer := newTestOtherServer(t)
+	defer otherServer.Close()
+	unhealthyServer := newTestAPIServer("[-]etcd failed")
+	defer unhealthyServer.Close()
+
+	address := func(server *httptest.Server) string {
+		return strings.TrimPrefix(server.URL, "https://")
+	}
+
+	assert.Nil(t, checkLoadBalancer(address(apiServer), address(apiServer)))
+
+	pbErr := checkLoadBalancer(address(otherServer), address(apiServer))
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "load balancer does not route to kube-apiserver", pbErr.Reason)
+	assert.NotEmpty(t, pbErr.FixMethods)
+
+	pbErr = checkLoadBalancer("127.0.0.1:1", address(apiServer))
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "load balancer is unreachable", pbErr.Reason)
+
+	pbErr = checkLoadBalancer(address(unhealthyServer), address(unhealthyServer))
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "kube-apiserver is unhealthy through load balancer", pbErr.Reason)
+
+	assert.Nil(t, CheckLoadBalancer(&pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+	}, []*pb.Node{{Name: "master", Ip: "127.0.0.1"}}))
+}
+
+func TestDiagnoseLoadBalancer(t *testing.T) {
+	apiServer := newTestAPIServer("ok")
+	defer apiServer.Close()
+	unhealthyServer := newTestAPIServer("[-]etcd failed")
+	defer unhealthyServer.Close()
+
+	address := func(server *httptest.Server) string {
+		return strings.TrimPrefix(server.URL, "https://")
+	}
+
+	// first master is down, the original init error must be reported
+	assert.Nil(t, diagnoseLoadBalancer("127.0.0.1:1", "127.0.0.1:1"))
+	assert.Nil(t, diagnoseLoadBalancer(address(apiServer), "127.0.0.1:1"))
+	assert.Nil(t, diagnoseLoadBalancer(address(unhealthyServer), address(unhealthyServer)))
+
+	assert.Nil(t, diagnoseLoadBalancer(address(apiServer), address(apiServer)))
+
+	pbErr := diagnoseLoadBalancer("127.0.0.1:1", address(apiServer))
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "load balancer is unreachable", pbErr.Reason)
+
+	assert.Nil(t, DiagnoseLoadBalancer(&pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+	}, []*pb.Node{{Name: "master", Ip: "127.0.0.1"}}))
+}
@@ -0,0 +1,60 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// PlanFiles returns the kubeadm config of the first master and the audit policy of each master,
+// the encryption config is left out since its key is generated on the first master during deployment.
+func PlanFiles(masterNodes, etcdNodes []*pb.Node, clusterConfig *pb.ClusterConfig, certKey string) ([]*pb.DeployPlanFile, error) {
+	if len(masterNodes) == 0 {
+		return nil, nil
+	}
+
+	initConfig, err := newInitConfig(&initMasterOperation{
+		MasterNodes:   masterNodes,
+		EtcdNodes:     etcdNodes,
+		ClusterConfig: clusterConfig,
+	}, certKey)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []*pb.DeployPlanFile{
+		{
+			Node:    masterNodes[0].GetName(),
+			Path:    kubeadmConfigPath,
+			Content: initConfig,
+		},
+	}
+
+	if IsAuditEnabled(clusterConfig) {
+		policy, err := auditPolicy(clusterConfig.GetAudit())
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range masterNodes {
+			files = append(files, &pb.DeployPlanFile{
+				Node:    node.GetName(),
+				Path:    auditPolicyPath,
+				Content: policy,
+			})
+		}
+	}
+
+	return files, nil
+}
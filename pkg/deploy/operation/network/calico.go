@@ -0,0 +1,94 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/chart"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	NetworkTypeCalico = "calico"
+
+	calicoChartName = "calico"
+	calicoNodeLabel = "k8s-app=calico-node"
+
+	ipDetectionMethodFromKubernetes = "from-kubernetes"
+)
+
+// calicoValues converts the calico options into values of the calico chart
+func calicoValues(options *pb.CalicoOptions, podSubnets []string) map[string]interface{} {
+	values := make(map[string]interface{})
+	networkConfig := make(map[string]interface{})
+
+	ipv4Subnet := deploy.GetSubnetOfFamily(podSubnets, false)
+	ipv6Subnet := deploy.GetSubnetOfFamily(podSubnets, true)
+
+	ipv4Pool := options.GetInitialPodIPs()
+	if ipv4Pool == "" {
+		ipv4Pool = ipv4Subnet
+	}
+
+	ipv6Pool := options.GetInitialPodIPv6Pool()
+	if ipv6Pool == "" {
+		ipv6Pool = ipv6Subnet
+	}
+	if ipv6Pool != "" {
+		values["ipv6pool_cidr"] = ipv6Pool
+	}
+
+	// IPv4 is disabled in an IPv6 only cluster, the initial IPv4 pool is ignored
+	if ipv4Subnet == "" && ipv6Subnet != "" {
+		values["disable_ipv4"] = true
+		ipv4Pool = ""
+	}
+	if ipv4Pool != "" {
+		values["ipv4pool_cidr"] = ipv4Pool
+	}
+	if options.GetVethMtu() != 0 {
+		values["veth_mtu"] = options.GetVethMtu()
+	}
+	if options.GetEncapsulationMode() != "" {
+		networkConfig["encap_mode"] = options.GetEncapsulationMode()
+	}
+	if options.GetVxlanPort() != 0 {
+		networkConfig["vxlan_port"] = options.GetVxlanPort()
+	}
+
+	if method := options.GetIpDetectionMethod(); method != "" {
+		ipDetection := make(map[string]interface{})
+		// the chart uses "from_kubernetes" for historical reasons
+		if method == ipDetectionMethodFromKubernetes {
+			method = "from_kubernetes"
+		}
+		ipDetection["method"] = method
+		if options.GetIpDetectionInterface() != "" {
+			ipDetection["interface"] = options.GetIpDetectionInterface()
+		}
+		networkConfig["ip_detection"] = ipDetection
+	}
+
+	if len(networkConfig) > 0 {
+		values["network_config"] = networkConfig
+	}
+
+	return values
+}
+
+// RenderCalicoManifest renders the manifest of calico from the embedded chart
+func RenderCalicoManifest(options *pb.CalicoOptions, podSubnets []string) (string, error) {
+	return chart.Render(calicoChartName, releaseNamespace, calicoValues(options, podSubnets))
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/chart"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	NetworkTypeCilium = "cilium"
+
+	ciliumChartName = "cilium"
+	ciliumNodeLabel = "k8s-app=cilium"
+)
+
+// ciliumValues converts the cilium options into values of the cilium chart
+func ciliumValues(options *pb.CiliumOptions, podSubnets []string) map[string]interface{} {
+	values := make(map[string]interface{})
+	networkConfig := make(map[string]interface{})
+
+	// IPv4 is enabled by default if pod subnets are not given
+	if len(podSubnets) > 0 {
+		networkConfig["enable_ipv4"] = deploy.GetSubnetOfFamily(podSubnets, false) != ""
+		networkConfig["enable_ipv6"] = deploy.GetSubnetOfFamily(podSubnets, true) != ""
+	}
+
+	if options.GetTunnelMode() != "" {
+		networkConfig["tunnel"] = options.GetTunnelMode()
+	}
+	if options.GetNativeRoutingCIDR() != "" {
+		networkConfig["native_routing_cidr"] = options.GetNativeRoutingCIDR()
+	}
+	if options.GetMtu() != 0 {
+		networkConfig["mtu"] = options.GetMtu()
+	}
+
+	if len(networkConfig) > 0 {
+		values["network_config"] = networkConfig
+	}
+
+	return values
+}
+
+// RenderCiliumManifest renders the manifest of cilium from the embedded chart
+func RenderCiliumManifest(options *pb.CiliumOptions, podSubnets []string) (string, error) {
+	return chart.Render(ciliumChartName, releaseNamespace, ciliumValues(options, podSubnets))
+}
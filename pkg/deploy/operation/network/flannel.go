@@ -0,0 +1,63 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/chart"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	NetworkTypeFlannel = "flannel"
+
+	flannelChartName = "flannel"
+	flannelNodeLabel = "app=flannel"
+)
+
+// flannelValues converts the flannel options into values of the flannel chart
+func flannelValues(options *pb.FlannelOptions, podSubnets []string) map[string]interface{} {
+	values := make(map[string]interface{})
+	networkConfig := make(map[string]interface{})
+
+	if podSubnet := deploy.GetSubnetOfFamily(podSubnets, false); podSubnet != "" {
+		networkConfig["pod_cidr"] = podSubnet
+	}
+	if options.GetBackend() != "" {
+		networkConfig["backend"] = options.GetBackend()
+	}
+	if options.GetVxlanPort() != 0 {
+		networkConfig["vxlan_port"] = options.GetVxlanPort()
+	}
+	if options.GetIface() != "" {
+		networkConfig["iface"] = options.GetIface()
+	}
+
+	if len(networkConfig) > 0 {
+		values["network_config"] = networkConfig
+	}
+
+	return values
+}
+
+// RenderFlannelManifest renders the manifest of flannel from the embedded chart, flannel only supports IPv4.
+func RenderFlannelManifest(options *pb.FlannelOptions, podSubnets []string) (string, error) {
+	if ipv6Subnet := deploy.GetSubnetOfFamily(podSubnets, true); ipv6Subnet != "" {
+		return "", fmt.Errorf("flannel does not support IPv6 pod subnet %v", ipv6Subnet)
+	}
+	return chart.Render(flannelChartName, releaseNamespace, flannelValues(options, podSubnets))
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	ManifestPath = "/tmp/installNetwork.yaml"
+
+	releaseNamespace = "kube-system"
+
+	DefaultNetworkReadyTimeout = 10 * time.Minute
+
+	podPhaseRunning = "Running"
+	conditionTrue   = "True"
+)
+
+var networkReadyPollInterval = 5 * time.Second
+
+// RenderManifest renders the manifest of network by the network type in cluster config
+func RenderManifest(clusterConfig *pb.ClusterConfig) (string, error) {
+	options := clusterConfig.GetNetworkOptions()
+	podSubnets := deploy.GetPodSubnets(clusterConfig)
+	switch options.GetNetworkType() {
+	case "", NetworkTypeCalico:
+		return RenderCalicoManifest(options.GetCalicoOptions(), podSubnets)
+	case NetworkTypeFlannel:
+		return RenderFlannelManifest(options.GetFlannelOptions(), podSubnets)
+	case NetworkTypeCilium:
+		return RenderCiliumManifest(options.GetCiliumOptions(), podSubnets)
+	default:
+		return "", fmt.Errorf("unsupported network type: %q", options.GetNetworkType())
+	}
+}
+
+// agentPodLabel returns the label selector of the network agent pods running on every node
+func agentPodLabel(clusterConfig *pb.ClusterConfig) string {
+	switch clusterConfig.GetNetworkOptions().GetNetworkType() {
+	case NetworkTypeFlannel:
+		return flannelNodeLabel
+	case NetworkTypeCilium:
+		return ciliumNodeLabel
+	default:
+		return calicoNodeLabel
+	}
+}
+
+// NodeNetworkReady checks the network agent pod on node is running and the node reports Ready,
+// kubectl is run on the master machine.
+func NodeNetworkReady(master machine.IMachine, clusterConfig *pb.ClusterConfig, nodeName string) error {
+	stdout, stderr, err := command.NewKubectlCommand(master, consts.KubeConfigPath, releaseNamespace,
+		"get", "pods", "-l", agentPodLabel(clusterConfig),
+		fmt.Sprintf("--field-selector spec.nodeName=%v", nodeName),
+		"-o", "jsonpath='{.items[*].status.phase}'",
+	).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to get network pods on node %v, error: %v, stderr: %s", nodeName, err, stderr)
+	}
+
+	phases := strings.Fields(string(stdout))
+	if len(phases) == 0 {
+		return fmt.Errorf("network pod on node %v is not created yet", nodeName)
+	}
+	for _, phase := range phases {
+		if phase != podPhaseRunning {
+			return fmt.Errorf("network pod on node %v is %v, not %v", nodeName, phase, podPhaseRunning)
+		}
+	}
+
+	stdout, stderr, err = command.NewKubectlCommand(master, consts.KubeConfigPath, "",
+		"get", "node", nodeName,
+		"-o", `jsonpath='{.status.conditions[?(@.type=="Ready")].status}'`,
+	).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to get status of node %v, error: %v, stderr: %s", nodeName, err, stderr)
+	}
+
+	if status := strings.TrimSpace(string(stdout)); status != conditionTrue {
+		return fmt.Errorf("node %v is not ready, ready condition: %q", nodeName, status)
+	}
+
+	return nil
+}
+
+// WaitNodeNetworkReady waits until NodeNetworkReady passes or timeout
+func WaitNodeNetworkReady(master machine.IMachine, clusterConfig *pb.ClusterConfig, nodeName string,
+	timeout time.Duration, logWriter io.Writer) error {
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := NodeNetworkReady(master, clusterConfig, nodeName)
+		if err == nil {
+			fmt.Fprintf(logWriter, "network of node %v is ready\n", nodeName)
+			return nil
+		}
+		if time.Now().After(deadline) {
+			fmt.Fprintf(logWriter, "network of node %v is not ready after %v, last error: %v\n", nodeName, timeout, err)
+			return fmt.Errorf("wait for network of node %v to be ready timeout after %v, last error: %v", nodeName, timeout, err)
+		}
+		time.Sleep(networkReadyPollInterval)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package network
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestRenderManifest(t *testing.T) {
+	manifest, err := RenderManifest(&pb.ClusterConfig{
+		PodSubnet: "172.30.0.0/16",
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType: NetworkTypeCalico,
+			CalicoOptions: &pb.CalicoOptions{
+				EncapsulationMode:    "ipip",
+				VethMtu:              1380,
+				IpDetectionMethod:    "interface",
+				IpDetectionInterface: "eth.*",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "name: calico-node")
+	assert.Contains(t, manifest, `value: "172.30.0.0/16"`)
+	assert.Contains(t, manifest, `veth_mtu: "1380"`)
+	assert.Contains(t, manifest, "name: CALICO_IPV4POOL_IPIP")
+	assert.Contains(t, manifest, "value: interface=eth.*")
+
+	// calico is used if network type is empty
+	manifest, err = RenderManifest(&pb.ClusterConfig{})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "name: calico-node")
+	assert.Contains(t, manifest, "fieldPath: status.podIP")
+
+	// dual-stack
+	manifest, err = RenderManifest(&pb.ClusterConfig{PodSubnets: []string{"172.30.0.0/16", "fd00:30::/64"}})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, `value: "fd00:30::/64"`)
+	assert.Contains(t, manifest, `value: "172.30.0.0/16"`)
+	assert.Contains(t, manifest, `"assign_ipv6": "true"`)
+	assert.Contains(t, manifest, "name: IP6\n")
+
+	// IPv6 only
+	manifest, err = RenderManifest(&pb.ClusterConfig{PodSubnets: []string{"fd00:30::/64"}})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, `"assign_ipv4": "false"`)
+	assert.Contains(t, manifest, "name: CALICO_ROUTER_ID")
+	assert.NotContains(t, manifest, "name: CALICO_IPV4POOL_CIDR")
+
+	_, err = RenderManifest(&pb.ClusterConfig{NetworkOptions: &pb.NetworkOptions{NetworkType: "unknown"}})
+	assert.Error(t, err)
+}
+
+func TestRenderFlannelManifest(t *testing.T) {
+	manifest, err := RenderManifest(&pb.ClusterConfig{
+		PodSubnet: "172.30.0.0/16",
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType: NetworkTypeFlannel,
+			FlannelOptions: &pb.FlannelOptions{
+				Backend:   "vxlan",
+				VxlanPort: 8475,
+				Iface:     "eth1",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "name: kube-flannel-ds")
+	assert.Contains(t, manifest, `"Network": "172.30.0.0/16"`)
+	assert.Contains(t, manifest, `"Port": 8475`)
+	assert.Contains(t, manifest, "--iface=eth1")
+
+	manifest, err = RenderFlannelManifest(&pb.FlannelOptions{Backend: "host-gw"}, []string{"172.30.0.0/16"})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, `"Type": "host-gw"`)
+	assert.NotContains(t, manifest, `"Port"`)
+	assert.NotContains(t, manifest, "--iface")
+
+	// flannel does not support IPv6
+	_, err = RenderFlannelManifest(&pb.FlannelOptions{}, []string{"172.30.0.0/16", "fd00:30::/64"})
+	assert.Error(t, err)
+}
+
+func TestRenderCiliumManifest(t *testing.T) {
+	manifest, err := RenderManifest(&pb.ClusterConfig{
+		NetworkOptions: &pb.NetworkOptions{
+			NetworkType:   NetworkTypeCilium,
+			CiliumOptions: &pb.CiliumOptions{TunnelMode: "geneve", Mtu: 1450},
+		},
+		PodSubnets: []string{"10.120.0.0/16"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "name: cilium-operator")
+	assert.Contains(t, manifest, `tunnel: "geneve"`)
+	assert.Contains(t, manifest, `mtu: "1450"`)
+
+	assert.Contains(t, manifest, `enable-ipv4: "true"`)
+	assert.Contains(t, manifest, `enable-ipv6: "false"`)
+
+	manifest, err = RenderCiliumManifest(&pb.CiliumOptions{TunnelMode: "disabled", NativeRoutingCIDR: "10.0.0.0/8"},
+		[]string{"10.120.0.0/16", "fd00:120::/64"})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, `native-routing-cidr: "10.0.0.0/8"`)
+	assert.Contains(t, manifest, `auto-direct-node-routes: "true"`)
+	assert.Contains(t, manifest, `enable-ipv6: "true"`)
+	assert.Contains(t, manifest, `k8s-require-ipv6-pod-cidr: "true"`)
+
+	// native routing cidr is required when tunnel is disabled
+	_, err = RenderCiliumManifest(&pb.CiliumOptions{TunnelMode: "disabled"}, nil)
+	assert.Error(t, err)
+}
+
+func TestAgentPodLabel(t *testing.T) {
+	assert.Equal(t, calicoNodeLabel, agentPodLabel(&pb.ClusterConfig{}))
+	assert.Equal(t, flannelNodeLabel, agentPodLabel(&pb.ClusterConfig{
+		NetworkOptions: &pb.NetworkOptions{NetworkType: NetworkTypeFlannel}}))
+	assert.Equal(t, ciliumNodeLabel, agentPodLabel(&pb.ClusterConfig{
+		NetworkOptions: &pb.NetworkOptions{NetworkType: NetworkTypeCilium}}))
+}
+
+func TestCalicoValues(t *testing.T) {
+	values := calicoValues(&pb.CalicoOptions{
+		InitialPodIPs:     "10.1.0.0/16",
+		VxlanPort:         4790,
+		IpDetectionMethod: "from-kubernetes",
+	}, []string{"172.30.0.0/16"})
+	assert.Equal(t, "10.1.0.0/16", values["ipv4pool_cidr"])
+	networkConfig := values["network_config"].(map[string]interface{})
+	assert.Equal(t, uint32(4790), networkConfig["vxlan_port"])
+	assert.Equal(t, "from_kubernetes", networkConfig["ip_detection"].(map[string]interface{})["method"])
+
+	assert.Empty(t, calicoValues(nil, nil))
+
+	values = calicoValues(nil, []string{"172.30.0.0/16", "fd00:30::/64"})
+	assert.Equal(t, "172.30.0.0/16", values["ipv4pool_cidr"])
+	assert.Equal(t, "fd00:30::/64", values["ipv6pool_cidr"])
+	assert.Nil(t, values["disable_ipv4"])
+
+	values = calicoValues(&pb.CalicoOptions{InitialPodIPs: "10.1.0.0/16"}, []string{"fd00:30::/64"})
+	assert.Nil(t, values["ipv4pool_cidr"])
+	assert.Equal(t, true, values["disable_ipv4"])
+}
+
+func TestWaitNodeNetworkReady(t *testing.T) {
+	networkReadyPollInterval = time.Millisecond
+
+	normal, err := machine.NewMachine(&pb.Node{Name: "normal", Ip: "10.10.10.10"})
+	assert.NoError(t, err)
+	logBuffer := &bytes.Buffer{}
+	assert.NoError(t, WaitNodeNetworkReady(normal, &pb.ClusterConfig{}, "node1", time.Second, logBuffer))
+	assert.Contains(t, logBuffer.String(), "network of node node1 is ready")
+
+	errorMachine := &machine.MockMachine{Node: &pb.Node{Name: "error", Ip: "10.10.10.10"}}
+	assert.Error(t, WaitNodeNetworkReady(errorMachine, &pb.ClusterConfig{}, "node1", 10*time.Millisecond, &bytes.Buffer{}))
+}
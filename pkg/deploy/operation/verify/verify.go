@@ -0,0 +1,442 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ingress"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// Namespace is the namespace of the workload used to verify the cluster, it's deleted after verification
+	Namespace    = "kpaas-verify"
+	ManifestPath = "/tmp/verifyCluster.yaml"
+
+	// imageName runs the http server, nslookup and wget used in verification, it's pulled from the image repository of cluster
+	imageName           = "busybox:1.31"
+	DefaultReadyTimeout = 5 * time.Minute
+
+	workloadName = "kpaas-verify"
+	workloadPort = 8080
+	servicePort  = 80
+
+	ingressHTTPPort = 80
+
+	systemNamespace = "kube-system"
+	dnsTestDomain   = "kubernetes.default.svc.cluster.local"
+
+	podPhaseRunning   = "Running"
+	podPhaseSucceeded = "Succeeded"
+	conditionTrue     = "True"
+
+	tcpReachable   = "reachable"
+	tcpUnreachable = "unreachable"
+	dialTimeout    = 5
+)
+
+var readyPollInterval = 5 * time.Second
+
+// workloadTemplate runs a http server on every node, it's exposed by a NodePort service.
+// All taints are tolerated so that the pod network of masters is verified too.
+var workloadTemplate = template.Must(template.New("verify").Parse(`apiVersion: v1
+kind: Namespace
+metadata:
+  name: {{ .Namespace }}
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+  labels:
+    app: {{ .Name }}
+spec:
+  selector:
+    matchLabels:
+      app: {{ .Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Name }}
+    spec:
+      tolerations:
+      - operator: Exists
+      containers:
+      - name: http
+        image: {{ .Image }}
+        imagePullPolicy: IfNotPresent
+        command: ["sh", "-c", "echo ok > /tmp/index.html && exec httpd -f -p {{ .Port }} -h /tmp"]
+        ports:
+        - name: http
+          containerPort: {{ .Port }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+spec:
+  type: NodePort
+  selector:
+    app: {{ .Name }}
+  ports:
+  - name: http
+    port: {{ .ServicePort }}
+    targetPort: http
+`))
+
+// Pod is the brief of a pod used in verification
+type Pod struct {
+	Name     string
+	Phase    string
+	IP       string
+	NodeName string
+}
+
+// GetImage returns the image of workload used to verify the cluster
+func GetImage(clusterConfig *pb.ClusterConfig) string {
+	return deploy.GetImageRepository(clusterConfig) + "/" + imageName
+}
+
+// RenderManifest renders the manifest of workload used to verify the cluster
+func RenderManifest(clusterConfig *pb.ClusterConfig) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := workloadTemplate.Execute(buffer, map[string]interface{}{
+		"Namespace":   Namespace,
+		"Name":        workloadName,
+		"Image":       GetImage(clusterConfig),
+		"Port":        workloadPort,
+		"ServicePort": servicePort,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// run executes the kubectl command on master and writes the result into log
+func run(cmd command.Command, logWriter io.Writer) (string, error) {
+	stdout, stderr, err := cmd.Execute()
+	fmt.Fprintf(logWriter, "[command]: %s\n", cmd.GetCommand())
+	if len(stdout) > 0 {
+		fmt.Fprintf(logWriter, "[stdout]:\n%s\n", stdout)
+	}
+	if len(stderr) > 0 {
+		fmt.Fprintf(logWriter, "[stderr]:\n%s\n", stderr)
+	}
+	if err != nil {
+		fmt.Fprintf(logWriter, "[error]: %v\n", err)
+		return string(stdout), fmt.Errorf("%v, stderr: %s", err, stderr)
+	}
+	return string(stdout), nil
+}
+
+// NodesReady checks all the nodes are registered and report Ready
+func NodesReady(master machine.IMachine, nodeNames []string, logWriter io.Writer) error {
+	stdout, err := run(command.NewKubectlCommand(master, consts.KubeConfigPath, "",
+		"get", "nodes",
+		"-o", `jsonpath='{range .items[*]}{.metadata.name}{" "}{.status.conditions[?(@.type=="Ready")].status}{"\n"}{end}'`,
+	), logWriter)
+	if err != nil {
+		return fmt.Errorf("failed to get nodes: %v", err)
+	}
+
+	nodeStatus := make(map[string]string)
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		nodeStatus[fields[0]] = fields[1]
+	}
+
+	var notReady []string
+	for _, name := range nodeNames {
+		status, ok := nodeStatus[name]
+		if !ok {
+			notReady = append(notReady, fmt.Sprintf("%v(not registered)", name))
+		} else if status != conditionTrue {
+			notReady = append(notReady, name)
+		}
+	}
+	if len(notReady) > 0 {
+		return fmt.Errorf("nodes are not ready: %v", strings.Join(notReady, ", "))
+	}
+	return nil
+}
+
+// GetPods returns the pods in namespace, label selector is ignored if empty
+func GetPods(master machine.IMachine, namespace, selector string, logWriter io.Writer) ([]Pod, error) {
+	args := []string{"get", "pods"}
+	if selector != "" {
+		args = append(args, "-l", selector)
+	}
+	args = append(args, "-o",
+		`jsonpath='{range .items[*]}{.metadata.name}{" "}{.status.phase}{" "}{.status.podIP}{" "}{.spec.nodeName}{"\n"}{end}'`)
+
+	stdout, err := run(command.NewKubectlCommand(master, consts.KubeConfigPath, namespace, args...), logWriter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pods in namespace %v: %v", namespace, err)
+	}
+	return parsePods(stdout), nil
+}
+
+// parsePods parses the output of GetPods, the ip and node name may be empty before the pod is scheduled
+func parsePods(output string) []Pod {
+	var pods []Pod
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		pod := Pod{Name: fields[0], Phase: fields[1]}
+		if len(fields) == 3 {
+			pod.NodeName = fields[2]
+		} else if len(fields) > 3 {
+			pod.IP = fields[2]
+			pod.NodeName = fields[3]
+		}
+		pods = append(pods, pod)
+	}
+	return pods
+}
+
+// WaitSystemPodsRunning waits until all the pods in kube-system are running, the pods of network plugin
+// and addons may be still starting when verification begins.
+func WaitSystemPodsRunning(master machine.IMachine, timeout time.Duration, logWriter io.Writer) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		err := systemPodsRunning(master, logWriter)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("wait for system pods to be running timeout after %v, last error: %v", timeout, err)
+		}
+		time.Sleep(readyPollInterval)
+	}
+}
+
+// systemPodsRunning checks all the pods in kube-system are running, completed pods are ignored
+func systemPodsRunning(master machine.IMachine, logWriter io.Writer) error {
+	pods, err := GetPods(master, systemNamespace, "", logWriter)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no pod found in namespace %v", systemNamespace)
+	}
+
+	var notRunning []string
+	for _, pod := range pods {
+		if pod.Phase != podPhaseRunning && pod.Phase != podPhaseSucceeded {
+			notRunning = append(notRunning, fmt.Sprintf("%v(%v)", pod.Name, pod.Phase))
+		}
+	}
+	if len(notRunning) > 0 {
+		return fmt.Errorf("pods in namespace %v are not running: %v", systemNamespace, strings.Join(notRunning, ", "))
+	}
+	return nil
+}
+
+// WaitWorkloadReady waits until the verify pod on every node is running and returns the pods
+func WaitWorkloadReady(master machine.IMachine, nodeNames []string, timeout time.Duration, logWriter io.Writer) ([]Pod, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		pods, err := workloadReady(master, nodeNames, logWriter)
+		if err == nil {
+			return pods, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("wait for verify pods to be running timeout after %v, last error: %v", timeout, err)
+		}
+		time.Sleep(readyPollInterval)
+	}
+}
+
+func workloadReady(master machine.IMachine, nodeNames []string, logWriter io.Writer) ([]Pod, error) {
+	pods, err := GetPods(master, Namespace, "app="+workloadName, logWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePods := make(map[string]Pod)
+	for _, pod := range pods {
+		if pod.Phase == podPhaseRunning && pod.IP != "" {
+			nodePods[pod.NodeName] = pod
+		}
+	}
+
+	readyPods := make([]Pod, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		pod, ok := nodePods[name]
+		if !ok {
+			return nil, fmt.Errorf("verify pod on node %v is not running", name)
+		}
+		readyPods = append(readyPods, pod)
+	}
+	return readyPods, nil
+}
+
+// podExec runs the command in the pod
+func podExec(master machine.IMachine, pod Pod, logWriter io.Writer, args ...string) (string, error) {
+	execArgs := append([]string{"exec", pod.Name}, args...)
+	return run(command.NewKubectlCommand(master, consts.KubeConfigPath, Namespace, execArgs...), logWriter)
+}
+
+// httpGet requests the address from the pod
+func httpGet(master machine.IMachine, pod Pod, host string, port int, logWriter io.Writer) error {
+	url := fmt.Sprintf("http://%v/", deploy.JoinHostPort(host, port))
+	_, err := podExec(master, pod, logWriter, "--", "wget", "-q", "-O", "-", "-T", strconv.Itoa(dialTimeout), url)
+	if err != nil {
+		return fmt.Errorf("failed to request %v from pod %v on node %v: %v", url, pod.Name, pod.NodeName, err)
+	}
+	return nil
+}
+
+// CheckDNS resolves the domain of kubernetes service from the pod
+func CheckDNS(master machine.IMachine, pod Pod, logWriter io.Writer) error {
+	if _, err := podExec(master, pod, logWriter, "--", "nslookup", dnsTestDomain); err != nil {
+		return fmt.Errorf("failed to resolve %v from pod %v on node %v: %v", dnsTestDomain, pod.Name, pod.NodeName, err)
+	}
+	return nil
+}
+
+// CheckPodNetwork requests every pod from the pod on the previous node, so that every node
+// sends and receives traffic across nodes.
+func CheckPodNetwork(master machine.IMachine, pods []Pod, logWriter io.Writer) error {
+	if len(pods) < 2 {
+		fmt.Fprintf(logWriter, "only %v verify pod, skip checking pod network across nodes\n", len(pods))
+		return nil
+	}
+
+	var errs []string
+	for i, dst := range pods {
+		src := pods[(i+len(pods)-1)%len(pods)]
+		if err := httpGet(master, src, dst.IP, workloadPort, logWriter); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// GetServiceAddress returns the cluster ip and the node port of http port of the service
+func GetServiceAddress(master machine.IMachine, namespace, name string, logWriter io.Writer) (clusterIP string, nodePort int, err error) {
+	stdout, err := run(command.NewKubectlCommand(master, consts.KubeConfigPath, namespace,
+		"get", "service", name,
+		"-o", `jsonpath='{.spec.clusterIP} {.spec.ports[?(@.name=="http")].nodePort}'`,
+	), logWriter)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get service %v/%v: %v", namespace, name, err)
+	}
+
+	fields := strings.Fields(stdout)
+	if len(fields) != 2 {
+		return "", 0, fmt.Errorf("unexpected address of service %v/%v: %q", namespace, name, stdout)
+	}
+	nodePort, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid node port of service %v/%v: %v", namespace, name, err)
+	}
+	return fields[0], nodePort, nil
+}
+
+// GetWorkloadServiceAddress returns the cluster ip and node port of the verify service
+func GetWorkloadServiceAddress(master machine.IMachine, logWriter io.Writer) (clusterIP string, nodePort int, err error) {
+	return GetServiceAddress(master, Namespace, workloadName, logWriter)
+}
+
+// CheckServiceClusterIP requests the cluster ip of verify service from every pod
+func CheckServiceClusterIP(master machine.IMachine, pods []Pod, clusterIP string, logWriter io.Writer) error {
+	var errs []string
+	for _, pod := range pods {
+		if err := httpGet(master, pod, clusterIP, servicePort, logWriter); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// TCPReachable connects the address from master
+func TCPReachable(master machine.IMachine, ip string, port int, logWriter io.Writer) error {
+	stdout, _, err := command.NewShellCommand(master, "timeout",
+		fmt.Sprintf("%v bash -c '</dev/tcp/%v/%v' && echo %v || echo %v",
+			dialTimeout, ip, port, tcpReachable, tcpUnreachable)).
+		WithDescription(fmt.Sprintf("connect %v", deploy.JoinHostPort(ip, port))).
+		WithExecuteLogWriter(logWriter).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("failed to connect %v: %v", deploy.JoinHostPort(ip, port), err)
+	}
+	if strings.TrimSpace(string(stdout)) != tcpReachable {
+		return fmt.Errorf("%v is unreachable in %v seconds", deploy.JoinHostPort(ip, port), dialTimeout)
+	}
+	return nil
+}
+
+// Cleanup deletes the workload used to verify the cluster without waiting
+func Cleanup(master machine.IMachine, logWriter io.Writer) error {
+	_, err := run(command.NewKubectlCommand(master, consts.KubeConfigPath, "",
+		"delete", "namespace", Namespace, "--ignore-not-found", "--wait=false",
+	), logWriter)
+	return err
+}
+
+// CheckIngress connects the http port of ingress controller from master. In hostNetwork mode every
+// ingress node listens on the port, while in nodePort mode only the nodes running the controller
+// are reachable since the service keeps the traffic local.
+func CheckIngress(master machine.IMachine, options *pb.IngressOptions, ingressNodes []*pb.Node, logWriter io.Writer) error {
+	if options.GetExposeMode() != ingress.ExposeModeNodePort {
+		var errs []string
+		for _, node := range ingressNodes {
+			if err := TCPReachable(master, node.GetIp(), ingressHTTPPort, logWriter); err != nil {
+				errs = append(errs, fmt.Sprintf("ingress node %v: %v", node.GetName(), err))
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("%v", strings.Join(errs, "; "))
+		}
+		return nil
+	}
+
+	_, nodePort, err := GetServiceAddress(master, ingress.ReleaseNamespace, ingress.ServiceName(options), logWriter)
+	if err != nil {
+		return err
+	}
+	for _, node := range ingressNodes {
+		if err = TCPReachable(master, node.GetIp(), nodePort, logWriter); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("node port %v of ingress controller is unreachable on all ingress nodes, last error: %v", nodePort, err)
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/ingress"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestRenderManifest(t *testing.T) {
+	manifest, err := RenderManifest(&pb.ClusterConfig{})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "name: kpaas-verify")
+	assert.Contains(t, manifest, "kind: DaemonSet")
+	assert.Contains(t, manifest, "image: docker.io/kpaas/busybox:1.31")
+	assert.Contains(t, manifest, "httpd -f -p 8080 -h /tmp")
+	assert.Contains(t, manifest, "type: NodePort")
+
+	// the image is pulled from the image repository of cluster, which may be a private registry
+	manifest, err = RenderManifest(&pb.ClusterConfig{ImageRepository: "registry.example.com:5000/library/"})
+	assert.NoError(t, err)
+	assert.Contains(t, manifest, "image: registry.example.com:5000/library/busybox:1.31")
+}
+
+func TestParsePods(t *testing.T) {
+	pods := parsePods("coredns-1 Running 172.30.0.2 master1\n" +
+		"verify-2 Pending node2\n" +
+		"verify-3 Pending\n" +
+		"\n" +
+		"ipv6-4 Running fd00:30::2 node3\n")
+	assert.Equal(t, []Pod{
+		{Name: "coredns-1", Phase: "Running", IP: "172.30.0.2", NodeName: "master1"},
+		{Name: "verify-2", Phase: "Pending", NodeName: "node2"},
+		{Name: "verify-3", Phase: "Pending"},
+		{Name: "ipv6-4", Phase: "Running", IP: "fd00:30::2", NodeName: "node3"},
+	}, pods)
+}
+
+func TestVerifyWithMockMachine(t *testing.T) {
+	readyPollInterval = time.Millisecond
+
+	master, err := machine.NewMachine(&pb.Node{Name: "master1", Ip: "10.10.10.10"})
+	assert.NoError(t, err)
+	logBuffer := &bytes.Buffer{}
+
+	assert.NoError(t, NodesReady(master, []string{"master1"}, logBuffer))
+	assert.Error(t, NodesReady(master, []string{"master1", "node2"}, logBuffer))
+	assert.NoError(t, WaitSystemPodsRunning(master, time.Second, logBuffer))
+
+	pods, err := WaitWorkloadReady(master, []string{"master1"}, time.Second, logBuffer)
+	assert.NoError(t, err)
+	assert.Equal(t, []Pod{{Name: "pod-master1", Phase: "Running", IP: "10.10.10.10", NodeName: "master1"}}, pods)
+	_, err = WaitWorkloadReady(master, []string{"master1", "node2"}, 10*time.Millisecond, logBuffer)
+	assert.Error(t, err)
+
+	assert.NoError(t, CheckDNS(master, pods[0], logBuffer))
+	assert.NoError(t, CheckPodNetwork(master, pods, logBuffer))
+	assert.Contains(t, logBuffer.String(), "skip checking pod network across nodes")
+	assert.NoError(t, CheckPodNetwork(master, append(pods, Pod{Name: "pod-node2", IP: "fd00:30::2", NodeName: "node2"}), logBuffer))
+	assert.Contains(t, logBuffer.String(), "http://[fd00:30::2]:8080/")
+
+	clusterIP, nodePort, err := GetWorkloadServiceAddress(master, logBuffer)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.96.0.100", clusterIP)
+	assert.Equal(t, 30080, nodePort)
+	assert.NoError(t, CheckServiceClusterIP(master, pods, clusterIP, logBuffer))
+	assert.NoError(t, TCPReachable(master, "10.10.10.10", nodePort, logBuffer))
+
+	ingressNodes := []*pb.Node{{Name: "ingress1", Ip: "10.10.10.11"}}
+	assert.NoError(t, CheckIngress(master, &pb.IngressOptions{}, ingressNodes, logBuffer))
+	assert.NoError(t, CheckIngress(master, &pb.IngressOptions{ExposeMode: ingress.ExposeModeNodePort}, ingressNodes, logBuffer))
+
+	assert.NoError(t, Cleanup(master, logBuffer))
+
+	errorMachine := &machine.MockMachine{Node: &pb.Node{Name: "error", Ip: "10.10.10.10"}}
+	assert.Error(t, NodesReady(errorMachine, []string{"master1"}, logBuffer))
+	assert.Error(t, WaitSystemPodsRunning(errorMachine, 10*time.Millisecond, logBuffer))
+	assert.Error(t, CheckDNS(errorMachine, pods[0], logBuffer))
+	assert.Error(t, TCPReachable(errorMachine, "10.10.10.10", nodePort, logBuffer))
+	assert.Error(t, CheckIngress(errorMachine, &pb.IngressOptions{ExposeMode: ingress.ExposeModeNodePort}, ingressNodes, logBuffer))
+}
@@ -76,13 +76,21 @@ func (operation *JoinCluster) JoinKubernetes() *pb.Error {
 		WithField("node", operation.config.Node.GetNode().GetName()).
 		Debugf("control plane endpoint: %s", controlPlaneEndpoint)
 
+	args := []string{
+		fmt.Sprint("join"),
+		fmt.Sprintf("--token %v", consts.KubernetesToken),
+		fmt.Sprintf("--master %v", controlPlaneEndpoint),
+		fmt.Sprintf("--container-runtime %v", deploy.GetContainerRuntime(operation.config.Cluster)),
+	}
+	if deploy.IsSwapKept(operation.config.Cluster) {
+		args = append(args, "--allow-swap")
+	}
+
 	return op.NewCommandRunner(operation.config.ExecuteLogWriter).RunCommand(
 		command.NewShellCommand(
 			operation.config.Machine,
 			fmt.Sprintf("/bin/bash %s/%s", op.InitRemoteScriptPath, consts.DefaultKubeToolScript),
-			fmt.Sprint("join"),
-			fmt.Sprintf("--token %v", consts.KubernetesToken),
-			fmt.Sprintf("--master %v", controlPlaneEndpoint),
+			args...,
 		),
 		"Join node to cluster failed",     // 添加节点到集群失败
 		"join node to kubernetes cluster", // 添加节点到Kubernetes集群
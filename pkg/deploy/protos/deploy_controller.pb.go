@@ -5,9 +5,11 @@
 Package protos is a generated protocol buffer package.
 
 It is generated from these files:
+
 	deploy_controller.proto
 
 It has these top-level messages:
+
 	Auth
 	SSH
 	Node
@@ -15,7 +17,10 @@ It has these top-level messages:
 	TestConnectionRequest
 	TestConnectionReply
 	NodeCheckConfig
+	NodeCheckThresholds
+	CheckThreshold
 	CheckNodesRequest
+	CustomCheckItem
 	CheckNodesReply
 	CheckItem
 	ItemCheckResult
@@ -27,11 +32,21 @@ It has these top-level messages:
 	NodePortRange
 	Keepalived
 	Loadbalancer
+	BGPPeer
+	KubeVIP
 	KubeAPIServerConnect
 	ClusterConfig
+	AuditConfig
+	EncryptionConfig
+	ComponentConfig
+	RegistryConfig
+	ProxyConfig
+	NodeInitProfile
 	Taint
 	NodeDeployConfig
 	DeployRequest
+	ExecutionPolicy
+	TaskExecution
 	DeployReply
 	GetDeployResultRequest
 	DeployItem
@@ -42,10 +57,28 @@ It has these top-level messages:
 	FetchKubeConfigRequest
 	FetchKubeConfigReply
 	CalicoOptions
+	FlannelOptions
+	CiliumOptions
 	NetworkOptions
+	IngressOptions
 	CheckNetworkRequirementRequest
 	ConnectivityCheckResult
 	CheckNetworkRequirementsReply
+	ReconfigureHARequest
+	ReconfigureHAReply
+	RotateEncryptionKeyRequest
+	RotateEncryptionKeyReply
+	GetRotateEncryptionKeyResultRequest
+	GetRotateEncryptionKeyResultReply
+	UpdateNodeConfigRequest
+	UpdateNodeConfigReply
+	NodeConfigDiff
+	NodeConfigChange
+	PlanDeployReply
+	DeployPlanTask
+	DeployPlanAction
+	DeployPlanFile
+	EtcdCertPlan
 */
 package protos
 
@@ -240,6 +273,9 @@ func (m *TestConnectionReply) GetErr() *Error {
 type NodeCheckConfig struct {
 	Node  *Node    `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
 	Roles []string `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+	// thresholds of checks keyed by role, the strictest of the node roles is used,
+	// built-in thresholds are used for the roles absent.
+	Thresholds map[string]*NodeCheckThresholds `protobuf:"bytes,3,rep,name=thresholds" json:"thresholds,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *NodeCheckConfig) Reset()                    { *m = NodeCheckConfig{} }
@@ -261,16 +297,109 @@ func (m *NodeCheckConfig) GetRoles() []string {
 	return nil
 }
 
+func (m *NodeCheckConfig) GetThresholds() map[string]*NodeCheckThresholds {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+// NodeCheckThresholds contains the thresholds of resource and version checks for a role.
+// A node under the minimum fails the check, a node which meets the minimum but is under
+// the recommended values passes the check with a warning.
+type NodeCheckThresholds struct {
+	Minimum     *CheckThreshold `protobuf:"bytes,1,opt,name=minimum" json:"minimum,omitempty"`
+	Recommended *CheckThreshold `protobuf:"bytes,2,opt,name=recommended" json:"recommended,omitempty"`
+}
+
+func (m *NodeCheckThresholds) Reset()                    { *m = NodeCheckThresholds{} }
+func (m *NodeCheckThresholds) String() string            { return proto.CompactTextString(m) }
+func (*NodeCheckThresholds) ProtoMessage()               {}
+func (*NodeCheckThresholds) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *NodeCheckThresholds) GetMinimum() *CheckThreshold {
+	if m != nil {
+		return m.Minimum
+	}
+	return nil
+}
+
+func (m *NodeCheckThresholds) GetRecommended() *CheckThreshold {
+	if m != nil {
+		return m.Recommended
+	}
+	return nil
+}
+
+// CheckThreshold contains the values of node checks, the built-in value is used for
+// empty or zero fields.
+type CheckThreshold struct {
+	DockerVersion string  `protobuf:"bytes,1,opt,name=dockerVersion" json:"dockerVersion,omitempty"`
+	KernelVersion string  `protobuf:"bytes,2,opt,name=kernelVersion" json:"kernelVersion,omitempty"`
+	CpuCore       float64 `protobuf:"fixed64,3,opt,name=cpuCore" json:"cpuCore,omitempty"`
+	MemoryGiB     float64 `protobuf:"fixed64,4,opt,name=memoryGiB" json:"memoryGiB,omitempty"`
+	RootDiskGiB   float64 `protobuf:"fixed64,5,opt,name=rootDiskGiB" json:"rootDiskGiB,omitempty"`
+}
+
+func (m *CheckThreshold) Reset()                    { *m = CheckThreshold{} }
+func (m *CheckThreshold) String() string            { return proto.CompactTextString(m) }
+func (*CheckThreshold) ProtoMessage()               {}
+func (*CheckThreshold) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *CheckThreshold) GetDockerVersion() string {
+	if m != nil {
+		return m.DockerVersion
+	}
+	return ""
+}
+
+func (m *CheckThreshold) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
+	}
+	return ""
+}
+
+func (m *CheckThreshold) GetCpuCore() float64 {
+	if m != nil {
+		return m.CpuCore
+	}
+	return 0
+}
+
+func (m *CheckThreshold) GetMemoryGiB() float64 {
+	if m != nil {
+		return m.MemoryGiB
+	}
+	return 0
+}
+
+func (m *CheckThreshold) GetRootDiskGiB() float64 {
+	if m != nil {
+		return m.RootDiskGiB
+	}
+	return 0
+}
+
 // CheckNodesRequest contains the request of node pre-checking.
 type CheckNodesRequest struct {
-	Configs        []*NodeCheckConfig `protobuf:"bytes,1,rep,name=configs" json:"configs,omitempty"`
-	NetworkOptions *NetworkOptions    `protobuf:"bytes,2,opt,name=networkOptions" json:"networkOptions,omitempty"`
+	Configs              []*NodeCheckConfig    `protobuf:"bytes,1,rep,name=configs" json:"configs,omitempty"`
+	NetworkOptions       *NetworkOptions       `protobuf:"bytes,2,opt,name=networkOptions" json:"networkOptions,omitempty"`
+	KubeAPIServerConnect *KubeAPIServerConnect `protobuf:"bytes,3,opt,name=kubeAPIServerConnect" json:"kubeAPIServerConnect,omitempty"`
+	// pod and service subnets of the cluster, checked against the routes of nodes
+	PodSubnets     []string `protobuf:"bytes,4,rep,name=podSubnets" json:"podSubnets,omitempty"`
+	ServiceSubnets []string `protobuf:"bytes,5,rep,name=serviceSubnets" json:"serviceSubnets,omitempty"`
+	// user defined checks run on nodes besides the built-in ones, an item replaces
+	// the one with the same name registered in the controller configuration.
+	CustomCheckItems []*CustomCheckItem `protobuf:"bytes,6,rep,name=customCheckItems" json:"customCheckItems,omitempty"`
+	// container runtime of the cluster, could be "docker" or "containerd", docker is used if empty
+	ContainerRuntime string `protobuf:"bytes,7,opt,name=containerRuntime" json:"containerRuntime,omitempty"`
 }
 
 func (m *CheckNodesRequest) Reset()                    { *m = CheckNodesRequest{} }
 func (m *CheckNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesRequest) ProtoMessage()               {}
-func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *CheckNodesRequest) GetConfigs() []*NodeCheckConfig {
 	if m != nil {
@@ -286,6 +415,110 @@ func (m *CheckNodesRequest) GetNetworkOptions() *NetworkOptions {
 	return nil
 }
 
+func (m *CheckNodesRequest) GetKubeAPIServerConnect() *KubeAPIServerConnect {
+	if m != nil {
+		return m.KubeAPIServerConnect
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetPodSubnets() []string {
+	if m != nil {
+		return m.PodSubnets
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetServiceSubnets() []string {
+	if m != nil {
+		return m.ServiceSubnets
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetCustomCheckItems() []*CustomCheckItem {
+	if m != nil {
+		return m.CustomCheckItems
+	}
+	return nil
+}
+
+func (m *CheckNodesRequest) GetContainerRuntime() string {
+	if m != nil {
+		return m.ContainerRuntime
+	}
+	return ""
+}
+
+// CustomCheckItem is a user defined node check, the script is run by bash on node.
+type CustomCheckItem struct {
+	Name        string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Script      string `protobuf:"bytes,3,opt,name=script" json:"script,omitempty"`
+	// regular expression matched against the output of script, the check passes
+	// as long as the script exits with 0 if it's empty.
+	ExpectedOutput string `protobuf:"bytes,4,opt,name=expectedOutput" json:"expectedOutput,omitempty"`
+	// severity could be ["error", "warning"], a failed warning check doesn't fail the node, defaults to "error".
+	Severity string `protobuf:"bytes,5,opt,name=severity" json:"severity,omitempty"`
+	// roles of nodes which the check runs on, all nodes if empty.
+	Roles      []string `protobuf:"bytes,6,rep,name=roles" json:"roles,omitempty"`
+	FixMethods string   `protobuf:"bytes,7,opt,name=fixMethods" json:"fixMethods,omitempty"`
+}
+
+func (m *CustomCheckItem) Reset()                    { *m = CustomCheckItem{} }
+func (m *CustomCheckItem) String() string            { return proto.CompactTextString(m) }
+func (*CustomCheckItem) ProtoMessage()               {}
+func (*CustomCheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *CustomCheckItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomCheckItem) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CustomCheckItem) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *CustomCheckItem) GetExpectedOutput() string {
+	if m != nil {
+		return m.ExpectedOutput
+	}
+	return ""
+}
+
+func (m *CustomCheckItem) GetSeverity() string {
+	if m != nil {
+		return m.Severity
+	}
+	return ""
+}
+
+func (m *CustomCheckItem) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *CustomCheckItem) GetFixMethods() string {
+	if m != nil {
+		return m.FixMethods
+	}
+	return ""
+}
+
 // CheckNodesReply contains the result of node pre-checking.
 type CheckNodesReply struct {
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
@@ -295,7 +528,7 @@ type CheckNodesReply struct {
 func (m *CheckNodesReply) Reset()                    { *m = CheckNodesReply{} }
 func (m *CheckNodesReply) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesReply) ProtoMessage()               {}
-func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *CheckNodesReply) GetAccepted() bool {
 	if m != nil {
@@ -320,7 +553,7 @@ type CheckItem struct {
 func (m *CheckItem) Reset()                    { *m = CheckItem{} }
 func (m *CheckItem) String() string            { return proto.CompactTextString(m) }
 func (*CheckItem) ProtoMessage()               {}
-func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *CheckItem) GetName() string {
 	if m != nil {
@@ -347,7 +580,7 @@ type ItemCheckResult struct {
 func (m *ItemCheckResult) Reset()                    { *m = ItemCheckResult{} }
 func (m *ItemCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*ItemCheckResult) ProtoMessage()               {}
-func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *ItemCheckResult) GetItem() *CheckItem {
 	if m != nil {
@@ -388,7 +621,7 @@ type NodeCheckResult struct {
 func (m *NodeCheckResult) Reset()                    { *m = NodeCheckResult{} }
 func (m *NodeCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*NodeCheckResult) ProtoMessage()               {}
-func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *NodeCheckResult) GetNodeName() string {
 	if m != nil {
@@ -425,7 +658,7 @@ type GetCheckNodesResultRequest struct {
 func (m *GetCheckNodesResultRequest) Reset()                    { *m = GetCheckNodesResultRequest{} }
 func (m *GetCheckNodesResultRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultRequest) ProtoMessage()               {}
-func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 // GetCheckNodesResultReply contains the result of nodes check
 type GetCheckNodesResultReply struct {
@@ -437,7 +670,7 @@ type GetCheckNodesResultReply struct {
 func (m *GetCheckNodesResultReply) Reset()                    { *m = GetCheckNodesResultReply{} }
 func (m *GetCheckNodesResultReply) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultReply) ProtoMessage()               {}
-func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *GetCheckNodesResultReply) GetStatus() string {
 	if m != nil {
@@ -468,7 +701,7 @@ type GetCheckNodesLogRequest struct {
 func (m *GetCheckNodesLogRequest) Reset()                    { *m = GetCheckNodesLogRequest{} }
 func (m *GetCheckNodesLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesLogRequest) ProtoMessage()               {}
-func (*GetCheckNodesLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*GetCheckNodesLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *GetCheckNodesLogRequest) GetNodeName() string {
 	if m != nil {
@@ -485,7 +718,7 @@ type GetCheckNodesLogReply struct {
 func (m *GetCheckNodesLogReply) Reset()                    { *m = GetCheckNodesLogReply{} }
 func (m *GetCheckNodesLogReply) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesLogReply) ProtoMessage()               {}
-func (*GetCheckNodesLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetCheckNodesLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *GetCheckNodesLogReply) GetLog() []byte {
 	if m != nil {
@@ -502,7 +735,7 @@ type NodePortRange struct {
 func (m *NodePortRange) Reset()                    { *m = NodePortRange{} }
 func (m *NodePortRange) String() string            { return proto.CompactTextString(m) }
 func (*NodePortRange) ProtoMessage()               {}
-func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *NodePortRange) GetFrom() uint32 {
 	if m != nil {
@@ -521,12 +754,22 @@ func (m *NodePortRange) GetTo() uint32 {
 type Keepalived struct {
 	Vip              string `protobuf:"bytes,1,opt,name=vip" json:"vip,omitempty"`
 	NetInterfaceName string `protobuf:"bytes,2,opt,name=netInterfaceName" json:"netInterfaceName,omitempty"`
+	// port haproxy listens on for kube-apiserver, default 4443
+	HaproxyPort uint32 `protobuf:"varint,3,opt,name=haproxyPort" json:"haproxyPort,omitempty"`
+	// port of haproxy stats page, default 1936
+	HaproxyStatsPort uint32 `protobuf:"varint,4,opt,name=haproxyStatsPort" json:"haproxyStatsPort,omitempty"`
+	// VRRP virtual router id in [1, 255], default the last octet of vip
+	VirtualRouterID uint32 `protobuf:"varint,5,opt,name=virtualRouterID" json:"virtualRouterID,omitempty"`
+	// VRRP authentication password with at most 8 characters, no authentication if empty
+	AuthPassword string `protobuf:"bytes,6,opt,name=authPassword" json:"authPassword,omitempty"`
+	// interval seconds of keepalived checking haproxy, default 2
+	HealthCheckInterval uint32 `protobuf:"varint,7,opt,name=healthCheckInterval" json:"healthCheckInterval,omitempty"`
 }
 
 func (m *Keepalived) Reset()                    { *m = Keepalived{} }
 func (m *Keepalived) String() string            { return proto.CompactTextString(m) }
 func (*Keepalived) ProtoMessage()               {}
-func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *Keepalived) GetVip() string {
 	if m != nil {
@@ -542,6 +785,41 @@ func (m *Keepalived) GetNetInterfaceName() string {
 	return ""
 }
 
+func (m *Keepalived) GetHaproxyPort() uint32 {
+	if m != nil {
+		return m.HaproxyPort
+	}
+	return 0
+}
+
+func (m *Keepalived) GetHaproxyStatsPort() uint32 {
+	if m != nil {
+		return m.HaproxyStatsPort
+	}
+	return 0
+}
+
+func (m *Keepalived) GetVirtualRouterID() uint32 {
+	if m != nil {
+		return m.VirtualRouterID
+	}
+	return 0
+}
+
+func (m *Keepalived) GetAuthPassword() string {
+	if m != nil {
+		return m.AuthPassword
+	}
+	return ""
+}
+
+func (m *Keepalived) GetHealthCheckInterval() uint32 {
+	if m != nil {
+		return m.HealthCheckInterval
+	}
+	return 0
+}
+
 type Loadbalancer struct {
 	Ip   string `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
 	Port uint32 `protobuf:"varint,2,opt,name=port" json:"port,omitempty"`
@@ -550,7 +828,7 @@ type Loadbalancer struct {
 func (m *Loadbalancer) Reset()                    { *m = Loadbalancer{} }
 func (m *Loadbalancer) String() string            { return proto.CompactTextString(m) }
 func (*Loadbalancer) ProtoMessage()               {}
-func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *Loadbalancer) GetIp() string {
 	if m != nil {
@@ -566,18 +844,117 @@ func (m *Loadbalancer) GetPort() uint32 {
 	return 0
 }
 
+type BGPPeer struct {
+	Address  string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	As       uint32 `protobuf:"varint,2,opt,name=as" json:"as,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *BGPPeer) Reset()                    { *m = BGPPeer{} }
+func (m *BGPPeer) String() string            { return proto.CompactTextString(m) }
+func (*BGPPeer) ProtoMessage()               {}
+func (*BGPPeer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *BGPPeer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BGPPeer) GetAs() uint32 {
+	if m != nil {
+		return m.As
+	}
+	return 0
+}
+
+func (m *BGPPeer) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// KubeVIP contains the config of kube-vip static pods running on master nodes
+type KubeVIP struct {
+	Vip              string `protobuf:"bytes,1,opt,name=vip" json:"vip,omitempty"`
+	NetInterfaceName string `protobuf:"bytes,2,opt,name=netInterfaceName" json:"netInterfaceName,omitempty"`
+	// mode could be ["arp", "bgp"]
+	Mode     string     `protobuf:"bytes,3,opt,name=mode" json:"mode,omitempty"`
+	LocalAS  uint32     `protobuf:"varint,4,opt,name=localAS" json:"localAS,omitempty"`
+	RouterID string     `protobuf:"bytes,5,opt,name=routerID" json:"routerID,omitempty"`
+	BgpPeers []*BGPPeer `protobuf:"bytes,6,rep,name=bgpPeers" json:"bgpPeers,omitempty"`
+	Image    string     `protobuf:"bytes,7,opt,name=image" json:"image,omitempty"`
+}
+
+func (m *KubeVIP) Reset()                    { *m = KubeVIP{} }
+func (m *KubeVIP) String() string            { return proto.CompactTextString(m) }
+func (*KubeVIP) ProtoMessage()               {}
+func (*KubeVIP) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *KubeVIP) GetVip() string {
+	if m != nil {
+		return m.Vip
+	}
+	return ""
+}
+
+func (m *KubeVIP) GetNetInterfaceName() string {
+	if m != nil {
+		return m.NetInterfaceName
+	}
+	return ""
+}
+
+func (m *KubeVIP) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *KubeVIP) GetLocalAS() uint32 {
+	if m != nil {
+		return m.LocalAS
+	}
+	return 0
+}
+
+func (m *KubeVIP) GetRouterID() string {
+	if m != nil {
+		return m.RouterID
+	}
+	return ""
+}
+
+func (m *KubeVIP) GetBgpPeers() []*BGPPeer {
+	if m != nil {
+		return m.BgpPeers
+	}
+	return nil
+}
+
+func (m *KubeVIP) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
 // KubeAPIServerConnect contains the info of how to connect to k8s API server
 type KubeAPIServerConnect struct {
-	// type could be ["firstMasterIP", "keepalived", "loadbalancer"]
+	// type could be ["firstMasterIP", "keepalived", "loadbalancer", "kubevip"]
 	Type         string        `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	Keepalived   *Keepalived   `protobuf:"bytes,2,opt,name=keepalived" json:"keepalived,omitempty"`
 	Loadbalancer *Loadbalancer `protobuf:"bytes,3,opt,name=loadbalancer" json:"loadbalancer,omitempty"`
+	KubeVIP      *KubeVIP      `protobuf:"bytes,4,opt,name=kubeVIP" json:"kubeVIP,omitempty"`
 }
 
 func (m *KubeAPIServerConnect) Reset()                    { *m = KubeAPIServerConnect{} }
 func (m *KubeAPIServerConnect) String() string            { return proto.CompactTextString(m) }
 func (*KubeAPIServerConnect) ProtoMessage()               {}
-func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *KubeAPIServerConnect) GetType() string {
 	if m != nil {
@@ -600,6 +977,13 @@ func (m *KubeAPIServerConnect) GetLoadbalancer() *Loadbalancer {
 	return nil
 }
 
+func (m *KubeAPIServerConnect) GetKubeVIP() *KubeVIP {
+	if m != nil {
+		return m.KubeVIP
+	}
+	return nil
+}
+
 // ClusterConfig contains the configuraton of a cluster
 type ClusterConfig struct {
 	ClusterName          string                `protobuf:"bytes,1,opt,name=clusterName" json:"clusterName,omitempty"`
@@ -607,16 +991,39 @@ type ClusterConfig struct {
 	NodePortRange        *NodePortRange        `protobuf:"bytes,3,opt,name=nodePortRange" json:"nodePortRange,omitempty"`
 	NodeLabels           map[string]string     `protobuf:"bytes,4,rep,name=nodeLabels" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	NodeAnnotations      map[string]string     `protobuf:"bytes,5,rep,name=nodeAnnotations" json:"nodeAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ImageRepository      string                `protobuf:"bytes,6,opt,name=imageRepository" json:"imageRepository,omitempty"`
-	PodSubnet            string                `protobuf:"bytes,7,opt,name=podSubnet" json:"podSubnet,omitempty"`
-	ServiceSubnet        string                `protobuf:"bytes,8,opt,name=serviceSubnet" json:"serviceSubnet,omitempty"`
-	KubernetesVersion    string                `protobuf:"bytes,9,opt,name=kubernetesVersion" json:"kubernetesVersion,omitempty"`
+	// repository of kubernetes and etcd images like registry.example.com/kpaas, docker.io/kpaas is used if empty
+	ImageRepository   string `protobuf:"bytes,6,opt,name=imageRepository" json:"imageRepository,omitempty"`
+	PodSubnet         string `protobuf:"bytes,7,opt,name=podSubnet" json:"podSubnet,omitempty"`
+	ServiceSubnet     string `protobuf:"bytes,8,opt,name=serviceSubnet" json:"serviceSubnet,omitempty"`
+	KubernetesVersion string `protobuf:"bytes,9,opt,name=kubernetesVersion" json:"kubernetesVersion,omitempty"`
+	// options of network deployed after all nodes joined, calico is used if empty
+	NetworkOptions *NetworkOptions `protobuf:"bytes,10,opt,name=networkOptions" json:"networkOptions,omitempty"`
+	// pod and service subnets of each ip family, at most one IPv4 and one IPv6 subnet
+	// makes a dual-stack cluster. podSubnet and serviceSubnet are used if empty.
+	PodSubnets     []string `protobuf:"bytes,11,rep,name=podSubnets" json:"podSubnets,omitempty"`
+	ServiceSubnets []string `protobuf:"bytes,12,rep,name=serviceSubnets" json:"serviceSubnets,omitempty"`
+	// options of ingress controller deployed on ingress nodes, contour is used if empty
+	IngressOptions *IngressOptions `protobuf:"bytes,13,opt,name=ingressOptions" json:"ingressOptions,omitempty"`
+	// container runtime of the cluster, could be "docker" or "containerd", docker is used if empty
+	ContainerRuntime string `protobuf:"bytes,15,opt,name=containerRuntime" json:"containerRuntime,omitempty"`
+	// choices of node initialization, the built-in choices are used if empty
+	NodeInitProfile *NodeInitProfile `protobuf:"bytes,16,opt,name=nodeInitProfile" json:"nodeInitProfile,omitempty"`
+	// proxy used by package manager, container runtime and kubeadm on nodes, no proxy is used if empty
+	Proxy *ProxyConfig `protobuf:"bytes,17,opt,name=proxy" json:"proxy,omitempty"`
+	// registries whose credentials, certificates and mirrors are set up on nodes
+	Registries []*RegistryConfig `protobuf:"bytes,18,rep,name=registries" json:"registries,omitempty"`
+	// arguments and configurations of kubernetes components merged into the generated kubeadm config
+	ComponentConfig *ComponentConfig `protobuf:"bytes,19,opt,name=componentConfig" json:"componentConfig,omitempty"`
+	// audit logging of kube-apiserver, audit is disabled if empty
+	Audit *AuditConfig `protobuf:"bytes,20,opt,name=audit" json:"audit,omitempty"`
+	// encryption of secrets at rest in etcd, secrets are stored unencrypted if empty
+	Encryption *EncryptionConfig `protobuf:"bytes,21,opt,name=encryption" json:"encryption,omitempty"`
 }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
 func (m *ClusterConfig) String() string            { return proto.CompactTextString(m) }
 func (*ClusterConfig) ProtoMessage()               {}
-func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ClusterConfig) GetClusterName() string {
 	if m != nil {
@@ -681,501 +1088,1742 @@ func (m *ClusterConfig) GetKubernetesVersion() string {
 	return ""
 }
 
-type Taint struct {
-	Key    string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
-	Effect string `protobuf:"bytes,3,opt,name=effect" json:"effect,omitempty"`
+func (m *ClusterConfig) GetNetworkOptions() *NetworkOptions {
+	if m != nil {
+		return m.NetworkOptions
+	}
+	return nil
 }
 
-func (m *Taint) Reset()                    { *m = Taint{} }
-func (m *Taint) String() string            { return proto.CompactTextString(m) }
-func (*Taint) ProtoMessage()               {}
-func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *ClusterConfig) GetPodSubnets() []string {
+	if m != nil {
+		return m.PodSubnets
+	}
+	return nil
+}
 
-func (m *Taint) GetKey() string {
+func (m *ClusterConfig) GetServiceSubnets() []string {
 	if m != nil {
-		return m.Key
+		return m.ServiceSubnets
 	}
-	return ""
+	return nil
 }
 
-func (m *Taint) GetValue() string {
+func (m *ClusterConfig) GetIngressOptions() *IngressOptions {
 	if m != nil {
-		return m.Value
+		return m.IngressOptions
 	}
-	return ""
+	return nil
 }
 
-func (m *Taint) GetEffect() string {
+func (m *ClusterConfig) GetContainerRuntime() string {
 	if m != nil {
-		return m.Effect
+		return m.ContainerRuntime
 	}
 	return ""
 }
 
-// NodeDeployConfig contains the deploy configuration for a node
-type NodeDeployConfig struct {
-	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
-	// role cloud be ["etcd", "master", "worker", ...]
-	Roles  []string          `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
-	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Taints []*Taint          `protobuf:"bytes,4,rep,name=taints" json:"taints,omitempty"`
+func (m *ClusterConfig) GetNodeInitProfile() *NodeInitProfile {
+	if m != nil {
+		return m.NodeInitProfile
+	}
+	return nil
 }
 
-func (m *NodeDeployConfig) Reset()                    { *m = NodeDeployConfig{} }
-func (m *NodeDeployConfig) String() string            { return proto.CompactTextString(m) }
-func (*NodeDeployConfig) ProtoMessage()               {}
-func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
-
-func (m *NodeDeployConfig) GetNode() *Node {
+func (m *ClusterConfig) GetProxy() *ProxyConfig {
 	if m != nil {
-		return m.Node
+		return m.Proxy
 	}
 	return nil
 }
 
-func (m *NodeDeployConfig) GetRoles() []string {
+func (m *ClusterConfig) GetRegistries() []*RegistryConfig {
 	if m != nil {
-		return m.Roles
+		return m.Registries
 	}
 	return nil
 }
 
-func (m *NodeDeployConfig) GetLabels() map[string]string {
+func (m *ClusterConfig) GetComponentConfig() *ComponentConfig {
 	if m != nil {
-		return m.Labels
+		return m.ComponentConfig
 	}
 	return nil
 }
 
-func (m *NodeDeployConfig) GetTaints() []*Taint {
+func (m *ClusterConfig) GetAudit() *AuditConfig {
 	if m != nil {
-		return m.Taints
+		return m.Audit
 	}
 	return nil
 }
 
-// DeployRequest contains the request of a deploy.
+func (m *ClusterConfig) GetEncryption() *EncryptionConfig {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+// AuditConfig contains the audit policy and log rotation of kube-apiserver.
+type AuditConfig struct {
+	// level of the built-in policy, could be "None", "Metadata", "Request" or "RequestResponse", ignored if policy is given
+	Level string `protobuf:"bytes,1,opt,name=level" json:"level,omitempty"`
+	// custom audit policy(audit.k8s.io/v1) in yaml or json
+	Policy string `protobuf:"bytes,2,opt,name=policy" json:"policy,omitempty"`
+	// days to keep the rotated audit logs, 30 if 0
+	MaxAge uint32 `protobuf:"varint,3,opt,name=maxAge" json:"maxAge,omitempty"`
+	// number of the rotated audit logs kept, 10 if 0
+	MaxBackup uint32 `protobuf:"varint,4,opt,name=maxBackup" json:"maxBackup,omitempty"`
+	// megabytes of the audit log before it's rotated, 100 if 0
+	MaxSize uint32 `protobuf:"varint,5,opt,name=maxSize" json:"maxSize,omitempty"`
+}
+
+func (m *AuditConfig) Reset()                    { *m = AuditConfig{} }
+func (m *AuditConfig) String() string            { return proto.CompactTextString(m) }
+func (*AuditConfig) ProtoMessage()               {}
+func (*AuditConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *AuditConfig) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *AuditConfig) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *AuditConfig) GetMaxAge() uint32 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *AuditConfig) GetMaxBackup() uint32 {
+	if m != nil {
+		return m.MaxBackup
+	}
+	return 0
+}
+
+func (m *AuditConfig) GetMaxSize() uint32 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+// EncryptionConfig contains the encryption of secrets at rest.
+type EncryptionConfig struct {
+	// provider of the encryption, could be "aescbc" or "secretbox". The key is generated on the first master
+	// and copied to other masters, it's replaced by RotateEncryptionKey.
+	Provider string `protobuf:"bytes,1,opt,name=provider" json:"provider,omitempty"`
+}
+
+func (m *EncryptionConfig) Reset()                    { *m = EncryptionConfig{} }
+func (m *EncryptionConfig) String() string            { return proto.CompactTextString(m) }
+func (*EncryptionConfig) ProtoMessage()               {}
+func (*EncryptionConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *EncryptionConfig) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// ComponentConfig contains the settings passed through to kubernetes components.
+type ComponentConfig struct {
+	// extra command line arguments without the leading "--", arguments managed by kpaas can not be set
+	ApiServerExtraArgs         map[string]string `protobuf:"bytes,1,rep,name=apiServerExtraArgs" json:"apiServerExtraArgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ControllerManagerExtraArgs map[string]string `protobuf:"bytes,2,rep,name=controllerManagerExtraArgs" json:"controllerManagerExtraArgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SchedulerExtraArgs         map[string]string `protobuf:"bytes,3,rep,name=schedulerExtraArgs" json:"schedulerExtraArgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// KubeletConfiguration(kubelet.config.k8s.io/v1beta1) in yaml or json, apiVersion and kind could be omitted
+	KubeletConfiguration string `protobuf:"bytes,4,opt,name=kubeletConfiguration" json:"kubeletConfiguration,omitempty"`
+	// mode of kube-proxy, could be "iptables" or "ipvs", iptables is used if empty and ipvs is used for dual-stack
+	KubeProxyMode string `protobuf:"bytes,5,opt,name=kubeProxyMode" json:"kubeProxyMode,omitempty"`
+}
+
+func (m *ComponentConfig) Reset()                    { *m = ComponentConfig{} }
+func (m *ComponentConfig) String() string            { return proto.CompactTextString(m) }
+func (*ComponentConfig) ProtoMessage()               {}
+func (*ComponentConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *ComponentConfig) GetApiServerExtraArgs() map[string]string {
+	if m != nil {
+		return m.ApiServerExtraArgs
+	}
+	return nil
+}
+
+func (m *ComponentConfig) GetControllerManagerExtraArgs() map[string]string {
+	if m != nil {
+		return m.ControllerManagerExtraArgs
+	}
+	return nil
+}
+
+func (m *ComponentConfig) GetSchedulerExtraArgs() map[string]string {
+	if m != nil {
+		return m.SchedulerExtraArgs
+	}
+	return nil
+}
+
+func (m *ComponentConfig) GetKubeletConfiguration() string {
+	if m != nil {
+		return m.KubeletConfiguration
+	}
+	return ""
+}
+
+func (m *ComponentConfig) GetKubeProxyMode() string {
+	if m != nil {
+		return m.KubeProxyMode
+	}
+	return ""
+}
+
+// RegistryConfig contains the settings of an image registry used by nodes.
+type RegistryConfig struct {
+	// host[:port] of the registry like registry.example.com:5000, docker.io is used to set up mirrors of docker hub
+	Server string `protobuf:"bytes,1,opt,name=server" json:"server,omitempty"`
+	// nodes log in to the registry if username is not empty
+	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+	// PEM encoded CA certificates which sign the certificate of the registry
+	CaBundle string `protobuf:"bytes,4,opt,name=caBundle" json:"caBundle,omitempty"`
+	// registry is accessed by http or by https without certificate verification
+	Insecure bool `protobuf:"varint,5,opt,name=insecure" json:"insecure,omitempty"`
+	// mirror endpoints of the registry like https://mirror.example.com, docker only supports mirrors of docker.io
+	Mirrors []string `protobuf:"bytes,6,rep,name=mirrors" json:"mirrors,omitempty"`
+}
+
+func (m *RegistryConfig) Reset()                    { *m = RegistryConfig{} }
+func (m *RegistryConfig) String() string            { return proto.CompactTextString(m) }
+func (*RegistryConfig) ProtoMessage()               {}
+func (*RegistryConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *RegistryConfig) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *RegistryConfig) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RegistryConfig) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RegistryConfig) GetCaBundle() string {
+	if m != nil {
+		return m.CaBundle
+	}
+	return ""
+}
+
+func (m *RegistryConfig) GetInsecure() bool {
+	if m != nil {
+		return m.Insecure
+	}
+	return false
+}
+
+func (m *RegistryConfig) GetMirrors() []string {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
+// ProxyConfig contains the proxy settings of nodes.
+type ProxyConfig struct {
+	HttpProxy  string `protobuf:"bytes,1,opt,name=httpProxy" json:"httpProxy,omitempty"`
+	HttpsProxy string `protobuf:"bytes,2,opt,name=httpsProxy" json:"httpsProxy,omitempty"`
+	// addresses not proxied, node IPs, control plane endpoint, service and pod subnets are added automatically
+	NoProxy []string `protobuf:"bytes,3,rep,name=noProxy" json:"noProxy,omitempty"`
+}
+
+func (m *ProxyConfig) Reset()                    { *m = ProxyConfig{} }
+func (m *ProxyConfig) String() string            { return proto.CompactTextString(m) }
+func (*ProxyConfig) ProtoMessage()               {}
+func (*ProxyConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *ProxyConfig) GetHttpProxy() string {
+	if m != nil {
+		return m.HttpProxy
+	}
+	return ""
+}
+
+func (m *ProxyConfig) GetHttpsProxy() string {
+	if m != nil {
+		return m.HttpsProxy
+	}
+	return ""
+}
+
+func (m *ProxyConfig) GetNoProxy() []string {
+	if m != nil {
+		return m.NoProxy
+	}
+	return nil
+}
+
+// NodeInitProfile contains the choices of node initialization, the built-in choice is used for an empty field.
+type NodeInitProfile struct {
+	// timezone set on nodes, Asia/Shanghai is used if empty
+	Timezone string `protobuf:"bytes,1,opt,name=timezone" json:"timezone,omitempty"`
+	// NTP servers of chronyd or systemd-timesyncd, time sync service is left as it is if empty
+	NtpServers []string `protobuf:"bytes,2,rep,name=ntpServers" json:"ntpServers,omitempty"`
+	// sysctl keys and values set and persisted after the built-in ones
+	Sysctl map[string]string `protobuf:"bytes,3,rep,name=sysctl" json:"sysctl,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// firewall mode could be "disable" or "open-ports", firewall is disabled if empty,
+	// the ports of node roles, network and NodePort range are opened if it's "open-ports"
+	FirewallMode string `protobuf:"bytes,4,opt,name=firewallMode" json:"firewallMode,omitempty"`
+	// SELinux mode could be "disabled", "permissive" or "enforcing", SELinux is disabled if empty
+	SelinuxMode string `protobuf:"bytes,5,opt,name=selinuxMode" json:"selinuxMode,omitempty"`
+	// swap policy could be "disable" or "keep", swap is disabled if empty, kubelet runs with swap if it's kept
+	SwapPolicy string `protobuf:"bytes,6,opt,name=swapPolicy" json:"swapPolicy,omitempty"`
+	// hostname policy could be "node-name" or "keep", hostname is set to node name if empty,
+	// hostname must be the same as node name if it's kept
+	HostnamePolicy string `protobuf:"bytes,7,opt,name=hostnamePolicy" json:"hostnamePolicy,omitempty"`
+	// init items enabled or disabled by name, the items absent are enabled
+	Items map[string]bool `protobuf:"bytes,8,rep,name=items" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *NodeInitProfile) Reset()                    { *m = NodeInitProfile{} }
+func (m *NodeInitProfile) String() string            { return proto.CompactTextString(m) }
+func (*NodeInitProfile) ProtoMessage()               {}
+func (*NodeInitProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *NodeInitProfile) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *NodeInitProfile) GetNtpServers() []string {
+	if m != nil {
+		return m.NtpServers
+	}
+	return nil
+}
+
+func (m *NodeInitProfile) GetSysctl() map[string]string {
+	if m != nil {
+		return m.Sysctl
+	}
+	return nil
+}
+
+func (m *NodeInitProfile) GetFirewallMode() string {
+	if m != nil {
+		return m.FirewallMode
+	}
+	return ""
+}
+
+func (m *NodeInitProfile) GetSelinuxMode() string {
+	if m != nil {
+		return m.SelinuxMode
+	}
+	return ""
+}
+
+func (m *NodeInitProfile) GetSwapPolicy() string {
+	if m != nil {
+		return m.SwapPolicy
+	}
+	return ""
+}
+
+func (m *NodeInitProfile) GetHostnamePolicy() string {
+	if m != nil {
+		return m.HostnamePolicy
+	}
+	return ""
+}
+
+func (m *NodeInitProfile) GetItems() map[string]bool {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type Taint struct {
+	Key    string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	Effect string `protobuf:"bytes,3,opt,name=effect" json:"effect,omitempty"`
+}
+
+func (m *Taint) Reset()                    { *m = Taint{} }
+func (m *Taint) String() string            { return proto.CompactTextString(m) }
+func (*Taint) ProtoMessage()               {}
+func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *Taint) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Taint) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Taint) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+// NodeDeployConfig contains the deploy configuration for a node
+type NodeDeployConfig struct {
+	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	// role cloud be ["etcd", "master", "worker", ...]
+	Roles  []string          `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Taints []*Taint          `protobuf:"bytes,4,rep,name=taints" json:"taints,omitempty"`
+}
+
+func (m *NodeDeployConfig) Reset()                    { *m = NodeDeployConfig{} }
+func (m *NodeDeployConfig) String() string            { return proto.CompactTextString(m) }
+func (*NodeDeployConfig) ProtoMessage()               {}
+func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *NodeDeployConfig) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *NodeDeployConfig) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *NodeDeployConfig) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *NodeDeployConfig) GetTaints() []*Taint {
+	if m != nil {
+		return m.Taints
+	}
+	return nil
+}
+
+// DeployRequest contains the request of a deploy.
 type DeployRequest struct {
 	NodeConfigs   []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
 	ClusterConfig *ClusterConfig      `protobuf:"bytes,2,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	// execution policies keyed by task type, e.g. "NodeInit", "DeployWorker", actions and sub tasks of tasks without a policy are all executed at once
+	ExecutionPolicies map[string]*ExecutionPolicy `protobuf:"bytes,3,rep,name=executionPolicies" json:"executionPolicies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *DeployRequest) Reset()                    { *m = DeployRequest{} }
+func (m *DeployRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeployRequest) ProtoMessage()               {}
+func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *DeployRequest) GetNodeConfigs() []*NodeDeployConfig {
+	if m != nil {
+		return m.NodeConfigs
+	}
+	return nil
+}
+
+func (m *DeployRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
+}
+
+func (m *DeployRequest) GetExecutionPolicies() map[string]*ExecutionPolicy {
+	if m != nil {
+		return m.ExecutionPolicies
+	}
+	return nil
+}
+
+// ExecutionPolicy limits how the actions or the same priority sub tasks of a task are executed.
+type ExecutionPolicy struct {
+	// max number of actions or sub tasks executed at the same time, 0 means no limit
+	Concurrency int32 `protobuf:"varint,1,opt,name=concurrency" json:"concurrency,omitempty"`
+	// actions or sub tasks are executed batch by batch, a batch starts after the previous one finished, 0 means all in one batch
+	BatchSize int32 `protobuf:"varint,2,opt,name=batchSize" json:"batchSize,omitempty"`
+	// percentage of failed actions or sub tasks to stop executing the remaining batches, 0 means never stop
+	FailureThreshold int32 `protobuf:"varint,3,opt,name=failureThreshold" json:"failureThreshold,omitempty"`
+}
+
+func (m *ExecutionPolicy) Reset()                    { *m = ExecutionPolicy{} }
+func (m *ExecutionPolicy) String() string            { return proto.CompactTextString(m) }
+func (*ExecutionPolicy) ProtoMessage()               {}
+func (*ExecutionPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ExecutionPolicy) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+func (m *ExecutionPolicy) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *ExecutionPolicy) GetFailureThreshold() int32 {
+	if m != nil {
+		return m.FailureThreshold
+	}
+	return 0
+}
+
+// TaskExecution contains how the actions or sub tasks of a task with an execution policy were executed.
+type TaskExecution struct {
+	TaskName  string           `protobuf:"bytes,1,opt,name=taskName" json:"taskName,omitempty"`
+	TaskType  string           `protobuf:"bytes,2,opt,name=taskType" json:"taskType,omitempty"`
+	Policy    *ExecutionPolicy `protobuf:"bytes,3,opt,name=policy" json:"policy,omitempty"`
+	Total     int32            `protobuf:"varint,4,opt,name=total" json:"total,omitempty"`
+	Succeeded int32            `protobuf:"varint,5,opt,name=succeeded" json:"succeeded,omitempty"`
+	Failed    int32            `protobuf:"varint,6,opt,name=failed" json:"failed,omitempty"`
+	// number of actions or sub tasks not executed since the failure threshold was reached
+	Skipped                 int32 `protobuf:"varint,7,opt,name=skipped" json:"skipped,omitempty"`
+	FailureThresholdReached bool  `protobuf:"varint,8,opt,name=failureThresholdReached" json:"failureThresholdReached,omitempty"`
+}
+
+func (m *TaskExecution) Reset()                    { *m = TaskExecution{} }
+func (m *TaskExecution) String() string            { return proto.CompactTextString(m) }
+func (*TaskExecution) ProtoMessage()               {}
+func (*TaskExecution) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *TaskExecution) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
+func (m *TaskExecution) GetTaskType() string {
+	if m != nil {
+		return m.TaskType
+	}
+	return ""
+}
+
+func (m *TaskExecution) GetPolicy() *ExecutionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *TaskExecution) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *TaskExecution) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *TaskExecution) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *TaskExecution) GetSkipped() int32 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *TaskExecution) GetFailureThresholdReached() bool {
+	if m != nil {
+		return m.FailureThresholdReached
+	}
+	return false
+}
+
+// DeployReply contains the response of a deploy request.
+type DeployReply struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *DeployReply) Reset()                    { *m = DeployReply{} }
+func (m *DeployReply) String() string            { return proto.CompactTextString(m) }
+func (*DeployReply) ProtoMessage()               {}
+func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *DeployReply) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *DeployReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+// GetDeployResultRequest contains the request of getting deploy result.
+type GetDeployResultRequest struct {
+}
+
+func (m *GetDeployResultRequest) Reset()                    { *m = GetDeployResultRequest{} }
+func (m *GetDeployResultRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployResultRequest) ProtoMessage()               {}
+func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+// DeployItem represents a deploy action in a node for a role.
+type DeployItem struct {
+	Role                string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	NodeName            string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+	FailureCanBeIgnored bool   `protobuf:"varint,3,opt,name=failureCanBeIgnored" json:"failureCanBeIgnored,omitempty"`
+}
+
+func (m *DeployItem) Reset()                    { *m = DeployItem{} }
+func (m *DeployItem) String() string            { return proto.CompactTextString(m) }
+func (*DeployItem) ProtoMessage()               {}
+func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *DeployItem) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *DeployItem) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *DeployItem) GetFailureCanBeIgnored() bool {
+	if m != nil {
+		return m.FailureCanBeIgnored
+	}
+	return false
+}
+
+// DeployItemResult represents the deploy result in a node for a role.
+type DeployItemResult struct {
+	DeployItem *DeployItem `protobuf:"bytes,1,opt,name=deployItem" json:"deployItem,omitempty"`
+	Status     string      `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Err        *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+	Logs       string      `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+}
+
+func (m *DeployItemResult) Reset()                    { *m = DeployItemResult{} }
+func (m *DeployItemResult) String() string            { return proto.CompactTextString(m) }
+func (*DeployItemResult) ProtoMessage()               {}
+func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *DeployItemResult) GetDeployItem() *DeployItem {
+	if m != nil {
+		return m.DeployItem
+	}
+	return nil
+}
+
+func (m *DeployItemResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeployItemResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *DeployItemResult) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+// GetDeployResultReply represents the result of a deploy
+type GetDeployResultReply struct {
+	Status string              `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	Err    *Error              `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	Items  []*DeployItemResult `protobuf:"bytes,3,rep,name=items" json:"items,omitempty"`
+	// results of the smoke test run against the cluster after all nodes are deployed
+	VerifyItems []*ItemCheckResult `protobuf:"bytes,4,rep,name=verifyItems" json:"verifyItems,omitempty"`
+	// executions of the tasks with an execution policy
+	Executions []*TaskExecution `protobuf:"bytes,5,rep,name=executions" json:"executions,omitempty"`
+}
+
+func (m *GetDeployResultReply) Reset()                    { *m = GetDeployResultReply{} }
+func (m *GetDeployResultReply) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployResultReply) ProtoMessage()               {}
+func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *GetDeployResultReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetDeployResultReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *GetDeployResultReply) GetItems() []*DeployItemResult {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *GetDeployResultReply) GetVerifyItems() []*ItemCheckResult {
+	if m != nil {
+		return m.VerifyItems
+	}
+	return nil
+}
+
+func (m *GetDeployResultReply) GetExecutions() []*TaskExecution {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+// GetDeployLogRequest contains the request of getting deploy log.
+type GetDeployLogRequest struct {
+	Role     string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	NodeName string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+}
+
+func (m *GetDeployLogRequest) Reset()                    { *m = GetDeployLogRequest{} }
+func (m *GetDeployLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployLogRequest) ProtoMessage()               {}
+func (*GetDeployLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *GetDeployLogRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *GetDeployLogRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+// GetDeployLogReply represents the response of getting deploy log.
+type GetDeployLogReply struct {
+	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *GetDeployLogReply) Reset()                    { *m = GetDeployLogReply{} }
+func (m *GetDeployLogReply) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployLogReply) ProtoMessage()               {}
+func (*GetDeployLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *GetDeployLogReply) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// FetchKubeConfigRequest contains the request of getting kube config.
+type FetchKubeConfigRequest struct {
+	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+}
+
+func (m *FetchKubeConfigRequest) Reset()                    { *m = FetchKubeConfigRequest{} }
+func (m *FetchKubeConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*FetchKubeConfigRequest) ProtoMessage()               {}
+func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *FetchKubeConfigRequest) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+// FetchKubeConfigReply contains the response of getting kube config.
+type FetchKubeConfigReply struct {
+	KubeConfig []byte `protobuf:"bytes,1,opt,name=kubeConfig,proto3" json:"kubeConfig,omitempty"`
+	Err        *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *FetchKubeConfigReply) Reset()                    { *m = FetchKubeConfigReply{} }
+func (m *FetchKubeConfigReply) String() string            { return proto.CompactTextString(m) }
+func (*FetchKubeConfigReply) ProtoMessage()               {}
+func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *FetchKubeConfigReply) GetKubeConfig() []byte {
+	if m != nil {
+		return m.KubeConfig
+	}
+	return nil
+}
+
+func (m *FetchKubeConfigReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+// CalicoOptions options for checking requirements for deploying calico network.
+type CalicoOptions struct {
+	// if checkConnectivityAll = true, check connectivity between each pair of nodes bidirectionally.
+	CheckConnectivityAll bool `protobuf:"varint,1,opt,name=checkConnectivityAll" json:"checkConnectivityAll,omitempty"`
+	// EncapsulationMode could be ["vxlan","ipip","none"].
+	EncapsulationMode string `protobuf:"bytes,2,opt,name=encapsulationMode" json:"encapsulationMode,omitempty"`
+	VxlanPort         uint32 `protobuf:"varint,10,opt,name=vxlanPort" json:"vxlanPort,omitempty"`
+	// initial IP pool of pods, pod subnet of cluster is used if empty
+	InitialPodIPs string `protobuf:"bytes,11,opt,name=initialPodIPs" json:"initialPodIPs,omitempty"`
+	VethMtu       uint32 `protobuf:"varint,12,opt,name=vethMtu" json:"vethMtu,omitempty"`
+	// ipDetectionMethod could be ["from-kubernetes", "first-found", "interface"]
+	IpDetectionMethod string `protobuf:"bytes,13,opt,name=ipDetectionMethod" json:"ipDetectionMethod,omitempty"`
+	// regex of interface name, used when ipDetectionMethod is "interface"
+	IpDetectionInterface string `protobuf:"bytes,14,opt,name=ipDetectionInterface" json:"ipDetectionInterface,omitempty"`
+	// initial IPv6 pool of pods, IPv6 pod subnet of cluster is used if empty
+	InitialPodIPv6Pool string `protobuf:"bytes,15,opt,name=initialPodIPv6Pool" json:"initialPodIPv6Pool,omitempty"`
+}
+
+func (m *CalicoOptions) Reset()                    { *m = CalicoOptions{} }
+func (m *CalicoOptions) String() string            { return proto.CompactTextString(m) }
+func (*CalicoOptions) ProtoMessage()               {}
+func (*CalicoOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *CalicoOptions) GetCheckConnectivityAll() bool {
+	if m != nil {
+		return m.CheckConnectivityAll
+	}
+	return false
+}
+
+func (m *CalicoOptions) GetEncapsulationMode() string {
+	if m != nil {
+		return m.EncapsulationMode
+	}
+	return ""
+}
+
+func (m *CalicoOptions) GetVxlanPort() uint32 {
+	if m != nil {
+		return m.VxlanPort
+	}
+	return 0
+}
+
+func (m *CalicoOptions) GetInitialPodIPs() string {
+	if m != nil {
+		return m.InitialPodIPs
+	}
+	return ""
+}
+
+func (m *CalicoOptions) GetVethMtu() uint32 {
+	if m != nil {
+		return m.VethMtu
+	}
+	return 0
+}
+
+func (m *CalicoOptions) GetIpDetectionMethod() string {
+	if m != nil {
+		return m.IpDetectionMethod
+	}
+	return ""
+}
+
+func (m *CalicoOptions) GetIpDetectionInterface() string {
+	if m != nil {
+		return m.IpDetectionInterface
+	}
+	return ""
+}
+
+func (m *CalicoOptions) GetInitialPodIPv6Pool() string {
+	if m != nil {
+		return m.InitialPodIPv6Pool
+	}
+	return ""
+}
+
+type FlannelOptions struct {
+	// backend could be ["vxlan", "host-gw"].
+	Backend string `protobuf:"bytes,1,opt,name=backend" json:"backend,omitempty"`
+	// UDP port of vxlan, used when backend is "vxlan".
+	VxlanPort uint32 `protobuf:"varint,2,opt,name=vxlanPort" json:"vxlanPort,omitempty"`
+	// name of interface used for inter-host communication, the interface of default route is used if empty.
+	Iface string `protobuf:"bytes,3,opt,name=iface" json:"iface,omitempty"`
+}
+
+func (m *FlannelOptions) Reset()                    { *m = FlannelOptions{} }
+func (m *FlannelOptions) String() string            { return proto.CompactTextString(m) }
+func (*FlannelOptions) ProtoMessage()               {}
+func (*FlannelOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+
+func (m *FlannelOptions) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *FlannelOptions) GetVxlanPort() uint32 {
+	if m != nil {
+		return m.VxlanPort
+	}
+	return 0
+}
+
+func (m *FlannelOptions) GetIface() string {
+	if m != nil {
+		return m.Iface
+	}
+	return ""
+}
+
+type CiliumOptions struct {
+	// tunnelMode could be ["vxlan", "geneve", "disabled"]. "disabled" means native routing.
+	TunnelMode string `protobuf:"bytes,1,opt,name=tunnelMode" json:"tunnelMode,omitempty"`
+	Mtu        uint32 `protobuf:"varint,2,opt,name=mtu" json:"mtu,omitempty"`
+	// CIDR in which native routing is possible, used when tunnelMode is "disabled".
+	NativeRoutingCIDR string `protobuf:"bytes,3,opt,name=nativeRoutingCIDR" json:"nativeRoutingCIDR,omitempty"`
+}
+
+func (m *CiliumOptions) Reset()                    { *m = CiliumOptions{} }
+func (m *CiliumOptions) String() string            { return proto.CompactTextString(m) }
+func (*CiliumOptions) ProtoMessage()               {}
+func (*CiliumOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *CiliumOptions) GetTunnelMode() string {
+	if m != nil {
+		return m.TunnelMode
+	}
+	return ""
+}
+
+func (m *CiliumOptions) GetMtu() uint32 {
+	if m != nil {
+		return m.Mtu
+	}
+	return 0
+}
+
+func (m *CiliumOptions) GetNativeRoutingCIDR() string {
+	if m != nil {
+		return m.NativeRoutingCIDR
+	}
+	return ""
+}
+
+// NetworkOptions options for deploying network. affects checked items.
+type NetworkOptions struct {
+	NetworkType string `protobuf:"bytes,1,opt,name=networkType" json:"networkType,omitempty"`
+	// options for a specified network type. Starts from 10 to reserve field 2-9.
+	CalicoOptions  *CalicoOptions  `protobuf:"bytes,10,opt,name=calicoOptions" json:"calicoOptions,omitempty"`
+	FlannelOptions *FlannelOptions `protobuf:"bytes,11,opt,name=flannelOptions" json:"flannelOptions,omitempty"`
+	CiliumOptions  *CiliumOptions  `protobuf:"bytes,12,opt,name=ciliumOptions" json:"ciliumOptions,omitempty"`
+}
+
+func (m *NetworkOptions) Reset()                    { *m = NetworkOptions{} }
+func (m *NetworkOptions) String() string            { return proto.CompactTextString(m) }
+func (*NetworkOptions) ProtoMessage()               {}
+func (*NetworkOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *NetworkOptions) GetNetworkType() string {
+	if m != nil {
+		return m.NetworkType
+	}
+	return ""
+}
+
+func (m *NetworkOptions) GetCalicoOptions() *CalicoOptions {
+	if m != nil {
+		return m.CalicoOptions
+	}
+	return nil
+}
+
+func (m *NetworkOptions) GetFlannelOptions() *FlannelOptions {
+	if m != nil {
+		return m.FlannelOptions
+	}
+	return nil
+}
+
+func (m *NetworkOptions) GetCiliumOptions() *CiliumOptions {
+	if m != nil {
+		return m.CiliumOptions
+	}
+	return nil
+}
+
+// IngressOptions options for deploying ingress controller on ingress nodes.
+type IngressOptions struct {
+	// ingressType could be ["contour", "nginx"], default contour
+	IngressType string `protobuf:"bytes,1,opt,name=ingressType" json:"ingressType,omitempty"`
+	// exposeMode could be ["hostNetwork", "nodePort"], default hostNetwork.
+	// In hostNetwork mode the controller runs as a DaemonSet on every ingress node,
+	// in nodePort mode it runs as a Deployment and is exposed by a NodePort service.
+	ExposeMode string `protobuf:"bytes,2,opt,name=exposeMode" json:"exposeMode,omitempty"`
+	// replicas of controller in nodePort mode, default 2
+	Replicas uint32 `protobuf:"varint,3,opt,name=replicas" json:"replicas,omitempty"`
+	// node ports of http and https in nodePort mode, allocated by kubernetes if 0
+	HttpNodePort  uint32 `protobuf:"varint,4,opt,name=httpNodePort" json:"httpNodePort,omitempty"`
+	HttpsNodePort uint32 `protobuf:"varint,5,opt,name=httpsNodePort" json:"httpsNodePort,omitempty"`
+	// default TLS certificate in format of "namespace/secretName"
+	DefaultTLSCertificate string `protobuf:"bytes,6,opt,name=defaultTLSCertificate" json:"defaultTLSCertificate,omitempty"`
+	// images of controller (contour or nginx-ingress-controller) and proxy (envoy), the defaults of chart are used if empty
+	ControllerImage string `protobuf:"bytes,7,opt,name=controllerImage" json:"controllerImage,omitempty"`
+	ProxyImage      string `protobuf:"bytes,8,opt,name=proxyImage" json:"proxyImage,omitempty"`
+}
+
+func (m *IngressOptions) Reset()                    { *m = IngressOptions{} }
+func (m *IngressOptions) String() string            { return proto.CompactTextString(m) }
+func (*IngressOptions) ProtoMessage()               {}
+func (*IngressOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *IngressOptions) GetIngressType() string {
+	if m != nil {
+		return m.IngressType
+	}
+	return ""
+}
+
+func (m *IngressOptions) GetExposeMode() string {
+	if m != nil {
+		return m.ExposeMode
+	}
+	return ""
+}
+
+func (m *IngressOptions) GetReplicas() uint32 {
+	if m != nil {
+		return m.Replicas
+	}
+	return 0
+}
+
+func (m *IngressOptions) GetHttpNodePort() uint32 {
+	if m != nil {
+		return m.HttpNodePort
+	}
+	return 0
+}
+
+func (m *IngressOptions) GetHttpsNodePort() uint32 {
+	if m != nil {
+		return m.HttpsNodePort
+	}
+	return 0
+}
+
+func (m *IngressOptions) GetDefaultTLSCertificate() string {
+	if m != nil {
+		return m.DefaultTLSCertificate
+	}
+	return ""
+}
+
+func (m *IngressOptions) GetControllerImage() string {
+	if m != nil {
+		return m.ControllerImage
+	}
+	return ""
+}
+
+func (m *IngressOptions) GetProxyImage() string {
+	if m != nil {
+		return m.ProxyImage
+	}
+	return ""
+}
+
+// CheckNetworkRequirementRequest nodes and network options when checking
+type CheckNetworkRequirementRequest struct {
+	Nodes   []*Node         `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	Options *NetworkOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+}
+
+func (m *CheckNetworkRequirementRequest) Reset()         { *m = CheckNetworkRequirementRequest{} }
+func (m *CheckNetworkRequirementRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckNetworkRequirementRequest) ProtoMessage()    {}
+func (*CheckNetworkRequirementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{51}
+}
+
+func (m *CheckNetworkRequirementRequest) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *CheckNetworkRequirementRequest) GetOptions() *NetworkOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ConnectivityCheckResult struct {
+	SourceNodeName      string             `protobuf:"bytes,1,opt,name=SourceNodeName" json:"SourceNodeName,omitempty"`
+	DestinationNodeName string             `protobuf:"bytes,2,opt,name=DestinationNodeName" json:"DestinationNodeName,omitempty"`
+	Status              string             `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	Err                 *Error             `protobuf:"bytes,4,opt,name=err" json:"err,omitempty"`
+	Items               []*ItemCheckResult `protobuf:"bytes,5,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *ConnectivityCheckResult) Reset()                    { *m = ConnectivityCheckResult{} }
+func (m *ConnectivityCheckResult) String() string            { return proto.CompactTextString(m) }
+func (*ConnectivityCheckResult) ProtoMessage()               {}
+func (*ConnectivityCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *ConnectivityCheckResult) GetSourceNodeName() string {
+	if m != nil {
+		return m.SourceNodeName
+	}
+	return ""
+}
+
+func (m *ConnectivityCheckResult) GetDestinationNodeName() string {
+	if m != nil {
+		return m.DestinationNodeName
+	}
+	return ""
+}
+
+func (m *ConnectivityCheckResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ConnectivityCheckResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *ConnectivityCheckResult) GetItems() []*ItemCheckResult {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// CheckNetworkRequirementsReply
+type CheckNetworkRequirementsReply struct {
+	Passed         bool                       `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	Err            *Error                     `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	Nodes          []*NodeCheckResult         `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
+	Connectivities []*ConnectivityCheckResult `protobuf:"bytes,4,rep,name=connectivities" json:"connectivities,omitempty"`
+}
+
+func (m *CheckNetworkRequirementsReply) Reset()                    { *m = CheckNetworkRequirementsReply{} }
+func (m *CheckNetworkRequirementsReply) String() string            { return proto.CompactTextString(m) }
+func (*CheckNetworkRequirementsReply) ProtoMessage()               {}
+func (*CheckNetworkRequirementsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *CheckNetworkRequirementsReply) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *CheckNetworkRequirementsReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *CheckNetworkRequirementsReply) GetNodes() []*NodeCheckResult {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *CheckNetworkRequirementsReply) GetConnectivities() []*ConnectivityCheckResult {
+	if m != nil {
+		return m.Connectivities
+	}
+	return nil
+}
+
+// ReconfigureHARequest contains the current nodes of cluster to regenerate haproxy and keepalived config.
+type ReconfigureHARequest struct {
+	NodeConfigs   []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
+	ClusterConfig *ClusterConfig      `protobuf:"bytes,2,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
 }
 
-func (m *DeployRequest) Reset()                    { *m = DeployRequest{} }
-func (m *DeployRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeployRequest) ProtoMessage()               {}
-func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (m *ReconfigureHARequest) Reset()                    { *m = ReconfigureHARequest{} }
+func (m *ReconfigureHARequest) String() string            { return proto.CompactTextString(m) }
+func (*ReconfigureHARequest) ProtoMessage()               {}
+func (*ReconfigureHARequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
-func (m *DeployRequest) GetNodeConfigs() []*NodeDeployConfig {
+func (m *ReconfigureHARequest) GetNodeConfigs() []*NodeDeployConfig {
 	if m != nil {
 		return m.NodeConfigs
 	}
 	return nil
 }
 
-func (m *DeployRequest) GetClusterConfig() *ClusterConfig {
+func (m *ReconfigureHARequest) GetClusterConfig() *ClusterConfig {
 	if m != nil {
 		return m.ClusterConfig
 	}
 	return nil
 }
 
-// DeployReply contains the response of a deploy request.
-type DeployReply struct {
+// ReconfigureHAReply contains the result of reconfiguring haproxy and keepalived.
+type ReconfigureHAReply struct {
+	Passed bool   `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	Err    *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *ReconfigureHAReply) Reset()                    { *m = ReconfigureHAReply{} }
+func (m *ReconfigureHAReply) String() string            { return proto.CompactTextString(m) }
+func (*ReconfigureHAReply) ProtoMessage()               {}
+func (*ReconfigureHAReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *ReconfigureHAReply) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *ReconfigureHAReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+// RotateEncryptionKeyRequest contains the nodes and cluster config of a cluster whose encryption key is rotated.
+type RotateEncryptionKeyRequest struct {
+	NodeConfigs   []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
+	ClusterConfig *ClusterConfig      `protobuf:"bytes,2,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+}
+
+func (m *RotateEncryptionKeyRequest) Reset()                    { *m = RotateEncryptionKeyRequest{} }
+func (m *RotateEncryptionKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyRequest) ProtoMessage()               {}
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *RotateEncryptionKeyRequest) GetNodeConfigs() []*NodeDeployConfig {
+	if m != nil {
+		return m.NodeConfigs
+	}
+	return nil
+}
+
+func (m *RotateEncryptionKeyRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
+}
+
+// RotateEncryptionKeyReply tells whether the rotation is accepted, masters are restarted in turn
+// so the result is got by GetRotateEncryptionKeyResult.
+type RotateEncryptionKeyReply struct {
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
 	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
 }
 
-func (m *DeployReply) Reset()                    { *m = DeployReply{} }
-func (m *DeployReply) String() string            { return proto.CompactTextString(m) }
-func (*DeployReply) ProtoMessage()               {}
-func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *RotateEncryptionKeyReply) Reset()                    { *m = RotateEncryptionKeyReply{} }
+func (m *RotateEncryptionKeyReply) String() string            { return proto.CompactTextString(m) }
+func (*RotateEncryptionKeyReply) ProtoMessage()               {}
+func (*RotateEncryptionKeyReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
-func (m *DeployReply) GetAccepted() bool {
+func (m *RotateEncryptionKeyReply) GetAccepted() bool {
 	if m != nil {
 		return m.Accepted
 	}
 	return false
 }
 
-func (m *DeployReply) GetErr() *Error {
+func (m *RotateEncryptionKeyReply) GetErr() *Error {
 	if m != nil {
 		return m.Err
 	}
 	return nil
 }
 
-// GetDeployResultRequest contains the request of getting deploy result.
-type GetDeployResultRequest struct {
+// GetRotateEncryptionKeyResultRequest contains the request of getting the result of the latest rotation.
+type GetRotateEncryptionKeyResultRequest struct {
 }
 
-func (m *GetDeployResultRequest) Reset()                    { *m = GetDeployResultRequest{} }
-func (m *GetDeployResultRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployResultRequest) ProtoMessage()               {}
-func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (m *GetRotateEncryptionKeyResultRequest) Reset()         { *m = GetRotateEncryptionKeyResultRequest{} }
+func (m *GetRotateEncryptionKeyResultRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRotateEncryptionKeyResultRequest) ProtoMessage()    {}
+func (*GetRotateEncryptionKeyResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{58}
+}
 
-// DeployItem represents a deploy action in a node for a role.
-type DeployItem struct {
-	Role                string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-	NodeName            string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
-	FailureCanBeIgnored bool   `protobuf:"varint,3,opt,name=failureCanBeIgnored" json:"failureCanBeIgnored,omitempty"`
+// GetRotateEncryptionKeyResultReply represents the result of the latest rotation.
+type GetRotateEncryptionKeyResultReply struct {
+	Status string `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	Err    *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
 }
 
-func (m *DeployItem) Reset()                    { *m = DeployItem{} }
-func (m *DeployItem) String() string            { return proto.CompactTextString(m) }
-func (*DeployItem) ProtoMessage()               {}
-func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *GetRotateEncryptionKeyResultReply) Reset()         { *m = GetRotateEncryptionKeyResultReply{} }
+func (m *GetRotateEncryptionKeyResultReply) String() string { return proto.CompactTextString(m) }
+func (*GetRotateEncryptionKeyResultReply) ProtoMessage()    {}
+func (*GetRotateEncryptionKeyResultReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{59}
+}
 
-func (m *DeployItem) GetRole() string {
+func (m *GetRotateEncryptionKeyResultReply) GetStatus() string {
 	if m != nil {
-		return m.Role
+		return m.Status
 	}
 	return ""
 }
 
-func (m *DeployItem) GetNodeName() string {
+func (m *GetRotateEncryptionKeyResultReply) GetErr() *Error {
 	if m != nil {
-		return m.NodeName
+		return m.Err
 	}
-	return ""
+	return nil
 }
 
-func (m *DeployItem) GetFailureCanBeIgnored() bool {
+// UpdateNodeConfigRequest contains the desired labels, annotations and taints of nodes in a deployed cluster.
+type UpdateNodeConfigRequest struct {
+	// nodes of the cluster, labels and taints of each node are applied to it, masters are used to reach the cluster
+	NodeConfigs []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
+	// nodeLabels and nodeAnnotations of the cluster are applied to all nodes
+	ClusterConfig *ClusterConfig `protobuf:"bytes,2,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	// changes and drifts are reported without being applied if dryRun is true
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *UpdateNodeConfigRequest) Reset()                    { *m = UpdateNodeConfigRequest{} }
+func (m *UpdateNodeConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateNodeConfigRequest) ProtoMessage()               {}
+func (*UpdateNodeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *UpdateNodeConfigRequest) GetNodeConfigs() []*NodeDeployConfig {
 	if m != nil {
-		return m.FailureCanBeIgnored
+		return m.NodeConfigs
 	}
-	return false
+	return nil
 }
 
-// DeployItemResult represents the deploy result in a node for a role.
-type DeployItemResult struct {
-	DeployItem *DeployItem `protobuf:"bytes,1,opt,name=deployItem" json:"deployItem,omitempty"`
-	Status     string      `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	Err        *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
-	Logs       string      `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+func (m *UpdateNodeConfigRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
 }
 
-func (m *DeployItemResult) Reset()                    { *m = DeployItemResult{} }
-func (m *DeployItemResult) String() string            { return proto.CompactTextString(m) }
-func (*DeployItemResult) ProtoMessage()               {}
-func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
-
-func (m *DeployItemResult) GetDeployItem() *DeployItem {
+func (m *UpdateNodeConfigRequest) GetDryRun() bool {
 	if m != nil {
-		return m.DeployItem
+		return m.DryRun
 	}
-	return nil
+	return false
 }
 
-func (m *DeployItemResult) GetStatus() string {
+// UpdateNodeConfigReply contains the changes and drifts of each node.
+type UpdateNodeConfigReply struct {
+	Passed bool              `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	Err    *Error            `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	Diffs  []*NodeConfigDiff `protobuf:"bytes,3,rep,name=diffs" json:"diffs,omitempty"`
+}
+
+func (m *UpdateNodeConfigReply) Reset()                    { *m = UpdateNodeConfigReply{} }
+func (m *UpdateNodeConfigReply) String() string            { return proto.CompactTextString(m) }
+func (*UpdateNodeConfigReply) ProtoMessage()               {}
+func (*UpdateNodeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *UpdateNodeConfigReply) GetPassed() bool {
 	if m != nil {
-		return m.Status
+		return m.Passed
 	}
-	return ""
+	return false
 }
 
-func (m *DeployItemResult) GetErr() *Error {
+func (m *UpdateNodeConfigReply) GetErr() *Error {
 	if m != nil {
 		return m.Err
 	}
 	return nil
 }
 
-func (m *DeployItemResult) GetLogs() string {
+func (m *UpdateNodeConfigReply) GetDiffs() []*NodeConfigDiff {
 	if m != nil {
-		return m.Logs
+		return m.Diffs
 	}
-	return ""
+	return nil
 }
 
-// GetDeployResultReply represents the result of a deploy
-type GetDeployResultReply struct {
-	Status string              `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
-	Err    *Error              `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
-	Items  []*DeployItemResult `protobuf:"bytes,3,rep,name=items" json:"items,omitempty"`
+// NodeConfigDiff contains the changes to the live node and the drifts found on it.
+type NodeConfigDiff struct {
+	NodeName string `protobuf:"bytes,1,opt,name=nodeName" json:"nodeName,omitempty"`
+	// changes from the live node to the desired config
+	Changes []*NodeConfigChange `protobuf:"bytes,2,rep,name=changes" json:"changes,omitempty"`
+	// changes made outside kpaas since kpaas updated the node last time, from the config applied by kpaas to the live node
+	Drifts []*NodeConfigChange `protobuf:"bytes,3,rep,name=drifts" json:"drifts,omitempty"`
+	Err    *Error              `protobuf:"bytes,4,opt,name=err" json:"err,omitempty"`
 }
 
-func (m *GetDeployResultReply) Reset()                    { *m = GetDeployResultReply{} }
-func (m *GetDeployResultReply) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployResultReply) ProtoMessage()               {}
-func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *NodeConfigDiff) Reset()                    { *m = NodeConfigDiff{} }
+func (m *NodeConfigDiff) String() string            { return proto.CompactTextString(m) }
+func (*NodeConfigDiff) ProtoMessage()               {}
+func (*NodeConfigDiff) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
-func (m *GetDeployResultReply) GetStatus() string {
+func (m *NodeConfigDiff) GetNodeName() string {
 	if m != nil {
-		return m.Status
+		return m.NodeName
 	}
 	return ""
 }
 
-func (m *GetDeployResultReply) GetErr() *Error {
+func (m *NodeConfigDiff) GetChanges() []*NodeConfigChange {
 	if m != nil {
-		return m.Err
+		return m.Changes
 	}
 	return nil
 }
 
-func (m *GetDeployResultReply) GetItems() []*DeployItemResult {
+func (m *NodeConfigDiff) GetDrifts() []*NodeConfigChange {
 	if m != nil {
-		return m.Items
+		return m.Drifts
 	}
 	return nil
 }
 
-// GetDeployLogRequest contains the request of getting deploy log.
-type GetDeployLogRequest struct {
-	Role     string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-	NodeName string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+func (m *NodeConfigDiff) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
 }
 
-func (m *GetDeployLogRequest) Reset()                    { *m = GetDeployLogRequest{} }
-func (m *GetDeployLogRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployLogRequest) ProtoMessage()               {}
-func (*GetDeployLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+// NodeConfigChange contains a changed label, annotation or taint.
+type NodeConfigChange struct {
+	// could be "label", "annotation" or "taint"
+	Kind string `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"`
+	// key of the label or annotation, key:effect of the taint
+	Key string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	// could be "add", "update" or "remove"
+	Action   string `protobuf:"bytes,3,opt,name=action" json:"action,omitempty"`
+	OldValue string `protobuf:"bytes,4,opt,name=oldValue" json:"oldValue,omitempty"`
+	NewValue string `protobuf:"bytes,5,opt,name=newValue" json:"newValue,omitempty"`
+}
+
+func (m *NodeConfigChange) Reset()                    { *m = NodeConfigChange{} }
+func (m *NodeConfigChange) String() string            { return proto.CompactTextString(m) }
+func (*NodeConfigChange) ProtoMessage()               {}
+func (*NodeConfigChange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *NodeConfigChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *NodeConfigChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *NodeConfigChange) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
 
-func (m *GetDeployLogRequest) GetRole() string {
+func (m *NodeConfigChange) GetOldValue() string {
 	if m != nil {
-		return m.Role
+		return m.OldValue
 	}
 	return ""
 }
 
-func (m *GetDeployLogRequest) GetNodeName() string {
+func (m *NodeConfigChange) GetNewValue() string {
 	if m != nil {
-		return m.NodeName
+		return m.NewValue
 	}
 	return ""
 }
 
-// GetDeployLogReply represents the response of getting deploy log.
-type GetDeployLogReply struct {
-	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+// PlanDeployReply contains what a deploy request would do, nothing is executed on nodes.
+type PlanDeployReply struct {
+	Passed bool   `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
+	Err    *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	// the deploy task split into sub tasks and actions
+	Plan *DeployPlanTask `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
+	// files generated by kpaas and put to nodes during deployment
+	Files []*DeployPlanFile `protobuf:"bytes,4,rep,name=files" json:"files,omitempty"`
+	// subject alternative names of etcd server and peer certificates
+	EtcdCerts []*EtcdCertPlan `protobuf:"bytes,5,rep,name=etcdCerts" json:"etcdCerts,omitempty"`
 }
 
-func (m *GetDeployLogReply) Reset()                    { *m = GetDeployLogReply{} }
-func (m *GetDeployLogReply) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployLogReply) ProtoMessage()               {}
-func (*GetDeployLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (m *PlanDeployReply) Reset()                    { *m = PlanDeployReply{} }
+func (m *PlanDeployReply) String() string            { return proto.CompactTextString(m) }
+func (*PlanDeployReply) ProtoMessage()               {}
+func (*PlanDeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
-func (m *GetDeployLogReply) GetLog() []byte {
+func (m *PlanDeployReply) GetPassed() bool {
 	if m != nil {
-		return m.Log
+		return m.Passed
 	}
-	return nil
-}
-
-// FetchKubeConfigRequest contains the request of getting kube config.
-type FetchKubeConfigRequest struct {
-	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	return false
 }
 
-func (m *FetchKubeConfigRequest) Reset()                    { *m = FetchKubeConfigRequest{} }
-func (m *FetchKubeConfigRequest) String() string            { return proto.CompactTextString(m) }
-func (*FetchKubeConfigRequest) ProtoMessage()               {}
-func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
-
-func (m *FetchKubeConfigRequest) GetNode() *Node {
+func (m *PlanDeployReply) GetErr() *Error {
 	if m != nil {
-		return m.Node
+		return m.Err
 	}
 	return nil
 }
 
-// FetchKubeConfigReply contains the response of getting kube config.
-type FetchKubeConfigReply struct {
-	KubeConfig []byte `protobuf:"bytes,1,opt,name=kubeConfig,proto3" json:"kubeConfig,omitempty"`
-	Err        *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+func (m *PlanDeployReply) GetPlan() *DeployPlanTask {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
 }
 
-func (m *FetchKubeConfigReply) Reset()                    { *m = FetchKubeConfigReply{} }
-func (m *FetchKubeConfigReply) String() string            { return proto.CompactTextString(m) }
-func (*FetchKubeConfigReply) ProtoMessage()               {}
-func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
-
-func (m *FetchKubeConfigReply) GetKubeConfig() []byte {
+func (m *PlanDeployReply) GetFiles() []*DeployPlanFile {
 	if m != nil {
-		return m.KubeConfig
+		return m.Files
 	}
 	return nil
 }
 
-func (m *FetchKubeConfigReply) GetErr() *Error {
+func (m *PlanDeployReply) GetEtcdCerts() []*EtcdCertPlan {
 	if m != nil {
-		return m.Err
+		return m.EtcdCerts
 	}
 	return nil
 }
 
-// CalicoOptions options for checking requirements for deploying calico network.
-type CalicoOptions struct {
-	// if checkConnectivityAll = true, check connectivity between each pair of nodes bidirectionally.
-	CheckConnectivityAll bool `protobuf:"varint,1,opt,name=checkConnectivityAll" json:"checkConnectivityAll,omitempty"`
-	// EncapsulationMode could be ["vxlan","ipip","none"].
-	EncapsulationMode string `protobuf:"bytes,2,opt,name=encapsulationMode" json:"encapsulationMode,omitempty"`
-	VxlanPort         uint32 `protobuf:"varint,10,opt,name=vxlanPort" json:"vxlanPort,omitempty"`
+// DeployPlanTask contains a task and its sub tasks, sub tasks with smaller priority are executed first.
+type DeployPlanTask struct {
+	Name     string              `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type     string              `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Priority int32               `protobuf:"varint,3,opt,name=priority" json:"priority,omitempty"`
+	Actions  []*DeployPlanAction `protobuf:"bytes,4,rep,name=actions" json:"actions,omitempty"`
+	SubTasks []*DeployPlanTask   `protobuf:"bytes,5,rep,name=subTasks" json:"subTasks,omitempty"`
 }
 
-func (m *CalicoOptions) Reset()                    { *m = CalicoOptions{} }
-func (m *CalicoOptions) String() string            { return proto.CompactTextString(m) }
-func (*CalicoOptions) ProtoMessage()               {}
-func (*CalicoOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (m *DeployPlanTask) Reset()                    { *m = DeployPlanTask{} }
+func (m *DeployPlanTask) String() string            { return proto.CompactTextString(m) }
+func (*DeployPlanTask) ProtoMessage()               {}
+func (*DeployPlanTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
-func (m *CalicoOptions) GetCheckConnectivityAll() bool {
+func (m *DeployPlanTask) GetName() string {
 	if m != nil {
-		return m.CheckConnectivityAll
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-func (m *CalicoOptions) GetEncapsulationMode() string {
+func (m *DeployPlanTask) GetType() string {
 	if m != nil {
-		return m.EncapsulationMode
+		return m.Type
 	}
 	return ""
 }
 
-func (m *CalicoOptions) GetVxlanPort() uint32 {
+func (m *DeployPlanTask) GetPriority() int32 {
 	if m != nil {
-		return m.VxlanPort
+		return m.Priority
 	}
 	return 0
 }
 
-// NetworkOptions options for deploying network. affects checked items.
-type NetworkOptions struct {
-	NetworkType string `protobuf:"bytes,1,opt,name=networkType" json:"networkType,omitempty"`
-	// options for a specified network type. Starts from 10 to reserve field 2-9.
-	CalicoOptions *CalicoOptions `protobuf:"bytes,10,opt,name=calicoOptions" json:"calicoOptions,omitempty"`
-}
-
-func (m *NetworkOptions) Reset()                    { *m = NetworkOptions{} }
-func (m *NetworkOptions) String() string            { return proto.CompactTextString(m) }
-func (*NetworkOptions) ProtoMessage()               {}
-func (*NetworkOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
-
-func (m *NetworkOptions) GetNetworkType() string {
+func (m *DeployPlanTask) GetActions() []*DeployPlanAction {
 	if m != nil {
-		return m.NetworkType
+		return m.Actions
 	}
-	return ""
+	return nil
 }
 
-func (m *NetworkOptions) GetCalicoOptions() *CalicoOptions {
+func (m *DeployPlanTask) GetSubTasks() []*DeployPlanTask {
 	if m != nil {
-		return m.CalicoOptions
+		return m.SubTasks
 	}
 	return nil
 }
 
-// CheckNetworkRequirementRequest nodes and network options when checking
-type CheckNetworkRequirementRequest struct {
-	Nodes   []*Node         `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
-	Options *NetworkOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
+// DeployPlanAction contains an action to be executed on a node.
+type DeployPlanAction struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Node string `protobuf:"bytes,3,opt,name=node" json:"node,omitempty"`
 }
 
-func (m *CheckNetworkRequirementRequest) Reset()                    { *m = CheckNetworkRequirementRequest{} }
-func (m *CheckNetworkRequirementRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckNetworkRequirementRequest) ProtoMessage()               {}
-func (*CheckNetworkRequirementRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (m *DeployPlanAction) Reset()                    { *m = DeployPlanAction{} }
+func (m *DeployPlanAction) String() string            { return proto.CompactTextString(m) }
+func (*DeployPlanAction) ProtoMessage()               {}
+func (*DeployPlanAction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
-func (m *CheckNetworkRequirementRequest) GetNodes() []*Node {
+func (m *DeployPlanAction) GetName() string {
 	if m != nil {
-		return m.Nodes
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *CheckNetworkRequirementRequest) GetOptions() *NetworkOptions {
+func (m *DeployPlanAction) GetType() string {
 	if m != nil {
-		return m.Options
+		return m.Type
 	}
-	return nil
-}
-
-type ConnectivityCheckResult struct {
-	SourceNodeName      string             `protobuf:"bytes,1,opt,name=SourceNodeName" json:"SourceNodeName,omitempty"`
-	DestinationNodeName string             `protobuf:"bytes,2,opt,name=DestinationNodeName" json:"DestinationNodeName,omitempty"`
-	Status              string             `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
-	Err                 *Error             `protobuf:"bytes,4,opt,name=err" json:"err,omitempty"`
-	Items               []*ItemCheckResult `protobuf:"bytes,5,rep,name=items" json:"items,omitempty"`
+	return ""
 }
 
-func (m *ConnectivityCheckResult) Reset()                    { *m = ConnectivityCheckResult{} }
-func (m *ConnectivityCheckResult) String() string            { return proto.CompactTextString(m) }
-func (*ConnectivityCheckResult) ProtoMessage()               {}
-func (*ConnectivityCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
-
-func (m *ConnectivityCheckResult) GetSourceNodeName() string {
+func (m *DeployPlanAction) GetNode() string {
 	if m != nil {
-		return m.SourceNodeName
+		return m.Node
 	}
 	return ""
 }
 
-func (m *ConnectivityCheckResult) GetDestinationNodeName() string {
-	if m != nil {
-		return m.DestinationNodeName
-	}
-	return ""
+// DeployPlanFile contains a file rendered for a node.
+type DeployPlanFile struct {
+	Node    string `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	Path    string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content" json:"content,omitempty"`
 }
 
-func (m *ConnectivityCheckResult) GetStatus() string {
+func (m *DeployPlanFile) Reset()                    { *m = DeployPlanFile{} }
+func (m *DeployPlanFile) String() string            { return proto.CompactTextString(m) }
+func (*DeployPlanFile) ProtoMessage()               {}
+func (*DeployPlanFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *DeployPlanFile) GetNode() string {
 	if m != nil {
-		return m.Status
+		return m.Node
 	}
 	return ""
 }
 
-func (m *ConnectivityCheckResult) GetErr() *Error {
+func (m *DeployPlanFile) GetPath() string {
 	if m != nil {
-		return m.Err
+		return m.Path
 	}
-	return nil
+	return ""
 }
 
-func (m *ConnectivityCheckResult) GetItems() []*ItemCheckResult {
+func (m *DeployPlanFile) GetContent() string {
 	if m != nil {
-		return m.Items
+		return m.Content
 	}
-	return nil
+	return ""
 }
 
-// CheckNetworkRequirementsReply
-type CheckNetworkRequirementsReply struct {
-	Passed         bool                       `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
-	Err            *Error                     `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
-	Nodes          []*NodeCheckResult         `protobuf:"bytes,3,rep,name=nodes" json:"nodes,omitempty"`
-	Connectivities []*ConnectivityCheckResult `protobuf:"bytes,4,rep,name=connectivities" json:"connectivities,omitempty"`
+// EtcdCertPlan contains the subject of the certificates of an etcd node.
+type EtcdCertPlan struct {
+	Node       string   `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	CommonName string   `protobuf:"bytes,2,opt,name=commonName" json:"commonName,omitempty"`
+	DnsNames   []string `protobuf:"bytes,3,rep,name=dnsNames" json:"dnsNames,omitempty"`
+	Ips        []string `protobuf:"bytes,4,rep,name=ips" json:"ips,omitempty"`
 }
 
-func (m *CheckNetworkRequirementsReply) Reset()                    { *m = CheckNetworkRequirementsReply{} }
-func (m *CheckNetworkRequirementsReply) String() string            { return proto.CompactTextString(m) }
-func (*CheckNetworkRequirementsReply) ProtoMessage()               {}
-func (*CheckNetworkRequirementsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (m *EtcdCertPlan) Reset()                    { *m = EtcdCertPlan{} }
+func (m *EtcdCertPlan) String() string            { return proto.CompactTextString(m) }
+func (*EtcdCertPlan) ProtoMessage()               {}
+func (*EtcdCertPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
-func (m *CheckNetworkRequirementsReply) GetPassed() bool {
+func (m *EtcdCertPlan) GetNode() string {
 	if m != nil {
-		return m.Passed
+		return m.Node
 	}
-	return false
+	return ""
 }
 
-func (m *CheckNetworkRequirementsReply) GetErr() *Error {
+func (m *EtcdCertPlan) GetCommonName() string {
 	if m != nil {
-		return m.Err
+		return m.CommonName
 	}
-	return nil
+	return ""
 }
 
-func (m *CheckNetworkRequirementsReply) GetNodes() []*NodeCheckResult {
+func (m *EtcdCertPlan) GetDnsNames() []string {
 	if m != nil {
-		return m.Nodes
+		return m.DnsNames
 	}
 	return nil
 }
 
-func (m *CheckNetworkRequirementsReply) GetConnectivities() []*ConnectivityCheckResult {
+func (m *EtcdCertPlan) GetIps() []string {
 	if m != nil {
-		return m.Connectivities
+		return m.Ips
 	}
 	return nil
 }
@@ -1188,7 +2836,10 @@ func init() {
 	proto.RegisterType((*TestConnectionRequest)(nil), "protos.TestConnectionRequest")
 	proto.RegisterType((*TestConnectionReply)(nil), "protos.TestConnectionReply")
 	proto.RegisterType((*NodeCheckConfig)(nil), "protos.NodeCheckConfig")
+	proto.RegisterType((*NodeCheckThresholds)(nil), "protos.NodeCheckThresholds")
+	proto.RegisterType((*CheckThreshold)(nil), "protos.CheckThreshold")
 	proto.RegisterType((*CheckNodesRequest)(nil), "protos.CheckNodesRequest")
+	proto.RegisterType((*CustomCheckItem)(nil), "protos.CustomCheckItem")
 	proto.RegisterType((*CheckNodesReply)(nil), "protos.CheckNodesReply")
 	proto.RegisterType((*CheckItem)(nil), "protos.CheckItem")
 	proto.RegisterType((*ItemCheckResult)(nil), "protos.ItemCheckResult")
@@ -1200,11 +2851,21 @@ func init() {
 	proto.RegisterType((*NodePortRange)(nil), "protos.NodePortRange")
 	proto.RegisterType((*Keepalived)(nil), "protos.Keepalived")
 	proto.RegisterType((*Loadbalancer)(nil), "protos.Loadbalancer")
+	proto.RegisterType((*BGPPeer)(nil), "protos.BGPPeer")
+	proto.RegisterType((*KubeVIP)(nil), "protos.KubeVIP")
 	proto.RegisterType((*KubeAPIServerConnect)(nil), "protos.KubeAPIServerConnect")
 	proto.RegisterType((*ClusterConfig)(nil), "protos.ClusterConfig")
+	proto.RegisterType((*AuditConfig)(nil), "protos.AuditConfig")
+	proto.RegisterType((*EncryptionConfig)(nil), "protos.EncryptionConfig")
+	proto.RegisterType((*ComponentConfig)(nil), "protos.ComponentConfig")
+	proto.RegisterType((*RegistryConfig)(nil), "protos.RegistryConfig")
+	proto.RegisterType((*ProxyConfig)(nil), "protos.ProxyConfig")
+	proto.RegisterType((*NodeInitProfile)(nil), "protos.NodeInitProfile")
 	proto.RegisterType((*Taint)(nil), "protos.Taint")
 	proto.RegisterType((*NodeDeployConfig)(nil), "protos.NodeDeployConfig")
 	proto.RegisterType((*DeployRequest)(nil), "protos.DeployRequest")
+	proto.RegisterType((*ExecutionPolicy)(nil), "protos.ExecutionPolicy")
+	proto.RegisterType((*TaskExecution)(nil), "protos.TaskExecution")
 	proto.RegisterType((*DeployReply)(nil), "protos.DeployReply")
 	proto.RegisterType((*GetDeployResultRequest)(nil), "protos.GetDeployResultRequest")
 	proto.RegisterType((*DeployItem)(nil), "protos.DeployItem")
@@ -1215,10 +2876,28 @@ func init() {
 	proto.RegisterType((*FetchKubeConfigRequest)(nil), "protos.FetchKubeConfigRequest")
 	proto.RegisterType((*FetchKubeConfigReply)(nil), "protos.FetchKubeConfigReply")
 	proto.RegisterType((*CalicoOptions)(nil), "protos.CalicoOptions")
+	proto.RegisterType((*FlannelOptions)(nil), "protos.FlannelOptions")
+	proto.RegisterType((*CiliumOptions)(nil), "protos.CiliumOptions")
 	proto.RegisterType((*NetworkOptions)(nil), "protos.NetworkOptions")
+	proto.RegisterType((*IngressOptions)(nil), "protos.IngressOptions")
 	proto.RegisterType((*CheckNetworkRequirementRequest)(nil), "protos.CheckNetworkRequirementRequest")
 	proto.RegisterType((*ConnectivityCheckResult)(nil), "protos.ConnectivityCheckResult")
 	proto.RegisterType((*CheckNetworkRequirementsReply)(nil), "protos.CheckNetworkRequirementsReply")
+	proto.RegisterType((*ReconfigureHARequest)(nil), "protos.ReconfigureHARequest")
+	proto.RegisterType((*ReconfigureHAReply)(nil), "protos.ReconfigureHAReply")
+	proto.RegisterType((*RotateEncryptionKeyRequest)(nil), "protos.RotateEncryptionKeyRequest")
+	proto.RegisterType((*RotateEncryptionKeyReply)(nil), "protos.RotateEncryptionKeyReply")
+	proto.RegisterType((*GetRotateEncryptionKeyResultRequest)(nil), "protos.GetRotateEncryptionKeyResultRequest")
+	proto.RegisterType((*GetRotateEncryptionKeyResultReply)(nil), "protos.GetRotateEncryptionKeyResultReply")
+	proto.RegisterType((*UpdateNodeConfigRequest)(nil), "protos.UpdateNodeConfigRequest")
+	proto.RegisterType((*UpdateNodeConfigReply)(nil), "protos.UpdateNodeConfigReply")
+	proto.RegisterType((*NodeConfigDiff)(nil), "protos.NodeConfigDiff")
+	proto.RegisterType((*NodeConfigChange)(nil), "protos.NodeConfigChange")
+	proto.RegisterType((*PlanDeployReply)(nil), "protos.PlanDeployReply")
+	proto.RegisterType((*DeployPlanTask)(nil), "protos.DeployPlanTask")
+	proto.RegisterType((*DeployPlanAction)(nil), "protos.DeployPlanAction")
+	proto.RegisterType((*DeployPlanFile)(nil), "protos.DeployPlanFile")
+	proto.RegisterType((*EtcdCertPlan)(nil), "protos.EtcdCertPlan")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1241,6 +2920,11 @@ type DeployContollerClient interface {
 	GetDeployLog(ctx context.Context, in *GetDeployLogRequest, opts ...grpc.CallOption) (*GetDeployLogReply, error)
 	FetchKubeConfig(ctx context.Context, in *FetchKubeConfigRequest, opts ...grpc.CallOption) (*FetchKubeConfigReply, error)
 	CheckNetworkRequirements(ctx context.Context, in *CheckNetworkRequirementRequest, opts ...grpc.CallOption) (*CheckNetworkRequirementsReply, error)
+	ReconfigureHA(ctx context.Context, in *ReconfigureHARequest, opts ...grpc.CallOption) (*ReconfigureHAReply, error)
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyReply, error)
+	GetRotateEncryptionKeyResult(ctx context.Context, in *GetRotateEncryptionKeyResultRequest, opts ...grpc.CallOption) (*GetRotateEncryptionKeyResultReply, error)
+	UpdateNodeConfig(ctx context.Context, in *UpdateNodeConfigRequest, opts ...grpc.CallOption) (*UpdateNodeConfigReply, error)
+	PlanDeploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*PlanDeployReply, error)
 }
 
 type deployContollerClient struct {
@@ -1332,6 +3016,51 @@ func (c *deployContollerClient) CheckNetworkRequirements(ctx context.Context, in
 	return out, nil
 }
 
+func (c *deployContollerClient) ReconfigureHA(ctx context.Context, in *ReconfigureHARequest, opts ...grpc.CallOption) (*ReconfigureHAReply, error) {
+	out := new(ReconfigureHAReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/ReconfigureHA", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateEncryptionKeyReply, error) {
+	out := new(RotateEncryptionKeyReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/RotateEncryptionKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) GetRotateEncryptionKeyResult(ctx context.Context, in *GetRotateEncryptionKeyResultRequest, opts ...grpc.CallOption) (*GetRotateEncryptionKeyResultReply, error) {
+	out := new(GetRotateEncryptionKeyResultReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetRotateEncryptionKeyResult", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) UpdateNodeConfig(ctx context.Context, in *UpdateNodeConfigRequest, opts ...grpc.CallOption) (*UpdateNodeConfigReply, error) {
+	out := new(UpdateNodeConfigReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/UpdateNodeConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) PlanDeploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*PlanDeployReply, error) {
+	out := new(PlanDeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/PlanDeploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DeployContoller service
 
 type DeployContollerServer interface {
@@ -1344,6 +3073,11 @@ type DeployContollerServer interface {
 	GetDeployLog(context.Context, *GetDeployLogRequest) (*GetDeployLogReply, error)
 	FetchKubeConfig(context.Context, *FetchKubeConfigRequest) (*FetchKubeConfigReply, error)
 	CheckNetworkRequirements(context.Context, *CheckNetworkRequirementRequest) (*CheckNetworkRequirementsReply, error)
+	ReconfigureHA(context.Context, *ReconfigureHARequest) (*ReconfigureHAReply, error)
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*RotateEncryptionKeyReply, error)
+	GetRotateEncryptionKeyResult(context.Context, *GetRotateEncryptionKeyResultRequest) (*GetRotateEncryptionKeyResultReply, error)
+	UpdateNodeConfig(context.Context, *UpdateNodeConfigRequest) (*UpdateNodeConfigReply, error)
+	PlanDeploy(context.Context, *DeployRequest) (*PlanDeployReply, error)
 }
 
 func RegisterDeployContollerServer(s *grpc.Server, srv DeployContollerServer) {
@@ -1512,6 +3246,96 @@ func _DeployContoller_CheckNetworkRequirements_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployContoller_ReconfigureHA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconfigureHARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).ReconfigureHA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/ReconfigureHA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).ReconfigureHA(ctx, req.(*ReconfigureHARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/RotateEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_GetRotateEncryptionKeyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRotateEncryptionKeyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).GetRotateEncryptionKeyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/GetRotateEncryptionKeyResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).GetRotateEncryptionKeyResult(ctx, req.(*GetRotateEncryptionKeyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_UpdateNodeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).UpdateNodeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/UpdateNodeConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).UpdateNodeConfig(ctx, req.(*UpdateNodeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_PlanDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).PlanDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/PlanDeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).PlanDeploy(ctx, req.(*DeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "protos.DeployContoller",
 	HandlerType: (*DeployContollerServer)(nil),
@@ -1552,6 +3376,26 @@ var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckNetworkRequirements",
 			Handler:    _DeployContoller_CheckNetworkRequirements_Handler,
 		},
+		{
+			MethodName: "ReconfigureHA",
+			Handler:    _DeployContoller_ReconfigureHA_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _DeployContoller_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "GetRotateEncryptionKeyResult",
+			Handler:    _DeployContoller_GetRotateEncryptionKeyResult_Handler,
+		},
+		{
+			MethodName: "UpdateNodeConfig",
+			Handler:    _DeployContoller_UpdateNodeConfig_Handler,
+		},
+		{
+			MethodName: "PlanDeploy",
+			Handler:    _DeployContoller_PlanDeploy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deploy_controller.proto",
@@ -1560,108 +3404,242 @@ var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deploy_controller.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x4f, 0x1c, 0x47,
-	0x13, 0xf7, 0xec, 0x03, 0x43, 0x2d, 0xcb, 0xa3, 0x59, 0x60, 0xbd, 0x06, 0x8c, 0x5a, 0xc6, 0xe2,
-	0xf3, 0xf7, 0x7d, 0xc8, 0x59, 0x2b, 0x91, 0x1f, 0x49, 0x24, 0x8c, 0x09, 0xc6, 0x0f, 0x62, 0x0f,
-	0xc8, 0x3e, 0x45, 0xd1, 0x30, 0xdb, 0xc0, 0x88, 0x61, 0x7a, 0xd2, 0xd3, 0xbb, 0xf1, 0x9e, 0x72,
-	0x4a, 0x94, 0x5b, 0x0e, 0x51, 0xa4, 0xfc, 0x59, 0xb9, 0xe7, 0x2f, 0x48, 0x2e, 0xf9, 0x07, 0x72,
-	0x88, 0xfa, 0x35, 0xdb, 0x33, 0x3b, 0x6b, 0xb0, 0xc9, 0x69, 0xa7, 0xeb, 0xd5, 0xbf, 0xaa, 0xae,
-	0xae, 0xae, 0x5a, 0x58, 0xec, 0x90, 0x38, 0xa4, 0xfd, 0xaf, 0x7d, 0x1a, 0x71, 0x46, 0xc3, 0x90,
-	0xb0, 0x8d, 0x98, 0x51, 0x4e, 0xd1, 0x98, 0xfc, 0x49, 0xf0, 0x6b, 0xa8, 0x6c, 0x76, 0xf9, 0x09,
-	0x42, 0x50, 0xe1, 0xfd, 0x98, 0x34, 0x9d, 0x55, 0x67, 0x7d, 0xc2, 0x95, 0xdf, 0x68, 0x05, 0xc0,
-	0x67, 0xa4, 0x43, 0x22, 0x1e, 0x78, 0x61, 0xb3, 0x24, 0x39, 0x16, 0x05, 0xb5, 0x60, 0xbc, 0x9b,
-	0x10, 0x16, 0x79, 0x67, 0xa4, 0x59, 0x96, 0xdc, 0x74, 0x8d, 0x1f, 0x42, 0x79, 0x7f, 0xff, 0x89,
-	0x30, 0x1b, 0x53, 0xc6, 0xa5, 0xd9, 0xba, 0x2b, 0xbf, 0xd1, 0x2a, 0x54, 0xbc, 0x2e, 0x3f, 0x91,
-	0x06, 0x6b, 0xed, 0x49, 0x05, 0x28, 0xd9, 0x10, 0x30, 0x5c, 0xc9, 0xc1, 0xbb, 0x50, 0xd9, 0xa3,
-	0x1d, 0x22, 0xb4, 0xa5, 0x71, 0x0d, 0x4a, 0x7c, 0xa3, 0x29, 0x28, 0x05, 0xb1, 0x06, 0x53, 0x0a,
-	0x62, 0xb4, 0x0c, 0xe5, 0x24, 0x39, 0x91, 0xfb, 0xd7, 0xda, 0x35, 0x63, 0x6c, 0x7f, 0xff, 0x89,
-	0x2b, 0xe8, 0xf8, 0x0d, 0x54, 0xb7, 0x19, 0xa3, 0x0c, 0x2d, 0xc0, 0x18, 0x23, 0x5e, 0x42, 0x23,
-	0x6d, 0x4d, 0xaf, 0x04, 0xbd, 0x43, 0xb8, 0x17, 0x18, 0x07, 0xf5, 0x4a, 0x38, 0x7f, 0x14, 0xbc,
-	0x7d, 0x41, 0xf8, 0x09, 0xed, 0x24, 0xda, 0x3d, 0x8b, 0x82, 0xef, 0xc3, 0xfc, 0x01, 0x49, 0xf8,
-	0x16, 0x8d, 0x22, 0xe2, 0xf3, 0x80, 0x46, 0x2e, 0xf9, 0xa6, 0x4b, 0x12, 0xe9, 0x5e, 0x44, 0x3b,
-	0x0a, 0xb4, 0xe5, 0x9e, 0x70, 0xc8, 0x95, 0x1c, 0xbc, 0x07, 0x73, 0x79, 0xd5, 0x38, 0xec, 0x0b,
-	0x24, 0xb1, 0x97, 0x24, 0xa4, 0x23, 0x55, 0xc7, 0x5d, 0xbd, 0x42, 0x37, 0xa0, 0x4c, 0x18, 0xd3,
-	0xe1, 0xaa, 0x1b, 0x7b, 0xd2, 0x2b, 0x57, 0x70, 0xf0, 0x2e, 0x4c, 0x0b, 0xeb, 0x5b, 0x27, 0xc4,
-	0x3f, 0xdd, 0xa2, 0xd1, 0x51, 0x70, 0x7c, 0x3e, 0x08, 0xd4, 0x80, 0x2a, 0xa3, 0x21, 0x49, 0x9a,
-	0xa5, 0xd5, 0xf2, 0xfa, 0x84, 0xab, 0x16, 0xf8, 0x07, 0x07, 0x66, 0xa5, 0x1d, 0x21, 0x99, 0x18,
-	0x97, 0x3e, 0x82, 0xab, 0xbe, 0xb4, 0x9b, 0x34, 0x9d, 0xd5, 0xf2, 0x7a, 0xad, 0xbd, 0x68, 0x1b,
-	0xb4, 0xf6, 0x75, 0x8d, 0x1c, 0xfa, 0x1c, 0xa6, 0x22, 0xc2, 0xbf, 0xa5, 0xec, 0xf4, 0xcb, 0x58,
-	0xb8, 0x98, 0x68, 0xfc, 0x0b, 0xa9, 0x66, 0x86, 0xeb, 0xe6, 0xa4, 0xf1, 0x1e, 0x4c, 0xdb, 0x38,
-	0x44, 0x7c, 0x5a, 0x30, 0xee, 0xf9, 0x3e, 0x89, 0x79, 0x1a, 0xa1, 0x74, 0x7d, 0x7e, 0x8c, 0x36,
-	0x61, 0x42, 0xda, 0xdb, 0xe5, 0xe4, 0xac, 0x30, 0xaf, 0x56, 0xa1, 0xd6, 0x21, 0x89, 0xcf, 0x02,
-	0x09, 0x40, 0x27, 0x83, 0x4d, 0xc2, 0xdf, 0x3b, 0x30, 0x2d, 0xd4, 0xa5, 0x1d, 0x97, 0x24, 0xdd,
-	0x90, 0xa3, 0x35, 0xa8, 0x04, 0x9c, 0x9c, 0xe9, 0x38, 0xcf, 0x9a, 0x8d, 0xd3, 0xad, 0x5c, 0xc9,
-	0x16, 0x47, 0x9b, 0x70, 0x8f, 0x77, 0x13, 0x93, 0x64, 0x6a, 0x65, 0x60, 0x97, 0x47, 0xc1, 0x16,
-	0x48, 0x43, 0x7a, 0x9c, 0x34, 0x2b, 0x0a, 0xa9, 0xf8, 0xc6, 0xbf, 0x38, 0xd6, 0x79, 0x6b, 0x1c,
-	0x2d, 0x18, 0x17, 0xa7, 0xba, 0x37, 0xf0, 0x2a, 0x5d, 0x7f, 0xf8, 0xe6, 0xff, 0x87, 0xaa, 0x40,
-	0x2f, 0x76, 0xcf, 0x1c, 0x7a, 0x2e, 0x08, 0xae, 0x92, 0xc2, 0x4b, 0xd0, 0xda, 0x21, 0xdc, 0x3e,
-	0x35, 0xc9, 0x55, 0x39, 0x84, 0xff, 0x70, 0xa0, 0x59, 0xc8, 0xd6, 0xa9, 0xaf, 0x21, 0x3a, 0x45,
-	0x10, 0x47, 0x1e, 0x2b, 0xda, 0x84, 0xaa, 0xf0, 0x53, 0x5c, 0x50, 0x01, 0xf1, 0xbf, 0x46, 0x64,
-	0xd4, 0x4e, 0x32, 0x61, 0x93, 0xed, 0x88, 0xb3, 0xbe, 0xab, 0x34, 0x5b, 0xaf, 0x00, 0x06, 0x44,
-	0x34, 0x03, 0xe5, 0x53, 0xd2, 0xd7, 0x30, 0xc4, 0xa7, 0x88, 0x42, 0xcf, 0x0b, 0xbb, 0x44, 0xa3,
-	0x18, 0x4e, 0x7d, 0x13, 0x05, 0x29, 0xf5, 0xa0, 0x74, 0xcf, 0xc1, 0x1f, 0xc3, 0x62, 0x06, 0xc0,
-	0x73, 0x7a, 0x6c, 0xae, 0xd2, 0x3b, 0x0e, 0x0a, 0xff, 0x07, 0xe6, 0x87, 0xd5, 0x44, 0x78, 0x66,
-	0xa0, 0x1c, 0xd2, 0x63, 0x29, 0x3f, 0xe9, 0x8a, 0x4f, 0x7c, 0x17, 0xea, 0x42, 0xe4, 0x25, 0x65,
-	0xdc, 0xf5, 0xa2, 0x63, 0x59, 0x2a, 0x8f, 0x18, 0x3d, 0x33, 0x85, 0x56, 0x7c, 0x8b, 0x52, 0xc9,
-	0xa9, 0x84, 0x5d, 0x77, 0x4b, 0x9c, 0xe2, 0xa7, 0x00, 0xcf, 0x08, 0x89, 0xbd, 0x30, 0xe8, 0x91,
-	0x8e, 0x30, 0xda, 0x0b, 0x62, 0xe3, 0x69, 0x2f, 0x88, 0xd1, 0x6d, 0x98, 0x89, 0x08, 0xdf, 0x8d,
-	0x38, 0x61, 0x47, 0x9e, 0xaf, 0x30, 0xaa, 0x94, 0x19, 0xa2, 0xe3, 0x36, 0x4c, 0x3e, 0xa7, 0x5e,
-	0xe7, 0xd0, 0x0b, 0xbd, 0xc8, 0x27, 0x4c, 0x97, 0x65, 0x27, 0x2d, 0xcb, 0xa6, 0xf0, 0x97, 0x06,
-	0x85, 0x1f, 0xff, 0xea, 0x40, 0xe3, 0x59, 0xf7, 0x90, 0x6c, 0xbe, 0xdc, 0xdd, 0x27, 0xac, 0x47,
-	0x98, 0xae, 0x80, 0x85, 0x8f, 0x4f, 0x1b, 0xe0, 0x34, 0x05, 0xab, 0x63, 0x8f, 0x4c, 0xec, 0x07,
-	0x6e, 0xb8, 0x96, 0x14, 0xba, 0x07, 0x93, 0xa1, 0x05, 0x4a, 0xa7, 0x76, 0xc3, 0x68, 0xd9, 0x80,
-	0xdd, 0x8c, 0x24, 0xfe, 0xbb, 0x02, 0xf5, 0xad, 0xb0, 0x9b, 0x70, 0x09, 0x4a, 0x55, 0xd0, 0x9a,
-	0xaf, 0x08, 0xd6, 0x59, 0xd9, 0x24, 0xf4, 0x12, 0x1a, 0xa7, 0x05, 0xde, 0x68, 0xac, 0x4b, 0x29,
-	0xd6, 0x02, 0x19, 0xb7, 0x50, 0x13, 0x3d, 0x84, 0x7a, 0x64, 0x9f, 0xaa, 0x76, 0x60, 0xde, 0x4e,
-	0xb9, 0x94, 0xe9, 0x66, 0x65, 0xd1, 0x36, 0x80, 0x20, 0x3c, 0xf7, 0x0e, 0x49, 0x68, 0xae, 0xec,
-	0x5a, 0x5a, 0x90, 0x6c, 0xdf, 0xa4, 0x1d, 0x25, 0xa7, 0x6e, 0x82, 0xa5, 0x88, 0x0e, 0x60, 0x5a,
-	0xac, 0x36, 0xa3, 0x88, 0x72, 0x4f, 0x55, 0xee, 0xaa, 0xb4, 0x75, 0x7b, 0xb4, 0x2d, 0x4b, 0x58,
-	0x19, 0xcc, 0x9b, 0x40, 0xeb, 0x30, 0x1d, 0x9c, 0x79, 0xc7, 0xc4, 0x25, 0x31, 0x4d, 0x02, 0x4e,
-	0x59, 0xbf, 0x39, 0x26, 0x23, 0x9a, 0x27, 0xa3, 0x25, 0x98, 0x88, 0x69, 0x67, 0xbf, 0x7b, 0x18,
-	0x11, 0xde, 0xbc, 0x2a, 0x65, 0x06, 0x04, 0x74, 0x13, 0xea, 0x09, 0x61, 0xbd, 0xc0, 0x27, 0x5a,
-	0x62, 0x5c, 0x4a, 0x64, 0x89, 0xe8, 0x7f, 0x30, 0x2b, 0xe2, 0xcb, 0x22, 0xc2, 0x49, 0xf2, 0x9a,
-	0xb0, 0x44, 0x54, 0xf4, 0x09, 0x29, 0x39, 0xcc, 0x68, 0x7d, 0xa6, 0xca, 0xa9, 0x15, 0x90, 0x82,
-	0x2a, 0xd0, 0xb0, 0xab, 0xc0, 0x84, 0x75, 0xd9, 0x5b, 0x8f, 0xa0, 0x51, 0x14, 0x83, 0xf7, 0xb1,
-	0x81, 0x77, 0xa0, 0x7a, 0xe0, 0x05, 0x11, 0xbf, 0xa8, 0x92, 0x28, 0x98, 0xe4, 0xe8, 0x48, 0x64,
-	0x9b, 0xea, 0x4c, 0xf4, 0x0a, 0xff, 0xe9, 0xc0, 0x8c, 0x40, 0xf3, 0x58, 0xb6, 0x7d, 0x97, 0x6b,
-	0x06, 0xd0, 0xa7, 0x30, 0x16, 0xaa, 0x6c, 0x52, 0xd5, 0xf5, 0xa6, 0xad, 0x69, 0xef, 0xb0, 0x61,
-	0x27, 0x93, 0xd6, 0x41, 0x6b, 0x30, 0xc6, 0x85, 0x4f, 0x26, 0x17, 0xd3, 0xf2, 0x2d, 0x3d, 0x75,
-	0x35, 0xb3, 0x75, 0x1f, 0x6a, 0x1f, 0x18, 0x79, 0xfc, 0xa3, 0x03, 0x75, 0x05, 0xc3, 0x54, 0xd7,
-	0x07, 0x50, 0x13, 0xfe, 0x6c, 0x65, 0x9a, 0x95, 0xe6, 0x28, 0xd8, 0xae, 0x2d, 0x2c, 0x2e, 0x9f,
-	0x6f, 0x67, 0xb6, 0xbe, 0xc7, 0xf3, 0x85, 0x69, 0xef, 0x66, 0x65, 0xf1, 0x53, 0xa8, 0x19, 0x24,
-	0x97, 0x6e, 0x55, 0x9a, 0xb0, 0xb0, 0x43, 0xb8, 0x31, 0x67, 0xbf, 0xa1, 0x11, 0x80, 0x22, 0x9b,
-	0x2e, 0x46, 0x9c, 0x93, 0xa9, 0x9a, 0xe2, 0x3b, 0xf3, 0xbc, 0x94, 0x72, 0x7d, 0xc0, 0x1d, 0x98,
-	0x3b, 0xf2, 0x82, 0xb0, 0xcb, 0xc8, 0x96, 0x17, 0x3d, 0x22, 0xbb, 0xc7, 0x11, 0x65, 0xa4, 0x23,
-	0x13, 0x68, 0xdc, 0x2d, 0x62, 0xe1, 0x9f, 0x1d, 0x98, 0x19, 0x6c, 0xa8, 0x5b, 0x8d, 0x36, 0x40,
-	0x27, 0xa5, 0xe9, 0x9c, 0x4a, 0x0b, 0xb3, 0x25, 0x6d, 0x49, 0xfd, 0xbb, 0xfd, 0xcf, 0x77, 0xd0,
-	0x18, 0x8a, 0xcf, 0xa5, 0x9a, 0x88, 0x0d, 0xd3, 0xe7, 0x94, 0xb3, 0xf9, 0x92, 0x77, 0xdd, 0x34,
-	0x3a, 0xdb, 0x30, 0x97, 0x02, 0xb0, 0x9e, 0xf6, 0xf7, 0x3c, 0x0f, 0xbc, 0x06, 0xb3, 0x59, 0x33,
-	0xc5, 0x4f, 0xfd, 0x03, 0x58, 0xf8, 0x82, 0x70, 0xff, 0x44, 0xbc, 0x23, 0x3a, 0xf9, 0x2e, 0x3c,
-	0x69, 0xbc, 0x81, 0xc6, 0x90, 0xae, 0xd8, 0x65, 0x05, 0xe0, 0x34, 0x25, 0xe9, 0xcd, 0x2c, 0xca,
-	0xf9, 0x39, 0xfa, 0x93, 0x03, 0xf5, 0x2d, 0x2f, 0x0c, 0x7c, 0xaa, 0x1b, 0x76, 0xd4, 0x86, 0x86,
-	0xaf, 0x07, 0x01, 0x39, 0xd5, 0xf4, 0x02, 0xde, 0xdf, 0x0c, 0x43, 0x9d, 0xfe, 0x85, 0x3c, 0x51,
-	0xa7, 0x49, 0xe4, 0x7b, 0x71, 0xd2, 0x0d, 0x65, 0xe5, 0x7c, 0x21, 0xbc, 0x51, 0x61, 0x1a, 0x66,
-	0x88, 0x97, 0xa1, 0xf7, 0x36, 0xf4, 0x22, 0xf1, 0xe4, 0x35, 0x41, 0xf6, 0x15, 0x03, 0x02, 0xa6,
-	0x30, 0x95, 0x1d, 0x29, 0xc4, 0x0b, 0xae, 0x87, 0x8a, 0x83, 0x41, 0x73, 0x61, 0x93, 0xe4, 0x95,
-	0xb7, 0x9d, 0x90, 0x56, 0xed, 0x2b, 0x6f, 0x33, 0xdd, 0xac, 0x2c, 0xee, 0xc1, 0x8a, 0x6a, 0xd5,
-	0x94, 0x41, 0x71, 0x28, 0x01, 0x23, 0x67, 0x24, 0x32, 0xd7, 0x15, 0x61, 0xd3, 0x9c, 0xaa, 0x3a,
-	0x94, 0x3d, 0x20, 0xc5, 0x42, 0x77, 0xe0, 0x2a, 0xbd, 0xd0, 0x80, 0x64, 0xc4, 0xf0, 0xef, 0x0e,
-	0x2c, 0xda, 0x81, 0xb4, 0xc7, 0x80, 0x5b, 0x30, 0xb5, 0x4f, 0xbb, 0xcc, 0x27, 0x7b, 0xd9, 0x1e,
-	0x33, 0x47, 0x15, 0xa5, 0xe0, 0x31, 0x49, 0x78, 0x10, 0xc9, 0xe8, 0xee, 0x65, 0x33, 0xb4, 0x88,
-	0x65, 0x5d, 0xae, 0x72, 0xd1, 0xe5, 0xaa, 0x9c, 0x3f, 0x44, 0x54, 0x2f, 0x34, 0x44, 0xfc, 0xe6,
-	0xc0, 0xf2, 0x88, 0xb0, 0x26, 0x97, 0x1b, 0x93, 0x05, 0x12, 0x7b, 0x56, 0x18, 0xdd, 0xc8, 0xab,
-	0x93, 0xd9, 0x81, 0x29, 0x7f, 0x10, 0xe6, 0x80, 0x98, 0x77, 0xec, 0x46, 0x9a, 0x1d, 0xc5, 0x87,
-	0xe0, 0xe6, 0xd4, 0xda, 0x7f, 0x55, 0x61, 0x3a, 0x7d, 0x76, 0xb8, 0xfc, 0x13, 0x06, 0xed, 0xc1,
-	0x54, 0xf6, 0x2f, 0x00, 0xb4, 0x9c, 0x3e, 0x8f, 0x45, 0xff, 0x2a, 0xb4, 0xae, 0x8f, 0x62, 0xc7,
-	0x61, 0x1f, 0x5f, 0x41, 0x8f, 0x00, 0x06, 0x73, 0x03, 0xba, 0x96, 0x99, 0x43, 0xed, 0x51, 0xbe,
-	0xb5, 0x58, 0xc4, 0x52, 0x36, 0xbe, 0x92, 0x65, 0x2d, 0x3f, 0x36, 0x21, 0xfc, 0xce, 0x99, 0x4a,
-	0x59, 0x5d, 0x3d, 0x6f, 0xee, 0xc2, 0x57, 0xd0, 0x01, 0xcc, 0xe4, 0xa7, 0x1b, 0x74, 0xa3, 0x50,
-	0x6f, 0x50, 0x53, 0x5b, 0xcb, 0xa3, 0x05, 0x94, 0xd5, 0x4f, 0x60, 0x4c, 0xc5, 0x16, 0xcd, 0x67,
-	0xcb, 0xb6, 0xb1, 0x30, 0x97, 0x27, 0x2b, 0xbd, 0x57, 0x30, 0x9d, 0x7b, 0x44, 0xd0, 0x8a, 0xb5,
-	0x57, 0xc1, 0xeb, 0xdb, 0x5a, 0x1a, 0xc9, 0x57, 0x26, 0x9f, 0xc0, 0xa4, 0x5d, 0xcf, 0xd1, 0xf5,
-	0x21, 0x79, 0xcb, 0xb1, 0x6b, 0xc5, 0xcc, 0x14, 0x5c, 0xae, 0x6c, 0x0f, 0xc0, 0x15, 0xbf, 0x05,
-	0x03, 0x70, 0x45, 0xf5, 0x1e, 0x5f, 0x41, 0xa7, 0xd0, 0x1c, 0x75, 0xad, 0xd0, 0xad, 0x6c, 0x4e,
-	0x8c, 0xaa, 0x67, 0xad, 0xb5, 0x73, 0xe4, 0x4c, 0x26, 0x1d, 0xaa, 0x3f, 0x17, 0xef, 0xfe, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x8d, 0xed, 0xa0, 0xdb, 0x7e, 0x14, 0x00, 0x00,
+	// 3779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x4d, 0x6f, 0x24, 0x49,
+	0x56, 0x53, 0x55, 0x2e, 0xdb, 0xf5, 0xec, 0xb2, 0xdd, 0xd1, 0xee, 0x76, 0x6d, 0x4d, 0xf7, 0x4c,
+	0x93, 0xbb, 0x3d, 0xcc, 0xcc, 0xce, 0x7a, 0x67, 0xbd, 0xec, 0xd0, 0x33, 0x03, 0x23, 0xb9, 0xdd,
+	0x9e, 0x1e, 0x33, 0xd3, 0x1e, 0x6f, 0xd8, 0xcc, 0x48, 0x2b, 0x96, 0x55, 0x3a, 0x2b, 0xca, 0x95,
+	0xaa, 0xac, 0x8c, 0x24, 0x32, 0xb2, 0xda, 0xb5, 0x12, 0x48, 0x08, 0x21, 0x21, 0x71, 0x40, 0x08,
+	0xad, 0xc4, 0x6f, 0x80, 0x1b, 0x48, 0x88, 0x03, 0xe2, 0xb2, 0x07, 0xae, 0x1c, 0x38, 0x80, 0x38,
+	0x71, 0x04, 0x21, 0x24, 0xfe, 0x01, 0x7a, 0xf1, 0x91, 0x19, 0x99, 0x95, 0x65, 0x77, 0xb7, 0x91,
+	0xe6, 0xe4, 0x7a, 0x1f, 0xf1, 0xe2, 0xbd, 0x17, 0x2f, 0x5e, 0xbc, 0x78, 0x91, 0x86, 0x9d, 0x01,
+	0x4b, 0x22, 0x3e, 0xfb, 0x59, 0xc0, 0x63, 0x29, 0x78, 0x14, 0x31, 0xb1, 0x9b, 0x08, 0x2e, 0x39,
+	0x59, 0x56, 0x7f, 0x52, 0xef, 0x2b, 0x58, 0xda, 0xcf, 0xe4, 0x88, 0x10, 0x58, 0x92, 0xb3, 0x84,
+	0xf5, 0x1a, 0x0f, 0x1a, 0x6f, 0x77, 0xa8, 0xfa, 0x4d, 0xde, 0x00, 0x08, 0x04, 0x1b, 0xb0, 0x58,
+	0x86, 0x7e, 0xd4, 0x6b, 0x2a, 0x8a, 0x83, 0x21, 0x7d, 0x58, 0xcd, 0x52, 0x26, 0x62, 0x7f, 0xc2,
+	0x7a, 0x2d, 0x45, 0xcd, 0x61, 0xef, 0x63, 0x68, 0x9d, 0x9e, 0x7e, 0x86, 0x62, 0x13, 0x2e, 0xa4,
+	0x12, 0xdb, 0xa5, 0xea, 0x37, 0x79, 0x00, 0x4b, 0x7e, 0x26, 0x47, 0x4a, 0xe0, 0xda, 0xde, 0xba,
+	0x56, 0x28, 0xdd, 0x45, 0x35, 0xa8, 0xa2, 0x78, 0x47, 0xb0, 0x74, 0xcc, 0x07, 0x0c, 0x47, 0x2b,
+	0xe1, 0x46, 0x29, 0xfc, 0x4d, 0x36, 0xa0, 0x19, 0x26, 0x46, 0x99, 0x66, 0x98, 0x90, 0xfb, 0xd0,
+	0x4a, 0xd3, 0x91, 0x9a, 0x7f, 0x6d, 0x6f, 0xcd, 0x0a, 0x3b, 0x3d, 0xfd, 0x8c, 0x22, 0xde, 0xfb,
+	0x1a, 0xda, 0x87, 0x42, 0x70, 0x41, 0xee, 0xc2, 0xb2, 0x60, 0x7e, 0xca, 0x63, 0x23, 0xcd, 0x40,
+	0x88, 0x1f, 0x30, 0xe9, 0x87, 0xd6, 0x40, 0x03, 0xa1, 0xf1, 0xc3, 0xf0, 0xf2, 0x19, 0x93, 0x23,
+	0x3e, 0x48, 0x8d, 0x79, 0x0e, 0xc6, 0xfb, 0x10, 0xee, 0x9c, 0xb1, 0x54, 0x1e, 0xf0, 0x38, 0x66,
+	0x81, 0x0c, 0x79, 0x4c, 0xd9, 0xef, 0x65, 0x2c, 0x55, 0xe6, 0xc5, 0x7c, 0xa0, 0x95, 0x76, 0xcc,
+	0x43, 0x83, 0xa8, 0xa2, 0x78, 0xc7, 0x70, 0xbb, 0x3a, 0x34, 0x89, 0x66, 0xa8, 0x49, 0xe2, 0xa7,
+	0x29, 0x1b, 0xa8, 0xa1, 0xab, 0xd4, 0x40, 0xe4, 0x4d, 0x68, 0x31, 0x21, 0x8c, 0xbb, 0xba, 0x56,
+	0x9e, 0xb2, 0x8a, 0x22, 0xc5, 0xfb, 0x9f, 0x06, 0x6c, 0xa2, 0xf8, 0x83, 0x11, 0x0b, 0xc6, 0x07,
+	0x3c, 0x1e, 0x86, 0x17, 0xd7, 0x6b, 0x41, 0xb6, 0xa1, 0x2d, 0x78, 0xc4, 0xd2, 0x5e, 0xf3, 0x41,
+	0xeb, 0xed, 0x0e, 0xd5, 0x00, 0x79, 0x0a, 0x20, 0x47, 0x82, 0xa5, 0x23, 0x1e, 0x29, 0xb3, 0x5b,
+	0x6f, 0xaf, 0xed, 0xfd, 0xaa, 0x3b, 0xda, 0x99, 0x64, 0xf7, 0x2c, 0xe7, 0x3c, 0x8c, 0xa5, 0x98,
+	0x51, 0x67, 0x68, 0xff, 0x27, 0xb0, 0x59, 0x21, 0x93, 0x2d, 0x68, 0x8d, 0xd9, 0xcc, 0xf8, 0x1f,
+	0x7f, 0x92, 0x1f, 0x40, 0x7b, 0xea, 0x47, 0x19, 0x33, 0xc6, 0xbd, 0x3e, 0x37, 0x51, 0x21, 0x82,
+	0x6a, 0xce, 0x8f, 0x9a, 0x8f, 0x1a, 0xde, 0x1f, 0x36, 0xe0, 0x76, 0x0d, 0x0b, 0x79, 0x1f, 0x56,
+	0x26, 0x61, 0x1c, 0x4e, 0xb2, 0x89, 0xb1, 0xfb, 0xae, 0x15, 0x58, 0xe6, 0xa4, 0x96, 0x8d, 0x3c,
+	0x82, 0x35, 0xc1, 0x02, 0x3e, 0x99, 0xb0, 0x78, 0xc0, 0x06, 0xbd, 0xe6, 0x95, 0xa3, 0x5c, 0x56,
+	0xef, 0xef, 0x1a, 0xb0, 0x51, 0xa6, 0x93, 0xef, 0x40, 0x77, 0xc0, 0x83, 0x31, 0x13, 0x5f, 0x31,
+	0x91, 0x86, 0x79, 0xa4, 0x95, 0x91, 0xc8, 0x35, 0x66, 0x22, 0x66, 0x91, 0xe5, 0xd2, 0x71, 0x57,
+	0x46, 0x92, 0x1e, 0xac, 0x04, 0x49, 0x76, 0xc0, 0x85, 0xde, 0x5a, 0x0d, 0x6a, 0x41, 0x72, 0x0f,
+	0x3a, 0x13, 0x36, 0xe1, 0x62, 0xf6, 0x34, 0x7c, 0xdc, 0x5b, 0x52, 0xb4, 0x02, 0x41, 0x1e, 0xc0,
+	0x9a, 0xe0, 0x5c, 0x3e, 0x09, 0xd3, 0x31, 0xd2, 0xdb, 0x8a, 0xee, 0xa2, 0xbc, 0x3f, 0x6f, 0xc1,
+	0x2d, 0xa5, 0x38, 0x7a, 0x30, 0xb5, 0x51, 0xfb, 0x03, 0x58, 0x09, 0xd4, 0xa2, 0xa6, 0xbd, 0x86,
+	0x5a, 0xf4, 0x9d, 0x05, 0x8b, 0x4e, 0x2d, 0x1f, 0xf9, 0x04, 0x36, 0x62, 0x26, 0x9f, 0x73, 0x31,
+	0xfe, 0x32, 0xc1, 0x28, 0x4e, 0xab, 0xee, 0x3b, 0x2e, 0x51, 0x69, 0x85, 0x9b, 0x9c, 0xc0, 0xf6,
+	0x38, 0x3b, 0x67, 0xfb, 0x27, 0x47, 0xa7, 0x4c, 0x4c, 0x99, 0x30, 0xfb, 0xc1, 0x6c, 0xe5, 0x7b,
+	0x56, 0xca, 0xe7, 0x35, 0x3c, 0xb4, 0x76, 0x24, 0xee, 0xd9, 0x84, 0x0f, 0x4e, 0xb3, 0xf3, 0x98,
+	0xc9, 0xb4, 0xb7, 0xa4, 0xe2, 0xda, 0xc1, 0x90, 0xb7, 0x60, 0x23, 0x65, 0x62, 0x1a, 0x06, 0xcc,
+	0xf2, 0xb4, 0x15, 0x4f, 0x05, 0x4b, 0x0e, 0x60, 0x2b, 0xc8, 0x52, 0xc9, 0x27, 0xca, 0xee, 0x23,
+	0xc9, 0x26, 0x69, 0x6f, 0xb9, 0xec, 0x95, 0x83, 0x32, 0x9d, 0xce, 0x0d, 0x20, 0xef, 0xc2, 0x16,
+	0x66, 0x5d, 0x3f, 0x8c, 0x99, 0xa0, 0x59, 0x2c, 0xc3, 0x09, 0xeb, 0xad, 0xa8, 0xa5, 0x9e, 0xc3,
+	0x7b, 0xff, 0xd6, 0x80, 0xcd, 0x8a, 0xc4, 0xda, 0xe4, 0xf7, 0x00, 0xd6, 0x06, 0x2c, 0x0d, 0x44,
+	0xa8, 0x5c, 0x68, 0x22, 0xc7, 0x45, 0x61, 0x12, 0xd1, 0x80, 0x49, 0x59, 0x06, 0x42, 0xd3, 0xd9,
+	0x65, 0xc2, 0x02, 0xc9, 0x06, 0x5f, 0x66, 0x32, 0xc9, 0xa4, 0x0a, 0x9d, 0x0e, 0xad, 0x60, 0x31,
+	0xa7, 0xa7, 0x6c, 0xca, 0x44, 0x28, 0x67, 0x2a, 0x78, 0x3a, 0x34, 0x87, 0x8b, 0x8c, 0xb1, 0xec,
+	0x66, 0x8c, 0x72, 0xa2, 0x5c, 0x99, 0x4b, 0x94, 0xc7, 0xb0, 0xe9, 0x86, 0x1b, 0x66, 0xba, 0x3e,
+	0xac, 0xfa, 0x41, 0xc0, 0x12, 0x99, 0xe7, 0xba, 0x1c, 0xbe, 0x3e, 0xdb, 0xed, 0x43, 0xe7, 0x86,
+	0x4e, 0xf2, 0xfe, 0xb8, 0x01, 0x9b, 0x38, 0x5c, 0xc9, 0xa1, 0x2c, 0xcd, 0x22, 0x49, 0x1e, 0xc2,
+	0x52, 0x28, 0x99, 0x4d, 0x1c, 0xb7, 0x4a, 0x29, 0x40, 0xad, 0xb0, 0x22, 0x2b, 0xff, 0x4a, 0x5f,
+	0x66, 0xa9, 0x3d, 0x2e, 0x34, 0x64, 0xd5, 0x6e, 0x2d, 0x52, 0x1b, 0x35, 0x8d, 0xf8, 0x45, 0x6a,
+	0xdc, 0xae, 0x7e, 0x7b, 0xbf, 0x70, 0x13, 0xb7, 0xd1, 0xa3, 0x0f, 0xab, 0x98, 0x9e, 0x8f, 0x0b,
+	0xab, 0x72, 0xf8, 0xd5, 0x27, 0xff, 0x1e, 0xb4, 0x43, 0x15, 0xc5, 0x4b, 0xe5, 0x28, 0xae, 0x38,
+	0x81, 0x6a, 0x2e, 0xef, 0x1e, 0xf4, 0x9f, 0x32, 0xe9, 0xae, 0x9a, 0xa2, 0xea, 0x54, 0xe1, 0xfd,
+	0x67, 0x03, 0x7a, 0xb5, 0x64, 0x73, 0x88, 0x19, 0x15, 0x1b, 0x75, 0x2a, 0x2e, 0x5c, 0x56, 0xb2,
+	0x0f, 0x6d, 0xb4, 0xd3, 0x9e, 0x39, 0xdf, 0xb5, 0x2c, 0x8b, 0x66, 0x52, 0x79, 0xc9, 0x9c, 0x3b,
+	0x7a, 0x64, 0xff, 0xc7, 0x00, 0x05, 0xb2, 0xe6, 0xb4, 0xf9, 0x5e, 0xf9, 0xb4, 0x99, 0xcf, 0x70,
+	0xd6, 0x0b, 0xc5, 0x49, 0xf3, 0x23, 0xd8, 0x29, 0x29, 0xf0, 0x05, 0xbf, 0xb0, 0x19, 0xf3, 0x8a,
+	0x85, 0xf2, 0xde, 0x81, 0x3b, 0xf3, 0xc3, 0xd0, 0x3d, 0x5b, 0xd0, 0x8a, 0xf8, 0x85, 0xe2, 0x5f,
+	0xa7, 0xf8, 0xd3, 0xfb, 0x21, 0x74, 0x91, 0xe5, 0x84, 0x0b, 0x49, 0xfd, 0xf8, 0x42, 0x15, 0x3d,
+	0x43, 0xc1, 0x27, 0xb6, 0x64, 0xc2, 0xdf, 0x58, 0xf4, 0x48, 0xae, 0xd4, 0xee, 0xd2, 0xa6, 0xe4,
+	0xde, 0x5f, 0x36, 0x01, 0x3e, 0x67, 0x2c, 0xf1, 0xa3, 0x70, 0xca, 0x06, 0x28, 0x75, 0x1a, 0x26,
+	0xd6, 0xd4, 0x69, 0x98, 0x60, 0xf2, 0x89, 0x99, 0x3c, 0x8a, 0x25, 0x13, 0x43, 0x3f, 0xd0, 0x4a,
+	0xea, 0x98, 0x99, 0xc3, 0xe3, 0x7e, 0x19, 0xf9, 0x89, 0xe0, 0x97, 0x33, 0x54, 0x42, 0x45, 0x51,
+	0x97, 0xba, 0x28, 0x94, 0x66, 0xc0, 0x53, 0xe9, 0xcb, 0x54, 0xb1, 0x2d, 0x29, 0xb6, 0x39, 0x3c,
+	0x79, 0x1b, 0x36, 0xa7, 0xa1, 0x90, 0x99, 0x1f, 0x51, 0x9e, 0x49, 0x26, 0x8e, 0x9e, 0xa8, 0x3c,
+	0xd2, 0xa5, 0x55, 0x34, 0xf1, 0x60, 0x1d, 0xab, 0xbd, 0x13, 0x3f, 0x4d, 0x9f, 0x73, 0x31, 0xe8,
+	0x2d, 0x2b, 0xfd, 0x4a, 0x38, 0xf2, 0x3e, 0xdc, 0x1e, 0x31, 0x3f, 0x92, 0x23, 0xbd, 0x0f, 0x51,
+	0xef, 0xa9, 0x1f, 0xa9, 0x2c, 0xd3, 0xa5, 0x75, 0x24, 0x6f, 0x0f, 0xd6, 0xbf, 0xe0, 0xfe, 0xe0,
+	0xdc, 0x8f, 0xfc, 0x38, 0x60, 0xc2, 0xd4, 0x8b, 0x8d, 0xbc, 0x5e, 0xb4, 0x15, 0x69, 0xb3, 0xa8,
+	0x48, 0xbd, 0x2f, 0x61, 0xe5, 0xf1, 0xd3, 0x93, 0x13, 0xc6, 0x04, 0x9e, 0xbb, 0xfe, 0x60, 0x20,
+	0x58, 0x6a, 0x03, 0xd8, 0x82, 0x28, 0xc8, 0x4f, 0xed, 0x1a, 0xf8, 0x29, 0xae, 0x7f, 0x62, 0x55,
+	0x37, 0xd5, 0xaf, 0x85, 0xbd, 0x7f, 0x69, 0xc0, 0x0a, 0x9e, 0x5b, 0x5f, 0x1d, 0x9d, 0xdc, 0x70,
+	0x71, 0x08, 0x2c, 0x4d, 0xb0, 0x8e, 0xd3, 0x33, 0xa8, 0xdf, 0xa8, 0x63, 0xc4, 0x03, 0x3f, 0xda,
+	0x3f, 0x35, 0xab, 0x60, 0x41, 0xd4, 0x49, 0xb8, 0x5e, 0xef, 0xd0, 0x1c, 0x26, 0xdf, 0x85, 0xd5,
+	0xf3, 0x8b, 0x04, 0x8d, 0xb4, 0x87, 0xd9, 0xa6, 0xdd, 0x00, 0xc6, 0x78, 0x9a, 0x33, 0x60, 0xaa,
+	0x0f, 0x27, 0xfe, 0x85, 0x3d, 0xb1, 0x34, 0xe0, 0xfd, 0xb2, 0x01, 0xdb, 0x75, 0xc7, 0x71, 0xed,
+	0xed, 0x61, 0x0f, 0x60, 0x9c, 0x87, 0xa8, 0xd9, 0x72, 0x24, 0x3f, 0xd4, 0x73, 0x0a, 0x75, 0xb8,
+	0xc8, 0x23, 0x58, 0x8f, 0x9c, 0xc5, 0x33, 0x19, 0x6d, 0xdb, 0x8e, 0x72, 0x17, 0x96, 0x96, 0x38,
+	0xc9, 0x3b, 0xb0, 0x32, 0xd6, 0x0e, 0x57, 0x3e, 0x71, 0x8c, 0x33, 0xeb, 0x40, 0x2d, 0xdd, 0xfb,
+	0xc7, 0x0e, 0x74, 0x0f, 0xa2, 0x2c, 0x95, 0x4c, 0xe4, 0xc5, 0xf2, 0x5a, 0xa0, 0x11, 0xce, 0x6e,
+	0x76, 0x51, 0x0b, 0x6b, 0x95, 0xe6, 0x2b, 0xd7, 0x2a, 0x1f, 0x43, 0x37, 0x76, 0xf7, 0xbd, 0xb1,
+	0xf5, 0x8e, 0x9b, 0x94, 0x72, 0x22, 0x2d, 0xf3, 0x92, 0x43, 0x00, 0x44, 0x7c, 0xe1, 0x9f, 0xb3,
+	0xc8, 0x26, 0xf5, 0x87, 0xf9, 0x91, 0xe5, 0xda, 0xb6, 0x7b, 0x9c, 0xf3, 0x99, 0x1a, 0xbd, 0x18,
+	0x48, 0xce, 0x60, 0x13, 0xa1, 0xfd, 0x38, 0xe6, 0xd2, 0xd7, 0x25, 0x5c, 0x5b, 0xc9, 0x7a, 0x77,
+	0xb1, 0x2c, 0x87, 0x59, 0x0b, 0xac, 0x8a, 0xc0, 0x0c, 0xa0, 0xc2, 0x85, 0xb2, 0x84, 0xa7, 0xa1,
+	0xe4, 0x62, 0x66, 0xb6, 0x76, 0x15, 0x8d, 0xa5, 0x6c, 0x5e, 0x9d, 0x99, 0x48, 0x2b, 0x10, 0x58,
+	0x28, 0x97, 0xea, 0xb2, 0xde, 0xaa, 0x2e, 0x94, 0x4b, 0x48, 0xf2, 0x1e, 0xdc, 0x42, 0xff, 0x8a,
+	0x98, 0x49, 0x96, 0xda, 0x92, 0xba, 0xa3, 0x38, 0xe7, 0x09, 0x35, 0x35, 0x2b, 0xbc, 0x54, 0xcd,
+	0x5a, 0xae, 0x30, 0xd7, 0x5e, 0xa0, 0xc2, 0x5c, 0xaf, 0xad, 0x30, 0x3f, 0x81, 0x8d, 0x30, 0xbe,
+	0xc0, 0xbc, 0x62, 0xf5, 0xe8, 0x96, 0xf5, 0x38, 0x2a, 0x51, 0x69, 0x85, 0xbb, 0xb6, 0xb8, 0xdc,
+	0xac, 0x2f, 0x2e, 0xc9, 0xbe, 0x5e, 0xe5, 0xa3, 0x38, 0x94, 0x27, 0x82, 0x0f, 0xc3, 0x88, 0xf5,
+	0xb6, 0xe6, 0x0f, 0x40, 0x87, 0x4c, 0xab, 0xfc, 0xe4, 0x1d, 0x68, 0xab, 0x34, 0xdf, 0xbb, 0xa5,
+	0x06, 0xde, 0xb6, 0x03, 0x4f, 0x10, 0x69, 0xee, 0x05, 0x9a, 0x83, 0x7c, 0x00, 0x20, 0xd8, 0x45,
+	0x98, 0x4a, 0x11, 0xb2, 0xb4, 0x47, 0x1e, 0xb4, 0x5c, 0xab, 0xa8, 0xa6, 0xd8, 0x21, 0x0e, 0x27,
+	0x6a, 0x19, 0xf0, 0x49, 0xc2, 0x63, 0x16, 0x4b, 0x4d, 0xee, 0xdd, 0x2e, 0x6b, 0x79, 0x50, 0x26,
+	0xd3, 0x2a, 0x3f, 0x6a, 0xe9, 0x67, 0x83, 0x50, 0xf6, 0xb6, 0xcb, 0x5a, 0xee, 0x23, 0xd2, 0x6a,
+	0xa9, 0x38, 0xc8, 0x23, 0x00, 0x16, 0x07, 0x62, 0xa6, 0x4b, 0xc4, 0x3b, 0x8a, 0xbf, 0x97, 0x57,
+	0x25, 0x39, 0xc5, 0xea, 0x59, 0xf0, 0xf6, 0x7f, 0x53, 0x97, 0x6c, 0xce, 0x96, 0xaa, 0xa9, 0x34,
+	0xb6, 0xdd, 0x4a, 0xa3, 0xe3, 0x14, 0x14, 0xfd, 0xc7, 0xb0, 0x5d, 0xb7, 0x8b, 0x5e, 0x46, 0x86,
+	0xf7, 0xa7, 0x0d, 0x58, 0x73, 0x6c, 0x42, 0xce, 0x88, 0x4d, 0x59, 0x64, 0x46, 0x6b, 0x40, 0xb5,
+	0x13, 0x78, 0x14, 0x06, 0x33, 0x5b, 0x2c, 0x6a, 0x08, 0xf1, 0x13, 0xff, 0x72, 0xdf, 0x64, 0x9c,
+	0x2e, 0x35, 0x90, 0xba, 0x57, 0xfa, 0x97, 0x8f, 0xfd, 0x60, 0x9c, 0x25, 0xe6, 0x5c, 0x29, 0x10,
+	0x78, 0xe6, 0x4c, 0xfc, 0xcb, 0xd3, 0xf0, 0xe7, 0xcc, 0x1c, 0xe7, 0x16, 0xf4, 0x76, 0x61, 0xab,
+	0xea, 0x30, 0x75, 0x36, 0x0a, 0x3e, 0x0d, 0x07, 0x4c, 0xd8, 0xda, 0xc8, 0xc2, 0xde, 0x1f, 0xb5,
+	0x61, 0xb3, 0xb2, 0x94, 0xe4, 0x67, 0x40, 0xfc, 0x24, 0xd4, 0x09, 0xf2, 0xf0, 0x52, 0x0a, 0x7f,
+	0x5f, 0xe4, 0x17, 0xd1, 0xef, 0x2f, 0x58, 0xff, 0xdd, 0xfd, 0xb9, 0x11, 0x3a, 0x21, 0xd5, 0x88,
+	0x22, 0xcf, 0xa1, 0x5f, 0xb4, 0xc0, 0x9e, 0xf9, 0xb1, 0x7f, 0xe1, 0x4e, 0xd4, 0x54, 0x13, 0xfd,
+	0xfa, 0xa2, 0x89, 0x0e, 0x16, 0x8e, 0xd4, 0x13, 0x5e, 0x21, 0x1a, 0x2d, 0x4b, 0x83, 0x11, 0x1b,
+	0x64, 0x91, 0x3b, 0x61, 0xeb, 0x6a, 0xcb, 0x4e, 0xe7, 0x46, 0x18, 0xcb, 0xe6, 0x45, 0x91, 0x3d,
+	0x7d, 0x32, 0x45, 0xcc, 0x0c, 0xce, 0x84, 0x0a, 0x2b, 0x73, 0xcf, 0xa8, 0xa5, 0xa9, 0x16, 0x44,
+	0x76, 0xce, 0xd4, 0xee, 0x7d, 0xc6, 0x07, 0x7a, 0x49, 0x3b, 0xb4, 0x8c, 0xec, 0x1f, 0xc2, 0xce,
+	0x02, 0x17, 0xbf, 0x54, 0xc4, 0x3f, 0x83, 0x37, 0xaf, 0x71, 0xe0, 0x4b, 0x89, 0x3b, 0x84, 0x9d,
+	0x05, 0xee, 0x79, 0xa9, 0x3d, 0xf4, 0x37, 0x0d, 0xd8, 0x28, 0x67, 0x23, 0x75, 0x75, 0x51, 0xc6,
+	0xe6, 0x57, 0x17, 0x05, 0x95, 0xda, 0x9c, 0xcd, 0x72, 0x9b, 0xf3, 0xaa, 0x22, 0x10, 0x69, 0x81,
+	0xff, 0x38, 0x8b, 0x07, 0x11, 0x33, 0xab, 0x91, 0xc3, 0x48, 0x0b, 0xe3, 0x94, 0x05, 0x99, 0xd0,
+	0xce, 0x5f, 0xa5, 0x39, 0xac, 0xb6, 0x5a, 0x28, 0x04, 0x17, 0xf6, 0xa2, 0x6d, 0x41, 0x8f, 0xc1,
+	0x9a, 0x93, 0x71, 0x71, 0xc7, 0x8e, 0xa4, 0x4c, 0x14, 0xca, 0xe8, 0x5c, 0x20, 0xf0, 0xa8, 0x42,
+	0x20, 0xd5, 0x64, 0xad, 0xb8, 0x83, 0xc1, 0x69, 0x62, 0xae, 0x89, 0x2d, 0x3d, 0x8d, 0x01, 0xbd,
+	0x7f, 0x6a, 0xe9, 0x1c, 0xe7, 0x9e, 0x00, 0x7d, 0x58, 0xc5, 0xc3, 0xe4, 0xe7, 0x3c, 0xce, 0x6f,
+	0x3b, 0x16, 0xc6, 0x99, 0x62, 0x99, 0xe8, 0x40, 0xb1, 0xed, 0x44, 0x07, 0x43, 0x3e, 0x86, 0xe5,
+	0x74, 0x96, 0x06, 0x32, 0x32, 0x71, 0xff, 0xed, 0x05, 0xe7, 0xce, 0xee, 0xa9, 0xe2, 0xd2, 0xb1,
+	0x6e, 0x86, 0xe0, 0x2d, 0x61, 0x18, 0x0a, 0xf6, 0xdc, 0x8f, 0x22, 0x15, 0xaa, 0xda, 0x93, 0x25,
+	0x1c, 0xd6, 0x6f, 0x29, 0x8b, 0xc2, 0x38, 0xbb, 0x74, 0xa2, 0xd9, 0x45, 0xa1, 0x8a, 0xe9, 0x73,
+	0x3f, 0x39, 0xd1, 0x09, 0x51, 0x97, 0x23, 0x0e, 0x06, 0xcf, 0xed, 0x11, 0x4f, 0x25, 0xae, 0xa9,
+	0xe1, 0xd1, 0xe5, 0x48, 0x05, 0x4b, 0x1e, 0xd9, 0x8b, 0xf4, 0xaa, 0xb2, 0xc4, 0x5b, 0x64, 0x89,
+	0x6a, 0x01, 0x99, 0xcb, 0xa9, 0x1a, 0xd0, 0xff, 0x10, 0xd6, 0x1c, 0xf3, 0x5e, 0x2a, 0xe4, 0x1f,
+	0x01, 0x14, 0xf2, 0xae, 0x1b, 0xb9, 0xea, 0x46, 0xf9, 0x53, 0x68, 0x9f, 0xf9, 0x61, 0x2c, 0x5f,
+	0x74, 0x3a, 0xdc, 0x03, 0x6c, 0x38, 0xb4, 0x5d, 0xb8, 0x0e, 0x35, 0x90, 0xf7, 0x5f, 0x0d, 0xd8,
+	0x42, 0x1b, 0x9f, 0xa8, 0xe7, 0x84, 0x1b, 0xf6, 0x98, 0x7f, 0x03, 0x96, 0x23, 0x5d, 0xb9, 0xea,
+	0x78, 0xf8, 0x8e, 0x3b, 0xd2, 0x9d, 0x61, 0xd7, 0x2d, 0x5c, 0xcd, 0x18, 0xf2, 0x10, 0x96, 0xb1,
+	0xbe, 0x91, 0xb6, 0xee, 0xcd, 0x9b, 0x09, 0xca, 0x52, 0x6a, 0x88, 0xe8, 0xef, 0x57, 0x3c, 0xa3,
+	0xbd, 0x5f, 0x36, 0xa1, 0xab, 0xd5, 0xb0, 0x77, 0xfd, 0x8f, 0x60, 0x0d, 0xed, 0x39, 0x28, 0x75,
+	0x48, 0x7b, 0x8b, 0xd4, 0xa6, 0x2e, 0x33, 0x16, 0xfa, 0x81, 0x5b, 0x45, 0xf7, 0x9a, 0xe5, 0x42,
+	0xbf, 0x54, 0x62, 0xd3, 0x32, 0x2f, 0xf9, 0x09, 0xdc, 0x62, 0x97, 0x2c, 0xc8, 0x30, 0x6d, 0xab,
+	0x10, 0x0c, 0xf3, 0x0e, 0xc9, 0x7b, 0x56, 0x40, 0x49, 0xd5, 0xdd, 0xc3, 0x2a, 0xbb, 0xf6, 0xde,
+	0xbc, 0x98, 0xfe, 0x4f, 0xe1, 0x6e, 0x3d, 0xf3, 0x4b, 0xb4, 0x4e, 0xca, 0x02, 0x66, 0xae, 0x17,
+	0x7f, 0x1f, 0x36, 0x2b, 0x54, 0x75, 0xcf, 0xe2, 0x71, 0x90, 0x09, 0xc1, 0xe2, 0x40, 0xcb, 0x6f,
+	0x53, 0x17, 0x85, 0x29, 0xed, 0xdc, 0x97, 0xc1, 0x48, 0x15, 0x1a, 0x4d, 0x45, 0x2f, 0x10, 0x58,
+	0xf5, 0x0e, 0xfd, 0x30, 0xca, 0x04, 0xcb, 0x9b, 0xee, 0x2a, 0x4e, 0xdb, 0x74, 0x0e, 0x8f, 0x2d,
+	0x92, 0xee, 0x99, 0x9f, 0x8e, 0x73, 0x1d, 0x54, 0x0a, 0xf3, 0xd3, 0xb1, 0xdb, 0xb0, 0xb1, 0xb0,
+	0xa5, 0x9d, 0xcd, 0x12, 0x3d, 0x6d, 0x87, 0xe6, 0x30, 0xf9, 0x7e, 0x5e, 0x48, 0xb5, 0xae, 0x36,
+	0xde, 0xb0, 0x61, 0x64, 0x49, 0x2e, 0xfd, 0x48, 0xe5, 0xaa, 0x36, 0xd5, 0x00, 0x9a, 0x96, 0x66,
+	0x41, 0xc0, 0x18, 0x3e, 0x34, 0xb4, 0xb5, 0x69, 0x39, 0x02, 0x37, 0x1e, 0x9a, 0xc0, 0x74, 0x1b,
+	0xa4, 0x4d, 0x0d, 0x84, 0x59, 0x3a, 0x1d, 0x87, 0x49, 0xc2, 0x06, 0x2a, 0x23, 0xb5, 0xa9, 0x05,
+	0xc9, 0x23, 0xd8, 0xa9, 0x1a, 0x4d, 0x99, 0x8f, 0x47, 0xa3, 0xba, 0x28, 0xad, 0xd2, 0x45, 0x64,
+	0xef, 0xb7, 0x60, 0xcd, 0xc6, 0xcc, 0x8d, 0xbb, 0xb1, 0x3d, 0xb8, 0xfb, 0x94, 0x49, 0x2b, 0xce,
+	0x6d, 0x13, 0xc6, 0x00, 0x1a, 0x6d, 0x1b, 0xb5, 0xb8, 0xf9, 0x6d, 0x87, 0x00, 0x7f, 0x97, 0x3a,
+	0x68, 0xcd, 0x4a, 0xab, 0xf3, 0x7d, 0xb8, 0x6d, 0xd4, 0x3f, 0xf0, 0xe3, 0xc7, 0xec, 0xe8, 0x22,
+	0xe6, 0x82, 0xe9, 0xd5, 0x5e, 0xa5, 0x75, 0x24, 0xef, 0x2f, 0x1a, 0xb0, 0x55, 0x4c, 0xa8, 0x75,
+	0xc1, 0x26, 0xc4, 0x20, 0xc7, 0xf5, 0x1a, 0xe5, 0x26, 0x84, 0xc3, 0xed, 0x70, 0xfd, 0xff, 0xb6,
+	0x78, 0xff, 0xb7, 0x01, 0xdb, 0x73, 0x0e, 0xba, 0x51, 0xa3, 0x74, 0xd7, 0x1e, 0x41, 0xad, 0x72,
+	0x16, 0xaa, 0xda, 0x6e, 0x0e, 0x1e, 0xf2, 0x21, 0xac, 0x61, 0xff, 0x7e, 0x38, 0x3b, 0x7a, 0x91,
+	0x0e, 0xb0, 0xcb, 0x4b, 0x7e, 0x04, 0x90, 0xa7, 0x0d, 0xdb, 0x1a, 0xb8, 0x53, 0xa4, 0x5b, 0x67,
+	0x73, 0x51, 0x87, 0xd1, 0x3b, 0x84, 0xdb, 0xb9, 0xc9, 0x4e, 0xc3, 0xf4, 0x25, 0x43, 0xc0, 0x7b,
+	0x08, 0xb7, 0xca, 0x62, 0xea, 0x1b, 0xa8, 0x1f, 0xc1, 0xdd, 0x4f, 0x99, 0x0c, 0x46, 0xd8, 0x7b,
+	0x31, 0x49, 0xf4, 0x85, 0x5f, 0x62, 0xbf, 0x86, 0xed, 0xb9, 0xb1, 0x38, 0xcb, 0x1b, 0x00, 0xe3,
+	0x1c, 0x65, 0x26, 0x73, 0x30, 0xd7, 0x6f, 0x8b, 0xff, 0x68, 0x42, 0xf7, 0xc0, 0x8f, 0xc2, 0x80,
+	0xdb, 0x1b, 0xfb, 0x1e, 0x6c, 0x07, 0xe6, 0x15, 0x4d, 0xbd, 0xfa, 0x4e, 0x43, 0x39, 0xdb, 0x8f,
+	0x22, 0xb3, 0xe3, 0x6a, 0x69, 0xd8, 0xdb, 0x60, 0x71, 0xe0, 0x27, 0x69, 0x16, 0xa9, 0xc2, 0x5d,
+	0x55, 0x37, 0xda, 0x4d, 0xf3, 0x04, 0x4c, 0x30, 0xd3, 0xcb, 0xc8, 0x8f, 0x55, 0x7b, 0x16, 0xf4,
+	0x05, 0x2e, 0x47, 0x60, 0xcd, 0x1f, 0xc6, 0x21, 0xbe, 0xdb, 0x9f, 0xf0, 0xc1, 0xd1, 0x09, 0x36,
+	0x2f, 0x54, 0xcd, 0x5f, 0x42, 0x62, 0xba, 0x99, 0x32, 0x39, 0x7a, 0x26, 0xb3, 0xde, 0xba, 0xbe,
+	0xe6, 0x19, 0x10, 0x75, 0x09, 0x93, 0x27, 0x4c, 0xea, 0x17, 0x6b, 0xfd, 0xb8, 0xa3, 0x9a, 0x16,
+	0x1d, 0x3a, 0x4f, 0x40, 0x6b, 0x1d, 0x64, 0xde, 0xd2, 0xec, 0x6d, 0xe8, 0x5b, 0x49, 0x1d, 0x8d,
+	0xec, 0x02, 0x71, 0x95, 0x99, 0x7e, 0x70, 0xc2, 0x79, 0x64, 0xba, 0x1a, 0x35, 0x14, 0xef, 0x77,
+	0x61, 0xe3, 0xd3, 0xc8, 0x8f, 0x63, 0x16, 0x59, 0x1f, 0xf7, 0x60, 0xe5, 0xdc, 0x0f, 0xc6, 0x2c,
+	0x1e, 0xd8, 0xe6, 0xad, 0x01, 0xcb, 0xbe, 0x69, 0x56, 0x7d, 0x83, 0xdd, 0x4e, 0xa5, 0x5e, 0xcb,
+	0x74, 0x3b, 0x11, 0xf0, 0x38, 0x74, 0x0f, 0xc2, 0x28, 0xcc, 0x26, 0x4e, 0xf3, 0x47, 0x66, 0x38,
+	0xdf, 0x33, 0x1b, 0x55, 0x1d, 0xea, 0x60, 0x30, 0x36, 0x27, 0x32, 0x33, 0xe2, 0x5b, 0x13, 0xed,
+	0xb4, 0xd8, 0x97, 0xe1, 0x94, 0x61, 0xd3, 0x3b, 0x8c, 0x2f, 0x0e, 0x8e, 0x9e, 0x50, 0x33, 0xc9,
+	0x3c, 0xc1, 0xfb, 0xef, 0x06, 0x6c, 0x94, 0xfb, 0x4f, 0x78, 0x62, 0x9a, 0x0e, 0xd4, 0x59, 0xd1,
+	0x5f, 0x75, 0x51, 0xaa, 0xbc, 0x70, 0x03, 0xcd, 0x34, 0xb4, 0x8a, 0xf2, 0xc2, 0x25, 0xd2, 0x32,
+	0x2f, 0xb6, 0xa1, 0x86, 0x25, 0x17, 0xaa, 0xa8, 0x70, 0x1a, 0x36, 0x65, 0x07, 0xd3, 0x0a, 0xb7,
+	0x9a, 0xdc, 0x75, 0x51, 0x6f, 0xbd, 0x32, 0xb9, 0x4b, 0xa4, 0x65, 0x5e, 0xef, 0xef, 0x9b, 0xb0,
+	0x51, 0x6e, 0x73, 0xa1, 0xb9, 0xa6, 0xd1, 0xe5, 0x9a, 0xeb, 0xa0, 0x70, 0x0d, 0xd8, 0x65, 0xc2,
+	0x53, 0xe6, 0xec, 0x05, 0x07, 0xa3, 0x3a, 0xe0, 0x2c, 0x89, 0xc2, 0xc0, 0x4f, 0x4d, 0x7f, 0x23,
+	0x87, 0xf1, 0x2a, 0x81, 0xf7, 0x1f, 0xdb, 0x59, 0x35, 0x4d, 0x8e, 0x12, 0x0e, 0xb7, 0x09, 0xc2,
+	0x69, 0xce, 0xa4, 0xbb, 0x1d, 0x65, 0x24, 0xf9, 0x35, 0xb8, 0x33, 0x60, 0x43, 0x3f, 0x8b, 0xe4,
+	0xd9, 0x17, 0xa7, 0x07, 0x4c, 0xc8, 0x70, 0x18, 0x06, 0xbe, 0x64, 0xe6, 0x66, 0x51, 0x4f, 0xc4,
+	0xc6, 0x68, 0xd1, 0x29, 0x38, 0x72, 0xda, 0xeb, 0x55, 0x34, 0x5a, 0xa9, 0xba, 0x69, 0x9a, 0x49,
+	0xf7, 0x3d, 0x1d, 0x8c, 0x37, 0x85, 0x37, 0xf4, 0xe3, 0x92, 0x0e, 0x04, 0x4c, 0x78, 0xa1, 0x60,
+	0x13, 0x16, 0xdb, 0xd3, 0x97, 0x78, 0xf6, 0x39, 0x4d, 0xd7, 0xaa, 0xe5, 0xe4, 0xa7, 0x49, 0xf8,
+	0xb9, 0x04, 0x7f, 0xa1, 0x97, 0x7b, 0xcb, 0xe6, 0xfd, 0x7b, 0x03, 0x76, 0xdc, 0x24, 0xe5, 0x3e,
+	0x5c, 0xbe, 0x05, 0x1b, 0xa7, 0x3c, 0x13, 0x01, 0x3b, 0x2e, 0xbf, 0x8a, 0x55, 0xb0, 0x78, 0xb2,
+	0x3f, 0x61, 0xa9, 0x0c, 0x63, 0x95, 0xb9, 0x8e, 0xcb, 0xd9, 0xbf, 0x8e, 0xe4, 0x1c, 0x95, 0xad,
+	0xba, 0xa3, 0x72, 0xe9, 0xfa, 0x67, 0xcf, 0xf6, 0x0b, 0x3d, 0x7b, 0xfe, 0x73, 0x03, 0xee, 0x2f,
+	0x70, 0x6b, 0x7a, 0xb3, 0x4f, 0x74, 0x50, 0x13, 0xf7, 0x75, 0x73, 0xf1, 0xd3, 0xa3, 0x5e, 0x99,
+	0xa7, 0xb0, 0x11, 0x14, 0x6e, 0x0e, 0x99, 0x3d, 0xb6, 0xdf, 0x2c, 0x3a, 0x46, 0xb5, 0x8b, 0x40,
+	0x2b, 0xc3, 0xbc, 0x3f, 0x6b, 0xc0, 0x36, 0x65, 0x81, 0xe9, 0xfe, 0xb0, 0xcf, 0xf6, 0xbf, 0xe9,
+	0x1b, 0x8d, 0xf7, 0x0c, 0x48, 0x45, 0xa1, 0x1b, 0x7d, 0xfb, 0xf4, 0x8b, 0x06, 0xf4, 0x29, 0x97,
+	0xbe, 0x64, 0x45, 0x13, 0xf2, 0x73, 0xf6, 0x8d, 0x5f, 0xdc, 0xbc, 0xaf, 0xa1, 0x57, 0xab, 0xd6,
+	0x8d, 0x0b, 0xee, 0x87, 0xf0, 0xed, 0xa7, 0x4c, 0xd6, 0xca, 0x76, 0xab, 0xef, 0xdf, 0x81, 0x5f,
+	0xb9, 0x9a, 0xed, 0x26, 0x35, 0xa8, 0xf7, 0x57, 0x0d, 0xd8, 0xf9, 0xed, 0x64, 0xe0, 0x4b, 0xb5,
+	0xad, 0xcb, 0x55, 0xd7, 0x37, 0x76, 0x57, 0xc6, 0x2f, 0xf9, 0xc4, 0x8c, 0x66, 0xb1, 0xb9, 0x25,
+	0x18, 0xc8, 0xfb, 0x03, 0xb8, 0x33, 0xaf, 0xeb, 0x8d, 0x76, 0xf3, 0x7b, 0xd0, 0x1e, 0x84, 0xc3,
+	0xa1, 0xdd, 0xcd, 0x77, 0x4b, 0xbb, 0x59, 0x4d, 0xf0, 0x24, 0x1c, 0x0e, 0xa9, 0x66, 0xf2, 0xfe,
+	0x16, 0x8f, 0xf5, 0x12, 0xe5, 0xca, 0x8f, 0x3c, 0xf6, 0x60, 0x25, 0x18, 0xe1, 0x2b, 0x9f, 0xed,
+	0x4b, 0xf7, 0xe6, 0xc5, 0x1f, 0x28, 0x06, 0x6a, 0x19, 0xc9, 0xfb, 0x68, 0x7a, 0x38, 0x94, 0x73,
+	0x97, 0x82, 0xb9, 0x21, 0x86, 0xef, 0xda, 0xdc, 0xe9, 0xfd, 0x89, 0xe9, 0xf8, 0xb8, 0xa3, 0xb1,
+	0x84, 0x1f, 0x87, 0x79, 0x75, 0xa5, 0x7e, 0xdb, 0x66, 0x41, 0xb3, 0x68, 0x16, 0xdc, 0x85, 0x65,
+	0x5f, 0xd5, 0x76, 0x36, 0x5f, 0x6b, 0x08, 0xad, 0xe6, 0xd1, 0xe0, 0x2b, 0xd5, 0x47, 0x30, 0x0d,
+	0x51, 0x0b, 0x2b, 0x8f, 0xb0, 0xe7, 0x9a, 0x66, 0x5e, 0xae, 0x2d, 0xec, 0xfd, 0x6b, 0x03, 0x36,
+	0x4f, 0x22, 0x3f, 0x76, 0x2f, 0xad, 0xaf, 0xbc, 0x76, 0xef, 0xc2, 0x52, 0x12, 0xf9, 0xb1, 0xb9,
+	0xc6, 0xdd, 0x2d, 0xdf, 0x9e, 0x70, 0x16, 0xbc, 0xd7, 0x50, 0xc5, 0x83, 0xeb, 0x8c, 0xdd, 0x3c,
+	0x9b, 0x7d, 0x6b, 0x98, 0x3f, 0xc5, 0xe7, 0x32, 0xcd, 0x44, 0xf6, 0xa0, 0xc3, 0x64, 0x30, 0xc0,
+	0x13, 0xdf, 0x9e, 0x38, 0xf9, 0xcb, 0xf5, 0xa1, 0x21, 0xe0, 0x18, 0x5a, 0xb0, 0x79, 0xff, 0xd0,
+	0x80, 0x8d, 0xf2, 0xd4, 0xb5, 0x9f, 0x34, 0xd9, 0xf7, 0xf5, 0xa6, 0xf3, 0xbe, 0xae, 0xde, 0x58,
+	0x42, 0xae, 0xbe, 0xd4, 0xd2, 0x4d, 0x90, 0x1c, 0xc6, 0x18, 0xd2, 0x3e, 0xb7, 0xaa, 0xf7, 0xe6,
+	0x55, 0xdf, 0x57, 0x0c, 0xd4, 0x32, 0x92, 0x3d, 0x58, 0x4d, 0xb3, 0x73, 0x54, 0xc1, 0x6a, 0xbf,
+	0xc8, 0x39, 0x39, 0x9f, 0x77, 0x0c, 0x5b, 0x05, 0x4d, 0x0b, 0x7c, 0x61, 0xfd, 0x89, 0xb9, 0x9d,
+	0x99, 0x2f, 0x1b, 0xf0, 0xb7, 0x47, 0x61, 0xa3, 0xec, 0xdb, 0x9c, 0xab, 0x51, 0x70, 0x21, 0x2e,
+	0xf1, 0xcd, 0x07, 0xc4, 0x1d, 0xaa, 0x7e, 0xab, 0xef, 0x25, 0x79, 0x2c, 0x59, 0x6c, 0x3b, 0x97,
+	0x16, 0xf4, 0x12, 0x58, 0x77, 0xbd, 0x5f, 0x2b, 0x11, 0xbf, 0x74, 0xe6, 0x93, 0x09, 0x8f, 0x9d,
+	0x52, 0xc4, 0xc1, 0xa0, 0xaf, 0x07, 0x71, 0x8a, 0x3f, 0x53, 0xd3, 0x2c, 0xcf, 0x61, 0x8c, 0xff,
+	0x30, 0xb1, 0x5f, 0x1b, 0xe2, 0xcf, 0xbd, 0xbf, 0xee, 0xc0, 0x66, 0x9e, 0xe3, 0xa4, 0x2a, 0xeb,
+	0xc8, 0x31, 0x6c, 0x94, 0xbf, 0xf9, 0x25, 0xf7, 0xf3, 0x8b, 0x74, 0xdd, 0x67, 0xc4, 0xfd, 0xd7,
+	0x17, 0x91, 0x93, 0x68, 0xe6, 0xbd, 0x46, 0x1e, 0x03, 0x14, 0x9f, 0x17, 0x91, 0x6f, 0x95, 0x3e,
+	0x57, 0x73, 0x3f, 0xec, 0xec, 0xef, 0xd4, 0x91, 0xb4, 0x8c, 0x9f, 0xaa, 0x7b, 0x7a, 0xf5, 0xeb,
+	0x2a, 0xe2, 0x5d, 0xf9, 0xe9, 0x95, 0x96, 0xfa, 0xe0, 0xba, 0xcf, 0xb3, 0xbc, 0xd7, 0xc8, 0x19,
+	0x6c, 0x55, 0x3f, 0x82, 0x22, 0x6f, 0xd6, 0x8e, 0x2b, 0x9a, 0x04, 0xfd, 0xfb, 0x8b, 0x19, 0xb4,
+	0xd4, 0x0f, 0x60, 0x59, 0xfb, 0x96, 0xdc, 0xa9, 0x6d, 0x80, 0xf6, 0x6f, 0x57, 0xd1, 0x7a, 0xdc,
+	0x8f, 0x61, 0xb3, 0xd2, 0x87, 0x21, 0x6f, 0x38, 0x73, 0xd5, 0x74, 0xb0, 0xfa, 0xf7, 0x16, 0xd2,
+	0xb5, 0xc8, 0xcf, 0x60, 0xdd, 0x6d, 0x50, 0x90, 0xd7, 0xe7, 0xf8, 0x1d, 0xc3, 0xbe, 0x55, 0x4f,
+	0xcc, 0x95, 0xab, 0xf4, 0x21, 0x0a, 0xe5, 0xea, 0x9b, 0x1b, 0xfd, 0x7b, 0x0b, 0xe9, 0x5a, 0xe4,
+	0x18, 0x7a, 0x8b, 0x6a, 0x59, 0xf2, 0x56, 0x39, 0x26, 0x16, 0x5d, 0x22, 0xfa, 0x0f, 0xaf, 0xe1,
+	0xcb, 0x23, 0xe9, 0x73, 0xe8, 0x96, 0x8a, 0x3a, 0x72, 0xaf, 0x78, 0xf1, 0x9f, 0x2f, 0x3e, 0xfb,
+	0xfd, 0x05, 0xd4, 0x3c, 0x2c, 0x6b, 0xea, 0x96, 0x22, 0x2c, 0x17, 0x97, 0x7b, 0xfd, 0x07, 0x57,
+	0xf2, 0x68, 0xf1, 0x97, 0x70, 0xef, 0xaa, 0xca, 0x88, 0xb8, 0x5f, 0x1e, 0x5e, 0x57, 0x66, 0xf5,
+	0xdf, 0x79, 0x31, 0xe6, 0x7c, 0x43, 0x54, 0x0b, 0x91, 0x62, 0x43, 0x2c, 0x28, 0xa7, 0xfa, 0xf7,
+	0x17, 0x33, 0x68, 0xa9, 0x9f, 0x00, 0x14, 0x87, 0xe3, 0xa2, 0x4d, 0x91, 0x67, 0x81, 0xca, 0x39,
+	0xea, 0xbd, 0x76, 0xae, 0xff, 0x13, 0xe4, 0x87, 0xff, 0x37, 0x00, 0x41, 0x3e, 0xcf, 0x6f, 0x2b,
+	0x32, 0x00, 0x00,
 }
@@ -13,9 +13,192 @@
 ## See the License for the specific language governing permissions and
 ## limitations under the License.
 
-# This script is aim to close firewall and disabled setenforce
+# This script is aim to set up firewall and SELinux of node
+# usage: init_change_firewall.sh [--firewall disable|open-ports] [--selinux disabled|permissive|enforcing]
+#            [--port PORT[-PORT]/PROTOCOL]... [--protocol NUMBER]...
+# The firewall is disabled by default. In open-ports mode the firewall is kept, and the given ports and IP protocols
+# are allowed by the active one of firewalld, ufw, nftables and iptables, the rules are persisted to survive reboot.
+# In enforcing mode SELinux is kept enforcing, and the host directories used by containers are labeled for them.
 
-systemctl disable firewalld &>/dev/null
-systemctl stop firewalld &>/dev/null
-setenforce 0 &>/dev/null
-sed -i 's/enforcing/disabled/' /etc/selinux/config
+FIREWALL_MODE=disable
+SELINUX_MODE=disabled
+PORTS=()
+PROTOCOLS=()
+
+# host directories mounted into containers by kubernetes components and network plugins
+CONTAINER_DIRS=(/etc/kubernetes /var/lib/etcd /var/lib/kubelet /etc/cni /opt/cni /var/lib/calico /var/log/pods /var/log/containers)
+
+while [[ $# -gt 0 ]]; do
+    case "$1" in
+        --firewall)
+            FIREWALL_MODE=$2
+            shift
+        ;;
+        --selinux)
+            SELINUX_MODE=$2
+            shift
+        ;;
+        --port)
+            PORTS+=("$2")
+            shift
+        ;;
+        --protocol)
+            PROTOCOLS+=("$2")
+            shift
+        ;;
+        *)
+            echo "invalid option: $1" >&2
+            exit 1
+        ;;
+    esac
+    shift
+done
+
+firewall::disable() {
+    systemctl disable firewalld &>/dev/null
+    systemctl stop firewalld &>/dev/null
+    return 0
+}
+
+firewall::firewalld() {
+    local port protocol
+
+    for port in "${PORTS[@]}"; do
+        firewall-cmd --permanent --add-port=$port 1>/dev/null || return 1
+    done
+    for protocol in "${PROTOCOLS[@]}"; do
+        firewall-cmd --permanent --add-protocol=$protocol 1>/dev/null || return 1
+    done
+    firewall-cmd --reload 1>/dev/null
+}
+
+firewall::ufw() {
+    local port protocol
+
+    # ufw takes port ranges as PORT:PORT
+    for port in "${PORTS[@]}"; do
+        ufw allow ${port/-/:} 1>/dev/null || return 1
+    done
+
+    # ufw only allows some protocols by command, the others are accepted by the before rules
+    for protocol in "${PROTOCOLS[@]}"; do
+        grep -q -- "^-A ufw-before-input -p $protocol -j ACCEPT" /etc/ufw/before.rules ||
+            sed -i "0,/^COMMIT/s//-A ufw-before-input -p $protocol -j ACCEPT\nCOMMIT/" /etc/ufw/before.rules || return 1
+    done
+    [[ ${#PROTOCOLS[@]} -eq 0 ]] || ufw reload 1>/dev/null
+}
+
+firewall::nftables() {
+    local port protocol chain
+
+    # rules are added to the input chain of the distro's filter table, which is the one dropping packets
+    chain=$(nft list chains 2>/dev/null | awk '/^table/{table=$2" "$3} /chain input/{print table" input"; exit}')
+    [[ -n $chain ]] || return 0
+
+    for port in "${PORTS[@]}"; do
+        nft list chain $chain | grep -q "${port#*/} dport ${port%/*} accept" && continue
+        nft insert rule $chain ${port#*/} dport ${port%/*} accept || return 1
+    done
+    for protocol in "${PROTOCOLS[@]}"; do
+        nft list chain $chain | grep -q "meta l4proto $protocol accept" && continue
+        nft insert rule $chain meta l4proto $protocol accept || return 1
+    done
+
+    for conf in /etc/sysconfig/nftables.conf /etc/nftables.conf; do
+        [[ -f $conf ]] && {
+            echo "flush ruleset" > $conf
+            nft list ruleset >> $conf
+            break
+        }
+    done
+    return 0
+}
+
+firewall::iptables() {
+    local port protocol cmd
+
+    for cmd in iptables ip6tables; do
+        command -v $cmd &>/dev/null || continue
+
+        for port in "${PORTS[@]}"; do
+            # iptables takes port ranges as PORT:PORT
+            local rule="-p ${port#*/} -m ${port#*/} --dport $(echo ${port%/*} | tr - :) -j ACCEPT"
+            $cmd -C INPUT $rule &>/dev/null || $cmd -I INPUT $rule || return 1
+        done
+        for protocol in "${PROTOCOLS[@]}"; do
+            $cmd -C INPUT -p $protocol -j ACCEPT &>/dev/null || $cmd -I INPUT -p $protocol -j ACCEPT || return 1
+        done
+    done
+
+    if [[ -f /etc/sysconfig/iptables ]]; then
+        iptables-save > /etc/sysconfig/iptables
+        [[ -f /etc/sysconfig/ip6tables ]] && ip6tables-save > /etc/sysconfig/ip6tables
+    elif [[ -d /etc/iptables ]]; then
+        iptables-save > /etc/iptables/rules.v4
+        command -v ip6tables-save &>/dev/null && ip6tables-save > /etc/iptables/rules.v6
+    fi
+    return 0
+}
+
+firewall::open_ports() {
+    if systemctl is-active firewalld &>/dev/null; then
+        firewall::firewalld
+    elif command -v ufw &>/dev/null && ufw status | grep -q "Status: active"; then
+        firewall::ufw
+    elif systemctl is-active nftables &>/dev/null; then
+        firewall::nftables
+    elif command -v iptables &>/dev/null; then
+        firewall::iptables
+    fi
+}
+
+selinux::label() {
+    local dir type
+
+    for dir in "${CONTAINER_DIRS[@]}"; do
+        mkdir -p $dir
+        # container_file_t is named svirt_sandbox_file_t by the old container-selinux
+        for type in container_file_t svirt_sandbox_file_t; do
+            if command -v semanage &>/dev/null; then
+                { semanage fcontext -a -t $type "$dir(/.*)?" || semanage fcontext -m -t $type "$dir(/.*)?"; } &>/dev/null &&
+                    restorecon -R $dir && break
+            else
+                # the label is lost on relabeling without semanage
+                chcon -R -t $type $dir &>/dev/null && break
+            fi
+        done
+        ls -Zd $dir | grep -q -E "container_file_t|svirt_sandbox_file_t" || return 1
+    done
+}
+
+selinux::set() {
+    [[ $SELINUX_MODE == enforcing ]] || setenforce 0 &>/dev/null
+    [[ -f /etc/selinux/config ]] && sed -i -E "s/^SELINUX=.*/SELINUX=$SELINUX_MODE/" /etc/selinux/config
+
+    if [[ $SELINUX_MODE == enforcing ]] && command -v selinuxenabled &>/dev/null && selinuxenabled; then
+        setenforce 1 || return 1
+        selinux::label || return 1
+    fi
+    return 0
+}
+
+case "$FIREWALL_MODE" in
+    disable)
+        firewall::disable
+    ;;
+    open-ports)
+        firewall::open_ports || {
+            echo "failed to open ports: ${PORTS[*]}, protocols: ${PROTOCOLS[*]}" >&2
+            exit 1
+        }
+    ;;
+    *)
+        echo "unsupported firewall mode: $FIREWALL_MODE" >&2
+        exit 1
+    ;;
+esac
+
+selinux::set || {
+    echo "failed to set SELinux $SELINUX_MODE" >&2
+    exit 1
+}
@@ -14,10 +14,51 @@
 ## limitations under the License.
 
 # This script is aim to change basic network env
+# usage: init_change_network.sh [ipv6] [KEY=VALUE]...
+# IPv6 forwarding is enabled too if ipv6 is given, which is required by IPv6 and dual-stack clusters.
+# The given sysctl settings are set and persisted after the built-in ones, so they take precedence.
 
 grep "net.ipv4.ip_forward" /etc/sysctl.conf && {
     sed -i -E 's/(.*)net.ipv4.ip_forward(.*)/net.ipv4.ip_forward\2/1' /etc/sysctl.conf
 }
 
 sysctl -w net.ipv4.ip_forward=1 1>/dev/null
+
+# set the sysctl key both at runtime and in /etc/sysctl.conf to keep it after reboot
+sysctl_persist() {
+    local key=$1 value=$2
+
+    sysctl -w "${key}=${value}" 1>/dev/null || return 1
+    if grep -q -E "^\s*#?\s*${key}\s*=" /etc/sysctl.conf; then
+        sed -i -E "s|^\s*#?\s*${key}\s*=.*|${key} = ${value}|" /etc/sysctl.conf
+    else
+        echo "${key} = ${value}" >> /etc/sysctl.conf
+    fi
+}
+
+ipv6=false
+if [[ "$1" == "ipv6" ]]; then
+    ipv6=true
+    shift
+fi
+
+if $ipv6; then
+    [[ -d /proc/sys/net/ipv6 ]] || {
+        echo "IPv6 is disabled in kernel, please remove ipv6.disable=1 from kernel parameters" >&2
+        exit 1
+    }
+
+    sysctl_persist net.ipv6.conf.all.disable_ipv6 0
+    sysctl_persist net.ipv6.conf.default.disable_ipv6 0
+    sysctl_persist net.ipv6.conf.all.forwarding 1
+
+    # bridged IPv6 traffic must pass ip6tables for kube-proxy
+    modprobe br_netfilter 2>/dev/null
+    [[ -e /proc/sys/net/bridge/bridge-nf-call-ip6tables ]] && sysctl_persist net.bridge.bridge-nf-call-ip6tables 1
+fi
+
+for setting in "$@"; do
+    sysctl_persist "${setting%%=*}" "${setting#*=}" || exit 1
+done
+
 sysctl -p /etc/sysctl.conf 1>/dev/null
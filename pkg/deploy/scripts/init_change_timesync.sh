@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+## Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+##
+## Licensed under the Apache License, Version 2.0 (the "License");
+## you may not use this file except in compliance with the License.
+## You may obtain a copy of the License at
+##
+##      http://www.apache.org/licenses/LICENSE-2.0
+##
+## Unless required by applicable law or agreed to in writing, software
+## distributed under the License is distributed on an "AS IS" BASIS,
+## WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+## See the License for the specific language governing permissions and
+## limitations under the License.
+
+# This script is aim to synchronize time with the given NTP servers
+# usage: init_change_timesync.sh SERVER...
+# chrony is preferred over systemd-timesyncd, nothing is installed if neither of them exists.
+
+[[ $# -gt 0 ]] || {
+    echo "no NTP server given" >&2
+    exit 1
+}
+
+chrony_conf=
+for conf in /etc/chrony.conf /etc/chrony/chrony.conf; do
+    [[ -f $conf ]] && chrony_conf=$conf && break
+done
+
+if [[ -n $chrony_conf ]]; then
+    sed -i -E '/^\s*(server|pool)\s/d' $chrony_conf
+    for server in "$@"; do
+        echo "server $server iburst" >> $chrony_conf
+    done
+
+    # the service is chronyd on centos and chrony on ubuntu
+    service=chronyd
+    systemctl cat chronyd.service &>/dev/null || service=chrony
+    systemctl enable $service &>/dev/null
+    systemctl restart $service || exit 1
+elif systemctl cat systemd-timesyncd.service &>/dev/null; then
+    mkdir -p /etc/systemd/timesyncd.conf.d
+    printf "[Time]\nNTP=%s\n" "$*" > /etc/systemd/timesyncd.conf.d/kpaas.conf
+    timedatectl set-ntp true
+    systemctl restart systemd-timesyncd || exit 1
+else
+    echo "neither chrony nor systemd-timesyncd is installed, please install one of them" >&2
+    exit 1
+fi
@@ -65,6 +65,11 @@ haproxy::run() {
     haproxy::start
 }
 
+haproxy::reconfigure() {
+    haproxy::config
+    haproxy::reload
+}
+
 haproxy::clean() {
     haproxy::stop
     haproxy::unconfig
@@ -83,6 +88,11 @@ keepalived::restart() {
     keepalived::start
 }
 
+# keepalived container copies config on start, so reload needs a restart
+keepalived::reload() {
+    keepalived::restart
+}
+
 keepalived::status() {
     docker inspect -f '{{.State.Status}}' kubernetes-ha-keepalived
 }
@@ -92,6 +102,11 @@ keepalived::run() {
     keepalived::start
 }
 
+keepalived::reconfigure() {
+    keepalived::config
+    keepalived::reload
+}
+
 keepalived::clean() {
     keepalived::stop
     keepalived::unconfig
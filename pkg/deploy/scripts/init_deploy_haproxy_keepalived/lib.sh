@@ -24,6 +24,8 @@ die() {
   exit 1
 }
 
+# the configs rendered by haproxy::config and keepalived::config are previewed by
+# pkg/deploy/operation/init/plan.go, keep them the same, TestHAConfigSameAsScript checks it
 haproxy::config() {
     mkdir -p ${HAPROXY_CONFIG_DIR}
 
@@ -34,6 +36,12 @@ haproxy::config() {
         upstreams+=${line}
     done
 
+    # listen on both ip families so that an IPv6 virtual ip is served too
+    local bind_options=""
+    if [[ "${BIND_ADDRESS}" == "::" ]]; then
+        bind_options=" v4v6"
+    fi
+
     cat > ${HAPROXY_CONFIG} <<EOF
 global
   #user haproxy
@@ -48,12 +56,12 @@ defaults
   timeout connect      3s
   retries 3
 listen stats
-  bind 0.0.0.0:1936
+  bind 0.0.0.0:${HAPROXY_STATS_PORT}
   mode http
   stats enable
   stats uri /
 frontend kubernetes
-  bind 0.0.0.0:${HAPROXY_PORT}
+  bind ${BIND_ADDRESS}:${HAPROXY_PORT}${bind_options}
   default_backend kube-apiserver
 backend kube-apiserver
 ${upstreams}
@@ -74,11 +82,23 @@ haproxy::unconfig() {
 keepalived::config() {
     mkdir -p ${KEEPALIVED_CONFIG_DIR}
 
-    local virtual_router_id=${VIP##*.}
+    local virtual_router_id=${VIRTUAL_ROUTER_ID}
+    local vip_prefix=32
+    if [[ "${VIP}" == *:* ]]; then
+        vip_prefix=128
+        # the last group of IPv6 address is hexadecimal and may be empty, e.g. fd00::
+        local last_group=${VIP##*:}
+        virtual_router_id=${virtual_router_id:-$(( 16#${last_group:-0} % 255 + 1 ))}
+    fi
+    virtual_router_id=${virtual_router_id:-${VIP##*.}}
+    local authentication=""
+    if [[ -n "${AUTH_PASSWORD}" ]]; then
+        printf -v authentication "  authentication {\n    auth_type PASS\n    auth_pass %s\n  }\n" "${AUTH_PASSWORD}"
+    fi
     cat > ${KEEPALIVED_CONFIG} <<EOF
 vrrp_script chk_proxy {
   script "nc -w 3 -z 127.0.0.1 ${HAPROXY_PORT}"
-  interval 2
+  interval ${CHECK_INTERVAL}
   weight -100
   fall 3
   rise 2
@@ -88,13 +108,10 @@ vrrp_instance kubernetes_ha {
   interface ${INTERFACE}
   virtual_router_id ${virtual_router_id}
   #nopreempt
-  priority 100
+  priority ${PRIORITY}
   advert_int 1
-  authentication {
-    auth_type MD5
-  }
-  virtual_ipaddress {
-    ${VIP}/32
+${authentication}  virtual_ipaddress {
+    ${VIP}/${vip_prefix}
   }
   track_script {
     chk_proxy
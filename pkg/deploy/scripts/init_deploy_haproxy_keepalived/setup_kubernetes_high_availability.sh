@@ -25,6 +25,14 @@ set -o pipefail
 
 HAPROXY_PORT=4443
 HAPROXY_STATS_PORT=1936
+# BIND_ADDRESS: haproxy listens on both ip families if it is "::"
+BIND_ADDRESS=0.0.0.0
+# VIRTUAL_ROUTER_ID defaults to the last octet of VIP when empty
+VIRTUAL_ROUTER_ID=
+PRIORITY=100
+# AUTH_PASSWORD: no vrrp authentication when empty
+AUTH_PASSWORD=
+CHECK_INTERVAL=2
 # DAEMON: "systemd" or "docker"
 DAEMON=systemd
 
@@ -43,6 +51,13 @@ Flags:
     -u  kube-apiserver proxy upstream addrs
     -n  ha vip
     -i  ha bind interface
+    -p  haproxy listening port, default ${HAPROXY_PORT}
+    -s  haproxy stats port, default ${HAPROXY_STATS_PORT}
+    -b  haproxy bind address, "::" listens on both ip families, default ${BIND_ADDRESS}
+    -r  vrrp virtual router id, default the last octet of vip
+    -P  vrrp priority, default ${PRIORITY}
+    -a  vrrp authentication password, at most 8 characters
+    -t  keepalived health check interval seconds, default ${CHECK_INTERVAL}
 
 Apps:
     haproxy
@@ -55,6 +70,7 @@ Actions:
     config  generate config file to config dir
     start   start app
     reload  reload app config from config file
+    reconfigure config and reload app
     restart stop and start app
     status  get running status of app
     stop    stop app
@@ -63,6 +79,9 @@ Actions:
 Examples:
     $0 -u "10.0.0.10:6443 10.0.0.11:6443 10.0.0.12:6443" haproxy run
     $0 -n "10.0.0.88" -i eth0 keepalived run
+    $0 -u "10.0.0.10:6443 10.0.0.11:6443" -p 4443 haproxy reconfigure
+    $0 -u "fd00::10:6443 fd00::11:6443" -b "::" haproxy run
+    $0 -n "10.0.0.88" -i eth0 -r 51 -P 99 -a secret keepalived reconfigure
     $0 haproxy clean|start|reload|status|stop|unconfig
     $0 keepalived clean|start|restart|reload|status|stop|unconfig
 EOF
@@ -72,7 +91,7 @@ EOF
 # It is a good idea to make OPTIND local if you process options in a function.
 OPTIND=1
 
-while getopts ":hu:n:i:" opt; do
+while getopts ":hu:n:i:p:s:b:r:P:a:t:" opt; do
     case "$opt" in
     h)
         usage
@@ -86,6 +105,27 @@ while getopts ":hu:n:i:" opt; do
     i)
         INTERFACE=$OPTARG
         ;;
+    p)
+        HAPROXY_PORT=$OPTARG
+        ;;
+    s)
+        HAPROXY_STATS_PORT=$OPTARG
+        ;;
+    b)
+        BIND_ADDRESS=$OPTARG
+        ;;
+    r)
+        VIRTUAL_ROUTER_ID=$OPTARG
+        ;;
+    P)
+        PRIORITY=$OPTARG
+        ;;
+    a)
+        AUTH_PASSWORD=$OPTARG
+        ;;
+    t)
+        CHECK_INTERVAL=$OPTARG
+        ;;
     \?)
         usage "Invalid option: -$OPTARG"
         ;;
@@ -104,7 +144,7 @@ fi
 APP=$1
 ACTION=$2
 
-if [[ "config|run" == *"${ACTION}"* ]];
+if [[ "config|run|reconfigure" == *"${ACTION}"* ]];
 then
     ${APP}::config::check
 fi
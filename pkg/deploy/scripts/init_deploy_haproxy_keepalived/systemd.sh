@@ -23,7 +23,10 @@ set -o pipefail
 # |              | haproxy | keepalived |
 # | ------------ | ------- | ---------- |
 # | ubuntu 16.04 | 1.6.3   | 1.2.24     |
+# | debian 10    | 1.8.19  | 2.0.10     |
 # | centos 7     | 1.5.18  | 1.3.5      |
+# | rocky 8      | 1.8.27  | 2.1.5      |
+# | openEuler    | 2.0.14  | 2.0.20     |
 # | rhel         | ----    | ----       |
 
 HAPROXY_CONFIG_DIR=/etc/haproxy
@@ -43,17 +46,12 @@ PKG_VERSION_SYMBOL=
 init() {
     local dist=$(. /etc/os-release && echo $ID)
     case ${dist} in
-    ubuntu)
+    ubuntu|debian)
         PKG_MGR=apt
         PKG_INSTALL_OPTIONS='-y --allow-unauthenticated'
         PKG_VERSION_SYMBOL='='
     ;;
-    centos)
-        PKG_MGR=yum
-        PKG_INSTALL_OPTIONS='-y --setopt=obsoletes=0 --nogpgcheck'
-        PKG_VERSION_SYMBOL='-'
-    ;;
-    rhel)
+    centos|rhel|rocky|almalinux|openEuler)
         PKG_MGR=yum
         PKG_INSTALL_OPTIONS='-y --setopt=obsoletes=0 --nogpgcheck'
         PKG_VERSION_SYMBOL='-'
@@ -112,6 +110,11 @@ haproxy::run() {
     haproxy::enable
 }
 
+haproxy::reconfigure() {
+    haproxy::config
+    haproxy::reload
+}
+
 haproxy::clean() {
     haproxy::stop
     haproxy::disable
@@ -135,6 +138,10 @@ keepalived::stop() {
     systemctl stop keepalived
 }
 
+keepalived::reload() {
+    systemctl reload keepalived
+}
+
 keepalived::restart() {
     systemctl restart keepalived
 }
@@ -150,6 +157,11 @@ keepalived::run() {
     keepalived::enable
 }
 
+keepalived::reconfigure() {
+    keepalived::config
+    keepalived::reload
+}
+
 keepalived::clean() {
     keepalived::stop
     keepalived::disable
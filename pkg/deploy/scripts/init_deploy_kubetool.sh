@@ -20,6 +20,7 @@ cd $ROOT
 DEBUG=false
 LSB_DIST=
 DIST_VERSION=
+DIST_MAJOR_VERSION=
 ACTION=
 COMPONENT=
 VERSION=
@@ -27,16 +28,36 @@ NODEIP=
 IMAGE_REPOSITORY=docker.io/kpaas
 DEVICE_MOUNTS=
 
+# container runtime specific
+CONTAINER_RUNTIME=docker
+CONTAINERD_VERSION=1.3.0
+CONTAINERD_CONFIG=/etc/containerd/config.toml
+CRI_SOCKET=/run/containerd/containerd.sock
+
 # kubelet specific
 KUBELET_VERSION=
 CLUSTER_DNS=
 KUBELET_PKG=
+FEATURE_GATES=
+ALLOW_SWAP=false
+KUBELET_CONFIG=false
+KUBELET_CONFIG_FILE=/var/lib/kubelet/config.yaml
+KUBELET_DOCKER_CONFIG=/var/lib/kubelet/config.json
+
+# kube-proxy specific
+IPVS=false
+IPVS_MODULES="ip_vs ip_vs_rr ip_vs_wrr ip_vs_sh"
+IPVS_MODULES_CONF=/etc/modules-load.d/ipvs.conf
 
 # kubeadm specific
 JOIN_CONTROL_PLANE=
 INIT_CONFIG=/etc/kubernetes/kubeadm_config.yaml
 
 # package specific
+OS_RELEASE=/etc/os-release
+APT_SOURCES=/etc/apt/sources.list
+APT_SOURCES_DIR=/etc/apt/sources.list.d
+YUM_REPOS_DIR=/etc/yum.repos.d
 PKG_MGR=
 INSTALL_OPTIONS=
 VERSION_SYMBOL=
@@ -44,6 +65,21 @@ LOCALREPO_ADDR=
 EXTRAPKGS=
 PKG_MIRROR=mirrors.aliyun.com
 
+# proxy specific
+HTTP_PROXY_ADDR=
+HTTPS_PROXY_ADDR=
+NO_PROXY_ADDRS=
+PROXY_ENV=/etc/kubernetes/proxy.env
+PROXY_DROPIN=kpaas-proxy.conf
+APT_PROXY_CONF=/etc/apt/apt.conf.d/95kpaas-proxy
+YUM_PROXY_MARK="# proxy set by kpaas"
+
+# registry specific
+REGISTRY_DIR=
+DOCKER_DAEMON_CONFIG=/etc/docker/daemon.json
+DOCKER_CERTS_DIR=/etc/docker/certs.d
+CONTAINERD_CERTS_DIR=/etc/containerd/certs.d
+
 # etcd specific
 ETCD_IP=
 APISERVERYAML=/etc/kubernetes/manifests/kube-apiserver.yaml
@@ -140,9 +176,21 @@ repos::setup() {
     repos::setup::${LSB_DIST}
 }
 
+# the local repo is a flat repository which contains all the packages needed, it's the only source for offline installation
+repos::setup::apt::local() {
+    echo "deb [trusted=yes] $LOCALREPO_ADDR ./" > $APT_SOURCES
+    rm -f $APT_SOURCES_DIR/kubernetes.list $APT_SOURCES_DIR/docker-ce.list
+    command::exec apt clean
+    command::exec apt update
+}
+
 repos::setup::ubuntu() {
-    local sourcedir=/etc/apt/sources.list.d
-    cat > /etc/apt/sources.list <<EOF
+    [[ -z $LOCALREPO_ADDR ]] || {
+        repos::setup::apt::local
+        return
+    }
+
+    cat > $APT_SOURCES <<EOF
 deb http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION} main restricted universe multiverse
 deb http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION}-security main restricted universe multiverse
 deb http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION}-updates main restricted universe multiverse
@@ -154,7 +202,7 @@ deb-src http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION}-updates main restricted unive
 deb-src http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION}-proposed main restricted universe multiverse
 deb-src http://$PKG_MIRROR/ubuntu/ ${DIST_VERSION}-backports main restricted universe multiverse
 EOF
-    cat > $sourcedir/kubernetes.list <<EOF
+    cat > $APT_SOURCES_DIR/kubernetes.list <<EOF
 deb https://$PKG_MIRROR/kubernetes/apt/ kubernetes-${DIST_VERSION} main
 EOF
     command::exec apt-key adv --recv-keys --keyserver keyserver.ubuntu.com 6A030B21BA07F4FB E84AC2C0460F3994 7EA0A9C3F273FCD8 F76221572C52609D
@@ -162,24 +210,50 @@ EOF
     command::exec apt update
 }
 
-repos::setup::centos() {
-    local repodir=/etc/yum.repos.d
+# debian uses the kubernetes repo of ubuntu xenial as the upstream installation guide does
+repos::setup::debian() {
+    [[ -z $LOCALREPO_ADDR ]] || {
+        repos::setup::apt::local
+        return
+    }
+
+    local security="${DIST_VERSION}-security"
+    # security suite is named as buster/updates before bullseye
+    [[ $DIST_MAJOR_VERSION -lt 11 ]] && security="${DIST_VERSION}/updates"
+
+    cat > $APT_SOURCES <<EOF
+deb http://$PKG_MIRROR/debian/ ${DIST_VERSION} main contrib non-free
+deb http://$PKG_MIRROR/debian/ ${DIST_VERSION}-updates main contrib non-free
+deb http://$PKG_MIRROR/debian-security/ ${security} main contrib non-free
+EOF
+    cat > $APT_SOURCES_DIR/kubernetes.list <<EOF
+deb https://$PKG_MIRROR/kubernetes/apt/ kubernetes-xenial main
+EOF
+    command::exists gpg || command::exec "$PKG_MGR install ${INSTALL_OPTIONS} gnupg"
+    command::exec apt-key adv --recv-keys --keyserver keyserver.ubuntu.com 6A030B21BA07F4FB 7EA0A9C3F273FCD8 F76221572C52609D
+    command::exec apt clean
+    command::exec apt update
+}
+
+# setup repos for the yum based distros, epel is skipped if the first argument is false
+repos::setup::yum() {
+    local with_epel=${1:-true}
 
     if [[ -z $LOCALREPO_ADDR ]]; then
         command::exists yum-config-manager || {
             command::exec "$PKG_MGR install $INSTALL_OPTIONS yum-utils"
         }
 
-        cat > $repodir/epel.repo <<EOF
+        $with_epel && cat > $YUM_REPOS_DIR/epel.repo <<EOF
 [epel]
-name=Extra Packages for Enterprise Linux 7 - \$basearch
-baseurl=http://$PKG_MIRROR/epel/7/\$basearch
+name=Extra Packages for Enterprise Linux ${DIST_MAJOR_VERSION} - \$basearch
+baseurl=http://$PKG_MIRROR/epel/${DIST_MAJOR_VERSION}/\$basearch
 failovermethod=priority
 enabled=1
 gpgcheck=0
-gpgkey=file:///etc/pki/rpm-gpg/RPM-GPG-KEY-EPEL-7
+gpgkey=file:///etc/pki/rpm-gpg/RPM-GPG-KEY-EPEL-${DIST_MAJOR_VERSION}
 EOF
-        cat > $repodir/k8s.repo <<EOF
+        cat > $YUM_REPOS_DIR/k8s.repo <<EOF
 [kubernetes]
 name = k8s
 baseurl = http://$PKG_MIRROR/kubernetes/yum/repos/kubernetes-el7-x86_64/
@@ -187,9 +261,9 @@ enabled = 1
 gpgcheck = 0
 EOF
     else
-        test -d /etc/yum.repos.d/bak || mkdir /etc/yum.repos.d/bak
-        mv -f /etc/yum.repos.d/*.repo /etc/yum.repos.d/bak &> /dev/null || true
-        cat > /etc/yum.repos.d/local.repo <<EOF
+        test -d $YUM_REPOS_DIR/bak || mkdir $YUM_REPOS_DIR/bak
+        mv -f $YUM_REPOS_DIR/*.repo $YUM_REPOS_DIR/bak &> /dev/null || true
+        cat > $YUM_REPOS_DIR/local.repo <<EOF
 [kpaas-deploy]
 name=local-yum
 baseurl=$LOCALREPO_ADDR
@@ -199,41 +273,302 @@ EOF
     fi
 }
 
+repos::setup::centos() {
+    repos::setup::yum
+}
+
 repos::setup::rhel() {
-    local repodir=/etc/yum.repos.d
+    repos::setup::yum
+}
 
-    if [[ -z $LOCALREPO_ADDR ]]; then
-        command::exists yum-config-manager || {
-            command::exec "$PKG_MGR install $INSTALL_OPTIONS yum-utils"
+repos::setup::rocky() {
+    repos::setup::yum
+}
+
+repos::setup::almalinux() {
+    repos::setup::yum
+}
+
+# packages of epel are shipped in the openEuler repos
+repos::setup::openEuler() {
+    repos::setup::yum false
+}
+
+proxy::used() {
+    [[ -n $HTTP_PROXY_ADDR || -n $HTTPS_PROXY_ADDR ]]
+}
+
+proxy::pkg() {
+    case $PKG_MGR in
+    apt)
+        rm -f $APT_PROXY_CONF
+        proxy::used || return 0
+        if [[ -n $HTTP_PROXY_ADDR ]]; then
+            echo "Acquire::http::Proxy \"$HTTP_PROXY_ADDR\";" >> $APT_PROXY_CONF
+        fi
+        if [[ -n $HTTPS_PROXY_ADDR ]]; then
+            echo "Acquire::https::Proxy \"$HTTPS_PROXY_ADDR\";" >> $APT_PROXY_CONF
+        fi
+    ;;
+    yum)
+        # yum takes only one proxy for both http and https,
+        # /etc/yum.conf is a symlink to /etc/dnf/dnf.conf on the el8 distros and openEuler, the link must be kept
+        local conf=/etc/yum.conf
+        [[ ! -e $conf && -f /etc/dnf/dnf.conf ]] && conf=/etc/dnf/dnf.conf
+        sed -i --follow-symlinks "/^$YUM_PROXY_MARK\$/,+1d" $conf
+        proxy::used || return 0
+        sed -i --follow-symlinks "/^\[main\]/a $YUM_PROXY_MARK\nproxy=${HTTP_PROXY_ADDR:-$HTTPS_PROXY_ADDR}" $conf
+    ;;
+    esac
+}
+
+# the drop-in of container runtime is rewritten only if it's changed, so that docker is not restarted for nothing
+proxy::runtime() {
+    local runtime
+    local dropin
+    local content=
+
+    proxy::used && {
+        # % is a specifier of systemd and needs to be escaped
+        content="[Service]"
+        if [[ -n $HTTP_PROXY_ADDR ]]; then
+            content+=$'\n'"Environment=\"HTTP_PROXY=${HTTP_PROXY_ADDR//%/%%}\""
+        fi
+        if [[ -n $HTTPS_PROXY_ADDR ]]; then
+            content+=$'\n'"Environment=\"HTTPS_PROXY=${HTTPS_PROXY_ADDR//%/%%}\""
+        fi
+        content+=$'\n'"Environment=\"NO_PROXY=$NO_PROXY_ADDRS\""
+    }
+
+    for runtime in docker containerd; do
+        dropin=/etc/systemd/system/${runtime}.service.d/$PROXY_DROPIN
+        # proxy settings may contain credentials, so they are readable by root only
+        if [[ $runtime == $CONTAINER_RUNTIME && -n $content ]]; then
+            if [[ -f $dropin && $(cat $dropin) == "$content" ]]; then
+                chmod 600 $dropin
+                continue
+            fi
+            mkdir -p $(dirname $dropin)
+            (umask 077 && echo "$content" > $dropin)
+            chmod 600 $dropin
+        else
+            [[ -f $dropin ]] || continue
+            rm -f $dropin
+        fi
+
+        command::exec systemctl daemon-reload
+        # containerd is restarted after it's configured
+        if [[ $runtime == docker ]] && systemctl -q is-active docker; then
+            command::exec systemctl restart docker
+        fi
+    done
+}
+
+# kubeadm loads the proxy settings from the env file before it runs
+proxy::kubeadm() {
+    rm -f $PROXY_ENV
+    proxy::used || return 0
+
+    mkdir -p $(dirname $PROXY_ENV)
+    # proxy settings may contain credentials, so they are readable by root only
+    (umask 077 && touch $PROXY_ENV)
+    if [[ -n $HTTP_PROXY_ADDR ]]; then
+        echo "export HTTP_PROXY='$HTTP_PROXY_ADDR' http_proxy='$HTTP_PROXY_ADDR'" >> $PROXY_ENV
+    fi
+    if [[ -n $HTTPS_PROXY_ADDR ]]; then
+        echo "export HTTPS_PROXY='$HTTPS_PROXY_ADDR' https_proxy='$HTTPS_PROXY_ADDR'" >> $PROXY_ENV
+    fi
+    echo "export NO_PROXY='$NO_PROXY_ADDRS' no_proxy='$NO_PROXY_ADDRS'" >> $PROXY_ENV
+}
+
+# proxy settings are removed if no proxy is given
+proxy::setup() {
+    proxy::used && log::deploy I "setup proxy for package manager, $CONTAINER_RUNTIME and kubeadm"
+    proxy::pkg
+    proxy::runtime
+    proxy::kubeadm
+}
+
+registry::staged() {
+    [[ -n $REGISTRY_DIR && -d $REGISTRY_DIR ]]
+}
+
+registry::docker() {
+    local auth
+    local server
+    local err
+
+    if [[ -d $REGISTRY_DIR/certs.d ]]; then
+        mkdir -p $DOCKER_CERTS_DIR
+        cp -rf $REGISTRY_DIR/certs.d/. $DOCKER_CERTS_DIR/
+    fi
+
+    if [[ -f $REGISTRY_DIR/daemon.json && $(cat $REGISTRY_DIR/daemon.json) != "$(cat $DOCKER_DAEMON_CONFIG 2>/dev/null)" ]]; then
+        mkdir -p $(dirname $DOCKER_DAEMON_CONFIG)
+        cp -f $REGISTRY_DIR/daemon.json $DOCKER_DAEMON_CONFIG
+        command::exec systemctl restart docker
+    fi
+
+    # password is passed by stdin, so that it's never in the command line
+    for auth in $REGISTRY_DIR/auth/*; do
+        [[ -f $auth ]] || continue
+        server=$(basename $auth)
+        err=$(cut -d: -f2- $auth | docker login --username "$(cut -d: -f1 $auth)" --password-stdin $server 2>&1 > /dev/null) ||
+            log::deploy E "failed to log in to registry $server, err: $err"
+    done
+
+    # images are pulled by kubelet, which reads credentials from its root dir instead of docker login
+    if [[ -f $REGISTRY_DIR/kubelet-config.json ]]; then
+        mkdir -p $(dirname $KUBELET_DOCKER_CONFIG)
+        install -m 600 $REGISTRY_DIR/kubelet-config.json $KUBELET_DOCKER_CONFIG
+    fi
+}
+
+# hosts.toml of registries are loaded from the certs dir by containerd 1.5 or later, credentials are
+# set in the config of cri plugin as hosts.toml does not support them. It's called after config is generated.
+registry::containerd() {
+    local auth
+    local server
+
+    registry::staged || return 0
+
+    if [[ -d $REGISTRY_DIR/certs.d ]]; then
+        mkdir -p $CONTAINERD_CERTS_DIR
+        cp -rf $REGISTRY_DIR/certs.d/. $CONTAINERD_CERTS_DIR/
+        if grep -q 'config_path = ' $CONTAINERD_CONFIG; then
+            sed -i '/\[plugins\."io\.containerd\.grpc\.v1\.cri"\.registry\]/,/config_path = / s#config_path = .*#config_path = "'$CONTAINERD_CERTS_DIR'"#' $CONTAINERD_CONFIG
+        else
+            log::deploy W "hosts.toml of registries is not supported by containerd $(containerd --version | awk '{print $3}'), please upgrade it to 1.5 or later"
+        fi
+    fi
+
+    chmod 600 $CONTAINERD_CONFIG
+    for auth in $REGISTRY_DIR/auth/*; do
+        [[ -f $auth ]] || continue
+        server=$(basename $auth)
+        [[ $server == docker.io ]] && server=registry-1.docker.io
+        cat >> $CONTAINERD_CONFIG <<EOF
+
+[plugins."io.containerd.grpc.v1.cri".registry.configs."$server".auth]
+  auth = "$(base64 -w0 $auth)"
+EOF
+    done
+}
+
+# registry files staged by deploy controller are installed, the staging dir is removed on exit as it contains credentials
+registry::setup() {
+    registry::staged || return 0
+    trap "rm -rf $REGISTRY_DIR" EXIT
+
+    log::deploy I "setup registries for $CONTAINER_RUNTIME"
+    # registries of containerd are set up when it's configured
+    if [[ $CONTAINER_RUNTIME == docker ]]; then
+        registry::docker
+    fi
+}
+
+containerd::validate() {
+    log::deploy I "validate containerd installation"
+    local containerd_version=
+    CONTAINERD_INSTALLED=false
+
+    command::exists containerd && {
+        containerd_version=$(containerd --version | awk '{print $3; exit}' | sed 's/^v//; s/[-+~].*//')
+
+        vercomp $containerd_version $CONTAINERD_VERSION && CONTAINERD_INSTALLED=true || {
+            [[ $? == 1 ]] && CONTAINERD_INSTALLED=true || log::deploy E "containerd $containerd_version already installed on host is lower than $CONTAINERD_VERSION, please upgrade it and try again"
         }
+    } || true
+}
 
-        cat > $repodir/epel.repo <<EOF
-[epel]
-name=Extra Packages for Enterprise Linux 7 - \$basearch
-baseurl=http://$PKG_MIRROR/epel/7/\$basearch
-failovermethod=priority
+containerd::install() {
+    $CONTAINERD_INSTALLED || {
+        log::deploy I "installing containerd"
+        containerd::install::${LSB_DIST}
+    }
+}
+
+containerd::install::ubuntu() {
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} containerd"
+}
+
+# containerd of buster is too old, containerd.io is released in docker-ce repo
+containerd::install::debian() {
+    [[ -n $LOCALREPO_ADDR ]] || {
+        echo "deb [trusted=yes] https://$PKG_MIRROR/docker-ce/linux/debian ${DIST_VERSION} stable" > $APT_SOURCES_DIR/docker-ce.list
+        command::exec apt update
+    }
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} containerd.io"
+}
+
+containerd::install::centos() {
+    # containerd.io is released in docker-ce repo
+    [[ -n $LOCALREPO_ADDR ]] || cat > $YUM_REPOS_DIR/docker-ce.repo <<EOF
+[docker-ce-stable]
+name=Docker CE Stable - \$basearch
+baseurl=https://$PKG_MIRROR/docker-ce/linux/centos/${DIST_MAJOR_VERSION}/\$basearch/stable
 enabled=1
 gpgcheck=0
-gpgkey=file:///etc/pki/rpm-gpg/RPM-GPG-KEY-EPEL-7
-EOF
-        cat > $repodir/k8s.repo <<EOF
-[kubernetes]
-name = k8s
-baseurl = http://$PKG_MIRROR/kubernetes/yum/repos/kubernetes-el7-x86_64/
-enabled = 1
-gpgcheck = 0
 EOF
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} containerd.io"
+}
+
+containerd::install::rhel() {
+    containerd::install::centos
+}
+
+containerd::install::rocky() {
+    containerd::install::centos
+}
+
+containerd::install::almalinux() {
+    containerd::install::centos
+}
+
+# containerd is shipped in the openEuler repos
+containerd::install::openEuler() {
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} containerd"
+}
+
+containerd::config() {
+    log::deploy I "generate config for containerd"
+    [[ -d $(dirname $CONTAINERD_CONFIG) ]] || mkdir -p $(dirname $CONTAINERD_CONFIG)
+
+    # kubelet uses systemd cgroup driver with containerd, so runc is configured to use it as well
+    containerd config default > $CONTAINERD_CONFIG
+    sed -i "s#sandbox_image = .*#sandbox_image = \"${IMAGE_REPOSITORY%*/}/pause:3.1\"#" $CONTAINERD_CONFIG
+    if grep -q 'SystemdCgroup = ' $CONTAINERD_CONFIG; then
+        sed -i 's/SystemdCgroup = .*/SystemdCgroup = true/' $CONTAINERD_CONFIG
     else
-        test -d /etc/yum.repos.d/bak || mkdir /etc/yum.repos.d/bak
-        mv -f /etc/yum.repos.d/*.repo /etc/yum.repos.d/bak &> /dev/null || true
-        cat > /etc/yum.repos.d/local.repo <<EOF
-[kpaas-deploy]
-name=local-yum
-baseurl=$LOCALREPO_ADDR
-enabled=1
-gpgcheck=0
-EOF
+        sed -i '/\[plugins\."io\.containerd\.grpc\.v1\.cri"\.containerd\.runtimes\.runc\]/a\          [plugins."io.containerd.grpc.v1.cri".containerd.runtimes.runc.options]\n            SystemdCgroup = true' $CONTAINERD_CONFIG
     fi
+    registry::containerd
+
+    # modules and sysctl needed by kubernetes are not set up by containerd as docker does
+    printf "overlay\nbr_netfilter\n" > /etc/modules-load.d/containerd.conf
+    command::exec modprobe overlay
+    command::exec modprobe br_netfilter
+    printf "net.bridge.bridge-nf-call-iptables = 1\nnet.bridge.bridge-nf-call-ip6tables = 1\nnet.ipv4.ip_forward = 1\n" > /etc/sysctl.d/99-kubernetes-cri.conf
+    command::exec sysctl --system
+
+    cat > /etc/crictl.yaml <<EOF
+runtime-endpoint: unix://$CRI_SOCKET
+image-endpoint: unix://$CRI_SOCKET
+EOF
+}
+
+containerd::run() {
+    log::deploy I "run containerd"
+    command::exec systemctl daemon-reload
+    command::exec systemctl enable containerd
+    command::exec systemctl restart containerd
+}
+
+containerd::setup() {
+    containerd::validate
+    containerd::install
+    containerd::config
+    containerd::run
 }
 
 kubelet::validate() {
@@ -261,13 +596,29 @@ kubelet::install() {
 }
 
 kubelet::config() {
-    # TODO: remove this line when release
-    NODEIP=`ip -f inet a | awk -F'[ /]+' '/,UP,/{getline; if ($3 ~ /^10./ || $3 ~ /^192.168./ || $3 ~ /^172.(1[6-9]|2[0-9]|3[01])./) {print $3;exit 0}}'`
+    # detect a private IPv4 address if node ip is not given
+    [[ -n $NODEIP ]] || NODEIP=`ip -f inet a | awk -F'[ /]+' '/,UP,/{getline; if ($3 ~ /^10./ || $3 ~ /^192.168./ || $3 ~ /^172.(1[6-9]|2[0-9]|3[01])./) {print $3;exit 0}}'`
     log::deploy I "generate config for kubelet${VERSION_SYMBOL}${KUBELET_VERSION}"
     [[ -d /etc/systemd/system/kubelet.service.d/ ]] || mkdir /etc/systemd/system/kubelet.service.d/
 
+    local cgroup_driver=cgroupfs
+    local runtime_args=
+    [[ $CONTAINER_RUNTIME == containerd ]] && {
+        cgroup_driver=systemd
+        runtime_args="--container-runtime=remote --container-runtime-endpoint=unix://$CRI_SOCKET"
+    }
+
+    local swap_args=
+    $ALLOW_SWAP && swap_args=--fail-swap-on=false
+
+    # config file is written by kubeadm init or join from the KubeletConfiguration uploaded to the cluster,
+    # kubelet keeps restarting until it's there. flags above take precedence over the config file.
+    local config_args=
+    $KUBELET_CONFIG && config_args=--config=$KUBELET_CONFIG_FILE
+
     echo '[Service]
-    Environment="KUBELET_CGROUP_DRIVER=--cgroup-driver=cgroupfs"
+    Environment="KUBELET_CGROUP_DRIVER=--cgroup-driver='$cgroup_driver'"
+    Environment="KUBELET_RUNTIME_ARGS='"$runtime_args"'"
     Environment="KUBELET_KUBECONFIG_ARGS=--bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip='$NODEIP'"
     Environment="KUBELET_SYSTEM_PODS_ARGS=--pod-manifest-path=/etc/kubernetes/manifests"
     Environment="KUBELET_NETWORK_ARGS=--network-plugin=cni --cni-conf-dir=/etc/cni/net.d --cni-bin-dir=/opt/cni/bin"
@@ -276,10 +627,12 @@ kubelet::config() {
     #Environment="KUBELET_CADVISOR_ARGS=--cadvisor-port=0"
     Environment="KUBELET_CERTIFICATE_ARGS=--rotate-certificates=true --cert-dir=/var/lib/kubelet/pki"
     Environment="KUBELET_POD_INFRA_ARGS=--pod-infra-container-image='${IMAGE_REPOSITORY%*/}'/pause:3.1"
-    Environment="KUBELET_FEATURE_GATES=--feature-gates=DevicePlugins=true"
+    Environment="KUBELET_FEATURE_GATES=--feature-gates=DevicePlugins=true'${FEATURE_GATES:+,$FEATURE_GATES}'"
+    Environment="KUBELET_SWAP_ARGS='$swap_args'"
     Environment="KUBELET_LOG_LEVEL=-v=4"
+    Environment="KUBELET_CONFIG_ARGS='$config_args'"
     ExecStart=
-    ExecStart=/usr/bin/kubelet $KUBELET_CGROUP_DRIVER $KUBELET_KUBECONFIG_ARGS $KUBELET_SYSTEM_PODS_ARGS $KUBELET_NETWORK_ARGS $KUBELET_DNS_ARGS $KUBELET_AUTHZ_ARGS $KUBELET_CADVISOR_ARGS $KUBELET_CERTIFICATE_ARGS $KUBELET_EXTRA_ARGS $KUBELET_POD_INFRA_ARGS $KUBELET_NODE_IP_ARGS $KUBELET_FEATURE_GATES $KUBELET_LOG_LEVEL $KUBELET_RESERVE_COMPUTE_RESOURCE_ARGS
+    ExecStart=/usr/bin/kubelet $KUBELET_CGROUP_DRIVER $KUBELET_RUNTIME_ARGS $KUBELET_KUBECONFIG_ARGS $KUBELET_SYSTEM_PODS_ARGS $KUBELET_NETWORK_ARGS $KUBELET_DNS_ARGS $KUBELET_AUTHZ_ARGS $KUBELET_CADVISOR_ARGS $KUBELET_CERTIFICATE_ARGS $KUBELET_EXTRA_ARGS $KUBELET_POD_INFRA_ARGS $KUBELET_NODE_IP_ARGS $KUBELET_FEATURE_GATES $KUBELET_SWAP_ARGS $KUBELET_LOG_LEVEL $KUBELET_RESERVE_COMPUTE_RESOURCE_ARGS $KUBELET_CONFIG_ARGS
     ' > /etc/systemd/system/kubelet.service.d/10-kubeadm.conf
 }
 
@@ -290,7 +643,27 @@ kubelet::run() {
     command::exec systemctl restart kubelet
 }
 
+# kube-proxy in ipvs mode falls back to iptables without the ipvs modules and ipset
+ipvs::setup() {
+    log::deploy I "setup ipvs for kube-proxy"
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} ipset ipvsadm"
+
+    # nf_conntrack_ipv4 is merged into nf_conntrack since kernel 4.19
+    local conntrack=nf_conntrack
+    modprobe $conntrack 2>/dev/null || conntrack=nf_conntrack_ipv4
+
+    local module=
+    for module in $IPVS_MODULES $conntrack; do
+        command::exec modprobe $module
+    done
+    printf "%s\n" $IPVS_MODULES $conntrack > $IPVS_MODULES_CONF
+}
+
 kubelet::setup() {
+    proxy::setup
+    registry::setup
+    $IPVS && ipvs::setup
+    [[ $CONTAINER_RUNTIME == containerd ]] && containerd::setup
     kubelet::validate
     kubelet::install
     kubelet::config
@@ -307,41 +680,63 @@ join() {
     fi
 
     #kubeadm join --token $TOKEN $MASTERIP --discovery-token-unsafe-skip-ca-verification [--experimental-control-plane]
-    command::exec kubeadm join --token $TOKEN $MASTER $skip_ca $JOIN_CONTROL_PLANE
+    local cri_socket=
+    [[ $CONTAINER_RUNTIME == containerd ]] && cri_socket="--cri-socket $CRI_SOCKET"
+    local ignore_swap=
+    $ALLOW_SWAP && ignore_swap="--ignore-preflight-errors Swap"
+
+    if [[ -f $PROXY_ENV ]]; then
+        . $PROXY_ENV
+    fi
+
+    command::exec kubeadm join --token $TOKEN $MASTER $skip_ca $cri_socket $ignore_swap $JOIN_CONTROL_PLANE
 }
 
 usage() {
 cat <<EOF
 Usage:
     $0 setup repos [--local-repo-addr http://10.10.0.1:8880/localrepo --pkg-mirror mirrors.aliyun.com] [--debug]
-    $0 setup kubelet --cluster-dns 169.169.0.10 --version 1.11.0 --image-repository docker.io/kpaas [--debug]
-    $0 join --token 845e36.bc466480ab621387 --master 10.10.0.1:6443 [--control-plane] [--debug]
+    $0 setup kubelet --cluster-dns 169.169.0.10 --version 1.11.0 --image-repository docker.io/kpaas [--node-ip 10.10.0.2] [--feature-gates IPv6DualStack=true] [--container-runtime containerd] [--allow-swap] [--kubelet-config] [--ipvs] [--http-proxy http://10.10.0.1:3128 --https-proxy http://10.10.0.1:3128 --no-proxy 127.0.0.1,10.10.0.0/16] [--registry-dir /etc/kubernetes/registries] [--debug]
+    $0 join --token 845e36.bc466480ab621387 --master 10.10.0.1:6443 [--control-plane] [--container-runtime containerd] [--allow-swap] [--debug]
     $0 clean [--debug]
 EOF
 }
 
-main() {
-    [[ -r /etc/os-release ]] && LSB_DIST=$(. /etc/os-release && echo $ID)
+# detect the distro and its package manager by os-release
+dist::detect() {
+    [[ -r $OS_RELEASE ]] && LSB_DIST=$(. $OS_RELEASE && echo $ID)
     [[ -z $LSB_DIST ]] && log::deploy F "failed to detect linux distro"
 
+    DIST_MAJOR_VERSION=$(. $OS_RELEASE && echo ${VERSION_ID%%.*})
+
     case $LSB_DIST in
     ubuntu)
         PKG_MGR=apt
         INSTALL_OPTIONS=' -y --allow-unauthenticated'
         VERSION_SYMBOL='='
-        DIST_VERSION=$(. /etc/os-release && echo $UBUNTU_CODENAME)
+        DIST_VERSION=$(. $OS_RELEASE && echo $UBUNTU_CODENAME)
+    ;;
+    debian)
+        PKG_MGR=apt
+        INSTALL_OPTIONS=' -y --allow-unauthenticated'
+        VERSION_SYMBOL='='
+        DIST_VERSION=$(. $OS_RELEASE && echo $VERSION_CODENAME)
     ;;
-    centos|rhel)
+    centos|rhel|rocky|almalinux|openEuler)
+        # yum is an alias of dnf on the el8 distros and openEuler
         PKG_MGR=yum
         INSTALL_OPTIONS=' -y --setopt=obsoletes=0 --nogpgcheck'
         VERSION_SYMBOL='-'
-        DIST_VERSION=$(. /etc/os-release && echo $VERSION_ID)
+        DIST_VERSION=$(. $OS_RELEASE && echo $VERSION_ID)
     ;;
     *)
-        log::deploy F "unrecognized Linux distro: $LSB_DIST, currently only support centos and ubuntu"
+        log::deploy F "unrecognized Linux distro: $LSB_DIST, currently only support centos, rhel, rocky, almalinux, openEuler, ubuntu and debian"
     ;;
     esac
+}
 
+main() {
+    dist::detect
     parse "$@"
 
     $DEBUG && log::deploy D "Linux distro: $LSB_DIST $DIST_VERSION detected"
@@ -406,6 +801,14 @@ parse() {
                     usage_exit "no node ip given for --node-ip"
                 }
             ;;
+            --feature-gates)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    FEATURE_GATES="$2"
+                    shift
+                } || {
+                    usage_exit "no feature gates given for --feature-gates"
+                }
+            ;;
             --image-repository)
                 [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
                     IMAGE_REPOSITORY="$2"
@@ -430,6 +833,22 @@ parse() {
                     usage_exit "no etcd ip given for --etcd-ip"
                 }
             ;;
+            --container-runtime)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    CONTAINER_RUNTIME="$2"
+                    shift
+                } || {
+                    usage_exit "no container runtime given for --container-runtime"
+                }
+            ;;
+            --local-repo-addr)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    LOCALREPO_ADDR="$2"
+                    shift
+                } || {
+                    usage_exit "no local repo address given for --local-repo-addr"
+                }
+            ;;
             --pkg-mirror)
                 [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
                     PKG_MIRROR="$2"
@@ -438,6 +857,47 @@ parse() {
                     usage_exit "no package mirror given for --pkg-mirror"
                 }
             ;;
+            --allow-swap)
+                ALLOW_SWAP=true
+            ;;
+            --kubelet-config)
+                KUBELET_CONFIG=true
+            ;;
+            --ipvs)
+                IPVS=true
+            ;;
+            --http-proxy)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    HTTP_PROXY_ADDR="$2"
+                    shift
+                } || {
+                    usage_exit "no proxy given for --http-proxy"
+                }
+            ;;
+            --https-proxy)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    HTTPS_PROXY_ADDR="$2"
+                    shift
+                } || {
+                    usage_exit "no proxy given for --https-proxy"
+                }
+            ;;
+            --registry-dir)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    REGISTRY_DIR="$2"
+                    shift
+                } || {
+                    usage_exit "no staging dir given for --registry-dir"
+                }
+            ;;
+            --no-proxy)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    NO_PROXY_ADDRS="$2"
+                    shift
+                } || {
+                    usage_exit "no addresses given for --no-proxy"
+                }
+            ;;
             --control-plane)
                 JOIN_CONTROL_PLANE=--experimental-control-plane
             ;;
@@ -478,5 +938,7 @@ parse() {
     esac
 }
 
-# main
-main "$@"
+# main, only the functions are defined if the script is sourced, which is used by tests
+if [[ "${BASH_SOURCE[0]}" == "$0" ]]; then
+    main "$@"
+fi
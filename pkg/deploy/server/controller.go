@@ -17,12 +17,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
 	"github.com/kpaas-io/kpaas/pkg/utils/idcreator"
@@ -31,6 +33,10 @@ import (
 type controller struct {
 	store      task.Store
 	logFileLoc string
+	// custom check items registered in the controller configuration
+	customCheckItems []*pb.CustomCheckItem
+	// rotateLock makes sure only one encryption key rotation is running
+	rotateLock sync.Mutex
 }
 
 func (c *controller) TestConnection(ctx context.Context, req *pb.TestConnectionRequest) (*pb.TestConnectionReply, error) {
@@ -83,9 +89,14 @@ func (c *controller) CheckNodes(ctx context.Context, req *pb.CheckNodesRequest)
 
 	taskName := getCheckNodeTaskName()
 	taskConfig := &task.NodeCheckTaskConfig{
-		NodeConfigs:     req.GetConfigs(),
-		NetworkOptions:  req.GetNetworkOptions(),
-		LogFileBasePath: c.logFileLoc,
+		NodeConfigs:          req.GetConfigs(),
+		NetworkOptions:       req.GetNetworkOptions(),
+		KubeAPIServerConnect: req.GetKubeAPIServerConnect(),
+		PodSubnets:           req.GetPodSubnets(),
+		ServiceSubnets:       req.GetServiceSubnets(),
+		CustomCheckItems:     check.MergeCustomCheckItems(c.customCheckItems, req.GetCustomCheckItems()),
+		ContainerRuntime:     req.GetContainerRuntime(),
+		LogFileBasePath:      c.logFileLoc,
 	}
 
 	nodeCheckTask, err := task.NewNodeCheckTask(taskName, taskConfig)
@@ -158,9 +169,10 @@ func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.Dep
 
 	taskName := getDeployTaskName()
 	taskConfig := &task.DeployTaskConfig{
-		NodeConfigs:     req.NodeConfigs,
-		ClusterConfig:   req.ClusterConfig,
-		LogFileBasePath: c.logFileLoc, // /app/deploy/logs
+		NodeConfigs:       req.NodeConfigs,
+		ClusterConfig:     req.ClusterConfig,
+		ExecutionPolicies: req.ExecutionPolicies,
+		LogFileBasePath:   c.logFileLoc, // /app/deploy/logs
 	}
 
 	deployTask, err := task.NewDeployTask(taskName, taskConfig)
@@ -186,6 +198,55 @@ func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.Dep
 	}, nil
 }
 
+func (c *controller) PlanDeploy(ctx context.Context, req *pb.DeployRequest) (*pb.PlanDeployReply, error) {
+	logrus.Info("Begins PlanDeploy request")
+
+	var err error
+	defer func() {
+		if err != nil {
+			logrus.Errorf("PlanDeploy request failed: %s", err)
+		}
+	}()
+
+	// the task is neither stored nor executed, and no log file is created for it
+	taskConfig := &task.DeployTaskConfig{
+		NodeConfigs:       req.NodeConfigs,
+		ClusterConfig:     req.ClusterConfig,
+		ExecutionPolicies: req.ExecutionPolicies,
+	}
+
+	deployTask, err := task.NewDeployTask(getDeployTaskName(), taskConfig)
+	if err == nil {
+		err = task.PlanTask(deployTask)
+	}
+
+	var (
+		files     []*pb.DeployPlanFile
+		etcdCerts []*pb.EtcdCertPlan
+	)
+	if err == nil {
+		files, etcdCerts, err = deployTask.(*task.DeployTask).PlanFiles()
+	}
+
+	if err != nil {
+		return &pb.PlanDeployReply{
+			Passed: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("Ends PlanDeploy request: succeeded")
+	return &pb.PlanDeployReply{
+		Passed:    true,
+		Plan:      task.GetTaskPlan(deployTask),
+		Files:     files,
+		EtcdCerts: etcdCerts,
+	}, nil
+}
+
 func (c *controller) GetDeployResult(ctx context.Context, req *pb.GetDeployResultRequest) (*pb.GetDeployResultReply, error) {
 	logrus.Info("Begins GetDeployResult request")
 
@@ -301,6 +362,185 @@ func (c *controller) CheckNetworkRequirements(
 	}, nil
 }
 
+func (c *controller) ReconfigureHA(ctx context.Context, req *pb.ReconfigureHARequest) (*pb.ReconfigureHAReply, error) {
+	logrus.Info("Begins ReconfigureHA request")
+
+	var err error
+	defer func() {
+		if err != nil {
+			logrus.Errorf("request failed: %s", err)
+		}
+	}()
+
+	taskConfig := &task.ReconfigureHATaskConfig{
+		NodeConfigs:     req.GetNodeConfigs(),
+		ClusterConfig:   req.GetClusterConfig(),
+		LogFileBasePath: c.logFileLoc,
+	}
+
+	haTask, err := task.NewReconfigureHATask(getReconfigureHATaskName(), taskConfig)
+	if err == nil {
+		err = c.storeAndExecuteTask(haTask)
+	}
+	if err != nil {
+		return &pb.ReconfigureHAReply{
+			Passed: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	if taskErr := haTask.GetErr(); taskErr != nil {
+		err = fmt.Errorf(taskErr.String())
+		return &pb.ReconfigureHAReply{
+			Passed: false,
+			Err:    taskErr,
+		}, err
+	}
+
+	logrus.Info("Ends ReconfigureHA request: succeeded")
+	return &pb.ReconfigureHAReply{
+		Passed: true,
+	}, nil
+}
+
+// RotateEncryptionKey launches the rotation and replies at once, kube-apiserver on masters are restarted
+// in turn which takes minutes. The result is got by GetRotateEncryptionKeyResult.
+func (c *controller) RotateEncryptionKey(ctx context.Context, req *pb.RotateEncryptionKeyRequest) (*pb.RotateEncryptionKeyReply, error) {
+	logrus.Info("Begins RotateEncryptionKey request")
+
+	taskName := getRotateEncryptionKeyTaskName()
+	taskConfig := &task.RotateEncryptionKeyTaskConfig{
+		NodeConfigs:     req.GetNodeConfigs(),
+		ClusterConfig:   req.GetClusterConfig(),
+		LogFileBasePath: c.logFileLoc,
+	}
+
+	c.rotateLock.Lock()
+	defer c.rotateLock.Unlock()
+
+	var rotateTask task.Task
+	err := c.checkRotationNotRunning(taskName)
+	if err == nil {
+		rotateTask, err = task.NewRotateEncryptionKeyTask(taskName, taskConfig)
+	}
+	if err == nil {
+		// store and launch the task
+		err = c.storeAndLanuchTask(rotateTask)
+	}
+	if err != nil {
+		logrus.Errorf("RotateEncryptionKey request failed: %s", err)
+		return &pb.RotateEncryptionKeyReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("RotateEncryptionKey request succeeded")
+	return &pb.RotateEncryptionKeyReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+// checkRotationNotRunning makes sure the latest rotation is finished, a new rotation replaces the keys
+// of the running one and the stored task of it.
+func (c *controller) checkRotationNotRunning(taskName string) error {
+	if c.store == nil {
+		return fmt.Errorf("no task store")
+	}
+
+	tsk := c.store.GetTask(taskName)
+	if tsk == nil {
+		return nil
+	}
+
+	switch tsk.GetStatus() {
+	case task.TaskSuccessful, task.TaskFailed, task.TaskSkipped:
+		return nil
+	}
+	return fmt.Errorf("the latest encryption key rotation is still %v, please wait for it to finish", tsk.GetStatus())
+}
+
+func (c *controller) GetRotateEncryptionKeyResult(ctx context.Context, req *pb.GetRotateEncryptionKeyResultRequest) (*pb.GetRotateEncryptionKeyResultReply, error) {
+	logrus.Info("Begins GetRotateEncryptionKeyResult request")
+
+	var err error
+	defer func() {
+		if err != nil {
+			logrus.Errorf("Failed to reply GetRotateEncryptionKeyResult request, error: %v", err)
+		} else {
+			logrus.Info("Succeeded to reply GetRotateEncryptionKeyResult request.")
+		}
+	}()
+
+	tsk, err := c.getTask(getRotateEncryptionKeyTaskName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRotateEncryptionKeyResultReply{
+		Status: string(taskStatusToOperationStatus(tsk.GetStatus())),
+		Err:    tsk.GetErr(),
+	}, nil
+}
+
+func (c *controller) UpdateNodeConfig(ctx context.Context, req *pb.UpdateNodeConfigRequest) (*pb.UpdateNodeConfigReply, error) {
+	logrus.Infof("Begins UpdateNodeConfig request, dryRun: %v", req.GetDryRun())
+
+	var err error
+	defer func() {
+		if err != nil {
+			logrus.Errorf("request failed: %s", err)
+		}
+	}()
+
+	taskConfig := &task.UpdateNodeConfigTaskConfig{
+		NodeConfigs:     req.GetNodeConfigs(),
+		ClusterConfig:   req.GetClusterConfig(),
+		DryRun:          req.GetDryRun(),
+		LogFileBasePath: c.logFileLoc,
+	}
+
+	updateTask, err := task.NewUpdateNodeConfigTask(getUpdateNodeConfigTaskName(), taskConfig)
+	if err == nil {
+		err = c.storeAndExecuteTask(updateTask)
+	}
+	if err != nil {
+		return &pb.UpdateNodeConfigReply{
+			Passed: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	diffs := updateTask.(*task.UpdateNodeConfigTask).GetDiffs()
+
+	// Failures of single nodes are reported in their diffs, so the reply is still returned
+	// to let the caller know which nodes were updated.
+	if taskErr := updateTask.GetErr(); taskErr != nil {
+		logrus.Errorf("Ends UpdateNodeConfig request: failed: %s", taskErr)
+		return &pb.UpdateNodeConfigReply{
+			Passed: false,
+			Err:    taskErr,
+			Diffs:  diffs,
+		}, nil
+	}
+
+	logrus.Info("Ends UpdateNodeConfig request: succeeded")
+	return &pb.UpdateNodeConfigReply{
+		Passed: true,
+		Diffs:  diffs,
+	}, nil
+}
+
 func (c *controller) storeTask(task task.Task) error {
 	if c.store == nil {
 		return fmt.Errorf("no task store")
@@ -368,6 +608,21 @@ func getFetchKubeConfigTaskName(req *pb.FetchKubeConfigRequest) string {
 	return "fetch-kube-config"
 }
 
+func getReconfigureHATaskName() string {
+	// use a fixed name for now, it may be changed in the future
+	return "reconfigure-ha"
+}
+
+func getRotateEncryptionKeyTaskName() string {
+	// use a fixed name for now, it may be changed in the future
+	return "rotate-encryption-key"
+}
+
+func getUpdateNodeConfigTaskName() string {
+	// use a fixed name for now, it may be changed in the future
+	return "update-node-config"
+}
+
 func getTestConnectionTaskName(nodeName string) string {
 	// User may test a node's connection repeatly, so create a unique task name
 	// for each request
@@ -384,6 +639,8 @@ func taskStatusToOperationStatus(status task.Status) constant.OperationStatus {
 		return constant.OperationStatusSuccessful
 	case task.TaskFailed:
 		return constant.OperationStatusFailed
+	case task.TaskSkipped:
+		return constant.OperationStatusAborted
 	default:
 		return constant.OperationStatusUnknown
 	}
@@ -399,6 +656,8 @@ func actionStatusToOperationStatus(status action.Status) constant.OperationStatu
 		return constant.OperationStatusSuccessful
 	case action.ActionFailed:
 		return constant.OperationStatusFailed
+	case action.ActionSkipped:
+		return constant.OperationStatusAborted
 	default:
 		return constant.OperationStatusUnknown
 	}
@@ -414,6 +673,8 @@ func itemStatusToOperationStatus(status action.ItemStatus) constant.OperationSta
 		return constant.OperationStatusSuccessful
 	case action.ItemFailed:
 		return constant.OperationStatusFailed
+	case action.ItemWarning:
+		return constant.OperationStatusWarning
 	default:
 		return constant.OperationStatusUnknown
 	}
@@ -0,0 +1,64 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+)
+
+func TestRotateEncryptionKey(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	req := &pb.RotateEncryptionKeyRequest{
+		NodeConfigs:   []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master"}}},
+		ClusterConfig: &pb.ClusterConfig{Encryption: &pb.EncryptionConfig{Provider: "aescbc"}},
+	}
+
+	_, err := c.GetRotateEncryptionKeyResult(context.Background(), &pb.GetRotateEncryptionKeyResultRequest{})
+	assert.Error(t, err)
+
+	rotateTask, err := task.NewRotateEncryptionKeyTask(getRotateEncryptionKeyTaskName(), &task.RotateEncryptionKeyTaskConfig{
+		NodeConfigs:   req.GetNodeConfigs(),
+		ClusterConfig: req.GetClusterConfig(),
+	})
+	assert.NoError(t, err)
+	rotateTask.SetStatus(task.TaskDoing)
+	assert.NoError(t, c.storeTask(rotateTask))
+
+	result, err := c.GetRotateEncryptionKeyResult(context.Background(), &pb.GetRotateEncryptionKeyResultRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, string(constant.OperationStatusRunning), result.GetStatus())
+
+	// a new rotation is rejected until the running one finishes
+	reply, err := c.RotateEncryptionKey(context.Background(), req)
+	assert.Error(t, err)
+	assert.False(t, reply.GetAccepted())
+	assert.Equal(t, rotateTask, c.store.GetTask(getRotateEncryptionKeyTaskName()))
+
+	rotateTask.SetStatus(task.TaskFailed)
+	rotateTask.SetErr(&pb.Error{Reason: "failed to rewrite secrets"})
+	result, err = c.GetRotateEncryptionKeyResult(context.Background(), &pb.GetRotateEncryptionKeyResultRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, string(constant.OperationStatusFailed), result.GetStatus())
+	assert.Equal(t, "failed to rewrite secrets", result.GetErr().GetReason())
+
+	assert.NoError(t, c.checkRotationNotRunning(getRotateEncryptionKeyTaskName()))
+}
@@ -26,8 +26,11 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
 )
 
+// networkRole is not a machine role, it's used to report the network deploy item of every node.
+const networkRole = constant.MachineRole(constant.DeployItemNetwork)
+
 var allRoles = []constant.MachineRole{constant.MachineRoleEtcd, constant.MachineRoleMaster,
-	constant.MachineRoleWorker, constant.MachineRoleIngress}
+	constant.MachineRoleWorker, constant.MachineRoleIngress, networkRole}
 
 func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply, error) {
 	if aTask == nil {
@@ -96,12 +99,19 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 	}
 
 	// If all node init action are done, update deploy item results with non node init actions
+	var verifyItems []*pb.ItemCheckResult
 	if !initNotDone {
 		for _, act := range actions {
 			if act.GetType() == action.ActionTypeNodeInit {
 				continue
 			}
 
+			// the verify cluster action is not bound to a role, its items are reported separately
+			if verifyAct, ok := act.(*action.VerifyClusterAction); ok {
+				verifyItems = verifyActionToItemCheckResults(verifyAct)
+				continue
+			}
+
 			node := act.GetNode()
 			if node == nil || node.Name == "" {
 				logrus.Warn("Invalid node")
@@ -125,15 +135,34 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 
 	// Update the reply's status according to the deploy task's status.
 	result := &pb.GetDeployResultReply{
-		Status: string(taskStatusToOperationStatus(aTask.GetStatus())),
-		Err:    aTask.GetErr(),
-		Items:  sortResultByRole(roleNodeDeployItemResult),
+		Status:      string(taskStatusToOperationStatus(aTask.GetStatus())),
+		Err:         aTask.GetErr(),
+		Items:       sortResultByRole(roleNodeDeployItemResult),
+		VerifyItems: verifyItems,
+		Executions:  task.GetAllExecutions(aTask),
 	}
 
 	logrus.Debugf("Result: %+v", *result)
 
 	return result, nil
 }
+
+// verifyActionToItemCheckResults converts the items of verify cluster action to check results
+func verifyActionToItemCheckResults(verifyAct *action.VerifyClusterAction) []*pb.ItemCheckResult {
+	var results []*pb.ItemCheckResult
+	for _, item := range verifyAct.GetVerifyItems() {
+		results = append(results, &pb.ItemCheckResult{
+			Item: &pb.CheckItem{
+				Name:        item.Name,
+				Description: item.Description,
+			},
+			Status: string(itemStatusToOperationStatus(item.Status)),
+			Err:    item.Err,
+		})
+	}
+	return results
+}
+
 func sortMap(m map[string]*pb.DeployItemResult) []*pb.DeployItemResult {
 	// get all keys
 	var keys []string
@@ -172,6 +201,7 @@ func groupNodesByRole(cfgs []*pb.NodeDeployConfig) map[constant.MachineRole][]st
 			roleName := constant.MachineRole(role)
 			roleNodes[roleName] = append(roleNodes[roleName], nodeCfg.Node.Name)
 		}
+		roleNodes[networkRole] = append(roleNodes[networkRole], nodeCfg.Node.Name)
 	}
 	return roleNodes
 }
@@ -186,8 +216,10 @@ func actionTypeToRole(actionType action.Type) constant.MachineRole {
 		return constant.MachineRoleWorker
 	case action.ActionTypeDeployIngress:
 		return constant.MachineRoleIngress
-	case action.ActionTypeDeployContour:
+	case action.ActionTypeDeployIngressController:
 		return constant.MachineRoleIngress
+	case action.ActionTypeDeployNetwork:
+		return networkRole
 	// treat node init action as ectd role
 	case action.ActionTypeNodeInit:
 		return constant.MachineRoleEtcd
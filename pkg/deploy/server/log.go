@@ -137,6 +137,8 @@ var roleActionTypeMap = map[constant.MachineRole]map[action.Type]struct{}{
 		action.ActionTypeDeployConfig: struct{}{},
 		action.ActionTypeInitMaster:   struct{}{},
 		action.ActionTypeJoinMaster:   struct{}{},
+		// verify cluster action runs on the first master
+		action.ActionTypeVerifyCluster: struct{}{},
 	},
 	constant.MachineRoleWorker: map[action.Type]struct{}{
 		action.ActionTypeNodeInit:     struct{}{},
@@ -144,10 +146,10 @@ var roleActionTypeMap = map[constant.MachineRole]map[action.Type]struct{}{
 		action.ActionTypeDeployWorker: struct{}{},
 	},
 	constant.MachineRoleIngress: map[action.Type]struct{}{
-		action.ActionTypeNodeInit:      struct{}{},
-		action.ActionTypeDeployConfig:  struct{}{},
-		action.ActionTypeDeployIngress: struct{}{},
-		action.ActionTypeDeployContour: struct{}{},
+		action.ActionTypeNodeInit:                struct{}{},
+		action.ActionTypeDeployConfig:            struct{}{},
+		action.ActionTypeDeployIngress:           struct{}{},
+		action.ActionTypeDeployIngressController: struct{}{},
 	},
 }
 
@@ -33,17 +33,21 @@ type Interface interface {
 type ServerOptions struct {
 	Port       uint16
 	LogFileLoc string
+	// CustomCheckItems are run in every node check besides the built-in checks
+	CustomCheckItems []*protos.CustomCheckItem
 }
 
 type server struct {
-	port       uint16
-	logFileLoc string
+	port             uint16
+	logFileLoc       string
+	customCheckItems []*protos.CustomCheckItem
 }
 
 func New(options ServerOptions) Interface {
 	return &server{
-		port:       options.Port,
-		logFileLoc: options.LogFileLoc,
+		port:             options.Port,
+		logFileLoc:       options.LogFileLoc,
+		customCheckItems: options.CustomCheckItems,
 	}
 }
 
@@ -53,8 +57,9 @@ func (s *server) Run(stopCh <-chan struct{}) error {
 	// use the map cache store
 	store := task.GetGlobalCacheStore()
 	protos.RegisterDeployContollerServer(gRpcSvr, &controller{
-		store:      store,
-		logFileLoc: s.logFileLoc,
+		store:            store,
+		logFileLoc:       s.logFileLoc,
+		customCheckItems: s.customCheckItems,
 	})
 	reflection.Register(gRpcSvr)
 
@@ -79,31 +79,20 @@ func (p *checkNetworkRequirementsProcessor) SplitTask(task Task) error {
 			NetworkType: string(consts.NetworkTypeCalico),
 		}
 	}
-	var actions []action.Action
-	switch checkNetworkRequirementsTask.NetworkOptions.NetworkType {
-	case string(consts.NetworkTypeCalico):
-		var err error
-		if checkNetworkRequirementsTask.NetworkOptions.CalicoOptions == nil {
-			checkNetworkRequirementsTask.NetworkOptions.CalicoOptions = &pb.CalicoOptions{
-				CheckConnectivityAll: false,
-				EncapsulationMode:    "vxlan",
-				VxlanPort:            4789,
-			}
-		}
-		actions, err = p.splitActionsCalico(checkNetworkRequirementsTask)
-		checkNetworkRequirementsTask.Actions = actions
-		if err != nil {
-			return fmt.Errorf("failed to split actions, error %v", err)
-		}
-	default:
-		return fmt.Errorf("unsupported network type: %s",
-			checkNetworkRequirementsTask.NetworkOptions.NetworkType)
+	if err := setDefaultNetworkOptions(checkNetworkRequirementsTask.NetworkOptions); err != nil {
+		return err
+	}
+
+	actions, err := p.splitActions(checkNetworkRequirementsTask)
+	checkNetworkRequirementsTask.Actions = actions
+	if err != nil {
+		return fmt.Errorf("failed to split actions, error %v", err)
 	}
 
 	return nil
 }
 
-func (p *checkNetworkRequirementsProcessor) splitActionsCalico(
+func (p *checkNetworkRequirementsProcessor) splitActions(
 	task *CheckNetworkRequirementsTask) ([]action.Action, error) {
 	// randomly choose a "peer" for each node.
 	numNodes := len(task.Nodes)
@@ -118,8 +107,8 @@ func (p *checkNetworkRequirementsProcessor) splitActionsCalico(
 		if peerIndex == i {
 			peerIndex = numNodes - 1
 		}
-		connectivityCheckAction, err := makeConnectivityCheckActionCalico(
-			node, task.Nodes[peerIndex], task.NetworkOptions.CalicoOptions, task.GetLogFileDir())
+		connectivityCheckAction, err := makeConnectivityCheckAction(
+			node, task.Nodes[peerIndex], task.NetworkOptions, task.GetLogFileDir())
 		if err != nil {
 			return []action.Action{}, fmt.Errorf("failed to split task into actions, error %v", err)
 		}
@@ -128,45 +117,160 @@ func (p *checkNetworkRequirementsProcessor) splitActionsCalico(
 	return actions, nil
 }
 
-func makeConnectivityCheckActionCalico(
-	src *pb.Node, dst *pb.Node, calicoOptions *pb.CalicoOptions, logDir string) (action.Action, error) {
+// setDefaultNetworkOptions fills the options of the network type with default values if not specified.
+func setDefaultNetworkOptions(options *pb.NetworkOptions) error {
+	switch options.NetworkType {
+	case "", string(consts.NetworkTypeCalico):
+		options.NetworkType = string(consts.NetworkTypeCalico)
+		if options.CalicoOptions == nil {
+			options.CalicoOptions = &pb.CalicoOptions{
+				CheckConnectivityAll: false,
+				EncapsulationMode:    consts.EncapsulationModeVxlan,
+				VxlanPort:            consts.DefaultVxlanPort,
+			}
+		}
+	case string(consts.NetworkTypeFlannel):
+		if options.FlannelOptions == nil {
+			options.FlannelOptions = &pb.FlannelOptions{}
+		}
+		if options.FlannelOptions.Backend == "" {
+			options.FlannelOptions.Backend = consts.FlannelBackendVxlan
+		}
+		if options.FlannelOptions.VxlanPort == 0 {
+			options.FlannelOptions.VxlanPort = consts.DefaultFlannelVxlanPort
+		}
+	case string(consts.NetworkTypeCilium):
+		if options.CiliumOptions == nil {
+			options.CiliumOptions = &pb.CiliumOptions{}
+		}
+		if options.CiliumOptions.TunnelMode == "" {
+			options.CiliumOptions.TunnelMode = consts.CiliumTunnelModeVxlan
+		}
+	default:
+		return fmt.Errorf("unsupported network type: %s", options.NetworkType)
+	}
+	return nil
+}
+
+// makeConnectivityCheckAction makes an action to check the ports required by the network type from src to dst.
+func makeConnectivityCheckAction(
+	src *pb.Node, dst *pb.Node, options *pb.NetworkOptions, logDir string) (action.Action, error) {
 	if src == nil {
 		return nil, fmt.Errorf("source node empty")
 	}
 	if dst == nil {
 		return nil, fmt.Errorf("destination node empty")
 	}
-	if calicoOptions == nil {
-		return nil, fmt.Errorf("calico options empty")
+	if options == nil {
+		return nil, fmt.Errorf("network options empty")
 	}
+
+	var items []*action.ConnectivityCheckItem
+	switch options.NetworkType {
+	case string(consts.NetworkTypeCalico):
+		if options.CalicoOptions == nil {
+			return nil, fmt.Errorf("calico options empty")
+		}
+		items = connectivityCheckItemsCalico(options.CalicoOptions)
+	case string(consts.NetworkTypeFlannel):
+		if options.FlannelOptions == nil {
+			return nil, fmt.Errorf("flannel options empty")
+		}
+		items = connectivityCheckItemsFlannel(options.FlannelOptions)
+	case string(consts.NetworkTypeCilium):
+		if options.CiliumOptions == nil {
+			return nil, fmt.Errorf("cilium options empty")
+		}
+		items = connectivityCheckItemsCilium(options.CiliumOptions)
+	default:
+		return nil, fmt.Errorf("unsupported network type: %s", options.NetworkType)
+	}
+
 	cfg := &action.ConnectivityCheckActionConfig{
-		LogFileBasePath: logDir,
-		SourceNode:      src,
-		DestinationNode: dst,
-		ConnectivityCheckItems: []*action.ConnectivityCheckItem{
-			&action.ConnectivityCheckItem{
-				Protocol:    consts.ProtocolTCP,
-				Port:        uint16(179),
-				Name:        "connectivity-BGP",
-				Description: "检查BGP端口连通性",
-				Status:      action.ItemPending,
-			},
-			&action.ConnectivityCheckItem{
-				Protocol:    consts.ProtocolTCP,
-				Port:        uint16(6443),
-				Name:        "connectivity-kube-API",
-				Description: "检查kubernetes API 服务端口连通性",
-				Status:      action.ItemPending,
-			},
-			&action.ConnectivityCheckItem{
-				Protocol:    consts.ProtocolUDP,
-				Port:        uint16(calicoOptions.VxlanPort),
-				Name:        "connectivity-vxlan",
-				Description: "检查vxlan连通性",
-
-				Status: action.ItemPending,
-			},
-		},
+		LogFileBasePath:        logDir,
+		SourceNode:             src,
+		DestinationNode:        dst,
+		ConnectivityCheckItems: items,
 	}
 	return action.NewConnectivityCheckAction(cfg)
 }
+
+func kubeAPIConnectivityCheckItem() *action.ConnectivityCheckItem {
+	return &action.ConnectivityCheckItem{
+		Protocol:    consts.ProtocolTCP,
+		Port:        uint16(6443),
+		Name:        "connectivity-kube-API",
+		Description: "检查kubernetes API 服务端口连通性",
+		Status:      action.ItemPending,
+	}
+}
+
+func connectivityCheckItemsCalico(calicoOptions *pb.CalicoOptions) []*action.ConnectivityCheckItem {
+	return []*action.ConnectivityCheckItem{
+		&action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolTCP,
+			Port:        uint16(179),
+			Name:        "connectivity-BGP",
+			Description: "检查BGP端口连通性",
+			Status:      action.ItemPending,
+		},
+		kubeAPIConnectivityCheckItem(),
+		&action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolUDP,
+			Port:        uint16(calicoOptions.VxlanPort),
+			Name:        "connectivity-vxlan",
+			Description: "检查vxlan连通性",
+
+			Status: action.ItemPending,
+		},
+	}
+}
+
+func connectivityCheckItemsFlannel(flannelOptions *pb.FlannelOptions) []*action.ConnectivityCheckItem {
+	items := []*action.ConnectivityCheckItem{
+		kubeAPIConnectivityCheckItem(),
+	}
+	// host-gw routes packets directly between hosts, no extra port is required.
+	if flannelOptions.Backend == consts.FlannelBackendVxlan {
+		items = append(items, &action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolUDP,
+			Port:        uint16(flannelOptions.VxlanPort),
+			Name:        "connectivity-flannel-vxlan",
+			Description: "检查flannel vxlan连通性",
+			Status:      action.ItemPending,
+		})
+	}
+	return items
+}
+
+func connectivityCheckItemsCilium(ciliumOptions *pb.CiliumOptions) []*action.ConnectivityCheckItem {
+	items := []*action.ConnectivityCheckItem{
+		&action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolTCP,
+			Port:        uint16(consts.DefaultCiliumHealthPort),
+			Name:        "connectivity-cilium-health",
+			Description: "检查cilium健康检查端口连通性",
+			Status:      action.ItemPending,
+		},
+		kubeAPIConnectivityCheckItem(),
+	}
+	switch ciliumOptions.TunnelMode {
+	case consts.CiliumTunnelModeVxlan:
+		items = append(items, &action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolUDP,
+			Port:        uint16(consts.DefaultCiliumVxlanPort),
+			Name:        "connectivity-cilium-vxlan",
+			Description: "检查cilium vxlan连通性",
+			Status:      action.ItemPending,
+		})
+	case consts.CiliumTunnelModeGeneve:
+		items = append(items, &action.ConnectivityCheckItem{
+			Protocol:    consts.ProtocolUDP,
+			Port:        uint16(consts.DefaultCiliumGenevePort),
+			Name:        "connectivity-cilium-geneve",
+			Description: "检查cilium geneve连通性",
+			Status:      action.ItemPending,
+		})
+	}
+	return items
+}
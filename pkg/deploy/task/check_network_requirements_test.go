@@ -0,0 +1,112 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func checkItemPorts(t *testing.T, act action.Action) map[string]uint16 {
+	connectivityCheckAction, ok := act.(*action.ConnectivityCheckAction)
+	assert.True(t, ok)
+	ports := make(map[string]uint16)
+	for _, item := range connectivityCheckAction.CheckItems {
+		ports[string(item.Protocol)+"/"+item.Name] = item.Port
+	}
+	return ports
+}
+
+func TestCheckNetworkRequirementsSplitTask(t *testing.T) {
+	nodes := []*pb.Node{
+		{Name: "node1", Ip: "192.168.1.1"},
+		{Name: "node2", Ip: "192.168.1.2"},
+	}
+
+	tests := []struct {
+		options  *pb.NetworkOptions
+		expected map[string]uint16
+	}{
+		{
+			options: nil,
+			expected: map[string]uint16{
+				"tcp/connectivity-BGP":      179,
+				"tcp/connectivity-kube-API": 6443,
+				"udp/connectivity-vxlan":    consts.DefaultVxlanPort,
+			},
+		},
+		{
+			options: &pb.NetworkOptions{NetworkType: string(consts.NetworkTypeFlannel)},
+			expected: map[string]uint16{
+				"tcp/connectivity-kube-API":      6443,
+				"udp/connectivity-flannel-vxlan": consts.DefaultFlannelVxlanPort,
+			},
+		},
+		{
+			options: &pb.NetworkOptions{
+				NetworkType:    string(consts.NetworkTypeFlannel),
+				FlannelOptions: &pb.FlannelOptions{Backend: consts.FlannelBackendHostGW},
+			},
+			expected: map[string]uint16{
+				"tcp/connectivity-kube-API": 6443,
+			},
+		},
+		{
+			options: &pb.NetworkOptions{NetworkType: string(consts.NetworkTypeCilium)},
+			expected: map[string]uint16{
+				"tcp/connectivity-cilium-health": consts.DefaultCiliumHealthPort,
+				"tcp/connectivity-kube-API":      6443,
+				"udp/connectivity-cilium-vxlan":  consts.DefaultCiliumVxlanPort,
+			},
+		},
+		{
+			options: &pb.NetworkOptions{
+				NetworkType:   string(consts.NetworkTypeCilium),
+				CiliumOptions: &pb.CiliumOptions{TunnelMode: consts.CiliumTunnelModeGeneve},
+			},
+			expected: map[string]uint16{
+				"tcp/connectivity-cilium-health": consts.DefaultCiliumHealthPort,
+				"tcp/connectivity-kube-API":      6443,
+				"udp/connectivity-cilium-geneve": consts.DefaultCiliumGenevePort,
+			},
+		},
+	}
+
+	processor := new(checkNetworkRequirementsProcessor)
+	for _, test := range tests {
+		checkTask, err := NewCheckNetworkRequirementsTask("test-task", &CheckNetworkRequirementsTaskConfig{
+			Nodes:          nodes,
+			NetworkOptions: test.options,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, processor.SplitTask(checkTask))
+		assert.Equal(t, len(nodes), len(checkTask.GetActions()))
+		for _, act := range checkTask.GetActions() {
+			assert.Equal(t, test.expected, checkItemPorts(t, act))
+		}
+	}
+
+	checkTask, err := NewCheckNetworkRequirementsTask("test-task", &CheckNetworkRequirementsTaskConfig{
+		Nodes:          nodes,
+		NetworkOptions: &pb.NetworkOptions{NetworkType: "unknown"},
+	})
+	assert.NoError(t, err)
+	assert.Error(t, processor.SplitTask(checkTask))
+}
@@ -63,6 +63,7 @@ func (p *deployEtcdProcessor) SplitTask(t Task) error {
 			CaKey:           cakey,
 			Node:            node,
 			ClusterNodes:    etcdTask.Nodes,
+			ClusterConfig:   etcdTask.ClusterConfig,
 			LogFileBasePath: etcdTask.LogFileDir,
 		}
 		act, err := action.NewDeployEtcdAction(actionCfg)
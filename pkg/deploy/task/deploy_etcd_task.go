@@ -28,6 +28,7 @@ const TaskTypeDeployEtcd Type = "DeployEtcd"
 // DeployEtcdTaskConfig represents the config for a deploy etcd task.
 type DeployEtcdTaskConfig struct {
 	Nodes           []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
 	LogFileBasePath string
 	Priority        int
 	Parent          string
@@ -36,7 +37,8 @@ type DeployEtcdTaskConfig struct {
 type DeployEtcdTask struct {
 	Base
 
-	Nodes []*pb.Node
+	Nodes         []*pb.Node
+	ClusterConfig *pb.ClusterConfig
 }
 
 // NewDeployEtcdTask returns a deploy etcd task based on the config.
@@ -65,7 +67,8 @@ func NewDeployEtcdTask(taskName string, taskConfig *DeployEtcdTaskConfig) (Task,
 			Priority:          taskConfig.Priority,
 			Parent:            taskConfig.Parent,
 		},
-		Nodes: taskConfig.Nodes,
+		Nodes:         taskConfig.Nodes,
+		ClusterConfig: taskConfig.ClusterConfig,
 	}
 
 	return task, nil
@@ -69,7 +69,7 @@ func (processor *DeployIngressProcessor) SplitTask(task Task) error {
 		actions = append(actions, act)
 	}
 
-	installContourAction, err := action.NewDeployContourAction(&action.DeployContourActionConfig{
+	installControllerAction, err := action.NewDeployIngressControllerAction(&action.DeployIngressControllerActionConfig{
 		ClusterConfig:   deployTask.Config.ClusterConfig,
 		MasterNodes:     deployTask.Config.MasterNodes,
 		LogFileBasePath: deployTask.LogFileDir, // /app/deploy/logs/unknown/deploy-ingress
@@ -79,7 +79,7 @@ func (processor *DeployIngressProcessor) SplitTask(task Task) error {
 		return err
 	}
 
-	actions = append(actions, installContourAction)
+	actions = append(actions, installControllerAction)
 
 	deployTask.Actions = actions
 
@@ -0,0 +1,86 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeDeployNetwork, new(deployNetworkProcessor))
+}
+
+// deployNetworkProcessor implements the specific logic for the deploy network task.
+type deployNetworkProcessor struct {
+}
+
+// Spilt the task into one deploy network action per node, the action of first master installs the network.
+func (p *deployNetworkProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split deploy network task")
+
+	networkTask := t.(*DeployNetworkTask)
+	firstMaster := networkTask.MasterNodes[0]
+
+	actions := make([]action.Action, 0, len(networkTask.NodeConfigs))
+	for _, nodeConfig := range networkTask.NodeConfigs {
+		act, err := action.NewDeployNetworkAction(&action.DeployNetworkActionConfig{
+			Node:            nodeConfig.GetNode(),
+			MasterNode:      firstMaster,
+			ClusterConfig:   networkTask.ClusterConfig,
+			Install:         nodeConfig.GetNode().GetName() == firstMaster.GetName(),
+			LogFileBasePath: networkTask.LogFileDir,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	networkTask.Actions = actions
+
+	logger.Debugf("Finish to split deploy network task: %d actions", len(actions))
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *deployNetworkProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	networkTask, ok := t.(*DeployNetworkTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(networkTask.MasterNodes) == 0 {
+		return fmt.Errorf("master nodes is empty")
+	}
+
+	return nil
+}
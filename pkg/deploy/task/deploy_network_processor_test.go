@@ -0,0 +1,58 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestDeployNetworkSplitTask(t *testing.T) {
+	master := &pb.Node{Name: "master1", Ip: "192.168.1.1"}
+	networkTask, err := NewDeployNetworkTask("deploy-network", &DeployNetworkTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: master, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "worker1", Ip: "192.168.1.2"}, Roles: []string{"worker"}},
+		},
+		MasterNodes:   []*pb.Node{master},
+		ClusterConfig: &pb.ClusterConfig{},
+	})
+	assert.NoError(t, err)
+
+	processor := new(deployNetworkProcessor)
+	assert.NoError(t, processor.SplitTask(networkTask))
+
+	actions := networkTask.GetActions()
+	assert.Len(t, actions, 2)
+	installs := 0
+	for _, act := range actions {
+		networkAction := act.(*action.DeployNetworkAction)
+		assert.Equal(t, master, networkAction.MasterNode)
+		if networkAction.Install {
+			installs++
+			assert.Equal(t, "master1", networkAction.Node.Name)
+		}
+	}
+	assert.Equal(t, 1, installs)
+
+	_, err = NewDeployNetworkTask("deploy-network", &DeployNetworkTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: master}},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeDeployNetwork Type = "DeployNetwork"
+
+// DeployNetworkTaskConfig represents the config for a deploy network task.
+type DeployNetworkTaskConfig struct {
+	BaseTaskConfig
+	NodeConfigs   []*pb.NodeDeployConfig
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+}
+
+// DeployNetworkTask installs the network components from the first master and waits for every node's network to be ready.
+type DeployNetworkTask struct {
+	Base
+
+	NodeConfigs   []*pb.NodeDeployConfig
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+}
+
+// NewDeployNetworkTask returns a deploy network task based on the config.
+// User should use this function to create a deploy network task.
+func NewDeployNetworkTask(taskName string, taskConfig *DeployNetworkTaskConfig) (Task, error) {
+	if taskConfig == nil {
+		return nil, fmt.Errorf("invalid task config: nil")
+	}
+	if len(taskConfig.NodeConfigs) == 0 {
+		return nil, fmt.Errorf("invalid task config: nodeConfigs is empty")
+	}
+	if len(taskConfig.MasterNodes) == 0 {
+		return nil, fmt.Errorf("invalid task config: master nodes is empty")
+	}
+
+	task := &DeployNetworkTask{
+		Base: Base{
+			Name:                taskName,
+			TaskType:            TaskTypeDeployNetwork,
+			Status:              TaskPending,
+			LogFileDir:          GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp:   time.Now(),
+			Priority:            taskConfig.Priority,
+			Parent:              taskConfig.Parent,
+			FailureCanBeIgnored: true,
+		},
+		NodeConfigs:   taskConfig.NodeConfigs,
+		MasterNodes:   taskConfig.MasterNodes,
+		ClusterConfig: taskConfig.ClusterConfig,
+	}
+
+	return task, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
+	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/master"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// certificate key of kubeadm is generated again for each deployment, so it's not shown in the plan
+const planCertificateKey = "<generated-at-deployment>"
+
+// GetTaskPlan returns the task with its sub tasks and actions, the task is supposed to be split by PlanTask.
+func GetTaskPlan(t Task) *pb.DeployPlanTask {
+	plan := &pb.DeployPlanTask{
+		Name:     t.GetName(),
+		Type:     string(t.GetType()),
+		Priority: int32(t.GetPriority()),
+	}
+
+	for _, act := range t.GetActions() {
+		plan.Actions = append(plan.Actions, &pb.DeployPlanAction{
+			Name: act.GetName(),
+			Type: string(act.GetType()),
+			Node: act.GetNode().GetName(),
+		})
+	}
+
+	for _, subTask := range t.GetSubTasks() {
+		plan.SubTasks = append(plan.SubTasks, GetTaskPlan(subTask))
+	}
+
+	return plan
+}
+
+// PlanFiles returns the files rendered for nodes and the etcd certificates, nothing is put to nodes.
+func (t *DeployTask) PlanFiles() ([]*pb.DeployPlanFile, []*pb.EtcdCertPlan, error) {
+	roles := new(deployProcessor).groupByRole(t.NodeConfigs)
+	unwrapNodes := new(deployProcessor).unwrapNodes
+
+	files, err := it.PlanHAFiles(t.NodeConfigs, t.ClusterConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	masterFiles, err := master.PlanFiles(unwrapNodes(roles[constant.MachineRoleMaster]),
+		unwrapNodes(roles[constant.MachineRoleEtcd]), t.ClusterConfig, planCertificateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	files = append(files, masterFiles...)
+
+	etcdCerts, err := etcd.PlanCerts(unwrapNodes(roles[constant.MachineRoleEtcd]))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return files, etcdCerts, nil
+}
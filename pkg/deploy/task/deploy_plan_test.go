@@ -0,0 +1,79 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestPlanDeployTask(t *testing.T) {
+	taskCfg := &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "master2", Ip: "192.168.1.2"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "worker1", Ip: "192.168.1.3"}, Roles: []string{"worker"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type:       "keepalived",
+				Keepalived: &pb.Keepalived{Vip: "192.168.1.100", NetInterfaceName: "eth0"},
+			},
+		},
+	}
+
+	deployTask, err := NewDeployTask("test-task", taskCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, PlanTask(deployTask))
+
+	// nothing is executed
+	for _, act := range GetAllActions(deployTask) {
+		assert.Equal(t, "pending", string(act.GetStatus()))
+	}
+
+	plan := GetTaskPlan(deployTask)
+	assert.Equal(t, "test-task", plan.Name)
+	var subTaskNames []string
+	for _, subTask := range plan.SubTasks {
+		subTaskNames = append(subTaskNames, subTask.Name)
+	}
+	assert.Equal(t, []string{"init", "deploy-etcd", "deploy-master", "deploy-worker", "deploy-network", "deploy-config", "verify-cluster"}, subTaskNames)
+	assert.Equal(t, 3, len(plan.SubTasks[0].Actions))
+	assert.Equal(t, 2, len(plan.SubTasks[2].SubTasks))
+
+	files, etcdCerts, err := deployTask.(*DeployTask).PlanFiles()
+	assert.NoError(t, err)
+	var paths []string
+	for _, file := range files {
+		paths = append(paths, file.Node+":"+file.Path)
+	}
+	assert.Equal(t, []string{
+		"master1:/etc/haproxy/haproxy.cfg",
+		"master1:/etc/keepalived/keepalived.conf",
+		"master2:/etc/haproxy/haproxy.cfg",
+		"master2:/etc/keepalived/keepalived.conf",
+		"master1:/etc/kubernetes/kubeadm_config.yaml",
+	}, paths)
+	assert.Contains(t, files[4].Content, "certificateKey: "+planCertificateKey)
+	assert.Contains(t, files[4].Content, "https://192.168.1.2:2379")
+
+	assert.Equal(t, 2, len(etcdCerts))
+	assert.Equal(t, "master2", etcdCerts[1].CommonName)
+	assert.Equal(t, []string{"master2", "localhost"}, etcdCerts[1].DnsNames)
+	assert.Equal(t, []string{"192.168.1.2", "127.0.0.1", "::1"}, etcdCerts[1].Ips)
+}
@@ -47,7 +47,7 @@ func (p *deployProcessor) SplitTask(t Task) error {
 
 	logger.Debug("Start to split deploy task")
 
-	// split task into subtask: init, deploy etcd, deploy master, deploy worker, deploy ingress
+	// split task into subtask: init, deploy etcd, deploy master, deploy worker, deploy ingress, deploy network, deploy config, verify cluster
 	var subTasks []Task
 
 	// first collect all roles and their related nodes
@@ -106,6 +106,17 @@ func (p *deployProcessor) SplitTask(t Task) error {
 		subTasks = append(subTasks, ingressTask)
 	}
 
+	// create the deploy network sub task with priority = 55
+	if _, ok := roles[constant.MachineRoleMaster]; ok {
+		networkTask, err := p.createNetworkSubTask(deployTask, roles)
+		if err != nil {
+			err = fmt.Errorf("failed to create deploy network sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		subTasks = append(subTasks, networkTask)
+	}
+
 	// create the deploy config sub task with priority = 60
 	configTask, err := p.createConfigSubTask(deployTask, roles)
 	if err != nil {
@@ -115,6 +126,17 @@ func (p *deployProcessor) SplitTask(t Task) error {
 	}
 	subTasks = append(subTasks, configTask)
 
+	// create the verify cluster sub task with priority = 70
+	if _, ok := roles[constant.MachineRoleMaster]; ok {
+		verifyTask, err := p.createVerifySubTask(deployTask, roles)
+		if err != nil {
+			err = fmt.Errorf("failed to create verify cluster sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		subTasks = append(subTasks, verifyTask)
+	}
+
 	deployTask.SubTasks = subTasks
 	logger.Debugf("Finish to split deploy task: %d sub tasks", len(subTasks))
 
@@ -164,6 +186,38 @@ func (p *deployProcessor) createInitSubTask(parent *DeployTask, rn map[constant.
 	return
 }
 
+func (p *deployProcessor) createNetworkSubTask(parent *DeployTask, rn map[constant.MachineRole][]*pb.NodeDeployConfig) (task Task, err error) {
+
+	config := &DeployNetworkTaskConfig{
+		BaseTaskConfig: BaseTaskConfig{
+			LogFileBasePath: parent.GetLogFileDir(),
+			Priority:        int(DeployNetworkPriority),
+			Parent:          parent.GetName(),
+		},
+		NodeConfigs:   parent.NodeConfigs,
+		ClusterConfig: parent.ClusterConfig,
+		MasterNodes:   p.unwrapNodes(rn[constant.MachineRoleMaster]),
+	}
+	task, err = NewDeployNetworkTask("deploy-network", config)
+	return
+}
+
+func (p *deployProcessor) createVerifySubTask(parent *DeployTask, rn map[constant.MachineRole][]*pb.NodeDeployConfig) (task Task, err error) {
+
+	config := &VerifyClusterTaskConfig{
+		BaseTaskConfig: BaseTaskConfig{
+			LogFileBasePath: parent.GetLogFileDir(),
+			Priority:        int(VerifyClusterPriority),
+			Parent:          parent.GetName(),
+		},
+		NodeConfigs:   parent.NodeConfigs,
+		ClusterConfig: parent.ClusterConfig,
+		MasterNodes:   p.unwrapNodes(rn[constant.MachineRoleMaster]),
+	}
+	task, err = NewVerifyClusterTask("verify-cluster", config)
+	return
+}
+
 func (p *deployProcessor) createConfigSubTask(parent *DeployTask, rn map[constant.MachineRole][]*pb.NodeDeployConfig) (task Task, err error) {
 
 	config := &DeployConfigTaskConfig{
@@ -184,6 +238,7 @@ func (p *deployProcessor) createDeploySubTask(role constant.MachineRole, parent
 	case constant.MachineRoleEtcd:
 		config := &DeployEtcdTaskConfig{
 			Nodes:           p.unwrapNodes(rn[role]),
+			ClusterConfig:   parent.ClusterConfig,
 			LogFileBasePath: parent.GetLogFileDir(),
 			Priority:        int(Priorities[role]),
 			Parent:          parent.GetName(),
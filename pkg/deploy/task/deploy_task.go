@@ -22,6 +22,9 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/master"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -39,7 +42,11 @@ const (
 	DeployMasterPriority  Priority = 30
 	DeployWorkerPriority  Priority = 40
 	DeployIngressPriority Priority = 50
+	// network waits for every node to be ready, so it is deployed after all nodes joined
+	DeployNetworkPriority Priority = 55
 	ConfigPriority        Priority = 60
+	// the cluster is verified after everything is deployed
+	VerifyClusterPriority Priority = 70
 )
 
 var (
@@ -53,10 +60,11 @@ var (
 
 // DeployTaskConfig represents the config for a deploy task.
 type DeployTaskConfig struct {
-	NodeConfigs     []*pb.NodeDeployConfig
-	ClusterConfig   *pb.ClusterConfig
-	LogFileBasePath string
-	Priority        int
+	NodeConfigs       []*pb.NodeDeployConfig
+	ClusterConfig     *pb.ClusterConfig
+	ExecutionPolicies map[string]*pb.ExecutionPolicy
+	LogFileBasePath   string
+	Priority          int
 }
 
 type DeployTask struct {
@@ -74,6 +82,31 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 
 	} else if len(taskConfig.NodeConfigs) == 0 {
 		err = fmt.Errorf("invalid task config: node deploy configs is empty")
+
+	} else if err = deploy.ValidateContainerRuntime(taskConfig.ClusterConfig.GetContainerRuntime()); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = it.ValidateNodeInitProfile(taskConfig.ClusterConfig.GetNodeInitProfile()); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = it.ValidateProxy(taskConfig.ClusterConfig.GetProxy()); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = it.ValidateRegistries(taskConfig.ClusterConfig.GetRegistries(),
+		deploy.GetContainerRuntime(taskConfig.ClusterConfig)); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = master.ValidateComponentConfig(taskConfig.ClusterConfig); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = master.ValidateAudit(taskConfig.ClusterConfig.GetAudit()); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = master.ValidateEncryption(taskConfig.ClusterConfig.GetEncryption()); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else if err = ValidateExecutionPolicies(taskConfig.ExecutionPolicies); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
 	}
 
 	if err != nil {
@@ -89,6 +122,7 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName), // /app/deploy/logs/unknown
 			CreationTimestamp: time.Now(),
 			Priority:          taskConfig.Priority,
+			ExecutionPolicies: taskConfig.ExecutionPolicies,
 		},
 		NodeConfigs:   taskConfig.NodeConfigs,
 		ClusterConfig: taskConfig.ClusterConfig,
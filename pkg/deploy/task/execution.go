@@ -0,0 +1,132 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"sync"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const maxFailureThreshold = 100
+
+// ValidateExecutionPolicies checks the execution policies keyed by task type
+func ValidateExecutionPolicies(policies map[string]*pb.ExecutionPolicy) error {
+	for taskType, policy := range policies {
+		if _, err := NewProcessor(Type(taskType)); err != nil {
+			return fmt.Errorf("invalid execution policy: %v", err)
+		}
+		if policy == nil {
+			return fmt.Errorf("invalid execution policy of %v: nil", taskType)
+		}
+		if policy.GetConcurrency() < 0 || policy.GetBatchSize() < 0 {
+			return fmt.Errorf("invalid execution policy of %v: concurrency and batchSize can not be negative", taskType)
+		}
+		if policy.GetFailureThreshold() < 0 || policy.GetFailureThreshold() > maxFailureThreshold {
+			return fmt.Errorf("invalid execution policy of %v: failureThreshold should be in [0, %v]", taskType, maxFailureThreshold)
+		}
+	}
+	return nil
+}
+
+// getExecutionPolicy returns the execution policy of the task's type, it's nil if not set
+func getExecutionPolicy(t Task) *pb.ExecutionPolicy {
+	return t.GetExecutionPolicies()[string(t.GetType())]
+}
+
+// inheritExecutionPolicies passes the execution policies of the task to its sub tasks which have none
+func inheritExecutionPolicies(t Task) {
+	if len(t.GetExecutionPolicies()) == 0 {
+		return
+	}
+
+	for _, subTask := range t.GetSubTasks() {
+		if subTask.GetExecutionPolicies() == nil {
+			subTask.SetExecutionPolicies(t.GetExecutionPolicies())
+		}
+	}
+}
+
+// executeWithPolicy executes the items batch by batch, at most policy.Concurrency items are executed at the same time.
+// It stops after a batch if the failed items reach policy.FailureThreshold percent of total, and returns the number of
+// executed items, the items after them are not executed. All items are executed at once if policy is nil.
+func executeWithPolicy(total int, policy *pb.ExecutionPolicy, execute func(index int, wg *sync.WaitGroup),
+	failed func(index int) bool) (executed int, thresholdReached bool) {
+
+	batchSize := int(policy.GetBatchSize())
+	if batchSize <= 0 || batchSize > total {
+		batchSize = total
+	}
+	concurrency := int(policy.GetConcurrency())
+	if concurrency <= 0 || concurrency > batchSize {
+		concurrency = batchSize
+	}
+
+	for executed < total {
+		end := executed + batchSize
+		if end > total {
+			end = total
+		}
+
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, concurrency)
+		for i := executed; i < end; i++ {
+			semaphore <- struct{}{}
+			wg.Add(1)
+			go func(index int) {
+				defer func() { <-semaphore }()
+				execute(index, &wg)
+			}(i)
+		}
+		wg.Wait()
+		executed = end
+
+		if policy.GetFailureThreshold() <= 0 {
+			continue
+		}
+
+		failures := 0
+		for i := 0; i < executed; i++ {
+			if failed(i) {
+				failures++
+			}
+		}
+		if failures*maxFailureThreshold >= int(policy.GetFailureThreshold())*total {
+			return executed, true
+		}
+	}
+
+	return executed, false
+}
+
+// recordExecution adds the result of executed items to the task's execution, it's called for each priority group of sub tasks.
+func recordExecution(t Task, policy *pb.ExecutionPolicy, total, succeeded, failed, skipped int, thresholdReached bool) {
+	execution := &pb.TaskExecution{
+		TaskName: t.GetName(),
+		TaskType: string(t.GetType()),
+		Policy:   policy,
+	}
+	if previous := t.GetExecution(); previous != nil {
+		*execution = *previous
+	}
+
+	execution.Total += int32(total)
+	execution.Succeeded += int32(succeeded)
+	execution.Failed += int32(failed)
+	execution.Skipped += int32(skipped)
+	execution.FailureThresholdReached = execution.FailureThresholdReached || thresholdReached
+	t.SetExecution(execution)
+}
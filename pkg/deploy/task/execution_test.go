@@ -0,0 +1,223 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Mockup an action which fails if its name has the "fail" prefix
+const ActionTypeTestExecutionMockup action.Type = "ActionTypeMockupForExecutionTest"
+
+type executorMockupForExecutionTest struct{}
+
+func (e *executorMockupForExecutionTest) Execute(act action.Action) *pb.Error {
+	if strings.HasPrefix(act.GetName(), "fail") {
+		return &pb.Error{Reason: "failed for testing"}
+	}
+	return nil
+}
+
+func init() {
+	action.RegisterExecutor(ActionTypeTestExecutionMockup, new(executorMockupForExecutionTest))
+}
+
+func TestValidateExecutionPolicies(t *testing.T) {
+	testCases := []struct {
+		policies map[string]*pb.ExecutionPolicy
+		wantErr  bool
+	}{
+		{
+			policies: nil,
+		},
+		{
+			policies: map[string]*pb.ExecutionPolicy{
+				string(TaskTypeNodeInit):     {Concurrency: 20},
+				string(TaskTypeDeployWorker): {BatchSize: 10, FailureThreshold: 10},
+			},
+		},
+		{
+			policies: map[string]*pb.ExecutionPolicy{"Unknown": {Concurrency: 20}},
+			wantErr:  true,
+		},
+		{
+			policies: map[string]*pb.ExecutionPolicy{string(TaskTypeNodeInit): {Concurrency: -1}},
+			wantErr:  true,
+		},
+		{
+			policies: map[string]*pb.ExecutionPolicy{string(TaskTypeNodeInit): {FailureThreshold: 101}},
+			wantErr:  true,
+		},
+	}
+
+	for _, cs := range testCases {
+		err := ValidateExecutionPolicies(cs.policies)
+		assert.Equal(t, cs.wantErr, err != nil, "policies: %v", cs.policies)
+	}
+}
+
+func TestExecuteWithPolicy(t *testing.T) {
+	testCases := []struct {
+		policy               *pb.ExecutionPolicy
+		failed               map[int]bool
+		wantExecuted         int
+		wantThresholdReached bool
+		wantMaxConcurrency   int32
+	}{
+		{
+			policy:             nil,
+			wantExecuted:       10,
+			wantMaxConcurrency: 10,
+		},
+		{
+			policy:             &pb.ExecutionPolicy{Concurrency: 3},
+			wantExecuted:       10,
+			wantMaxConcurrency: 3,
+		},
+		{
+			policy:             &pb.ExecutionPolicy{Concurrency: 3, BatchSize: 2},
+			wantExecuted:       10,
+			wantMaxConcurrency: 2,
+		},
+		{
+			// the first failure reaches 10%, the batches after it are not executed
+			policy:               &pb.ExecutionPolicy{BatchSize: 4, FailureThreshold: 10},
+			failed:               map[int]bool{5: true},
+			wantExecuted:         8,
+			wantThresholdReached: true,
+			wantMaxConcurrency:   4,
+		},
+		{
+			policy:             &pb.ExecutionPolicy{BatchSize: 4, FailureThreshold: 20},
+			failed:             map[int]bool{5: true},
+			wantExecuted:       10,
+			wantMaxConcurrency: 4,
+		},
+	}
+
+	for _, cs := range testCases {
+		var running, maxRunning int32
+		executed, thresholdReached := executeWithPolicy(10, cs.policy,
+			func(index int, wg *sync.WaitGroup) {
+				defer wg.Done()
+				current := atomic.AddInt32(&running, 1)
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			},
+			func(index int) bool {
+				return cs.failed[index]
+			})
+
+		assert.Equal(t, cs.wantExecuted, executed, "policy: %v", cs.policy)
+		assert.Equal(t, cs.wantThresholdReached, thresholdReached, "policy: %v", cs.policy)
+		assert.Equal(t, cs.wantMaxConcurrency, maxRunning, "policy: %v", cs.policy)
+	}
+}
+
+func TestExecuteActionsWithPolicy(t *testing.T) {
+	err := RegisterProcessor(TaskTypeTestProcessorMockup2, new(processorMockupForProcessorTest2))
+	assert.NoError(t, err)
+
+	names := []string{"succeed1", "fail1", "succeed2", "succeed3", "succeed4"}
+	var actions []action.Action
+	for _, name := range names {
+		actions = append(actions, &actionMockupForProcessorTest{
+			Base: action.Base{
+				Name:       name,
+				ActionType: ActionTypeTestExecutionMockup,
+				Status:     action.ActionPending,
+			},
+		})
+	}
+
+	policy := &pb.ExecutionPolicy{BatchSize: 2, FailureThreshold: 20}
+	tsk := &taskMockupForProcessorTest2{
+		Base: Base{
+			Name:              "task",
+			TaskType:          TaskTypeTestProcessorMockup2,
+			Status:            TaskPending,
+			Actions:           actions,
+			ExecutionPolicies: map[string]*pb.ExecutionPolicy{string(TaskTypeTestProcessorMockup2): policy},
+		},
+	}
+
+	assert.NoError(t, executeActions(tsk))
+	assert.Equal(t, action.ActionDone, actions[0].GetStatus())
+	assert.Equal(t, action.ActionFailed, actions[1].GetStatus())
+	for _, act := range actions[2:] {
+		assert.Equal(t, action.ActionSkipped, act.GetStatus())
+		assert.NotNil(t, act.GetErr())
+	}
+
+	assert.Equal(t, &pb.TaskExecution{
+		TaskName:                "task",
+		TaskType:                string(TaskTypeTestProcessorMockup2),
+		Policy:                  policy,
+		Total:                   5,
+		Succeeded:               1,
+		Failed:                  1,
+		Skipped:                 3,
+		FailureThresholdReached: true,
+	}, tsk.GetExecution())
+	assert.Equal(t, []*pb.TaskExecution{tsk.GetExecution()}, GetAllExecutions(tsk))
+
+	assert.NoError(t, statTask(tsk))
+	assert.Equal(t, TaskFailed, tsk.GetStatus())
+
+	// cleanup
+	_processRegistry = nil
+}
+
+func TestInheritExecutionPolicies(t *testing.T) {
+	err := RegisterProcessor(TaskTypeTestProcessorMockup1, new(processorMockupForProcessorTest1))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup2, new(processorMockupForProcessorTest2))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup3, new(processorMockupForProcessorTest3))
+	assert.NoError(t, err)
+
+	policies := map[string]*pb.ExecutionPolicy{string(TaskTypeTestProcessorMockup2): {Concurrency: 1}}
+	task1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:              "task1",
+			TaskType:          TaskTypeTestProcessorMockup1,
+			Status:            TaskPending,
+			ExecutionPolicies: policies,
+		},
+	}
+
+	assert.NoError(t, PlanTask(task1))
+	for _, subTask := range task1.GetSubTasks() {
+		assert.Equal(t, policies, subTask.GetExecutionPolicies())
+	}
+
+	// cleanup
+	_processRegistry = nil
+}
@@ -23,7 +23,7 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
-	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 )
 
 func init() {
@@ -54,8 +54,13 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 	actions := make([]action.Action, 0, len(checkTask.NodeConfigs))
 	for _, subConfig := range checkTask.NodeConfigs {
 		actionCfg := &action.NodeCheckActionConfig{
-			NodeCheckConfig: subConfig,
-			LogFileBasePath: checkTask.LogFileDir,
+			NodeCheckConfig:      subConfig,
+			KubeAPIServerConnect: checkTask.KubeAPIServerConnect,
+			PodSubnets:           checkTask.PodSubnets,
+			ServiceSubnets:       checkTask.ServiceSubnets,
+			CustomCheckItems:     checkTask.CustomCheckItems,
+			ContainerRuntime:     checkTask.ContainerRuntime,
+			LogFileBasePath:      checkTask.LogFileDir,
 		}
 		act, err := action.NewNodeCheckAction(actionCfg)
 		if err != nil {
@@ -66,6 +71,8 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 
 	if checkTask.NetworkOptions == nil {
 		logger.Debugf("skip checking network requirements since networkOptions is empty")
+	} else if err := setDefaultNetworkOptions(checkTask.NetworkOptions); err != nil {
+		return err
 	} else if len(checkTask.NodeConfigs) > 1 {
 		// split into connectivity check actions
 		numNodes := len(checkTask.NodeConfigs)
@@ -77,16 +84,9 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 				peerIndex = numNodes - 1
 			}
 			// make a connectivity check action for the pair.
-			calicoOptions := checkTask.NetworkOptions.CalicoOptions
-			if calicoOptions == nil {
-				calicoOptions = &protos.CalicoOptions{
-					EncapsulationMode: "vxlan",
-					VxlanPort:         4789,
-				}
-			}
-			act, err := makeConnectivityCheckActionCalico(
+			act, err := makeConnectivityCheckAction(
 				subConfig.Node, checkTask.NodeConfigs[peerIndex].Node,
-				calicoOptions, checkTask.GetLogFileDir())
+				checkTask.NetworkOptions, checkTask.GetLogFileDir())
 			if err != nil {
 				logger.WithField("node", subConfig.Node.Name).
 					WithField("peer-node", checkTask.NodeConfigs[peerIndex].Node.Name).
@@ -102,6 +102,33 @@ func (p *nodeCheckProcessor) SplitTask(t Task) error {
 	return nil
 }
 
+// ProcessExtraResult finds the nodes with duplicated product_uuid or MAC addresses after all nodes are checked,
+// kubernetes uses them to identify nodes.
+func (p *nodeCheckProcessor) ProcessExtraResult(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		return err
+	}
+
+	identities := make(map[string]*check.NodeIdentity)
+	checkActions := make(map[string]*action.NodeCheckAction)
+	for _, act := range t.GetActions() {
+		checkAction, ok := act.(*action.NodeCheckAction)
+		if !ok {
+			continue
+		}
+		nodeName := checkAction.GetNode().GetName()
+		identities[nodeName] = checkAction.GetIdentity()
+		checkActions[nodeName] = checkAction
+	}
+
+	for nodeName, duplicates := range check.FindDuplicateIdentities(identities) {
+		logrus.WithField("node", nodeName).Warnf("duplicate identities: %v", duplicates)
+		checkActions[nodeName].MarkDuplicateIdentity(duplicates)
+	}
+
+	return nil
+}
+
 // Verify if the task is valid.
 func (p *nodeCheckProcessor) verifyTask(t Task) error {
 	if t == nil {
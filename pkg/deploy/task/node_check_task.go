@@ -20,6 +20,8 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -27,16 +29,26 @@ const TaskTypeNodeCheck Type = "NodeCheck"
 
 // NodeCheckTaskConfig represents the config for a node check task.
 type NodeCheckTaskConfig struct {
-	NodeConfigs     []*pb.NodeCheckConfig
-	NetworkOptions  *pb.NetworkOptions
-	LogFileBasePath string
-	Priority        int
+	NodeConfigs          []*pb.NodeCheckConfig
+	NetworkOptions       *pb.NetworkOptions
+	KubeAPIServerConnect *pb.KubeAPIServerConnect
+	PodSubnets           []string
+	ServiceSubnets       []string
+	CustomCheckItems     []*pb.CustomCheckItem
+	ContainerRuntime     string
+	LogFileBasePath      string
+	Priority             int
 }
 
 type NodeCheckTask struct {
 	Base
-	NodeConfigs    []*pb.NodeCheckConfig
-	NetworkOptions *pb.NetworkOptions
+	NodeConfigs          []*pb.NodeCheckConfig
+	NetworkOptions       *pb.NetworkOptions
+	KubeAPIServerConnect *pb.KubeAPIServerConnect
+	PodSubnets           []string
+	ServiceSubnets       []string
+	CustomCheckItems     []*pb.CustomCheckItem
+	ContainerRuntime     string
 }
 
 // NewNodeCheckTask returns a node check task based on the config.
@@ -49,6 +61,24 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 	} else if len(taskConfig.NodeConfigs) == 0 {
 		err = fmt.Errorf("invalid task config: node configs is empty")
 
+	} else if err = deploy.ValidateContainerRuntime(taskConfig.ContainerRuntime); err != nil {
+		err = fmt.Errorf("invalid task config: %v", err)
+
+	} else {
+		for _, item := range taskConfig.CustomCheckItems {
+			if err = check.ValidateCustomCheckItem(item); err != nil {
+				err = fmt.Errorf("invalid task config: %v", err)
+				break
+			}
+		}
+		for _, nodeConfig := range taskConfig.NodeConfigs {
+			if err != nil {
+				break
+			}
+			if err = check.ValidateNodeCheckThresholds(nodeConfig.GetThresholds()); err != nil {
+				err = fmt.Errorf("invalid task config: node %v: %v", nodeConfig.GetNode().GetName(), err)
+			}
+		}
 	}
 
 	if err != nil {
@@ -65,8 +95,13 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 			CreationTimestamp: time.Now(),
 			Priority:          taskConfig.Priority,
 		},
-		NodeConfigs:    taskConfig.NodeConfigs,
-		NetworkOptions: taskConfig.NetworkOptions,
+		NodeConfigs:          taskConfig.NodeConfigs,
+		NetworkOptions:       taskConfig.NetworkOptions,
+		KubeAPIServerConnect: taskConfig.KubeAPIServerConnect,
+		PodSubnets:           taskConfig.PodSubnets,
+		ServiceSubnets:       taskConfig.ServiceSubnets,
+		CustomCheckItems:     taskConfig.CustomCheckItems,
+		ContainerRuntime:     taskConfig.ContainerRuntime,
 	}
 
 	return task, nil
@@ -0,0 +1,135 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestNodeCheckTaskCustomCheckItems(t *testing.T) {
+	nodeConfigs := []*pb.NodeCheckConfig{
+		{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master"}},
+	}
+	items := []*pb.CustomCheckItem{{Name: "auditd", Script: "systemctl is-active auditd", ExpectedOutput: "^active$"}}
+
+	checkTask, err := NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs:      nodeConfigs,
+		CustomCheckItems: items,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, new(nodeCheckProcessor).SplitTask(checkTask))
+	for _, act := range checkTask.GetActions() {
+		if checkAction, ok := act.(*action.NodeCheckAction); ok {
+			assert.Equal(t, items, checkAction.CustomCheckItems)
+		}
+	}
+
+	_, err = NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs:      nodeConfigs,
+		CustomCheckItems: []*pb.CustomCheckItem{{Name: "auditd"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestNodeCheckTaskThresholds(t *testing.T) {
+	_, err := NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs: []*pb.NodeCheckConfig{{
+			Node:       &pb.Node{Name: "worker1", Ip: "192.168.1.2"},
+			Roles:      []string{"worker"},
+			Thresholds: map[string]*pb.NodeCheckThresholds{"worker": {Minimum: &pb.CheckThreshold{CpuCore: 2}}},
+		}},
+	})
+	assert.NoError(t, err)
+
+	_, err = NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs: []*pb.NodeCheckConfig{{
+			Node:       &pb.Node{Name: "worker1", Ip: "192.168.1.2"},
+			Roles:      []string{"worker"},
+			Thresholds: map[string]*pb.NodeCheckThresholds{"worker": {Minimum: &pb.CheckThreshold{DockerVersion: "latest"}}},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+func TestNodeCheckTaskContainerRuntime(t *testing.T) {
+	nodeConfigs := []*pb.NodeCheckConfig{{
+		Node:  &pb.Node{Name: "worker1", Ip: "192.168.1.2"},
+		Roles: []string{"worker"},
+	}}
+
+	for _, containerRuntime := range []string{"", "docker", "containerd"} {
+		_, err := NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+			NodeConfigs:      nodeConfigs,
+			ContainerRuntime: containerRuntime,
+		})
+		assert.NoError(t, err, containerRuntime)
+	}
+
+	_, err := NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs:      nodeConfigs,
+		ContainerRuntime: "rkt",
+	})
+	assert.Error(t, err)
+}
+
+func TestNodeCheckProcessExtraResult(t *testing.T) {
+	machine.IsTesting = true
+	defer func() {
+		machine.IsTesting = false
+	}()
+
+	newCheckAction := func(name, ip string) action.Action {
+		act, err := action.NewNodeCheckAction(&action.NodeCheckActionConfig{
+			NodeCheckConfig: &pb.NodeCheckConfig{
+				Node:  &pb.Node{Name: name, Ip: ip},
+				Roles: []string{"worker"},
+			},
+		})
+		assert.NoError(t, err)
+		return act
+	}
+
+	// identities of mock machine are derived from its ip
+	actions := []action.Action{
+		newCheckAction("worker1", "192.168.1.1"),
+		newCheckAction("worker2", "192.168.1.2"),
+		newCheckAction("worker3", "192.168.1.2"),
+	}
+	var wg sync.WaitGroup
+	for _, act := range actions {
+		wg.Add(1)
+		action.ExecuteAction(act, &wg)
+	}
+	wg.Wait()
+
+	checkTask, err := NewNodeCheckTask("check-nodes", &NodeCheckTaskConfig{
+		NodeConfigs: []*pb.NodeCheckConfig{actions[0].(*action.NodeCheckAction).NodeCheckConfig},
+	})
+	assert.NoError(t, err)
+	checkTask.(*NodeCheckTask).Actions = actions
+
+	assert.NoError(t, new(nodeCheckProcessor).ProcessExtraResult(checkTask))
+	assert.Equal(t, action.ActionDone, actions[0].GetStatus())
+	assert.Equal(t, action.ActionFailed, actions[1].GetStatus())
+	assert.Equal(t, action.ActionFailed, actions[2].GetStatus())
+	assert.Contains(t, actions[1].GetErr().GetDetail(), "check duplicate-identity")
+}
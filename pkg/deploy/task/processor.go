@@ -149,6 +149,7 @@ func ExecuteTask(t Task) error {
 		logger.Errorf("Failed in Step 2: %v", err)
 		return err
 	}
+	inheritExecutionPolicies(t)
 
 	t.SetStatus(TaskDoing)
 	logger.Debug("Step 3: Execute Sub Tasks")
@@ -173,6 +174,27 @@ func ExecuteTask(t Task) error {
 	return nil
 }
 
+// PlanTask splits the task and its sub tasks recursively without executing them,
+// nothing is done on nodes and no log file is created.
+func PlanTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	if err := splitTask(t); err != nil {
+		return err
+	}
+
+	inheritExecutionPolicies(t)
+	for _, subTask := range t.GetSubTasks() {
+		if err := PlanTask(subTask); err != nil {
+			return fmt.Errorf("[%s] %v", subTask.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
 func executeTaskWithWG(t Task, wg *sync.WaitGroup) error {
 	defer wg.Done()
 
@@ -234,17 +256,45 @@ func executeSubTasks(t Task) error {
 
 	logger.Debug("Start to execute sub tasks")
 
+	policy := getExecutionPolicy(t)
+
 	// Group the sub tasks by priority firstly.
 	priTasks := prioritizeTasks(t.GetSubTasks())
 	// Execute the task group sequentially.
 	for _, taskGp := range priTasks {
-		var wg sync.WaitGroup
-		// Execute the tasks in the same group parallelly.
-		for _, aSubTask := range taskGp {
-			wg.Add(1)
-			go executeTaskWithWG(aSubTask, &wg)
+		// Execute the tasks in the same group parallelly, they're executed batch by batch if the task has an execution policy.
+		executed, thresholdReached := executeWithPolicy(len(taskGp), policy,
+			func(index int, wg *sync.WaitGroup) {
+				executeTaskWithWG(taskGp[index], wg)
+			},
+			func(index int) bool {
+				return taskGp[index].GetStatus() == TaskFailed
+			})
+
+		for _, aSubTask := range taskGp[executed:] {
+			aSubTask.SetStatus(TaskSkipped)
+			aSubTask.SetErr(&pb.Error{
+				Reason: "task was skipped",
+				Detail: fmt.Sprintf("failure threshold %v%% of task %s was reached", policy.GetFailureThreshold(), t.GetName()),
+			})
+		}
+
+		if policy != nil {
+			succeeded, failed := 0, 0
+			for _, aSubTask := range taskGp[:executed] {
+				switch aSubTask.GetStatus() {
+				case TaskSuccessful:
+					succeeded++
+				case TaskFailed:
+					failed++
+				}
+			}
+			recordExecution(t, policy, len(taskGp), succeeded, failed, len(taskGp)-executed, thresholdReached)
+		}
+
+		if thresholdReached {
+			return fmt.Errorf("failure threshold %v%% was reached", policy.GetFailureThreshold())
 		}
-		wg.Wait()
 
 		// If any sub task in the current task group was failed and its failure can't be ignored,
 		// stop to execut other task groups and return.
@@ -276,13 +326,38 @@ func executeActions(t Task) error {
 
 	logger.Debug("Start to execute actions")
 
-	var wg sync.WaitGroup
-	// execute the actions parallelly
-	for _, act := range t.GetActions() {
-		wg.Add(1)
-		go action.ExecuteAction(act, &wg)
+	policy := getExecutionPolicy(t)
+	actions := t.GetActions()
+
+	// execute the actions parallelly, they're executed batch by batch if the task has an execution policy
+	executed, thresholdReached := executeWithPolicy(len(actions), policy,
+		func(index int, wg *sync.WaitGroup) {
+			action.ExecuteAction(actions[index], wg)
+		},
+		func(index int) bool {
+			return actions[index].GetStatus() == action.ActionFailed
+		})
+
+	for _, act := range actions[executed:] {
+		act.SetStatus(action.ActionSkipped)
+		act.SetErr(&pb.Error{
+			Reason: "action was skipped",
+			Detail: fmt.Sprintf("failure threshold %v%% of task %s was reached", policy.GetFailureThreshold(), t.GetName()),
+		})
+	}
+
+	if policy != nil {
+		succeeded, failed := 0, 0
+		for _, act := range actions[:executed] {
+			switch act.GetStatus() {
+			case action.ActionDone:
+				succeeded++
+			case action.ActionFailed:
+				failed++
+			}
+		}
+		recordExecution(t, policy, len(actions), succeeded, failed, len(actions)-executed, thresholdReached)
 	}
-	wg.Wait()
 
 	logger.Debug("Finish to execute actions")
 	return nil
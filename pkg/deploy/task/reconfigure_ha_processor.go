@@ -0,0 +1,89 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeReconfigureHA, new(reconfigureHAProcessor))
+}
+
+// reconfigureHAProcessor implements the specific logic for the reconfigure-ha task.
+type reconfigureHAProcessor struct {
+}
+
+// Spilt the task into one reconfigure-ha action per master node
+func (p *reconfigureHAProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	haTask := t.(*ReconfigureHATask)
+
+	var actions []action.Action
+	for _, nodeConfig := range haTask.NodeConfigs {
+		if !sets.NewString(nodeConfig.GetRoles()...).Has(string(constant.MachineRoleMaster)) {
+			continue
+		}
+		act, err := action.NewReconfigureHAAction(&action.ReconfigureHAActionConfig{
+			NodeConfig:      nodeConfig,
+			NodesConfig:     haTask.NodeConfigs,
+			ClusterConfig:   haTask.ClusterConfig,
+			LogFileBasePath: haTask.LogFileDir,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	haTask.Actions = actions
+
+	logger.Debugf("Finish to split task: %d actions", len(actions))
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *reconfigureHAProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	haTask, ok := t.(*ReconfigureHATask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if connectType := haTask.ClusterConfig.GetKubeAPIServerConnect().GetType(); connectType != "keepalived" {
+		return fmt.Errorf("only keepalived connect type could be reconfigured, got: %q", connectType)
+	}
+
+	return nil
+}
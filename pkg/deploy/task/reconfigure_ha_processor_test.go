@@ -0,0 +1,51 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestReconfigureHASplitTask(t *testing.T) {
+	taskCfg := &ReconfigureHATaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "master2", Ip: "192.168.1.2"}, Roles: []string{"master"}},
+			{Node: &pb.Node{Name: "worker1", Ip: "192.168.1.3"}, Roles: []string{"worker"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "keepalived"},
+		},
+	}
+
+	haTask, err := NewReconfigureHATask("test-task", taskCfg)
+	assert.NoError(t, err)
+
+	processor := new(reconfigureHAProcessor)
+	err = processor.SplitTask(haTask)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(haTask.GetActions()))
+
+	taskCfg.ClusterConfig = &pb.ClusterConfig{
+		KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+	}
+	haTask, err = NewReconfigureHATask("test-task", taskCfg)
+	assert.NoError(t, err)
+	assert.Error(t, processor.SplitTask(haTask))
+}
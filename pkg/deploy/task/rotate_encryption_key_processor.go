@@ -0,0 +1,90 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/master"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeRotateEncryptionKey, new(rotateEncryptionKeyProcessor))
+}
+
+// rotateEncryptionKeyProcessor implements the specific logic for the rotate-encryption-key task.
+type rotateEncryptionKeyProcessor struct {
+}
+
+// Spilt the task into one rotate-encryption-key action of all master nodes, as masters are updated in turn
+func (p *rotateEncryptionKeyProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	rotateTask := t.(*RotateEncryptionKeyTask)
+
+	var masterNodes []*pb.Node
+	for _, nodeConfig := range rotateTask.NodeConfigs {
+		if sets.NewString(nodeConfig.GetRoles()...).Has(string(constant.MachineRoleMaster)) {
+			masterNodes = append(masterNodes, nodeConfig.GetNode())
+		}
+	}
+
+	act, err := action.NewRotateEncryptionKeyAction(&action.RotateEncryptionKeyActionConfig{
+		MasterNodes:     masterNodes,
+		ClusterConfig:   rotateTask.ClusterConfig,
+		LogFileBasePath: rotateTask.LogFileDir,
+	})
+	if err != nil {
+		return err
+	}
+	rotateTask.Actions = []action.Action{act}
+
+	logger.Debugf("Finish to split task: %d actions", len(rotateTask.Actions))
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *rotateEncryptionKeyProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	rotateTask, ok := t.(*RotateEncryptionKeyTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if !master.IsEncryptionEnabled(rotateTask.ClusterConfig) {
+		return fmt.Errorf("encryption at rest is not enabled")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeRotateEncryptionKey Type = "RotateEncryptionKey"
+
+// RotateEncryptionKeyTaskConfig represents the config for a rotate-encryption-key task.
+type RotateEncryptionKeyTaskConfig struct {
+	NodeConfigs     []*pb.NodeDeployConfig
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+	Priority        int
+}
+
+// RotateEncryptionKeyTask replaces the key which encrypts secrets at rest on all masters.
+type RotateEncryptionKeyTask struct {
+	Base
+
+	NodeConfigs   []*pb.NodeDeployConfig
+	ClusterConfig *pb.ClusterConfig
+}
+
+// NewRotateEncryptionKeyTask returns a rotate-encryption-key task based on the config.
+// User should use this function to create a rotate-encryption-key task.
+func NewRotateEncryptionKeyTask(taskName string, taskConfig *RotateEncryptionKeyTaskConfig) (Task, error) {
+	var err error
+	if taskName == "" {
+		err = fmt.Errorf("taskName can't be empty")
+	} else if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: nodeConfigs is empty")
+	} else if taskConfig.ClusterConfig == nil {
+		err = fmt.Errorf("invalid task config: cluster config is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &RotateEncryptionKeyTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeRotateEncryptionKey,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+		},
+		NodeConfigs:   taskConfig.NodeConfigs,
+		ClusterConfig: taskConfig.ClusterConfig,
+	}
+
+	return task, nil
+}
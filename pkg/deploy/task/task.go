@@ -45,6 +45,12 @@ type Task interface {
 	// the task's failure will not affect other task's execution.
 	GetFailureCanBeIgnored() bool
 	SetFailureCanBeIgnored(bool)
+	// ExecutionPolicies are keyed by task type, they are passed to sub tasks when the task is split.
+	GetExecutionPolicies() map[string]*pb.ExecutionPolicy
+	SetExecutionPolicies(map[string]*pb.ExecutionPolicy)
+	// Execution is set if the task's actions or sub tasks are executed with an execution policy.
+	GetExecution() *pb.TaskExecution
+	SetExecution(*pb.TaskExecution)
 }
 
 // Type represents the type of a task
@@ -60,6 +66,8 @@ const (
 	TaskDoing        Status = "doing"
 	TaskSuccessful   Status = "successful"
 	TaskFailed       Status = "failed"
+	// TaskSkipped means the task is not executed since the failure threshold of its parent was reached
+	TaskSkipped Status = "skipped"
 )
 
 type Base struct {
@@ -74,6 +82,8 @@ type Base struct {
 	Priority            int
 	Parent              string
 	FailureCanBeIgnored bool
+	ExecutionPolicies   map[string]*pb.ExecutionPolicy
+	Execution           *pb.TaskExecution
 }
 
 func (b *Base) GetName() string {
@@ -136,6 +146,22 @@ func (b *Base) SetFailureCanBeIgnored(val bool) {
 	b.FailureCanBeIgnored = val
 }
 
+func (b *Base) GetExecutionPolicies() map[string]*pb.ExecutionPolicy {
+	return b.ExecutionPolicies
+}
+
+func (b *Base) SetExecutionPolicies(policies map[string]*pb.ExecutionPolicy) {
+	b.ExecutionPolicies = policies
+}
+
+func (b *Base) GetExecution() *pb.TaskExecution {
+	return b.Execution
+}
+
+func (b *Base) SetExecution(execution *pb.TaskExecution) {
+	b.Execution = execution
+}
+
 // GenTaskLogFileDir is a helper to return the log file dir based on base path and task name
 func GenTaskLogFileDir(basePath, taskName string) string {
 	if basePath == "" || taskName == "" {
@@ -158,6 +184,20 @@ func GetAllActions(aTask Task) []action.Action {
 	return actions
 }
 
+// GetAllExecutions returns the executions of a task and its sub tasks recursively,
+// tasks executed without an execution policy are left out.
+func GetAllExecutions(aTask Task) []*pb.TaskExecution {
+	var executions []*pb.TaskExecution
+	if execution := aTask.GetExecution(); execution != nil {
+		executions = append(executions, execution)
+	}
+
+	for _, subTask := range aTask.GetSubTasks() {
+		executions = append(executions, GetAllExecutions(subTask)...)
+	}
+	return executions
+}
+
 type BaseTaskConfig struct {
 	LogFileBasePath string
 	Priority        int
@@ -0,0 +1,99 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeUpdateNodeConfig, new(updateNodeConfigProcessor))
+}
+
+// updateNodeConfigProcessor implements the specific logic for the update-node-config task.
+type updateNodeConfigProcessor struct {
+}
+
+// Spilt the task into one update-node-config action per node
+func (p *updateNodeConfigProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	updateTask := t.(*UpdateNodeConfigTask)
+	masterNodes := getMasterNodes(updateTask.NodeConfigs)
+
+	actions := make([]action.Action, 0, len(updateTask.NodeConfigs))
+	for _, nodeConfig := range updateTask.NodeConfigs {
+		act, err := action.NewUpdateNodeConfigAction(&action.UpdateNodeConfigActionConfig{
+			NodeConfig:      nodeConfig,
+			MasterNodes:     masterNodes,
+			ClusterConfig:   updateTask.ClusterConfig,
+			DryRun:          updateTask.DryRun,
+			LogFileBasePath: updateTask.LogFileDir,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	updateTask.Actions = actions
+
+	logger.Debugf("Finish to split task: %d actions", len(actions))
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *updateNodeConfigProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	updateTask, ok := t.(*UpdateNodeConfigTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(getMasterNodes(updateTask.NodeConfigs)) == 0 {
+		return fmt.Errorf("no master node found to reach the cluster")
+	}
+
+	return nil
+}
+
+func getMasterNodes(nodeConfigs []*pb.NodeDeployConfig) []*pb.Node {
+	var masterNodes []*pb.Node
+	for _, nodeConfig := range nodeConfigs {
+		if sets.NewString(nodeConfig.GetRoles()...).Has(string(constant.MachineRoleMaster)) {
+			masterNodes = append(masterNodes, nodeConfig.GetNode())
+		}
+	}
+	return masterNodes
+}
@@ -0,0 +1,54 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestUpdateNodeConfigSplitTask(t *testing.T) {
+	taskCfg := &UpdateNodeConfigTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: &pb.Node{Name: "master1", Ip: "192.168.1.1"}, Roles: []string{"master", "etcd"}},
+			{Node: &pb.Node{Name: "worker1", Ip: "192.168.1.2"}, Roles: []string{"worker"}},
+		},
+		ClusterConfig: &pb.ClusterConfig{},
+		DryRun:        true,
+	}
+
+	updateTask, err := NewUpdateNodeConfigTask("test-task", taskCfg)
+	assert.NoError(t, err)
+
+	processor := new(updateNodeConfigProcessor)
+	err = processor.SplitTask(updateTask)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(updateTask.GetActions()))
+	for _, act := range updateTask.GetActions() {
+		updateAction := act.(*action.UpdateNodeConfigAction)
+		assert.True(t, updateAction.DryRun)
+		assert.Equal(t, "master1", updateAction.MasterNodes[0].GetName())
+	}
+
+	// no master node
+	taskCfg.NodeConfigs = taskCfg.NodeConfigs[1:]
+	updateTask, err = NewUpdateNodeConfigTask("test-task", taskCfg)
+	assert.NoError(t, err)
+	assert.Error(t, processor.SplitTask(updateTask))
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeUpdateNodeConfig Type = "UpdateNodeConfig"
+
+// UpdateNodeConfigTaskConfig represents the config for a update-node-config task.
+type UpdateNodeConfigTaskConfig struct {
+	NodeConfigs     []*pb.NodeDeployConfig
+	ClusterConfig   *pb.ClusterConfig
+	DryRun          bool
+	LogFileBasePath string
+	Priority        int
+}
+
+// UpdateNodeConfigTask updates labels, annotations and taints of live nodes in a deployed cluster.
+type UpdateNodeConfigTask struct {
+	Base
+
+	NodeConfigs   []*pb.NodeDeployConfig
+	ClusterConfig *pb.ClusterConfig
+	DryRun        bool
+}
+
+// NewUpdateNodeConfigTask returns a update-node-config task based on the config.
+// User should use this function to create a update-node-config task.
+func NewUpdateNodeConfigTask(taskName string, taskConfig *UpdateNodeConfigTaskConfig) (Task, error) {
+	var err error
+	if taskName == "" {
+		err = fmt.Errorf("taskName can't be empty")
+	} else if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: nodeConfigs is empty")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &UpdateNodeConfigTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeUpdateNodeConfig,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+		},
+		NodeConfigs:   taskConfig.NodeConfigs,
+		ClusterConfig: taskConfig.ClusterConfig,
+		DryRun:        taskConfig.DryRun,
+	}
+
+	return task, nil
+}
+
+// GetDiffs returns the diff of each node after the task is executed
+func (t *UpdateNodeConfigTask) GetDiffs() []*pb.NodeConfigDiff {
+	diffs := make([]*pb.NodeConfigDiff, 0, len(t.Actions))
+	for _, act := range t.Actions {
+		if diff := act.(*action.UpdateNodeConfigAction).Diff; diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeVerifyCluster, new(verifyClusterProcessor))
+}
+
+// verifyClusterProcessor implements the specific logic for the verify cluster task.
+type verifyClusterProcessor struct {
+}
+
+// Spilt the task into one verify cluster action on the first master.
+func (p *verifyClusterProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split verify cluster task")
+
+	verifyTask := t.(*VerifyClusterTask)
+
+	// etcd only nodes are not kubernetes nodes
+	var nodes, ingressNodes []*pb.Node
+	for _, nodeConfig := range verifyTask.NodeConfigs {
+		isKubeNode := false
+		for _, role := range nodeConfig.GetRoles() {
+			switch constant.MachineRole(role) {
+			case constant.MachineRoleMaster, constant.MachineRoleWorker:
+				isKubeNode = true
+			case constant.MachineRoleIngress:
+				isKubeNode = true
+				ingressNodes = append(ingressNodes, nodeConfig.GetNode())
+			}
+		}
+		if isKubeNode {
+			nodes = append(nodes, nodeConfig.GetNode())
+		}
+	}
+
+	act, err := action.NewVerifyClusterAction(&action.VerifyClusterActionConfig{
+		MasterNode:      verifyTask.MasterNodes[0],
+		Nodes:           nodes,
+		IngressNodes:    ingressNodes,
+		ClusterConfig:   verifyTask.ClusterConfig,
+		LogFileBasePath: verifyTask.LogFileDir,
+	})
+	if err != nil {
+		return err
+	}
+	verifyTask.Actions = []action.Action{act}
+
+	logger.Debug("Finish to split verify cluster task")
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *verifyClusterProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	verifyTask, ok := t.(*VerifyClusterTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(verifyTask.MasterNodes) == 0 {
+		return fmt.Errorf("master nodes is empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestVerifyClusterSplitTask(t *testing.T) {
+	master := &pb.Node{Name: "master1", Ip: "192.168.1.1"}
+	worker := &pb.Node{Name: "worker1", Ip: "192.168.1.2"}
+	ingress := &pb.Node{Name: "ingress1", Ip: "192.168.1.3"}
+	etcd := &pb.Node{Name: "etcd1", Ip: "192.168.1.4"}
+	verifyTask, err := NewVerifyClusterTask("verify-cluster", &VerifyClusterTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{Node: master, Roles: []string{"master", "etcd"}},
+			{Node: worker, Roles: []string{"worker"}},
+			{Node: ingress, Roles: []string{"worker", "ingress"}},
+			{Node: etcd, Roles: []string{"etcd"}},
+		},
+		MasterNodes:   []*pb.Node{master},
+		ClusterConfig: &pb.ClusterConfig{},
+	})
+	assert.NoError(t, err)
+	assert.True(t, verifyTask.GetFailureCanBeIgnored())
+
+	processor := new(verifyClusterProcessor)
+	assert.NoError(t, processor.SplitTask(verifyTask))
+
+	actions := verifyTask.GetActions()
+	assert.Len(t, actions, 1)
+	verifyAction := actions[0].(*action.VerifyClusterAction)
+	assert.Equal(t, master, verifyAction.Node)
+	assert.Equal(t, []*pb.Node{master, worker, ingress}, verifyAction.Nodes)
+	assert.Equal(t, []*pb.Node{ingress}, verifyAction.IngressNodes)
+
+	_, err = NewVerifyClusterTask("verify-cluster", &VerifyClusterTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: master}},
+	})
+	assert.Error(t, err)
+}
@@ -32,8 +32,11 @@ type ExecuteLogItem struct {
 	Stderr      []byte
 	Err         error // other error messages
 	Description string
+	Secrets     []string // secrets redacted from the log, such as passwords in the command
 }
 
+const redactedSecret = "******"
+
 // WriteExecuteLog write an item into writer.
 func WriteExecuteLog(w io.Writer, item *ExecuteLogItem) {
 	if w == nil || item == nil {
@@ -72,5 +75,15 @@ func WriteExecuteLog(w io.Writer, item *ExecuteLogItem) {
 	buf.Write([]byte(endTimeMsg))
 	// write an extra empty line
 	buf.Write([]byte("\n"))
-	w.Write(buf.Bytes())
+	w.Write(redactSecrets(buf.Bytes(), item.Secrets))
+}
+
+// redactSecrets replaces the secrets in content with asterisks
+func redactSecrets(content []byte, secrets []string) []byte {
+	for _, secret := range secrets {
+		if secret != "" {
+			content = bytes.ReplaceAll(content, []byte(secret), []byte(redactedSecret))
+		}
+	}
+	return content
 }
@@ -76,6 +76,30 @@ exit code 1
 `,
 		},
 	}
+	tests = append(tests, struct {
+		input *ExecuteLogItem
+		want  string
+	}{
+		input: &ExecuteLogItem{
+			StartTime: time.Date(2019, 12, 19, 19, 0, 0, 0, time.Local),
+			EndTime:   time.Date(2019, 12, 19, 19, 0, 1, 0, time.Local),
+			Command:   "login --password s3cret",
+			Stdout:    []byte("logged in with s3cret\n"),
+			Stderr:    []byte{},
+			Secrets:   []string{"s3cret", ""},
+		},
+		want: consts.DashLine + `
+[start time] 2019-12-19 19:00:00
+[command] login --password ******
+[stderr]
+
+[stdout]
+logged in with ******
+
+[end time] 2019-12-19 19:00:01
+
+`,
+	})
 	for _, testCase := range tests {
 		buf := &bytes.Buffer{}
 		WriteExecuteLog(buf, testCase.input)
@@ -17,6 +17,7 @@ package deploy
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
@@ -430,3 +431,170 @@ func TestSplitInputRoles(t *testing.T) {
 		assert.Equal(t, test.Want, splitInputRoles(test.Input))
 	}
 }
+
+func TestUploadBatchNodesCSV(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+
+	input := `
+name,description,roles,ip,port,username,authorizationType,password,privateKeyName,dockerRootDirectory,labels,taints
+k8s-master1,first master,master;etcd,192.168.3.223,22,root,password,111111,,/mnt/docker,kpaas.io/role=master,dedicated=master:NoSchedule
+# comment line
+k8s-worker1,,worker,192.168.3.226,,,,,worker_key,,zone=a;rack=1,
+`
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/batchnodes?format=csv", strings.NewReader(input))
+
+	UploadBatchNodes(ctx)
+	assert.Equal(t, http.StatusCreated, resp.Code)
+
+	wizardData := wizard.GetCurrentWizard()
+	assert.Len(t, wizardData.Nodes, 2)
+
+	master := wizardData.GetNode("192.168.3.223")
+	assert.Equal(t, "k8s-master1", master.Name)
+	assert.Equal(t, "first master", master.Description)
+	assert.Equal(t, []constant.MachineRole{constant.MachineRoleMaster, constant.MachineRoleEtcd}, master.MachineRoles)
+	assert.Equal(t, "111111", master.Password)
+	assert.Equal(t, "/mnt/docker", master.DockerRootDirectory)
+	assert.Equal(t, []*wizard.Label{{Key: "kpaas.io/role", Value: "master"}}, master.Labels)
+	assert.Equal(t, []*wizard.Taint{{Key: "dedicated", Value: "master", Effect: wizard.TaintEffectNoSchedule}}, master.Taints)
+
+	worker := wizardData.GetNode("192.168.3.226")
+	assert.Equal(t, uint16(22), worker.Port)
+	assert.Equal(t, wizard.DefaultUsername, worker.Username)
+	assert.Equal(t, wizard.AuthenticationTypePrivateKey, worker.AuthenticationType)
+	assert.Equal(t, "worker_key", worker.PrivateKeyName)
+	assert.Equal(t, wizard.DefaultDockerRootDirectory, worker.DockerRootDirectory)
+	assert.Equal(t, []*wizard.Label{{Key: "zone", Value: "a"}, {Key: "rack", Value: "1"}}, worker.Labels)
+}
+
+func TestParseCSVBatchNodesMultiLineCell(t *testing.T) {
+
+	input := `name,description,ip,password
+
+# comment line
+k8s-master1,"first master
+in rack 1",192.168.3.223,111111
+k8s-master2,"bad "quote",192.168.3.224,111111
+k8s-master3,"second ""master""",192.168.3.225,111111
+k8s-master4,,192.168.3.226,"222222"`
+
+	entries, err := parseCSVBatchNodes([]byte(input))
+	assert.Nil(t, err)
+	assert.Len(t, entries, 4)
+
+	assert.Equal(t, 4, entries[0].line)
+	assert.Nil(t, entries[0].err)
+	assert.Equal(t, "first master\nin rack 1", entries[0].node.Description)
+	assert.Equal(t, "192.168.3.223", entries[0].node.IP)
+
+	assert.Equal(t, 6, entries[1].line)
+	assert.NotNil(t, entries[1].err)
+
+	assert.Equal(t, 7, entries[2].line)
+	assert.Equal(t, `second "master"`, entries[2].node.Description)
+
+	assert.Equal(t, 8, entries[3].line)
+	assert.Equal(t, "222222", entries[3].node.Password)
+}
+
+func TestUploadBatchNodesYAMLUpsert(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	existNode := wizard.NewNode()
+	existNode.Name = "k8s-master1"
+	existNode.IP = "192.168.3.223"
+	existNode.Password = "111111"
+	wizardData.Nodes = []*wizard.Node{existNode}
+
+	input := `
+nodes:
+- name: k8s-master-renamed
+  ip: 192.168.3.223
+  username: root
+  authorizationType: password
+  roles: [master]
+  taints:
+  - key: dedicated
+    value: master
+    effect: NoExecute
+- name: k8s-master1
+  ip: 192.168.3.224
+  username: root
+  authorizationType: password
+  password: "222222"
+  roles: [master, etcd]
+`
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/batchnodes?format=yaml&mode=upsert", strings.NewReader(input))
+
+	UploadBatchNodes(ctx)
+	assert.Equal(t, http.StatusCreated, resp.Code)
+
+	assert.Len(t, wizardData.Nodes, 2)
+	assert.Equal(t, "k8s-master-renamed", existNode.Name)
+	assert.Equal(t, "111111", existNode.Password)
+	assert.Equal(t, []*wizard.Taint{{Key: "dedicated", Value: "master", Effect: wizard.TaintEffectNoExecute}}, existNode.Taints)
+	assert.Equal(t, "k8s-master1", wizardData.GetNode("192.168.3.224").Name)
+}
+
+func TestUploadBatchNodesDryRun(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	existNode := wizard.NewNode()
+	existNode.Name = "k8s-exist"
+	existNode.IP = "192.168.3.200"
+	wizardData.Nodes = []*wizard.Node{existNode}
+
+	input := `name,roles,ip,password
+k8s-master1,master,192.168.3.223,111111
+k8s-master2,master,192.168.3.223,111111
+k8s-master3,unknown,192.168.3.225,111111
+k8s-master4,master,192.168.3.200,111111
+k8s-master5,master,192.168.3.227,111111`
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/batchnodes?format=csv&dryRun=true", strings.NewReader(input))
+
+	UploadBatchNodes(ctx)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	responseData := new(api.UploadBatchNodesDryRunResponse)
+	err := json.Unmarshal(resp.Body.Bytes(), responseData)
+	assert.Nil(t, err)
+
+	assert.False(t, responseData.Passed)
+	assert.Len(t, responseData.Nodes, 5)
+	assert.Equal(t, "", responseData.Nodes[0].Password)
+	assert.Equal(t, []api.BatchNodesError{
+		{Line: 3, Name: "k8s-master2", IP: "192.168.3.223", Error: "node ip 192.168.3.223 was duplicated"},
+		{Line: 4, Name: "k8s-master3", IP: "192.168.3.225", Error: responseData.Errors[1].Error},
+		{Line: 5, Name: "k8s-master4", IP: "192.168.3.200", Error: "node ip 192.168.3.200 was exist"},
+	}, responseData.Errors)
+
+	// nothing was stored in dry run mode
+	assert.Len(t, wizardData.Nodes, 1)
+}
+
+func TestUploadBatchNodesWrongOptions(t *testing.T) {
+
+	for _, query := range []string{"format=xml", "mode=replace", "dryRun=maybe"} {
+
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/batchnodes?"+query, strings.NewReader(""))
+
+		UploadBatchNodes(ctx)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+	}
+}
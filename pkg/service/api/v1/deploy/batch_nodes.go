@@ -15,63 +15,88 @@
 package deploy
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 // @ID UploadBatchNodes
 // @Summary Upload batch nodes configuration
-// @Description Upload batch nodes configuration file to node list
+// @Description Upload batch nodes configuration file to node list, supports text, csv and yaml format.
+// @Description The first line of csv content must be header, columns: name,description,roles,ip,port,username,authorizationType,password,privateKeyName,dockerRootDirectory,labels,taints.
+// @Description Multiple values in a csv cell are separated by ";", labels like "key=value;key2=value2", taints like "key=value:NoSchedule".
+// @Description The yaml content is a node list under "nodes" field, node fields are the same as node api.
 // @Tags nodes
 // @Accept text/plain
+// @Accept text/csv
+// @Accept application/x-yaml
 // @Produce application/json
 // @Param nodes body string true "node list"
+// @Param format query string false "content format" Enums(text, csv, yaml) default(text)
+// @Param mode query string false "add: fail if the node exists, upsert: update the existing node matched by ip" Enums(add, upsert) default(add)
+// @Param dryRun query bool false "only validate the nodes, return errors of each line" default(false)
 // @Success 201 {object} api.GetNodeListResponse
+// @Success 200 {object} api.UploadBatchNodesDryRunResponse
+// @Failure 400 {object} h.AppErr
+// @Failure 409 {object} h.AppErr
 // @Router /api/v1/deploy/wizard/batchnodes [post]
 func UploadBatchNodes(c *gin.Context) {
 
-	requestData, err := getUploadBatchNodesRequestData(c)
+	format, mode, dryRun, err := getUploadBatchNodesOptions(c)
+	if err != nil {
+		h.E(c, err)
+		return
+	}
+
+	entries, err := getUploadBatchNodesRequestData(c, format)
 	if err != nil {
 		h.E(c, h.EParamsError.WithPayload(err.Error()))
 		log.ReqEntry(c).Infof("parameter error: %v, %T", err, err)
 		return
 	}
 
-	nodeList := make([]*wizard.Node, 0, len(requestData))
-	for _, data := range requestData {
+	wizardData := wizard.GetCurrentWizard()
+	validateBatchNodes(entries, mode, wizardData)
+
+	if dryRun {
+		c.JSON(http.StatusOK, getUploadBatchNodesDryRunResponse(entries))
+		return
+	}
 
-		node := wizard.NewNode()
-		node.Name = data.Name
-		node.DockerRootDirectory = data.DockerRootDirectory
-		node.MachineRoles = data.MachineRoles
+	nodeList := make([]*wizard.Node, 0, len(entries))
+	for _, entry := range entries {
 
-		node.IP = data.IP
-		node.Port = data.Port
-		node.Username = data.Username
-		node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(data.AuthenticationType)
-		switch data.AuthenticationType {
-		case api.AuthenticationTypePassword:
-			node.Password = data.Password
-		case api.AuthenticationTypePrivateKey:
-			node.PrivateKeyName = data.PrivateKeyName
+		if entry.err != nil {
+			log.ReqEntry(c).Infof("line %d error: %v", entry.line, entry.err)
+			h.E(c, entry.err)
+			return
 		}
 
-		nodeList = append(nodeList, node)
+		nodeList = append(nodeList, convertAPINodeToModelNode(entry.node))
 	}
 
-	wizardData := wizard.GetCurrentWizard()
-	err = wizardData.AddNodeList(nodeList)
+	switch mode {
+	case api.BatchNodesModeUpsert:
+		err = wizardData.UpsertNodeList(nodeList)
+	default:
+		err = wizardData.AddNodeList(nodeList)
+	}
 	if err != nil {
 
 		h.E(c, err)
@@ -84,7 +109,37 @@ func UploadBatchNodes(c *gin.Context) {
 	})
 }
 
-func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, err error) {
+// batchNodeEntry is a node parsed from a line of the batch nodes content
+type batchNodeEntry struct {
+	line int
+	node *api.NodeData
+	err  error
+}
+
+func getUploadBatchNodesOptions(c *gin.Context) (format api.BatchNodesFormat, mode api.BatchNodesMode, dryRun bool, err error) {
+
+	format = api.BatchNodesFormat(c.DefaultQuery("format", string(api.BatchNodesFormatText)))
+	mode = api.BatchNodesMode(c.DefaultQuery("mode", string(api.BatchNodesModeAdd)))
+
+	err = validator.NewWrapper(
+		validator.ValidateStringOptions(string(format), "format",
+			[]string{string(api.BatchNodesFormatText), string(api.BatchNodesFormatCSV), string(api.BatchNodesFormatYAML)}),
+		validator.ValidateStringOptions(string(mode), "mode",
+			[]string{string(api.BatchNodesModeAdd), string(api.BatchNodesModeUpsert)}),
+	).Validate()
+	if err != nil {
+		err = h.EParamsError.WithPayload(err.Error())
+		return
+	}
+
+	dryRun, err = strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		err = h.EParamsError.WithPayload("query parameter \"dryRun\" must be a boolean")
+	}
+	return
+}
+
+func getUploadBatchNodesRequestData(c *gin.Context, format api.BatchNodesFormat) (entries []*batchNodeEntry, err error) {
 
 	data, err := c.GetRawData()
 	log.ReqEntry(c).Tracef("rawData: %v, err: %v", string(data), err)
@@ -92,6 +147,30 @@ func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, e
 		return
 	}
 
+	switch format {
+	case api.BatchNodesFormatCSV:
+		entries, err = parseCSVBatchNodes(data)
+	case api.BatchNodesFormatYAML:
+		entries, err = parseYAMLBatchNodes(data)
+	default:
+		entries = parseTextBatchNodes(data)
+	}
+	if err != nil {
+		return
+	}
+
+	log.ReqEntry(c).Tracef("match node count: %d", len(entries))
+
+	if len(entries) == 0 {
+		err = fmt.Errorf("node list empty")
+		return
+	}
+
+	return
+}
+
+func parseTextBatchNodes(data []byte) []*batchNodeEntry {
+
 	/**
 	Excample Template
 	#<hostname> <user>  <role,role,role>         <IP>             <ssh port>  <password>          <login key name>        <docker path>
@@ -102,36 +181,31 @@ func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, e
 	k8s-worker2   root	    worker,etcd          192.168.3.229    22          -	                  worker_key 	          /var/lib/docker
 	k8s-worker3   root	    worker               192.168.3.230    22          -	                  worker_key 	          /var/lib/docker
 	*/
-	matches, groupNames := tryToMatchBatchNodes(data)
-
-	log.ReqEntry(c).Tracef("matches: %v", matches)
-
-	if len(matches) == 0 {
-		err = fmt.Errorf("node list empty")
-		return
-	}
-
-	nodeList = make([]*api.NodeData, 0, len(matches))
+	entries := make([]*batchNodeEntry, 0)
+	for index, line := range strings.Split(string(data), "\n") {
 
-	log.ReqEntry(c).Tracef("match node count: %d", len(matches))
+		trimmedLine := strings.TrimSpace(line)
+		if trimmedLine == "" || strings.HasPrefix(trimmedLine, "#") {
+			continue
+		}
 
-	ipList := make(map[string]bool)
-	nameList := make(map[string]bool)
+		entry := &batchNodeEntry{line: index + 1}
+		entries = append(entries, entry)
 
-	for _, match := range matches {
+		matches, groupNames := tryToMatchBatchNodes([]byte(line))
+		if len(matches) == 0 {
+			entry.err = fmt.Errorf("line format is wrong")
+			continue
+		}
 
 		matchMap := make(map[string]string)
 		for i, groupName := range groupNames {
 
-			if i > 0 && i <= len(match) {
-				matchMap[groupName] = match[i]
+			if i > 0 && i < len(matches[0]) {
+				matchMap[groupName] = matches[0][i]
 			}
 		}
 
-		log.ReqEntry(c).Tracef("matched roles: %s", matchMap["roles"])
-		roles := splitInputRoles(matchMap)
-		log.ReqEntry(c).Tracef("roles: %#v", roles)
-
 		loginData := api.SSHLoginData{
 			Username:           matchMap["username"],
 			Password:           matchMap["password"],
@@ -144,52 +218,375 @@ func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, e
 			loginData.Password = ""
 		}
 
-		var port int
-		port, err = strconv.Atoi(matchMap["port"])
-		if err != nil {
-			return
-		}
-
-		node := &api.NodeData{
+		entry.node = &api.NodeData{
 			NodeBaseData: api.NodeBaseData{
 				Name:                matchMap["nodeName"],
-				MachineRoles:        roles,
+				MachineRoles:        splitInputRoles(matchMap),
 				DockerRootDirectory: matchMap["dockerPath"],
 			},
 			ConnectionData: api.ConnectionData{
 				SSHLoginData: loginData,
 				IP:           matchMap["ip"],
-				Port:         uint16(port),
 			},
 		}
-		log.ReqEntry(c).Tracef("node: %#v", node)
 
-		err = node.Validate()
+		port, err := strconv.Atoi(matchMap["port"])
 		if err != nil {
-			return
+			entry.err = err
+			continue
+		}
+		entry.node.Port = uint16(port)
+	}
+
+	return entries
+}
+
+// parseCSVBatchNodes parses the whole content by one csv reader, so that quoted cells could contain line breaks.
+// The line of an entry is the line where the record starts.
+func parseCSVBatchNodes(data []byte) ([]*batchNodeEntry, error) {
+
+	lines := &csvLineReader{data: data}
+	reader := csv.NewReader(lines)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var columns map[string]int
+	entries := make([]*batchNodeEntry, 0)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
 		}
 
-		if _, exist := ipList[matchMap["ip"]]; exist {
+		line := lines.currentLine()
+		if parseErr, ok := err.(*csv.ParseError); ok {
+			line = parseErr.StartLine
+		}
+		// line breaks in quoted cells are the lines of the record besides the last one
+		for _, field := range record {
+			line -= strings.Count(field, "\n")
+		}
 
-			err = fmt.Errorf("node ip %s was duplicated", matchMap["ip"])
-			return
+		// lines with blanks only are skipped as empty lines
+		if err == nil && len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
 		}
 
-		if _, exist := nameList[matchMap["nodeName"]]; exist {
+		// the first record is header
+		if columns == nil {
+			if err != nil {
+				return nil, err
+			}
+			if columns, err = getBatchNodesCSVColumns(record); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-			err = fmt.Errorf("node name %s was duplicated", matchMap["nodeName"])
-			return
+		entry := &batchNodeEntry{line: line}
+		entries = append(entries, entry)
+		if err != nil {
+			entry.err = err
+			continue
 		}
 
-		ipList[matchMap["ip"]] = true
-		nameList[matchMap["nodeName"]] = true
+		entry.node, entry.err = convertCSVRecordToAPINode(record, columns)
+	}
+
+	return entries, nil
+}
+
+// csvLineReader returns at most one line by each read, so that the buffered reader of csv.Reader never reads ahead
+// of the current record, and the lines it has read are the lines of the records read by csv.Reader.
+type csvLineReader struct {
+	data  []byte
+	lines int
+	// partial is true if the last line read has no line break, i.e. the last line of content
+	partial bool
+}
 
-		nodeList = append(nodeList, node)
+func (reader *csvLineReader) Read(p []byte) (int, error) {
+
+	if len(reader.data) == 0 {
+		return 0, io.EOF
 	}
 
-	log.ReqEntry(c).WithField("inputNodeList", nodeList).Trace("input node list")
+	n := bytes.IndexByte(reader.data, '\n') + 1
+	if n == 0 {
+		n = len(reader.data)
+	}
+	if n > len(p) {
+		n = len(p)
+	}
+	copy(p, reader.data[:n])
+	reader.data = reader.data[n:]
 
-	return
+	reader.partial = p[n-1] != '\n'
+	if !reader.partial {
+		reader.lines++
+	}
+
+	return n, nil
+}
+
+// currentLine returns the number of the last line read
+func (reader *csvLineReader) currentLine() int {
+
+	if reader.partial {
+		return reader.lines + 1
+	}
+	return reader.lines
+}
+
+func getBatchNodesCSVColumns(header []string) (map[string]int, error) {
+
+	columns := make(map[string]int)
+	for index, column := range header {
+
+		column = strings.TrimSpace(column)
+		if err := validator.ValidateStringOptions(column, "csv column", batchNodesCSVColumns)(); err != nil {
+			return nil, err
+		}
+		columns[column] = index
+	}
+
+	if _, exist := columns[api.BatchNodesCSVColumnIP]; !exist {
+		return nil, fmt.Errorf("csv column %s is required", api.BatchNodesCSVColumnIP)
+	}
+
+	return columns, nil
+}
+
+var batchNodesCSVColumns = []string{
+	api.BatchNodesCSVColumnName,
+	api.BatchNodesCSVColumnDescription,
+	api.BatchNodesCSVColumnRoles,
+	api.BatchNodesCSVColumnIP,
+	api.BatchNodesCSVColumnPort,
+	api.BatchNodesCSVColumnUsername,
+	api.BatchNodesCSVColumnAuthenticationType,
+	api.BatchNodesCSVColumnPassword,
+	api.BatchNodesCSVColumnPrivateKeyName,
+	api.BatchNodesCSVColumnDockerRootDirectory,
+	api.BatchNodesCSVColumnLabels,
+	api.BatchNodesCSVColumnTaints,
+}
+
+func convertCSVRecordToAPINode(record []string, columns map[string]int) (*api.NodeData, error) {
+
+	value := func(column string) string {
+		index, exist := columns[column]
+		if !exist || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	node := &api.NodeData{
+		NodeBaseData: api.NodeBaseData{
+			Name:                value(api.BatchNodesCSVColumnName),
+			Description:         value(api.BatchNodesCSVColumnDescription),
+			MachineRoles:        make([]constant.MachineRole, 0),
+			Labels:              make([]api.Label, 0),
+			Taints:              make([]api.Taint, 0),
+			DockerRootDirectory: value(api.BatchNodesCSVColumnDockerRootDirectory),
+		},
+		ConnectionData: api.ConnectionData{
+			IP:   value(api.BatchNodesCSVColumnIP),
+			Port: wizard.DefaultSSHPort,
+			SSHLoginData: api.SSHLoginData{
+				Username:           value(api.BatchNodesCSVColumnUsername),
+				AuthenticationType: api.AuthenticationType(value(api.BatchNodesCSVColumnAuthenticationType)),
+				Password:           value(api.BatchNodesCSVColumnPassword),
+				PrivateKeyName:     value(api.BatchNodesCSVColumnPrivateKeyName),
+			},
+		},
+	}
+
+	if node.Username == "" {
+		node.Username = wizard.DefaultUsername
+	}
+
+	if node.AuthenticationType == "" {
+		node.AuthenticationType = api.AuthenticationTypePassword
+		if node.PrivateKeyName != "" {
+			node.AuthenticationType = api.AuthenticationTypePrivateKey
+		}
+	}
+
+	if port := value(api.BatchNodesCSVColumnPort); port != "" {
+		portNumber, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return node, err
+		}
+		node.Port = uint16(portNumber)
+	}
+
+	for _, role := range splitBatchNodesCSVCell(value(api.BatchNodesCSVColumnRoles)) {
+		node.MachineRoles = append(node.MachineRoles, constant.MachineRole(role))
+	}
+
+	for _, label := range splitBatchNodesCSVCell(value(api.BatchNodesCSVColumnLabels)) {
+
+		keyValue := strings.SplitN(label, "=", 2)
+		if len(keyValue) != 2 {
+			return node, fmt.Errorf("label %s format is wrong, should be key=value", label)
+		}
+		node.Labels = append(node.Labels, api.Label{Key: keyValue[0], Value: keyValue[1]})
+	}
+
+	for _, taint := range splitBatchNodesCSVCell(value(api.BatchNodesCSVColumnTaints)) {
+
+		keyValue := strings.SplitN(taint, "=", 2)
+		if len(keyValue) != 2 {
+			return node, fmt.Errorf("taint %s format is wrong, should be key=value:effect", taint)
+		}
+		valueEffect := strings.SplitN(keyValue[1], ":", 2)
+		if len(valueEffect) != 2 {
+			return node, fmt.Errorf("taint %s format is wrong, should be key=value:effect", taint)
+		}
+		node.Taints = append(node.Taints, api.Taint{Key: keyValue[0], Value: valueEffect[0], Effect: api.TaintEffect(valueEffect[1])})
+	}
+
+	return node, nil
+}
+
+func splitBatchNodesCSVCell(cell string) []string {
+
+	values := make([]string, 0)
+	for _, value := range strings.Split(cell, ";") {
+
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+func parseYAMLBatchNodes(data []byte) ([]*batchNodeEntry, error) {
+
+	content := new(api.BatchNodesYAMLContent)
+	if err := yaml.Unmarshal(data, content); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*batchNodeEntry, 0, len(content.Nodes))
+	for index := range content.Nodes {
+
+		node := &content.Nodes[index]
+		if node.Port == 0 {
+			node.Port = wizard.DefaultSSHPort
+		}
+		entries = append(entries, &batchNodeEntry{line: index + 1, node: node})
+	}
+
+	return entries, nil
+}
+
+// validateBatchNodes validate each parsed node, check duplication in the content and conflict with the existing nodes
+func validateBatchNodes(entries []*batchNodeEntry, mode api.BatchNodesMode, wizardData *wizard.Cluster) {
+
+	ipList := make(map[string]bool)
+	nameList := make(map[string]bool)
+	for _, entry := range entries {
+
+		if entry.err != nil {
+			entry.err = h.EParamsError.WithPayload(entry.err.Error())
+			continue
+		}
+
+		node := entry.node
+		if node.DockerRootDirectory == "" {
+			node.DockerRootDirectory = wizard.DefaultDockerRootDirectory
+		}
+
+		if err := validateBatchNode(node, mode, wizardData); err != nil {
+			entry.err = h.EParamsError.WithPayload(err.Error())
+		} else if ipList[node.IP] {
+			entry.err = h.EParamsError.WithPayload(fmt.Sprintf("node ip %s was duplicated", node.IP))
+		} else if nameList[node.Name] {
+			entry.err = h.EParamsError.WithPayload(fmt.Sprintf("node name %s was duplicated", node.Name))
+		}
+		ipList[node.IP] = true
+		nameList[node.Name] = true
+	}
+
+	for _, entry := range entries {
+
+		if entry.err != nil {
+			continue
+		}
+
+		existNode := wizardData.GetNode(entry.node.IP)
+		if existNode != nil && mode != api.BatchNodesModeUpsert {
+			entry.err = h.EExists.WithPayload(fmt.Sprintf("node ip %s was exist", entry.node.IP))
+			continue
+		}
+
+		// the name can be reused when the node which owns it will be updated
+		nameOwner := wizardData.GetNodeByName(entry.node.Name)
+		if nameOwner != nil && nameOwner != existNode && !(mode == api.BatchNodesModeUpsert && ipList[nameOwner.IP]) {
+			entry.err = h.EExists.WithPayload(fmt.Sprintf("node name %s was exist", entry.node.Name))
+		}
+	}
+}
+
+// validateBatchNode validate a node, the password can be omitted when updating a node which has password
+func validateBatchNode(node *api.NodeData, mode api.BatchNodesMode, wizardData *wizard.Cluster) error {
+
+	existNode := wizardData.GetNode(node.IP)
+	if mode != api.BatchNodesModeUpsert || existNode == nil ||
+		existNode.AuthenticationType != wizard.AuthenticationTypePassword || existNode.Password == "" {
+		return node.Validate()
+	}
+
+	return validator.NewWrapper(
+		node.NodeBaseData.Validate,
+		validator.ValidateIP(node.IP, "ip"),
+		validator.ValidateIntRange(int(node.Port), "port", api.NodeSSHPortMinimum, api.NodeSSHPortMaximum),
+		node.SSHLoginData.ValidateWithoutPassword,
+	).Validate()
+}
+
+func getUploadBatchNodesDryRunResponse(entries []*batchNodeEntry) *api.UploadBatchNodesDryRunResponse {
+
+	response := &api.UploadBatchNodesDryRunResponse{
+		Passed: true,
+		Nodes:  make([]api.NodeData, 0, len(entries)),
+		Errors: make([]api.BatchNodesError, 0),
+	}
+
+	for _, entry := range entries {
+
+		if entry.node != nil {
+			node := *entry.node
+			node.Password = ""
+			response.Nodes = append(response.Nodes, node)
+		}
+
+		if entry.err == nil {
+			continue
+		}
+
+		response.Passed = false
+		batchNodesError := api.BatchNodesError{
+			Line:  entry.line,
+			Error: entry.err.Error(),
+		}
+		if appErr, ok := entry.err.(*h.AppErr); ok {
+			batchNodesError.Error = fmt.Sprintf("%v", appErr.Payload)
+		}
+		if entry.node != nil {
+			batchNodesError.Name = entry.node.Name
+			batchNodesError.IP = entry.node.IP
+		}
+		response.Errors = append(response.Errors, batchNodesError)
+	}
+
+	return response
 }
 
 func splitInputRoles(matchMap map[string]string) []constant.MachineRole {
@@ -124,6 +124,8 @@ func getCallCheckNodesData() *protos.CheckNodesRequest {
 			nodeConfig.Roles = append(nodeConfig.Roles, string(role))
 		}
 
+		nodeConfig.Thresholds = convertModelCheckThresholdsToDeployController(wizardData.Info.CheckThresholds, node.MachineRoles)
+
 		nodeConfig.Node = &protos.Node{
 			Name: node.Name,
 			Ip:   node.IP,
@@ -134,20 +136,17 @@ func getCallCheckNodesData() *protos.CheckNodesRequest {
 	}
 
 	// add network options for checking network connectivity.
-	if wizardNetworkOptions := wizardData.GetNetworkOptions(); wizardNetworkOptions != nil {
-		reqNetworkOptions := &protos.NetworkOptions{
-			NetworkType: string(wizardNetworkOptions.NetworkType),
-		}
-		if wizardNetworkOptions.NetworkType == api.NetworkTypeCalico &&
-			wizardNetworkOptions.CalicoOptions != nil {
-			reqNetworkOptions.CalicoOptions = &protos.CalicoOptions{
-				CheckConnectivityAll: false,
-				EncapsulationMode:    string(wizardNetworkOptions.CalicoOptions.EncapsulationMode),
-				VxlanPort:            uint32(wizardNetworkOptions.CalicoOptions.VxlanPort),
-			}
-		}
-		requestData.NetworkOptions = reqNetworkOptions
-	}
+	requestData.NetworkOptions = convertModelNetworkOptionsToDeployController(wizardData.GetNetworkOptions())
+
+	// add kube-apiserver connection for checking the virtual ip on master nodes.
+	requestData.KubeAPIServerConnect = convertModelKubeAPIServerConnectionToDeployController(wizardData.Info.KubeAPIServerConnection)
+
+	// add container runtime for checking docker or containerd.
+	requestData.ContainerRuntime = string(wizardData.Info.ContainerRuntime)
+
+	// add cluster subnets for checking conflicts with node routes.
+	requestData.PodSubnets = wizardData.Info.PodSubnets
+	requestData.ServiceSubnets = wizardData.Info.ServiceSubnets
 
 	return requestData
 }
@@ -286,9 +285,73 @@ func checkWrongClusterConfiguration() (errs []*api.CheckingItem) {
 		})
 	}
 
+	errs = append(errs, checkClusterVIP()...)
+	errs = append(errs, checkClusterNetworkPlan()...)
+	errs = append(errs, checkIngressNodePorts()...)
+
 	return errs
 }
 
+func checkIngressNodePorts() (errs []*api.CheckingItem) {
+
+	errs = make([]*api.CheckingItem, 0)
+	wizardData := wizard.GetCurrentWizard()
+	options := wizardData.GetIngressOptions()
+	if options.ExposeMode != api.IngressExposeModeNodePort {
+		return
+	}
+
+	minimum, maximum := int(wizardData.Info.NodePortMinimum), int(wizardData.Info.NodePortMaximum)
+	for _, port := range []int{options.HTTPNodePort, options.HTTPSNodePort} {
+
+		if port == 0 || (port >= minimum && port <= maximum) {
+			continue
+		}
+
+		errs = append(errs, &api.CheckingItem{
+			CheckingPoint: "Checking node ports of ingress controller", // 检查 Ingress 控制器的 NodePort
+			Result:        constant.CheckResultFailed,
+			Error: &api.Error{
+				Reason:     "Node port of ingress controller is out of range",                               // Ingress 控制器的 NodePort 超出范围
+				Detail:     fmt.Sprintf("node port %d is not in range %d-%d", port, minimum, maximum),       // NodePort %d 不在 %d-%d 范围内
+				FixMethods: "Modify the node port of ingress controller, or the node port range of cluster", // 修改 Ingress 控制器的 NodePort，或者集群的 NodePort 范围
+			},
+		})
+	}
+
+	return
+}
+
+func checkClusterVIP() (errs []*api.CheckingItem) {
+
+	errs = make([]*api.CheckingItem, 0)
+	wizardData := wizard.GetCurrentWizard()
+	connection := wizardData.Info.KubeAPIServerConnection
+	if connection.KubeAPIServerConnectType != wizard.KubeAPIServerConnectTypeKeepalived &&
+		connection.KubeAPIServerConnectType != wizard.KubeAPIServerConnectTypeKubeVIP {
+		return
+	}
+
+	for _, node := range wizardData.Nodes {
+
+		if node.IP != connection.VIP {
+			continue
+		}
+
+		errs = append(errs, &api.CheckingItem{
+			CheckingPoint: "Checking virtual ip of kube-apiserver", // 检查 kube-apiserver 虚拟 IP
+			Result:        constant.CheckResultFailed,
+			Error: &api.Error{
+				Reason:     "Virtual ip is used by node",                                                 // 虚拟 IP 已被节点使用
+				Detail:     fmt.Sprintf("virtual ip %s is the ip of node %s", connection.VIP, node.Name), // 虚拟 IP %s 是节点 %s 的 IP
+				FixMethods: "Choose an unused ip as virtual ip",                                          // 选择一个未使用的 IP 作为虚拟 IP
+			},
+		})
+	}
+
+	return
+}
+
 func checkClusterHANodeCount() (warnings []*api.CheckingItem) {
 
 	warnings = make([]*api.CheckingItem, 0)
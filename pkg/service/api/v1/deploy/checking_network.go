@@ -0,0 +1,210 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+// mask size of pod subnet allocated to each node, same as the defaults of kube-controller-manager
+const (
+	nodeCIDRMaskSizeIPv4 = 24
+	nodeCIDRMaskSizeIPv6 = 64
+)
+
+// checkClusterNetworkPlan checks the subnets of cluster and the addresses of nodes.
+// Routes of nodes are checked against the subnets by deploy controller during node checking.
+func checkClusterNetworkPlan() (errs []*api.CheckingItem) {
+
+	errs = make([]*api.CheckingItem, 0)
+	wizardData := wizard.GetCurrentWizard()
+
+	podSubnets, podErrs := parseClusterSubnets("pod", wizardData.Info.PodSubnets)
+	serviceSubnets, serviceErrs := parseClusterSubnets("service", wizardData.Info.ServiceSubnets)
+	errs = append(errs, podErrs...)
+	errs = append(errs, serviceErrs...)
+	if len(errs) > 0 {
+		return
+	}
+
+	errs = append(errs, checkClusterSubnetsOverlap(podSubnets, serviceSubnets)...)
+	errs = append(errs, checkClusterPodSubnetsSize(podSubnets, wizardData.Nodes)...)
+	errs = append(errs, checkNodeAddressConflict(append(podSubnets, serviceSubnets...), wizardData.Nodes)...)
+
+	return
+}
+
+func parseClusterSubnets(kind string, subnets []string) (networks []*net.IPNet, errs []*api.CheckingItem) {
+
+	for _, subnet := range subnets {
+
+		_, network, err := net.ParseCIDR(subnet)
+		if err != nil {
+			errs = append(errs, newNetworkPlanCheckingItem(
+				fmt.Sprintf("Invalid %s subnet", kind),                        // 无效的 %s 网段
+				fmt.Sprintf("%s subnet %s is invalid: %v", kind, subnet, err), // %s 网段 %s 无效
+				"Modify the subnet to a valid CIDR",                           // 修改为合法的 CIDR
+			))
+			continue
+		}
+		networks = append(networks, network)
+	}
+
+	return
+}
+
+func checkClusterSubnetsOverlap(podSubnets, serviceSubnets []*net.IPNet) (errs []*api.CheckingItem) {
+
+	subnets := append(append([]*net.IPNet{}, podSubnets...), serviceSubnets...)
+	for i := 0; i < len(subnets); i++ {
+		for j := i + 1; j < len(subnets); j++ {
+
+			if !subnetsOverlap(subnets[i], subnets[j]) {
+				continue
+			}
+
+			errs = append(errs, newNetworkPlanCheckingItem(
+				"Cluster subnets overlap", // 集群网段重叠
+				fmt.Sprintf("subnet %s overlaps with subnet %s", subnets[i], subnets[j]), // 网段 %s 与网段 %s 重叠
+				"Choose pod subnets and service subnets which do not overlap each other", // 选择互不重叠的 Pod 网段和 Service 网段
+			))
+		}
+	}
+
+	return
+}
+
+func checkClusterPodSubnetsSize(podSubnets []*net.IPNet, nodes []*wizard.Node) (errs []*api.CheckingItem) {
+
+	nodeCount := 0
+	for _, node := range nodes {
+		if isKubernetesNode(node) {
+			nodeCount++
+		}
+	}
+
+	for _, subnet := range podSubnets {
+
+		ones, bits := subnet.Mask.Size()
+		maskSize := nodeCIDRMaskSizeIPv4
+		if bits == net.IPv6len*8 {
+			maskSize = nodeCIDRMaskSizeIPv6
+		}
+
+		if ones > maskSize {
+			errs = append(errs, newNetworkPlanCheckingItem(
+				"Pod subnet is too small", // Pod 网段过小
+				fmt.Sprintf("prefix length of pod subnet %s is longer than the mask size /%d of each node", subnet, maskSize), // Pod 网段 %s 的前缀长度超过了每个节点的掩码 /%d
+				fmt.Sprintf("Modify the prefix length of pod subnet to be at most %d", maskSize),                              // 修改 Pod 网段前缀长度不超过 %d
+			))
+			continue
+		}
+
+		capacity := new(big.Int).Lsh(big.NewInt(1), uint(maskSize-ones))
+		if capacity.Cmp(big.NewInt(int64(nodeCount))) >= 0 {
+			continue
+		}
+
+		errs = append(errs, newNetworkPlanCheckingItem(
+			"Pod subnet is too small", // Pod 网段过小
+			fmt.Sprintf("pod subnet %s can be allocated to %s nodes with mask size /%d, but there are %d nodes", subnet, capacity, maskSize, nodeCount), // Pod 网段 %s 可分配的节点数不足
+			"Choose a larger pod subnet", // 选择更大的 Pod 网段
+		))
+	}
+
+	return
+}
+
+func checkNodeAddressConflict(subnets []*net.IPNet, nodes []*wizard.Node) (errs []*api.CheckingItem) {
+
+	nodeNames := make(map[string]string)
+	nodeIPs := make(map[string]string)
+	for _, node := range nodes {
+
+		if node.Name != "" {
+			if _, exist := nodeNames[node.Name]; exist {
+				errs = append(errs, newNetworkPlanCheckingItem(
+					"Duplicate node name", // 节点名称重复
+					fmt.Sprintf("node name %s is used by more than one node", node.Name), // 节点名称 %s 被多个节点使用
+					"Modify the node name to be unique",                                  // 修改节点名称使其唯一
+				))
+			}
+			nodeNames[node.Name] = node.IP
+		}
+
+		ip := net.ParseIP(node.IP)
+		if ip == nil {
+			continue
+		}
+
+		if name, exist := nodeIPs[ip.String()]; exist {
+			errs = append(errs, newNetworkPlanCheckingItem(
+				"Duplicate node ip", // 节点 IP 重复
+				fmt.Sprintf("ip %s is used by both node %s and node %s", node.IP, name, node.Name), // IP %s 被多个节点使用
+				"Modify the node ip to be unique",                                                  // 修改节点 IP 使其唯一
+			))
+		}
+		nodeIPs[ip.String()] = node.Name
+
+		for _, subnet := range subnets {
+
+			if !subnet.Contains(ip) {
+				continue
+			}
+
+			errs = append(errs, newNetworkPlanCheckingItem(
+				"Node ip conflicts with cluster subnets",                                            // 节点 IP 与集群网段冲突
+				fmt.Sprintf("ip %s of node %s is in cluster subnet %s", node.IP, node.Name, subnet), // 节点 IP %s 位于集群网段内
+				"Choose pod subnets and service subnets which do not contain node ips",              // 选择不包含节点 IP 的 Pod 网段和 Service 网段
+			))
+		}
+	}
+
+	return
+}
+
+func isKubernetesNode(node *wizard.Node) bool {
+
+	for _, role := range node.MachineRoles {
+		if role != constant.MachineRoleEtcd {
+			return true
+		}
+	}
+	return false
+}
+
+func subnetsOverlap(a, b *net.IPNet) bool {
+
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func newNetworkPlanCheckingItem(reason, detail, fixMethods string) *api.CheckingItem {
+
+	return &api.CheckingItem{
+		CheckingPoint: "Checking network plan", // 检查网络规划
+		Result:        constant.CheckResultFailed,
+		Error: &api.Error{
+			Reason:     reason,
+			Detail:     detail,
+			FixMethods: fixMethods,
+		},
+	}
+}
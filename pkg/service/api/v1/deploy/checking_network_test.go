@@ -0,0 +1,119 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+func TestCheckClusterNetworkPlan(t *testing.T) {
+
+	newNode := func(name, ip string, roles ...constant.MachineRole) *wizard.Node {
+		node := wizard.NewNode()
+		node.Name = name
+		node.IP = ip
+		node.MachineRoles = roles
+		return node
+	}
+
+	tests := []struct {
+		name           string
+		podSubnets     []string
+		serviceSubnets []string
+		nodes          []*wizard.Node
+		wantErrCount   int
+	}{
+		{
+			name:           "valid plan",
+			podSubnets:     []string{"172.20.0.0/16"},
+			serviceSubnets: []string{"10.96.0.0/12"},
+			nodes: []*wizard.Node{
+				newNode("master1", "192.168.31.11", constant.MachineRoleMaster, constant.MachineRoleEtcd),
+				newNode("worker1", "192.168.31.21", constant.MachineRoleWorker),
+			},
+			wantErrCount: 0,
+		},
+		{
+			name:           "invalid subnet",
+			podSubnets:     []string{"172.20.0.0/16"},
+			serviceSubnets: []string{"10.96.0.0/33"},
+			wantErrCount:   1,
+		},
+		{
+			name:           "pod subnet overlaps with service subnet",
+			podSubnets:     []string{"10.64.0.0/10"},
+			serviceSubnets: []string{"10.96.0.0/12"},
+			wantErrCount:   1,
+		},
+		{
+			name:           "pod subnet too small for nodes",
+			podSubnets:     []string{"172.20.0.0/23", "fd00:20::/63"},
+			serviceSubnets: []string{"10.96.0.0/12", "fd00:96::/112"},
+			nodes: []*wizard.Node{
+				newNode("master1", "192.168.31.11", constant.MachineRoleMaster),
+				newNode("worker1", "192.168.31.21", constant.MachineRoleWorker),
+				newNode("worker2", "192.168.31.22", constant.MachineRoleWorker),
+				newNode("etcd1", "192.168.31.31", constant.MachineRoleEtcd),
+			},
+			wantErrCount: 2,
+		},
+		{
+			name:           "pod subnet smaller than node mask",
+			podSubnets:     []string{"172.20.0.0/25"},
+			serviceSubnets: []string{"10.96.0.0/12"},
+			wantErrCount:   1,
+		},
+		{
+			name:           "duplicate node name and ip",
+			podSubnets:     []string{"172.20.0.0/16"},
+			serviceSubnets: []string{"10.96.0.0/12"},
+			nodes: []*wizard.Node{
+				newNode("master1", "192.168.31.11", constant.MachineRoleMaster),
+				newNode("master1", "192.168.31.12", constant.MachineRoleWorker),
+				newNode("worker1", "192.168.31.12", constant.MachineRoleWorker),
+			},
+			wantErrCount: 2,
+		},
+		{
+			name:           "node ip in cluster subnet",
+			podSubnets:     []string{"192.168.0.0/16"},
+			serviceSubnets: []string{"10.96.0.0/12"},
+			nodes: []*wizard.Node{
+				newNode("master1", "192.168.31.11", constant.MachineRoleMaster),
+			},
+			wantErrCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+
+		wizard.ClearCurrentWizardData()
+		wizardData := wizard.GetCurrentWizard()
+		wizardData.Info.PodSubnets = test.podSubnets
+		wizardData.Info.ServiceSubnets = test.serviceSubnets
+		wizardData.Nodes = test.nodes
+
+		errs := checkClusterNetworkPlan()
+		assert.Len(t, errs, test.wantErrCount, test.name)
+		for _, err := range errs {
+			assert.Equal(t, constant.CheckResultFailed, err.Result)
+		}
+	}
+}
@@ -136,3 +136,24 @@ func TestGetCheckingNodeListResult(t *testing.T) {
 
 	assert.Equal(t, constant.CheckResultSuccessful, responseData.Result)
 }
+
+func TestCheckIngressNodePorts(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.Info.NodePortMinimum = 30000
+	wizardData.Info.NodePortMaximum = 32767
+
+	// host network mode has no node port
+	assert.Len(t, checkIngressNodePorts(), 0)
+
+	wizardData.SetIngressOptions(&api.IngressOptions{
+		IngressType:   api.IngressTypeNginx,
+		ExposeMode:    api.IngressExposeModeNodePort,
+		HTTPNodePort:  30080,
+		HTTPSNodePort: 443,
+	})
+	errs := checkIngressNodePorts()
+	assert.Len(t, errs, 1)
+	assert.Equal(t, constant.CheckResultFailed, errs[0].Result)
+}
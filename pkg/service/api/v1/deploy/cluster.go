@@ -17,6 +17,8 @@
 package deploy
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
@@ -51,6 +53,16 @@ func SetCluster(c *gin.Context) {
 		return
 	}
 
+	registries, err := resolveRegistryPasswords(requestData.Registries, wizardData.Info.Registries)
+	if err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, err)
+		return
+	}
+
+	requestData.BGPPeers = resolveBGPPeerPasswords(requestData.BGPPeers, wizardData.Info.KubeAPIServerConnection)
+	requestData.KeepalivedAuthPassword = resolveKeepalivedAuthPassword(requestData, wizardData.Info.KubeAPIServerConnection)
+
 	wizardData.Info.Name = requestData.Name
 	wizardData.Info.ShortName = requestData.ShortName
 	wizardData.Info.KubeAPIServerConnection = wizard.NewKubeAPIServerConnectionData()
@@ -59,16 +71,31 @@ func SetCluster(c *gin.Context) {
 	case api.KubeAPIServerConnectTypeFirstMasterIP:
 		wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeFirstMasterIP
 	case api.KubeAPIServerConnectTypeKeepalived:
-		wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeKeepalived
-		wizardData.Info.KubeAPIServerConnection.VIP = requestData.VIP
-		wizardData.Info.KubeAPIServerConnection.NetInterfaceName = requestData.NetInterfaceName
+		setModelKeepalivedConnection(wizardData.Info.KubeAPIServerConnection, requestData)
 	case api.KubeAPIServerConnectTypeLoadBalancer:
 		wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeLoadBalancer
 		wizardData.Info.KubeAPIServerConnection.LoadbalancerIP = requestData.LoadbalancerIP
 		wizardData.Info.KubeAPIServerConnection.LoadbalancerPort = requestData.LoadbalancerPort
+	case api.KubeAPIServerConnectTypeKubeVIP:
+		setModelKubeVIPConnection(wizardData.Info.KubeAPIServerConnection, requestData)
 	}
 	wizardData.Info.NodePortMinimum = requestData.NodePortMinimum
 	wizardData.Info.NodePortMaximum = requestData.NodePortMaximum
+	if len(requestData.PodSubnets) > 0 {
+		wizardData.Info.PodSubnets = requestData.PodSubnets
+		wizardData.Info.ServiceSubnets = requestData.ServiceSubnets
+	}
+	wizardData.Info.CheckThresholds = requestData.CheckThresholds
+	if requestData.ContainerRuntime != "" {
+		wizardData.Info.ContainerRuntime = requestData.ContainerRuntime
+	}
+	wizardData.Info.NodeInitProfile = requestData.NodeInitProfile
+	wizardData.Info.Proxy = requestData.Proxy
+	wizardData.Info.ImageRepository = requestData.ImageRepository
+	wizardData.Info.Registries = registries
+	wizardData.Info.ComponentConfig = requestData.ComponentConfig
+	wizardData.Info.Audit = requestData.Audit
+	wizardData.Info.Encryption = requestData.Encryption
 	wizardData.Info.Labels = make([]*wizard.Label, 0, len(requestData.Labels))
 	for _, label := range requestData.Labels {
 		wizardData.Info.Labels = append(wizardData.Info.Labels, &wizard.Label{
@@ -103,6 +130,68 @@ func initDefaultNodePort(requestData *api.Cluster, wizardData *wizard.Cluster) e
 	return nil
 }
 
+// resolveRegistryPasswords fills the empty passwords from the stored registries which have the same server and username,
+// so that passwords are not required to be sent again.
+func resolveRegistryPasswords(registries, storedRegistries []api.Registry) ([]api.Registry, error) {
+
+	result := make([]api.Registry, 0, len(registries))
+	for _, registry := range registries {
+
+		if registry.Username != "" && registry.Password == "" {
+			for _, stored := range storedRegistries {
+				if stored.Server == registry.Server && stored.Username == registry.Username {
+					registry.Password = stored.Password
+					break
+				}
+			}
+			if registry.Password == "" {
+				return nil, h.EParamsError.WithPayload(fmt.Sprintf("password of registry %s is required", registry.Server))
+			}
+		}
+		result = append(result, registry)
+	}
+
+	return result, nil
+}
+
+// resolveBGPPeerPasswords fills the empty passwords from the stored bgp peers which have the same address and AS,
+// so that passwords are not required to be sent again.
+func resolveBGPPeerPasswords(peers []api.BGPPeer, storedConnection *wizard.KubeAPIServerConnectionData) []api.BGPPeer {
+
+	if len(peers) == 0 || storedConnection == nil {
+		return peers
+	}
+
+	result := make([]api.BGPPeer, 0, len(peers))
+	for _, peer := range peers {
+
+		if peer.Password == "" {
+			for _, stored := range storedConnection.BGPPeers {
+				if stored.Address == peer.Address && stored.AS == peer.AS {
+					peer.Password = stored.Password
+					break
+				}
+			}
+		}
+		result = append(result, peer)
+	}
+
+	return result
+}
+
+// resolveKeepalivedAuthPassword returns the stored auth password if the password is empty and the stored keepalived
+// connection has the same vip, so that the password is not required to be sent again.
+func resolveKeepalivedAuthPassword(cluster *api.Cluster, storedConnection *wizard.KubeAPIServerConnectionData) string {
+
+	if cluster.KeepalivedAuthPassword != "" || storedConnection == nil ||
+		storedConnection.KubeAPIServerConnectType != wizard.KubeAPIServerConnectTypeKeepalived ||
+		storedConnection.VIP != cluster.VIP {
+		return cluster.KeepalivedAuthPassword
+	}
+
+	return storedConnection.KeepalivedAuthPassword
+}
+
 // @ID GetCluster
 // @Summary Get Cluster Information
 // @Description Describe cluster information
@@ -18,12 +18,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
@@ -180,6 +182,557 @@ func TestSetCluster3(t *testing.T) {
 	assert.Equal(t, uint16(16999), wizardData.Info.NodePortMaximum)
 }
 
+func TestSetCluster4(t *testing.T) {
+
+	var err error
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeKubeVIP,
+		VIP:                      "192.168.31.200",
+		NetInterfaceName:         "eth0",
+		KubeVIPMode:              api.KubeVIPModeBGP,
+		BGPLocalAS:               65000,
+		BGPPeers: []api.BGPPeer{
+			{
+				Address:  "192.168.31.1",
+				AS:       65001,
+				Password: "secret",
+			},
+		},
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	bodyReader := bytes.NewReader(bodyContent)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bodyReader)
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.True(t, resp.Body.Len() > 0)
+	fmt.Printf("result: %s\n", resp.Body.String())
+	responseData := new(api.SuccessfulOption)
+	err = json.Unmarshal(resp.Body.Bytes(), responseData)
+	assert.Nil(t, err)
+
+	assert.True(t, responseData.Success)
+
+	wizardData := wizard.GetCurrentWizard()
+	connection := wizardData.Info.KubeAPIServerConnection
+	assert.Equal(t, wizard.KubeAPIServerConnectTypeKubeVIP, connection.KubeAPIServerConnectType)
+	assert.Equal(t, "192.168.31.200", connection.VIP)
+	assert.Equal(t, "eth0", connection.NetInterfaceName)
+	assert.Equal(t, wizard.KubeVIPModeBGP, connection.KubeVIPMode)
+	assert.Equal(t, uint32(65000), connection.BGPLocalAS)
+	assert.Equal(t, []*wizard.BGPPeer{{Address: "192.168.31.1", AS: 65001, Password: "secret"}}, connection.BGPPeers)
+
+	// bgp peer passwords are not returned unless secrets are included
+	assert.Equal(t, []api.BGPPeer{{Address: "192.168.31.1", AS: 65001}}, getWizardClusterInfo().BGPPeers)
+	assert.Equal(t, []api.BGPPeer{{Address: "192.168.31.1", AS: 65001}}, getWizardConfiguration(false).Cluster.BGPPeers)
+	assert.Equal(t, []api.BGPPeer{{Address: "192.168.31.1", AS: 65001, Password: "secret"}}, getWizardConfiguration(true).Cluster.BGPPeers)
+
+	// the stored password is kept when it's not sent again, AS numbers use the whole uint32 range
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.BGPLocalAS = 4294967295
+	body.BGPPeers = []api.BGPPeer{
+		{Address: "192.168.31.1", AS: 65001},
+		{Address: "192.168.31.2", AS: 65002},
+	}
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	connection = wizardData.Info.KubeAPIServerConnection
+	assert.Equal(t, uint32(4294967295), connection.BGPLocalAS)
+	assert.Equal(t, []*wizard.BGPPeer{
+		{Address: "192.168.31.1", AS: 65001, Password: "secret"},
+		{Address: "192.168.31.2", AS: 65002},
+	}, connection.BGPPeers)
+
+	// bgp mode without peers
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.BGPPeers = nil
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterKeepalived(t *testing.T) {
+
+	setCluster := func(body api.Cluster) int {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		bodyContent, err := json.Marshal(body)
+		assert.Nil(t, err)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+		SetCluster(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	gin.SetMode(gin.TestMode)
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeKeepalived,
+		VIP:                      "192.168.31.200",
+		NetInterfaceName:         "eth0",
+		HaproxyPort:              8443,
+		HaproxyStatsPort:         9000,
+		VirtualRouterID:          51,
+		KeepalivedAuthPassword:   "secret",
+		HealthCheckInterval:      5,
+	}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+
+	wizardData := wizard.GetCurrentWizard()
+	assert.Equal(t, &wizard.KubeAPIServerConnectionData{
+		KubeAPIServerConnectType: wizard.KubeAPIServerConnectTypeKeepalived,
+		VIP:                      "192.168.31.200",
+		NetInterfaceName:         "eth0",
+		HaproxyPort:              8443,
+		HaproxyStatsPort:         9000,
+		VirtualRouterID:          51,
+		KeepalivedAuthPassword:   "secret",
+		HealthCheckInterval:      5,
+	}, wizardData.Info.KubeAPIServerConnection)
+
+	// auth password is not returned unless secrets are included
+	clusterInfo := getWizardClusterInfo()
+	assert.Equal(t, uint16(8443), clusterInfo.HaproxyPort)
+	assert.Equal(t, uint8(51), clusterInfo.VirtualRouterID)
+	assert.Empty(t, clusterInfo.KeepalivedAuthPassword)
+	assert.Empty(t, getWizardConfiguration(false).Cluster.KeepalivedAuthPassword)
+	assert.Equal(t, "secret", getWizardConfiguration(true).Cluster.KeepalivedAuthPassword)
+
+	// the stored password is kept when it's not sent again
+	body.KeepalivedAuthPassword = ""
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	assert.Equal(t, "secret", wizardData.Info.KubeAPIServerConnection.KeepalivedAuthPassword)
+
+	// but not for another vip
+	body.VIP = "192.168.31.201"
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	assert.Empty(t, wizardData.Info.KubeAPIServerConnection.KeepalivedAuthPassword)
+
+	invalidBodies := []api.Cluster{body, body, body}
+	invalidBodies[0].HaproxyStatsPort = invalidBodies[0].HaproxyPort
+	invalidBodies[1].KeepalivedAuthPassword = "too-long-password"
+	invalidBodies[2].KeepalivedAuthPassword = "a b"
+	for _, invalidBody := range invalidBodies {
+		assert.Equal(t, http.StatusBadRequest, setCluster(invalidBody))
+	}
+}
+
+func TestSetClusterSubnets(t *testing.T) {
+
+	var err error
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		PodSubnets:               []string{"10.120.0.0/16", "fd00:120::/64"},
+		ServiceSubnets:           []string{"10.96.0.0/12", "fd00:96::/112"},
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	fmt.Printf("result: %s\n", resp.Body.String())
+	assert.Equal(t, http.StatusCreated, resp.Code)
+
+	wizardData := wizard.GetCurrentWizard()
+	assert.Equal(t, []string{"10.120.0.0/16", "fd00:120::/64"}, wizardData.Info.PodSubnets)
+	assert.Equal(t, []string{"10.96.0.0/12", "fd00:96::/112"}, wizardData.Info.ServiceSubnets)
+
+	// service subnets in a different family order than pod subnets
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.ServiceSubnets = []string{"fd00:96::/112", "10.96.0.0/12"}
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	// invalid cidr
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.ServiceSubnets = []string{"10.96.0.0/12", "not-a-cidr"}
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterCheckThresholds(t *testing.T) {
+
+	var err error
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		CheckThresholds: []api.CheckThreshold{
+			{
+				Role:    constant.MachineRoleWorker,
+				Minimum: api.CheckThresholdValues{CPUCore: 2, MemoryGiB: 4},
+			},
+		},
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+
+	wizardData := wizard.GetCurrentWizard()
+	assert.Equal(t, body.CheckThresholds, wizardData.Info.CheckThresholds)
+
+	// duplicated role
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.CheckThresholds = append(body.CheckThresholds, api.CheckThreshold{Role: constant.MachineRoleWorker})
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	// invalid version
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	body.CheckThresholds = []api.CheckThreshold{
+		{Role: constant.MachineRoleMaster, Recommended: api.CheckThresholdValues{DockerVersion: "latest"}},
+	}
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterContainerRuntime(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	assert.Equal(t, api.ContainerRuntimeDocker, wizard.GetCurrentWizard().Info.ContainerRuntime)
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		ContainerRuntime:         api.ContainerRuntimeContainerd,
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	resp := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, api.ContainerRuntimeContainerd, wizard.GetCurrentWizard().Info.ContainerRuntime)
+	assert.Equal(t, string(api.ContainerRuntimeContainerd), buildCallDeployDataClusterPart().ContainerRuntime)
+
+	// unsupported container runtime
+	body.ContainerRuntime = "rkt"
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterNodeInitProfile(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	assert.Nil(t, buildCallDeployDataClusterPart().NodeInitProfile)
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		NodeInitProfile: &api.NodeInitProfile{
+			Timezone:     "UTC",
+			NTPServers:   []string{"ntp.aliyun.com"},
+			Sysctl:       map[string]string{"vm.max_map_count": "262144"},
+			FirewallMode: api.FirewallModeOpenPorts,
+			SwapPolicy:   api.SwapPolicyKeep,
+			Items:        map[string]bool{"hostalias": false},
+		},
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	resp := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	profile := buildCallDeployDataClusterPart().NodeInitProfile
+	assert.Equal(t, "UTC", profile.Timezone)
+	assert.Equal(t, []string{"ntp.aliyun.com"}, profile.NtpServers)
+	assert.Equal(t, "262144", profile.Sysctl["vm.max_map_count"])
+	assert.Equal(t, string(api.FirewallModeOpenPorts), profile.FirewallMode)
+	assert.Equal(t, string(api.SwapPolicyKeep), profile.SwapPolicy)
+	assert.Equal(t, map[string]bool{"hostalias": false}, profile.Items)
+	assert.Equal(t, body.NodeInitProfile, getWizardClusterInfo().NodeInitProfile)
+
+	// sysctl value could not be passed to the init script
+	body.NodeInitProfile.Sysctl = map[string]string{"vm.max_map_count": "1; reboot"}
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterProxy(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	assert.Nil(t, buildCallDeployDataClusterPart().Proxy)
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		Proxy: &api.Proxy{
+			HTTPProxy:  "http://10.0.0.1:3128",
+			HTTPSProxy: "http://10.0.0.1:3128",
+			NoProxy:    []string{".example.com", "10.0.0.0/8"},
+		},
+	}
+	bodyContent, err := json.Marshal(body)
+	assert.Nil(t, err)
+	resp := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	proxy := buildCallDeployDataClusterPart().Proxy
+	assert.Equal(t, "http://10.0.0.1:3128", proxy.HttpProxy)
+	assert.Equal(t, "http://10.0.0.1:3128", proxy.HttpsProxy)
+	assert.Equal(t, []string{".example.com", "10.0.0.0/8"}, proxy.NoProxy)
+	assert.Equal(t, body.Proxy, getWizardClusterInfo().Proxy)
+
+	// proxy must be a http or https url
+	body.Proxy.HTTPSProxy = "10.0.0.1:3128"
+	bodyContent, err = json.Marshal(body)
+	assert.Nil(t, err)
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+	SetCluster(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSetClusterComponentConfig(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	assert.Nil(t, buildCallDeployDataClusterPart().ComponentConfig)
+
+	setCluster := func(body api.Cluster) int {
+		bodyContent, err := json.Marshal(body)
+		assert.Nil(t, err)
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+		SetCluster(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		ComponentConfig: &api.ComponentConfig{
+			APIServerExtraArgs:   map[string]string{"default-watch-cache-size": "200"},
+			SchedulerExtraArgs:   map[string]string{"v": "2"},
+			KubeletConfiguration: "maxPods: 200\n",
+			KubeProxyMode:        api.KubeProxyModeIPVS,
+		},
+	}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	componentConfig := buildCallDeployDataClusterPart().ComponentConfig
+	assert.Equal(t, map[string]string{"default-watch-cache-size": "200"}, componentConfig.ApiServerExtraArgs)
+	assert.Equal(t, map[string]string{"v": "2"}, componentConfig.SchedulerExtraArgs)
+	assert.Equal(t, "maxPods: 200\n", componentConfig.KubeletConfiguration)
+	assert.Equal(t, "ipvs", componentConfig.KubeProxyMode)
+	assert.Equal(t, body.ComponentConfig, getWizardClusterInfo().ComponentConfig)
+
+	// arguments are given without the leading "--"
+	body.ComponentConfig.APIServerExtraArgs = map[string]string{"--default-watch-cache-size": "200"}
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.ComponentConfig.APIServerExtraArgs = nil
+
+	// kubelet configuration must be a KubeletConfiguration mapping
+	body.ComponentConfig.KubeletConfiguration = "- maxPods"
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.ComponentConfig.KubeletConfiguration = "kind: KubeProxyConfiguration"
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.ComponentConfig.KubeletConfiguration = ""
+
+	// dual-stack requires ipvs
+	body.PodSubnets = []string{"10.120.0.0/16", "fd00:120::/64"}
+	body.ServiceSubnets = []string{"10.112.0.0/16", "fd00:112::/112"}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	body.ComponentConfig.KubeProxyMode = api.KubeProxyModeIPTables
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+}
+
+func TestSetClusterAuditAndEncryption(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	assert.Nil(t, buildCallDeployDataClusterPart().Audit)
+	assert.Nil(t, buildCallDeployDataClusterPart().Encryption)
+
+	setCluster := func(body api.Cluster) int {
+		bodyContent, err := json.Marshal(body)
+		assert.Nil(t, err)
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+		SetCluster(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		Audit:                    &api.Audit{Level: api.AuditLevelMetadata, MaxAge: 7},
+		Encryption:               &api.Encryption{Provider: api.EncryptionProviderSecretbox},
+	}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	clusterConfig := buildCallDeployDataClusterPart()
+	assert.Equal(t, "Metadata", clusterConfig.Audit.Level)
+	assert.Equal(t, uint32(7), clusterConfig.Audit.MaxAge)
+	assert.Equal(t, "secretbox", clusterConfig.Encryption.Provider)
+	assert.Equal(t, body.Audit, getWizardClusterInfo().Audit)
+	assert.Equal(t, body.Encryption, getWizardClusterInfo().Encryption)
+
+	// custom policy
+	body.Audit = &api.Audit{Policy: "apiVersion: audit.k8s.io/v1\nkind: Policy\nrules:\n- level: Metadata\n"}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	body.Audit.Policy = "kind: Policy\n"
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+
+	// either level or policy is required
+	body.Audit = &api.Audit{MaxAge: 7}
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.Audit = &api.Audit{Level: "All"}
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.Audit = nil
+
+	body.Encryption.Provider = "aesgcm"
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+}
+
+func TestSetClusterRegistries(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+
+	setCluster := func(body api.Cluster) int {
+		bodyContent, err := json.Marshal(body)
+		assert.Nil(t, err)
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+		SetCluster(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	body := api.Cluster{
+		Name:                     "cluster-name",
+		ShortName:                "short-name",
+		KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+		ImageRepository:          "registry.example.com:5000/kpaas",
+		Registries: []api.Registry{
+			{Server: "registry.example.com:5000", Username: "admin", Password: "password", Insecure: true},
+			{Server: "docker.io", Mirrors: []string{"https://mirror.example.com"}},
+		},
+	}
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+
+	clusterConfig := buildCallDeployDataClusterPart()
+	assert.Equal(t, "registry.example.com:5000/kpaas", clusterConfig.ImageRepository)
+	assert.Len(t, clusterConfig.Registries, 2)
+	assert.Equal(t, "password", clusterConfig.Registries[0].Password)
+	assert.True(t, clusterConfig.Registries[0].Insecure)
+	assert.Equal(t, []string{"https://mirror.example.com"}, clusterConfig.Registries[1].Mirrors)
+
+	// password is not returned, and kept if it is not sent again
+	registries := getWizardClusterInfo().Registries
+	assert.Equal(t, "", registries[0].Password)
+	assert.Equal(t, "password", getWizardConfiguration(true).Cluster.Registries[0].Password)
+	body.Registries = registries
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+	assert.Equal(t, "password", buildCallDeployDataClusterPart().Registries[0].Password)
+
+	// password of a new username is required
+	body.Registries[0].Username = "guest"
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+
+	// docker only supports mirrors of docker hub
+	body.Registries[0].Username = ""
+	body.Registries[0].Mirrors = []string{"https://mirror.example.com"}
+	assert.Equal(t, http.StatusBadRequest, setCluster(body))
+	body.ContainerRuntime = api.ContainerRuntimeContainerd
+	assert.Equal(t, http.StatusCreated, setCluster(body))
+}
+
 func TestGetCluster(t *testing.T) {
 
 	wizard.ClearCurrentWizardData()
@@ -260,3 +813,32 @@ func TestGetCluster2(t *testing.T) {
 	assert.Equal(t, []api.Label{{Key: "for-test", Value: "yes"}}, responseData.Labels)
 	assert.Equal(t, []api.Annotation{{Key: "comment", Value: "Icanspeakenglish"}}, responseData.Annotations)
 }
+
+func TestGetCluster3(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeKubeVIP
+	wizardData.Info.KubeAPIServerConnection.VIP = "192.168.31.100"
+	wizardData.Info.KubeAPIServerConnection.NetInterfaceName = "em0"
+	wizardData.Info.KubeAPIServerConnection.KubeVIPMode = wizard.KubeVIPModeARP
+
+	var err error
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/clusters", nil)
+
+	GetCluster(ctx)
+	resp.Flush()
+	assert.True(t, resp.Body.Len() > 0)
+	responseData := new(api.Cluster)
+	err = json.Unmarshal(resp.Body.Bytes(), responseData)
+	assert.Nil(t, err)
+
+	assert.Equal(t, api.KubeAPIServerConnectTypeKubeVIP, responseData.KubeAPIServerConnectType)
+	assert.Equal(t, "192.168.31.100", responseData.VIP)
+	assert.Equal(t, "em0", responseData.NetInterfaceName)
+	assert.Equal(t, api.KubeVIPModeARP, responseData.KubeVIPMode)
+	assert.Empty(t, responseData.BGPPeers)
+}
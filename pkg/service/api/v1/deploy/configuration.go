@@ -0,0 +1,258 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Service for exporting and importing the whole wizard configuration
+
+package deploy
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+// @ID ExportWizardConfiguration
+// @Summary Export wizard configuration
+// @Description Export cluster information, network options, nodes and certificate references. Passwords and private keys are only exported when includeSecrets is true.
+// @Tags wizard
+// @Produce application/json
+// @Produce application/x-yaml
+// @Param format query string false "output format" Enums(json, yaml) default(json)
+// @Param includeSecrets query bool false "export passwords and private keys" default(false)
+// @Success 200 {object} api.WizardConfiguration
+// @Failure 400 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/configurations [get]
+func ExportWizardConfiguration(c *gin.Context) {
+
+	format := api.ConfigurationFormat(c.DefaultQuery("format", string(api.ConfigurationFormatJSON)))
+	if err := validator.ValidateStringOptions(string(format), "format",
+		[]string{string(api.ConfigurationFormatJSON), string(api.ConfigurationFormatYAML)})(); err != nil {
+
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+
+	includeSecrets, err := strconv.ParseBool(c.DefaultQuery("includeSecrets", "false"))
+	if err != nil {
+		h.E(c, h.EParamsError.WithPayload("query parameter \"includeSecrets\" must be a boolean"))
+		return
+	}
+
+	log.ReqEntry(c).WithField("includeSecrets", includeSecrets).Info("export wizard configuration")
+	configuration := getWizardConfiguration(includeSecrets)
+
+	if format == api.ConfigurationFormatYAML {
+
+		content, err := yaml.Marshal(configuration)
+		if err != nil {
+			h.E(c, h.EUnknown.WithPayload(err.Error()))
+			return
+		}
+		c.Data(http.StatusOK, gin.MIMEYAML, content)
+		return
+	}
+
+	h.R(c, configuration)
+}
+
+// @ID ImportWizardConfiguration
+// @Summary Import wizard configuration
+// @Description Replace cluster information, network options and nodes with the uploaded configuration, both json and yaml are accepted. Checking and deployment results will be cleared.
+// @Tags wizard
+// @Accept application/json
+// @Accept application/x-yaml
+// @Produce application/json
+// @Param configuration body api.WizardConfiguration true "wizard configuration"
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/configurations [post]
+func ImportWizardConfiguration(c *gin.Context) {
+
+	logger := log.ReqEntry(c)
+
+	configuration, err := getWizardConfigurationRequestData(c)
+	if err != nil {
+		logger.Info(err)
+		h.E(c, err)
+		return
+	}
+
+	wizardData := wizard.GetCurrentWizard()
+	newCertificates, err := resolveConfigurationSecrets(configuration, wizardData)
+	if err != nil {
+		logger.Info(err)
+		h.E(c, err)
+		return
+	}
+
+	info := convertAPIClusterToModelClusterInfo(&configuration.Cluster)
+	nodes := make([]*wizard.Node, 0, len(configuration.Nodes))
+	for i := range configuration.Nodes {
+		nodes = append(nodes, convertAPINodeToModelNode(&configuration.Nodes[i]))
+	}
+
+	if err := wizardData.ReplaceConfiguration(info, configuration.NetworkOptions, configuration.IngressOptions, nodes); err != nil {
+		logger.Info(err)
+		h.E(c, err)
+		return
+	}
+
+	for name, content := range newCertificates {
+		sshcertificate.AddCertificate(name, content)
+	}
+
+	logger.WithField("nodes", len(nodes)).Warn("wizard configuration imported")
+	h.R(c, api.SuccessfulOption{Success: true})
+}
+
+func getWizardConfiguration(includeSecrets bool) *api.WizardConfiguration {
+
+	wizardData := wizard.GetCurrentWizard()
+	configuration := &api.WizardConfiguration{
+		Cluster:        *getWizardClusterInfo(),
+		NetworkOptions: getWizardNetworkOptions(),
+		IngressOptions: getWizardIngressOptions(),
+		Nodes:          make([]api.NodeData, 0, len(wizardData.Nodes)),
+		Certificates:   make([]api.ConfigurationCertificate, 0),
+	}
+
+	if includeSecrets {
+		configuration.Cluster.Registries = convertModelRegistriesToAPIRegistries(wizardData.Info.Registries, true)
+		switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
+		case wizard.KubeAPIServerConnectTypeKeepalived:
+			setAPIKeepalivedConnection(&configuration.Cluster, wizardData.Info.KubeAPIServerConnection, true)
+		case wizard.KubeAPIServerConnectTypeKubeVIP:
+			setAPIKubeVIPConnection(&configuration.Cluster, wizardData.Info.KubeAPIServerConnection, true)
+		}
+	}
+
+	certificateNames := make(map[string]bool)
+	for _, node := range wizardData.Nodes {
+
+		apiNode := convertModelNodeToAPINode(node)
+		if includeSecrets && node.AuthenticationType == wizard.AuthenticationTypePassword {
+			apiNode.Password = node.Password
+		}
+		configuration.Nodes = append(configuration.Nodes, *apiNode)
+
+		if node.AuthenticationType != wizard.AuthenticationTypePrivateKey || certificateNames[node.PrivateKeyName] {
+			continue
+		}
+		certificateNames[node.PrivateKeyName] = true
+
+		certificate := api.ConfigurationCertificate{Name: node.PrivateKeyName}
+		if includeSecrets {
+			certificate.Content = sshcertificate.GetPrivateKey(node.PrivateKeyName)
+		}
+		configuration.Certificates = append(configuration.Certificates, certificate)
+	}
+
+	return configuration
+}
+
+func getWizardConfigurationRequestData(c *gin.Context) (*api.WizardConfiguration, error) {
+
+	data, err := c.GetRawData()
+	if err != nil {
+		return nil, h.EBindBodyError.WithPayload(err.Error())
+	}
+
+	// json is a subset of yaml, so both formats can be decoded by the yaml decoder
+	configuration := new(api.WizardConfiguration)
+	if err := yaml.Unmarshal(data, configuration); err != nil {
+		return nil, h.EBindBodyError.WithPayload(err.Error())
+	}
+
+	if err := configuration.Validate(); err != nil {
+		return nil, h.EParamsError.WithPayload(err.Error())
+	}
+
+	for i := range configuration.Nodes {
+		if configuration.Nodes[i].DockerRootDirectory == "" {
+			configuration.Nodes[i].DockerRootDirectory = wizard.DefaultDockerRootDirectory
+		}
+	}
+
+	if configuration.Cluster.NodePortMinimum == 0 {
+		configuration.Cluster.NodePortMinimum = wizard.DefaultNodePortMinimum
+	}
+	if configuration.Cluster.NodePortMaximum == 0 {
+		configuration.Cluster.NodePortMaximum = wizard.DefaultNodePortMaximum
+	}
+	if configuration.Cluster.NodePortMinimum > configuration.Cluster.NodePortMaximum {
+		return nil, h.EParamsError.WithPayload("nodePortMinimum must be not larger than nodePortMaximum")
+	}
+
+	return configuration, nil
+}
+
+// resolveConfigurationSecrets fill the missing passwords from the current wizard nodes which have the same ip
+// and the current registries which have the same server and username,
+// and make sure every private key name refers to a stored or uploaded private key.
+// It returns the private keys which need to be stored.
+func resolveConfigurationSecrets(configuration *api.WizardConfiguration, wizardData *wizard.Cluster) (map[string]string, error) {
+
+	registries, err := resolveRegistryPasswords(configuration.Cluster.Registries, wizardData.Info.Registries)
+	if err != nil {
+		return nil, err
+	}
+	configuration.Cluster.Registries = registries
+	configuration.Cluster.BGPPeers = resolveBGPPeerPasswords(configuration.Cluster.BGPPeers, wizardData.Info.KubeAPIServerConnection)
+	configuration.Cluster.KeepalivedAuthPassword = resolveKeepalivedAuthPassword(&configuration.Cluster, wizardData.Info.KubeAPIServerConnection)
+
+	newCertificates := make(map[string]string)
+	for _, certificate := range configuration.Certificates {
+		if certificate.Content != "" {
+			newCertificates[certificate.Name] = certificate.Content
+		}
+	}
+
+	for i := range configuration.Nodes {
+
+		node := &configuration.Nodes[i]
+		switch node.AuthenticationType {
+		case api.AuthenticationTypePassword:
+			if node.Password != "" {
+				continue
+			}
+
+			existNode := wizardData.GetNode(node.IP)
+			if existNode == nil || existNode.AuthenticationType != wizard.AuthenticationTypePassword || existNode.Password == "" {
+				return nil, h.EParamsError.WithPayload(fmt.Sprintf("password of node %s is required", node.IP))
+			}
+			node.Password = existNode.Password
+
+		case api.AuthenticationTypePrivateKey:
+			if _, uploaded := newCertificates[node.PrivateKeyName]; uploaded {
+				continue
+			}
+
+			if sshcertificate.GetPrivateKey(node.PrivateKeyName) == "" {
+				return nil, h.EParamsError.WithPayload(fmt.Sprintf("private key %s of node %s not exist", node.PrivateKeyName, node.IP))
+			}
+		}
+	}
+
+	return newCertificates, nil
+}
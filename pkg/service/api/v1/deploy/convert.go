@@ -245,6 +245,8 @@ func convertDeployControllerCheckResultToModelCheckResult(status string) constan
 		return constant.CheckResultSuccessful
 	case string(constant.OperationStatusFailed):
 		return constant.CheckResultFailed
+	case string(constant.OperationStatusWarning):
+		return constant.CheckResultWarning
 	case string(constant.OperationStatusUnknown):
 		return constant.CheckResultDeployServiceUnknown
 	}
@@ -285,3 +287,559 @@ func convertDeployControllerDeployResultToModelDeployResult(status string) wizar
 
 	return wizard.DeployStatus(fmt.Sprintf("unknown(%s)", status))
 }
+
+func convertAPIClusterToModelClusterInfo(cluster *api.Cluster) *wizard.ClusterInfo {
+
+	info := wizard.NewClusterInfo()
+	info.Name = cluster.Name
+	info.ShortName = cluster.ShortName
+
+	switch cluster.KubeAPIServerConnectType {
+	case api.KubeAPIServerConnectTypeFirstMasterIP:
+		info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeFirstMasterIP
+	case api.KubeAPIServerConnectTypeKeepalived:
+		setModelKeepalivedConnection(info.KubeAPIServerConnection, cluster)
+	case api.KubeAPIServerConnectTypeLoadBalancer:
+		info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeLoadBalancer
+		info.KubeAPIServerConnection.LoadbalancerIP = cluster.LoadbalancerIP
+		info.KubeAPIServerConnection.LoadbalancerPort = cluster.LoadbalancerPort
+	case api.KubeAPIServerConnectTypeKubeVIP:
+		setModelKubeVIPConnection(info.KubeAPIServerConnection, cluster)
+	}
+
+	info.NodePortMinimum = cluster.NodePortMinimum
+	info.NodePortMaximum = cluster.NodePortMaximum
+	if len(cluster.PodSubnets) > 0 {
+		info.PodSubnets = cluster.PodSubnets
+		info.ServiceSubnets = cluster.ServiceSubnets
+	}
+	info.CheckThresholds = cluster.CheckThresholds
+	if cluster.ContainerRuntime != "" {
+		info.ContainerRuntime = cluster.ContainerRuntime
+	}
+	info.NodeInitProfile = cluster.NodeInitProfile
+	info.Proxy = cluster.Proxy
+	info.ImageRepository = cluster.ImageRepository
+	info.Registries = cluster.Registries
+	info.ComponentConfig = cluster.ComponentConfig
+	info.Audit = cluster.Audit
+	info.Encryption = cluster.Encryption
+
+	for _, label := range cluster.Labels {
+		info.Labels = append(info.Labels, &wizard.Label{
+			Key:   label.Key,
+			Value: label.Value,
+		})
+	}
+
+	for _, annotation := range cluster.Annotations {
+		info.Annotations = append(info.Annotations, &wizard.Annotation{
+			Key:   annotation.Key,
+			Value: annotation.Value,
+		})
+	}
+
+	return info
+}
+
+func convertAPINodeToModelNode(data *api.NodeData) *wizard.Node {
+
+	node := wizard.NewNode()
+	node.Name = data.Name
+	node.Description = data.Description
+	node.DockerRootDirectory = data.DockerRootDirectory
+	node.MachineRoles = data.MachineRoles
+
+	for _, label := range data.Labels {
+		node.Labels = append(node.Labels, &wizard.Label{
+			Key:   label.Key,
+			Value: label.Value,
+		})
+	}
+
+	for _, taint := range data.Taints {
+		node.Taints = append(node.Taints, &wizard.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: convertAPITaintEffectToModelTaintEffect(taint.Effect),
+		})
+	}
+
+	node.IP = data.IP
+	node.Port = data.Port
+	node.Username = data.Username
+	node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(data.AuthenticationType)
+	switch data.AuthenticationType {
+	case api.AuthenticationTypePassword:
+		node.Password = data.Password
+	case api.AuthenticationTypePrivateKey:
+		node.PrivateKeyName = data.PrivateKeyName
+	}
+
+	return node
+}
+
+func setModelKeepalivedConnection(connection *wizard.KubeAPIServerConnectionData, cluster *api.Cluster) {
+
+	connection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeKeepalived
+	connection.VIP = cluster.VIP
+	connection.NetInterfaceName = cluster.NetInterfaceName
+	connection.HaproxyPort = cluster.HaproxyPort
+	connection.HaproxyStatsPort = cluster.HaproxyStatsPort
+	connection.VirtualRouterID = cluster.VirtualRouterID
+	connection.KeepalivedAuthPassword = cluster.KeepalivedAuthPassword
+	connection.HealthCheckInterval = cluster.HealthCheckInterval
+}
+
+// setAPIKeepalivedConnection sets the keepalived connection to the cluster, auth password is cleared unless includeSecrets is true.
+func setAPIKeepalivedConnection(cluster *api.Cluster, connection *wizard.KubeAPIServerConnectionData, includeSecrets bool) {
+
+	cluster.KubeAPIServerConnectType = api.KubeAPIServerConnectTypeKeepalived
+	cluster.VIP = connection.VIP
+	cluster.NetInterfaceName = connection.NetInterfaceName
+	cluster.HaproxyPort = connection.HaproxyPort
+	cluster.HaproxyStatsPort = connection.HaproxyStatsPort
+	cluster.VirtualRouterID = connection.VirtualRouterID
+	cluster.HealthCheckInterval = connection.HealthCheckInterval
+	if includeSecrets {
+		cluster.KeepalivedAuthPassword = connection.KeepalivedAuthPassword
+	}
+}
+
+func setModelKubeVIPConnection(connection *wizard.KubeAPIServerConnectionData, cluster *api.Cluster) {
+
+	connection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeKubeVIP
+	connection.VIP = cluster.VIP
+	connection.NetInterfaceName = cluster.NetInterfaceName
+	connection.KubeVIPImage = cluster.KubeVIPImage
+	connection.KubeVIPMode = wizard.KubeVIPModeARP
+	if cluster.KubeVIPMode != api.KubeVIPModeBGP {
+		return
+	}
+
+	connection.KubeVIPMode = wizard.KubeVIPModeBGP
+	connection.BGPLocalAS = cluster.BGPLocalAS
+	connection.BGPRouterID = cluster.BGPRouterID
+	connection.BGPPeers = make([]*wizard.BGPPeer, 0, len(cluster.BGPPeers))
+	for _, peer := range cluster.BGPPeers {
+		connection.BGPPeers = append(connection.BGPPeers, &wizard.BGPPeer{
+			Address:  peer.Address,
+			AS:       peer.AS,
+			Password: peer.Password,
+		})
+	}
+}
+
+// setAPIKubeVIPConnection sets the kube-vip connection to the cluster, bgp peer passwords are cleared unless includeSecrets is true.
+func setAPIKubeVIPConnection(cluster *api.Cluster, connection *wizard.KubeAPIServerConnectionData, includeSecrets bool) {
+
+	cluster.KubeAPIServerConnectType = api.KubeAPIServerConnectTypeKubeVIP
+	cluster.VIP = connection.VIP
+	cluster.NetInterfaceName = connection.NetInterfaceName
+	cluster.KubeVIPImage = connection.KubeVIPImage
+	cluster.KubeVIPMode = api.KubeVIPModeARP
+	if connection.KubeVIPMode != wizard.KubeVIPModeBGP {
+		return
+	}
+
+	cluster.KubeVIPMode = api.KubeVIPModeBGP
+	cluster.BGPLocalAS = connection.BGPLocalAS
+	cluster.BGPRouterID = connection.BGPRouterID
+	cluster.BGPPeers = make([]api.BGPPeer, 0, len(connection.BGPPeers))
+	for _, peer := range connection.BGPPeers {
+		apiPeer := api.BGPPeer{
+			Address: peer.Address,
+			AS:      peer.AS,
+		}
+		if includeSecrets {
+			apiPeer.Password = peer.Password
+		}
+		cluster.BGPPeers = append(cluster.BGPPeers, apiPeer)
+	}
+}
+
+func convertModelKubeAPIServerConnectionToDeployController(connection *wizard.KubeAPIServerConnectionData) *protos.KubeAPIServerConnect {
+
+	connect := &protos.KubeAPIServerConnect{
+		Type: string(connection.KubeAPIServerConnectType),
+	}
+
+	switch connection.KubeAPIServerConnectType {
+	case wizard.KubeAPIServerConnectTypeKeepalived:
+		connect.Keepalived = &protos.Keepalived{
+			Vip:                 connection.VIP,
+			NetInterfaceName:    connection.NetInterfaceName,
+			HaproxyPort:         uint32(connection.HaproxyPort),
+			HaproxyStatsPort:    uint32(connection.HaproxyStatsPort),
+			VirtualRouterID:     uint32(connection.VirtualRouterID),
+			AuthPassword:        connection.KeepalivedAuthPassword,
+			HealthCheckInterval: uint32(connection.HealthCheckInterval),
+		}
+	case wizard.KubeAPIServerConnectTypeLoadBalancer:
+		connect.Loadbalancer = &protos.Loadbalancer{
+			Ip:   connection.LoadbalancerIP,
+			Port: uint32(connection.LoadbalancerPort),
+		}
+	case wizard.KubeAPIServerConnectTypeKubeVIP:
+		connect.KubeVIP = &protos.KubeVIP{
+			Vip:              connection.VIP,
+			NetInterfaceName: connection.NetInterfaceName,
+			Mode:             string(connection.KubeVIPMode),
+			LocalAS:          connection.BGPLocalAS,
+			RouterID:         connection.BGPRouterID,
+			Image:            connection.KubeVIPImage,
+		}
+		for _, peer := range connection.BGPPeers {
+			connect.KubeVIP.BgpPeers = append(connect.KubeVIP.BgpPeers, &protos.BGPPeer{
+				Address:  peer.Address,
+				As:       peer.AS,
+				Password: peer.Password,
+			})
+		}
+	}
+
+	return connect
+}
+
+func convertModelNetworkOptionsToDeployController(options *api.NetworkOptions) *protos.NetworkOptions {
+
+	if options == nil {
+		return nil
+	}
+
+	networkOptions := &protos.NetworkOptions{
+		NetworkType: string(options.NetworkType),
+	}
+
+	if options.NetworkType == api.NetworkTypeCalico && options.CalicoOptions != nil {
+		networkOptions.CalicoOptions = &protos.CalicoOptions{
+			CheckConnectivityAll: false,
+			EncapsulationMode:    string(options.CalicoOptions.EncapsulationMode),
+			VxlanPort:            uint32(options.CalicoOptions.VxlanPort),
+			InitialPodIPs:        options.CalicoOptions.InitialPodIPs,
+			VethMtu:              uint32(options.CalicoOptions.VethMtu),
+			IpDetectionMethod:    string(options.CalicoOptions.IPDetectionMethod),
+			IpDetectionInterface: options.CalicoOptions.IPDetectionInterface,
+		}
+	}
+
+	if options.NetworkType == api.NetworkTypeFlannel && options.FlannelOptions != nil {
+		networkOptions.FlannelOptions = &protos.FlannelOptions{
+			Backend:   string(options.FlannelOptions.Backend),
+			VxlanPort: uint32(options.FlannelOptions.VxlanPort),
+			Iface:     options.FlannelOptions.Iface,
+		}
+	}
+
+	if options.NetworkType == api.NetworkTypeCilium && options.CiliumOptions != nil {
+		networkOptions.CiliumOptions = &protos.CiliumOptions{
+			TunnelMode:        string(options.CiliumOptions.TunnelMode),
+			Mtu:               uint32(options.CiliumOptions.MTU),
+			NativeRoutingCIDR: options.CiliumOptions.NativeRoutingCIDR,
+		}
+	}
+
+	return networkOptions
+}
+
+func convertModelIngressOptionsToDeployController(options *api.IngressOptions) *protos.IngressOptions {
+
+	if options == nil {
+		return nil
+	}
+
+	return &protos.IngressOptions{
+		IngressType:           string(options.IngressType),
+		ExposeMode:            string(options.ExposeMode),
+		Replicas:              uint32(options.Replicas),
+		HttpNodePort:          uint32(options.HTTPNodePort),
+		HttpsNodePort:         uint32(options.HTTPSNodePort),
+		DefaultTLSCertificate: options.DefaultTLSCertificate,
+		ControllerImage:       options.ControllerImage,
+		ProxyImage:            options.ProxyImage,
+	}
+}
+
+// convertModelNodeInitProfileToDeployController returns nil if profile is nil, then node init uses the defaults.
+func convertModelNodeInitProfileToDeployController(profile *api.NodeInitProfile) *protos.NodeInitProfile {
+
+	if profile == nil {
+		return nil
+	}
+
+	return &protos.NodeInitProfile{
+		Timezone:       profile.Timezone,
+		NtpServers:     profile.NTPServers,
+		Sysctl:         profile.Sysctl,
+		FirewallMode:   string(profile.FirewallMode),
+		SelinuxMode:    string(profile.SELinuxMode),
+		SwapPolicy:     string(profile.SwapPolicy),
+		HostnamePolicy: string(profile.HostnamePolicy),
+		Items:          profile.Items,
+	}
+}
+
+// convertModelComponentConfigToDeployController returns nil if config is nil, then kubeadm defaults are used.
+func convertModelComponentConfigToDeployController(config *api.ComponentConfig) *protos.ComponentConfig {
+
+	if config == nil {
+		return nil
+	}
+
+	return &protos.ComponentConfig{
+		ApiServerExtraArgs:         config.APIServerExtraArgs,
+		ControllerManagerExtraArgs: config.ControllerManagerExtraArgs,
+		SchedulerExtraArgs:         config.SchedulerExtraArgs,
+		KubeletConfiguration:       config.KubeletConfiguration,
+		KubeProxyMode:              string(config.KubeProxyMode),
+	}
+}
+
+// convertModelAuditToDeployController returns nil if audit is nil, then audit is disabled.
+func convertModelAuditToDeployController(audit *api.Audit) *protos.AuditConfig {
+
+	if audit == nil {
+		return nil
+	}
+
+	return &protos.AuditConfig{
+		Level:     string(audit.Level),
+		Policy:    audit.Policy,
+		MaxAge:    audit.MaxAge,
+		MaxBackup: audit.MaxBackup,
+		MaxSize:   audit.MaxSize,
+	}
+}
+
+// convertModelEncryptionToDeployController returns nil if encryption is nil, then secrets are stored unencrypted.
+func convertModelEncryptionToDeployController(encryption *api.Encryption) *protos.EncryptionConfig {
+
+	if encryption == nil {
+		return nil
+	}
+
+	return &protos.EncryptionConfig{
+		Provider: string(encryption.Provider),
+	}
+}
+
+// convertModelProxyToDeployController returns nil if proxy is nil, then no proxy is used by nodes.
+func convertModelProxyToDeployController(proxy *api.Proxy) *protos.ProxyConfig {
+
+	if proxy == nil {
+		return nil
+	}
+
+	return &protos.ProxyConfig{
+		HttpProxy:  proxy.HTTPProxy,
+		HttpsProxy: proxy.HTTPSProxy,
+		NoProxy:    proxy.NoProxy,
+	}
+}
+
+// convertModelRegistriesToAPIRegistries returns a copy of the registries, passwords are cleared unless includeSecrets is true.
+func convertModelRegistriesToAPIRegistries(registries []api.Registry, includeSecrets bool) []api.Registry {
+
+	if len(registries) == 0 {
+		return nil
+	}
+
+	result := make([]api.Registry, 0, len(registries))
+	for _, registry := range registries {
+		if !includeSecrets {
+			registry.Password = ""
+		}
+		result = append(result, registry)
+	}
+
+	return result
+}
+
+func convertModelRegistriesToDeployController(registries []api.Registry) []*protos.RegistryConfig {
+
+	if len(registries) == 0 {
+		return nil
+	}
+
+	result := make([]*protos.RegistryConfig, 0, len(registries))
+	for _, registry := range registries {
+		result = append(result, &protos.RegistryConfig{
+			Server:   registry.Server,
+			Username: registry.Username,
+			Password: registry.Password,
+			CaBundle: registry.CABundle,
+			Insecure: registry.Insecure,
+			Mirrors:  registry.Mirrors,
+		})
+	}
+
+	return result
+}
+
+// convertModelCheckThresholdsToDeployController returns the thresholds keyed by role,
+// only the thresholds of the roles are returned if roles is not empty.
+func convertModelCheckThresholdsToDeployController(thresholds []api.CheckThreshold, roles []constant.MachineRole) map[string]*protos.NodeCheckThresholds {
+
+	if len(thresholds) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*protos.NodeCheckThresholds, len(thresholds))
+	for _, threshold := range thresholds {
+
+		if len(roles) > 0 && !containsMachineRole(roles, threshold.Role) {
+			continue
+		}
+
+		result[string(threshold.Role)] = &protos.NodeCheckThresholds{
+			Minimum:     convertModelCheckThresholdValuesToDeployController(threshold.Minimum),
+			Recommended: convertModelCheckThresholdValuesToDeployController(threshold.Recommended),
+		}
+	}
+
+	return result
+}
+
+func convertModelCheckThresholdValuesToDeployController(values api.CheckThresholdValues) *protos.CheckThreshold {
+
+	return &protos.CheckThreshold{
+		DockerVersion: values.DockerVersion,
+		KernelVersion: values.KernelVersion,
+		CpuCore:       values.CPUCore,
+		MemoryGiB:     values.MemoryGiB,
+		RootDiskGiB:   values.RootDiskGiB,
+	}
+}
+
+func containsMachineRole(roles []constant.MachineRole, role constant.MachineRole) bool {
+
+	for _, iterateRole := range roles {
+		if iterateRole == role {
+			return true
+		}
+	}
+	return false
+}
+
+func convertDeployControllerNodeConfigDiffsToAPI(diffs []*protos.NodeConfigDiff) []api.NodeConfigDiff {
+
+	nodes := make([]api.NodeConfigDiff, 0, len(diffs))
+	for _, diff := range diffs {
+		nodes = append(nodes, api.NodeConfigDiff{
+			NodeName: diff.GetNodeName(),
+			Changes:  convertDeployControllerNodeConfigChangesToAPI(diff.GetChanges()),
+			Drifts:   convertDeployControllerNodeConfigChangesToAPI(diff.GetDrifts()),
+			Error:    convertDeployControllerErrorToAPIError(diff.GetErr()),
+		})
+	}
+
+	return nodes
+}
+
+func convertDeployControllerNodeConfigChangesToAPI(changes []*protos.NodeConfigChange) []api.NodeConfigChange {
+
+	result := make([]api.NodeConfigChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, api.NodeConfigChange{
+			Kind:     api.NodeConfigKind(change.GetKind()),
+			Key:      change.GetKey(),
+			Action:   api.NodeConfigAction(change.GetAction()),
+			OldValue: change.GetOldValue(),
+			NewValue: change.GetNewValue(),
+		})
+	}
+
+	return result
+}
+
+func convertDeployControllerPlanToAPI(reply *protos.PlanDeployReply) *api.DeployPlanResponse {
+
+	response := &api.DeployPlanResponse{
+		Plan:      convertDeployControllerPlanTaskToAPI(reply.GetPlan()),
+		Files:     make([]api.DeployPlanFile, 0, len(reply.GetFiles())),
+		EtcdCerts: make([]api.EtcdCertPlan, 0, len(reply.GetEtcdCerts())),
+	}
+
+	for _, file := range reply.GetFiles() {
+		response.Files = append(response.Files, api.DeployPlanFile{
+			Node:    file.GetNode(),
+			Path:    file.GetPath(),
+			Content: file.GetContent(),
+		})
+	}
+
+	for _, cert := range reply.GetEtcdCerts() {
+		response.EtcdCerts = append(response.EtcdCerts, api.EtcdCertPlan{
+			Node:       cert.GetNode(),
+			CommonName: cert.GetCommonName(),
+			DNSNames:   cert.GetDnsNames(),
+			IPs:        cert.GetIps(),
+		})
+	}
+
+	return response
+}
+
+func convertDeployControllerPlanTaskToAPI(task *protos.DeployPlanTask) api.DeployPlanTask {
+
+	result := api.DeployPlanTask{
+		Name:     task.GetName(),
+		Type:     task.GetType(),
+		Priority: int(task.GetPriority()),
+		Actions:  make([]api.DeployPlanAction, 0, len(task.GetActions())),
+		SubTasks: make([]api.DeployPlanTask, 0, len(task.GetSubTasks())),
+	}
+
+	for _, action := range task.GetActions() {
+		result.Actions = append(result.Actions, api.DeployPlanAction{
+			Name: action.GetName(),
+			Type: action.GetType(),
+			Node: action.GetNode(),
+		})
+	}
+
+	for _, subTask := range task.GetSubTasks() {
+		result.SubTasks = append(result.SubTasks, convertDeployControllerPlanTaskToAPI(subTask))
+	}
+
+	return result
+}
+
+func convertAPIExecutionPoliciesToDeployController(policies map[string]api.ExecutionPolicy) map[string]*protos.ExecutionPolicy {
+
+	if len(policies) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*protos.ExecutionPolicy, len(policies))
+	for taskType, policy := range policies {
+		result[taskType] = &protos.ExecutionPolicy{
+			Concurrency:      int32(policy.Concurrency),
+			BatchSize:        int32(policy.BatchSize),
+			FailureThreshold: int32(policy.FailureThreshold),
+		}
+	}
+	return result
+}
+
+func convertDeployControllerTaskExecutionsToAPI(executions []*protos.TaskExecution) []api.TaskExecution {
+
+	result := make([]api.TaskExecution, 0, len(executions))
+	for _, execution := range executions {
+		result = append(result, api.TaskExecution{
+			TaskName: execution.GetTaskName(),
+			TaskType: execution.GetTaskType(),
+			Policy: api.ExecutionPolicy{
+				Concurrency:      int(execution.GetPolicy().GetConcurrency()),
+				BatchSize:        int(execution.GetPolicy().GetBatchSize()),
+				FailureThreshold: int(execution.GetPolicy().GetFailureThreshold()),
+			},
+			Total:                   int(execution.GetTotal()),
+			Succeeded:               int(execution.GetSucceeded()),
+			Failed:                  int(execution.GetFailed()),
+			Skipped:                 int(execution.GetSkipped()),
+			FailureThresholdReached: execution.GetFailureThresholdReached(),
+		})
+	}
+	return result
+}
@@ -221,6 +221,7 @@ func TestConvertDeployControllerCheckResultToModelCheckResult(t *testing.T) {
 	assert.Equal(t, constant.CheckResultRunning, convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusRunning)))
 	assert.Equal(t, constant.CheckResultSuccessful, convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusSuccessful)))
 	assert.Equal(t, constant.CheckResultFailed, convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusFailed)))
+	assert.Equal(t, constant.CheckResultWarning, convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusWarning)))
 	assert.Equal(t, constant.CheckResult(fmt.Sprintf("unknown(%s)", constant.OperationStatusAborted)), convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusAborted)))
 	assert.Equal(t, constant.CheckResultDeployServiceUnknown, convertDeployControllerCheckResultToModelCheckResult(string(constant.OperationStatusUnknown)))
 	assert.Equal(t, constant.CheckResult("unknown(OtherType)"), convertDeployControllerCheckResultToModelCheckResult("OtherType"))
@@ -276,3 +277,152 @@ func TestConvertDeployControllerErrorToFailureDetail(t *testing.T) {
 		assert.Equal(t, test.Want, convertDeployControllerErrorToFailureDetail(test.Input))
 	}
 }
+
+func TestConvertModelKubeAPIServerConnectionToDeployController(t *testing.T) {
+
+	assert.Equal(t,
+		&protos.KubeAPIServerConnect{Type: "firstMasterIP"},
+		convertModelKubeAPIServerConnectionToDeployController(&wizard.KubeAPIServerConnectionData{
+			KubeAPIServerConnectType: wizard.KubeAPIServerConnectTypeFirstMasterIP,
+		}),
+	)
+
+	assert.Equal(t,
+		&protos.KubeAPIServerConnect{
+			Type: "kubevip",
+			KubeVIP: &protos.KubeVIP{
+				Vip:              "192.168.1.100",
+				NetInterfaceName: "eth0",
+				Mode:             "bgp",
+				LocalAS:          65000,
+				RouterID:         "192.168.1.10",
+				BgpPeers:         []*protos.BGPPeer{{Address: "192.168.1.1", As: 65001}},
+			},
+		},
+		convertModelKubeAPIServerConnectionToDeployController(&wizard.KubeAPIServerConnectionData{
+			KubeAPIServerConnectType: wizard.KubeAPIServerConnectTypeKubeVIP,
+			VIP:                      "192.168.1.100",
+			NetInterfaceName:         "eth0",
+			KubeVIPMode:              wizard.KubeVIPModeBGP,
+			BGPLocalAS:               65000,
+			BGPRouterID:              "192.168.1.10",
+			BGPPeers:                 []*wizard.BGPPeer{{Address: "192.168.1.1", AS: 65001}},
+		}),
+	)
+
+	assert.Equal(t,
+		&protos.KubeAPIServerConnect{
+			Type: "keepalived",
+			Keepalived: &protos.Keepalived{
+				Vip:                 "192.168.1.100",
+				NetInterfaceName:    "eth0",
+				HaproxyPort:         8443,
+				HaproxyStatsPort:    9000,
+				VirtualRouterID:     51,
+				AuthPassword:        "secret",
+				HealthCheckInterval: 5,
+			},
+		},
+		convertModelKubeAPIServerConnectionToDeployController(&wizard.KubeAPIServerConnectionData{
+			KubeAPIServerConnectType: wizard.KubeAPIServerConnectTypeKeepalived,
+			VIP:                      "192.168.1.100",
+			NetInterfaceName:         "eth0",
+			HaproxyPort:              8443,
+			HaproxyStatsPort:         9000,
+			VirtualRouterID:          51,
+			KeepalivedAuthPassword:   "secret",
+			HealthCheckInterval:      5,
+		}),
+	)
+}
+
+func TestConvertModelNetworkOptionsToDeployController(t *testing.T) {
+
+	assert.Nil(t, convertModelNetworkOptionsToDeployController(nil))
+
+	options := convertModelNetworkOptionsToDeployController(&wizard.DefaultNetworkOptions)
+	assert.Equal(t, &protos.NetworkOptions{
+		NetworkType: string(api.NetworkTypeCalico),
+		CalicoOptions: &protos.CalicoOptions{
+			EncapsulationMode: api.EncapsulationVxlan,
+			VxlanPort:         api.DefaultVxlanPort,
+			InitialPodIPs:     constant.DefaultPodSubnet,
+			VethMtu:           1400,
+			IpDetectionMethod: api.IPDetectionMethodFromKubernetes,
+		},
+	}, options)
+
+	options = convertModelNetworkOptionsToDeployController(&api.NetworkOptions{
+		NetworkType:    api.NetworkTypeFlannel,
+		FlannelOptions: &api.FlannelOptions{Backend: api.FlannelBackendVxlan, VxlanPort: 8472, Iface: "eth1"},
+	})
+	assert.Equal(t, &protos.NetworkOptions{
+		NetworkType:    string(api.NetworkTypeFlannel),
+		FlannelOptions: &protos.FlannelOptions{Backend: "vxlan", VxlanPort: 8472, Iface: "eth1"},
+	}, options)
+
+	options = convertModelNetworkOptionsToDeployController(&api.NetworkOptions{
+		NetworkType:   api.NetworkTypeCilium,
+		CiliumOptions: &api.CiliumOptions{TunnelMode: api.CiliumTunnelModeDisabled, MTU: 1450, NativeRoutingCIDR: "10.0.0.0/8"},
+	})
+	assert.Equal(t, &protos.NetworkOptions{
+		NetworkType:   string(api.NetworkTypeCilium),
+		CiliumOptions: &protos.CiliumOptions{TunnelMode: "disabled", Mtu: 1450, NativeRoutingCIDR: "10.0.0.0/8"},
+	}, options)
+}
+
+func TestConvertModelIngressOptionsToDeployController(t *testing.T) {
+
+	assert.Nil(t, convertModelIngressOptionsToDeployController(nil))
+
+	options := convertModelIngressOptionsToDeployController(&wizard.DefaultIngressOptions)
+	assert.Equal(t, &protos.IngressOptions{
+		IngressType: string(api.IngressTypeContour),
+		ExposeMode:  string(api.IngressExposeModeHostNetwork),
+	}, options)
+
+	options = convertModelIngressOptionsToDeployController(&api.IngressOptions{
+		IngressType:           api.IngressTypeNginx,
+		ExposeMode:            api.IngressExposeModeNodePort,
+		Replicas:              3,
+		HTTPNodePort:          30080,
+		HTTPSNodePort:         30443,
+		DefaultTLSCertificate: "kube-system/default-tls",
+		ControllerImage:       "example.com/nginx-ingress-controller:0.30.0",
+	})
+	assert.Equal(t, &protos.IngressOptions{
+		IngressType:           "nginx",
+		ExposeMode:            "nodePort",
+		Replicas:              3,
+		HttpNodePort:          30080,
+		HttpsNodePort:         30443,
+		DefaultTLSCertificate: "kube-system/default-tls",
+		ControllerImage:       "example.com/nginx-ingress-controller:0.30.0",
+	}, options)
+}
+
+func TestConvertModelCheckThresholdsToDeployController(t *testing.T) {
+
+	assert.Nil(t, convertModelCheckThresholdsToDeployController(nil, nil))
+
+	thresholds := []api.CheckThreshold{
+		{
+			Role:        constant.MachineRoleWorker,
+			Minimum:     api.CheckThresholdValues{CPUCore: 2, MemoryGiB: 4},
+			Recommended: api.CheckThresholdValues{KernelVersion: "4.19.46"},
+		},
+		{
+			Role:    constant.MachineRoleEtcd,
+			Minimum: api.CheckThresholdValues{RootDiskGiB: 20},
+		},
+	}
+
+	assert.Equal(t, map[string]*protos.NodeCheckThresholds{
+		"worker": {
+			Minimum:     &protos.CheckThreshold{CpuCore: 2, MemoryGiB: 4},
+			Recommended: &protos.CheckThreshold{KernelVersion: "4.19.46"},
+		},
+	}, convertModelCheckThresholdsToDeployController(thresholds, []constant.MachineRole{constant.MachineRoleWorker}))
+
+	assert.Len(t, convertModelCheckThresholdsToDeployController(thresholds, nil), 2)
+}
@@ -16,7 +16,6 @@ package deploy
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -24,26 +23,33 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
-	"github.com/kpaas-io/kpaas/pkg/service/api/v1/helm"
 	"github.com/kpaas-io/kpaas/pkg/service/config"
 	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
-	"github.com/kpaas-io/kpaas/pkg/service/model/common"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 // @ID LaunchDeployment
 // @Summary Launch deployment
 // @Description Launch deployment
 // @Tags deploy
+// @Accept application/json
 // @Produce application/json
+// @Param deployRequest body api.DeployRequest false "Execution policies of the deployment"
 // @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
 // @Failure 404 {object} h.AppErr
 // @Router /api/v1/deploy/wizard/deploys [post]
 func Deploy(c *gin.Context) {
 
+	requestData, hasError := getDeployRequestData(c)
+	if hasError {
+		return
+	}
+
 	wizardData := wizard.GetCurrentWizard()
 	if len(wizardData.Nodes) == 0 {
 		h.E(c, h.ENotFound.WithPayload("No node information, node list is empty, please add node information"))
@@ -81,7 +87,10 @@ func Deploy(c *gin.Context) {
 	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
 	defer cancel()
 
-	resp, err := client.Deploy(grpcContext, getCallDeployData())
+	deployData := getCallDeployData()
+	deployData.ExecutionPolicies = convertAPIExecutionPoliciesToDeployController(requestData.ExecutionPolicies)
+
+	resp, err := client.Deploy(grpcContext, deployData)
 	if err != nil {
 		h.E(c, h.EDeployControllerError.WithPayload(err))
 		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
@@ -94,12 +103,65 @@ func Deploy(c *gin.Context) {
 		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
 	}
 
-	go deployNetwork()
 	go listenDeploymentData()
 
 	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
 }
 
+// @ID PlanDeployment
+// @Summary Preview deployment plan
+// @Description Split the deployment into sub tasks and actions and render the files put to nodes, nothing is executed on nodes
+// @Tags deploy
+// @Accept application/json
+// @Produce application/json
+// @Param deployRequest body api.DeployRequest false "Execution policies of the deployment"
+// @Success 201 {object} api.DeployPlanResponse
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/deploys/plan [post]
+func PlanDeploy(c *gin.Context) {
+
+	requestData, hasError := getDeployRequestData(c)
+	if hasError {
+		return
+	}
+
+	wizardData := wizard.GetCurrentWizard()
+	if len(wizardData.Nodes) == 0 {
+		h.E(c, h.ENotFound.WithPayload("No node information, node list is empty, please add node information"))
+		return
+	}
+
+	if !checkClusterConfiguration() {
+		h.E(c, h.EStatusError.WithPayload("current cluster configuration check is not passed"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	deployData := getCallDeployData()
+	deployData.ExecutionPolicies = convertAPIExecutionPoliciesToDeployController(requestData.ExecutionPolicies)
+
+	resp, err := client.PlanDeploy(grpcContext, deployData)
+	if err != nil {
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	if resp.GetErr() != nil {
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+		h.E(c, h.EDeployControllerError.WithPayload(convertDeployControllerErrorToAPIError(resp.GetErr())))
+		return
+	}
+
+	h.R(c, convertDeployControllerPlanToAPI(resp))
+}
+
 // @ID GetDeploymentReport
 // @Summary Get the result of deployment
 // @Description Get the result of the deployment
@@ -115,11 +177,36 @@ func GetDeployReport(c *gin.Context) {
 		DeployItems:         *nodeList,
 		DeployClusterStatus: convertModelDeployClusterStatusToAPIDeployClusterStatus(wizardData.DeployClusterStatus),
 		DeployClusterError:  convertModelErrorToAPIError(wizardData.DeployClusterError),
+		VerifyItems:         getWizardVerifyItems(),
+		TaskExecutions:      wizardData.GetTaskExecutions(),
+	}
+	if responseData.TaskExecutions == nil {
+		responseData.TaskExecutions = []api.TaskExecution{}
 	}
 
 	h.R(c, responseData)
 }
 
+// getDeployRequestData reads the optional request body, the deployment uses the default execution policies if it is empty.
+func getDeployRequestData(c *gin.Context) (requestData *api.DeployRequest, hasError bool) {
+
+	requestData = new(api.DeployRequest)
+	logger := log.ReqEntry(c)
+
+	if c.Request.Body == nil || c.Request.ContentLength == 0 {
+		return requestData, false
+	}
+
+	if err := validator.Params(c, requestData); err != nil {
+		logger.Info(err)
+		h.E(c, err)
+		return nil, true
+	}
+
+	logger.WithField("data", requestData)
+	return requestData, false
+}
+
 func getCallDeployData() *protos.DeployRequest {
 
 	return &protos.DeployRequest{
@@ -169,31 +256,26 @@ func buildCallDeployDataClusterPart() (clusterConfig *protos.ClusterConfig) {
 
 	wizardData := wizard.GetCurrentWizard()
 	clusterConfig = &protos.ClusterConfig{
-		ClusterName: wizardData.Info.ShortName,
-		KubeAPIServerConnect: &protos.KubeAPIServerConnect{
-			Type:         string(wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType),
-			Keepalived:   nil,
-			Loadbalancer: nil,
-		},
+		ClusterName:          wizardData.Info.ShortName,
+		KubeAPIServerConnect: convertModelKubeAPIServerConnectionToDeployController(wizardData.Info.KubeAPIServerConnection),
 		NodePortRange: &protos.NodePortRange{
 			From: uint32(wizardData.Info.NodePortMinimum),
 			To:   uint32(wizardData.Info.NodePortMaximum),
 		},
-		NodeLabels:      make(map[string]string),
-		NodeAnnotations: make(map[string]string),
-	}
-
-	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
-	case wizard.KubeAPIServerConnectTypeKeepalived:
-		clusterConfig.KubeAPIServerConnect.Keepalived = &protos.Keepalived{
-			Vip:              wizardData.Info.KubeAPIServerConnection.VIP,
-			NetInterfaceName: wizardData.Info.KubeAPIServerConnection.NetInterfaceName,
-		}
-	case wizard.KubeAPIServerConnectTypeLoadBalancer:
-		clusterConfig.KubeAPIServerConnect.Loadbalancer = &protos.Loadbalancer{
-			Ip:   wizardData.Info.KubeAPIServerConnection.LoadbalancerIP,
-			Port: uint32(wizardData.Info.KubeAPIServerConnection.LoadbalancerPort),
-		}
+		NodeLabels:       make(map[string]string),
+		NodeAnnotations:  make(map[string]string),
+		NetworkOptions:   convertModelNetworkOptionsToDeployController(wizardData.GetNetworkOptions()),
+		IngressOptions:   convertModelIngressOptionsToDeployController(wizardData.GetIngressOptions()),
+		PodSubnets:       wizardData.Info.PodSubnets,
+		ServiceSubnets:   wizardData.Info.ServiceSubnets,
+		ContainerRuntime: string(wizardData.Info.ContainerRuntime),
+		NodeInitProfile:  convertModelNodeInitProfileToDeployController(wizardData.Info.NodeInitProfile),
+		Proxy:            convertModelProxyToDeployController(wizardData.Info.Proxy),
+		ImageRepository:  wizardData.Info.ImageRepository,
+		Registries:       convertModelRegistriesToDeployController(wizardData.Info.Registries),
+		ComponentConfig:  convertModelComponentConfigToDeployController(wizardData.Info.ComponentConfig),
+		Audit:            convertModelAuditToDeployController(wizardData.Info.Audit),
+		Encryption:       convertModelEncryptionToDeployController(wizardData.Info.Encryption),
 	}
 
 	for _, label := range wizardData.Info.Labels {
@@ -263,6 +345,12 @@ func refreshDeployResultOneTime() {
 			failureDetail)
 	}
 
+	wizardData.SetTaskExecutions(convertDeployControllerTaskExecutionsToAPI(resp.GetExecutions()))
+
+	if len(resp.GetVerifyItems()) > 0 {
+		wizardData.SetVerifyItems(convertDeployControllerVerifyItemsToModelCheckItems(resp.GetVerifyItems()))
+	}
+
 	switch wizardData.DeployClusterStatus {
 	case wizard.DeployClusterStatusSuccessful, wizard.DeployClusterStatusWorkedButHaveError:
 
@@ -271,6 +359,29 @@ func refreshDeployResultOneTime() {
 
 }
 
+func convertDeployControllerVerifyItemsToModelCheckItems(items []*protos.ItemCheckResult) []*wizard.CheckItem {
+
+	checkItems := make([]*wizard.CheckItem, 0, len(items))
+	for _, item := range items {
+
+		failureDetail := convertDeployControllerErrorToFailureDetail(item.GetErr())
+		if failureDetail != nil && item.Logs != "" {
+			var setLogContentError error
+			failureDetail.LogId, setLogContentError = wizard.SetLogByString(item.Logs)
+			if setLogContentError != nil {
+				logrus.Errorf("Store verify error log error, %s", setLogContentError)
+			}
+		}
+
+		checkItems = append(checkItems, &wizard.CheckItem{
+			ItemName:    getItemNameFromDeployControllerCheckItem(item.GetItem()),
+			CheckResult: convertDeployControllerCheckResultToModelCheckResult(item.GetStatus()),
+			Error:       failureDetail,
+		})
+	}
+	return checkItems
+}
+
 func computeClusterDeployStatus(resp *protos.GetDeployResultReply) wizard.DeployClusterStatus {
 
 	status := convertDeployControllerDeployClusterStatusToModelDeployClusterStatus(resp.GetStatus())
@@ -329,85 +440,3 @@ func fetchKubeConfigContent() {
 	kubeConfig := string(fetchResponse.GetKubeConfig())
 	wizardData.KubeConfig = &kubeConfig
 }
-
-func deployNetwork() {
-	wizardData := wizard.GetCurrentWizard()
-	networkOptions := wizardData.GetNetworkOptions()
-
-	for _, node := range wizardData.Nodes {
-		node.SetDeployResult(
-			constant.DeployItemNetwork, wizard.DeployStatusPending, nil)
-	}
-	logrus.Debugf("waiting for kubernetes to be ready and kubeconfig")
-	// TODO: add timeout here?
-	for {
-		// abort deploying of network components if deploying of cluster failed.
-		if wizardData.GetDeployClusterStatus() == wizard.DeployClusterStatusFailed {
-			logrus.Errorf("deploy cluster failed, abort deploying of network components")
-			for _, node := range wizardData.Nodes {
-				node.SetDeployResult(
-					constant.DeployItemNetwork, wizard.DeployStatusAborted, nil,
-				)
-			}
-			return
-		}
-		if wizardData.GetDeployClusterStatus() ==
-			wizard.DeployClusterStatusSuccessful ||
-			wizardData.GetDeployClusterStatus() ==
-				wizard.DeployClusterStatusWorkedButHaveError {
-			logrus.Debugf("deploying of cluster done, start deploying network...")
-			break
-		}
-		time.Sleep(5 * time.Second)
-	}
-
-	// install calico by helm.
-	if networkOptions.NetworkType == api.NetworkTypeCalico {
-
-		err := installCalicoNetwork(networkOptions.CalicoOptions, wizardData.Info.ShortName)
-		if err != nil {
-			for _, node := range wizardData.Nodes {
-				node.SetDeployResult(
-					constant.DeployItemNetwork, wizard.DeployStatusFailed,
-					&common.FailureDetail{
-						Reason:     "failed to install calico by helm",
-						Detail:     fmt.Sprintf("error %v happened in installing calico by helm", err),
-						FixMethods: "check help docs of helm about the error message",
-					})
-			}
-			return
-		}
-		for _, node := range wizardData.Nodes {
-			// TODO: check pod status of network components.
-			node.SetDeployResult(
-				constant.DeployItemNetwork, wizard.DeployStatusSuccessful, nil)
-		}
-	}
-}
-
-func installCalicoNetwork(options *api.CalicoOptions, clusterName string) error {
-	calicoValues := api.HelmValues{}
-	if options != nil {
-		calicoValues["encap_mode"] = string(options.EncapsulationMode)
-		calicoValues["vxlan_port"] = string(options.VxlanPort)
-		calicoValues["ipv4_pool"] = options.InitialPodIPs
-		calicoValues["ip_detection.method"] = string(options.IPDetectionMethod)
-		if options.IPDetectionMethod == api.IPDetectionMethodInterface {
-			calicoValues["ip_detection.interface"] = options.IPDetectionInterface
-		}
-		calicoValues["veth_mtu"] = options.VethMtu
-	}
-	_, err := helm.RunInstallReleaseAction(nil, &api.HelmRelease{
-		Cluster:   clusterName,
-		Name:      "calico",
-		Namespace: "kube-system",
-		// TODO: chart path here can be modified
-		Chart:  "charts/calico",
-		Values: calicoValues,
-	})
-	if err != nil {
-		logrus.WithError(err).Errorf("error happened in running helm release action")
-		return err
-	}
-	return nil
-}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +28,8 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -119,6 +122,123 @@ func TestDeploy3(t *testing.T) {
 	assert.True(t, responseData.Success)
 }
 
+func TestDeployWithExecutionPolicies(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	tests := []struct {
+		Input      string
+		WantStatus int
+		WantMsg    string
+	}{
+		{
+			Input:      `{"executionPolicies":{"DeployWorker":{"concurrency":2,"batchSize":5,"failureThreshold":20}}}`,
+			WantStatus: http.StatusCreated,
+		},
+		{
+			Input:      `"policies"`,
+			WantStatus: http.StatusBadRequest,
+			WantMsg:    h.EBindBodyError.Msg,
+		},
+		{
+			Input:      `{"executionPolicies":{"DeployWorker":{"failureThreshold":101}}}`,
+			WantStatus: http.StatusBadRequest,
+			WantMsg:    h.EParamsError.Msg,
+		},
+		{
+			Input:      `{"executionPolicies":{"NodeInit":{"concurrency":-1}}}`,
+			WantStatus: http.StatusBadRequest,
+			WantMsg:    h.EParamsError.Msg,
+		},
+	}
+
+	for _, test := range tests {
+
+		wizard.ClearCurrentWizardData()
+		wizardData := wizard.GetCurrentWizard()
+		wizardData.ClusterCheckResult = constant.CheckResultSuccessful
+		node := wizard.NewNode()
+		node.Name = "master1"
+		node.MachineRoles = []constant.MachineRole{
+			constant.MachineRoleEtcd,
+			constant.MachineRoleMaster,
+			constant.MachineRoleWorker,
+			constant.MachineRoleIngress,
+		}
+		node.CheckReport = &wizard.CheckReport{
+			CheckResult: constant.CheckResultSuccessful,
+		}
+		wizardData.Nodes = []*wizard.Node{node}
+
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/deploys", strings.NewReader(test.Input))
+
+		Deploy(ctx)
+		resp.Flush()
+		assert.Equal(t, test.WantStatus, resp.Code, test.Input)
+		if test.WantMsg != "" {
+			responseData := new(h.AppErr)
+			assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+			assert.Equal(t, test.WantMsg, responseData.Msg, test.Input)
+			assert.Equal(t, wizard.DeployClusterStatusPending, wizardData.GetDeployClusterStatus())
+		}
+	}
+}
+
+func TestConvertAPIExecutionPoliciesToDeployController(t *testing.T) {
+
+	assert.Nil(t, convertAPIExecutionPoliciesToDeployController(nil))
+	assert.Equal(t, map[string]*protos.ExecutionPolicy{
+		"DeployWorker": {Concurrency: 2, BatchSize: 5, FailureThreshold: 20},
+	}, convertAPIExecutionPoliciesToDeployController(map[string]api.ExecutionPolicy{
+		"DeployWorker": {Concurrency: 2, BatchSize: 5, FailureThreshold: 20},
+	}))
+}
+
+func TestPlanDeploy(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	plan := func() *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/deploys/plan", nil)
+		PlanDeploy(ctx)
+		resp.Flush()
+		return resp
+	}
+
+	// no node
+	assert.Equal(t, http.StatusNotFound, plan().Code)
+
+	// plan is available before nodes are checked
+	wizardData := wizard.GetCurrentWizard()
+	node := wizard.NewNode()
+	node.Name = "master1"
+	node.MachineRoles = []constant.MachineRole{
+		constant.MachineRoleEtcd,
+		constant.MachineRoleMaster,
+		constant.MachineRoleWorker,
+		constant.MachineRoleIngress,
+	}
+	wizardData.Nodes = []*wizard.Node{
+		node,
+	}
+
+	resp := plan()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	responseData := new(api.DeployPlanResponse)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, "deploy", responseData.Plan.Name)
+	assert.Equal(t, 1, len(responseData.Plan.Actions))
+	assert.Equal(t, "master1", responseData.Plan.Actions[0].Node)
+	assert.Equal(t, wizard.DeployClusterStatusPending, wizardData.DeployClusterStatus)
+}
+
 func TestGetDeployReport(t *testing.T) {
 
 	wizard.ClearCurrentWizardData()
@@ -139,6 +259,12 @@ func TestGetDeployReport(t *testing.T) {
 		},
 	}
 	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	wizardData.VerifyItems = []*wizard.CheckItem{
+		{
+			ItemName:    "nodes-ready",
+			CheckResult: constant.CheckResultSuccessful,
+		},
+	}
 
 	var err error
 	resp := httptest.NewRecorder()
@@ -176,6 +302,47 @@ func TestGetDeployReport(t *testing.T) {
 		},
 	}, sortRoles(responseData.DeployItems))
 	assert.Nil(t, responseData.DeployClusterError)
+	assert.Equal(t, []api.CheckingItem{
+		{
+			CheckingPoint: "nodes-ready",
+			Result:        constant.CheckResultSuccessful,
+		},
+	}, responseData.VerifyItems)
+}
+
+func TestRefreshDeployResultOneTime(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	node := wizard.NewNode()
+	node.Name = "master1"
+	node.MachineRoles = []constant.MachineRole{constant.MachineRoleMaster, constant.MachineRoleEtcd}
+	wizardData.Nodes = []*wizard.Node{node}
+
+	refreshDeployResultOneTime()
+
+	assert.Equal(t, wizard.DeployClusterStatusSuccessful, wizardData.DeployClusterStatus)
+	verifyItems := wizardData.GetVerifyItems()
+	assert.Len(t, verifyItems, 2)
+	assert.Equal(t, "nodes-ready（all nodes are ready）", verifyItems[0].ItemName)
+	assert.Equal(t, constant.CheckResultSuccessful, verifyItems[0].CheckResult)
+	assert.Nil(t, verifyItems[0].Error)
+	assert.Equal(t, constant.CheckResultFailed, verifyItems[1].CheckResult)
+	assert.Equal(t, "verify dns failed", verifyItems[1].Error.Reason)
+
+	assert.Equal(t, []api.TaskExecution{
+		{
+			TaskName:  "deploy-worker",
+			TaskType:  "DeployWorker",
+			Policy:    api.ExecutionPolicy{Concurrency: 2, BatchSize: 2, FailureThreshold: 50},
+			Total:     1,
+			Succeeded: 1,
+		},
+	}, wizardData.GetTaskExecutions())
+
+	wizardData.ClearClusterDeployData()
+	assert.Nil(t, wizardData.GetVerifyItems())
+	assert.Nil(t, wizardData.GetTaskExecutions())
 }
 
 func TestFetchKubeConfigContent(t *testing.T) {
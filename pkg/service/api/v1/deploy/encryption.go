@@ -0,0 +1,106 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/config"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+)
+
+// @ID RotateEncryptionKey
+// @Summary Rotate encryption key
+// @Description Start to replace the key which encrypts secrets at rest on all masters and rewrite secrets by the new key, kube-apiserver on masters are restarted in turn, the result is got by GET of the same path
+// @Tags encryption
+// @Produce application/json
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/encryptionkeys [post]
+func RotateEncryptionKey(c *gin.Context) {
+
+	wizardData := wizard.GetCurrentWizard()
+	if wizardData.DeployClusterStatus != wizard.DeployClusterStatusSuccessful &&
+		wizardData.DeployClusterStatus != wizard.DeployClusterStatusWorkedButHaveError {
+		h.E(c, h.EStatusError.WithPayload("Current cluster has not been deployed yet"))
+		return
+	}
+
+	if wizardData.Info.Encryption == nil {
+		h.E(c, h.EStatusError.WithPayload("encryption at rest is not enabled"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	// the rotation is launched in background, the request returns once it is accepted
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.RotateEncryptionKey(grpcContext, &protos.RotateEncryptionKeyRequest{
+		NodeConfigs:   buildCallDeployDataNodesPart(),
+		ClusterConfig: buildCallDeployDataClusterPart(),
+	})
+	if err != nil {
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	if resp.GetErr() != nil {
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+		h.E(c, h.EDeployControllerError.WithPayload(convertDeployControllerErrorToAPIError(resp.GetErr())))
+		return
+	}
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
+}
+
+// @ID GetEncryptionKeyRotationResult
+// @Summary Get the result of encryption key rotation
+// @Description Get the status of the latest encryption key rotation
+// @Tags encryption
+// @Produce application/json
+// @Success 200 {object} api.GetEncryptionKeyRotationResponse
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/encryptionkeys [get]
+func GetEncryptionKeyRotationResult(c *gin.Context) {
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.GetRotateEncryptionKeyResult(grpcContext, &protos.GetRotateEncryptionKeyResultRequest{})
+	if err != nil {
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	h.R(c, api.GetEncryptionKeyRotationResponse{
+		Status: constant.OperationStatus(resp.GetStatus()),
+		Error:  convertDeployControllerErrorToAPIError(resp.GetErr()),
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+func TestRotateEncryptionKey(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	rotate := func() int {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/encryptionkeys", nil)
+		RotateEncryptionKey(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	// cluster is not deployed
+	assert.Equal(t, http.StatusBadRequest, rotate())
+
+	// encryption is not enabled
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	assert.Equal(t, http.StatusBadRequest, rotate())
+
+	wizardData.Info.Encryption = &api.Encryption{Provider: api.EncryptionProviderAESCBC}
+	assert.Equal(t, http.StatusCreated, rotate())
+}
+
+func TestGetEncryptionKeyRotationResult(t *testing.T) {
+
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	resp := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/encryptionkeys", nil)
+	GetEncryptionKeyRotationResult(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	result := new(api.GetEncryptionKeyRotationResponse)
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), result))
+	assert.Equal(t, constant.OperationStatusSuccessful, result.Status)
+	assert.Nil(t, result.Error)
+}
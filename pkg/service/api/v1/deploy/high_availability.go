@@ -0,0 +1,76 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/config"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+)
+
+// @ID ReconfigureHA
+// @Summary Reconfigure haproxy and keepalived of deployed cluster
+// @Description Regenerate haproxy and keepalived config on all masters by the current wizard nodes and cluster, and reload them. It's used after masters are added or removed, or keepalived parameters are changed
+// @Tags cluster
+// @Produce application/json
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/haconfigs [post]
+func ReconfigureHA(c *gin.Context) {
+
+	wizardData := wizard.GetCurrentWizard()
+	if wizardData.DeployClusterStatus != wizard.DeployClusterStatusSuccessful &&
+		wizardData.DeployClusterStatus != wizard.DeployClusterStatusWorkedButHaveError {
+		h.E(c, h.EStatusError.WithPayload("Current cluster has not been deployed yet"))
+		return
+	}
+
+	if wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType != wizard.KubeAPIServerConnectTypeKeepalived {
+		h.E(c, h.EStatusError.WithPayload("haproxy and keepalived are only used by keepalived connect type"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.ReconfigureHA(grpcContext, &protos.ReconfigureHARequest{
+		NodeConfigs:   buildCallDeployDataNodesPart(),
+		ClusterConfig: buildCallDeployDataClusterPart(),
+	})
+	if err != nil {
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	if resp.GetErr() != nil {
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+		h.E(c, h.EDeployControllerError.WithPayload(convertDeployControllerErrorToAPIError(resp.GetErr())))
+		return
+	}
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetPassed()})
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+func TestReconfigureHA(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	reconfigure := func() int {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/haconfigs", nil)
+		ReconfigureHA(ctx)
+		resp.Flush()
+		return resp.Code
+	}
+
+	// cluster is not deployed
+	assert.Equal(t, http.StatusBadRequest, reconfigure())
+
+	// haproxy and keepalived are not used
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	assert.Equal(t, http.StatusBadRequest, reconfigure())
+
+	wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType = wizard.KubeAPIServerConnectTypeKeepalived
+	wizardData.Info.KubeAPIServerConnection.VIP = "192.168.31.200"
+	wizardData.Info.KubeAPIServerConnection.NetInterfaceName = "eth0"
+	assert.Equal(t, http.StatusCreated, reconfigure())
+}
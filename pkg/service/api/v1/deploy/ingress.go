@@ -0,0 +1,66 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+// SetIngress set and store ingress options
+// @ID SetIngress
+// @Summary set ingress options
+// @Description set ingress controller options, the controller is deployed on ingress nodes
+// @Tags ingress
+// @Accept application/json
+// @Produce application/json
+// @Param ingressOptions body api.IngressOptions true "options of ingress controller in the cluster"
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/ingresses [post]
+func SetIngress(c *gin.Context) {
+	logger := log.ReqEntry(c)
+	ingressOptions := &api.IngressOptions{}
+	if err := validator.Params(c, ingressOptions); err != nil {
+		logger.WithError(err).Info("invalid ingress options in request body")
+		h.E(c, err)
+		return
+	}
+
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.SetIngressOptions(ingressOptions)
+	h.R(c, &api.SuccessfulOption{Success: true})
+}
+
+// GetIngress get currently stored ingress options
+// @ID GetIngress
+// @Summary get current ingress options
+// @Description get currently stored ingress options, returns default options if nothing stored.
+// @Tags ingress
+// @Produce application/json
+// @Success 200 {object} api.IngressOptions
+// @Router /api/v1/deploy/wizard/ingresses [get]
+func GetIngress(c *gin.Context) {
+	logger := log.ReqEntry(c)
+	wizardData := wizard.GetCurrentWizard()
+	logger.WithField("cluster", wizardData.Info.ShortName).Debug("get ingress options of cluster")
+	ingressOptions := wizardData.GetIngressOptions()
+	h.R(c, ingressOptions)
+}
@@ -0,0 +1,137 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+func TestSetIngress(t *testing.T) {
+	tests := []struct {
+		inputBody      []byte
+		wantOptions    *api.IngressOptions
+		wantStatusCode int
+	}{
+		{
+			inputBody: []byte(`{
+	"ingressType": "nginx",
+	"exposeMode": "nodePort",
+	"replicas": 3,
+	"httpNodePort": 30080,
+	"httpsNodePort": 30443,
+	"defaultTLSCertificate": "kube-system/default-tls",
+	"controllerImage": "example.com/nginx-ingress-controller:0.30.0"
+	}`),
+			wantOptions: &api.IngressOptions{
+				IngressType:           api.IngressTypeNginx,
+				ExposeMode:            api.IngressExposeModeNodePort,
+				Replicas:              3,
+				HTTPNodePort:          30080,
+				HTTPSNodePort:         30443,
+				DefaultTLSCertificate: "kube-system/default-tls",
+				ControllerImage:       "example.com/nginx-ingress-controller:0.30.0",
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			inputBody: []byte(`{"ingressType":"contour"}`),
+			wantOptions: &api.IngressOptions{
+				IngressType: api.IngressTypeContour,
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			// contour does not support default tls certificate
+			inputBody:      []byte(`{"ingressType":"contour","defaultTLSCertificate":"kube-system/default-tls"}`),
+			wantOptions:    wizard.NewIngressOptions(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			inputBody:      []byte(`{"ingressType":"nginx","defaultTLSCertificate":"default-tls"}`),
+			wantOptions:    wizard.NewIngressOptions(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			inputBody:      []byte(`{"ingressType":"nginx","exposeMode":"loadBalancer"}`),
+			wantOptions:    wizard.NewIngressOptions(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			inputBody:      []byte(`{"ingressType":"traefik"}`),
+			wantOptions:    wizard.NewIngressOptions(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, testCase := range tests {
+		wizard.ClearCurrentWizardData()
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		bodyReader := bytes.NewReader(testCase.inputBody)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/ingresses", bodyReader)
+
+		SetIngress(ctx)
+		assert.Equal(t, testCase.wantStatusCode, resp.Code)
+		assert.Equal(t, testCase.wantOptions, wizard.GetCurrentWizard().IngressOptions)
+	}
+}
+
+func TestGetIngress(t *testing.T) {
+	tests := []struct {
+		inputOptions *api.IngressOptions
+		wantOptions  *api.IngressOptions
+	}{
+		{
+			inputOptions: nil,
+			wantOptions:  &wizard.DefaultIngressOptions,
+		},
+		{
+			inputOptions: &api.IngressOptions{
+				IngressType: api.IngressTypeNginx,
+				ExposeMode:  api.IngressExposeModeHostNetwork,
+			},
+			wantOptions: &api.IngressOptions{
+				IngressType: api.IngressTypeNginx,
+				ExposeMode:  api.IngressExposeModeHostNetwork,
+			},
+		},
+	}
+	for _, testCase := range tests {
+		wizard.ClearCurrentWizardData()
+		wizard.GetCurrentWizard().SetIngressOptions(testCase.inputOptions)
+
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/ingresses", bytes.NewReader([]byte{}))
+
+		GetIngress(ctx)
+		var options *api.IngressOptions
+		err := json.Unmarshal(resp.Body.Bytes(), &options)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.wantOptions, options)
+	}
+}
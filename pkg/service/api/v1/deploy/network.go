@@ -21,6 +21,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 // SetNetwork set and store network options
@@ -37,15 +38,12 @@ import (
 func SetNetwork(c *gin.Context) {
 	logger := log.ReqEntry(c)
 	networkOptions := &api.NetworkOptions{}
-	err := c.BindJSON(networkOptions)
-	if err != nil {
-		logger.WithError(err).Info("failed to parse network options in request body")
-		h.EBindBodyError.WithPayload("failed to parse network options in request body")
+	if err := validator.Params(c, networkOptions); err != nil {
+		logger.WithError(err).Info("invalid network options in request body")
+		h.E(c, err)
 		return
 	}
 
-	// TODO: validate network options.
-
 	wizardData := wizard.GetCurrentWizard()
 	wizardData.SetNetworkOptions(networkOptions)
 	h.R(c, &api.SuccessfulOption{Success: true})
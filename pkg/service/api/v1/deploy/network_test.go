@@ -65,6 +65,42 @@ func TestSetNetwork(t *testing.T) {
 			wantOptions:    &wizard.DefaultNetworkOptions,
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			inputBody: []byte(`{"networkType":"flannel",
+			"flannelOptions":{"backend":"host-gw","iface":"eth1"}}`),
+			wantOptions: &api.NetworkOptions{
+				NetworkType: api.NetworkTypeFlannel,
+				FlannelOptions: &api.FlannelOptions{
+					Backend: api.FlannelBackendHostGW,
+					Iface:   "eth1",
+				},
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			inputBody: []byte(`{"networkType":"cilium",
+			"ciliumOptions":{"tunnelMode":"geneve","mtu":1450}}`),
+			wantOptions: &api.NetworkOptions{
+				NetworkType: api.NetworkTypeCilium,
+				CiliumOptions: &api.CiliumOptions{
+					TunnelMode: api.CiliumTunnelModeGeneve,
+					MTU:        1450,
+				},
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			// native routing cidr is required when tunnel is disabled
+			inputBody: []byte(`{"networkType":"cilium",
+			"ciliumOptions":{"tunnelMode":"disabled"}}`),
+			wantOptions:    &wizard.DefaultNetworkOptions,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			inputBody:      []byte(`{"networkType":"weave"}`),
+			wantOptions:    &wizard.DefaultNetworkOptions,
+			wantStatusCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, testCase := range tests {
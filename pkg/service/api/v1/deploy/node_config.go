@@ -0,0 +1,82 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/config"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+)
+
+// @ID UpdateNodeConfig
+// @Summary Update node labels, annotations and taints of deployed cluster
+// @Description Diff labels, annotations and taints of the wizard nodes against the live nodes and apply the changes, drifts made outside kpaas since last applied are reported
+// @Tags node
+// @Produce application/json
+// @Param dryRun query bool false "only compute the changes and drifts, do not apply them" default(false)
+// @Success 201 {object} api.UpdateNodeConfigResponse
+// @Failure 400 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/nodeconfigs [post]
+func UpdateNodeConfig(c *gin.Context) {
+
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		h.E(c, h.EParamsError.WithPayload("query parameter \"dryRun\" must be a boolean"))
+		return
+	}
+
+	wizardData := wizard.GetCurrentWizard()
+	if wizardData.DeployClusterStatus != wizard.DeployClusterStatusSuccessful &&
+		wizardData.DeployClusterStatus != wizard.DeployClusterStatusWorkedButHaveError {
+		h.E(c, h.EStatusError.WithPayload("Current cluster has not been deployed yet"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.UpdateNodeConfig(grpcContext, &protos.UpdateNodeConfigRequest{
+		NodeConfigs:   buildCallDeployDataNodesPart(),
+		ClusterConfig: buildCallDeployDataClusterPart(),
+		DryRun:        dryRun,
+	})
+	if err != nil {
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	if resp.GetErr() != nil {
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+	}
+
+	h.R(c, api.UpdateNodeConfigResponse{
+		Passed: resp.GetPassed(),
+		DryRun: dryRun,
+		Nodes:  convertDeployControllerNodeConfigDiffsToAPI(resp.GetDiffs()),
+	})
+}
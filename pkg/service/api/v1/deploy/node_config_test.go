@@ -0,0 +1,69 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+func TestUpdateNodeConfig(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	gin.SetMode(gin.TestMode)
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	update := func(url string) (int, *api.UpdateNodeConfigResponse) {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", url, nil)
+		UpdateNodeConfig(ctx)
+		resp.Flush()
+		responseData := new(api.UpdateNodeConfigResponse)
+		_ = json.Unmarshal(resp.Body.Bytes(), responseData)
+		return resp.Code, responseData
+	}
+
+	// cluster is not deployed
+	code, _ := update("/api/v1/deploy/wizard/nodeconfigs")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	wizardData.Nodes = []*wizard.Node{
+		{ConnectionData: wizard.ConnectionData{IP: "192.168.31.101"}, Name: "master1"},
+	}
+
+	// invalid dryRun
+	code, _ = update("/api/v1/deploy/wizard/nodeconfigs?dryRun=maybe")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, responseData := update("/api/v1/deploy/wizard/nodeconfigs?dryRun=true")
+	assert.Equal(t, http.StatusCreated, code)
+	assert.True(t, responseData.Passed)
+	assert.True(t, responseData.DryRun)
+	assert.Len(t, responseData.Nodes, 1)
+	assert.Equal(t, "master1", responseData.Nodes[0].NodeName)
+}
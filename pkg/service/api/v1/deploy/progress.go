@@ -38,12 +38,14 @@ func GetWizardProgress(c *gin.Context) {
 	clusterInfo := getWizardClusterInfo()
 	nodes := getWizardNodes()
 	networkOptions := getWizardNetworkOptions()
+	ingressOptions := getWizardIngressOptions()
 	checkingData := getWizardCheckingData()
 	deploymentData := getWizardDeploymentData()
 
 	responseData := api.GetWizardResponse{
 		ClusterData:         *clusterInfo,
 		NetworkOptions:      *networkOptions,
+		IngressOptions:      *ingressOptions,
 		NodesData:           *nodes,
 		CheckingData:        *checkingData,
 		DeploymentData:      *deploymentData,
@@ -72,23 +74,34 @@ func getWizardClusterInfo() *api.Cluster {
 
 	wizardData := wizard.GetCurrentWizard()
 	clusterInfo := &api.Cluster{
-		ShortName:       wizardData.Info.ShortName,
-		Name:            wizardData.Info.Name,
-		NodePortMinimum: wizardData.Info.NodePortMinimum,
-		NodePortMaximum: wizardData.Info.NodePortMaximum,
+		ShortName:        wizardData.Info.ShortName,
+		Name:             wizardData.Info.Name,
+		NodePortMinimum:  wizardData.Info.NodePortMinimum,
+		NodePortMaximum:  wizardData.Info.NodePortMaximum,
+		PodSubnets:       wizardData.Info.PodSubnets,
+		ServiceSubnets:   wizardData.Info.ServiceSubnets,
+		CheckThresholds:  wizardData.Info.CheckThresholds,
+		ContainerRuntime: wizardData.Info.ContainerRuntime,
+		NodeInitProfile:  wizardData.Info.NodeInitProfile,
+		Proxy:            wizardData.Info.Proxy,
+		ImageRepository:  wizardData.Info.ImageRepository,
+		Registries:       convertModelRegistriesToAPIRegistries(wizardData.Info.Registries, false),
+		ComponentConfig:  wizardData.Info.ComponentConfig,
+		Audit:            wizardData.Info.Audit,
+		Encryption:       wizardData.Info.Encryption,
 	}
 
 	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
 	case wizard.KubeAPIServerConnectTypeFirstMasterIP:
 		clusterInfo.KubeAPIServerConnectType = api.KubeAPIServerConnectTypeFirstMasterIP
 	case wizard.KubeAPIServerConnectTypeKeepalived:
-		clusterInfo.KubeAPIServerConnectType = api.KubeAPIServerConnectTypeKeepalived
-		clusterInfo.VIP = wizardData.Info.KubeAPIServerConnection.VIP
-		clusterInfo.NetInterfaceName = wizardData.Info.KubeAPIServerConnection.NetInterfaceName
+		setAPIKeepalivedConnection(clusterInfo, wizardData.Info.KubeAPIServerConnection, false)
 	case wizard.KubeAPIServerConnectTypeLoadBalancer:
 		clusterInfo.KubeAPIServerConnectType = api.KubeAPIServerConnectTypeLoadBalancer
 		clusterInfo.LoadbalancerIP = wizardData.Info.KubeAPIServerConnection.LoadbalancerIP
 		clusterInfo.LoadbalancerPort = wizardData.Info.KubeAPIServerConnection.LoadbalancerPort
+	case wizard.KubeAPIServerConnectTypeKubeVIP:
+		setAPIKubeVIPConnection(clusterInfo, wizardData.Info.KubeAPIServerConnection, false)
 	}
 
 	clusterInfo.Labels = make([]api.Label, 0, len(wizardData.Info.Labels))
@@ -124,6 +137,10 @@ func getWizardNetworkOptions() *api.NetworkOptions {
 	return wizard.GetCurrentWizard().GetNetworkOptions()
 }
 
+func getWizardIngressOptions() *api.IngressOptions {
+	return wizard.GetCurrentWizard().GetIngressOptions()
+}
+
 func getWizardCheckingData() *[]api.CheckingResultResponseData {
 
 	wizardData := wizard.GetCurrentWizard()
@@ -153,6 +170,24 @@ func getWizardCheckingData() *[]api.CheckingResultResponseData {
 	return responseData
 }
 
+func getWizardVerifyItems() []api.CheckingItem {
+
+	wizardData := wizard.GetCurrentWizard()
+	verifyItems := wizardData.GetVerifyItems()
+	items := make([]api.CheckingItem, 0, len(verifyItems))
+
+	for _, checkItem := range verifyItems {
+
+		items = append(items, api.CheckingItem{
+			CheckingPoint: checkItem.ItemName,
+			Result:        checkItem.CheckResult,
+			Error:         convertModelErrorToAPIError(checkItem.Error),
+		})
+	}
+
+	return items
+}
+
 func getWizardDeploymentData() *[]api.DeploymentResponseData {
 
 	wizardData := wizard.GetCurrentWizard()
@@ -32,6 +32,9 @@ func (a *app) setRoutes() {
 	wizardGroup.GET("/progresses", deploy.GetWizardProgress)
 	wizardGroup.DELETE("/progresses", deploy.ClearWizard)
 
+	wizardGroup.GET("/configurations", deploy.ExportWizardConfiguration)
+	wizardGroup.POST("/configurations", deploy.ImportWizardConfiguration)
+
 	wizardGroup.GET("/clusters", deploy.GetCluster)
 	wizardGroup.POST("/clusters", deploy.SetCluster)
 
@@ -48,14 +51,25 @@ func (a *app) setRoutes() {
 
 	wizardGroup.POST("/deploys", deploy.Deploy)
 	wizardGroup.GET("/deploys", deploy.GetDeployReport)
+	wizardGroup.POST("/deploys/plan", deploy.PlanDeploy)
 
 	wizardGroup.GET("/logs/:id", deploy.DownloadLog)
 
 	wizardGroup.GET("/kubeconfigs", deploy.DownloadKubeConfig)
 
+	wizardGroup.POST("/encryptionkeys", deploy.RotateEncryptionKey)
+	wizardGroup.GET("/encryptionkeys", deploy.GetEncryptionKeyRotationResult)
+
+	wizardGroup.POST("/nodeconfigs", deploy.UpdateNodeConfig)
+
+	wizardGroup.POST("/haconfigs", deploy.ReconfigureHA)
+
 	wizardGroup.POST("/networks", deploy.SetNetwork)
 	wizardGroup.GET("/networks", deploy.GetNetwork)
 
+	wizardGroup.POST("/ingresses", deploy.SetIngress)
+	wizardGroup.GET("/ingresses", deploy.GetIngress)
+
 	v1.POST("/ssh/tests", deploy.TestConnectNode)
 
 	v1.POST("/ssh_certificates", deploy.AddSSHCertificate)
@@ -113,6 +113,35 @@ func (mock *DeployController) GetDeployResult(ctx context.Context, in *protos.Ge
 				Logs:   "",
 			},
 		},
+		VerifyItems: []*protos.ItemCheckResult{
+			{
+				Item: &protos.CheckItem{
+					Name:        "nodes-ready",
+					Description: "all nodes are ready",
+				},
+				Status: "successful",
+			},
+			{
+				Item: &protos.CheckItem{
+					Name:        "dns",
+					Description: "pods can resolve the domain of kubernetes service",
+				},
+				Status: "failed",
+				Err: &protos.Error{
+					Reason: "verify dns failed",
+					Detail: "failed to resolve kubernetes.default.svc.cluster.local",
+				},
+			},
+		},
+		Executions: []*protos.TaskExecution{
+			{
+				TaskName:  "deploy-worker",
+				TaskType:  "DeployWorker",
+				Policy:    &protos.ExecutionPolicy{Concurrency: 2, BatchSize: 2, FailureThreshold: 50},
+				Total:     1,
+				Succeeded: 1,
+			},
+		},
 	}, nil
 }
 
@@ -158,3 +187,59 @@ func (mock *DeployController) GetDeployLog(ctx context.Context, in *protos.GetDe
 	// To be implmented
 	return nil, nil
 }
+
+func (mock *DeployController) ReconfigureHA(
+	ctx context.Context, in *protos.ReconfigureHARequest, opts ...grpc.CallOption) (
+	*protos.ReconfigureHAReply, error) {
+	return &protos.ReconfigureHAReply{
+		Passed: true,
+	}, nil
+}
+
+func (mock *DeployController) RotateEncryptionKey(
+	ctx context.Context, in *protos.RotateEncryptionKeyRequest, opts ...grpc.CallOption) (
+	*protos.RotateEncryptionKeyReply, error) {
+	return &protos.RotateEncryptionKeyReply{
+		Accepted: true,
+	}, nil
+}
+
+func (mock *DeployController) GetRotateEncryptionKeyResult(
+	ctx context.Context, in *protos.GetRotateEncryptionKeyResultRequest, opts ...grpc.CallOption) (
+	*protos.GetRotateEncryptionKeyResultReply, error) {
+	return &protos.GetRotateEncryptionKeyResultReply{
+		Status: string(constant.OperationStatusSuccessful),
+	}, nil
+}
+
+func (mock *DeployController) PlanDeploy(
+	ctx context.Context, in *protos.DeployRequest, opts ...grpc.CallOption) (
+	*protos.PlanDeployReply, error) {
+	plan := &protos.DeployPlanTask{Name: "deploy", Type: "Deploy"}
+	for _, nodeConfig := range in.GetNodeConfigs() {
+		plan.Actions = append(plan.Actions, &protos.DeployPlanAction{
+			Name: "init-" + nodeConfig.GetNode().GetName(),
+			Type: "NodeInit",
+			Node: nodeConfig.GetNode().GetName(),
+		})
+	}
+	return &protos.PlanDeployReply{
+		Passed: true,
+		Plan:   plan,
+	}, nil
+}
+
+func (mock *DeployController) UpdateNodeConfig(
+	ctx context.Context, in *protos.UpdateNodeConfigRequest, opts ...grpc.CallOption) (
+	*protos.UpdateNodeConfigReply, error) {
+	diffs := make([]*protos.NodeConfigDiff, 0, len(in.GetNodeConfigs()))
+	for _, nodeConfig := range in.GetNodeConfigs() {
+		diffs = append(diffs, &protos.NodeConfigDiff{
+			NodeName: nodeConfig.GetNode().GetName(),
+		})
+	}
+	return &protos.UpdateNodeConfigReply{
+		Passed: true,
+		Diffs:  diffs,
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+type (
+	Audit struct {
+		Level     AuditLevel `json:"level,omitempty" enums:"None,Metadata,Request,RequestResponse"` // level of the built-in audit policy, ignored if policy is given
+		Policy    string     `json:"policy,omitempty" maxLength:"65536"`                            // custom audit policy(audit.k8s.io/v1) in yaml or json
+		MaxAge    uint32     `json:"maxAge,omitempty" default:"30"`                                 // days to keep the rotated audit logs
+		MaxBackup uint32     `json:"maxBackup,omitempty" default:"10"`                              // number of the rotated audit logs kept
+		MaxSize   uint32     `json:"maxSize,omitempty" default:"100"`                               // megabytes of the audit log before it's rotated
+	}
+
+	AuditLevel string
+)
+
+const (
+	AuditLevelNone            AuditLevel = "None"
+	AuditLevelMetadata        AuditLevel = "Metadata"
+	AuditLevelRequest         AuditLevel = "Request"
+	AuditLevelRequestResponse AuditLevel = "RequestResponse"
+
+	AuditPolicyLengthLimit = 65536
+	auditPolicyAPIVersion  = "audit.k8s.io/v1"
+	auditPolicyKind        = "Policy"
+)
+
+func (audit *Audit) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(audit.Policy, "audit.policy", validator.ItemNoLimit, AuditPolicyLengthLimit),
+	)
+
+	if audit.Level == "" && strings.TrimSpace(audit.Policy) == "" {
+		wrapper.AddValidateFunc(func() error {
+			return fmt.Errorf("audit.level or audit.policy is required")
+		})
+	}
+
+	if audit.Level != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(audit.Level), "audit.level",
+				[]string{string(AuditLevelNone), string(AuditLevelMetadata), string(AuditLevelRequest),
+					string(AuditLevelRequestResponse)}),
+		)
+	}
+
+	if strings.TrimSpace(audit.Policy) != "" {
+		wrapper.AddValidateFunc(audit.validatePolicy)
+	}
+
+	return wrapper.Validate()
+}
+
+// validatePolicy checks the audit policy is a Policy mapping with rules.
+func (audit *Audit) validatePolicy() error {
+
+	policy := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(audit.Policy), &policy); err != nil {
+		return fmt.Errorf("audit.policy is invalid, error: %v", err)
+	}
+
+	if apiVersion, ok := policy["apiVersion"]; ok && apiVersion != auditPolicyAPIVersion {
+		return fmt.Errorf("audit.policy apiVersion should be %s", auditPolicyAPIVersion)
+	}
+
+	if kind, ok := policy["kind"]; ok && kind != auditPolicyKind {
+		return fmt.Errorf("audit.policy kind should be %s", auditPolicyKind)
+	}
+
+	if rules, ok := policy["rules"].([]interface{}); !ok || len(rules) == 0 {
+		return fmt.Errorf("audit.policy has no rules")
+	}
+
+	return nil
+}
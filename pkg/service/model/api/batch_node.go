@@ -0,0 +1,60 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+type (
+	BatchNodesFormat string // Format of batch nodes content, text, csv or yaml
+	BatchNodesMode   string // How to deal with the nodes which already exist, add or upsert
+
+	BatchNodesError struct {
+		Line  int    `json:"line"`           // Line number of text and csv content, sequence number of yaml nodes, starts from 1
+		Name  string `json:"name,omitempty"` // node name
+		IP    string `json:"ip,omitempty"`   // node ip
+		Error string `json:"error"`          // error message
+	}
+
+	UploadBatchNodesDryRunResponse struct {
+		Passed bool              `json:"passed"` // Whether all nodes are valid
+		Nodes  []NodeData        `json:"nodes"`  // Parsed node list, password will not be returned
+		Errors []BatchNodesError `json:"errors"` // Errors of each line
+	}
+
+	BatchNodesYAMLContent struct {
+		Nodes []NodeData `json:"nodes"` // node list
+	}
+)
+
+const (
+	BatchNodesFormatText BatchNodesFormat = "text"
+	BatchNodesFormatCSV  BatchNodesFormat = "csv"
+	BatchNodesFormatYAML BatchNodesFormat = "yaml"
+
+	BatchNodesModeAdd    BatchNodesMode = "add"    // Add nodes, fail if node ip or name was exist
+	BatchNodesModeUpsert BatchNodesMode = "upsert" // Update the existing nodes matched by ip, add the others
+
+	// Columns of csv content, the first line of csv content must be header
+	BatchNodesCSVColumnName                = "name"
+	BatchNodesCSVColumnDescription         = "description"
+	BatchNodesCSVColumnRoles               = "roles"
+	BatchNodesCSVColumnIP                  = "ip"
+	BatchNodesCSVColumnPort                = "port"
+	BatchNodesCSVColumnUsername            = "username"
+	BatchNodesCSVColumnAuthenticationType  = "authorizationType"
+	BatchNodesCSVColumnPassword            = "password"
+	BatchNodesCSVColumnPrivateKeyName      = "privateKeyName"
+	BatchNodesCSVColumnDockerRootDirectory = "dockerRootDirectory"
+	BatchNodesCSVColumnLabels              = "labels"
+	BatchNodesCSVColumnTaints              = "taints"
+)
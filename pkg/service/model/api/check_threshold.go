@@ -0,0 +1,75 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+var checkThresholdVersionRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)+(-.*)?$`)
+
+type (
+	CheckThreshold struct {
+		Role        constant.MachineRole `json:"role" binding:"required" enums:"master,worker,etcd,ingress"`
+		Minimum     CheckThresholdValues `json:"minimum"`     // the node check fails if the node is under the minimum values
+		Recommended CheckThresholdValues `json:"recommended"` // the node check passes with a warning if the node is under the recommended values
+	}
+
+	CheckThresholdValues struct {
+		DockerVersion string  `json:"dockerVersion,omitempty"` // use the built-in value if empty, the same below
+		KernelVersion string  `json:"kernelVersion,omitempty"`
+		CPUCore       float64 `json:"cpuCore,omitempty" minimum:"0"`
+		MemoryGiB     float64 `json:"memoryGiB,omitempty" minimum:"0"`
+		RootDiskGiB   float64 `json:"rootDiskGiB,omitempty" minimum:"0"`
+	}
+)
+
+func (threshold *CheckThreshold) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateStringOptions(string(threshold.Role), "checkThresholds.role",
+			[]string{string(constant.MachineRoleMaster), string(constant.MachineRoleWorker), string(constant.MachineRoleEtcd), string(constant.MachineRoleIngress)}),
+		threshold.Minimum.validate("checkThresholds.minimum"),
+		threshold.Recommended.validate("checkThresholds.recommended"),
+	)
+
+	return wrapper.Validate()
+}
+
+func (values *CheckThresholdValues) validate(keyName string) validator.ValidateFunc {
+
+	return func() error {
+
+		if values.DockerVersion != "" {
+			if err := validator.ValidateRegexp(checkThresholdVersionRegexp, values.DockerVersion, keyName+".dockerVersion")(); err != nil {
+				return err
+			}
+		}
+		if values.KernelVersion != "" {
+			if err := validator.ValidateRegexp(checkThresholdVersionRegexp, values.KernelVersion, keyName+".kernelVersion")(); err != nil {
+				return err
+			}
+		}
+		if values.CPUCore < 0 || values.MemoryGiB < 0 || values.RootDiskGiB < 0 {
+			return fmt.Errorf("%s can not be negative", keyName)
+		}
+
+		return nil
+	}
+}
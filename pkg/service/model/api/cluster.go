@@ -16,30 +16,66 @@ package api
 
 import (
 	"fmt"
+	"net"
+	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
+var clusterImageRepositoryRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._:/\-]*[A-Za-z0-9])?$`)
+
 type (
 	Cluster struct {
 		ShortName                string                   `json:"shortName" binding:"required" minLength:"1" maxLength:"20"`
 		Name                     string                   `json:"name" binding:"required"`
-		KubeAPIServerConnectType KubeAPIServerConnectType `json:"kubeAPIServerConnectType" binding:"required" enums:"firstMasterIP,keepalived,loadbalancer"` // kube-apiserver connect type
-		VIP                      string                   `json:"vip,omitempty" maxLength:"15"`                                                              // keepalived listen virtual ip
-		NetInterfaceName         string                   `json:"netInterfaceName,omitempty" maxLength:"30"`                                                 // keepalived listen net interface name
-		LoadbalancerIP           string                   `json:"loadbalancerIP,omitempty" maxLength:"15"`                                                   // kube-apiserver loadbalancer ip when kubeAPIServerConnectType is loadbalancer required
-		LoadbalancerPort         uint16                   `json:"loadbalancerPort,omitempty" minimum:"1" maximum:"65535"`                                    // kube-apiserver loadbalancer port when kubeAPIServerConnectType is loadbalancer required
+		KubeAPIServerConnectType KubeAPIServerConnectType `json:"kubeAPIServerConnectType" binding:"required" enums:"firstMasterIP,keepalived,loadbalancer,kubevip"` // kube-apiserver connect type
+		VIP                      string                   `json:"vip,omitempty" maxLength:"39"`                                                                      // keepalived or kube-vip listen virtual ip
+		NetInterfaceName         string                   `json:"netInterfaceName,omitempty" maxLength:"30"`                                                         // keepalived or kube-vip listen net interface name
+		HaproxyPort              uint16                   `json:"haproxyPort,omitempty" minimum:"1" maximum:"65535" default:"4443"`                                  // port haproxy listens on for kube-apiserver when kubeAPIServerConnectType is keepalived
+		HaproxyStatsPort         uint16                   `json:"haproxyStatsPort,omitempty" minimum:"1" maximum:"65535" default:"1936"`                             // port of haproxy stats page when kubeAPIServerConnectType is keepalived
+		VirtualRouterID          uint8                    `json:"virtualRouterID,omitempty" minimum:"1" maximum:"255"`                                               // keepalived virtual router id, use the last octet of vip when empty
+		KeepalivedAuthPassword   string                   `json:"keepalivedAuthPassword,omitempty" maxLength:"8"`                                                    // keepalived vrrp authentication password, no authentication when empty
+		HealthCheckInterval      uint16                   `json:"healthCheckInterval,omitempty" minimum:"1" default:"2"`                                             // interval seconds of keepalived checking haproxy
+		LoadbalancerIP           string                   `json:"loadbalancerIP,omitempty" maxLength:"39"`                                                           // kube-apiserver loadbalancer ip when kubeAPIServerConnectType is loadbalancer required
+		LoadbalancerPort         uint16                   `json:"loadbalancerPort,omitempty" minimum:"1" maximum:"65535"`                                            // kube-apiserver loadbalancer port when kubeAPIServerConnectType is loadbalancer required
+		KubeVIPMode              KubeVIPMode              `json:"kubeVIPMode,omitempty" enums:"arp,bgp" default:"arp"`                                               // how kube-vip announces the virtual ip when kubeAPIServerConnectType is kubevip
+		KubeVIPImage             string                   `json:"kubeVIPImage,omitempty" maxLength:"255"`                                                            // kube-vip image, use the default image when empty
+		BGPLocalAS               uint32                   `json:"bgpLocalAS,omitempty" minimum:"1"`                                                                  // local AS number when kubeVIPMode is bgp required
+		BGPRouterID              string                   `json:"bgpRouterID,omitempty" maxLength:"15"`                                                              // bgp router id, use the master node ip when empty
+		BGPPeers                 []BGPPeer                `json:"bgpPeers,omitempty"`                                                                                // bgp peers when kubeVIPMode is bgp required
 		NodePortMinimum          uint16                   `json:"nodePortMinimum" minimum:"1" default:"30000"`
 		NodePortMaximum          uint16                   `json:"nodePortMaximum" maximum:"65535" default:"32767"`
+		PodSubnets               []string                 `json:"podSubnets,omitempty"`                                                  // pod subnets, one IPv4 and one IPv6 subnet make a dual-stack cluster
+		ServiceSubnets           []string                 `json:"serviceSubnets,omitempty"`                                              // service subnets, must be of the same ip families as pod subnets
+		CheckThresholds          []CheckThreshold         `json:"checkThresholds,omitempty"`                                             // thresholds of node checks by role, built-in thresholds are used for the roles absent
+		ContainerRuntime         ContainerRuntime         `json:"containerRuntime,omitempty" enums:"docker,containerd" default:"docker"` // container runtime of nodes, containerd is installed by node init
+		NodeInitProfile          *NodeInitProfile         `json:"nodeInitProfile,omitempty"`                                             // how nodes are initialized, the defaults are used if empty
+		Proxy                    *Proxy                   `json:"proxy,omitempty"`                                                       // proxy used by package manager, container runtime and kubeadm on nodes, no proxy if empty
+		ImageRepository          string                   `json:"imageRepository,omitempty" maxLength:"255"`                             // repository of kubernetes and etcd images like registry.example.com/kpaas, docker.io/kpaas if empty
+		Registries               []Registry               `json:"registries,omitempty"`                                                  // registries whose credentials, certificates and mirrors are set up on nodes
+		ComponentConfig          *ComponentConfig         `json:"componentConfig,omitempty"`                                             // arguments and configurations passed through to kubernetes components, kubeadm defaults if empty
+		Audit                    *Audit                   `json:"audit,omitempty"`                                                       // audit logging of kube-apiserver, audit is disabled if empty
+		Encryption               *Encryption              `json:"encryption,omitempty"`                                                  // encryption of secrets at rest, secrets are stored unencrypted if empty
 		Labels                   []Label                  `json:"labels"`
 		Annotations              []Annotation             `json:"annotations"`
 	}
 
 	KubeAPIServerConnectType string
 
+	KubeVIPMode string
+
+	ContainerRuntime string
+
+	BGPPeer struct {
+		Address  string `json:"address" binding:"required" maxLength:"39"` // peer ip address
+		AS       uint32 `json:"as" binding:"required" minimum:"1"`         // peer AS number
+		Password string `json:"password,omitempty"`                        // bgp session password
+	}
+
 	Label struct {
 		Key   string `json:"key" binding:"required" minimum:"1" maximum:"253"`
 		Value string `json:"value" binding:"required" minimum:"1"`
@@ -55,19 +91,30 @@ const (
 	KubeAPIServerConnectTypeFirstMasterIP KubeAPIServerConnectType = "firstMasterIP"
 	KubeAPIServerConnectTypeKeepalived    KubeAPIServerConnectType = "keepalived"
 	KubeAPIServerConnectTypeLoadBalancer  KubeAPIServerConnectType = "loadbalancer"
+	KubeAPIServerConnectTypeKubeVIP       KubeAPIServerConnectType = "kubevip"
+
+	KubeVIPModeARP KubeVIPMode = "arp"
+	KubeVIPModeBGP KubeVIPMode = "bgp"
 
-	ClusterNameLengthLimit         = 30
-	ClusterShortNameLengthLimit    = 20
-	ClusterIPLengthLimit           = 15
-	ClusterNetInterfaceLengthLimit = 30
-	ClusterLoadbalancerPortMinimum = 1
-	ClusterLoadbalancerPortMaximum = 65535
-	ClusterNodePortMinimum         = 1
-	ClusterNodePortMaximum         = 65535
-	LabelKeyLengthLimit            = 253
-	AnnotationKeyLengthLimit       = 253
-	DefaultClusterNodePortMinimum  = 30000
-	DefaultClusterNodePortMaximum  = 32767
+	ContainerRuntimeDocker     ContainerRuntime = "docker"
+	ContainerRuntimeContainerd ContainerRuntime = "containerd"
+
+	ClusterNameLengthLimit                   = 30
+	ClusterShortNameLengthLimit              = 20
+	ClusterIPLengthLimit                     = 39
+	ClusterNetInterfaceLengthLimit           = 30
+	ClusterLoadbalancerPortMinimum           = 1
+	ClusterLoadbalancerPortMaximum           = 65535
+	ClusterKubeVIPImageLengthLimit           = 255
+	ClusterKeepalivedAuthPasswordLengthLimit = 8
+	ClusterImageRepositoryLimit              = 255
+	ClusterBGPASMinimum                      = 1
+	ClusterNodePortMinimum                   = 1
+	ClusterNodePortMaximum                   = 65535
+	LabelKeyLengthLimit                      = 253
+	AnnotationKeyLengthLimit                 = 253
+	DefaultClusterNodePortMinimum            = 30000
+	DefaultClusterNodePortMaximum            = 32767
 )
 
 func (cluster *Cluster) Validate() error {
@@ -77,7 +124,7 @@ func (cluster *Cluster) Validate() error {
 		validator.ValidateString(cluster.ShortName, "shortName", validator.ItemNotEmptyLimit, ClusterShortNameLengthLimit),
 		validator.ValidateStringOptions(string(cluster.KubeAPIServerConnectType),
 			"kubeAPIServerConnectType",
-			[]string{string(KubeAPIServerConnectTypeFirstMasterIP), string(KubeAPIServerConnectTypeKeepalived), string(KubeAPIServerConnectTypeLoadBalancer)}),
+			[]string{string(KubeAPIServerConnectTypeFirstMasterIP), string(KubeAPIServerConnectTypeKeepalived), string(KubeAPIServerConnectTypeLoadBalancer), string(KubeAPIServerConnectTypeKubeVIP)}),
 	)
 
 	if cluster.NodePortMinimum > 0 {
@@ -103,12 +150,18 @@ func (cluster *Cluster) Validate() error {
 		)
 	}
 
+	if len(cluster.PodSubnets) > 0 || len(cluster.ServiceSubnets) > 0 {
+		wrapper.AddValidateFunc(cluster.validateSubnets)
+	}
+
 	switch cluster.KubeAPIServerConnectType {
 	case KubeAPIServerConnectTypeKeepalived:
 		wrapper.AddValidateFunc(
 			validator.ValidateString(cluster.VIP, "vip", validator.ItemNotEmptyLimit, ClusterIPLengthLimit),
 			validator.ValidateIP(cluster.VIP, "vip"),
 			validator.ValidateString(cluster.NetInterfaceName, "netInterfaceName", validator.ItemNotEmptyLimit, ClusterNetInterfaceLengthLimit),
+			validator.ValidateString(cluster.KeepalivedAuthPassword, "keepalivedAuthPassword", validator.ItemNoLimit, ClusterKeepalivedAuthPasswordLengthLimit),
+			cluster.validateKeepalived,
 		)
 	case KubeAPIServerConnectTypeLoadBalancer:
 		wrapper.AddValidateFunc(
@@ -116,6 +169,56 @@ func (cluster *Cluster) Validate() error {
 			validator.ValidateIP(cluster.LoadbalancerIP, "loadbalancerIP"),
 			validator.ValidateIntRange(int(cluster.LoadbalancerPort), "loadbalancerPort", ClusterLoadbalancerPortMinimum, ClusterLoadbalancerPortMaximum),
 		)
+	case KubeAPIServerConnectTypeKubeVIP:
+		wrapper.AddValidateFunc(
+			validator.ValidateString(cluster.VIP, "vip", validator.ItemNotEmptyLimit, ClusterIPLengthLimit),
+			validator.ValidateIP(cluster.VIP, "vip"),
+			validator.ValidateString(cluster.NetInterfaceName, "netInterfaceName", validator.ItemNotEmptyLimit, ClusterNetInterfaceLengthLimit),
+			validator.ValidateString(cluster.KubeVIPImage, "kubeVIPImage", validator.ItemNoLimit, ClusterKubeVIPImageLengthLimit),
+			cluster.validateKubeVIPMode,
+		)
+	}
+
+	if len(cluster.CheckThresholds) > 0 {
+		wrapper.AddValidateFunc(cluster.validateCheckThresholds)
+	}
+
+	if cluster.ContainerRuntime != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(cluster.ContainerRuntime), "containerRuntime",
+				[]string{string(ContainerRuntimeDocker), string(ContainerRuntimeContainerd)}),
+		)
+	}
+
+	if cluster.NodeInitProfile != nil {
+		wrapper.AddValidateFunc(cluster.NodeInitProfile.Validate)
+	}
+
+	if cluster.Proxy != nil {
+		wrapper.AddValidateFunc(cluster.Proxy.Validate)
+	}
+
+	if cluster.ImageRepository != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateString(cluster.ImageRepository, "imageRepository", validator.ItemNoLimit, ClusterImageRepositoryLimit),
+			validator.ValidateRegexp(clusterImageRepositoryRegexp, cluster.ImageRepository, "imageRepository"),
+		)
+	}
+
+	if len(cluster.Registries) > 0 {
+		wrapper.AddValidateFunc(cluster.validateRegistries)
+	}
+
+	if cluster.ComponentConfig != nil {
+		wrapper.AddValidateFunc(cluster.ComponentConfig.Validate, cluster.validateKubeProxyMode)
+	}
+
+	if cluster.Audit != nil {
+		wrapper.AddValidateFunc(cluster.Audit.Validate)
+	}
+
+	if cluster.Encryption != nil {
+		wrapper.AddValidateFunc(cluster.Encryption.Validate)
 	}
 
 	for _, label := range cluster.Labels {
@@ -139,6 +242,171 @@ func (cluster *Cluster) Validate() error {
 	return wrapper.Validate()
 }
 
+// validateSubnets checks pod and service subnets are valid CIDRs, with at most one subnet of each ip family,
+// and pod subnets are of the same ip families as service subnets.
+func (cluster *Cluster) validateSubnets() error {
+
+	podFamilies, err := subnetFamilies(cluster.PodSubnets, "podSubnets")
+	if err != nil {
+		return err
+	}
+
+	serviceFamilies, err := subnetFamilies(cluster.ServiceSubnets, "serviceSubnets")
+	if err != nil {
+		return err
+	}
+
+	if len(podFamilies) != len(serviceFamilies) {
+		return fmt.Errorf("podSubnets and serviceSubnets must be of the same ip families")
+	}
+	for i := range podFamilies {
+		if podFamilies[i] != serviceFamilies[i] {
+			return fmt.Errorf("podSubnets and serviceSubnets must be of the same ip families in the same order")
+		}
+	}
+
+	return nil
+}
+
+// subnetFamilies returns whether each subnet is IPv6
+func subnetFamilies(subnets []string, keyName string) ([]bool, error) {
+
+	if len(subnets) == 0 || len(subnets) > 2 {
+		return nil, fmt.Errorf("%s must have one subnet, or one IPv4 and one IPv6 subnet for dual-stack", keyName)
+	}
+
+	families := make([]bool, 0, len(subnets))
+	for _, subnet := range subnets {
+		ip, _, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("%s has invalid subnet %s", keyName, subnet)
+		}
+		families = append(families, ip.To4() == nil)
+	}
+
+	if len(families) == 2 && families[0] == families[1] {
+		return nil, fmt.Errorf("%s must have one IPv4 and one IPv6 subnet for dual-stack", keyName)
+	}
+
+	return families, nil
+}
+
+// validateCheckThresholds checks each threshold is valid and there is at most one threshold of each role.
+func (cluster *Cluster) validateCheckThresholds() error {
+
+	roles := make(map[constant.MachineRole]bool, len(cluster.CheckThresholds))
+	for i := range cluster.CheckThresholds {
+
+		threshold := &cluster.CheckThresholds[i]
+		if err := threshold.Validate(); err != nil {
+			return err
+		}
+		if roles[threshold.Role] {
+			return fmt.Errorf("checkThresholds has duplicated role %s", threshold.Role)
+		}
+		roles[threshold.Role] = true
+	}
+
+	return nil
+}
+
+// validateRegistries checks each registry is valid and there is at most one registry of each server,
+// docker only supports mirrors of docker hub.
+func (cluster *Cluster) validateRegistries() error {
+
+	servers := make(map[string]bool, len(cluster.Registries))
+	for i := range cluster.Registries {
+
+		registry := &cluster.Registries[i]
+		if err := registry.Validate(); err != nil {
+			return err
+		}
+		if servers[registry.Server] {
+			return fmt.Errorf("registries has duplicated server %s", registry.Server)
+		}
+		servers[registry.Server] = true
+
+		if len(registry.Mirrors) > 0 && registry.Server != RegistryServerDockerHub && cluster.ContainerRuntime != ContainerRuntimeContainerd {
+			return fmt.Errorf("registries.mirrors of %s is only supported by %s", registry.Server, ContainerRuntimeContainerd)
+		}
+	}
+
+	return nil
+}
+
+// validateKubeProxyMode checks kube-proxy runs in ipvs mode for dual-stack
+func (cluster *Cluster) validateKubeProxyMode() error {
+
+	if cluster.ComponentConfig.KubeProxyMode == KubeProxyModeIPTables && len(cluster.PodSubnets) == 2 {
+		return fmt.Errorf("componentConfig.kubeProxyMode %s does not support dual-stack, use %s instead",
+			KubeProxyModeIPTables, KubeProxyModeIPVS)
+	}
+
+	return nil
+}
+
+// validateKeepalived checks haproxy listens on different ports and the auth password could be passed to the setup script.
+func (cluster *Cluster) validateKeepalived() error {
+
+	if cluster.HaproxyPort != 0 && cluster.HaproxyPort == cluster.HaproxyStatsPort {
+		return fmt.Errorf("haproxyPort and haproxyStatsPort can not be the same")
+	}
+
+	if strings.ContainsAny(cluster.KeepalivedAuthPassword, "' \t\n") {
+		return fmt.Errorf("keepalivedAuthPassword can not contain quote or blank characters")
+	}
+
+	return nil
+}
+
+func (cluster *Cluster) validateKubeVIPMode() error {
+
+	switch cluster.KubeVIPMode {
+	case "", KubeVIPModeARP:
+		return nil
+	case KubeVIPModeBGP:
+	default:
+		return validator.ValidateStringOptions(string(cluster.KubeVIPMode), "kubeVIPMode",
+			[]string{string(KubeVIPModeARP), string(KubeVIPModeBGP)})()
+	}
+
+	wrapper := validator.NewWrapper(
+		validateBGPAS(cluster.BGPLocalAS, "bgpLocalAS"),
+	)
+
+	if cluster.BGPRouterID != "" {
+		wrapper.AddValidateFunc(validator.ValidateIP(cluster.BGPRouterID, "bgpRouterID"))
+	}
+
+	if len(cluster.BGPPeers) == 0 {
+		wrapper.AddValidateFunc(func() error {
+			return fmt.Errorf("bgpPeers is required when kubeVIPMode is %s", KubeVIPModeBGP)
+		})
+	}
+
+	for i := range cluster.BGPPeers {
+
+		peer := &cluster.BGPPeers[i]
+		wrapper.AddValidateFunc(
+			validator.ValidateIP(peer.Address, "bgpPeers.address"),
+			validateBGPAS(peer.AS, "bgpPeers.as"),
+		)
+	}
+
+	return wrapper.Validate()
+}
+
+// validateBGPAS checks the AS number is not reserved, the maximum is limited by uint32.
+func validateBGPAS(as uint32, keyName string) validator.ValidateFunc {
+
+	return func() error {
+		if as < ClusterBGPASMinimum {
+			return fmt.Errorf("%s out of range: minimum: %d", keyName, ClusterBGPASMinimum)
+		}
+		return nil
+	}
+}
+
 func (label *Label) Validate() error {
 
 	return validator.NewWrapper(
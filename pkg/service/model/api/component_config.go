@@ -0,0 +1,116 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+var (
+	componentArgNameRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+type (
+	ComponentConfig struct {
+		APIServerExtraArgs         map[string]string `json:"apiServerExtraArgs,omitempty"`                     // extra arguments of kube-apiserver without the leading "--", like {"default-watch-cache-size": "200"}
+		ControllerManagerExtraArgs map[string]string `json:"controllerManagerExtraArgs,omitempty"`             // extra arguments of kube-controller-manager without the leading "--"
+		SchedulerExtraArgs         map[string]string `json:"schedulerExtraArgs,omitempty"`                     // extra arguments of kube-scheduler without the leading "--"
+		KubeletConfiguration       string            `json:"kubeletConfiguration,omitempty" maxLength:"65536"` // KubeletConfiguration(kubelet.config.k8s.io/v1beta1) in yaml or json, like maxPods, evictionHard and systemReserved
+		KubeProxyMode              KubeProxyMode     `json:"kubeProxyMode,omitempty" enums:"iptables,ipvs"`    // mode of kube-proxy, iptables if empty, ipvs is required by dual-stack
+	}
+
+	KubeProxyMode string
+)
+
+const (
+	KubeProxyModeIPTables KubeProxyMode = "iptables"
+	KubeProxyModeIPVS     KubeProxyMode = "ipvs"
+
+	ComponentArgValueLengthLimit         = 4096
+	ComponentKubeletConfigurationLimit   = 65536
+	componentKubeletConfigurationKind    = "KubeletConfiguration"
+	componentKubeletConfigurationVersion = "kubelet.config.k8s.io/v1beta1"
+)
+
+func (config *ComponentConfig) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validateComponentArgs(config.APIServerExtraArgs, "componentConfig.apiServerExtraArgs"),
+		validateComponentArgs(config.ControllerManagerExtraArgs, "componentConfig.controllerManagerExtraArgs"),
+		validateComponentArgs(config.SchedulerExtraArgs, "componentConfig.schedulerExtraArgs"),
+		validator.ValidateString(config.KubeletConfiguration, "componentConfig.kubeletConfiguration",
+			validator.ItemNoLimit, ComponentKubeletConfigurationLimit),
+	)
+
+	if strings.TrimSpace(config.KubeletConfiguration) != "" {
+		wrapper.AddValidateFunc(config.validateKubeletConfiguration)
+	}
+
+	if config.KubeProxyMode != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(config.KubeProxyMode), "componentConfig.kubeProxyMode",
+				[]string{string(KubeProxyModeIPTables), string(KubeProxyModeIPVS)}),
+		)
+	}
+
+	return wrapper.Validate()
+}
+
+// validateComponentArgs checks the argument names are given without the leading "--" and values are in one line,
+// arguments managed by kpaas are rejected by deploy controller.
+func validateComponentArgs(args map[string]string, keyName string) validator.ValidateFunc {
+
+	return func() error {
+
+		for name, value := range args {
+			if !componentArgNameRegexp.MatchString(name) {
+				return fmt.Errorf("%s has invalid argument %q, should be given without the leading \"--\"", keyName, name)
+			}
+			if len(value) > ComponentArgValueLengthLimit {
+				return fmt.Errorf("%s argument %q is longer than %d", keyName, name, ComponentArgValueLengthLimit)
+			}
+			if strings.ContainsAny(value, "\r\n") {
+				return fmt.Errorf("%s argument %q has line break", keyName, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+// validateKubeletConfiguration checks the kubelet configuration is a mapping of the KubeletConfiguration type.
+func (config *ComponentConfig) validateKubeletConfiguration() error {
+
+	kubeletConfig := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(config.KubeletConfiguration), &kubeletConfig); err != nil {
+		return fmt.Errorf("componentConfig.kubeletConfiguration is invalid, error: %v", err)
+	}
+
+	if apiVersion, ok := kubeletConfig["apiVersion"]; ok && apiVersion != componentKubeletConfigurationVersion {
+		return fmt.Errorf("componentConfig.kubeletConfiguration apiVersion should be %s", componentKubeletConfigurationVersion)
+	}
+
+	if kind, ok := kubeletConfig["kind"]; ok && kind != componentKubeletConfigurationKind {
+		return fmt.Errorf("componentConfig.kubeletConfiguration kind should be %s", componentKubeletConfigurationKind)
+	}
+
+	return nil
+}
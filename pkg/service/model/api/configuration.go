@@ -0,0 +1,103 @@
+// Copyright 2020 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+type (
+	WizardConfiguration struct {
+		Cluster        Cluster                    `json:"cluster"`                // Cluster Information
+		NetworkOptions *NetworkOptions            `json:"networkOptions"`         // Network options
+		IngressOptions *IngressOptions            `json:"ingressOptions"`         // Ingress options
+		Nodes          []NodeData                 `json:"nodes"`                  // Nodes Information, password only exported when includeSecrets is true
+		Certificates   []ConfigurationCertificate `json:"certificates,omitempty"` // SSH private keys referenced by nodes
+	}
+
+	ConfigurationCertificate struct {
+		Name    string `json:"name" binding:"required" minimum:"1" maximum:"20"` // private key name
+		Content string `json:"content,omitempty"`                                // private key content, only exported when includeSecrets is true
+	}
+
+	ConfigurationFormat string
+)
+
+const (
+	ConfigurationFormatJSON ConfigurationFormat = "json"
+	ConfigurationFormatYAML ConfigurationFormat = "yaml"
+)
+
+// Validate checks the configuration without requiring secrets,
+// missing passwords and private keys are resolved when importing.
+func (config *WizardConfiguration) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		config.Cluster.Validate,
+	)
+
+	if config.NetworkOptions != nil {
+		wrapper.AddValidateFunc(config.NetworkOptions.Validate)
+	}
+
+	if config.IngressOptions != nil {
+		wrapper.AddValidateFunc(config.IngressOptions.Validate)
+	}
+
+	ipList := make(map[string]bool)
+	nameList := make(map[string]bool)
+	for i := range config.Nodes {
+
+		node := &config.Nodes[i]
+		wrapper.AddValidateFunc(
+			node.NodeBaseData.Validate,
+			validator.ValidateIP(node.IP, "ip"),
+			validator.ValidateIntRange(int(node.Port), "port", NodeSSHPortMinimum, NodeSSHPortMaximum),
+			node.SSHLoginData.ValidateWithoutPassword,
+		)
+
+		if ipList[node.IP] {
+			wrapper.AddValidateFunc(func() error {
+				return fmt.Errorf("node ip %s was duplicated", node.IP)
+			})
+		}
+		if nameList[node.Name] {
+			wrapper.AddValidateFunc(func() error {
+				return fmt.Errorf("node name %s was duplicated", node.Name)
+			})
+		}
+		ipList[node.IP] = true
+		nameList[node.Name] = true
+	}
+
+	for i := range config.Certificates {
+
+		certificate := &config.Certificates[i]
+		wrapper.AddValidateFunc(
+			validator.ValidateString(certificate.Name, "certificates.name", validator.ItemNotEmptyLimit, CertificateNameLimit),
+			validator.ValidateString(certificate.Content, "certificates.content", validator.ItemNoLimit, CertificateContentLimit),
+		)
+
+		if certificate.Content != "" {
+			wrapper.AddValidateFunc(func() error {
+				return verifyPrivateKeyContent(certificate.Content)
+			})
+		}
+	}
+
+	return wrapper.Validate()
+}
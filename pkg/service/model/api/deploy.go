@@ -15,14 +15,42 @@
 package api
 
 import (
+	"fmt"
+	"math"
+	"sort"
+
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 type (
+	DeployRequest struct {
+		ExecutionPolicies map[string]ExecutionPolicy `json:"executionPolicies,omitempty"` // Execution policies keyed by task type, such as DeployWorker or NodeInit
+	}
+
+	ExecutionPolicy struct {
+		Concurrency      int `json:"concurrency"`      // Maximum number of sub tasks or actions executed at the same time, 0 means unlimited
+		BatchSize        int `json:"batchSize"`        // Number of sub tasks or actions in a rolling batch, 0 means all in one batch
+		FailureThreshold int `json:"failureThreshold"` // Failure percentage which stops the following batches, 0 means never stop
+	}
+
+	TaskExecution struct {
+		TaskName                string          `json:"taskName"`                // task name
+		TaskType                string          `json:"taskType"`                // task type
+		Policy                  ExecutionPolicy `json:"policy"`                  // execution policy applied to the task
+		Total                   int             `json:"total"`                   // number of sub tasks or actions
+		Succeeded               int             `json:"succeeded"`               // number of succeeded sub tasks or actions
+		Failed                  int             `json:"failed"`                  // number of failed sub tasks or actions
+		Skipped                 int             `json:"skipped"`                 // number of sub tasks or actions skipped after the failure threshold was reached
+		FailureThresholdReached bool            `json:"failureThresholdReached"` // whether the failure threshold was reached
+	}
+
 	GetDeploymentReportResponse struct {
 		DeployItems         []DeploymentResponseData `json:"deployItems"`
 		DeployClusterStatus DeployClusterStatus      `json:"deployClusterStatus" enums:"pending,running,successful,failed,workedButHaveError"` // The cluster deployment status
 		DeployClusterError  *Error                   `json:"deployClusterError,omitempty"`                                                     // Deploy cluster error message
+		VerifyItems         []CheckingItem           `json:"verifyItems"`                                                                      // Results of the smoke test after the cluster is deployed
+		TaskExecutions      []TaskExecution          `json:"taskExecutions"`                                                                   // Progress of the tasks which have an execution policy
 	}
 
 	DeploymentResponseData struct {
@@ -36,6 +64,39 @@ type (
 		Error  *Error       `json:"error,omitempty"`
 	}
 
+	DeployPlanResponse struct {
+		Plan      DeployPlanTask   `json:"plan"`      // The deploy task split into sub tasks and actions
+		Files     []DeployPlanFile `json:"files"`     // Files rendered for nodes, such as kubeadm config, haproxy and keepalived configs
+		EtcdCerts []EtcdCertPlan   `json:"etcdCerts"` // Subjects of etcd server and peer certificates
+	}
+
+	DeployPlanTask struct {
+		Name     string             `json:"name"`     // task name
+		Type     string             `json:"type"`     // task type
+		Priority int                `json:"priority"` // sub tasks with smaller priority are executed first, the same priority are executed in parallel
+		Actions  []DeployPlanAction `json:"actions"`  // actions of the task
+		SubTasks []DeployPlanTask   `json:"subTasks"` // sub tasks of the task
+	}
+
+	DeployPlanAction struct {
+		Name string `json:"name"` // action name
+		Type string `json:"type"` // action type
+		Node string `json:"node"` // node name which the action is executed on
+	}
+
+	DeployPlanFile struct {
+		Node    string `json:"node"`    // node name
+		Path    string `json:"path"`    // file path on the node
+		Content string `json:"content"` // file content
+	}
+
+	EtcdCertPlan struct {
+		Node       string   `json:"node"`       // etcd node name
+		CommonName string   `json:"commonName"` // certificate common name
+		DNSNames   []string `json:"dnsNames"`   // subject alternative DNS names
+		IPs        []string `json:"ips"`        // subject alternative IPs
+	}
+
 	DeployStatus        string
 	DeployClusterStatus string
 )
@@ -53,3 +114,30 @@ const (
 	DeployClusterStatusFailed             DeployClusterStatus = "failed"
 	DeployClusterStatusWorkedButHaveError DeployClusterStatus = "workedButHaveError"
 )
+
+const (
+	ExecutionPolicyFailureThresholdMaximum = 100
+)
+
+func (request *DeployRequest) Validate() error {
+
+	taskTypes := make([]string, 0, len(request.ExecutionPolicies))
+	for taskType := range request.ExecutionPolicies {
+		taskTypes = append(taskTypes, taskType)
+	}
+	sort.Strings(taskTypes)
+
+	wrapper := validator.NewWrapper()
+	for _, taskType := range taskTypes {
+		policy := request.ExecutionPolicies[taskType]
+		keyName := fmt.Sprintf("executionPolicies[%s]", taskType)
+		wrapper.AddValidateFunc(
+			validator.ValidateString(taskType, "executionPolicies", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+			validator.ValidateIntRange(policy.Concurrency, keyName+".concurrency", 0, math.MaxInt32),
+			validator.ValidateIntRange(policy.BatchSize, keyName+".batchSize", 0, math.MaxInt32),
+			validator.ValidateIntRange(policy.FailureThreshold, keyName+".failureThreshold", 0, ExecutionPolicyFailureThresholdMaximum),
+		)
+	}
+
+	return wrapper.Validate()
+}
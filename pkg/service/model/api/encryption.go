@@ -0,0 +1,48 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+type (
+	Encryption struct {
+		Provider EncryptionProvider `json:"provider" binding:"required" enums:"aescbc,secretbox"` // provider which encrypts secrets at rest, the key is generated on the first master
+	}
+
+	EncryptionProvider string
+
+	GetEncryptionKeyRotationResponse struct {
+		Status constant.OperationStatus `json:"status" enums:"pending,running,successful,failed"` // status of the latest rotation
+		Error  *Error                   `json:"error,omitempty"`                                  // error of the rotation if failed
+	}
+)
+
+const (
+	EncryptionProviderAESCBC    EncryptionProvider = "aescbc"
+	EncryptionProviderSecretbox EncryptionProvider = "secretbox"
+)
+
+func (encryption *Encryption) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateStringOptions(string(encryption.Provider), "encryption.provider",
+			[]string{string(EncryptionProviderAESCBC), string(EncryptionProviderSecretbox)}),
+	)
+
+	return wrapper.Validate()
+}
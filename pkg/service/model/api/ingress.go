@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+type IngressType string
+
+const (
+	IngressTypeContour IngressType = "contour"
+	IngressTypeNginx   IngressType = "nginx"
+)
+
+type IngressExposeMode string
+
+const (
+	IngressExposeModeHostNetwork IngressExposeMode = "hostNetwork"
+	IngressExposeModeNodePort    IngressExposeMode = "nodePort"
+)
+
+const (
+	IngressReplicasMinimum = 1
+	IngressReplicasMaximum = 100
+)
+
+var ingressTLSCertificateRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?/[a-z0-9]([-.a-z0-9]*[a-z0-9])?$`)
+
+type IngressOptions struct {
+	IngressType           IngressType       `json:"ingressType" enums:"contour,nginx"`
+	ExposeMode            IngressExposeMode `json:"exposeMode,omitempty" enums:"hostNetwork,nodePort"` // hostNetwork runs a DaemonSet on every ingress node, nodePort runs a Deployment exposed by a NodePort service
+	Replicas              int               `json:"replicas,omitempty"`                                // replicas of controller in nodePort mode, default 2
+	HTTPNodePort          int               `json:"httpNodePort,omitempty"`                            // node port of http in nodePort mode, allocated by kubernetes if empty
+	HTTPSNodePort         int               `json:"httpsNodePort,omitempty"`                           // node port of https in nodePort mode, allocated by kubernetes if empty
+	DefaultTLSCertificate string            `json:"defaultTLSCertificate,omitempty"`                   // secret of default TLS certificate in format of "namespace/secretName", only supported by nginx
+	ControllerImage       string            `json:"controllerImage,omitempty"`                         // image of contour or nginx-ingress-controller, default image is used if empty
+	ProxyImage            string            `json:"proxyImage,omitempty"`                              // image of envoy used by contour, default image is used if empty
+}
+
+func (options *IngressOptions) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateStringOptions(string(options.IngressType), "ingressType",
+			[]string{string(IngressTypeContour), string(IngressTypeNginx)}),
+	)
+
+	if options.ExposeMode != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(options.ExposeMode), "exposeMode",
+				[]string{string(IngressExposeModeHostNetwork), string(IngressExposeModeNodePort)}),
+		)
+	}
+	if options.Replicas != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.Replicas, "replicas", IngressReplicasMinimum, IngressReplicasMaximum),
+		)
+	}
+	if options.HTTPNodePort != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.HTTPNodePort, "httpNodePort", NetworkPortMinimum, NetworkPortMaximum),
+		)
+	}
+	if options.HTTPSNodePort != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.HTTPSNodePort, "httpsNodePort", NetworkPortMinimum, NetworkPortMaximum),
+		)
+	}
+	if options.DefaultTLSCertificate != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateRegexp(ingressTLSCertificateRegexp, options.DefaultTLSCertificate, "defaultTLSCertificate"),
+			func() error {
+				if options.IngressType == IngressTypeContour {
+					return fmt.Errorf("defaultTLSCertificate is not supported by %s", IngressTypeContour)
+				}
+				return nil
+			},
+		)
+	}
+
+	return wrapper.Validate()
+}
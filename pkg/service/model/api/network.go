@@ -13,15 +13,23 @@
 // limitations under the License.
 package api
 
+import (
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
 type NetworkType string
 
 const (
-	NetworkTypeCalico NetworkType = "calico"
+	NetworkTypeCalico  NetworkType = "calico"
+	NetworkTypeFlannel NetworkType = "flannel"
+	NetworkTypeCilium  NetworkType = "cilium"
 )
 
 type NetworkOptions struct {
-	NetworkType   NetworkType    `json:"networkType" enums:"calico"`
-	CalicoOptions *CalicoOptions `json:"calicoOptions,omitempty"`
+	NetworkType    NetworkType     `json:"networkType" enums:"calico,flannel,cilium"`
+	CalicoOptions  *CalicoOptions  `json:"calicoOptions,omitempty"`
+	FlannelOptions *FlannelOptions `json:"flannelOptions,omitempty"`
+	CiliumOptions  *CiliumOptions  `json:"ciliumOptions,omitempty"`
 }
 
 type EncapsulationMode string
@@ -41,7 +49,15 @@ const (
 )
 
 const (
-	DefaultVxlanPort = 4789
+	DefaultVxlanPort        = 4789
+	DefaultFlannelVxlanPort = 8472
+)
+
+const (
+	NetworkPortMinimum = 1
+	NetworkPortMaximum = 65535
+	NetworkMTUMinimum  = 576
+	NetworkMTUMaximum  = 9000
 )
 
 type CalicoOptions struct {
@@ -52,3 +68,125 @@ type CalicoOptions struct {
 	IPDetectionMethod    IPDetectionMethod `json:"ipDetectionMethod,omitempty" enums:"from-kubernetes,first-found,interface"`
 	IPDetectionInterface string            `json:"ipDetectionInterface,omitempty"`
 }
+
+type FlannelBackend string
+
+const (
+	FlannelBackendVxlan  FlannelBackend = "vxlan"
+	FlannelBackendHostGW FlannelBackend = "host-gw"
+)
+
+type FlannelOptions struct {
+	Backend   FlannelBackend `json:"backend,omitempty" enums:"vxlan,host-gw"`
+	VxlanPort int            `json:"vxlanPort,omitempty"`
+	Iface     string         `json:"iface,omitempty"` // interface used for inter-host communication, interface of default route is used if empty
+}
+
+type CiliumTunnelMode string
+
+const (
+	CiliumTunnelModeVxlan    CiliumTunnelMode = "vxlan"
+	CiliumTunnelModeGeneve   CiliumTunnelMode = "geneve"
+	CiliumTunnelModeDisabled CiliumTunnelMode = "disabled"
+)
+
+type CiliumOptions struct {
+	TunnelMode        CiliumTunnelMode `json:"tunnelMode,omitempty" enums:"vxlan,geneve,disabled"`
+	MTU               int              `json:"mtu,omitempty"`
+	NativeRoutingCIDR string           `json:"nativeRoutingCIDR,omitempty"` // required when tunnelMode is disabled
+}
+
+func (options *NetworkOptions) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateStringOptions(string(options.NetworkType), "networkType",
+			[]string{string(NetworkTypeCalico), string(NetworkTypeFlannel), string(NetworkTypeCilium)}),
+	)
+
+	switch options.NetworkType {
+	case NetworkTypeCalico:
+		if options.CalicoOptions != nil {
+			wrapper.AddValidateFunc(options.CalicoOptions.Validate)
+		}
+	case NetworkTypeFlannel:
+		if options.FlannelOptions != nil {
+			wrapper.AddValidateFunc(options.FlannelOptions.Validate)
+		}
+	case NetworkTypeCilium:
+		if options.CiliumOptions != nil {
+			wrapper.AddValidateFunc(options.CiliumOptions.Validate)
+		}
+	}
+
+	return wrapper.Validate()
+}
+
+func (options *CalicoOptions) Validate() error {
+
+	wrapper := validator.NewWrapper()
+	if options.EncapsulationMode != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(options.EncapsulationMode), "calicoOptions.encapsulationMode",
+				[]string{EncapsulationVxlan, EncapsulationIpip, EncapsulationNone}),
+		)
+	}
+	if options.VxlanPort != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.VxlanPort, "calicoOptions.vxlanPort", NetworkPortMinimum, NetworkPortMaximum),
+		)
+	}
+	if options.VethMtu != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.VethMtu, "calicoOptions.vethMtu", NetworkMTUMinimum, NetworkMTUMaximum),
+		)
+	}
+	if options.IPDetectionMethod != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(options.IPDetectionMethod), "calicoOptions.ipDetectionMethod",
+				[]string{IPDetectionMethodFromKubernetes, IPDetectionMethodFirstFound, IPDetectionMethodInterface}),
+		)
+	}
+
+	return wrapper.Validate()
+}
+
+func (options *FlannelOptions) Validate() error {
+
+	wrapper := validator.NewWrapper()
+	if options.Backend != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(options.Backend), "flannelOptions.backend",
+				[]string{string(FlannelBackendVxlan), string(FlannelBackendHostGW)}),
+		)
+	}
+	if options.VxlanPort != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.VxlanPort, "flannelOptions.vxlanPort", NetworkPortMinimum, NetworkPortMaximum),
+		)
+	}
+
+	return wrapper.Validate()
+}
+
+func (options *CiliumOptions) Validate() error {
+
+	wrapper := validator.NewWrapper()
+	if options.TunnelMode != "" {
+		wrapper.AddValidateFunc(
+			validator.ValidateStringOptions(string(options.TunnelMode), "ciliumOptions.tunnelMode",
+				[]string{string(CiliumTunnelModeVxlan), string(CiliumTunnelModeGeneve), string(CiliumTunnelModeDisabled)}),
+		)
+	}
+	if options.MTU != 0 {
+		wrapper.AddValidateFunc(
+			validator.ValidateIntRange(options.MTU, "ciliumOptions.mtu", NetworkMTUMinimum, NetworkMTUMaximum),
+		)
+	}
+	if options.TunnelMode == CiliumTunnelModeDisabled {
+		wrapper.AddValidateFunc(
+			validator.ValidateString(options.NativeRoutingCIDR, "ciliumOptions.nativeRoutingCIDR", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+		)
+	}
+
+	return wrapper.Validate()
+}
@@ -41,7 +41,7 @@ type (
 	ConnectionData struct {
 		SSHLoginData `json:",inline"`
 
-		IP   string `json:"ip" binding:"required" minLength:"1" maxLength:"15"`               // node ip
+		IP   string `json:"ip" binding:"required" minLength:"1" maxLength:"39"`               // node ip
 		Port uint16 `json:"port" binding:"required" minimum:"1" maximum:"65535" default:"22"` // ssh port
 	}
 
@@ -0,0 +1,51 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+type (
+	NodeConfigKind   string // Kind of a node config item, label, annotation or taint
+	NodeConfigAction string // How a node config item is changed, add, update or remove
+
+	NodeConfigChange struct {
+		Kind     NodeConfigKind   `json:"kind" enums:"label,annotation,taint"` // kind of the item
+		Key      string           `json:"key"`                                 // label or annotation key, taint is identified by "key:effect"
+		Action   NodeConfigAction `json:"action" enums:"add,update,remove"`    // change action
+		OldValue string           `json:"oldValue,omitempty"`                  // value before the change
+		NewValue string           `json:"newValue,omitempty"`                  // value after the change
+	}
+
+	NodeConfigDiff struct {
+		NodeName string             `json:"nodeName"`        // node name
+		Changes  []NodeConfigChange `json:"changes"`         // changes from the live node to the wizard config, applied unless dry run
+		Drifts   []NodeConfigChange `json:"drifts"`          // changes made outside kpaas since the config was applied last time
+		Error    *Error             `json:"error,omitempty"` // error of the node if failed
+	}
+
+	UpdateNodeConfigResponse struct {
+		Passed bool             `json:"passed"` // Whether all nodes are updated successfully
+		DryRun bool             `json:"dryRun"` // Whether changes are only computed and not applied
+		Nodes  []NodeConfigDiff `json:"nodes"`  // Diff of each node
+	}
+)
+
+const (
+	NodeConfigKindLabel      NodeConfigKind = "label"
+	NodeConfigKindAnnotation NodeConfigKind = "annotation"
+	NodeConfigKindTaint      NodeConfigKind = "taint"
+
+	NodeConfigActionAdd    NodeConfigAction = "add"
+	NodeConfigActionUpdate NodeConfigAction = "update"
+	NodeConfigActionRemove NodeConfigAction = "remove"
+)
@@ -0,0 +1,123 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+var (
+	nodeInitTimezoneRegexp    = regexp.MustCompile(`^[A-Za-z0-9_+\-]+(/[A-Za-z0-9_+\-]+)*$`)
+	nodeInitNTPServerRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.:\-]*[A-Za-z0-9])?$`)
+	nodeInitSysctlKeyRegexp   = regexp.MustCompile(`^[A-Za-z0-9_\-]+([./][A-Za-z0-9_\-]+)+$`)
+	nodeInitSysctlValueRegexp = regexp.MustCompile(`^[A-Za-z0-9 _.,:/\-]+$`)
+)
+
+type (
+	NodeInitProfile struct {
+		Timezone       string            `json:"timezone,omitempty" maxLength:"64"`                                              // timezone of nodes, Asia/Shanghai if empty
+		NTPServers     []string          `json:"ntpServers,omitempty"`                                                           // time is synchronized by chronyd or systemd-timesyncd with the servers, left as is if empty
+		Sysctl         map[string]string `json:"sysctl,omitempty"`                                                               // sysctl settings persisted on nodes after the built-in ones
+		FirewallMode   FirewallMode      `json:"firewallMode,omitempty" enums:"disable,open-ports" default:"disable"`            // disable the firewall or keep it with the ports of node roles, network and NodePort range opened
+		SELinuxMode    SELinuxMode       `json:"selinuxMode,omitempty" enums:"disabled,permissive,enforcing" default:"disabled"` // SELinux mode of nodes, directories used by containers are labeled if it is enforcing
+		SwapPolicy     SwapPolicy        `json:"swapPolicy,omitempty" enums:"disable,keep" default:"disable"`                    // kubelet runs with swap if it is kept
+		HostnamePolicy HostnamePolicy    `json:"hostnamePolicy,omitempty" enums:"node-name,keep" default:"node-name"`            // hostname is set to node name, or must equal node name if it is kept
+		Items          map[string]bool   `json:"items,omitempty"`                                                                // init items enabled or disabled by name, absent items are enabled
+	}
+
+	FirewallMode string
+
+	SELinuxMode string
+
+	SwapPolicy string
+
+	HostnamePolicy string
+)
+
+const (
+	FirewallModeDisable   FirewallMode = "disable"
+	FirewallModeOpenPorts FirewallMode = "open-ports"
+
+	SELinuxModeDisabled   SELinuxMode = "disabled"
+	SELinuxModePermissive SELinuxMode = "permissive"
+	SELinuxModeEnforcing  SELinuxMode = "enforcing"
+
+	SwapPolicyDisable SwapPolicy = "disable"
+	SwapPolicyKeep    SwapPolicy = "keep"
+
+	HostnamePolicyNodeName HostnamePolicy = "node-name"
+	HostnamePolicyKeep     HostnamePolicy = "keep"
+
+	NodeInitTimezoneLengthLimit = 64
+)
+
+func (profile *NodeInitProfile) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(profile.Timezone, "nodeInitProfile.timezone", validator.ItemNoLimit, NodeInitTimezoneLengthLimit),
+	)
+
+	if profile.Timezone != "" {
+		wrapper.AddValidateFunc(validator.ValidateRegexp(nodeInitTimezoneRegexp, profile.Timezone, "nodeInitProfile.timezone"))
+	}
+
+	for _, server := range profile.NTPServers {
+		wrapper.AddValidateFunc(validator.ValidateRegexp(nodeInitNTPServerRegexp, server, "nodeInitProfile.ntpServers"))
+	}
+
+	if len(profile.Sysctl) > 0 {
+		wrapper.AddValidateFunc(profile.validateSysctl)
+	}
+
+	if profile.FirewallMode != "" {
+		wrapper.AddValidateFunc(validator.ValidateStringOptions(string(profile.FirewallMode), "nodeInitProfile.firewallMode",
+			[]string{string(FirewallModeDisable), string(FirewallModeOpenPorts)}))
+	}
+
+	if profile.SELinuxMode != "" {
+		wrapper.AddValidateFunc(validator.ValidateStringOptions(string(profile.SELinuxMode), "nodeInitProfile.selinuxMode",
+			[]string{string(SELinuxModeDisabled), string(SELinuxModePermissive), string(SELinuxModeEnforcing)}))
+	}
+
+	if profile.SwapPolicy != "" {
+		wrapper.AddValidateFunc(validator.ValidateStringOptions(string(profile.SwapPolicy), "nodeInitProfile.swapPolicy",
+			[]string{string(SwapPolicyDisable), string(SwapPolicyKeep)}))
+	}
+
+	if profile.HostnamePolicy != "" {
+		wrapper.AddValidateFunc(validator.ValidateStringOptions(string(profile.HostnamePolicy), "nodeInitProfile.hostnamePolicy",
+			[]string{string(HostnamePolicyNodeName), string(HostnamePolicyKeep)}))
+	}
+
+	return wrapper.Validate()
+}
+
+// validateSysctl checks sysctl keys and values are safe to pass to the init script.
+func (profile *NodeInitProfile) validateSysctl() error {
+
+	for key, value := range profile.Sysctl {
+		if !nodeInitSysctlKeyRegexp.MatchString(key) {
+			return fmt.Errorf("nodeInitProfile.sysctl key %q is invalid", key)
+		}
+		if !nodeInitSysctlValueRegexp.MatchString(value) {
+			return fmt.Errorf("nodeInitProfile.sysctl value %q of %s is invalid", value, key)
+		}
+	}
+
+	return nil
+}
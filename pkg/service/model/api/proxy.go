@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+var (
+	proxyURLRegexp     = regexp.MustCompile(`^[A-Za-z0-9._~:/?#@!&+,;=%\-\[\]]+$`)
+	proxyNoProxyRegexp = regexp.MustCompile(`^[A-Za-z0-9.:/*\-\[\]]+$`)
+)
+
+type (
+	Proxy struct {
+		HTTPProxy  string   `json:"httpProxy,omitempty" maxLength:"255"`  // proxy of http requests, like http://10.0.0.1:3128
+		HTTPSProxy string   `json:"httpsProxy,omitempty" maxLength:"255"` // proxy of https requests, like http://10.0.0.1:3128
+		NoProxy    []string `json:"noProxy,omitempty"`                    // addresses not proxied, node IPs, control plane endpoint, service and pod subnets are added automatically
+	}
+)
+
+const (
+	ProxyURLLengthLimit = 255
+)
+
+func (proxy *Proxy) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(proxy.HTTPProxy, "proxy.httpProxy", validator.ItemNoLimit, ProxyURLLengthLimit),
+		validator.ValidateString(proxy.HTTPSProxy, "proxy.httpsProxy", validator.ItemNoLimit, ProxyURLLengthLimit),
+	)
+
+	if proxy.HTTPProxy != "" {
+		wrapper.AddValidateFunc(validateProxyURL(proxy.HTTPProxy, "proxy.httpProxy"))
+	}
+
+	if proxy.HTTPSProxy != "" {
+		wrapper.AddValidateFunc(validateProxyURL(proxy.HTTPSProxy, "proxy.httpsProxy"))
+	}
+
+	for _, address := range proxy.NoProxy {
+		wrapper.AddValidateFunc(validator.ValidateRegexp(proxyNoProxyRegexp, address, "proxy.noProxy"))
+	}
+
+	return wrapper.Validate()
+}
+
+// validateProxyURL checks the proxy is a http or https url which is safe to pass to the init script.
+func validateProxyURL(proxyURL, keyName string) validator.ValidateFunc {
+
+	return func() error {
+
+		if !proxyURLRegexp.MatchString(proxyURL) {
+			return fmt.Errorf("%s %q is invalid", keyName, proxyURL)
+		}
+
+		parsed, err := url.Parse(proxyURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%s %q is invalid, should be like http://host:port", keyName, proxyURL)
+		}
+
+		return nil
+	}
+}
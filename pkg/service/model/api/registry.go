@@ -0,0 +1,92 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+var (
+	registryServerRegexp   = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9.\-]*[A-Za-z0-9])?(:[0-9]{1,5})?$`)
+	registryUsernameRegexp = regexp.MustCompile(`^[A-Za-z0-9._@+\-]+$`)
+)
+
+type (
+	Registry struct {
+		Server   string   `json:"server" binding:"required" maxLength:"255"` // host[:port] of the registry, docker.io to set up mirrors of docker hub
+		Username string   `json:"username,omitempty" maxLength:"255"`        // nodes log in to the registry if username is not empty
+		Password string   `json:"password,omitempty" maxLength:"255"`        // password of username, only exported when includeSecrets is true, the stored password is kept if empty
+		CABundle string   `json:"caBundle,omitempty"`                        // PEM encoded CA certificates of the registry
+		Insecure bool     `json:"insecure,omitempty"`                        // access the registry by http or by https without certificate verification
+		Mirrors  []string `json:"mirrors,omitempty"`                         // mirror endpoints like https://mirror.example.com, docker only supports mirrors of docker.io
+	}
+)
+
+const (
+	RegistryServerDockerHub  = "docker.io"
+	RegistryFieldLengthLimit = 255
+)
+
+func (registry *Registry) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(registry.Server, "registries.server", validator.ItemNotEmptyLimit, RegistryFieldLengthLimit),
+		validator.ValidateRegexp(registryServerRegexp, registry.Server, "registries.server"),
+		validator.ValidateString(registry.Username, "registries.username", validator.ItemNoLimit, RegistryFieldLengthLimit),
+		validator.ValidateString(registry.Password, "registries.password", validator.ItemNoLimit, RegistryFieldLengthLimit),
+	)
+
+	if registry.Username != "" {
+		wrapper.AddValidateFunc(validator.ValidateRegexp(registryUsernameRegexp, registry.Username, "registries.username"))
+	}
+
+	if strings.ContainsAny(registry.Password, "\n\r") {
+		wrapper.AddValidateFunc(func() error {
+			return fmt.Errorf("registries.password of %s can not contain line breaks", registry.Server)
+		})
+	}
+
+	if registry.CABundle != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(registry.CABundle)) {
+		wrapper.AddValidateFunc(func() error {
+			return fmt.Errorf("registries.caBundle of %s has no valid PEM certificate", registry.Server)
+		})
+	}
+
+	for _, mirror := range registry.Mirrors {
+		wrapper.AddValidateFunc(validateRegistryMirror(mirror))
+	}
+
+	return wrapper.Validate()
+}
+
+func validateRegistryMirror(mirror string) validator.ValidateFunc {
+
+	return func() error {
+
+		parsed, err := url.Parse(mirror)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" ||
+			parsed.User != nil || !proxyURLRegexp.MatchString(mirror) {
+			return fmt.Errorf("registries.mirrors %q is invalid, should be like https://host:port", mirror)
+		}
+
+		return nil
+	}
+}
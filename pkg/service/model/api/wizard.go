@@ -22,6 +22,7 @@ type (
 	GetWizardResponse struct {
 		ClusterData         Cluster                      `json:"cluster"`                                                                                                    // Cluster Information
 		NetworkOptions      NetworkOptions               `json:"networkOptions"`                                                                                             // Network options
+		IngressOptions      IngressOptions               `json:"ingressOptions"`                                                                                             // Ingress options
 		NodesData           []NodeData                   `json:"nodes"`                                                                                                      // Nodes Information
 		CheckingData        []CheckingResultResponseData `json:"checkingData"`                                                                                               // Check result
 		DeploymentData      []DeploymentResponseData     `json:"deploymentData"`                                                                                             // Deployment result
@@ -31,9 +31,12 @@ type (
 		ClusterId           uint64
 		Info                *ClusterInfo
 		NetworkOptions      *api.NetworkOptions
+		IngressOptions      *api.IngressOptions
 		Nodes               []*Node
 		DeployClusterStatus DeployClusterStatus
 		DeployClusterError  *common.FailureDetail
+		VerifyItems         []*CheckItem        // Results of the smoke test after the cluster is deployed
+		TaskExecutions      []api.TaskExecution // Progress of the deploy tasks which have an execution policy
 		ClusterCheckResult  constant.CheckResult
 		ClusterCheckError   *common.FailureDetail
 		Wizard              *WizardData
@@ -49,18 +52,47 @@ type (
 		NodePortMaximum         uint16
 		Labels                  []*Label
 		Annotations             []*Annotation
+		PodSubnets              []string
+		ServiceSubnets          []string
+		CheckThresholds         []api.CheckThreshold
+		ContainerRuntime        api.ContainerRuntime
+		NodeInitProfile         *api.NodeInitProfile
+		Proxy                   *api.Proxy
+		ImageRepository         string
+		Registries              []api.Registry // passwords are only returned when secrets are exported
+		ComponentConfig         *api.ComponentConfig
+		Audit                   *api.Audit
+		Encryption              *api.Encryption
 	}
 
 	KubeAPIServerConnectionData struct {
 		KubeAPIServerConnectType KubeAPIServerConnectType
 		VIP                      string
 		NetInterfaceName         string
+		HaproxyPort              uint16
+		HaproxyStatsPort         uint16
+		VirtualRouterID          uint8
+		KeepalivedAuthPassword   string // only returned when secrets are exported
+		HealthCheckInterval      uint16
 		LoadbalancerIP           string
 		LoadbalancerPort         uint16
+		KubeVIPMode              KubeVIPMode
+		KubeVIPImage             string
+		BGPLocalAS               uint32
+		BGPRouterID              string
+		BGPPeers                 []*BGPPeer
+	}
+
+	BGPPeer struct {
+		Address  string
+		AS       uint32
+		Password string
 	}
 
 	KubeAPIServerConnectType string
 
+	KubeVIPMode string
+
 	DeployClusterStatus string
 )
 
@@ -68,6 +100,10 @@ const (
 	KubeAPIServerConnectTypeFirstMasterIP KubeAPIServerConnectType = "firstMasterIP"
 	KubeAPIServerConnectTypeKeepalived    KubeAPIServerConnectType = "keepalived"
 	KubeAPIServerConnectTypeLoadBalancer  KubeAPIServerConnectType = "loadbalancer"
+	KubeAPIServerConnectTypeKubeVIP       KubeAPIServerConnectType = "kubevip"
+
+	KubeVIPModeARP KubeVIPMode = "arp"
+	KubeVIPModeBGP KubeVIPMode = "bgp"
 
 	DeployClusterStatusPending              DeployClusterStatus = "pending"
 	DeployClusterStatusRunning              DeployClusterStatus = "running"
@@ -95,6 +131,7 @@ func (cluster *Cluster) init() {
 
 	cluster.Info = NewClusterInfo()
 	cluster.NetworkOptions = NewNetworkOptions()
+	cluster.IngressOptions = NewIngressOptions()
 	cluster.DeployClusterStatus = DeployClusterStatusPending
 	cluster.ClusterCheckResult = constant.CheckResultPending
 	cluster.Nodes = make([]*Node, 0, 0)
@@ -170,23 +207,7 @@ func (cluster *Cluster) UpdateNode(node *Node) error {
 		}
 	}
 
-	targetNode.Name = node.Name
-	targetNode.Description = node.Description
-	if node.DockerRootDirectory != "" {
-		targetNode.DockerRootDirectory = node.DockerRootDirectory
-	}
-
-	targetNode.MachineRoles = node.MachineRoles
-	targetNode.Labels = node.Labels
-	targetNode.Taints = node.Taints
-	targetNode.ConnectionData.IP = node.ConnectionData.IP
-	targetNode.ConnectionData.Port = node.ConnectionData.Port
-	targetNode.ConnectionData.Username = node.ConnectionData.Username
-	targetNode.ConnectionData.AuthenticationType = node.ConnectionData.AuthenticationType
-	targetNode.ConnectionData.PrivateKeyName = node.ConnectionData.PrivateKeyName
-	if len(node.ConnectionData.Password) != 0 {
-		targetNode.ConnectionData.Password = node.ConnectionData.Password
-	}
+	targetNode.updateFrom(node)
 
 	return nil
 }
@@ -311,6 +332,8 @@ func (cluster *Cluster) ClearClusterDeployData() {
 
 	cluster.DeployClusterStatus = DeployClusterStatusPending
 	cluster.DeployClusterError = nil
+	cluster.VerifyItems = nil
+	cluster.TaskExecutions = nil
 
 	for _, node := range cluster.Nodes {
 
@@ -349,6 +372,38 @@ func (cluster *Cluster) SetClusterDeploymentStatus(status DeployClusterStatus, f
 	}
 }
 
+func (cluster *Cluster) SetVerifyItems(items []*CheckItem) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	cluster.VerifyItems = items
+}
+
+func (cluster *Cluster) GetVerifyItems() []*CheckItem {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	return cluster.VerifyItems
+}
+
+func (cluster *Cluster) SetTaskExecutions(executions []api.TaskExecution) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	cluster.TaskExecutions = executions
+}
+
+func (cluster *Cluster) GetTaskExecutions() []api.TaskExecution {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	return cluster.TaskExecutions
+}
+
 func (cluster *Cluster) AddNodeList(nodes []*Node) error {
 
 	cluster.lock.Lock()
@@ -382,6 +437,84 @@ func (cluster *Cluster) AddNodeList(nodes []*Node) error {
 	return nil
 }
 
+// UpsertNodeList update the existing nodes which have the same ip, and add the others.
+func (cluster *Cluster) UpsertNodeList(nodes []*Node) error {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	upsertNodes := make(map[string]bool)
+	for _, iterateNode := range nodes {
+
+		if _, exist := upsertNodes[iterateNode.IP]; exist {
+			return h.EExists.WithPayload(fmt.Sprintf("node ip %s was duplicated", iterateNode.IP))
+		}
+		upsertNodes[iterateNode.IP] = true
+	}
+
+	nameOwners := make(map[string]string)
+	for _, iterateNode := range cluster.Nodes {
+
+		if _, updating := upsertNodes[iterateNode.IP]; updating {
+			continue
+		}
+		nameOwners[iterateNode.Name] = iterateNode.IP
+	}
+
+	for _, iterateNode := range nodes {
+
+		if _, exist := nameOwners[iterateNode.Name]; exist {
+			return h.EExists.WithPayload(fmt.Sprintf("node name %s was exist", iterateNode.Name))
+		}
+		nameOwners[iterateNode.Name] = iterateNode.IP
+	}
+
+	for _, iterateNode := range nodes {
+
+		if targetNode := cluster.GetNode(iterateNode.IP); targetNode != nil {
+			targetNode.updateFrom(iterateNode)
+			continue
+		}
+		cluster.Nodes = append(cluster.Nodes, iterateNode)
+	}
+
+	return nil
+}
+
+// ReplaceConfiguration replace cluster information, network options, ingress options and nodes,
+// checking and deployment data will be reset.
+func (cluster *Cluster) ReplaceConfiguration(info *ClusterInfo, networkOptions *api.NetworkOptions,
+	ingressOptions *api.IngressOptions, nodes []*Node) error {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	if cluster.ClusterCheckResult == constant.CheckResultRunning {
+		return h.EStatusError.WithPayload("It was checking")
+	}
+
+	if cluster.DeployClusterStatus == DeployClusterStatusRunning {
+		return h.EStatusError.WithPayload("It was deploying")
+	}
+
+	cluster.Info = info
+	if networkOptions != nil {
+		cluster.NetworkOptions = networkOptions
+	}
+	if ingressOptions != nil {
+		cluster.IngressOptions = ingressOptions
+	}
+	cluster.Nodes = nodes
+	cluster.ClusterCheckResult = constant.CheckResultPending
+	cluster.ClusterCheckError = nil
+	cluster.DeployClusterStatus = DeployClusterStatusPending
+	cluster.DeployClusterError = nil
+	cluster.VerifyItems = nil
+	cluster.TaskExecutions = nil
+
+	return nil
+}
+
 func NewClusterInfo() *ClusterInfo {
 
 	info := new(ClusterInfo)
@@ -396,6 +529,9 @@ func (info *ClusterInfo) init() {
 	info.Annotations = make([]*Annotation, 0, 0)
 	info.NodePortMinimum = DefaultNodePortMinimum
 	info.NodePortMaximum = DefaultNodePortMaximum
+	info.PodSubnets = []string{constant.DefaultPodSubnet}
+	info.ServiceSubnets = []string{constant.DefaultServiceSubnet}
+	info.ContainerRuntime = api.ContainerRuntimeDocker
 }
 
 func NewNetworkOptions() *api.NetworkOptions {
@@ -406,6 +542,11 @@ func NewNetworkOptions() *api.NetworkOptions {
 	return options
 }
 
+func NewIngressOptions() *api.IngressOptions {
+	options := DefaultIngressOptions
+	return &options
+}
+
 func NewKubeAPIServerConnectionData() *KubeAPIServerConnectionData {
 
 	data := new(KubeAPIServerConnectionData)
@@ -1439,3 +1439,62 @@ func TestCluster_AddNodeList(t *testing.T) {
 		assert.Equal(t, test.WantNodeList, cluster.Nodes)
 	}
 }
+
+func TestCluster_UpsertNodeList(t *testing.T) {
+
+	newNode := func(name, ip, password string) *Node {
+		node := NewNode()
+		node.Name = name
+		node.IP = ip
+		node.Password = password
+		return node
+	}
+
+	tests := []struct {
+		BaseNodeList []*Node
+		Input        []*Node
+		Want         error
+		WantNames    map[string]string
+		WantPassword map[string]string
+	}{
+		{
+			BaseNodeList: []*Node{newNode("master1", "192.168.31.1", "111")},
+			Input:        []*Node{newNode("master1-new", "192.168.31.1", ""), newNode("master2", "192.168.31.2", "222")},
+			Want:         nil,
+			WantNames:    map[string]string{"192.168.31.1": "master1-new", "192.168.31.2": "master2"},
+			WantPassword: map[string]string{"192.168.31.1": "111", "192.168.31.2": "222"},
+		},
+		{
+			BaseNodeList: []*Node{newNode("master1", "192.168.31.1", "111"), newNode("master2", "192.168.31.2", "222")},
+			Input:        []*Node{newNode("master2", "192.168.31.1", "")},
+			Want:         h.EExists.WithPayload("node name master2 was exist"),
+			WantNames:    map[string]string{"192.168.31.1": "master1", "192.168.31.2": "master2"},
+		},
+		{
+			// swap the names of two nodes
+			BaseNodeList: []*Node{newNode("master1", "192.168.31.1", "111"), newNode("master2", "192.168.31.2", "222")},
+			Input:        []*Node{newNode("master2", "192.168.31.1", ""), newNode("master1", "192.168.31.2", "")},
+			Want:         nil,
+			WantNames:    map[string]string{"192.168.31.1": "master2", "192.168.31.2": "master1"},
+		},
+		{
+			BaseNodeList: []*Node{},
+			Input:        []*Node{newNode("master1", "192.168.31.1", ""), newNode("master2", "192.168.31.1", "")},
+			Want:         h.EExists.WithPayload("node ip 192.168.31.1 was duplicated"),
+			WantNames:    map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		cluster := NewCluster()
+		cluster.Nodes = test.BaseNodeList
+		assert.Equal(t, test.Want, cluster.UpsertNodeList(test.Input))
+		assert.Len(t, cluster.Nodes, len(test.WantNames))
+		for ip, name := range test.WantNames {
+			assert.Equal(t, name, cluster.GetNode(ip).Name)
+		}
+		for ip, password := range test.WantPassword {
+			assert.Equal(t, password, cluster.GetNode(ip).Password)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wizard
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+)
+
+var DefaultIngressOptions api.IngressOptions = api.IngressOptions{
+	IngressType: api.IngressTypeContour,
+	ExposeMode:  api.IngressExposeModeHostNetwork,
+}
+
+func (cluster *Cluster) SetIngressOptions(options *api.IngressOptions) {
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+	cluster.IngressOptions = options
+}
+
+func (cluster *Cluster) GetIngressOptions() *api.IngressOptions {
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+	if cluster.IngressOptions == nil {
+		return &DefaultIngressOptions
+	}
+	return cluster.IngressOptions
+}
@@ -102,6 +102,7 @@ const (
 
 	DefaultDockerRootDirectory = "/var/lib/docker"
 	DefaultUsername            = "root"
+	DefaultSSHPort             = uint16(22)
 )
 
 func NewNode() *Node {
@@ -117,7 +118,7 @@ func (node *Node) init() {
 	node.initDeploymentReports()
 	node.Labels = make([]*Label, 0, 0)
 	node.Taints = make([]*Taint, 0, 0)
-	node.ConnectionData.Port = uint16(22)
+	node.ConnectionData.Port = DefaultSSHPort
 	node.ConnectionData.Username = DefaultUsername
 	node.ConnectionData.AuthenticationType = AuthenticationTypePassword
 	node.DockerRootDirectory = DefaultDockerRootDirectory
@@ -126,6 +127,28 @@ func (node *Node) init() {
 	node.rwLock = sync.RWMutex{}
 }
 
+// updateFrom copy the configuration of source node, keep password and docker root directory if not set
+func (node *Node) updateFrom(source *Node) {
+
+	node.Name = source.Name
+	node.Description = source.Description
+	if source.DockerRootDirectory != "" {
+		node.DockerRootDirectory = source.DockerRootDirectory
+	}
+
+	node.MachineRoles = source.MachineRoles
+	node.Labels = source.Labels
+	node.Taints = source.Taints
+	node.ConnectionData.IP = source.ConnectionData.IP
+	node.ConnectionData.Port = source.ConnectionData.Port
+	node.ConnectionData.Username = source.ConnectionData.Username
+	node.ConnectionData.AuthenticationType = source.ConnectionData.AuthenticationType
+	node.ConnectionData.PrivateKeyName = source.ConnectionData.PrivateKeyName
+	if len(source.ConnectionData.Password) != 0 {
+		node.ConnectionData.Password = source.ConnectionData.Password
+	}
+}
+
 func (node *Node) initDeploymentReports() {
 	node.DeploymentReports = make(map[constant.DeployItem]*DeploymentReport)
 }
@@ -33,9 +33,11 @@ var doc = `{
     "paths": {
         "/api/v1/deploy/wizard/batchnodes": {
             "post": {
-                "description": "Upload batch nodes configuration file to node list",
+                "description": "Upload batch nodes configuration file to node list, supports text, csv and yaml format.\nThe first line of csv content must be header, columns: name,description,roles,ip,port,username,authorizationType,password,privateKeyName,dockerRootDirectory,labels,taints.\nMultiple values in a csv cell are separated by \";\", labels like \"key=value;key2=value2\", taints like \"key=value:NoSchedule\".\nThe yaml content is a node list under \"nodes\" field, node fields are the same as node api.",
                 "consumes": [
-                    "text/plain"
+                    "text/plain",
+                    "text/csv",
+                    "application/x-yaml"
                 ],
                 "produces": [
                     "application/json"
@@ -54,14 +56,62 @@ var doc = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "csv",
+                            "yaml"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "content format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "add",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "add",
+                        "description": "add: fail if the node exists, upsert: update the existing node matched by ip",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "only validate the nodes, return errors of each line",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.UploadBatchNodesDryRunResponse"
+                        }
+                    },
                     "201": {
                         "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/api.GetNodeListResponse"
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
                     }
                 }
             }
@@ -173,36 +223,79 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/deploy/wizard/deploys": {
+        "/api/v1/deploy/wizard/configurations": {
             "get": {
-                "description": "Get the result of the deployment",
+                "description": "Export cluster information, network options, nodes and certificate references. Passwords and private keys are only exported when includeSecrets is true.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/x-yaml"
                 ],
                 "tags": [
-                    "deploy"
+                    "wizard"
+                ],
+                "summary": "Export wizard configuration",
+                "operationId": "ExportWizardConfiguration",
+                "parameters": [
+                    {
+                        "enum": [
+                            "json",
+                            "yaml"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "output format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "export passwords and private keys",
+                        "name": "includeSecrets",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get the result of deployment",
-                "operationId": "GetDeploymentReport",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.GetDeploymentReportResponse"
+                            "$ref": "#/definitions/api.WizardConfiguration"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Launch deployment",
+                "description": "Replace cluster information, network options and nodes with the uploaded configuration, both json and yaml are accepted. Checking and deployment results will be cleared.",
+                "consumes": [
+                    "application/json",
+                    "application/x-yaml"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "deploy"
+                    "wizard"
+                ],
+                "summary": "Import wizard configuration",
+                "operationId": "ImportWizardConfiguration",
+                "parameters": [
+                    {
+                        "description": "wizard configuration",
+                        "name": "configuration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.WizardConfiguration"
+                        }
+                    }
                 ],
-                "summary": "Launch deployment",
-                "operationId": "LaunchDeployment",
                 "responses": {
                     "201": {
                         "description": "Created",
@@ -210,8 +303,8 @@ var doc = `{
                             "$ref": "#/definitions/api.SuccessfulOption"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/h.AppErr"
                         }
@@ -219,22 +312,55 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/deploy/wizard/kubeconfigs": {
+        "/api/v1/deploy/wizard/deploys": {
             "get": {
-                "description": "Download kubeconfig file",
+                "description": "Get the result of the deployment",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "kubeconfig"
+                    "deploy"
                 ],
-                "summary": "Download kubeconfig file content",
-                "operationId": "DownloadKubeConfig",
+                "summary": "Get the result of deployment",
+                "operationId": "GetDeploymentReport",
                 "responses": {
                     "200": {
-                        "description": "Kube Config File Content",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.GetDeploymentReportResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Launch deployment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deploy"
+                ],
+                "summary": "Launch deployment",
+                "operationId": "LaunchDeployment",
+                "parameters": [
+                    {
+                        "description": "Execution policies of the deployment",
+                        "name": "deployRequest",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.DeployRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
                         }
                     },
                     "400": {
@@ -252,31 +378,36 @@ var doc = `{
                 }
             }
         },
-        "/api/v1/deploy/wizard/logs/{id}": {
-            "get": {
-                "description": "Download the deployment log details to check the cause of the error",
+        "/api/v1/deploy/wizard/deploys/plan": {
+            "post": {
+                "description": "Split the deployment into sub tasks and actions and render the files put to nodes, nothing is executed on nodes",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "log"
+                    "deploy"
                 ],
-                "summary": "Download the log detail",
-                "operationId": "DownloadLog",
+                "summary": "Preview deployment plan",
+                "operationId": "PlanDeployment",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Log ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Execution policies of the deployment",
+                        "name": "deployRequest",
+                        "in": "body",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.DeployRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Log File Content",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.DeployPlanResponse"
                         }
                     },
                     "400": {
@@ -290,55 +421,85 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/h.AppErr"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/deploy/wizard/networks": {
+        "/api/v1/deploy/wizard/encryptionkeys": {
             "get": {
-                "description": "get currently stored network options, returns default options if nothing stored.",
+                "description": "Get the status of the latest encryption key rotation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "network"
+                    "encryption"
                 ],
-                "summary": "get current network options",
-                "operationId": "GetNetwork",
+                "summary": "Get the result of encryption key rotation",
+                "operationId": "GetEncryptionKeyRotationResult",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.NetworkOptions"
+                            "$ref": "#/definitions/api.GetEncryptionKeyRotationResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
                         }
                     }
                 }
             },
             "post": {
-                "description": "set network options",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Start to replace the key which encrypts secrets at rest on all masters and rewrite secrets by the new key, kube-apiserver on masters are restarted in turn, the result is got by GET of the same path",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "network"
+                    "encryption"
                 ],
-                "summary": "set network options",
-                "operationId": "SetNetwork",
-                "parameters": [
-                    {
-                        "description": "options of network components in the cluster",
-                        "name": "networkOptions",
-                        "in": "body",
-                        "required": true,
+                "summary": "Rotate encryption key",
+                "operationId": "RotateEncryptionKey",
+                "responses": {
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/api.NetworkOptions"
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
                         }
                     }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/haconfigs": {
+            "post": {
+                "description": "Regenerate haproxy and keepalived config on all masters by the current wizard nodes and cluster, and reload them. It's used after masters are added or removed, or keepalived parameters are changed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster"
                 ],
+                "summary": "Reconfigure haproxy and keepalived of deployed cluster",
+                "operationId": "ReconfigureHA",
                 "responses": {
                     "201": {
                         "description": "Created",
@@ -351,32 +512,38 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/h.AppErr"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
                     }
                 }
             }
         },
-        "/api/v1/deploy/wizard/nodes": {
+        "/api/v1/deploy/wizard/ingresses": {
             "get": {
-                "description": "Get nodes information",
+                "description": "get currently stored ingress options, returns default options if nothing stored.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "node"
+                    "ingress"
                 ],
-                "summary": "Get nodes information",
-                "operationId": "GetNodeList",
+                "summary": "get current ingress options",
+                "operationId": "GetIngress",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.GetNodeListResponse"
+                            "$ref": "#/definitions/api.IngressOptions"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Add deployment candidate to node list",
+                "description": "set ingress controller options, the controller is deployed on ingress nodes",
                 "consumes": [
                     "application/json"
                 ],
@@ -384,19 +551,19 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "node"
+                    "ingress"
                 ],
-                "summary": "Add Node Information",
-                "operationId": "AddNode",
+                "summary": "set ingress options",
+                "operationId": "SetIngress",
                 "parameters": [
                     {
-                        "description": "Node information",
-                        "name": "node",
+                        "description": "options of ingress controller in the cluster",
+                        "name": "ingressOptions",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/api.NodeData"
+                            "$ref": "#/definitions/api.IngressOptions"
                         }
                     }
                 ],
@@ -404,7 +571,7 @@ var doc = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api.NodeData"
+                            "$ref": "#/definitions/api.SuccessfulOption"
                         }
                     },
                     "400": {
@@ -412,41 +579,26 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/h.AppErr"
                         }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/h.AppErr"
-                        }
                     }
                 }
             }
         },
-        "/api/v1/deploy/wizard/nodes/{ip}": {
+        "/api/v1/deploy/wizard/kubeconfigs": {
             "get": {
-                "description": "Get a node information",
+                "description": "Download kubeconfig file",
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "node"
-                ],
-                "summary": "Get a node information",
-                "operationId": "GetNode",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Node IP Address",
-                        "name": "ip",
-                        "in": "path",
-                        "required": true
-                    }
+                    "kubeconfig"
                 ],
+                "summary": "Download kubeconfig file content",
+                "operationId": "DownloadKubeConfig",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Kube Config File Content",
                         "schema": {
-                            "$ref": "#/definitions/api.NodeData"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -462,12 +614,266 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a node information which in deployment candidate node list",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/v1/deploy/wizard/logs/{id}": {
+            "get": {
+                "description": "Download the deployment log details to check the cause of the error",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "log"
+                ],
+                "summary": "Download the log detail",
+                "operationId": "DownloadLog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Log ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Log File Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/networks": {
+            "get": {
+                "description": "get currently stored network options, returns default options if nothing stored.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "network"
+                ],
+                "summary": "get current network options",
+                "operationId": "GetNetwork",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.NetworkOptions"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "set network options",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "network"
+                ],
+                "summary": "set network options",
+                "operationId": "SetNetwork",
+                "parameters": [
+                    {
+                        "description": "options of network components in the cluster",
+                        "name": "networkOptions",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.NetworkOptions"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/nodeconfigs": {
+            "post": {
+                "description": "Diff labels, annotations and taints of the wizard nodes against the live nodes and apply the changes, drifts made outside kpaas since last applied are reported",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Update node labels, annotations and taints of deployed cluster",
+                "operationId": "UpdateNodeConfig",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "only compute the changes and drifts, do not apply them",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.UpdateNodeConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/nodes": {
+            "get": {
+                "description": "Get nodes information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Get nodes information",
+                "operationId": "GetNodeList",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.GetNodeListResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add deployment candidate to node list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Add Node Information",
+                "operationId": "AddNode",
+                "parameters": [
+                    {
+                        "description": "Node information",
+                        "name": "node",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.NodeData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.NodeData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/nodes/{ip}": {
+            "get": {
+                "description": "Get a node information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Get a node information",
+                "operationId": "GetNode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node IP Address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.NodeData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a node information which in deployment candidate node list",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
@@ -1090,18 +1496,97 @@ var doc = `{
                 }
             }
         },
-        "api.CalicoOptions": {
+        "api.Audit": {
             "type": "object",
             "properties": {
-                "encapsulationMode": {
+                "level": {
+                    "description": "level of the built-in audit policy, ignored if policy is given",
                     "type": "string",
                     "enum": [
-                        "vxlan",
-                        " ipip",
-                        " none"
+                        "None",
+                        "Metadata",
+                        "Request",
+                        "RequestResponse"
                     ]
                 },
-                "initialPodIPs": {
+                "maxAge": {
+                    "description": "days to keep the rotated audit logs",
+                    "type": "integer",
+                    "default": 30
+                },
+                "maxBackup": {
+                    "description": "number of the rotated audit logs kept",
+                    "type": "integer",
+                    "default": 10
+                },
+                "maxSize": {
+                    "description": "megabytes of the audit log before it's rotated",
+                    "type": "integer",
+                    "default": 100
+                },
+                "policy": {
+                    "description": "custom audit policy(audit.k8s.io/v1) in yaml or json",
+                    "type": "string",
+                    "maxLength": 65536
+                }
+            }
+        },
+        "api.BGPPeer": {
+            "type": "object",
+            "required": [
+                "address",
+                "as"
+            ],
+            "properties": {
+                "address": {
+                    "description": "peer ip address",
+                    "type": "string",
+                    "maxLength": 39
+                },
+                "as": {
+                    "description": "peer AS number",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "password": {
+                    "description": "bgp session password",
+                    "type": "string"
+                }
+            }
+        },
+        "api.BatchNodesError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "error message",
+                    "type": "string"
+                },
+                "ip": {
+                    "description": "node ip",
+                    "type": "string"
+                },
+                "line": {
+                    "description": "Line number of text and csv content, sequence number of yaml nodes, starts from 1",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "node name",
+                    "type": "string"
+                }
+            }
+        },
+        "api.CalicoOptions": {
+            "type": "object",
+            "properties": {
+                "encapsulationMode": {
+                    "type": "string",
+                    "enum": [
+                        "vxlan",
+                        " ipip",
+                        " none"
+                    ]
+                },
+                "initialPodIPs": {
                     "type": "string"
                 },
                 "ipDetectionInterface": {
@@ -1134,6 +1619,57 @@ var doc = `{
                 }
             }
         },
+        "api.CheckThreshold": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "minimum": {
+                    "description": "the node check fails if the node is under the minimum values",
+                    "type": "object",
+                    "$ref": "#/definitions/api.CheckThresholdValues"
+                },
+                "recommended": {
+                    "description": "the node check passes with a warning if the node is under the recommended values",
+                    "type": "object",
+                    "$ref": "#/definitions/api.CheckThresholdValues"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "master",
+                        "worker",
+                        "etcd",
+                        "ingress"
+                    ]
+                }
+            }
+        },
+        "api.CheckThresholdValues": {
+            "type": "object",
+            "properties": {
+                "cpuCore": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "dockerVersion": {
+                    "description": "use the built-in value if empty, the same below",
+                    "type": "string"
+                },
+                "kernelVersion": {
+                    "type": "string"
+                },
+                "memoryGiB": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "rootDiskGiB": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
         "api.CheckingItem": {
             "type": "object",
             "properties": {
@@ -1172,6 +1708,26 @@ var doc = `{
                 }
             }
         },
+        "api.CiliumOptions": {
+            "type": "object",
+            "properties": {
+                "mtu": {
+                    "type": "integer"
+                },
+                "nativeRoutingCIDR": {
+                    "description": "required when tunnelMode is disabled",
+                    "type": "string"
+                },
+                "tunnelMode": {
+                    "type": "string",
+                    "enum": [
+                        "vxlan",
+                        "geneve",
+                        "disabled"
+                    ]
+                }
+            }
+        },
         "api.Cluster": {
             "type": "object",
             "required": [
@@ -1186,13 +1742,106 @@ var doc = `{
                         "$ref": "#/definitions/api.Annotation"
                     }
                 },
+                "audit": {
+                    "description": "audit logging of kube-apiserver, audit is disabled if empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Audit"
+                },
+                "bgpLocalAS": {
+                    "description": "local AS number when kubeVIPMode is bgp required",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "bgpPeers": {
+                    "description": "bgp peers when kubeVIPMode is bgp required",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BGPPeer"
+                    }
+                },
+                "bgpRouterID": {
+                    "description": "bgp router id, use the master node ip when empty",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "checkThresholds": {
+                    "description": "thresholds of node checks by role, built-in thresholds are used for the roles absent",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CheckThreshold"
+                    }
+                },
+                "componentConfig": {
+                    "description": "arguments and configurations passed through to kubernetes components, kubeadm defaults if empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.ComponentConfig"
+                },
+                "containerRuntime": {
+                    "description": "container runtime of nodes, containerd is installed by node init",
+                    "type": "string",
+                    "default": "docker",
+                    "enum": [
+                        "docker",
+                        "containerd"
+                    ]
+                },
+                "encryption": {
+                    "description": "encryption of secrets at rest, secrets are stored unencrypted if empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Encryption"
+                },
+                "haproxyPort": {
+                    "description": "port haproxy listens on for kube-apiserver when kubeAPIServerConnectType is keepalived",
+                    "type": "integer",
+                    "default": 4443,
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "haproxyStatsPort": {
+                    "description": "port of haproxy stats page when kubeAPIServerConnectType is keepalived",
+                    "type": "integer",
+                    "default": 1936,
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "healthCheckInterval": {
+                    "description": "interval seconds of keepalived checking haproxy",
+                    "type": "integer",
+                    "default": 2,
+                    "minimum": 1
+                },
+                "imageRepository": {
+                    "description": "repository of kubernetes and etcd images like registry.example.com/kpaas, docker.io/kpaas if empty",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "keepalivedAuthPassword": {
+                    "description": "keepalived vrrp authentication password, no authentication when empty",
+                    "type": "string",
+                    "maxLength": 8
+                },
                 "kubeAPIServerConnectType": {
                     "description": "kube-apiserver connect type",
                     "type": "string",
                     "enum": [
                         "firstMasterIP",
                         "keepalived",
-                        "loadbalancer"
+                        "loadbalancer",
+                        "kubevip"
+                    ]
+                },
+                "kubeVIPImage": {
+                    "description": "kube-vip image, use the default image when empty",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "kubeVIPMode": {
+                    "description": "how kube-vip announces the virtual ip when kubeAPIServerConnectType is kubevip",
+                    "type": "string",
+                    "default": "arp",
+                    "enum": [
+                        "arp",
+                        "bgp"
                     ]
                 },
                 "labels": {
@@ -1204,7 +1853,7 @@ var doc = `{
                 "loadbalancerIP": {
                     "description": "kube-apiserver loadbalancer ip when kubeAPIServerConnectType is loadbalancer required",
                     "type": "string",
-                    "maxLength": 15
+                    "maxLength": 39
                 },
                 "loadbalancerPort": {
                     "description": "kube-apiserver loadbalancer port when kubeAPIServerConnectType is loadbalancer required",
@@ -1216,10 +1865,15 @@ var doc = `{
                     "type": "string"
                 },
                 "netInterfaceName": {
-                    "description": "keepalived listen net interface name",
+                    "description": "keepalived or kube-vip listen net interface name",
                     "type": "string",
                     "maxLength": 30
                 },
+                "nodeInitProfile": {
+                    "description": "how nodes are initialized, the defaults are used if empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.NodeInitProfile"
+                },
                 "nodePortMaximum": {
                     "type": "integer",
                     "default": 32767,
@@ -1230,15 +1884,93 @@ var doc = `{
                     "default": 30000,
                     "minimum": 1
                 },
+                "podSubnets": {
+                    "description": "pod subnets, one IPv4 and one IPv6 subnet make a dual-stack cluster",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "proxy": {
+                    "description": "proxy used by package manager, container runtime and kubeadm on nodes, no proxy if empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Proxy"
+                },
+                "registries": {
+                    "description": "registries whose credentials, certificates and mirrors are set up on nodes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.Registry"
+                    }
+                },
+                "serviceSubnets": {
+                    "description": "service subnets, must be of the same ip families as pod subnets",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "shortName": {
                     "type": "string",
                     "maxLength": 20,
                     "minLength": 1
                 },
                 "vip": {
-                    "description": "keepalived listen virtual ip",
+                    "description": "keepalived or kube-vip listen virtual ip",
                     "type": "string",
-                    "maxLength": 15
+                    "maxLength": 39
+                },
+                "virtualRouterID": {
+                    "description": "keepalived virtual router id, use the last octet of vip when empty",
+                    "type": "integer",
+                    "maximum": 255,
+                    "minimum": 1
+                }
+            }
+        },
+        "api.ComponentConfig": {
+            "type": "object",
+            "properties": {
+                "apiServerExtraArgs": {
+                    "description": "extra arguments of kube-apiserver without the leading \"--\", like {\"default-watch-cache-size\": \"200\"}",
+                    "type": "object"
+                },
+                "controllerManagerExtraArgs": {
+                    "description": "extra arguments of kube-controller-manager without the leading \"--\"",
+                    "type": "object"
+                },
+                "kubeProxyMode": {
+                    "description": "mode of kube-proxy, iptables if empty, ipvs is required by dual-stack",
+                    "type": "string",
+                    "enum": [
+                        "iptables",
+                        "ipvs"
+                    ]
+                },
+                "kubeletConfiguration": {
+                    "description": "KubeletConfiguration(kubelet.config.k8s.io/v1beta1) in yaml or json, like maxPods, evictionHard and systemReserved",
+                    "type": "string",
+                    "maxLength": 65536
+                },
+                "schedulerExtraArgs": {
+                    "description": "extra arguments of kube-scheduler without the leading \"--\"",
+                    "type": "object"
+                }
+            }
+        },
+        "api.ConfigurationCertificate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "content": {
+                    "description": "private key content, only exported when includeSecrets is true",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "private key name",
+                    "type": "string"
                 }
             }
         },
@@ -1261,7 +1993,7 @@ var doc = `{
                 "ip": {
                     "description": "node ip",
                     "type": "string",
-                    "maxLength": 15,
+                    "maxLength": 39,
                     "minLength": 1
                 },
                 "password": {
@@ -1286,6 +2018,104 @@ var doc = `{
                 }
             }
         },
+        "api.DeployPlanAction": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "action name",
+                    "type": "string"
+                },
+                "node": {
+                    "description": "node name which the action is executed on",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "action type",
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeployPlanFile": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "file content",
+                    "type": "string"
+                },
+                "node": {
+                    "description": "node name",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "file path on the node",
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeployPlanResponse": {
+            "type": "object",
+            "properties": {
+                "etcdCerts": {
+                    "description": "Subjects of etcd server and peer certificates",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.EtcdCertPlan"
+                    }
+                },
+                "files": {
+                    "description": "Files rendered for nodes, such as kubeadm config, haproxy and keepalived configs",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeployPlanFile"
+                    }
+                },
+                "plan": {
+                    "description": "The deploy task split into sub tasks and actions",
+                    "type": "object",
+                    "$ref": "#/definitions/api.DeployPlanTask"
+                }
+            }
+        },
+        "api.DeployPlanTask": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "description": "actions of the task",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeployPlanAction"
+                    }
+                },
+                "name": {
+                    "description": "task name",
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "sub tasks with smaller priority are executed first, the same priority are executed in parallel",
+                    "type": "integer"
+                },
+                "subTasks": {
+                    "description": "sub tasks of the task",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeployPlanTask"
+                    }
+                },
+                "type": {
+                    "description": "task type",
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeployRequest": {
+            "type": "object",
+            "properties": {
+                "executionPolicies": {
+                    "description": "Execution policies keyed by task type, such as DeployWorker or NodeInit",
+                    "type": "object"
+                }
+            }
+        },
         "api.DeploymentNode": {
             "type": "object",
             "properties": {
@@ -1331,24 +2161,103 @@ var doc = `{
                 }
             }
         },
-        "api.Error": {
+        "api.Encryption": {
+            "type": "object",
+            "required": [
+                "provider"
+            ],
+            "properties": {
+                "provider": {
+                    "description": "provider which encrypts secrets at rest, the key is generated on the first master",
+                    "type": "string",
+                    "enum": [
+                        "aescbc",
+                        "secretbox"
+                    ]
+                }
+            }
+        },
+        "api.Error": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Why is it wrong, what is the judgment condition?",
+                    "type": "string"
+                },
+                "fixMethods": {
+                    "description": "How to improve to meet the conditions",
+                    "type": "string"
+                },
+                "logId": {
+                    "description": "ID used to get the log file",
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "Reason of Error message",
+                    "type": "string"
+                }
+            }
+        },
+        "api.EtcdCertPlan": {
+            "type": "object",
+            "properties": {
+                "commonName": {
+                    "description": "certificate common name",
+                    "type": "string"
+                },
+                "dnsNames": {
+                    "description": "subject alternative DNS names",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ips": {
+                    "description": "subject alternative IPs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "node": {
+                    "description": "etcd node name",
+                    "type": "string"
+                }
+            }
+        },
+        "api.ExecutionPolicy": {
+            "type": "object",
+            "properties": {
+                "batchSize": {
+                    "description": "Number of sub tasks or actions in a rolling batch, 0 means all in one batch",
+                    "type": "integer"
+                },
+                "concurrency": {
+                    "description": "Maximum number of sub tasks or actions executed at the same time, 0 means unlimited",
+                    "type": "integer"
+                },
+                "failureThreshold": {
+                    "description": "Failure percentage which stops the following batches, 0 means never stop",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.FlannelOptions": {
             "type": "object",
             "properties": {
-                "detail": {
-                    "description": "Why is it wrong, what is the judgment condition?",
-                    "type": "string"
+                "backend": {
+                    "type": "string",
+                    "enum": [
+                        "vxlan",
+                        "host-gw"
+                    ]
                 },
-                "fixMethods": {
-                    "description": "How to improve to meet the conditions",
+                "iface": {
+                    "description": "interface used for inter-host communication, interface of default route is used if empty",
                     "type": "string"
                 },
-                "logId": {
-                    "description": "ID used to get the log file",
+                "vxlanPort": {
                     "type": "integer"
-                },
-                "reason": {
-                    "description": "Reason of Error message",
-                    "type": "string"
                 }
             }
         },
@@ -1402,6 +2311,40 @@ var doc = `{
                     "items": {
                         "$ref": "#/definitions/api.DeploymentResponseData"
                     }
+                },
+                "taskExecutions": {
+                    "description": "Progress of the tasks which have an execution policy",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.TaskExecution"
+                    }
+                },
+                "verifyItems": {
+                    "description": "Results of the smoke test after the cluster is deployed",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.CheckingItem"
+                    }
+                }
+            }
+        },
+        "api.GetEncryptionKeyRotationResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "error of the rotation if failed",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Error"
+                },
+                "status": {
+                    "description": "status of the latest rotation",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "successful",
+                        "failed"
+                    ]
                 }
             }
         },
@@ -1471,6 +2414,11 @@ var doc = `{
                         "$ref": "#/definitions/api.DeploymentResponseData"
                     }
                 },
+                "ingressOptions": {
+                    "description": "Ingress options",
+                    "type": "object",
+                    "$ref": "#/definitions/api.IngressOptions"
+                },
                 "mode": {
                     "description": "Wizard mode, normal or advanced",
                     "type": "string"
@@ -1540,6 +2488,50 @@ var doc = `{
                 "type": "object"
             }
         },
+        "api.IngressOptions": {
+            "type": "object",
+            "properties": {
+                "controllerImage": {
+                    "description": "image of contour or nginx-ingress-controller, default image is used if empty",
+                    "type": "string"
+                },
+                "defaultTLSCertificate": {
+                    "description": "secret of default TLS certificate in format of \"namespace/secretName\", only supported by nginx",
+                    "type": "string"
+                },
+                "exposeMode": {
+                    "description": "hostNetwork runs a DaemonSet on every ingress node, nodePort runs a Deployment exposed by a NodePort service",
+                    "type": "string",
+                    "enum": [
+                        "hostNetwork",
+                        "nodePort"
+                    ]
+                },
+                "httpNodePort": {
+                    "description": "node port of http in nodePort mode, allocated by kubernetes if empty",
+                    "type": "integer"
+                },
+                "httpsNodePort": {
+                    "description": "node port of https in nodePort mode, allocated by kubernetes if empty",
+                    "type": "integer"
+                },
+                "ingressType": {
+                    "type": "string",
+                    "enum": [
+                        "contour",
+                        "nginx"
+                    ]
+                },
+                "proxyImage": {
+                    "description": "image of envoy used by contour, default image is used if empty",
+                    "type": "string"
+                },
+                "replicas": {
+                    "description": "replicas of controller in nodePort mode, default 2",
+                    "type": "integer"
+                }
+            }
+        },
         "api.Label": {
             "type": "object",
             "required": [
@@ -1562,11 +2554,84 @@ var doc = `{
                     "type": "object",
                     "$ref": "#/definitions/api.CalicoOptions"
                 },
+                "ciliumOptions": {
+                    "type": "object",
+                    "$ref": "#/definitions/api.CiliumOptions"
+                },
+                "flannelOptions": {
+                    "type": "object",
+                    "$ref": "#/definitions/api.FlannelOptions"
+                },
                 "networkType": {
                     "type": "string",
                     "enum": [
-                        "calico"
+                        "calico",
+                        "flannel",
+                        "cilium"
+                    ]
+                }
+            }
+        },
+        "api.NodeConfigChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "change action",
+                    "type": "string",
+                    "enum": [
+                        "add",
+                        "update",
+                        "remove"
+                    ]
+                },
+                "key": {
+                    "description": "label or annotation key, taint is identified by \"key:effect\"",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "kind of the item",
+                    "type": "string",
+                    "enum": [
+                        "label",
+                        "annotation",
+                        "taint"
                     ]
+                },
+                "newValue": {
+                    "description": "value after the change",
+                    "type": "string"
+                },
+                "oldValue": {
+                    "description": "value before the change",
+                    "type": "string"
+                }
+            }
+        },
+        "api.NodeConfigDiff": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "description": "changes from the live node to the wizard config, applied unless dry run",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeConfigChange"
+                    }
+                },
+                "drifts": {
+                    "description": "changes made outside kpaas since the config was applied last time",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeConfigChange"
+                    }
+                },
+                "error": {
+                    "description": "error of the node if failed",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Error"
+                },
+                "nodeName": {
+                    "description": "node name",
+                    "type": "string"
                 }
             }
         },
@@ -1599,7 +2664,7 @@ var doc = `{
                 "ip": {
                     "description": "node ip",
                     "type": "string",
-                    "maxLength": 15,
+                    "maxLength": 39,
                     "minLength": 1
                 },
                 "labels": {
@@ -1653,6 +2718,128 @@ var doc = `{
                 }
             }
         },
+        "api.NodeInitProfile": {
+            "type": "object",
+            "properties": {
+                "firewallMode": {
+                    "description": "disable the firewall or keep it with the ports of node roles, network and NodePort range opened",
+                    "type": "string",
+                    "default": "disable",
+                    "enum": [
+                        "disable",
+                        "open-ports"
+                    ]
+                },
+                "hostnamePolicy": {
+                    "description": "hostname is set to node name, or must equal node name if it is kept",
+                    "type": "string",
+                    "default": "node-name",
+                    "enum": [
+                        "node-name",
+                        "keep"
+                    ]
+                },
+                "items": {
+                    "description": "init items enabled or disabled by name, absent items are enabled",
+                    "type": "object"
+                },
+                "ntpServers": {
+                    "description": "time is synchronized by chronyd or systemd-timesyncd with the servers, left as is if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "selinuxMode": {
+                    "description": "SELinux mode of nodes, directories used by containers are labeled if it is enforcing",
+                    "type": "string",
+                    "default": "disabled",
+                    "enum": [
+                        "disabled",
+                        "permissive",
+                        "enforcing"
+                    ]
+                },
+                "swapPolicy": {
+                    "description": "kubelet runs with swap if it is kept",
+                    "type": "string",
+                    "default": "disable",
+                    "enum": [
+                        "disable",
+                        "keep"
+                    ]
+                },
+                "sysctl": {
+                    "description": "sysctl settings persisted on nodes after the built-in ones",
+                    "type": "object"
+                },
+                "timezone": {
+                    "description": "timezone of nodes, Asia/Shanghai if empty",
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "api.Proxy": {
+            "type": "object",
+            "properties": {
+                "httpProxy": {
+                    "description": "proxy of http requests, like http://10.0.0.1:3128",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "httpsProxy": {
+                    "description": "proxy of https requests, like http://10.0.0.1:3128",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "noProxy": {
+                    "description": "addresses not proxied, node IPs, control plane endpoint, service and pod subnets are added automatically",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api.Registry": {
+            "type": "object",
+            "required": [
+                "server"
+            ],
+            "properties": {
+                "caBundle": {
+                    "description": "PEM encoded CA certificates of the registry",
+                    "type": "string"
+                },
+                "insecure": {
+                    "description": "access the registry by http or by https without certificate verification",
+                    "type": "boolean"
+                },
+                "mirrors": {
+                    "description": "mirror endpoints like https://mirror.example.com, docker only supports mirrors of docker.io",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "password": {
+                    "description": "password of username, only exported when includeSecrets is true, the stored password is kept if empty",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "server": {
+                    "description": "host[:port] of the registry, docker.io to set up mirrors of docker hub",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "username": {
+                    "description": "nodes log in to the registry if username is not empty",
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "api.SSHCertificate": {
             "type": "object",
             "required": [
@@ -1699,6 +2886,64 @@ var doc = `{
                 }
             }
         },
+        "api.TaskExecution": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "description": "number of failed sub tasks or actions",
+                    "type": "integer"
+                },
+                "failureThresholdReached": {
+                    "description": "whether the failure threshold was reached",
+                    "type": "boolean"
+                },
+                "policy": {
+                    "description": "execution policy applied to the task",
+                    "type": "object",
+                    "$ref": "#/definitions/api.ExecutionPolicy"
+                },
+                "skipped": {
+                    "description": "number of sub tasks or actions skipped after the failure threshold was reached",
+                    "type": "integer"
+                },
+                "succeeded": {
+                    "description": "number of succeeded sub tasks or actions",
+                    "type": "integer"
+                },
+                "taskName": {
+                    "description": "task name",
+                    "type": "string"
+                },
+                "taskType": {
+                    "description": "task type",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "number of sub tasks or actions",
+                    "type": "integer"
+                }
+            }
+        },
+        "api.UpdateNodeConfigResponse": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "description": "Whether changes are only computed and not applied",
+                    "type": "boolean"
+                },
+                "nodes": {
+                    "description": "Diff of each node",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeConfigDiff"
+                    }
+                },
+                "passed": {
+                    "description": "Whether all nodes are updated successfully",
+                    "type": "boolean"
+                }
+            }
+        },
         "api.UpdateNodeData": {
             "type": "object",
             "required": [
@@ -1775,6 +3020,63 @@ var doc = `{
                 }
             }
         },
+        "api.UploadBatchNodesDryRunResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "description": "Errors of each line",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.BatchNodesError"
+                    }
+                },
+                "nodes": {
+                    "description": "Parsed node list, password will not be returned",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeData"
+                    }
+                },
+                "passed": {
+                    "description": "Whether all nodes are valid",
+                    "type": "boolean"
+                }
+            }
+        },
+        "api.WizardConfiguration": {
+            "type": "object",
+            "properties": {
+                "certificates": {
+                    "description": "SSH private keys referenced by nodes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ConfigurationCertificate"
+                    }
+                },
+                "cluster": {
+                    "description": "Cluster Information",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Cluster"
+                },
+                "ingressOptions": {
+                    "description": "Ingress options",
+                    "type": "object",
+                    "$ref": "#/definitions/api.IngressOptions"
+                },
+                "networkOptions": {
+                    "description": "Network options",
+                    "type": "object",
+                    "$ref": "#/definitions/api.NetworkOptions"
+                },
+                "nodes": {
+                    "description": "Nodes Information, password only exported when includeSecrets is true",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.NodeData"
+                    }
+                }
+            }
+        },
         "h.AppErr": {
             "type": "object",
             "properties": {
@@ -23,6 +23,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/check"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/server"
 	_ "github.com/kpaas-io/kpaas/pkg/utils/log"
 )
@@ -38,6 +40,8 @@ const (
 	defaultPort       uint16 = 8081
 	defaultLogLevel   string = "info"
 	defaultLogFileLoc string = "/app/log/deploy"
+
+	customCheckItemsKey = "customCheckItems"
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -47,9 +51,15 @@ var rootCmd = &cobra.Command{
 	Long:  `The kpass deploy controller provides gRPC API services to deploy a k8s cluster`,
 	Run: func(cmd *cobra.Command, args []string) {
 		setupLogLevel()
+		customCheckItems, err := loadCustomCheckItems()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 		options := server.ServerOptions{
-			Port:       port,
-			LogFileLoc: logFileLoc,
+			Port:             port,
+			LogFileLoc:       logFileLoc,
+			CustomCheckItems: customCheckItems,
 		}
 		server.New(options).Run(SetupSignalHandler())
 	},
@@ -86,6 +96,21 @@ func initConfig() {
 	}
 }
 
+// loadCustomCheckItems reads the custom node check items from config file, each item has the fields
+// of protos.CustomCheckItem: name, description, script, expectedOutput, severity, roles and fixMethods.
+func loadCustomCheckItems() ([]*protos.CustomCheckItem, error) {
+	var items []*protos.CustomCheckItem
+	if err := viper.UnmarshalKey(customCheckItemsKey, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse %v in config file: %v", customCheckItemsKey, err)
+	}
+	for _, item := range items {
+		if err := check.ValidateCustomCheckItem(item); err != nil {
+			return nil, fmt.Errorf("invalid %v in config file: %v", customCheckItemsKey, err)
+		}
+	}
+	return items, nil
+}
+
 func setupLogLevel() {
 	logLevel, err := logrus.ParseLevel(logLevel)
 	if err != nil {
@@ -248,6 +248,30 @@ func getGetCheckNodesResultData() (request *pb.GetCheckNodesResultRequest, reply
 			Name:        "check port-occupied",
 			Description: "检查 port-occupied 环境",
 		},
+		&pb.CheckItem{
+			Name:        "check time-sync",
+			Description: "检查 time-sync 环境",
+		},
+		&pb.CheckItem{
+			Name:        "check nameserver",
+			Description: "检查 nameserver 环境",
+		},
+		&pb.CheckItem{
+			Name:        "check kernel-module",
+			Description: "检查 kernel-module 环境",
+		},
+		&pb.CheckItem{
+			Name:        "check cgroup-driver",
+			Description: "检查 cgroup-driver 环境",
+		},
+		&pb.CheckItem{
+			Name:        "check inode",
+			Description: "检查 inode 环境",
+		},
+		&pb.CheckItem{
+			Name:        "check duplicate-identity",
+			Description: "检查 duplicate-identity 环境",
+		},
 		&pb.CheckItem{
 			Name:        "connectivity-BGP",
 			Description: "检查BGP端口连通性",
@@ -274,6 +298,19 @@ func getGetCheckNodesResultData() (request *pb.GetCheckNodesResultRequest, reply
 	for _, checkResult := range reply.Nodes {
 		checkResult.Items = itemsResult
 	}
+	// disk sync latency is only checked on etcd nodes
+	diskSyncResult := &pb.ItemCheckResult{
+		Item: &pb.CheckItem{
+			Name:        "check disk-sync",
+			Description: "检查 disk-sync 环境",
+		},
+		Status: string(constant.OperationStatusSuccessful),
+		Err:    nil,
+	}
+	for _, node := range _testConfig.Nodes[:3] {
+		checkResult := reply.Nodes[node.Name]
+		checkResult.Items = append(append([]*pb.ItemCheckResult{}, itemsResult...), diskSyncResult)
+	}
 	return
 }
 